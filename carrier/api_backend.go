@@ -3,7 +3,10 @@ package carrier
 import (
 	"errors"
 	"fmt"
+	"github.com/RosettaFlow/Carrier-Go/consensus/chaincons"
 	"github.com/RosettaFlow/Carrier-Go/core/rawdb"
+	"github.com/RosettaFlow/Carrier-Go/core/task"
+	"github.com/RosettaFlow/Carrier-Go/event"
 	"github.com/RosettaFlow/Carrier-Go/grpclient"
 	libTypes "github.com/RosettaFlow/Carrier-Go/lib/types"
 	"github.com/RosettaFlow/Carrier-Go/types"
@@ -129,15 +132,16 @@ func (s *CarrierAPIBackend) GetRegisteredPeers() (*types.YarnRegisteredNodeDetai
 			ExternalIp:   v.ExternalIp,
 			InternalPort: v.InternalPort,
 			ExternalPort: v.ExternalPort,
-			//ResourceUsage:  &types.ResourceUsage{},
-			Duration: duration, // ms
+			ResourceUsage: &types.ResourceUsage{},
+			Duration:      duration, // ms
+			Health:        convertNodeHealth(s.carrier.resourceClientSet.QueryJobNodeHealth(v.Id)),
 		}
 
 		n.Task.Count, _ = s.carrier.carrierDB.GetRunningTaskCountOnJobNode(v.Id)
 		n.Task.TaskIds, _ = s.carrier.carrierDB.GetJobNodeRunningTaskIdList(v.Id)
 		jns[i] = n
 	}
-	dns := make([]*types.YarnRegisteredDataNode, len(jobNodes))
+	dns := make([]*types.YarnRegisteredDataNode, len(dataNodes))
 	for i, v := range dataNodes {
 
 		var duration uint64
@@ -152,8 +156,9 @@ func (s *CarrierAPIBackend) GetRegisteredPeers() (*types.YarnRegisteredNodeDetai
 			ExternalIp:   v.ExternalIp,
 			InternalPort: v.InternalPort,
 			ExternalPort: v.ExternalPort,
-			//ResourceUsage:  &types.ResourceUsage{},
-			Duration: duration, // ms
+			ResourceUsage: &types.ResourceUsage{},
+			Duration:      duration, // ms
+			Health:        convertNodeHealth(s.carrier.resourceClientSet.QueryDataNodeHealth(v.Id)),
 		}
 		n.Delta.FileCount = 0
 		n.Delta.FileTotalSize = 0
@@ -165,6 +170,20 @@ func (s *CarrierAPIBackend) GetRegisteredPeers() (*types.YarnRegisteredNodeDetai
 	}, nil
 }
 
+func convertNodeHealth(health *grpclient.NodeHealth) *types.RegisteredNodeHealth {
+	return &types.RegisteredNodeHealth{
+		State:        health.State().String(),
+		Latency:      uint64(health.Latency.Milliseconds()),
+		FailureCount: health.FailureCount,
+		LastCheckAt:  uint64(health.LastCheckAt),
+		LastError:    health.LastError,
+	}
+}
+
+func (s *CarrierAPIBackend) BackupDatabase(file string) (int, error) {
+	return s.carrier.carrierDB.BackupDatabase(file)
+}
+
 func (s *CarrierAPIBackend) SetSeedNode(seed *types.SeedNodeInfo) (types.NodeConnStatus, error) {
 	//TODO: current node need to connect with seed node.(delay processing)
 	return s.carrier.carrierDB.SetSeedNode(seed)
@@ -259,7 +278,7 @@ func (s *CarrierAPIBackend) UpdateRegisterNode(typ types.RegisteredNodeType, nod
 			return types.NONCONNECTED, fmt.Errorf("connect new jobNode failed, %s", err)
 		}
 		s.carrier.resourceClientSet.StoreJobNodeClient(node.Id, client)
-
+		s.restoreDrained(typ, node.Id)
 	}
 
 	if typ == types.PREFIX_TYPE_DATANODE {
@@ -290,6 +309,7 @@ func (s *CarrierAPIBackend) UpdateRegisterNode(typ types.RegisteredNodeType, nod
 			return types.NONCONNECTED, fmt.Errorf("connect new dataNode failed, %s", err)
 		}
 		s.carrier.resourceClientSet.StoreDataNodeClient(node.Id, client)
+		s.restoreDrained(typ, node.Id)
 
 		// add new data resource  (disk)  todo 后续 需要根据 真实的 dataNode 上报自身的 disk 信息
 		err = s.carrier.carrierDB.StoreDataResourceTable(types.NewDataResourceTable(node.Id, types.DefaultDisk, 0))
@@ -369,9 +389,51 @@ func (s *CarrierAPIBackend) DeleteRegisterNode(typ types.RegisteredNodeType, id
 			return fmt.Errorf("remove disk summary of old registerNode, %s", err)
 		}
 	}
+	if err := s.carrier.carrierDB.RemoveDrainedNode(typ, id); nil != err {
+		return fmt.Errorf("remove drained flag of old registerNode, %s", err)
+	}
 	return s.carrier.carrierDB.DeleteRegisterNode(typ, id)
 }
 
+func (s *CarrierAPIBackend) DrainRegisterNode(typ types.RegisteredNodeType, id string, drained bool) error {
+	if typ != types.PREFIX_TYPE_JOBNODE && typ != types.PREFIX_TYPE_DATANODE {
+		return errors.New("invalid nodeType")
+	}
+	if _, err := s.carrier.carrierDB.GetRegisterNode(typ, id); nil != err {
+		return fmt.Errorf("query registered node failed, %s", err)
+	}
+	if err := s.carrier.healthChecker.SetDrained(typ, id, drained); nil != err {
+		return err
+	}
+	// the drained flag must survive the restarting
+	if drained {
+		return s.carrier.carrierDB.StoreDrainedNode(typ, id)
+	}
+	return s.carrier.carrierDB.RemoveDrainedNode(typ, id)
+}
+
+// IsDataNodeAvailable returns true while the dataNode is connected, not unhealthy and not drained.
+func (s *CarrierAPIBackend) IsDataNodeAvailable(id string) bool {
+	return s.carrier.resourceClientSet.IsDataNodeAvailable(id)
+}
+
+// restoreDrained keeps the drained flag of the node whose client was recreated.
+func (s *CarrierAPIBackend) restoreDrained(typ types.RegisteredNodeType, id string) {
+	drainedIds, err := s.carrier.carrierDB.GetDrainedNodeIdList(typ)
+	if nil != err {
+		log.WithError(err).Errorf("Failed to query the drained %s list", typ.String())
+		return
+	}
+	for _, drainedId := range drainedIds {
+		if drainedId == id {
+			if err := s.carrier.healthChecker.SetDrained(typ, id, true); nil != err {
+				log.WithError(err).Warnf("Failed to restore the drained %s, nodeId: {%s}", typ.String(), id)
+			}
+			return
+		}
+	}
+}
+
 func (s *CarrierAPIBackend) GetRegisterNode(typ types.RegisteredNodeType, id string) (*types.RegisteredNodeInfo, error) {
 	return s.carrier.carrierDB.GetRegisterNode(typ, id)
 }
@@ -422,6 +484,10 @@ func (s *CarrierAPIBackend) SendTaskEvent(event *types.TaskEventInfo) error {
 	return s.carrier.taskManager.SendTaskEvent(event)
 }
 
+func (s *CarrierAPIBackend) ReportTaskResourceExpense(usage *types.TaskResourceUsage) error {
+	return s.carrier.taskManager.ReportTaskResourceExpense(usage)
+}
+
 // metadata api
 func (s *CarrierAPIBackend) GetMetaDataDetail(identityId, metaDataId string) (*types.OrgMetaDataInfo, error) {
 	metadata, err := s.carrier.carrierDB.GetMetadataByDataId(metaDataId)
@@ -656,6 +722,22 @@ func (s *CarrierAPIBackend) GetPowerSingleDetailList() ([]*types.NodePowerDetail
 	return result, nil
 }
 
+func (s *CarrierAPIBackend) GetOrgQuotaList() ([]*types.OrgQuota, error) {
+	return s.carrier.resourceManager.GetOrgQuotas()
+}
+
+func (s *CarrierAPIBackend) GetOrgQuotaUsage(identityId string) (*types.OrgQuotaUsage, error) {
+	return s.carrier.resourceManager.GetOrgQuotaUsage(identityId)
+}
+
+func (s *CarrierAPIBackend) SetOrgQuota(quota *types.OrgQuota) error {
+	return s.carrier.resourceManager.SetOrgQuota(quota)
+}
+
+func (s *CarrierAPIBackend) RemoveOrgQuota(identityId string) error {
+	return s.carrier.resourceManager.RemoveOrgQuota(identityId)
+}
+
 // identity api
 func (s *CarrierAPIBackend) ApplyIdentityJoin(identity *types.Identity) error {
 	//TODO: 申请身份标识时，相关数据需要进行本地存储，然后进行网络发布
@@ -823,6 +905,113 @@ func (s *CarrierAPIBackend) GetTaskEventListByTaskIds(taskIds []string) ([]*type
 	return evenList, nil
 }
 
+// GetLocalTaskEventList returns the task events stored on local,
+// all of the local task events are returned if taskIds is empty.
+func (s *CarrierAPIBackend) GetLocalTaskEventList(taskIds []string) ([]*types.TaskEventInfo, error) {
+	if len(taskIds) == 0 {
+		return s.carrier.carrierDB.GetAllTaskEventList()
+	}
+	evenList := make([]*types.TaskEventInfo, 0)
+	for _, taskId := range taskIds {
+		localEventList, err := s.carrier.carrierDB.GetTaskEventList(taskId)
+		if rawdb.IsNoDBNotFoundErr(err) {
+			return nil, err
+		}
+		evenList = append(evenList, localEventList...)
+	}
+	return evenList, nil
+}
+
+func (s *CarrierAPIBackend) SubscribeTaskEvents(ch chan<- *types.TaskEventInfo) event.Subscription {
+	return s.carrier.carrierDB.SubscribeTaskEvent(ch)
+}
+
+// GetTaskResourceUsage returns the declared cost of task and the resource actually used by the task partners.
+// The declared cost is nil if the task is not found on local.
+func (s *CarrierAPIBackend) GetTaskResourceUsage(taskId string) (*types.TaskOperationCost, []*types.TaskResourceUsage, error) {
+	usageList, err := s.carrier.carrierDB.GetTaskResourceUsageList(taskId)
+	if nil != err {
+		return nil, nil, err
+	}
+
+	// the task is executing.
+	if task, err := s.carrier.carrierDB.GetLocalTask(taskId); nil == err && nil != task {
+		return task.OperationCost(), usageList, nil
+	}
+	// the task has been executed.
+	localIdentityId, err := s.carrier.carrierDB.GetIdentityId()
+	if nil != err {
+		return nil, nil, fmt.Errorf("query local identityId failed, %s", err)
+	}
+	taskList, err := s.carrier.carrierDB.GetTaskListByIdentityId(localIdentityId)
+	if rawdb.IsNoDBNotFoundErr(err) {
+		return nil, nil, err
+	}
+	for _, task := range taskList {
+		if task.TaskId() == taskId {
+			return task.OperationCost(), usageList, nil
+		}
+	}
+	return nil, usageList, nil
+}
+
+// GetTaskProgress returns the latest progress of the executing task on the jobNodes of local and the task partners.
+func (s *CarrierAPIBackend) GetTaskProgress(taskId string) ([]*types.TaskProgress, error) {
+	return s.carrier.carrierDB.GetTaskProgressList(taskId)
+}
+
+func (s *CarrierAPIBackend) CancelTask(taskId string) error {
+
+	// 先尝试从 调度队列中 移除还未被调度的 task
+	if err := s.carrier.scheduler.RemoveTask(taskId); nil == err {
+		return nil
+	}
+
+	// 等待重试退避中的 task, 直接取消其下一次尝试
+	if err := s.carrier.taskManager.CancelPendingRetry(taskId); nil == err {
+		return nil
+	} else if err != task.ErrTaskRetryNotPending {
+		return err
+	}
+
+	// 再取消 共识中 或者 执行中的 task
+	engine, ok := s.carrier.Engines[s.carrier.config.ConsensusEngine]
+	if !ok {
+		return fmt.Errorf("not found %s consensus engine", s.carrier.config.ConsensusEngine)
+	}
+	return engine.OnCancelTask(taskId)
+}
+
+func (s *CarrierAPIBackend) ExplainSchedule(task *types.TaskMsg) (*types.ScheduleExplain, error) {
+	return s.carrier.scheduler.ExplainSchedule(task)
+}
+
+// VerifyTaskAgreement proves the agreement on the task from the local ledger, only the tasks consensused by chaincons have a ledger.
+func (s *CarrierAPIBackend) VerifyTaskAgreement(taskId string) (*types.TaskAgreement, error) {
+	engine, ok := s.carrier.Engines[types.ChainconsTyp].(*chaincons.Chaincons)
+	if !ok {
+		return nil, fmt.Errorf("the tasks are not consensused by %s", types.ChainconsTyp)
+	}
+	return engine.VerifyTaskAgreement(taskId)
+}
+
+// workflow api
+func (s *CarrierAPIBackend) PublishWorkflow(workflow *types.Workflow) (string, error) {
+	return s.carrier.workflowManager.PublishWorkflow(workflow)
+}
+
+func (s *CarrierAPIBackend) GetWorkflow(workflowId string) (*types.Workflow, error) {
+	return s.carrier.carrierDB.GetWorkflow(workflowId)
+}
+
+func (s *CarrierAPIBackend) GetWorkflowList() ([]*types.Workflow, error) {
+	return s.carrier.carrierDB.GetWorkflowList()
+}
+
+func (s *CarrierAPIBackend) CancelWorkflow(workflowId string) error {
+	return s.carrier.workflowManager.CancelWorkflow(workflowId)
+}
+
 // about DataResourceTable
 func (s *CarrierAPIBackend) StoreDataResourceTable(dataResourceTable *types.DataResourceTable) error {
 	return s.carrier.carrierDB.StoreDataResourceTable(dataResourceTable)
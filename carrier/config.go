@@ -1,22 +1,62 @@
 package carrier
 
 import (
+	"github.com/RosettaFlow/Carrier-Go/consensus/twopc"
 	"github.com/RosettaFlow/Carrier-Go/core"
+	"github.com/RosettaFlow/Carrier-Go/core/scheduler"
+	"github.com/RosettaFlow/Carrier-Go/db"
+	"github.com/RosettaFlow/Carrier-Go/grpclient"
 	"github.com/RosettaFlow/Carrier-Go/p2p"
+	"github.com/RosettaFlow/Carrier-Go/types"
 )
 
 // DefaultConfig contains default settings for use on the Carrier main.
 var DefaultConfig = Config{
 	DatabaseCache: 768,
+
+	SchedQueuePolicy:     scheduler.QueuePolicyStarveFIFO,
+	SchedElectionPolicy:  scheduler.PolicyVRF,
+	SchedPlacementPolicy: scheduler.PolicyRoundRobin,
+	SchedHighPriorityCap: scheduler.DefaultHighPriorityCap,
+
+	HealthCheck: grpclient.HealthCheckConfig{
+		Interval:         grpclient.DefaultHealthCheckInterval,
+		Timeout:          grpclient.DefaultHealthCheckTimeout,
+		FailureThreshold: grpclient.DefaultHealthCheckFailureThreshold,
+	},
+
+	TwopcPeriod: twopc.DefaultPeriodConfig,
+
+	ConsensusEngine: types.TwopcTyp,
 }
 
 //go:generate gencodec -type Config -formats toml -out gen_config.go
 
 type Config struct {
 	CarrierDB core.CarrierDB
-	P2P       p2p.P2P
+	// The local database which the ledger of chaincons is stored in
+	DataDB db.Database
+	P2P    p2p.P2P
 
 	// Database options
 	DatabaseHandles    int  `toml:"-"`
 	DatabaseCache      int
+
+	// Scheduler options
+	SchedQueuePolicy     string
+	SchedElectionPolicy  string
+	SchedPlacementPolicy string
+	// The max count of the queued high priority tasks of every identity, 0 means unlimited
+	SchedHighPriorityCap uint32
+	// The slot unit of local resource, nil means using the stored one (or the default one)
+	SlotUnit *types.Slot
+
+	// The health checking options of the registered jobNodes and dataNodes
+	HealthCheck grpclient.HealthCheckConfig
+
+	// The periods of the 2pc proposals started by myself and the bounds of the periods from other owners
+	TwopcPeriod twopc.PeriodConfig
+
+	// The engine which the tasks are consensused by, selected by the network (or the flag)
+	ConsensusEngine types.ConsensusEngineType
 }
\ No newline at end of file
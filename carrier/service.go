@@ -3,6 +3,7 @@ package carrier
 import (
 	"context"
 	"fmt"
+	"github.com/RosettaFlow/Carrier-Go/common/timeutils"
 	"github.com/RosettaFlow/Carrier-Go/consensus/chaincons"
 	"github.com/RosettaFlow/Carrier-Go/consensus/twopc"
 	"github.com/RosettaFlow/Carrier-Go/core"
@@ -11,10 +12,13 @@ import (
 	"github.com/RosettaFlow/Carrier-Go/core/resource"
 	"github.com/RosettaFlow/Carrier-Go/core/scheduler"
 	"github.com/RosettaFlow/Carrier-Go/core/task"
+	"github.com/RosettaFlow/Carrier-Go/core/workflow"
 	"github.com/RosettaFlow/Carrier-Go/db"
+	"github.com/RosettaFlow/Carrier-Go/event"
 	"github.com/RosettaFlow/Carrier-Go/grpclient"
 	"github.com/RosettaFlow/Carrier-Go/handler"
 	"github.com/RosettaFlow/Carrier-Go/p2p"
+	"github.com/RosettaFlow/Carrier-Go/params"
 	"github.com/RosettaFlow/Carrier-Go/types"
 	"sync"
 )
@@ -36,11 +40,16 @@ type Service struct {
 	resourceManager *resource.Manager
 	messageManager  *message.MessageHandler
 	taskManager     *task.Manager
+	workflowManager *workflow.Manager
 	scheduler       core.Scheduler
 	runError        error
 
 	// internal resource node set (Fighter node grpc client set)
 	resourceClientSet *grpclient.InternalResourceClientSet
+	// probe the health of jobNodes and dataNodes in resourceClientSet
+	healthChecker *grpclient.HealthChecker
+	healthEventCh chan *grpclient.NodeHealthEvent
+	healthSub     event.Subscription
 }
 
 // NewService creates a new CarrierServer object (including the
@@ -54,15 +63,33 @@ func NewService(ctx context.Context, config *Config, mockIdentityIdsFile string)
 	eventEngine := evengine.NewEventEngine(config.CarrierDB)
 
 	// TODO 这些 Ch 的大小目前都是写死的 ...
-	localTaskMsgCh, needConsensusTaskCh, replayScheduleTaskCh, doneScheduleTaskCh :=
+	localTaskMsgCh, needConsensusTaskCh, replayScheduleTaskCh, doneScheduleTaskCh, cancelTaskCh :=
 		make(chan types.TaskMsgs, 27),
 		make(chan *types.ConsensusTaskWrap, 100),
 		make(chan *types.ReplayScheduleTaskWrap, 100),
-		make(chan *types.DoneScheduleTaskChWrap, 10)
+		make(chan *types.DoneScheduleTaskChWrap, 10),
+		make(chan *types.CancelTaskWrap, 10)
 
 	resourceClientSet := grpclient.NewInternalResourceNodeSet()
 
-	resourceMng := resource.NewResourceManager(config.CarrierDB, mockIdentityIdsFile)
+	resourceMng := resource.NewResourceManager(config.CarrierDB, mockIdentityIdsFile, config.SlotUnit)
+
+	schedPolicy, err := scheduler.NewSchedulePolicy(config.SchedQueuePolicy, config.SchedElectionPolicy, config.SchedPlacementPolicy)
+	if nil != err {
+		return nil, err
+	}
+	schedPolicy.HighPriorityCap = config.SchedHighPriorityCap
+	if !schedPolicy.Election.Verifiable() {
+		log.Warnf("The %s election policy can not be verified by the power suppliers, only the retry attempts reusing the previous powers can be proposed",
+			schedPolicy.Election.Name())
+	}
+
+	if err := config.TwopcPeriod.Validate(); nil != err {
+		return nil, err
+	}
+	if config.ConsensusEngine != types.TwopcTyp && config.ConsensusEngine != types.ChainconsTyp {
+		return nil, fmt.Errorf("unknown consensus engine: %s", config.ConsensusEngine)
+	}
 
 	taskManager := task.NewTaskManager(
 		config.CarrierDB,
@@ -71,6 +98,7 @@ func NewService(ctx context.Context, config *Config, mockIdentityIdsFile string)
 		resourceClientSet,
 		localTaskMsgCh,
 		doneScheduleTaskCh,
+		cancelTaskCh,
 	)
 
 	s := &Service{
@@ -78,6 +106,7 @@ func NewService(ctx context.Context, config *Config, mockIdentityIdsFile string)
 		cancel:          cancel,
 		config:          config,
 		carrierDB:       config.CarrierDB,
+		dataDb:          config.DataDB,
 		mempool:         pool,
 		resourceManager: resourceMng,
 		messageManager:  message.NewHandler(pool, config.CarrierDB, taskManager),
@@ -91,22 +120,39 @@ func NewService(ctx context.Context, config *Config, mockIdentityIdsFile string)
 			needConsensusTaskCh,
 			replayScheduleTaskCh,
 			doneScheduleTaskCh,
+			schedPolicy,
+			config.P2P.PirKey(),
 		),
 		resourceClientSet: resourceClientSet,
+		healthChecker:     grpclient.NewHealthChecker(resourceClientSet, &config.HealthCheck),
+		healthEventCh:     make(chan *grpclient.NodeHealthEvent, 32),
 	}
 	
 	// read config from p2p config.
 	NodeId, _ := p2p.HexID(nodeId)
 
 	s.APIBackend = &CarrierAPIBackend{carrier: s}
-	s.Engines = make(map[types.ConsensusEngineType]handler.Engine, 0)
-	s.Engines[types.TwopcTyp] = twopc.New(
+	// the peers are admitted by the identities registered on the data center (if the peer admission is enabled)
+	config.P2P.SetIdentityNodeIdsFetcher(func() ([]string, error) {
+		identityList, err := s.carrierDB.GetIdentityList()
+		if nil != err {
+			return nil, err
+		}
+		nodeIds := make([]string, len(identityList))
+		for i, identity := range identityList {
+			nodeIds[i] = identity.NodeId()
+		}
+		return nodeIds, nil
+	})
+	s.workflowManager = workflow.NewWorkflowManager(s.carrierDB, taskManager, s.mempool.Add, s.APIBackend.CancelTask)
+	twopcEngine := twopc.New(
 		&twopc.Config{
 			Option: &twopc.OptionConfig{
 				NodePriKey: s.config.P2P.PirKey(),
 				NodeID:     NodeId,
 			},
 			PeerMsgQueueSize: 1024,
+			Period:           config.TwopcPeriod,
 		},
 		s.carrierDB,
 		resourceMng,
@@ -114,8 +160,19 @@ func NewService(ctx context.Context, config *Config, mockIdentityIdsFile string)
 		needConsensusTaskCh,
 		replayScheduleTaskCh,
 		doneScheduleTaskCh,
+		cancelTaskCh,
 	)
-	s.Engines[types.ChainconsTyp] = chaincons.New()
+	s.Engines = make(map[types.ConsensusEngineType]handler.Engine, 0)
+	switch config.ConsensusEngine {
+	case types.ChainconsTyp:
+		chain, err := core.NewDataChain(ctx, config.DataDB, params.CarrierChainConfig())
+		if nil != err {
+			return nil, err
+		}
+		s.Engines[types.ChainconsTyp] = chaincons.New(s.config.P2P.PirKey(), chain, config.DataDB, twopcEngine)
+	default:
+		s.Engines[types.TwopcTyp] = twopcEngine
+	}
 
 	// load stored jobNode and dataNode
 	jobNodeList, err := s.carrierDB.GetRegisterNodeList(types.PREFIX_TYPE_JOBNODE)
@@ -136,13 +193,31 @@ func NewService(ctx context.Context, config *Config, mockIdentityIdsFile string)
 			}
 		}
 	}
+	// restore the drained flag of jobNodes and dataNodes
+	s.restoreDrainedNodes(types.PREFIX_TYPE_JOBNODE)
+	s.restoreDrainedNodes(types.PREFIX_TYPE_DATANODE)
 	return s, nil
 }
 
+// restoreDrainedNodes marks the registered nodes which were drained by operator before restarting.
+func (s *Service) restoreDrainedNodes(typ types.RegisteredNodeType) {
+	drainedIds, err := s.carrierDB.GetDrainedNodeIdList(typ)
+	if nil != err {
+		log.WithError(err).Errorf("Failed to query the drained %s list", typ.String())
+		return
+	}
+	for _, id := range drainedIds {
+		if err := s.healthChecker.SetDrained(typ, id, true); nil != err {
+			log.WithError(err).Warnf("Failed to restore the drained %s, nodeId: {%s}", typ.String(), id)
+		}
+	}
+}
+
 func (s *Service) Start() error {
 	for typ, engine := range s.Engines {
 		if err := engine.Start(); nil != err {
-			log.WithError(err).Errorf("Cound not start the consensus engine: %s, err: %v", typ.String(), err)
+			// the carrier can not run with a broken consensus engine (e.g. the proposals failed to recover)
+			log.WithError(err).Fatalf("Cound not start the consensus engine: %s", typ.String())
 		}
 	}
 	if nil != s.resourceManager {
@@ -165,6 +240,18 @@ func (s *Service) Start() error {
 			log.WithError(err).Errorf("Failed to start the scheduler, err: %v", err)
 		}
 	}
+	if nil != s.workflowManager {
+		if err := s.workflowManager.Start(); nil != err {
+			log.WithError(err).Errorf("Failed to start the workflowManager, err: %v", err)
+		}
+	}
+	if nil != s.healthChecker {
+		s.healthSub = s.healthChecker.SubscribeNodeHealthEvent(s.healthEventCh)
+		go s.loopNodeHealthEvent()
+		if err := s.healthChecker.Start(); nil != err {
+			log.WithError(err).Errorf("Failed to start the healthChecker, err: %v", err)
+		}
+	}
 
 	return nil
 }
@@ -200,10 +287,90 @@ func (s *Service) Stop() error {
 			log.WithError(err).Errorf("Failed to stop the scheduler, err: %v", err)
 		}
 	}
+	if nil != s.workflowManager {
+		if err := s.workflowManager.Stop(); nil != err {
+			log.WithError(err).Errorf("Failed to stop the workflowManager, err: %v", err)
+		}
+	}
+	if nil != s.healthChecker {
+		if err := s.healthChecker.Stop(); nil != err {
+			log.WithError(err).Errorf("Failed to stop the healthChecker, err: %v", err)
+		}
+		s.healthSub.Unsubscribe()
+	}
+
+	return nil
+}
+
+// loopNodeHealthEvent records the health changes of the jobNodes and dataNodes,
+// and publishes them to the subscriptions of task events.
+func (s *Service) loopNodeHealthEvent() {
+	for {
+		select {
+		case ev := <-s.healthEventCh:
+			switch ev.NewState {
+			case grpclient.NodeHealthUnhealthy:
+				log.Warnf("The %s became unhealthy, nodeId: {%s}, oldState: {%s}, health: %s",
+					ev.NodeType.String(), ev.NodeId, ev.OldState.String(), ev.Health.String())
+			default:
+				log.Infof("The health state of %s changed, nodeId: {%s}, oldState: {%s}, newState: {%s}, health: %s",
+					ev.NodeType.String(), ev.NodeId, ev.OldState.String(), ev.NewState.String(), ev.Health.String())
+			}
+			s.publishNodeHealthEvent(ev)
+		case <-s.healthSub.Err():
+			return
+		}
+	}
+}
+
+func (s *Service) publishNodeHealthEvent(ev *grpclient.NodeHealthEvent) {
+	typ := nodeHealthEventType(ev.NodeType, ev.NewState)
+	if nil == typ {
+		return
+	}
+	// the node event is owned by the local identity, it is empty before applying the identity.
+	identityId, _ := s.carrierDB.GetIdentityId()
+	s.carrierDB.PublishNodeEvent(&types.TaskEventInfo{
+		Type:       typ.Type,
+		Identity:   identityId,
+		Content:    fmt.Sprintf("%s, nodeId: {%s}, oldState: {%s}, health: %s", typ.Msg, ev.NodeId, ev.OldState.String(), ev.Health.String()),
+		CreateTime: uint64(timeutils.UnixMsec()),
+	})
+}
 
+func nodeHealthEventType(nodeType types.RegisteredNodeType, state grpclient.NodeHealthState) *evengine.EventType {
+	switch nodeType {
+	case types.PREFIX_TYPE_JOBNODE:
+		switch state {
+		case grpclient.NodeHealthHealthy:
+			return evengine.JobNodeHealthy
+		case grpclient.NodeHealthUnhealthy:
+			return evengine.JobNodeUnhealthy
+		case grpclient.NodeHealthDrained:
+			return evengine.JobNodeDrained
+		default:
+			return evengine.JobNodeHealthUnknown
+		}
+	case types.PREFIX_TYPE_DATANODE:
+		switch state {
+		case grpclient.NodeHealthHealthy:
+			return evengine.DataNodeHealthy
+		case grpclient.NodeHealthUnhealthy:
+			return evengine.DataNodeUnhealthy
+		case grpclient.NodeHealthDrained:
+			return evengine.DataNodeDrained
+		default:
+			return evengine.DataNodeHealthUnknown
+		}
+	}
 	return nil
 }
 
+// ConsensusEngine returns the type of the engine which the tasks are consensused by.
+func (s *Service) ConsensusEngine() types.ConsensusEngineType {
+	return s.config.ConsensusEngine
+}
+
 // Status is service health checks. Return nil or error.
 func (s *Service) Status() error {
 	// Service don't start
@@ -1,10 +1,19 @@
 package db
 
 import (
+	"errors"
+	"fmt"
 	"github.com/RosettaFlow/Carrier-Go/cmd"
 	"github.com/RosettaFlow/Carrier-Go/common/flags"
+	"github.com/RosettaFlow/Carrier-Go/core/rawdb"
+	"github.com/RosettaFlow/Carrier-Go/db"
+	"github.com/RosettaFlow/Carrier-Go/node"
 	"github.com/sirupsen/logrus"
 	"github.com/urfave/cli/v2"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
 )
 
 var log = logrus.WithField("prefix", "db")
@@ -15,6 +24,23 @@ var Commands = &cli.Command{
 	Category: "db",
 	Usage:    "defines commands for interacting with carrier node database",
 	Subcommands: []*cli.Command{
+		{
+			Name:        "backup",
+			Description: `writes a consistent snapshot of the database into a backup file, the carrier must be stopped (use the BackupDatabase rpc for a running carrier)`,
+			Flags: cmd.WrapFlags([]cli.Flag{
+				flags.DataDirFlag,
+				flags.BackupOutputFileFlag,
+			}),
+			Before: func(context *cli.Context) error {
+				return nil
+			},
+			Action: func(cliCtx *cli.Context) error {
+				if err := backupDB(cliCtx); err != nil {
+					log.WithError(err).Fatal("Could not backup database")
+				}
+				return nil
+			},
+		},
 		{
 			Name:        "restore",
 			Description: `restores a database from a backup file`,
@@ -26,9 +52,102 @@ var Commands = &cli.Command{
 				return nil
 			},
 			Action: func(cliCtx *cli.Context) error {
-
+				if err := restoreDB(cliCtx); err != nil {
+					log.WithError(err).Fatal("Could not restore database")
+				}
 				return nil
 			},
 		},
 	},
 }
+
+func backupDB(cliCtx *cli.Context) error {
+	dataDir := cliCtx.String(flags.DataDirFlag.Name)
+	dbPath := filepath.Join(dataDir, node.DatabaseDirName)
+	if _, err := os.Stat(dbPath); err != nil {
+		return fmt.Errorf("the database is not found, path: %s, %s", dbPath, err)
+	}
+
+	outputFile := cliCtx.String(flags.BackupOutputFileFlag.Name)
+	if "" == outputFile {
+		outputFile = filepath.Join(dataDir, "backups", fmt.Sprintf("%s_%d.backup", node.DatabaseDirName, time.Now().Unix()))
+	}
+
+	database, err := db.NewLDBDatabase(dbPath, 0, 0)
+	if err != nil {
+		return fmt.Errorf("could not open the database (is the carrier still running?), %s", err)
+	}
+	defer database.Close()
+
+	count, err := db.BackupFile(database, outputFile)
+	if err != nil {
+		return err
+	}
+	log.WithField("database-path", dbPath).WithField("backup-file", outputFile).WithField("keys", count).Info("Database backup succeed")
+	return nil
+}
+
+func restoreDB(cliCtx *cli.Context) error {
+	sourceFile := cliCtx.String(flags.RestoreSourceFileFlag.Name)
+	if "" == sourceFile {
+		return errors.New("required the backup file by --" + flags.RestoreSourceFileFlag.Name)
+	}
+	targetDir := cliCtx.String(flags.RestoreTargetDirFlag.Name)
+	dbPath := filepath.Join(targetDir, node.DatabaseDirName)
+
+	// never overwrite an existing database.
+	if files, err := ioutil.ReadDir(dbPath); err == nil && len(files) != 0 {
+		return fmt.Errorf("the target database is not empty, path: %s", dbPath)
+	}
+
+	f, err := os.Open(sourceFile)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	// Restore into a temporary directory first, the target database only appears
+	// after the whole backup file has been validated.
+	tmpPath := dbPath + ".restoring"
+	if err := os.RemoveAll(tmpPath); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(targetDir, 0700); err != nil {
+		return err
+	}
+	database, err := db.NewLDBDatabase(tmpPath, 0, 0)
+	if err != nil {
+		return err
+	}
+	count, err := db.Restore(f, database)
+	if err == nil {
+		err = checkDatabaseVersion(database)
+	}
+	database.Close()
+	if err != nil {
+		os.RemoveAll(tmpPath)
+		return err
+	}
+
+	if err := os.RemoveAll(dbPath); err != nil {
+		os.RemoveAll(tmpPath)
+		return err
+	}
+	if err := os.Rename(tmpPath, dbPath); err != nil {
+		os.RemoveAll(tmpPath)
+		return err
+	}
+	log.WithField("backup-file", sourceFile).WithField("database-path", dbPath).WithField("keys", count).Info("Database restore succeed")
+	return nil
+}
+
+func checkDatabaseVersion(database db.Database) error {
+	version := rawdb.ReadDatabaseVersion(database)
+	if nil == version {
+		return errors.New("the database version is not found in the backup file")
+	}
+	if *version != rawdb.DatabaseVersion {
+		return fmt.Errorf("the database version mismatch, backup: %d, carrier: %d", *version, rawdb.DatabaseVersion)
+	}
+	return nil
+}
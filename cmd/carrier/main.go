@@ -46,6 +46,8 @@ var (
 		flags.RPCPort,
 		flags.CertFlag,
 		flags.KeyFlag,
+		flags.ClientCAFlag,
+		flags.RPCAuthConfigFlag,
 		flags.DisableGRPCGateway,
 		flags.GPRCGatewayCorsDomain,
 		flags.GRPCGatewayHost,
@@ -59,6 +61,9 @@ var (
 		flags.P2PAllowList,
 		flags.P2PHostDNS,
 		flags.P2PDenyList,
+		flags.P2PPeerAdmission,
+		flags.P2PPeerAllowlistFile,
+		flags.P2PPSKFile,
 		flags.P2PMaxPeers,
 		flags.P2PMetadata,
 		flags.P2PPrivKey,
@@ -89,6 +94,30 @@ var (
 		flags.TraceSampleFractionFlag,
 	}
 
+	schedulerFlags = []cli.Flag{
+		flags.SchedQueuePolicyFlag,
+		flags.SchedElectionPolicyFlag,
+		flags.SchedPlacementPolicyFlag,
+		flags.SchedHighPriorityCapFlag,
+		flags.SlotUnitMemFlag,
+		flags.SlotUnitProcessorFlag,
+		flags.SlotUnitBandwidthFlag,
+		flags.FighterHealthCheckIntervalFlag,
+		flags.FighterHealthCheckTimeoutFlag,
+		flags.FighterHealthFailureThresholdFlag,
+	}
+
+	consensusFlags = []cli.Flag{
+		flags.ConsensusEngineFlag,
+		flags.TwopcPrepareVotingTimeoutFlag,
+		flags.TwopcConfirmVotingTimeoutFlag,
+		flags.TwopcCommitEndingTimeoutFlag,
+		flags.TwopcProposalDeadlineFlag,
+		flags.TwopcMinPeriodTimeoutFlag,
+		flags.TwopcMaxPeriodTimeoutFlag,
+		flags.TwopcMaxProposalDeadlineFlag,
+	}
+
 	mockFlags = []cli.Flag{
 		flags.MockIdentityIdFileFlag,
 	}
@@ -101,6 +130,8 @@ func init() {
 	rpcFlags = cmd.WrapFlags(rpcFlags)
 	p2pFlags = cmd.WrapFlags(p2pFlags)
 	debugFlags = cmd.WrapFlags(debugFlags)
+	schedulerFlags = cmd.WrapFlags(schedulerFlags)
+	consensusFlags = cmd.WrapFlags(consensusFlags)
 	mockFlags = cmd.WrapFlags(mockFlags)
 }
 
@@ -119,6 +150,8 @@ func main() {
 	app.Flags = append(app.Flags, nodeFlags...)
 	app.Flags = append(app.Flags, p2pFlags...)
 	app.Flags = append(app.Flags, debugFlags...)
+	app.Flags = append(app.Flags, schedulerFlags...)
+	app.Flags = append(app.Flags, consensusFlags...)
 	app.Flags = append(app.Flags, mockFlags...)
 
 	app.Before = func(ctx *cli.Context) error {
@@ -49,6 +49,8 @@ var appHelpFlagGroups = []flagGroup{
 			flags.GRPCGatewayPort,
 			flags.CertFlag,
 			flags.KeyFlag,
+			flags.ClientCAFlag,
+			flags.RPCAuthConfigFlag,
 			flags.GrpcMaxCallRecvMsgSizeFlag,
 		},
 	},
@@ -92,6 +94,9 @@ var appHelpFlagGroups = []flagGroup{
 			flags.P2PAllowList,
 			flags.P2PHostDNS,
 			flags.P2PDenyList,
+			flags.P2PPeerAdmission,
+			flags.P2PPeerAllowlistFile,
+			flags.P2PPSKFile,
 			flags.P2PMaxPeers,
 			flags.P2PMetadata,
 			flags.P2PPrivKey,
@@ -105,6 +110,34 @@ var appHelpFlagGroups = []flagGroup{
 			flags.RelayNode,
 		},
 	},
+	{
+		Name: "scheduler",
+		Flags: []cli.Flag{
+			flags.SchedQueuePolicyFlag,
+			flags.SchedElectionPolicyFlag,
+			flags.SchedPlacementPolicyFlag,
+			flags.SchedHighPriorityCapFlag,
+			flags.SlotUnitMemFlag,
+			flags.SlotUnitProcessorFlag,
+			flags.SlotUnitBandwidthFlag,
+			flags.FighterHealthCheckIntervalFlag,
+			flags.FighterHealthCheckTimeoutFlag,
+			flags.FighterHealthFailureThresholdFlag,
+		},
+	},
+	{
+		Name: "consensus",
+		Flags: []cli.Flag{
+			flags.ConsensusEngineFlag,
+			flags.TwopcPrepareVotingTimeoutFlag,
+			flags.TwopcConfirmVotingTimeoutFlag,
+			flags.TwopcCommitEndingTimeoutFlag,
+			flags.TwopcProposalDeadlineFlag,
+			flags.TwopcMinPeriodTimeoutFlag,
+			flags.TwopcMaxPeriodTimeoutFlag,
+			flags.TwopcMaxProposalDeadlineFlag,
+		},
+	},
 	{
 		Name: "log",
 		Flags: []cli.Flag{
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/RosettaFlow/Carrier-Go/cmd/utils"
+	"github.com/urfave/cli/v2"
+)
+
+// the header of the libp2p private network swarm key file (base16 encoded)
+const swarmKeyHeader = "/key/swarm/psk/1.0.0/\n/base16/\n"
+
+var outputFlag = &cli.StringFlag{
+	Name:  "output",
+	Usage: "the file to write the swarm key into (print to stdout if it is empty)",
+}
+
+var commandGenswarmkey = &cli.Command{
+	Name:      "genswarmkey",
+	Usage:     "generate new swarm key of the libp2p private network",
+	ArgsUsage: "[ ]",
+	Description: `
+Generate a new random pre-shared key of the libp2p private network,
+all the carriers of the network must use the same key by --p2p-psk-file.
+`,
+	Flags: []cli.Flag{
+		outputFlag,
+	},
+	Action: func(ctx *cli.Context) error {
+		psk := make([]byte, 32)
+		if _, err := rand.Read(psk); err != nil {
+			utils.Fatalf("Failed to generate random swarm key: %v", err)
+		}
+		content := swarmKeyHeader + hex.EncodeToString(psk) + "\n"
+
+		output := ctx.String(outputFlag.Name)
+		if output == "" {
+			fmt.Print(content)
+			return nil
+		}
+		// Make sure the swarm key file doesn't already exist.
+		if _, err := os.Stat(output); err == nil {
+			utils.Fatalf("Swarm key file already exists at %s", output)
+		}
+		if err := ioutil.WriteFile(output, []byte(content), 0600); err != nil {
+			utils.Fatalf("Failed to write swarm key file to %s: %v", output, err)
+		}
+		fmt.Println("Swarm key file: ", output)
+		return nil
+	},
+}
@@ -20,6 +20,7 @@ func init() {
 	app = utils.NewApp(gitCommit, "an Carrier key manager")
 	app.Commands = []*cli.Command{
 		commandGenkeypair,
+		commandGenswarmkey,
 	}
 }
 
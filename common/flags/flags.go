@@ -7,6 +7,7 @@ import (
 	"github.com/urfave/cli/v2/altsrc"
 	"path/filepath"
 	"runtime"
+	"time"
 )
 
 var (
@@ -20,6 +21,16 @@ var (
 		Name:  "tls-key",
 		Usage: "Key for secure gRPC. Pass this and the tls-cert flag in order to use gRPC securely.",
 	}
+	// ClientCAFlag defines a flag for the CA certificate to verify the gRPC client certificates.
+	ClientCAFlag = &cli.StringFlag{
+		Name:  "tls-client-ca",
+		Usage: "CA certificate to verify the client certificates of secure gRPC (mTLS), requires the tls-cert and tls-key flags.",
+	}
+	// RPCAuthConfigFlag defines a flag for the api tokens, client certificates and roles of the gRPC API.
+	RPCAuthConfigFlag = &cli.StringFlag{
+		Name:  "rpc-auth-config",
+		Usage: "Path to a yaml file which configures the api tokens, client certificates and roles of the gRPC API. The gRPC API is not authenticated if unset.",
+	}
 	// DisableGRPCGateway for JSON-HTTP requests to the beacon node.
 	DisableGRPCGateway = &cli.BoolFlag{
 		Name:  "disable-grpc-gateway",
@@ -96,6 +107,11 @@ var (
 		Usage: "Target directory of the restored database",
 		Value: DefaultDataDir(),
 	}
+	// BackupOutputFileFlag specifies the filepath of the database backup file to be written.
+	BackupOutputFileFlag = &cli.StringFlag{
+		Name:  "backup-output-file",
+		Usage: "Filepath of the database backup file to be written (default: <datadir>/backups/datachain_<timestamp>.backup)",
+	}
 	// ConfigFileFlag specifies the filepath to load flag values.
 	ConfigFileFlag = &cli.StringFlag{
 		Name:  "config-file",
@@ -208,6 +224,23 @@ var (
 			"192.168.0.0/16 would deny connections from peers on your local network only. The " +
 			"default is to accept all connections.",
 	}
+	// P2PPeerAdmission enables the identity-based admission of peers.
+	P2PPeerAdmission = &cli.BoolFlag{
+		Name: "p2p-peer-admission",
+		Usage: "Admit only the peers whose nodeId maps to an identity registered (and not revoked) on the data center, " +
+			"or is listed on the file of --p2p-peer-allowlist-file. The default is to admit all peers.",
+	}
+	// P2PPeerAllowlistFile defines the file of the nodeIds always admitted by the peer admission.
+	P2PPeerAllowlistFile = &cli.StringFlag{
+		Name:  "p2p-peer-allowlist-file",
+		Usage: "The file of the nodeIds (one per line) always admitted when --p2p-peer-admission is enabled.",
+	}
+	// P2PPSKFile defines the swarm key file to enable the libp2p private network.
+	P2PPSKFile = &cli.StringFlag{
+		Name: "p2p-psk-file",
+		Usage: "The swarm key file (generated by `keytool genswarmkey`) of the libp2p private network, only the nodes " +
+			"with the same key can connect to each other. The discv5 and relay node are disabled when it is set.",
+	}
 	// EnableUPnPFlag specifies if UPnP should be enabled or not. The default value is false.
 	EnableUPnPFlag = &cli.BoolFlag{
 		Name:  "enable-upnp",
@@ -250,6 +283,117 @@ var (
 		Value: 0.20,
 	}
 
+	// ================================= Scheduler Flags ===========================================
+	// SchedQueuePolicyFlag specifies the queueing policy of the local tasks waiting to be scheduled.
+	SchedQueuePolicyFlag = &cli.StringFlag{
+		Name:  "sched-queue-policy",
+		Usage: "The queueing policy of the local tasks waiting to be scheduled, (\"starve-fifo\", \"fifo\")",
+		Value: "starve-fifo",
+	}
+	// SchedElectionPolicyFlag specifies the strategy to elect the power orgs of task.
+	SchedElectionPolicyFlag = &cli.StringFlag{
+		Name:  "sched-election-policy",
+		Usage: "The strategy to elect the power orgs of task, (\"vrf\", \"round-robin\", \"least-loaded\", \"weighted-random\"), only the election of \"vrf\" can be verified by the task partners, the powers elected by the others are refused on the cross-org proposals",
+		Value: "vrf",
+	}
+	// SchedPlacementPolicyFlag specifies the strategy to place the task on the local jobNodes.
+	SchedPlacementPolicyFlag = &cli.StringFlag{
+		Name:  "sched-placement-policy",
+		Usage: "The strategy to place the task on the local jobNodes, (\"round-robin\", \"least-loaded\", \"weighted-random\")",
+		Value: "round-robin",
+	}
+	// SchedHighPriorityCapFlag specifies the max count of the queued high priority tasks of every identity.
+	SchedHighPriorityCapFlag = &cli.UintFlag{
+		Name:  "sched-high-priority-cap",
+		Usage: "The max count of the queued tasks with high priority of every identity, the priority of the task beyond the cap is downgraded to normal, 0 means unlimited",
+		Value: 8,
+	}
+	// SlotUnitMemFlag specifies the mem of the slot unit, which is the minimum unit of the local resource assigned to the task.
+	SlotUnitMemFlag = &cli.Uint64Flag{
+		Name:  "slot-unit-mem",
+		Usage: "The mem of the slot unit (byte), the slot unit is the minimum unit of the local resource assigned to the task",
+		Value: 1024 * 1024 * 2,
+	}
+	// SlotUnitProcessorFlag specifies the processor of the slot unit.
+	SlotUnitProcessorFlag = &cli.Uint64Flag{
+		Name:  "slot-unit-processor",
+		Usage: "The processor of the slot unit (cpu)",
+		Value: 1,
+	}
+	// SlotUnitBandwidthFlag specifies the bandwidth of the slot unit.
+	SlotUnitBandwidthFlag = &cli.Uint64Flag{
+		Name:  "slot-unit-bandwidth",
+		Usage: "The bandwidth of the slot unit (bps)",
+		Value: 1024 * 64,
+	}
+	// FighterHealthCheckIntervalFlag specifies the interval of probing the health of the registered jobNodes and dataNodes.
+	FighterHealthCheckIntervalFlag = &cli.DurationFlag{
+		Name:  "fighter-health-check-interval",
+		Usage: "The interval of probing the health of the registered jobNodes and dataNodes by calling their `GetStatus`",
+		Value: 10 * time.Second,
+	}
+	// FighterHealthCheckTimeoutFlag specifies the timeout of each health probing.
+	FighterHealthCheckTimeoutFlag = &cli.DurationFlag{
+		Name:  "fighter-health-check-timeout",
+		Usage: "The timeout of each health probing on the registered jobNodes and dataNodes",
+		Value: 2 * time.Second,
+	}
+	// FighterHealthFailureThresholdFlag specifies the count of the consecutive failed probing before a node is marked as unhealthy.
+	FighterHealthFailureThresholdFlag = &cli.UintFlag{
+		Name:  "fighter-health-failure-threshold",
+		Usage: "The node is marked as unhealthy (and skipped by the scheduler) after so many consecutive failed health probing",
+		Value: 3,
+	}
+
+	// ================================= Consensus Flags ===========================================
+	// ConsensusEngineFlag overrides the consensus engine selected by the network.
+	ConsensusEngineFlag = &cli.StringFlag{
+		Name:  "consensus-engine",
+		Usage: "The engine which the tasks are consensused by, `TwopcType` (2pc) or `ChainconsType` (2pc with the signed msgs appended on the local ledger), default to the one of the network",
+	}
+	// TwopcPrepareVotingTimeoutFlag specifies the prepare period of the 2pc proposals started by myself.
+	TwopcPrepareVotingTimeoutFlag = &cli.DurationFlag{
+		Name:  "twopc-prepare-voting-timeout",
+		Usage: "The timeout of the prepare period of the 2pc proposals started by myself, it is carried in prepareMsg and used by all the task partners",
+		Value: 3 * time.Second,
+	}
+	// TwopcConfirmVotingTimeoutFlag specifies the confirm period of the 2pc proposals started by myself.
+	TwopcConfirmVotingTimeoutFlag = &cli.DurationFlag{
+		Name:  "twopc-confirm-voting-timeout",
+		Usage: "The timeout of the confirm period of the 2pc proposals started by myself",
+		Value: 1 * time.Second,
+	}
+	// TwopcCommitEndingTimeoutFlag specifies the commit period of the 2pc proposals started by myself.
+	TwopcCommitEndingTimeoutFlag = &cli.DurationFlag{
+		Name:  "twopc-commit-ending-timeout",
+		Usage: "The timeout of the commit period of the 2pc proposals started by myself",
+		Value: 1 * time.Second,
+	}
+	// TwopcProposalDeadlineFlag specifies the lifetime of the 2pc proposals started by myself.
+	TwopcProposalDeadlineFlag = &cli.DurationFlag{
+		Name:  "twopc-proposal-deadline",
+		Usage: "The max lifetime of the 2pc proposals started by myself, it must hold all the periods",
+		Value: 60 * time.Second,
+	}
+	// TwopcMinPeriodTimeoutFlag specifies the lower bound of the period timeouts accepted from other owners.
+	TwopcMinPeriodTimeoutFlag = &cli.DurationFlag{
+		Name:  "twopc-min-period-timeout",
+		Usage: "The 2pc proposal from other owner is refused if any of its period timeouts is shorter than it",
+		Value: 500 * time.Millisecond,
+	}
+	// TwopcMaxPeriodTimeoutFlag specifies the upper bound of the period timeouts accepted from other owners.
+	TwopcMaxPeriodTimeoutFlag = &cli.DurationFlag{
+		Name:  "twopc-max-period-timeout",
+		Usage: "The 2pc proposal from other owner is refused if any of its period timeouts is longer than it",
+		Value: 30 * time.Second,
+	}
+	// TwopcMaxProposalDeadlineFlag specifies the upper bound of the proposal deadline accepted from other owners.
+	TwopcMaxProposalDeadlineFlag = &cli.DurationFlag{
+		Name:  "twopc-max-proposal-deadline",
+		Usage: "The 2pc proposal from other owner is refused if its deadline is longer than it",
+		Value: 10 * time.Minute,
+	}
+
 	// +++++++++++++++++++++++++++++++++++++++++ Mock Flags +++++++++++++++++++++++++++++++++++++++++
 	MockIdentityIdFileFlag = &cli.StringFlag{
 		Name:  "mock-identity-file",
@@ -0,0 +1,138 @@
+package chaincons
+
+import (
+	"github.com/RosettaFlow/Carrier-Go/common"
+	"github.com/RosettaFlow/Carrier-Go/p2p"
+	"github.com/RosettaFlow/Carrier-Go/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// taskPartnerNodeIds returns the nodes of the task partners which vote on the proposal of the task owner,
+// the node of the task owner itself does not vote.
+func taskPartnerNodeIds(task *types.Task) map[p2p.NodeID]struct{} {
+	nodeIds := make(map[p2p.NodeID]struct{})
+	owner, _ := p2p.HexID(task.TaskData().NodeId)
+	add := func(nodeId string) {
+		if id, err := p2p.HexID(nodeId); nil == err && id != owner {
+			nodeIds[id] = struct{}{}
+		}
+	}
+	for _, supplier := range task.TaskData().MetadataSupplier {
+		add(supplier.GetOrganization().GetNodeId())
+	}
+	for _, supplier := range task.TaskData().ResourceSupplier {
+		add(supplier.GetOrganization().GetNodeId())
+	}
+	for _, receiver := range task.TaskData().Receivers {
+		add(receiver.GetReceiver().GetNodeId())
+	}
+	return nodeIds
+}
+
+// signerNodeId returns the node of the public key recovered from the signature of msg.
+func signerNodeId(signer []byte) (p2p.NodeID, error) {
+	pubKey, err := crypto.UnmarshalPubkey(signer)
+	if nil != err {
+		return p2p.NodeID{}, err
+	}
+	return p2p.PubkeyID(pubKey), nil
+}
+
+// proveAgreement decides the agreed proposal of the task from the verified ledger entries.
+//
+// On the task owner, a proposal started by myself is agreed if every partner node of the task
+// has signed a `YES` confirmVote on it, and none of them has rejected it. On a task partner,
+// a proposal is agreed if the prepareMsg was signed by the node of the task owner and the
+// commitMsg was signed by the same node.
+func proveAgreement(agreement *types.TaskAgreement, entries []*ledgerEntry) {
+	type vote struct {
+		proposalId common.Hash
+		signer     p2p.NodeID
+		yes        bool
+	}
+	var (
+		// the proposals started by myself (proposalId -> the partner nodes of task)
+		proposals = make(map[common.Hash]map[p2p.NodeID]struct{})
+		// the signers of prepareMsg and commitMsg received from the task owner
+		owners  = make(map[common.Hash]p2p.NodeID)
+		commits = make(map[common.Hash]p2p.NodeID)
+		votes   = make([]vote, 0)
+	)
+	for i, entry := range entries {
+		switch entry.Kind {
+		case entryProposal:
+			if tasks := agreement.Blocks[i].TaskDatas(); len(tasks) != 0 {
+				proposals[entry.ProposalId] = taskPartnerNodeIds(tasks[0])
+			}
+		case entryPrepareMsg:
+			tasks := agreement.Blocks[i].TaskDatas()
+			if len(tasks) == 0 {
+				continue
+			}
+			signer, err := signerNodeId(entry.Signer)
+			if nil != err {
+				continue
+			}
+			if owner, err := p2p.HexID(tasks[0].TaskData().NodeId); nil == err && owner == signer {
+				owners[entry.ProposalId] = signer
+			}
+		case entryCommitMsg:
+			if signer, err := signerNodeId(entry.Signer); nil == err {
+				commits[entry.ProposalId] = signer
+			}
+		case entryConfirmVote:
+			msg, err := decodeEntryMsg(entry)
+			if nil != err {
+				continue
+			}
+			signer, err := signerNodeId(entry.Signer)
+			if nil != err {
+				continue
+			}
+			votes = append(votes, vote{
+				proposalId: entry.ProposalId,
+				signer:     signer,
+				yes:        types.VoteOptionFromBytes(msg.(*types.ConfirmVoteWrap).VoteOption) == types.Yes,
+			})
+		}
+	}
+
+	for proposalId, owner := range owners {
+		if signer, ok := commits[proposalId]; ok && signer == owner {
+			agreement.ProposalId, agreement.Agreed = proposalId, true
+			return
+		}
+	}
+
+	var (
+		yesVoters = make(map[common.Hash]map[p2p.NodeID]struct{})
+		rejected  = make(map[common.Hash]bool)
+	)
+	for _, v := range votes {
+		// only the votes signed by the partners on the proposals started by myself are counted, once per signer
+		partners, ok := proposals[v.proposalId]
+		if !ok {
+			continue
+		}
+		if _, ok := partners[v.signer]; !ok {
+			continue
+		}
+		if !v.yes {
+			rejected[v.proposalId] = true
+			continue
+		}
+		if _, ok := yesVoters[v.proposalId]; !ok {
+			yesVoters[v.proposalId] = make(map[p2p.NodeID]struct{})
+		}
+		yesVoters[v.proposalId][v.signer] = struct{}{}
+	}
+	for proposalId, partners := range proposals {
+		if len(partners) == 0 || rejected[proposalId] {
+			continue
+		}
+		if len(yesVoters[proposalId]) == len(partners) {
+			agreement.ProposalId, agreement.Agreed = proposalId, true
+			return
+		}
+	}
+}
@@ -0,0 +1,111 @@
+package chaincons
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/RosettaFlow/Carrier-Go/common"
+	pb "github.com/RosettaFlow/Carrier-Go/lib/consensus/twopc"
+	"github.com/RosettaFlow/Carrier-Go/types"
+	"gotest.tools/assert"
+)
+
+// newTestAgreement seals the entries into the blocks of an agreement, the task is carried by the first block.
+func newTestAgreement(t *testing.T, task *types.Task, entries ...*ledgerEntry) *types.TaskAgreement {
+	sealer := newTestKey(t)
+	agreement := &types.TaskAgreement{TaskId: testTaskId, Blocks: make(types.Blocks, 0)}
+	var parent *types.Block
+	for i, entry := range entries {
+		var carried *types.Task
+		if i == 0 {
+			carried = task
+		}
+		block, err := sealBlock(parent, entry, carried, sealer)
+		assert.NilError(t, err)
+		agreement.Blocks = append(agreement.Blocks, block)
+		parent = block
+	}
+	return agreement
+}
+
+func TestProveAgreementOnOwner(t *testing.T) {
+	var (
+		owner      = newTestKey(t)
+		partnerA   = newTestKey(t)
+		partnerB   = newTestKey(t)
+		outsider   = newTestKey(t)
+		task       = newTestTask(owner, partnerA, partnerB)
+		proposalId = common.BytesToHash([]byte("proposal"))
+		proposal   = &ledgerEntry{Kind: entryProposal, TaskId: testTaskId, ProposalId: proposalId}
+	)
+	yesA, _ := newTestMsgEntry(t, newTestConfirmVote(proposalId, types.Yes), partnerA)
+	yesB, _ := newTestMsgEntry(t, newTestConfirmVote(proposalId, types.Yes), partnerB)
+	noB, _ := newTestMsgEntry(t, newTestConfirmVote(proposalId, types.No), partnerB)
+	yesOutsider, _ := newTestMsgEntry(t, newTestConfirmVote(proposalId, types.Yes), outsider)
+	yesOther, _ := newTestMsgEntry(t, newTestConfirmVote(common.BytesToHash([]byte("other")), types.Yes), partnerB)
+
+	testCases := []struct {
+		name    string
+		entries []*ledgerEntry
+		agreed  bool
+	}{
+		{"all partners agreed", []*ledgerEntry{proposal, yesA, yesB}, true},
+		{"a partner missing", []*ledgerEntry{proposal, yesA}, false},
+		// the vote retried by the same partner is counted once
+		{"a partner voted twice", []*ledgerEntry{proposal, yesA, yesA}, false},
+		{"a partner rejected", []*ledgerEntry{proposal, yesA, yesB, noB}, false},
+		{"an outsider voted", []*ledgerEntry{proposal, yesA, yesOutsider}, false},
+		{"a vote on another proposal", []*ledgerEntry{proposal, yesA, yesOther}, false},
+		// the votes can not be counted without the proposal started by myself
+		{"no proposal of myself", []*ledgerEntry{yesA, yesA, yesB}, false},
+	}
+	for _, tc := range testCases {
+		agreement := newTestAgreement(t, task, tc.entries...)
+		proveAgreement(agreement, tc.entries)
+		assert.Equal(t, agreement.Agreed, tc.agreed, tc.name)
+		if tc.agreed {
+			assert.Equal(t, agreement.ProposalId, proposalId, tc.name)
+		}
+	}
+}
+
+func TestProveAgreementOnPartner(t *testing.T) {
+	var (
+		owner      = newTestKey(t)
+		partner    = newTestKey(t)
+		outsider   = newTestKey(t)
+		proposalId = common.BytesToHash([]byte("proposal"))
+	)
+	newPrepareMsg := func() *types.PrepareMsgWrap {
+		var buf bytes.Buffer
+		assert.NilError(t, newTestTask(owner, partner).EncodePb(&buf))
+		return &types.PrepareMsgWrap{PrepareMsg: &pb.PrepareMsg{ProposalId: proposalId.Bytes(), TaskInfo: buf.Bytes(), CreateAt: 1}}
+	}
+	newCommitMsg := func() *types.CommitMsgWrap {
+		return &types.CommitMsgWrap{CommitMsg: &pb.CommitMsg{ProposalId: proposalId.Bytes(), CreateAt: 1}}
+	}
+	prepare, task := newTestMsgEntry(t, newPrepareMsg(), owner)
+	commit, _ := newTestMsgEntry(t, newCommitMsg(), owner)
+	forgedPrepare, _ := newTestMsgEntry(t, newPrepareMsg(), outsider)
+	forgedCommit, _ := newTestMsgEntry(t, newCommitMsg(), outsider)
+
+	testCases := []struct {
+		name    string
+		entries []*ledgerEntry
+		agreed  bool
+	}{
+		{"committed by the owner", []*ledgerEntry{prepare, commit}, true},
+		{"not committed", []*ledgerEntry{prepare}, false},
+		{"committed by an outsider", []*ledgerEntry{prepare, forgedCommit}, false},
+		// the prepareMsg must be sent by the node of the task owner
+		{"prepared by an outsider", []*ledgerEntry{forgedPrepare, forgedCommit}, false},
+	}
+	for _, tc := range testCases {
+		agreement := newTestAgreement(t, task, tc.entries...)
+		proveAgreement(agreement, tc.entries)
+		assert.Equal(t, agreement.Agreed, tc.agreed, tc.name)
+		if tc.agreed {
+			assert.Equal(t, agreement.ProposalId, proposalId, tc.name)
+		}
+	}
+}
@@ -1,23 +1,242 @@
 package chaincons
 
 import (
+	"crypto/ecdsa"
+	"fmt"
+	"sync"
+
+	"github.com/RosettaFlow/Carrier-Go/common"
+	"github.com/RosettaFlow/Carrier-Go/consensus/twopc"
+	"github.com/RosettaFlow/Carrier-Go/core"
+	"github.com/RosettaFlow/Carrier-Go/core/rawdb"
+	"github.com/RosettaFlow/Carrier-Go/db"
 	"github.com/RosettaFlow/Carrier-Go/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	lru "github.com/hashicorp/golang-lru"
 	"github.com/libp2p/go-libp2p-core/peer"
-	log "github.com/sirupsen/logrus"
 )
 
+const defaultRecordedMsgCacheSize = 4096
+
+// Chaincons is the ledger-backed consensus engine. The tasks are consensused by the
+// msgs of 2pc, and every step of the consensus (the proposal started by myself and the
+// msgs received from the other task members) is appended on the local ledger as a block
+// sealed by myself. The msgs keep the signatures of their senders, so the agreement
+// on a task can be proved from the ledger after the fact.
+//
+// The blocks are indexed by the taskIds of their entries, so the agreement on a task is
+// proved from the blocks of the task only.
 type Chaincons struct {
+	engine *twopc.TwoPC
+	chain  *core.DataChain
+	db     db.Database
+	priKey *ecdsa.PrivateKey
+
+	// the consensus msgs which have been appended, used to drop the retries of them
+	recordedMsgCache *lru.Cache
+	ledgerLock       sync.Mutex
+}
 
+func New(priKey *ecdsa.PrivateKey, chain *core.DataChain, db db.Database, engine *twopc.TwoPC) *Chaincons {
+	recordedMsgCache, _ := lru.New(defaultRecordedMsgCacheSize)
+	c := &Chaincons{
+		engine:           engine,
+		chain:            chain,
+		db:               db,
+		priKey:           priKey,
+		recordedMsgCache: recordedMsgCache,
+	}
+	// the proposal is appended once by 2pc when it starts, when it is sent by `Scheduler`
+	engine.SetProposalHook(c.appendProposal)
+	return c
 }
 
-func New() *Chaincons {return &Chaincons{}}
-func (c *Chaincons)Start() error {
+func (c *Chaincons) Start() error {
+	if err := c.reindexLedger(); nil != err {
+		return err
+	}
+	if err := c.engine.Start(); nil != err {
+		return err
+	}
 	log.Info("Started chainCons consensus engine ...")
 	return nil
 }
-func (c *Chaincons) Close() error {return nil}
-func (c *Chaincons)OnPrepare(task *types.Task) error {return nil}
-func (c *Chaincons)OnHandle(task *types.Task,selfPeerResource *types.PrepareVoteResource, result chan<- *types.ConsensuResult) error  {return nil}
-func (c *Chaincons) ValidateConsensusMsg(pid peer.ID, msg types.ConsensusMsg) error {return nil}
-func (c *Chaincons) OnConsensusMsg(pid peer.ID, msg types.ConsensusMsg) error {return nil}
-func (c *Chaincons)OnError() error  {return nil}
\ No newline at end of file
+func (c *Chaincons) Close() error {
+	return c.engine.Close()
+}
+
+func (c *Chaincons) OnPrepare(task *types.Task) error {
+	return c.engine.OnPrepare(task)
+}
+func (c *Chaincons) ValidateConsensusMsg(pid peer.ID, msg types.ConsensusMsg) error {
+	return c.engine.ValidateConsensusMsg(pid, msg)
+}
+
+// OnConsensusMsg handles the msg by 2pc, and then appends the msg accepted by 2pc on the ledger.
+// The proposal can not be proved without the msg, so it is interrupted if the msg can not be appended.
+func (c *Chaincons) OnConsensusMsg(pid peer.ID, msg types.ConsensusMsg) error {
+	// the proposal may be finished by the msg (e.g. commitMsg), so fetch the taskId before handling it.
+	taskId := c.proposalTaskId(msg)
+	if err := c.engine.OnConsensusMsg(pid, msg); nil != err {
+		return err
+	}
+	if c.recordedMsgCache.Contains(msg.Hash()) {
+		return nil
+	}
+	if "" == taskId {
+		taskId = c.proposalTaskId(msg)
+	}
+	entry, task, ok, err := newMsgEntry(msg, taskId)
+	if nil == err && !ok {
+		return nil
+	}
+	if nil == err {
+		if "" == entry.TaskId {
+			// the msg of the proposal which is not running on myself is not a step of any agreement
+			return nil
+		}
+		err = c.appendEntry(entry, task)
+	}
+	if nil != err {
+		log.Errorf("Failed to append the consensus msg on ledger, interrupt the proposal, remote pid: {%s}, msgHash: {%s}, err: {%s}",
+			pid, msg.Hash().String(), err)
+		c.engine.InterruptProposal(msgProposalId(msg))
+		return fmt.Errorf("failed to append the consensus msg on ledger, %s", err)
+	}
+	c.recordedMsgCache.Add(msg.Hash(), struct{}{})
+	return nil
+}
+func (c *Chaincons) OnCancelTask(taskId string) error {
+	return c.engine.OnCancelTask(taskId)
+}
+func (c *Chaincons) OnError() error {
+	return c.engine.OnError()
+}
+
+// VerifyTaskAgreement verifies the indexed blocks of the task, and then proves the agreement on the task from them.
+func (c *Chaincons) VerifyTaskAgreement(taskId string) (*types.TaskAgreement, error) {
+	var (
+		agreement = &types.TaskAgreement{TaskId: taskId, Blocks: make(types.Blocks, 0)}
+		entries   = make([]*ledgerEntry, 0)
+		sealer    = crypto.FromECDSAPub(&c.priKey.PublicKey)
+	)
+	for _, number := range rawdb.ReadLedgerTaskIndex(c.db, taskId) {
+		block := c.chain.GetBlockByNumber(number)
+		if nil == block {
+			return nil, fmt.Errorf("%s, number: %d", ErrLedgerBlockMissing, number)
+		}
+		// the block is linked to the previous one by its seal, so the parent hash is verified
+		// against the previous block instead of walking the whole ledger.
+		var parent common.Hash
+		if number > 0 {
+			prev := c.chain.GetBlockByNumber(number - 1)
+			if nil == prev {
+				return nil, fmt.Errorf("%s, number: %d", ErrLedgerBlockMissing, number-1)
+			}
+			parent = prev.Hash()
+		}
+		entry, err := verifyBlock(block, number, parent, sealer)
+		if nil != err {
+			return nil, fmt.Errorf("%s, number: %d, hash: %s", err, number, block.Hash().String())
+		}
+		if entry.TaskId != taskId {
+			return nil, fmt.Errorf("%s, number: %d, hash: %s", ErrLedgerBlockTaskInvalid, number, block.Hash().String())
+		}
+		agreement.Blocks = append(agreement.Blocks, block)
+		entries = append(entries, entry)
+	}
+	proveAgreement(agreement, entries)
+	return agreement, nil
+}
+
+func (c *Chaincons) proposalTaskId(msg types.ConsensusMsg) string {
+	proposalId := msgProposalId(msg)
+	if proposalId == (common.Hash{}) {
+		return ""
+	}
+	return c.engine.ProposalTaskId(proposalId)
+}
+
+// msgProposalId returns the proposalId of the msg which is a step of the agreement, empty for the other msgs.
+func msgProposalId(msg types.ConsensusMsg) common.Hash {
+	switch msg := msg.(type) {
+	case *types.PrepareMsgWrap:
+		return common.BytesToHash(msg.ProposalId)
+	case *types.PrepareVoteWrap:
+		return common.BytesToHash(msg.ProposalId)
+	case *types.ConfirmMsgWrap:
+		return common.BytesToHash(msg.ProposalId)
+	case *types.ConfirmVoteWrap:
+		return common.BytesToHash(msg.ProposalId)
+	case *types.CommitMsgWrap:
+		return common.BytesToHash(msg.ProposalId)
+	default:
+		return common.Hash{}
+	}
+}
+
+func (c *Chaincons) appendProposal(proposalId common.Hash, task *types.Task) error {
+	return c.appendEntry(&ledgerEntry{Kind: entryProposal, TaskId: task.TaskId(), ProposalId: proposalId}, task)
+}
+
+// appendEntry seals the entry into a block and appends it on the head of the ledger.
+func (c *Chaincons) appendEntry(entry *ledgerEntry, task *types.Task) error {
+	c.ledgerLock.Lock()
+	defer c.ledgerLock.Unlock()
+
+	block, err := sealBlock(c.chain.CurrentBlock(), entry, task, c.priKey)
+	if nil != err {
+		return err
+	}
+	if _, err := c.chain.InsertData(types.Blocks{block}); nil != err {
+		return err
+	}
+	if err := c.indexBlock(block.NumberU64(), entry.TaskId); nil != err {
+		return err
+	}
+	log.Debugf("Appended the consensus entry on ledger, number: {%d}, hash: {%s}, entry: %s",
+		block.NumberU64(), block.Hash().String(), entry.String())
+	return nil
+}
+
+// indexBlock adds the block into the index of the task, and moves the index head onto it.
+func (c *Chaincons) indexBlock(number uint64, taskId string) error {
+	batch := c.db.NewBatch()
+	numbers := rawdb.ReadLedgerTaskIndex(c.db, taskId)
+	if len(numbers) == 0 || numbers[len(numbers)-1] < number {
+		rawdb.WriteLedgerTaskIndex(batch, taskId, append(numbers, number))
+	}
+	rawdb.WriteLedgerIndexHead(batch, number)
+	return batch.Write()
+}
+
+// reindexLedger indexes the blocks which were appended but not indexed before the last shutdown.
+func (c *Chaincons) reindexLedger() error {
+	c.ledgerLock.Lock()
+	defer c.ledgerLock.Unlock()
+
+	head := c.chain.CurrentBlock()
+	if nil == head {
+		return nil
+	}
+	number := uint64(0)
+	if indexed := rawdb.ReadLedgerIndexHead(c.db); nil != indexed {
+		number = *indexed + 1
+	}
+	for ; number <= head.NumberU64(); number++ {
+		block := c.chain.GetBlockByNumber(number)
+		if nil == block {
+			return fmt.Errorf("%s, number: %d", ErrLedgerBlockMissing, number)
+		}
+		var entry ledgerEntry
+		if err := rlp.DecodeBytes(block.ExtraData(), &entry); nil != err {
+			return fmt.Errorf("failed to decode the ledger entry, number: %d, hash: %s, %s", number, block.Hash().String(), err)
+		}
+		if err := c.indexBlock(number, entry.TaskId); nil != err {
+			return err
+		}
+		log.Debugf("Reindexed the ledger block, number: {%d}, taskId: {%s}", number, entry.TaskId)
+	}
+	return nil
+}
@@ -0,0 +1,15 @@
+package chaincons
+
+import "errors"
+
+var (
+	ErrLedgerBlockNumberInvalid = errors.New("The number of ledger block is not contiguous")
+	ErrLedgerBlockParentInvalid = errors.New("The parent of ledger block is not the previous block")
+	ErrLedgerBlockExtraInvalid  = errors.New("The extra of ledger block is invalid")
+	ErrLedgerBlockBodyInvalid   = errors.New("The body of ledger block does not match the header")
+	ErrLedgerBlockSealInvalid   = errors.New("The ledger block is not sealed by the local node")
+	ErrLedgerEntryKindUnknown   = errors.New("The kind of ledger entry is unknown")
+	ErrLedgerEntrySignerInvalid = errors.New("The msg of ledger entry is not signed by the recorded signer")
+	ErrLedgerBlockMissing       = errors.New("The ledger block is missing")
+	ErrLedgerBlockTaskInvalid   = errors.New("The ledger block does not record the task")
+)
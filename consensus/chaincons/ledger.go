@@ -0,0 +1,218 @@
+package chaincons
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"fmt"
+
+	"github.com/RosettaFlow/Carrier-Go/common"
+	"github.com/RosettaFlow/Carrier-Go/common/timeutils"
+	pb "github.com/RosettaFlow/Carrier-Go/lib/consensus/twopc"
+	libTypes "github.com/RosettaFlow/Carrier-Go/lib/types"
+	"github.com/RosettaFlow/Carrier-Go/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// The kinds of the entries appended on the ledger.
+const (
+	entryProposal    uint8 = iota + 1 // the proposal started by myself, the task is carried by the block body
+	entryPrepareMsg                   // the prepareMsg received from the task owner, the task is carried by the block body
+	entryPrepareVote                  // the prepareVote received from a task partner
+	entryConfirmMsg                   // the confirmMsg received from the task owner
+	entryConfirmVote                  // the confirmVote received from a task partner
+	entryCommitMsg                    // the commitMsg received from the task owner
+)
+
+// extraDigestLength is the length of the body digest at the head of the header extra,
+// the seal signature of the block follows it.
+const extraDigestLength = 32
+
+// ledgerEntry is a step of the consensus on a task, it is rlp encoded into the extra data of the block body.
+type ledgerEntry struct {
+	Kind       uint8
+	TaskId     string
+	ProposalId common.Hash
+	// the public key recovered from the signature of msg, empty on the proposal of myself
+	Signer []byte
+	// the protobuf encoding of msg, empty on the proposal of myself
+	Payload []byte
+}
+
+func (entry *ledgerEntry) String() string {
+	return fmt.Sprintf(`{"kind": %d, "taskId": %s, "proposalId": %s, "signer": %x}`,
+		entry.Kind, entry.TaskId, entry.ProposalId.String(), entry.Signer)
+}
+
+// newMsgEntry makes the ledger entry of a consensus msg received from the remote peer,
+// the returned task is the one carried by prepareMsg. The msgs which are not a step
+// of the agreement (taskResultMsg, taskCancelMsg, taskProgressMsg) are not recorded.
+func newMsgEntry(msg types.ConsensusMsg, taskId string) (*ledgerEntry, *types.Task, bool, error) {
+	var (
+		entry = &ledgerEntry{TaskId: taskId}
+		task  *types.Task
+		err   error
+	)
+	switch msg := msg.(type) {
+	case *types.PrepareMsgWrap:
+		entry.Kind, entry.ProposalId = entryPrepareMsg, common.BytesToHash(msg.ProposalId)
+		task = types.NewTask(&libTypes.TaskData{})
+		if err := task.DecodePb(msg.TaskInfo); nil != err {
+			return nil, nil, false, err
+		}
+		if "" == entry.TaskId {
+			entry.TaskId = task.TaskId()
+		}
+		entry.Payload, err = msg.PrepareMsg.Marshal()
+	case *types.PrepareVoteWrap:
+		entry.Kind, entry.ProposalId = entryPrepareVote, common.BytesToHash(msg.ProposalId)
+		entry.Payload, err = msg.PrepareVote.Marshal()
+	case *types.ConfirmMsgWrap:
+		entry.Kind, entry.ProposalId = entryConfirmMsg, common.BytesToHash(msg.ProposalId)
+		entry.Payload, err = msg.ConfirmMsg.Marshal()
+	case *types.ConfirmVoteWrap:
+		entry.Kind, entry.ProposalId = entryConfirmVote, common.BytesToHash(msg.ProposalId)
+		entry.Payload, err = msg.ConfirmVote.Marshal()
+	case *types.CommitMsgWrap:
+		entry.Kind, entry.ProposalId = entryCommitMsg, common.BytesToHash(msg.ProposalId)
+		entry.Payload, err = msg.CommitMsg.Marshal()
+	default:
+		return nil, nil, false, nil
+	}
+	if nil != err {
+		return nil, nil, false, err
+	}
+	if entry.Signer, err = crypto.Ecrecover(msg.SealHash().Bytes(), msg.Signature()); nil != err {
+		return nil, nil, false, err
+	}
+	return entry, task, true, nil
+}
+
+// decodeEntryMsg decodes the consensus msg recorded by the ledger entry.
+func decodeEntryMsg(entry *ledgerEntry) (types.ConsensusMsg, error) {
+	switch entry.Kind {
+	case entryPrepareMsg:
+		msg := new(pb.PrepareMsg)
+		if err := msg.Unmarshal(entry.Payload); nil != err {
+			return nil, err
+		}
+		return &types.PrepareMsgWrap{PrepareMsg: msg}, nil
+	case entryPrepareVote:
+		msg := new(pb.PrepareVote)
+		if err := msg.Unmarshal(entry.Payload); nil != err {
+			return nil, err
+		}
+		return &types.PrepareVoteWrap{PrepareVote: msg}, nil
+	case entryConfirmMsg:
+		msg := new(pb.ConfirmMsg)
+		if err := msg.Unmarshal(entry.Payload); nil != err {
+			return nil, err
+		}
+		return &types.ConfirmMsgWrap{ConfirmMsg: msg}, nil
+	case entryConfirmVote:
+		msg := new(pb.ConfirmVote)
+		if err := msg.Unmarshal(entry.Payload); nil != err {
+			return nil, err
+		}
+		return &types.ConfirmVoteWrap{ConfirmVote: msg}, nil
+	case entryCommitMsg:
+		msg := new(pb.CommitMsg)
+		if err := msg.Unmarshal(entry.Payload); nil != err {
+			return nil, err
+		}
+		return &types.CommitMsgWrap{CommitMsg: msg}, nil
+	default:
+		return nil, ErrLedgerEntryKindUnknown
+	}
+}
+
+// verifyEntry checks that the msg recorded by the entry is signed by the recorded signer.
+func verifyEntry(entry *ledgerEntry) error {
+	if entry.Kind == entryProposal {
+		return nil
+	}
+	msg, err := decodeEntryMsg(entry)
+	if nil != err {
+		return err
+	}
+	signer, err := crypto.Ecrecover(msg.SealHash().Bytes(), msg.Signature())
+	if nil != err {
+		return err
+	}
+	if !bytes.Equal(signer, entry.Signer) {
+		return ErrLedgerEntrySignerInvalid
+	}
+	return nil
+}
+
+// bodyDigest returns the hash of the body, which is committed by the header extra.
+func bodyDigest(body *libTypes.BodyData) (common.Hash, error) {
+	data, err := body.Marshal()
+	if nil != err {
+		return common.Hash{}, err
+	}
+	return common.BytesToHash(crypto.Keccak256(data)), nil
+}
+
+// sealBlock makes the block which records the entry (and the task) on top of the parent, the parent
+// is nil on an empty ledger. The header extra is the digest of the body followed by the seal signature.
+func sealBlock(parent *types.Block, entry *ledgerEntry, task *types.Task, priKey *ecdsa.PrivateKey) (*types.Block, error) {
+	extraData, err := rlp.EncodeToBytes(entry)
+	if nil != err {
+		return nil, err
+	}
+	var tasks []*types.Task
+	if nil != task {
+		tasks = []*types.Task{task}
+	}
+	header := &types.Header{Timestamp: uint64(timeutils.UnixMsec())}
+	if nil != parent {
+		header.ParentHash = parent.Hash().Bytes()
+		header.Version = parent.NumberU64() + 1
+	}
+	digest, err := bodyDigest(types.NewBlockWithHeader(header).WithBody(nil, nil, nil, tasks, extraData).Body())
+	if nil != err {
+		return nil, err
+	}
+	header.Extra = digest.Bytes()
+	sign, err := crypto.Sign(header.SealHash().Bytes(), priKey)
+	if nil != err {
+		return nil, err
+	}
+	header.Extra = append(header.Extra, sign...)
+	return types.NewBlockWithHeader(header).WithBody(nil, nil, nil, tasks, extraData), nil
+}
+
+// verifyBlock checks that the block extends the parent, commits its body and
+// is sealed by the sealer, and then returns the entry recorded by the block.
+func verifyBlock(block *types.Block, number uint64, parent common.Hash, sealer []byte) (*ledgerEntry, error) {
+	if block.NumberU64() != number {
+		return nil, ErrLedgerBlockNumberInvalid
+	}
+	if block.ParentHash() != parent {
+		return nil, ErrLedgerBlockParentInvalid
+	}
+	header := block.Header()
+	if len(header.Extra) != extraDigestLength+types.MsgSignLength {
+		return nil, ErrLedgerBlockExtraInvalid
+	}
+	digest, err := bodyDigest(block.Body())
+	if nil != err {
+		return nil, err
+	}
+	if !bytes.Equal(digest.Bytes(), header.Extra[:extraDigestLength]) {
+		return nil, ErrLedgerBlockBodyInvalid
+	}
+	signer, err := crypto.Ecrecover(header.SealHash().Bytes(), header.Signature())
+	if nil != err || !bytes.Equal(signer, sealer) {
+		return nil, ErrLedgerBlockSealInvalid
+	}
+	var entry ledgerEntry
+	if err := rlp.DecodeBytes(block.ExtraData(), &entry); nil != err {
+		return nil, err
+	}
+	if err := verifyEntry(&entry); nil != err {
+		return nil, err
+	}
+	return &entry, nil
+}
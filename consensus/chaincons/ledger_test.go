@@ -0,0 +1,193 @@
+package chaincons
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"testing"
+
+	"github.com/RosettaFlow/Carrier-Go/common"
+	"github.com/RosettaFlow/Carrier-Go/core"
+	"github.com/RosettaFlow/Carrier-Go/core/rawdb"
+	"github.com/RosettaFlow/Carrier-Go/db"
+	pb "github.com/RosettaFlow/Carrier-Go/lib/consensus/twopc"
+	libTypes "github.com/RosettaFlow/Carrier-Go/lib/types"
+	"github.com/RosettaFlow/Carrier-Go/p2p"
+	"github.com/RosettaFlow/Carrier-Go/params"
+	"github.com/RosettaFlow/Carrier-Go/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	lru "github.com/hashicorp/golang-lru"
+	"gotest.tools/assert"
+)
+
+const testTaskId = "taskId-01"
+
+func newTestKey(t *testing.T) *ecdsa.PrivateKey {
+	key, err := crypto.GenerateKey()
+	assert.NilError(t, err)
+	return key
+}
+
+func testNodeId(key *ecdsa.PrivateKey) string {
+	return p2p.PubkeyID(&key.PublicKey).String()
+}
+
+// newTestTask makes the task started by the owner, the partners supply the metadata and receive the result.
+func newTestTask(owner *ecdsa.PrivateKey, partners ...*ecdsa.PrivateKey) *types.Task {
+	data := &libTypes.TaskData{TaskId: testTaskId, NodeId: testNodeId(owner)}
+	for i, partner := range partners {
+		org := &libTypes.OrganizationData{NodeId: testNodeId(partner)}
+		if i%2 == 0 {
+			data.MetadataSupplier = append(data.MetadataSupplier, &libTypes.TaskMetadataSupplierData{Organization: org})
+		} else {
+			data.Receivers = append(data.Receivers, &libTypes.TaskResultReceiverData{Receiver: org})
+		}
+	}
+	// the task owner is also a receiver of the result
+	data.Receivers = append(data.Receivers, &libTypes.TaskResultReceiverData{Receiver: &libTypes.OrganizationData{NodeId: testNodeId(owner)}})
+	return types.NewTask(data)
+}
+
+// newTestMsgEntry signs the msg by the key, and then makes the ledger entry of it.
+func newTestMsgEntry(t *testing.T, msg types.ConsensusMsg, key *ecdsa.PrivateKey) (*ledgerEntry, *types.Task) {
+	sign, err := crypto.Sign(msg.SealHash().Bytes(), key)
+	assert.NilError(t, err)
+	switch msg := msg.(type) {
+	case *types.PrepareMsgWrap:
+		msg.Sign = sign
+	case *types.ConfirmVoteWrap:
+		msg.Sign = sign
+	case *types.CommitMsgWrap:
+		msg.Sign = sign
+	default:
+		t.Fatalf("unexpected msg: %T", msg)
+	}
+	entry, task, ok, err := newMsgEntry(msg, testTaskId)
+	assert.NilError(t, err)
+	assert.Assert(t, ok)
+	return entry, task
+}
+
+func newTestConfirmVote(proposalId common.Hash, option types.VoteOption) *types.ConfirmVoteWrap {
+	return &types.ConfirmVoteWrap{ConfirmVote: &pb.ConfirmVote{
+		ProposalId: proposalId.Bytes(),
+		VoteOption: option.Bytes(),
+		CreateAt:   1,
+	}}
+}
+
+func TestSealBlock(t *testing.T) {
+	var (
+		sealer     = newTestKey(t)
+		partner    = newTestKey(t)
+		task       = newTestTask(sealer, partner)
+		proposalId = common.BytesToHash([]byte("proposal"))
+	)
+	proposal := &ledgerEntry{Kind: entryProposal, TaskId: testTaskId, ProposalId: proposalId}
+	genesis, err := sealBlock(nil, proposal, task, sealer)
+	assert.NilError(t, err)
+	assert.Equal(t, genesis.NumberU64(), uint64(0))
+	assert.Equal(t, genesis.ParentHash(), common.Hash{})
+
+	entry, err := verifyBlock(genesis, 0, common.Hash{}, crypto.FromECDSAPub(&sealer.PublicKey))
+	assert.NilError(t, err)
+	assert.Equal(t, entry.String(), proposal.String())
+	assert.Equal(t, len(genesis.TaskDatas()), 1)
+	assert.Equal(t, genesis.TaskDatas()[0].TaskId(), testTaskId)
+
+	vote, _ := newTestMsgEntry(t, newTestConfirmVote(proposalId, types.Yes), partner)
+	block, err := sealBlock(genesis, vote, nil, sealer)
+	assert.NilError(t, err)
+	assert.Equal(t, block.NumberU64(), uint64(1))
+
+	entry, err = verifyBlock(block, 1, genesis.Hash(), crypto.FromECDSAPub(&sealer.PublicKey))
+	assert.NilError(t, err)
+	assert.Equal(t, entry.Kind, entryConfirmVote)
+	assert.Equal(t, entry.ProposalId, proposalId)
+	assert.DeepEqual(t, entry.Signer, crypto.FromECDSAPub(&partner.PublicKey))
+}
+
+func TestVerifyBlockTampered(t *testing.T) {
+	var (
+		sealer     = newTestKey(t)
+		partner    = newTestKey(t)
+		proposalId = common.BytesToHash([]byte("proposal"))
+		sealerPub  = crypto.FromECDSAPub(&sealer.PublicKey)
+	)
+	genesis, err := sealBlock(nil, &ledgerEntry{Kind: entryProposal, TaskId: testTaskId, ProposalId: proposalId},
+		newTestTask(sealer, partner), sealer)
+	assert.NilError(t, err)
+	vote, _ := newTestMsgEntry(t, newTestConfirmVote(proposalId, types.Yes), partner)
+	block, err := sealBlock(genesis, vote, nil, sealer)
+	assert.NilError(t, err)
+
+	// the body is replaced by the one of another vote
+	reject, _ := newTestMsgEntry(t, newTestConfirmVote(proposalId, types.No), partner)
+	forged, err := sealBlock(genesis, reject, nil, sealer)
+	assert.NilError(t, err)
+	tampered := types.NewBlockWithHeader(block.Header()).WithBody(nil, nil, nil, nil, forged.ExtraData())
+	_, err = verifyBlock(tampered, 1, genesis.Hash(), sealerPub)
+	assert.Equal(t, err, ErrLedgerBlockBodyInvalid)
+
+	// the task carried by the body is removed
+	tampered = types.NewBlockWithHeader(genesis.Header()).WithBody(nil, nil, nil, nil, genesis.ExtraData())
+	_, err = verifyBlock(tampered, 0, common.Hash{}, sealerPub)
+	assert.Equal(t, err, ErrLedgerBlockBodyInvalid)
+
+	// the header is changed after sealing
+	header := block.Header()
+	header.Timestamp++
+	tampered = types.NewBlockWithHeader(header).WithBody(nil, nil, nil, nil, block.ExtraData())
+	_, err = verifyBlock(tampered, 1, tampered.ParentHash(), sealerPub)
+	assert.Equal(t, err, ErrLedgerBlockSealInvalid)
+
+	// the block is sealed by another node
+	_, err = verifyBlock(block, 1, genesis.Hash(), crypto.FromECDSAPub(&partner.PublicKey))
+	assert.Equal(t, err, ErrLedgerBlockSealInvalid)
+
+	// the block does not extend the parent
+	_, err = verifyBlock(block, 1, common.BytesToHash([]byte("parent")), sealerPub)
+	assert.Equal(t, err, ErrLedgerBlockParentInvalid)
+	_, err = verifyBlock(block, 2, genesis.Hash(), sealerPub)
+	assert.Equal(t, err, ErrLedgerBlockNumberInvalid)
+
+	// the msg is claimed to be signed by another node
+	vote.Signer = sealerPub
+	block, err = sealBlock(genesis, vote, nil, sealer)
+	assert.NilError(t, err)
+	_, err = verifyBlock(block, 1, genesis.Hash(), sealerPub)
+	assert.Equal(t, err, ErrLedgerEntrySignerInvalid)
+}
+
+func TestVerifyTaskAgreement(t *testing.T) {
+	database := db.NewMemoryDatabase()
+	chain, err := core.NewDataChain(context.Background(), database, params.CarrierChainConfig())
+	assert.NilError(t, err)
+	recordedMsgCache, _ := lru.New(defaultRecordedMsgCacheSize)
+	var (
+		owner      = newTestKey(t)
+		partner    = newTestKey(t)
+		proposalId = common.BytesToHash([]byte("proposal"))
+		c          = &Chaincons{chain: chain, db: database, priKey: owner, recordedMsgCache: recordedMsgCache}
+	)
+
+	other := newTestTask(owner, partner)
+	other.TaskData().TaskId = "taskId-02"
+	assert.NilError(t, c.appendEntry(&ledgerEntry{Kind: entryProposal, TaskId: "taskId-02", ProposalId: common.BytesToHash([]byte("other"))}, other))
+	assert.NilError(t, c.appendProposal(proposalId, newTestTask(owner, partner)))
+	vote, _ := newTestMsgEntry(t, newTestConfirmVote(proposalId, types.Yes), partner)
+	assert.NilError(t, c.appendEntry(vote, nil))
+	assert.DeepEqual(t, rawdb.ReadLedgerTaskIndex(database, testTaskId), []uint64{1, 2})
+
+	agreement, err := c.VerifyTaskAgreement(testTaskId)
+	assert.NilError(t, err)
+	assert.Assert(t, agreement.Agreed)
+	assert.Equal(t, agreement.ProposalId, proposalId)
+	assert.Equal(t, len(agreement.Blocks), 2)
+
+	// the blocks appended before the index is written are indexed on start
+	database.Delete([]byte("LedgerIndexHead"))
+	database.Delete([]byte("LedgerTaskIndex" + testTaskId))
+	assert.NilError(t, c.reindexLedger())
+	assert.DeepEqual(t, rawdb.ReadLedgerTaskIndex(database, testTaskId), []uint64{1, 2})
+	assert.Equal(t, *rawdb.ReadLedgerIndexHead(database), uint64(2))
+}
@@ -0,0 +1,6 @@
+package chaincons
+
+import "github.com/sirupsen/logrus"
+
+// Global log object, used by the current package.
+var log = logrus.WithField("prefix", "Chaincons")
@@ -15,9 +15,9 @@ type Engine interface {
 	Start() error
 	Close() error
 	OnPrepare(task *types.Task) error
-	OnHandle(task *types.Task, selfPeerResource *types.PrepareVoteResource, result chan<- *types.ConsensuResult) error
 	ValidateConsensusMsg(pid peer.ID, msg types.ConsensusMsg) error
 	OnConsensusMsg(pid peer.ID, msg types.ConsensusMsg) error
+	OnCancelTask(taskId string) error
 	OnError() error
 }
 
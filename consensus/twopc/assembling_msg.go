@@ -4,18 +4,104 @@ import (
 	"bytes"
 	"fmt"
 	"github.com/RosettaFlow/Carrier-Go/common"
+	ctypes "github.com/RosettaFlow/Carrier-Go/consensus/twopc/types"
 	pb "github.com/RosettaFlow/Carrier-Go/lib/consensus/twopc"
 	libtypes "github.com/RosettaFlow/Carrier-Go/lib/types"
 	"github.com/RosettaFlow/Carrier-Go/types"
+	"github.com/ethereum/go-ethereum/crypto"
 )
 
-func makePrepareMsgWithoutTaskRole(proposalId common.Hash, task *types.Task, startTime uint64) (*pb.PrepareMsg, error) {
+// signMsg signs the sealHash of consensus msg with the private key of current node.
+func (t *TwoPC) signMsg(msg types.ConsensusMsg) ([]byte, error) {
+	if nil == t.config.Option.NodePriKey {
+		return nil, ctypes.ErrMsgSignInvalid
+	}
+	return crypto.Sign(msg.SealHash().Bytes(), t.config.Option.NodePriKey)
+}
+
+func (t *TwoPC) signPrepareMsg(msg *pb.PrepareMsg) error {
+	sign, err := t.signMsg(&types.PrepareMsgWrap{PrepareMsg: msg})
+	if nil != err {
+		return err
+	}
+	msg.Sign = sign
+	return nil
+}
+
+func (t *TwoPC) signPrepareVote(vote *pb.PrepareVote) error {
+	sign, err := t.signMsg(&types.PrepareVoteWrap{PrepareVote: vote})
+	if nil != err {
+		return err
+	}
+	vote.Sign = sign
+	return nil
+}
+
+func (t *TwoPC) signConfirmMsg(msg *pb.ConfirmMsg) error {
+	sign, err := t.signMsg(&types.ConfirmMsgWrap{ConfirmMsg: msg})
+	if nil != err {
+		return err
+	}
+	msg.Sign = sign
+	return nil
+}
+
+func (t *TwoPC) signConfirmVote(vote *pb.ConfirmVote) error {
+	sign, err := t.signMsg(&types.ConfirmVoteWrap{ConfirmVote: vote})
+	if nil != err {
+		return err
+	}
+	vote.Sign = sign
+	return nil
+}
+
+func (t *TwoPC) signCommitMsg(msg *pb.CommitMsg) error {
+	sign, err := t.signMsg(&types.CommitMsgWrap{CommitMsg: msg})
+	if nil != err {
+		return err
+	}
+	msg.Sign = sign
+	return nil
+}
+
+func (t *TwoPC) signTaskResultMsg(msg *pb.TaskResultMsg) error {
+	sign, err := t.signMsg(&types.TaskResultMsgWrap{TaskResultMsg: msg})
+	if nil != err {
+		return err
+	}
+	msg.Sign = sign
+	return nil
+}
+
+func (t *TwoPC) signTaskCancelMsg(msg *pb.TaskCancelMsg) error {
+	sign, err := t.signMsg(&types.TaskCancelMsgWrap{TaskCancelMsg: msg})
+	if nil != err {
+		return err
+	}
+	msg.Sign = sign
+	return nil
+}
+
+func (t *TwoPC) signTaskProgressMsg(msg *pb.TaskProgressMsg) error {
+	sign, err := t.signMsg(&types.TaskProgressMsgWrap{TaskProgressMsg: msg})
+	if nil != err {
+		return err
+	}
+	msg.Sign = sign
+	return nil
+}
+
+func makePrepareMsgWithoutTaskRole(proposalId common.Hash, task *types.Task, election *types.ElectionProof, periods *ctypes.ProposalPeriods, startTime uint64) (*pb.PrepareMsg, error) {
 	// region receivers come from task.Receivers
 	bys := new(bytes.Buffer)
 	err := task.EncodePb(bys)
 	if err != nil {
 		return nil, err
 	}
+	var seed, proof, powersSign []byte
+	if nil != election {
+		seed, proof, powersSign = election.Seed, election.Proof, election.PowersSign
+	}
 	return &pb.PrepareMsg{
 		ProposalId: proposalId.Bytes(),
 		Owner: &pb.TaskOrganizationIdentityInfo{
@@ -24,8 +110,16 @@ func makePrepareMsgWithoutTaskRole(proposalId common.Hash, task *types.Task, sta
 			IdentityId: []byte(task.TaskData().Identity),
 			PartyId:    []byte(task.TaskData().PartyId),
 		},
-		TaskInfo: bys.Bytes(),
-		CreateAt: startTime,
+		TaskInfo:      bys.Bytes(),
+		CreateAt:      startTime,
+		ElectionSeed:       seed,
+		ElectionProof:      proof,
+		ElectionPowersSign: powersSign,
+
+		PrepareVotingTimeout: uint64(periods.PrepareVotingTimeout.Milliseconds()),
+		ConfirmVotingTimeout: uint64(periods.ConfirmVotingTimeout.Milliseconds()),
+		CommitEndingTimeout:  uint64(periods.CommitEndingTimeout.Milliseconds()),
+		ProposalDeadline:     uint64(periods.Deadline.Milliseconds()),
 	}, nil
 }
 
@@ -75,6 +169,23 @@ func makeCommitMsg(proposalId common.Hash, task *types.Task, startTime uint64) *
 	return msg
 }
 
+func makeTaskCancelMsg(proposalId common.Hash, task *types.Task, startTime uint64) *pb.TaskCancelMsg {
+	msg := &pb.TaskCancelMsg{
+		ProposalId: proposalId.Bytes(),
+		TaskRole:   nil,
+		TaskId:     []byte(task.TaskId()),
+		Owner: &pb.TaskOrganizationIdentityInfo{
+			Name:       []byte(task.TaskData().NodeName),
+			NodeId:     []byte(task.TaskData().NodeId),
+			IdentityId: []byte(task.TaskData().Identity),
+			PartyId:    []byte(task.TaskData().PartyId),
+		},
+		CreateAt: startTime,
+		Sign:     nil,
+	}
+	return msg
+}
+
 func makeTaskResultMsg(startTime uint64) *pb.TaskResultMsg {
 
 	// 组装  TaskResultMsg
@@ -92,6 +203,11 @@ func fetchPrepareMsg(prepareMsg *types.PrepareMsgWrap) (*types.PrepareMsg, error
 	if err != nil {
 		return nil, err
 	}
+	election := &types.ElectionProof{
+		Seed:       prepareMsg.ElectionSeed,
+		Proof:      prepareMsg.ElectionProof,
+		PowersSign: prepareMsg.ElectionPowersSign,
+	}
 	return &types.PrepareMsg{
 			ProposalId:  common.BytesToHash(prepareMsg.ProposalId),
 			TaskRole:    types.TaskRoleFromBytes(prepareMsg.TaskRole),
@@ -105,9 +221,16 @@ func fetchPrepareMsg(prepareMsg *types.PrepareMsgWrap) (*types.PrepareMsg, error
 			TaskInfo: task,
 			CreateAt: prepareMsg.CreateAt,
 			Sign:     prepareMsg.Sign,
+			Election: election,
 		},
 		nil
 }
+// fetchProposalPeriods returns the periods of proposal decided by the owner of prepareMsg.
+func fetchProposalPeriods(prepareMsg *types.PrepareMsgWrap) *ctypes.ProposalPeriods {
+	return ctypes.ProposalPeriodsFromMsec(prepareMsg.PrepareVotingTimeout, prepareMsg.ConfirmVotingTimeout,
+		prepareMsg.CommitEndingTimeout, prepareMsg.ProposalDeadline)
+}
+
 func fetchProposalFromPrepareMsg(prepareMsg *types.PrepareMsg) *types.ProposalTask {
 	return &types.ProposalTask{
 		ProposalId: prepareMsg.ProposalId,
@@ -226,6 +349,7 @@ func fetchTaskResultMsg(commitMsg *types.TaskResultMsgWrap) (*types.TaskResultMs
 		TaskEventList: taskEventList,
 		CreateAt:      commitMsg.CreateAt,
 		Sign:          commitMsg.Sign,
+		ResourceUsageList: types.FetchTaskResourceUsageArr(commitMsg.ResourceUsageList),
 	}
 	return msg, nil
 }
@@ -2,15 +2,65 @@ package twopc
 
 import (
 	"crypto/ecdsa"
+	ctypes "github.com/RosettaFlow/Carrier-Go/consensus/twopc/types"
 	"github.com/RosettaFlow/Carrier-Go/p2p"
+	"time"
 )
 
+// DefaultPeriodConfig contains the default periods of proposal and the bounds of them.
+var DefaultPeriodConfig = PeriodConfig{
+	PrepareVotingTimeout: ctypes.DefaultPrepareMsgVotingTimeout,
+	ConfirmVotingTimeout: ctypes.DefaultConfirmMsgVotingTimeout,
+	CommitEndingTimeout:  ctypes.DefaultCommitMsgEndingTimeout,
+	ProposalDeadline:     ctypes.DefaultProposalDeadlineDuration,
+	MinPeriodTimeout:     500 * time.Millisecond,
+	MaxPeriodTimeout:     30 * time.Second,
+	MaxProposalDeadline:  10 * time.Minute,
+}
+
 type OptionConfig struct {
 	NodePriKey *ecdsa.PrivateKey `json:"-"`
 	NodeID     p2p.NodeID        `json:"nodeID"`
 }
 
+// PeriodConfig holds the periods of the proposals started by myself,
+// and the bounds of the periods accepted from the proposals of other owners.
+type PeriodConfig struct {
+	PrepareVotingTimeout time.Duration `json:"prepareVotingTimeout"`
+	ConfirmVotingTimeout time.Duration `json:"confirmVotingTimeout"`
+	CommitEndingTimeout  time.Duration `json:"commitEndingTimeout"`
+	ProposalDeadline     time.Duration `json:"proposalDeadline"`
+
+	MinPeriodTimeout    time.Duration `json:"minPeriodTimeout"`
+	MaxPeriodTimeout    time.Duration `json:"maxPeriodTimeout"`
+	MaxProposalDeadline time.Duration `json:"maxProposalDeadline"`
+}
+
+func (c *PeriodConfig) Periods() *ctypes.ProposalPeriods {
+	return &ctypes.ProposalPeriods{
+		PrepareVotingTimeout: c.PrepareVotingTimeout,
+		ConfirmVotingTimeout: c.ConfirmVotingTimeout,
+		CommitEndingTimeout:  c.CommitEndingTimeout,
+		Deadline:             c.ProposalDeadline,
+	}
+}
+
+func (c *PeriodConfig) Bounds() *ctypes.ProposalPeriodBounds {
+	return &ctypes.ProposalPeriodBounds{
+		MinPeriodTimeout: c.MinPeriodTimeout,
+		MaxPeriodTimeout: c.MaxPeriodTimeout,
+		MaxDeadline:      c.MaxProposalDeadline,
+	}
+}
+
+// Validate checks that the own periods are accepted by the own bounds.
+func (c *PeriodConfig) Validate() error {
+	return c.Periods().Validate(c.Bounds())
+}
+
 type Config struct {
-	Option           *OptionConfig `json:"option"`
-	PeerMsgQueueSize uint64        `json:"peerMsgQueueSize"`
+	Option *OptionConfig `json:"option"`
+	// The bound of the outbound msg queue of each remote peer.
+	PeerMsgQueueSize uint64       `json:"peerMsgQueueSize"`
+	Period           PeriodConfig `json:"period"`
 }
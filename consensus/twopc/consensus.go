@@ -3,6 +3,7 @@ package twopc
 import (
 	"context"
 	"fmt"
+	"github.com/RosettaFlow/Carrier-Go/common"
 	"github.com/RosettaFlow/Carrier-Go/common/rlputil"
 	"github.com/RosettaFlow/Carrier-Go/common/timeutils"
 	ctypes "github.com/RosettaFlow/Carrier-Go/consensus/twopc/types"
@@ -38,8 +39,16 @@ type TwoPC struct {
 	replayTaskCh chan<- *types.ReplayScheduleTaskWrap
 	// send has consensused remote tasks to taskManager
 	doneScheduleTaskCh chan<- *types.DoneScheduleTaskChWrap
+	// send the executing task which was cancelled by task owner to taskManager
+	cancelTaskCh chan<- *types.CancelTaskWrap
 	asyncCallCh        chan func()
 	quit               chan struct{}
+	// the outbound msg queues of remote peers
+	peerSenders *peerSenderSet
+	// the consensus msgs which have been accepted or are being handled, used to drop the retries of them
+	recvMsgs *consensusMsgDedup
+	// called with the proposal started by myself before its prepareMsg is sent, the proposal is aborted if it fails
+	proposalHook func(proposalId common.Hash, task *types.Task) error
 	// The task being processed by myself  (taskId -> task)
 	sendTaskCache map[string]*types.Task
 	// The task processing  that received someone else (taskId -> task)
@@ -62,19 +71,24 @@ func New(
 	schedTaskCh chan *types.ConsensusTaskWrap,
 	replayTaskCh chan *types.ReplayScheduleTaskWrap,
 	doneScheduleTaskCh chan *types.DoneScheduleTaskChWrap,
+	cancelTaskCh chan *types.CancelTaskWrap,
 ) *TwoPC {
+	quit := make(chan struct{})
 	return &TwoPC{
 		config:             conf,
 		p2p:                p2p,
 		peerSet:            ctypes.NewPeerSet(10), // TODO 暂时写死的
-		state:              newState(),
+		state:              newState(dataCenter),
 		dataCenter:         dataCenter,
 		resourceMng:        resourceMng,
 		schedTaskCh:        schedTaskCh,
 		replayTaskCh:       replayTaskCh,
 		doneScheduleTaskCh: doneScheduleTaskCh,
+		cancelTaskCh:       cancelTaskCh,
 		asyncCallCh:        make(chan func(), conf.PeerMsgQueueSize),
-		quit:               make(chan struct{}),
+		quit:               quit,
+		peerSenders:        newPeerSenderSet(conf.PeerMsgQueueSize),
+		recvMsgs:           newConsensusMsgDedup(defaultRecvMsgCacheSize),
 		sendTaskCache:          make(map[string]*types.Task),
 		recvTaskCache:          make(map[string]*types.Task),
 
@@ -86,11 +100,17 @@ func New(
 }
 
 func (t *TwoPC) Start() error {
+	// recover the proposals that being processed before restarted,
+	// and then `refreshProposalState()` will handle them on loop.
+	if err := t.recoverProposalStates(); nil != err {
+		return fmt.Errorf("recover proposalStates failed, %s", err)
+	}
 	go t.loop()
 	log.Info("Started 2pc consensus engine ...")
 	return nil
 }
 func (t *TwoPC) Close() error {
+	t.peerSenders.close()
 	close(t.quit)
 	return nil
 }
@@ -116,8 +136,16 @@ func (t *TwoPC) loop() {
 					})
 					return
 				}
-				if err := t.OnHandle(taskWrap.Task, taskWrap.OwnerDataResource, taskWrap.ResultCh); nil != err {
-					log.Errorf("Failed to call `OnHandle()` on 2pc consensus engine, taskId: {%s}, err: {%s}", taskWrap.Task.TaskId(), err)
+				if err := t.onHandle(taskWrap.Task, taskWrap.OwnerDataResource, taskWrap.Election, taskWrap.ResultCh); nil != err {
+					log.Errorf("Failed to call `onHandle()` on 2pc consensus engine, taskId: {%s}, err: {%s}", taskWrap.Task.TaskId(), err)
+					taskWrap.SendResult(&types.ConsensuResult{
+						TaskConsResult: &types.TaskConsResult{
+							TaskId: taskWrap.Task.TaskId(),
+							Status: types.TaskConsensusInterrupt,
+							Done:   false,
+							Err:    fmt.Errorf("failed to onHandle 2pc, %s", err),
+						},
+					})
 				}
 			}()
 		case fn := <-t.asyncCallCh:
@@ -146,27 +174,49 @@ func (t *TwoPC) ValidateConsensusMsg(pid peer.ID, msg types.ConsensusMsg) error
 	if nil == msg {
 		return fmt.Errorf("Failed to validate 2pc consensus msg, the msg is nil")
 	}
-	//switch msg := msg.(type) {
-	//case *types.PrepareMsgWrap:
-	//	return t.validatePrepareMsg(pid, msg)
-	//case *types.PrepareVoteWrap:
-	//	return t.validatePrepareVote(pid, msg)
-	//case *types.ConfirmMsgWrap:
-	//	return t.validateConfirmMsg(pid, msg)
-	//case *types.ConfirmVoteWrap:
-	//	return t.validateConfirmVote(pid, msg)
-	//case *types.CommitMsgWrap:
-	//	return t.validateCommitMsg(pid, msg)
-	//case *types.TaskResultMsgWrap:
-	//	return t.validateTaskResultMsg(pid, msg)
-	//default:
-	//	return fmt.Errorf("TaskRoleUnknown the 2pc msg type")
-	//}
-	return nil
+	// the retry of an accepted msg is answered as accepted again.
+	if t.isDuplicateConsensusMsg(msg) {
+		return nil
+	}
+	switch msg := msg.(type) {
+	case *types.PrepareMsgWrap:
+		return t.validatePrepareMsg(pid, msg)
+	case *types.PrepareVoteWrap:
+		return t.validatePrepareVote(pid, msg)
+	case *types.ConfirmMsgWrap:
+		return t.validateConfirmMsg(pid, msg)
+	case *types.ConfirmVoteWrap:
+		return t.validateConfirmVote(pid, msg)
+	case *types.CommitMsgWrap:
+		return t.validateCommitMsg(pid, msg)
+	case *types.TaskResultMsgWrap:
+		return t.validateTaskResultMsg(pid, msg)
+	case *types.TaskCancelMsgWrap:
+		return t.validateTaskCancelMsg(pid, msg)
+	case *types.TaskProgressMsgWrap:
+		return t.validateTaskProgressMsg(pid, msg)
+	default:
+		return fmt.Errorf("TaskRoleUnknown the 2pc msg type")
+	}
 }
 
 func (t *TwoPC) OnConsensusMsg(pid peer.ID, msg types.ConsensusMsg) error {
 
+	key, ok := fetchConsensusMsgKey(msg)
+	if !ok {
+		return t.onConsensusMsg(pid, msg)
+	}
+	duplicate, err := t.recvMsgs.handle(key, func() error {
+		return t.onConsensusMsg(pid, msg)
+	})
+	if duplicate {
+		log.Debugf("Skip the duplicate 2pc consensus msg, remote pid: {%s}, msgHash: {%s}", pid, msg.Hash().String())
+	}
+	return err
+}
+
+func (t *TwoPC) onConsensusMsg(pid peer.ID, msg types.ConsensusMsg) error {
+
 	switch msg := msg.(type) {
 	case *types.PrepareMsgWrap:
 		return t.onPrepareMsg(pid, msg)
@@ -180,6 +230,10 @@ func (t *TwoPC) OnConsensusMsg(pid peer.ID, msg types.ConsensusMsg) error {
 		return t.onCommitMsg(pid, msg)
 	case *types.TaskResultMsgWrap:
 		return t.onTaskResultMsg(pid, msg)
+	case *types.TaskCancelMsgWrap:
+		return t.onTaskCancelMsg(pid, msg)
+	case *types.TaskProgressMsgWrap:
+		return t.onTaskProgressMsg(pid, msg)
 	default:
 		return fmt.Errorf("TaskRoleUnknown the 2pc msg type")
 
@@ -199,11 +253,43 @@ func (t *TwoPC) OnError() error {
 	return fmt.Errorf("%s", strings.Join(errStrs, "\n"))
 }
 
+// ProposalTaskId returns the taskId of the running proposal, empty if the proposal is not running.
+func (t *TwoPC) ProposalTaskId(proposalId common.Hash) string {
+	return t.state.GetProposalState(proposalId).TaskId
+}
+
+// SetProposalHook sets the hook which is called with the proposal started by myself before its prepareMsg is sent.
+func (t *TwoPC) SetProposalHook(hook func(proposalId common.Hash, task *types.Task) error) {
+	t.proposalHook = hook
+}
+
+// InterruptProposal aborts the running proposal as an invalid one, the proposal which has finished is skipped.
+func (t *TwoPC) InterruptProposal(proposalId common.Hash) {
+	fn := func() {
+		proposalState := t.state.GetProposalState(proposalId)
+		if proposalState == ctypes.EmptyProposalState {
+			return
+		}
+		log.Warnf("Interrupt the proposal, proposalId: {%s}, taskId: {%s}", proposalId.String(), proposalState.TaskId)
+		t.handleInvalidProposal(proposalState)
+	}
+	select {
+	case t.asyncCallCh <- fn:
+	case <-t.quit:
+	}
+}
+
 func (t *TwoPC) OnPrepare(task *types.Task) error {
 
 	return nil
 }
-func (t *TwoPC) OnHandle(task *types.Task, selfPeerResource *types.PrepareVoteResource, result chan<- *types.ConsensuResult) error {
+// onHandle starts the consensus of task, and the election proof of the powers of task is sent to the partners by prepareMsg.
+// The partners reject the prepareMsg without the election proof, so the tasks can only be sent by `Scheduler` with the proof.
+func (t *TwoPC) onHandle(task *types.Task, selfPeerResource *types.PrepareVoteResource, election *types.ElectionProof, result chan<- *types.ConsensuResult) error {
+
+	if nil == election || 0 == len(election.Proof) {
+		return ctypes.ErrProposalElectionEmpty
+	}
 
 	if t.isConsensusTask(task.TaskId()) {
 		return ctypes.ErrPrososalTaskIsProcessed
@@ -230,15 +316,23 @@ func (t *TwoPC) OnHandle(task *types.Task, selfPeerResource *types.PrepareVoteRe
 			NodeId:     task.TaskData().NodeId,
 			IdentityId: task.TaskData().NodeName,
 		},
-		now)
+		now,
+		t.config.Period.Periods())
 
 	log.Debugf("Generate proposal, proposalId: {%s}, taskId: {%s}", proposalHash, task.TaskId())
 
+	if nil != t.proposalHook {
+		if err := t.proposalHook(proposalHash, task); nil != err {
+			return fmt.Errorf("failed to call the proposal hook, %s", err)
+		}
+	}
+
 	// add proposal
 	t.addProposalState(proposalState)
 	// add task
 	// task 不论是 发起方 还是 参与方, 都应该是  一抵达, 就保存本地..
 	t.addSendTask(task)
+	t.state.StoreProposalTask(proposalHash, task)
 	// add ResultCh
 	t.addTaskResultCh(task.TaskId(), result)
 	// set myself peerInfo cache
@@ -247,7 +341,7 @@ func (t *TwoPC) OnHandle(task *types.Task, selfPeerResource *types.PrepareVoteRe
 	// Start handle task ...
 	go func() {
 
-		if err := t.sendPrepareMsg(proposalHash, task, now); nil != err {
+		if err := t.sendPrepareMsg(proposalHash, task, election, now); nil != err {
 			log.Errorf("Failed to call `SendTwoPcPrepareMsg`, consensus epoch finished, proposalId: {%s}, taskId: {%s}, err: \n%s", proposalHash, task.TaskId(), err)
 			// Send consensus result to Scheduler
 			t.collectTaskResultWillSendToSched(&types.ConsensuResult{
@@ -307,7 +401,8 @@ func (t *TwoPC) onPrepareMsg(pid peer.ID, prepareMsg *types.PrepareMsgWrap) erro
 			NodeId:     self.NodeId,
 			IdentityId: self.IdentityId,
 		},
-		proposal.CreateAt)
+		proposal.CreateAt,
+		fetchProposalPeriods(prepareMsg))
 
 	t.addProposalState(proposalState)
 
@@ -315,6 +410,7 @@ func (t *TwoPC) onPrepareMsg(pid peer.ID, prepareMsg *types.PrepareMsgWrap) erro
 	// 将接受到的 task 保存本地
 	// task 不论是 发起方 还是 参与方, 都应该是  一抵达, 就保存本地.. todo 让 超时检查 proposalState 机制去清除 task 和各类本地缓存
 	t.addRecvTask(task)
+	t.state.StoreProposalTask(proposal.ProposalId, task)
 
 	if err := t.validateRecvTask(task); nil != err {
 		// clean some data
@@ -326,7 +422,8 @@ func (t *TwoPC) onPrepareMsg(pid peer.ID, prepareMsg *types.PrepareMsgWrap) erro
 	replaySchedTask := types.NewReplayScheduleTaskWrap(
 		types.TaskRoleFromBytes(prepareMsg.TaskRole),
 		msg.TaskPartyId,
-		task)
+		task,
+		msg.Election)
 
 	// replay schedule task on myself ...
 	t.sendReplaySchedTaskToScheduler(replaySchedTask)
@@ -348,7 +445,6 @@ func (t *TwoPC) onPrepareMsg(pid peer.ID, prepareMsg *types.PrepareMsgWrap) erro
 			PartyId:    msg.TaskPartyId,
 		},
 		CreateAt: uint64(timeutils.UnixMsec()),
-		//Sign:
 	}
 
 	if result.Status == types.TaskSchedFailed {
@@ -366,11 +462,24 @@ func (t *TwoPC) onPrepareMsg(pid peer.ID, prepareMsg *types.PrepareMsgWrap) erro
 		log.Infof("Succeed to replay schedule task, will vote `YES`, taskId: {%s}", result.TaskId)
 	}
 
+	pbVote := types.ConvertPrepareVote(vote)
+	if err := t.signPrepareVote(pbVote); nil != err {
+		log.Errorf("Failed to sign prepareVote, proposalId: {%s}, taskId: {%s}, err: {%s}", proposal.ProposalId.String(), task.TaskId(), err)
+		t.resourceMng.ReleaseLocalResourceWithTask("on onPrepareMsg", task.TaskId(), resource.SetAllReleaseResourceOption())
+		// clean some data
+		t.delProposalStateAndTask(proposal.ProposalId)
+		return err
+	}
+	vote.Sign = pbVote.Sign
+
 	// store self vote state And Send vote to Other peer
 	t.state.StorePrepareVoteState(vote)
 	go func() {
 
-		if err := handler.SendTwoPcPrepareVote(context.TODO(), t.p2p, pid, types.ConvertPrepareVote(vote)); nil != err {
+		err := t.sendToPeer(pid, proposal.ProposalId, "prepareVote", periodDeadline(proposal.CreateAt, time.Duration(proposalState.PrepareTimeout)*time.Millisecond), func(ctx context.Context) error {
+			return handler.SendTwoPcPrepareVote(ctx, t.p2p, pid, pbVote)
+		})
+		if nil != err {
 			log.Errorf("failed to call `SendTwoPcPrepareVote`, proposalId: {%s}, taskId: {%s}, taskRole:{%s}, other identityId: {%s}, other peerId: {%s}, err: \n%s",
 				proposal.ProposalId.String(), task.TaskId(), msg.TaskRole.String(), msg.Owner.IdentityId, pid, err)
 
@@ -437,9 +546,9 @@ func (t *TwoPC) onPrepareVote(pid peer.ID, prepareVote *types.PrepareVoteWrap) e
 		}
 		for _, dataSupplier := range task.TaskData().MetadataSupplier {
 
-			// identity + partyId
-			if dataSupplier.Organization.Identity == voteMsg.Owner.IdentityId && dataSupplier.Organization.PartyId == voteMsg.Owner.PartyId {
-				// TODO validate vote sign
+			// identity + partyId + nodeId (the vote sign had been verified with the nodeId of voter)
+			if dataSupplier.Organization.Identity == voteMsg.Owner.IdentityId && dataSupplier.Organization.PartyId == voteMsg.Owner.PartyId &&
+				dataSupplier.Organization.NodeId == voteMsg.Owner.NodeId {
 				identityValid = true
 				break
 			}
@@ -452,9 +561,9 @@ func (t *TwoPC) onPrepareVote(pid peer.ID, prepareVote *types.PrepareVoteWrap) e
 		}
 		for _, powerSupplier := range task.TaskData().ResourceSupplier {
 
-			// identity + partyId
-			if powerSupplier.Organization.Identity == voteMsg.Owner.IdentityId && powerSupplier.Organization.PartyId == voteMsg.Owner.PartyId {
-				// TODO validate vote sign
+			// identity + partyId + nodeId (the vote sign had been verified with the nodeId of voter)
+			if powerSupplier.Organization.Identity == voteMsg.Owner.IdentityId && powerSupplier.Organization.PartyId == voteMsg.Owner.PartyId &&
+				powerSupplier.Organization.NodeId == voteMsg.Owner.NodeId {
 				identityValid = true
 				break
 			}
@@ -467,9 +576,9 @@ func (t *TwoPC) onPrepareVote(pid peer.ID, prepareVote *types.PrepareVoteWrap) e
 		}
 		for _, resulter := range task.TaskData().Receivers {
 
-			// identity + partyId
-			if resulter.Receiver.Identity == voteMsg.Owner.IdentityId && resulter.Receiver.PartyId == voteMsg.Owner.PartyId {
-				// TODO validate vote sign
+			// identity + partyId + nodeId (the vote sign had been verified with the nodeId of voter)
+			if resulter.Receiver.Identity == voteMsg.Owner.IdentityId && resulter.Receiver.PartyId == voteMsg.Owner.PartyId &&
+				resulter.Receiver.NodeId == voteMsg.Owner.NodeId {
 				identityValid = true
 				break
 			}
@@ -604,9 +713,17 @@ func (t *TwoPC) onConfirmMsg(pid peer.ID, confirmMsg *types.ConfirmMsgWrap) erro
 		},
 		VoteOption: types.Yes,
 		CreateAt:   uint64(timeutils.UnixMsec()),
-		//Sign
 	}
 
+	pbVote := types.ConvertConfirmVote(vote)
+	if err := t.signConfirmVote(pbVote); nil != err {
+		t.resourceMng.ReleaseLocalResourceWithTask("on onConfirmMsg", task.TaskId(), resource.SetAllReleaseResourceOption())
+		// clean some data
+		t.delProposalStateAndTask(proposalState.ProposalId)
+		return fmt.Errorf("failed to sign confirmVote, %s", err)
+	}
+	vote.Sign = pbVote.Sign
+
 	// 修改状态
 	t.state.ChangeToConfirm(msg.ProposalId, msg.CreateAt)
 	// store the proposal about all partner peerInfo of task to local cache
@@ -616,7 +733,10 @@ func (t *TwoPC) onConfirmMsg(pid peer.ID, confirmMsg *types.ConfirmMsgWrap) erro
 
 	go func() {
 
-		if err := handler.SendTwoPcConfirmVote(context.TODO(), t.p2p, pid, types.ConvertConfirmVote(vote)); nil != err {
+		err := t.sendToPeer(pid, proposalState.ProposalId, "confirmVote", periodDeadline(msg.CreateAt, time.Duration(proposalState.ConfirmTimeout)*time.Millisecond), func(ctx context.Context) error {
+			return handler.SendTwoPcConfirmVote(ctx, t.p2p, pid, pbVote)
+		})
+		if nil != err {
 			log.Errorf("failed to call `SendTwoPcConfirmVote`, proposalId: {%s}, taskId: {%s}, taskRole:{%s}, other identityId: {%s}, other peerId: {%s}, err: \n%s",
 				proposalState.ProposalId.String(), task.TaskId(), msg.TaskRole.String(), msg.Owner.IdentityId, pid, err)
 
@@ -683,9 +803,9 @@ func (t *TwoPC) onConfirmVote(pid peer.ID, confirmVote *types.ConfirmVoteWrap) e
 		}
 		for _, dataSupplier := range task.TaskData().MetadataSupplier {
 
-			// identity + partyId
-			if dataSupplier.Organization.Identity == voteMsg.Owner.IdentityId && dataSupplier.Organization.PartyId == voteMsg.Owner.PartyId {
-				// TODO validate vote sign
+			// identity + partyId + nodeId (the vote sign had been verified with the nodeId of voter)
+			if dataSupplier.Organization.Identity == voteMsg.Owner.IdentityId && dataSupplier.Organization.PartyId == voteMsg.Owner.PartyId &&
+				dataSupplier.Organization.NodeId == voteMsg.Owner.NodeId {
 				identityValid = true
 				break
 			}
@@ -698,9 +818,9 @@ func (t *TwoPC) onConfirmVote(pid peer.ID, confirmVote *types.ConfirmVoteWrap) e
 		}
 		for _, powerSupplier := range task.TaskData().ResourceSupplier {
 
-			// identity + partyId
-			if powerSupplier.Organization.Identity == voteMsg.Owner.IdentityId && powerSupplier.Organization.PartyId == voteMsg.Owner.PartyId {
-				// TODO validate vote sign
+			// identity + partyId + nodeId (the vote sign had been verified with the nodeId of voter)
+			if powerSupplier.Organization.Identity == voteMsg.Owner.IdentityId && powerSupplier.Organization.PartyId == voteMsg.Owner.PartyId &&
+				powerSupplier.Organization.NodeId == voteMsg.Owner.NodeId {
 				identityValid = true
 				break
 			}
@@ -713,9 +833,9 @@ func (t *TwoPC) onConfirmVote(pid peer.ID, confirmVote *types.ConfirmVoteWrap) e
 		}
 		for _, resulter := range task.TaskData().Receivers {
 
-			// identity + partyId
-			if resulter.Receiver.Identity == voteMsg.Owner.IdentityId && resulter.Receiver.PartyId == voteMsg.Owner.PartyId {
-				// TODO validate vote sign
+			// identity + partyId + nodeId (the vote sign had been verified with the nodeId of voter)
+			if resulter.Receiver.Identity == voteMsg.Owner.IdentityId && resulter.Receiver.PartyId == voteMsg.Owner.PartyId &&
+				resulter.Receiver.NodeId == voteMsg.Owner.NodeId {
 				identityValid = true
 				break
 			}
@@ -879,7 +999,14 @@ func (t *TwoPC) onCommitMsg(pid peer.ID, cimmitMsg *types.CommitMsgWrap) error {
 
 // Subscriber 在完成任务时对 task 生成 taskResultMsg 反馈给 发起方
 func (t *TwoPC) sendTaskResultMsg(pid peer.ID, msg *types.TaskResultMsgWrap) error {
-	if err := handler.SendTwoPcTaskResultMsg(context.TODO(), t.p2p, pid, msg.TaskResultMsg); nil != err {
+	if err := t.signTaskResultMsg(msg.TaskResultMsg); nil != err {
+		return fmt.Errorf("failed to sign taskResultMsg, taskId: {%s}, taskRole: {%s}, err: {%s}",
+			msg.TaskResultMsg.TaskId, types.TaskRoleFromBytes(msg.TaskRole).String(), err)
+	}
+	err := t.sendToPeer(pid, common.BytesToHash(msg.TaskResultMsg.ProposalId), "taskResultMsg", time.Now().Add(defaultSendDeadlineWithoutProposal), func(ctx context.Context) error {
+		return handler.SendTwoPcTaskResultMsg(ctx, t.p2p, pid, msg.TaskResultMsg)
+	})
+	if nil != err {
 		err := fmt.Errorf("failed to call `SendTwoPcTaskResultMsg`, taskId: {%s}, taskRole: {%s}, task owner's identityId: {%s}, task owner's peerId: {%s}, err: {%s}",
 			msg.TaskResultMsg.TaskId, types.TaskRoleFromBytes(msg.TaskRole).String(), string(msg.TaskResultMsg.Owner.IdentityId), pid, err)
 		return err
@@ -908,5 +1035,144 @@ func (t *TwoPC) onTaskResultMsg(pid peer.ID, taskResultMsg *types.TaskResultMsgW
 		return fmt.Errorf("%s, the local task executing status is not found", ctypes.ErrTaskResultMsgInvalid)
 	}
 	t.storeTaskEvent(pid, msg.TaskId, msg.TaskEventList)
+
+	// The resource usage is accounted to the sender, so it must be a partner of task with the role and partyId.
+	if !t.isLocalTaskPartner(msg.TaskId, msg.TaskRole, msg.Owner.IdentityId, msg.Owner.PartyId) {
+		log.Warnf("Skip the task resource usage from remote peer which is not the task partner, remote peerId: {%s}, taskId: {%s}, taskRole: {%s}, identityId: {%s}, partyId: {%s}",
+			pid, msg.TaskId, msg.TaskRole.String(), msg.Owner.IdentityId, msg.Owner.PartyId)
+		return nil
+	}
+	t.storeTaskResourceUsage(pid, msg.TaskId, msg.Owner.IdentityId, msg.ResourceUsageList)
+	return nil
+}
+
+// Subscriber 在执行任务期间 定期将任务在本方的执行进度 反馈给 发起方
+func (t *TwoPC) sendTaskProgressMsg(pid peer.ID, msg *types.TaskProgressMsgWrap) error {
+	if err := t.signTaskProgressMsg(msg.TaskProgressMsg); nil != err {
+		return fmt.Errorf("failed to sign taskProgressMsg, taskId: {%s}, taskRole: {%s}, err: {%s}",
+			msg.TaskProgressMsg.TaskId, types.TaskRoleFromBytes(msg.TaskRole).String(), err)
+	}
+	// the progress is sent once, a lost one is superseded by the next progress.
+	err := t.sendToPeer(pid, common.BytesToHash(msg.TaskProgressMsg.ProposalId), "taskProgressMsg", time.Now(), func(ctx context.Context) error {
+		return handler.SendTwoPcTaskProgressMsg(ctx, t.p2p, pid, msg.TaskProgressMsg)
+	})
+	if nil != err {
+		return fmt.Errorf("failed to call `SendTwoPcTaskProgressMsg`, taskId: {%s}, taskRole: {%s}, task owner's peerId: {%s}, err: {%s}",
+			msg.TaskProgressMsg.TaskId, types.TaskRoleFromBytes(msg.TaskRole).String(), pid, err)
+	}
+	return nil
+}
+
+// (on Publisher)
+func (t *TwoPC) onTaskProgressMsg(pid peer.ID, taskProgressMsg *types.TaskProgressMsgWrap) error {
+	taskId := string(taskProgressMsg.TaskId)
+
+	has, err := t.dataCenter.HasLocalTaskExecute(taskId)
+	if nil != err {
+		log.Errorf("Failed to query local task executing status on `onTaskProgressMsg`, taskId: {%s}, err: {%s}", taskId, err)
+		return fmt.Errorf("query local task failed")
+	}
+	if !has {
+		log.Warnf("Warning not found local task executing status on `onTaskProgressMsg`, taskId: {%s}", taskId)
+		return fmt.Errorf("%s, the local task executing status is not found", ctypes.ErrTaskProgressMsgInvalid)
+	}
+	t.storeTaskProgress(pid, taskId, string(taskProgressMsg.Owner.IdentityId), types.FetchTaskProgressArr(taskProgressMsg.ProgressList))
+	return nil
+}
+
+// OnCancelTask aborts the task which was published by myself (on Publisher).
+//
+// If the task is still on consensus, the proposal will be interrupted and the scheduler will finish the task,
+// if the task is executing, the taskManager will stop it, and the task keeps running if the local Fighter failed to stop it.
+// Both of them, all the task partners will be told to abort the task by taskCancelMsg.
+func (t *TwoPC) OnCancelTask(taskId string) error {
+
+	now := uint64(timeutils.UnixMsec())
+
+	// The task is still on consensus
+	if task, ok := t.GetSendTaskWithOk(taskId); ok {
+
+		proposalState := t.state.GetProposalStateByTaskId(taskId)
+		if t.state.EmptyInfo() == proposalState {
+			return fmt.Errorf("%s, the proposal of task is not found, taskId: {%s}", ctypes.ErrCancelTaskNotFound, taskId)
+		}
+
+		log.Infof("Start cancel the task on consensus, proposalId: {%s}, taskId: {%s}", proposalState.ProposalId.String(), taskId)
+
+		if err := t.sendTaskCancelMsg(proposalState.ProposalId, task, now); nil != err {
+			log.Warnf("Failed to call `SendTwoPcTaskCancelMsg` on consensus, proposalId: {%s}, taskId: {%s}, err: \n%s",
+				proposalState.ProposalId.String(), taskId, err)
+		}
+		// Send consensus result to Scheduler
+		t.collectTaskResultWillSendToSched(&types.ConsensuResult{
+			TaskConsResult: &types.TaskConsResult{
+				TaskId: taskId,
+				Status: types.TaskConsensusCancel,
+				Done:   false,
+				Err:    fmt.Errorf("the task was cancelled by owner"),
+			},
+		})
+		// clean some data
+		t.delProposalStateAndTask(proposalState.ProposalId)
+		return nil
+	}
+
+	// The task is executing
+	has, err := t.dataCenter.HasLocalTaskExecute(taskId)
+	if nil != err {
+		return fmt.Errorf("query local task executing status failed, %s", err)
+	}
+	if !has {
+		return fmt.Errorf("%s, taskId: {%s}", ctypes.ErrCancelTaskNotFound, taskId)
+	}
+	task, err := t.dataCenter.GetLocalTask(taskId)
+	if nil != err {
+		return fmt.Errorf("query local task failed, %s", err)
+	}
+	self, err := t.dataCenter.GetIdentity()
+	if nil != err {
+		return fmt.Errorf("query local identity failed, %s", err)
+	}
+	if task.TaskData().Identity != self.IdentityId {
+		return fmt.Errorf("%s, the task owner is not myself, taskId: {%s}", ctypes.ErrCancelTaskNotFound, taskId)
+	}
+
+	log.Infof("Start cancel the executing task, taskId: {%s}", taskId)
+
+	// Stop the task on local first, the partners are told only when it was stopped.
+	if err := t.sendCancelTaskToTaskManager(taskId); nil != err {
+		return err
+	}
+	if err := t.sendTaskCancelMsg(common.Hash{}, task, now); nil != err {
+		log.Warnf("Failed to call `SendTwoPcTaskCancelMsg` on executing task, taskId: {%s}, err: \n%s", taskId, err)
+	}
 	return nil
 }
+
+// (on Subscriber)
+func (t *TwoPC) onTaskCancelMsg(pid peer.ID, taskCancelMsg *types.TaskCancelMsgWrap) error {
+
+	proposalId := common.BytesToHash(taskCancelMsg.ProposalId)
+	taskId := string(taskCancelMsg.TaskId)
+
+	log.Debugf("Received remote taskCancelMsg, remote pid: {%s}, taskCancelMsg: %s", pid, taskCancelMsg.String())
+
+	// The task is still on consensus
+	if t.state.IsRecvTaskOnProposalState(proposalId) {
+		t.resourceMng.ReleaseLocalResourceWithTask("on onTaskCancelMsg", taskId, resource.SetAllReleaseResourceOption())
+		// clean some data
+		t.delProposalStateAndTask(proposalId)
+		return nil
+	}
+
+	// The task is executing
+	has, err := t.dataCenter.HasLocalTaskExecute(taskId)
+	if nil != err {
+		log.Errorf("Failed to query local task executing status on `onTaskCancelMsg`, taskId: {%s}, err: {%s}", taskId, err)
+		return fmt.Errorf("query local task failed")
+	}
+	if !has {
+		return fmt.Errorf("%s, the local task executing status is not found", ctypes.ErrTaskCancelMsgInvalid)
+	}
+	return t.sendCancelTaskToTaskManager(taskId)
+}
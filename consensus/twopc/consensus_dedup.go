@@ -0,0 +1,133 @@
+package twopc
+
+import (
+	"sync"
+
+	"github.com/RosettaFlow/Carrier-Go/common"
+	"github.com/RosettaFlow/Carrier-Go/common/rlputil"
+	pb "github.com/RosettaFlow/Carrier-Go/lib/consensus/twopc"
+	"github.com/RosettaFlow/Carrier-Go/types"
+	lru "github.com/hashicorp/golang-lru"
+)
+
+const defaultRecvMsgCacheSize = 4096
+
+// consensusMsgKey is the identity of a consensus msg of a proposal for one party,
+// all the retries of the same msg have the same key.
+type consensusMsgKey struct {
+	Kind       string
+	ProposalId []byte
+	TaskId     []byte
+	TaskRole   []byte
+	PartyId    []byte
+	IdentityId []byte
+}
+
+func makeConsensusMsgKey(kind string, proposalId, taskId, taskRole, partyId []byte, owner *pb.TaskOrganizationIdentityInfo) common.Hash {
+	key := &consensusMsgKey{
+		Kind:       kind,
+		ProposalId: proposalId,
+		TaskId:     taskId,
+		TaskRole:   taskRole,
+		PartyId:    partyId,
+	}
+	if nil != owner {
+		key.IdentityId = owner.IdentityId
+		if len(key.PartyId) == 0 {
+			key.PartyId = owner.PartyId
+		}
+	}
+	return rlputil.RlpHash(key)
+}
+
+// fetchConsensusMsgKey returns the dedup key of the msg,
+// the taskProgressMsg is sent periodically with new content, so it is never deduplicated.
+func fetchConsensusMsgKey(msg types.ConsensusMsg) (common.Hash, bool) {
+	switch msg := msg.(type) {
+	case *types.PrepareMsgWrap:
+		return makeConsensusMsgKey("prepareMsg", msg.ProposalId, nil, msg.TaskRole, msg.TaskPartyId, msg.Owner), true
+	case *types.PrepareVoteWrap:
+		return makeConsensusMsgKey("prepareVote", msg.ProposalId, nil, msg.TaskRole, nil, msg.Owner), true
+	case *types.ConfirmMsgWrap:
+		return makeConsensusMsgKey("confirmMsg", msg.ProposalId, nil, msg.TaskRole, msg.TaskPartyId, msg.Owner), true
+	case *types.ConfirmVoteWrap:
+		return makeConsensusMsgKey("confirmVote", msg.ProposalId, nil, msg.TaskRole, nil, msg.Owner), true
+	case *types.CommitMsgWrap:
+		return makeConsensusMsgKey("commitMsg", msg.ProposalId, nil, msg.TaskRole, msg.TaskPartyId, msg.Owner), true
+	case *types.TaskResultMsgWrap:
+		return makeConsensusMsgKey("taskResultMsg", msg.ProposalId, msg.TaskId, msg.TaskRole, nil, msg.Owner), true
+	case *types.TaskCancelMsgWrap:
+		return makeConsensusMsgKey("taskCancelMsg", msg.ProposalId, msg.TaskId, msg.TaskRole, msg.TaskPartyId, msg.Owner), true
+	default:
+		return common.Hash{}, false
+	}
+}
+
+// consensusMsgHandling is a msg being handled, the retries of it wait for its result.
+type consensusMsgHandling struct {
+	done chan struct{}
+	err  error
+}
+
+// consensusMsgDedup records the consensus msgs which have been accepted and the ones being handled.
+// A msg is recorded as accepted only after it has been handled successfully.
+type consensusMsgDedup struct {
+	accepted *lru.Cache
+	handling map[common.Hash]*consensusMsgHandling
+	lock     sync.Mutex
+}
+
+func newConsensusMsgDedup(size int) *consensusMsgDedup {
+	accepted, _ := lru.New(size)
+	return &consensusMsgDedup{
+		accepted: accepted,
+		handling: make(map[common.Hash]*consensusMsgHandling),
+	}
+}
+
+// contains reports whether the msg of the key has been accepted or is being handled.
+func (d *consensusMsgDedup) contains(key common.Hash) bool {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	_, handling := d.handling[key]
+	return handling || d.accepted.Contains(key)
+}
+
+// handle calls fn to handle the msg of the key once. The retry of an accepted msg is skipped,
+// and the retry arriving while the msg is being handled waits for the result of the handling.
+// It returns true as `duplicate` if fn is not called.
+func (d *consensusMsgDedup) handle(key common.Hash, fn func() error) (duplicate bool, err error) {
+	d.lock.Lock()
+	if d.accepted.Contains(key) {
+		d.lock.Unlock()
+		return true, nil
+	}
+	if handling, ok := d.handling[key]; ok {
+		d.lock.Unlock()
+		<-handling.done
+		return true, handling.err
+	}
+	handling := &consensusMsgHandling{done: make(chan struct{})}
+	d.handling[key] = handling
+	d.lock.Unlock()
+
+	handling.err = fn()
+
+	d.lock.Lock()
+	if nil == handling.err {
+		d.accepted.Add(key, struct{}{})
+	}
+	delete(d.handling, key)
+	d.lock.Unlock()
+	close(handling.done)
+	return false, handling.err
+}
+
+// isDuplicateConsensusMsg reports whether the msg has been accepted before or is being handled.
+func (t *TwoPC) isDuplicateConsensusMsg(msg types.ConsensusMsg) bool {
+	key, ok := fetchConsensusMsgKey(msg)
+	if !ok {
+		return false
+	}
+	return t.recvMsgs.contains(key)
+}
@@ -0,0 +1,99 @@
+package twopc
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	pb "github.com/RosettaFlow/Carrier-Go/lib/consensus/twopc"
+	"github.com/RosettaFlow/Carrier-Go/types"
+)
+
+func TestFetchConsensusMsgKey(t *testing.T) {
+	vote := &pb.PrepareVote{ProposalId: []byte("proposal"), TaskRole: []byte{1}, Owner: &pb.TaskOrganizationIdentityInfo{PartyId: []byte("p1"), IdentityId: []byte("org1")}, CreateAt: 1}
+	key, ok := fetchConsensusMsgKey(&types.PrepareVoteWrap{PrepareVote: vote})
+	if !ok {
+		t.Fatal("the prepareVote is not deduplicated")
+	}
+
+	// the retry of the msg is signed again, but it has the same key
+	retry := *vote
+	retry.CreateAt, retry.Sign = 2, []byte("sign")
+	if retryKey, _ := fetchConsensusMsgKey(&types.PrepareVoteWrap{PrepareVote: &retry}); retryKey != key {
+		t.Fatal("the retry of the msg has another key")
+	}
+
+	// the vote of another party is not a duplicate
+	other := *vote
+	other.Owner = &pb.TaskOrganizationIdentityInfo{PartyId: []byte("p2"), IdentityId: []byte("org1")}
+	if otherKey, _ := fetchConsensusMsgKey(&types.PrepareVoteWrap{PrepareVote: &other}); otherKey == key {
+		t.Fatal("the votes of different parties have the same key")
+	}
+
+	if _, ok := fetchConsensusMsgKey(&types.TaskProgressMsgWrap{TaskProgressMsg: &pb.TaskProgressMsg{}}); ok {
+		t.Fatal("the taskProgressMsg is deduplicated")
+	}
+}
+
+func TestConsensusMsgDedup(t *testing.T) {
+	dedup := newConsensusMsgDedup(16)
+	key, _ := fetchConsensusMsgKey(&types.PrepareVoteWrap{PrepareVote: &pb.PrepareVote{ProposalId: []byte("proposal")}})
+
+	// the failed msg is not recorded, so its retry is handled again
+	errHandle := errors.New("handle failed")
+	if duplicate, err := dedup.handle(key, func() error { return errHandle }); duplicate || err != errHandle {
+		t.Fatalf("handle mismatch, duplicate: %v, err: %v", duplicate, err)
+	}
+	if dedup.contains(key) {
+		t.Fatal("the failed msg is recorded")
+	}
+
+	// the retry arriving while the msg is being handled waits for the result of the handling
+	release := make(chan struct{})
+	first := make(chan error, 1)
+	go func() {
+		_, err := dedup.handle(key, func() error {
+			<-release
+			return errHandle
+		})
+		first <- err
+	}()
+	time.Sleep(10 * time.Millisecond)
+	if !dedup.contains(key) {
+		t.Fatal("the msg being handled is not recorded")
+	}
+	retry := make(chan error, 1)
+	go func() {
+		duplicate, err := dedup.handle(key, func() error {
+			t.Error("the msg being handled is handled again")
+			return nil
+		})
+		if !duplicate {
+			t.Error("the retry is not a duplicate")
+		}
+		retry <- err
+	}()
+	select {
+	case err := <-retry:
+		t.Fatalf("the retry is answered before the handling finished, err: %v", err)
+	case <-time.After(10 * time.Millisecond):
+	}
+	close(release)
+	if err := <-first; err != errHandle {
+		t.Fatalf("err mismatch, want: %s, got: %v", errHandle, err)
+	}
+	if err := <-retry; err != errHandle {
+		t.Fatalf("the retry is not answered by the result of the handling, err: %v", err)
+	}
+
+	// the accepted msg is recorded, and its retry is skipped
+	if duplicate, err := dedup.handle(key, func() error { return nil }); duplicate || nil != err {
+		t.Fatalf("handle mismatch, duplicate: %v, err: %v", duplicate, err)
+	}
+	if duplicate, err := dedup.handle(key, func() error {
+		t.Error("the accepted msg is handled again")
+		return nil
+	}); !duplicate || nil != err {
+		t.Fatalf("handle mismatch, duplicate: %v, err: %v", duplicate, err)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/RosettaFlow/Carrier-Go/common/timeutils"
 	ctypes "github.com/RosettaFlow/Carrier-Go/consensus/twopc/types"
 	"github.com/RosettaFlow/Carrier-Go/core/evengine"
+	"github.com/RosettaFlow/Carrier-Go/core/rawdb"
 	"github.com/RosettaFlow/Carrier-Go/core/resource"
 	"github.com/RosettaFlow/Carrier-Go/handler"
 	pb "github.com/RosettaFlow/Carrier-Go/lib/consensus/twopc"
@@ -169,21 +170,21 @@ func (t *TwoPC) refreshProposalState() {
 			if proposalState.IsPrepareTimeout() {
 				log.Debugf("Started refresh proposalState loop, the proposalState was prepareTimeout, change to confirm epoch, proposalId: {%s}, taskId: {%s}",
 					id.String(), proposalState.TaskId)
-				proposalState.ChangeToConfirm(proposalState.PeriodStartTime + uint64(ctypes.PrepareMsgVotingTimeout.Milliseconds()))
+				proposalState.ChangeToConfirm(proposalState.PeriodStartTime + proposalState.PrepareTimeout)
 				t.state.UpdateProposalState(proposalState)
 			}
 		case ctypes.PeriodConfirm:
 			if proposalState.IsConfirmTimeout() {
 				log.Debugf("Started refresh proposalState loop, the proposalState was confirmTimeout, change to commit epoch, proposalId: {%s}, taskId: {%s}",
 					id.String(), proposalState.TaskId)
-				proposalState.ChangeToCommit(proposalState.PeriodStartTime + uint64(ctypes.ConfirmMsgVotingTimeout.Milliseconds()))
+				proposalState.ChangeToCommit(proposalState.PeriodStartTime + proposalState.ConfirmTimeout)
 				t.state.UpdateProposalState(proposalState)
 			}
 		case ctypes.PeriodCommit:
 			if proposalState.IsCommitTimeout() {
 				log.Debugf("Started refresh proposalState loop, the proposalState was commitTimeout, change to finished epoch, proposalId: {%s}, taskId: {%s}",
 					id.String(), proposalState.TaskId)
-				proposalState.ChangeToFinished(proposalState.PeriodStartTime + uint64(ctypes.CommitMsgEndingTimeout.Milliseconds()))
+				proposalState.ChangeToFinished(proposalState.PeriodStartTime + proposalState.CommitTimeout)
 				//t.state.UpdateProposalState(proposalState)
 				t.handleInvalidProposal(proposalState)
 			}
@@ -202,6 +203,76 @@ func (t *TwoPC) refreshProposalState() {
 	}
 }
 
+// recoverProposalStates reloads the proposals which were being processed before carrier restarted from local db.
+//
+// The proposal on recvTask side will continue if it is still alive, otherwise `refreshProposalState()` will
+// send the taskResultMsg to task owner and release the local resource.
+// The proposal on sendTask side can not continue any more, because the waiting scheduler has gone with the restart,
+// so abort it directly.
+//
+// The db errors except NotFound are returned, the engine must not start with the partial proposals.
+func (t *TwoPC) recoverProposalStates() error {
+
+	records, err := t.dataCenter.QueryProposalStates()
+	if rawdb.IsNoDBNotFoundErr(err) {
+		log.Errorf("Failed to query proposalStates from local db on recoverProposalStates(), err: {%s}", err)
+		return fmt.Errorf("query proposalStates failed, %s", err)
+	}
+
+	for _, record := range records {
+
+		proposalState := ctypes.FetchProposalState(record)
+		proposalId := proposalState.ProposalId
+
+		task, err := t.dataCenter.QueryProposalTask(proposalId)
+		if rawdb.IsNoDBNotFoundErr(err) {
+			log.Errorf("Failed to query proposal task from local db on recoverProposalStates(), proposalId: {%s}, taskId: {%s}, err: {%s}",
+				proposalId.String(), proposalState.TaskId, err)
+			return fmt.Errorf("query proposal task failed, proposalId: {%s}, %s", proposalId.String(), err)
+		}
+		if nil != err {
+			log.Warnf("Not found proposal task from local db on recoverProposalStates(), clean the proposal, proposalId: {%s}, taskId: {%s}",
+				proposalId.String(), proposalState.TaskId)
+			t.state.CleanProposalState(proposalId)
+			continue
+		}
+
+		selfPeerInfo, err := t.dataCenter.QueryProposalSelfPeerInfo(proposalId)
+		if rawdb.IsNoDBNotFoundErr(err) {
+			log.Errorf("Failed to query selfPeerInfo from local db on recoverProposalStates(), proposalId: {%s}, err: {%s}", proposalId.String(), err)
+			return fmt.Errorf("query selfPeerInfo of proposal failed, proposalId: {%s}, %s", proposalId.String(), err)
+		}
+		prepareVotes, err := t.dataCenter.QueryProposalPrepareVotes(proposalId)
+		if rawdb.IsNoDBNotFoundErr(err) {
+			log.Errorf("Failed to query prepareVotes from local db on recoverProposalStates(), proposalId: {%s}, err: {%s}", proposalId.String(), err)
+			return fmt.Errorf("query prepareVotes of proposal failed, proposalId: {%s}, %s", proposalId.String(), err)
+		}
+		confirmVotes, err := t.dataCenter.QueryProposalConfirmVotes(proposalId)
+		if rawdb.IsNoDBNotFoundErr(err) {
+			log.Errorf("Failed to query confirmVotes from local db on recoverProposalStates(), proposalId: {%s}, err: {%s}", proposalId.String(), err)
+			return fmt.Errorf("query confirmVotes of proposal failed, proposalId: {%s}, %s", proposalId.String(), err)
+		}
+		peerDesc, err := t.dataCenter.QueryProposalConfirmPeerInfo(proposalId)
+		if rawdb.IsNoDBNotFoundErr(err) {
+			log.Errorf("Failed to query confirmTaskPeerInfo from local db on recoverProposalStates(), proposalId: {%s}, err: {%s}", proposalId.String(), err)
+			return fmt.Errorf("query confirmTaskPeerInfo of proposal failed, proposalId: {%s}, %s", proposalId.String(), err)
+		}
+
+		t.state.RecoverProposal(proposalState, selfPeerInfo, prepareVotes, confirmVotes, peerDesc)
+
+		log.Infof("Recovered proposalState from local db, proposalId: {%s}, taskId: {%s}, taskDir: {%s}, period: {%s}",
+			proposalId.String(), proposalState.TaskId, proposalState.TaskDir.String(), proposalState.GetPeriod())
+
+		if proposalState.TaskDir == types.SendTaskDir {
+			t.addSendTask(task)
+			go t.handleInvalidProposal(proposalState)
+		} else {
+			t.addRecvTask(task)
+		}
+	}
+	return nil
+}
+
 func (t *TwoPC) handleInvalidProposal(proposalState *ctypes.ProposalState) {
 
 	log.Debugf("Call handleInvalidProposal(), handle and clean proposalState and task, proposalId: {%s}, taskId: {%s}, taskDir: {%s}", proposalState.ProposalId, proposalState.TaskId, proposalState.TaskDir.String())
@@ -294,6 +365,36 @@ func (t *TwoPC) storeTaskEvent(pid peer.ID, taskId string, events []*types.TaskE
 	return nil
 }
 
+// storeTaskResourceUsage stores the final resource usage carried by the taskResultMsg of task partner,
+// only the usage of the partner itself is accepted.
+func (t *TwoPC) storeTaskResourceUsage(pid peer.ID, taskId, identityId string, usages []*types.TaskResourceUsage) {
+	for _, usage := range usages {
+		if usage.TaskId != taskId || usage.IdentityId != identityId {
+			log.Warnf("Skip the invalid task resource usage from remote peer, remote peerId: {%s}, taskId: {%s}, identityId: {%s}, usage: %s",
+				pid, taskId, identityId, usage.String())
+			continue
+		}
+		if err := t.dataCenter.StoreTaskResourceUsage(usage); nil != err {
+			log.Errorf("Failed to store task resource usage from remote peer, remote peerId: {%s}, taskId: {%s}, err: {%s}", pid, taskId, err)
+		}
+	}
+}
+
+// storeTaskProgress stores the progress of task reported by the task partner,
+// only the progress of the partner self is accepted.
+func (t *TwoPC) storeTaskProgress(pid peer.ID, taskId, identityId string, progressList []*types.TaskProgress) {
+	for _, progress := range progressList {
+		if progress.TaskId != taskId || progress.IdentityId != identityId {
+			log.Warnf("Skip the invalid task progress from remote peer, remote peerId: {%s}, taskId: {%s}, identityId: {%s}, progress: %s",
+				pid, taskId, identityId, progress.String())
+			continue
+		}
+		if err := t.dataCenter.StoreTaskProgress(progress); nil != err {
+			log.Errorf("Failed to store task progress from remote peer, remote peerId: {%s}, taskId: {%s}, err: {%s}", pid, taskId, err)
+		}
+	}
+}
+
 func (t *TwoPC) driveTask(
 	pid peer.ID,
 	proposalId common.Hash,
@@ -346,18 +447,30 @@ func (t *TwoPC) driveTask(
 			Resources: confirmTaskPeerInfo,
 		},
 		ResultCh: make(chan *types.TaskResultMsgWrap, 0),
+		ProgressCh: make(chan *types.TaskProgressMsgWrap, 1),
 	}
 	// 发给 taskManager 去执行 task
 	t.sendTaskToTaskManagerForExecute(taskWrap)
 	go func() {
 		if taskDir == types.RecvTaskDir {
-			if taskResultWrap, ok := <-taskWrap.ResultCh; ok {
-				if err := t.sendTaskResultMsg(pid, taskResultWrap); nil != err {
-					log.Error(err)
+			for {
+				select {
+				// 执行期间 将本方的任务进度 转发给 发起方
+				case progressWrap := <-taskWrap.ProgressCh:
+					if err := t.sendTaskProgressMsg(pid, progressWrap); nil != err {
+						log.Warn(err)
+					}
+				case taskResultWrap, ok := <-taskWrap.ResultCh:
+					if ok {
+						if err := t.sendTaskResultMsg(pid, taskResultWrap); nil != err {
+							log.Error(err)
+						}
+						t.resourceMng.ReleaseLocalResourceWithTask("on consensus.driveTask()", task.TaskId(), resource.SetAllReleaseResourceOption())
+						//// clean some data
+						//t.delProposalStateAndTask(proposalId)
+					}
+					return
 				}
-				t.resourceMng.ReleaseLocalResourceWithTask("on consensus.driveTask()", task.TaskId(), resource.SetAllReleaseResourceOption())
-				//// clean some data
-				//t.delProposalStateAndTask(proposalId)
 			}
 		} else {
 			<-taskWrap.ResultCh  // publish taskInfo to dataCenter done ..
@@ -367,7 +480,7 @@ func (t *TwoPC) driveTask(
 	}()
 }
 
-func (t *TwoPC) sendPrepareMsg(proposalId common.Hash, task *types.Task, startTime uint64) error {
+func (t *TwoPC) sendPrepareMsg(proposalId common.Hash, task *types.Task, election *types.ElectionProof, startTime uint64) error {
 
 	sendTaskFn := func(wg *sync.WaitGroup, proposalId common.Hash, taskRole types.TaskRole, partyId, identityId, nodeId, taskId string, errCh chan<- error) {
 
@@ -380,7 +493,7 @@ func (t *TwoPC) sendPrepareMsg(proposalId common.Hash, task *types.Task, startTi
 			return
 		}
 
-		prepareMsg, err := makePrepareMsgWithoutTaskRole(proposalId, task, startTime)
+		prepareMsg, err := makePrepareMsgWithoutTaskRole(proposalId, task, election, t.config.Period.Periods(), startTime)
 
 		if nil != err {
 			errCh <- fmt.Errorf("failed to make prepareMsg, proposalId: %s, taskId: %s, other peer taskRole: %s, other peer taskPartyId: %s, identityId: %s, pid: %s, err: %s",
@@ -392,7 +505,15 @@ func (t *TwoPC) sendPrepareMsg(proposalId common.Hash, task *types.Task, startTi
 		prepareMsg.TaskRole = taskRole.Bytes()
 		prepareMsg.TaskPartyId = []byte(partyId)
 
-		if err = handler.SendTwoPcPrepareMsg(context.TODO(), t.p2p, pid, prepareMsg); nil != err {
+		if err = t.signPrepareMsg(prepareMsg); nil != err {
+			errCh <- fmt.Errorf("failed to sign prepareMsg, proposalId: %s, taskId: %s, other peer taskRole: %s, other peer taskPartyId: %s, identityId: %s, pid: %s, err: %s",
+				proposalId.String(), taskId, taskRole.String(), partyId, identityId, pid, err)
+			return
+		}
+
+		if err = t.sendToPeer(pid, proposalId, "prepareMsg", periodDeadline(startTime, t.config.Period.PrepareVotingTimeout), func(ctx context.Context) error {
+			return handler.SendTwoPcPrepareMsg(ctx, t.p2p, pid, prepareMsg)
+		}); nil != err {
 			errCh <- fmt.Errorf("failed to call `SendTwoPcPrepareMsg` proposalId: %s, taskId: %s, other peer taskRole: %s, other peer taskPartyId: %s, identityId: %s, pid: %s, err: %s",
 				proposalId.String(), taskId, taskRole.String(), partyId, identityId, pid, err)
 			return
@@ -471,8 +592,16 @@ func (t *TwoPC) sendConfirmMsg(proposalId common.Hash, task *types.Task, startTi
 		confirmMsg.TaskRole = taskRole.Bytes()
 		confirmMsg.TaskPartyId = []byte(taskPartyId)
 
+		if err := t.signConfirmMsg(confirmMsg); nil != err {
+			errCh <- fmt.Errorf("failed to sign confirmMsg, proposalId: %s, taskId: %s, other peer's taskRole: %s, other peer's partyId: %s, other identityId: %s, pid: %s, err: %s",
+				proposalId.String(), taskId, taskRole.String(), taskPartyId, identityId, pid, err)
+			return
+		}
+
 		// Send the ConfirmMsg to other peer
-		if err := handler.SendTwoPcConfirmMsg(context.TODO(), t.p2p, pid, confirmMsg); nil != err {
+		if err := t.sendToPeer(pid, proposalId, "confirmMsg", periodDeadline(startTime, t.config.Period.ConfirmVotingTimeout), func(ctx context.Context) error {
+			return handler.SendTwoPcConfirmMsg(ctx, t.p2p, pid, confirmMsg)
+		}); nil != err {
 			errCh <- fmt.Errorf("failed to call`SendTwoPcConfirmMsg` proposalId: %s, taskId: %s,other peer's taskRole: %s, other peer's partyId: %s, other identityId: %s, pid: %s, err: %s",
 				proposalId.String(), taskId, taskRole.String(), taskPartyId, identityId, pid, err)
 			errCh <- err
@@ -545,8 +674,16 @@ func (t *TwoPC) sendCommitMsg(proposalId common.Hash, task *types.Task, startTim
 		commitMsg.TaskRole = taskRole.Bytes()
 		commitMsg.TaskPartyId = []byte(taskPartyId)
 
+		if err := t.signCommitMsg(commitMsg); nil != err {
+			errCh <- fmt.Errorf("failed to sign commitMsg, proposalId: %s, taskId: %s, other peer's taskRole: %s, other peer's partyId: %s, identityId: %s, pid: %s, err: %s",
+				proposalId.String(), taskId, taskRole.String(), taskPartyId, identityId, pid, err)
+			return
+		}
+
 		// Send the ConfirmMsg to other peer
-		if err := handler.SendTwoPcCommitMsg(context.TODO(), t.p2p, pid, commitMsg); nil != err {
+		if err := t.sendToPeer(pid, proposalId, "commitMsg", periodDeadline(startTime, t.config.Period.CommitEndingTimeout), func(ctx context.Context) error {
+			return handler.SendTwoPcCommitMsg(ctx, t.p2p, pid, commitMsg)
+		}); nil != err {
 			errCh <- fmt.Errorf("failed to call`SendTwoPcCommitMsg` proposalId: %s, taskId: %s,  other peer's taskRole: %s, other peer's partyId: %s, identityId: %s, pid: %s, err: %s",
 				proposalId.String(), taskId, taskRole.String(), taskPartyId, identityId, pid, err)
 			errCh <- err
@@ -603,3 +740,90 @@ func (t *TwoPC) sendCommitMsg(proposalId common.Hash, task *types.Task, startTim
 	return nil
 }
 
+// sendTaskCancelMsg tells all the task partners to abort the task,
+// the proposalId is empty if the consensus of task has finished (the task is executing).
+func (t *TwoPC) sendTaskCancelMsg(proposalId common.Hash, task *types.Task, startTime uint64) error {
+
+	sendTaskCancelMsgFn := func(wg *sync.WaitGroup, proposalId common.Hash, taskRole types.TaskRole, taskPartyId, identityId, nodeId, taskId string, errCh chan<- error) {
+
+		defer wg.Done()
+
+		pid, err := p2p.HexPeerID(nodeId)
+		if nil != err {
+			errCh <- fmt.Errorf("failed to nodeId => peerId, proposalId: %s, taskId: %s, other peer's taskRole: %s, other peer's partyId: %s, identityId: %s, pid: %s, err: %s",
+				proposalId.String(), taskId, taskRole.String(), taskPartyId, identityId, pid, err)
+			return
+		}
+		cancelMsg := makeTaskCancelMsg(proposalId, task, startTime)
+		// set other peer's role and partyId
+		cancelMsg.TaskRole = taskRole.Bytes()
+		cancelMsg.TaskPartyId = []byte(taskPartyId)
+
+		if err := t.signTaskCancelMsg(cancelMsg); nil != err {
+			errCh <- fmt.Errorf("failed to sign taskCancelMsg, proposalId: %s, taskId: %s, other peer's taskRole: %s, other peer's partyId: %s, identityId: %s, pid: %s, err: %s",
+				proposalId.String(), taskId, taskRole.String(), taskPartyId, identityId, pid, err)
+			return
+		}
+
+		// Send the TaskCancelMsg to other peer
+		if err := t.sendToPeer(pid, proposalId, "taskCancelMsg", periodDeadline(startTime, defaultSendDeadlineWithoutProposal), func(ctx context.Context) error {
+			return handler.SendTwoPcTaskCancelMsg(ctx, t.p2p, pid, cancelMsg)
+		}); nil != err {
+			errCh <- fmt.Errorf("failed to call`SendTwoPcTaskCancelMsg` proposalId: %s, taskId: %s,  other peer's taskRole: %s, other peer's partyId: %s, identityId: %s, pid: %s, err: %s",
+				proposalId.String(), taskId, taskRole.String(), taskPartyId, identityId, pid, err)
+			return
+		}
+
+		log.Debugf("Succceed to call`SendTwoPcTaskCancelMsg` proposalId: %s, taskId: %s,  other peer's taskRole: %s, other peer's partyId: %s, identityId: %s, pid: %s",
+			proposalId.String(), taskId, taskRole.String(), taskPartyId, identityId, pid)
+	}
+
+	size := (len(task.TaskData().MetadataSupplier) - 1) + len(task.TaskData().ResourceSupplier) + len(task.TaskData().Receivers)
+	errCh := make(chan error, size)
+	var wg sync.WaitGroup
+
+	for i := 0; i < len(task.TaskData().MetadataSupplier); i++ {
+		dataSupplier := task.TaskData().MetadataSupplier[i]
+		// 排除掉 task 发起方 ...
+		if task.TaskData().Identity != dataSupplier.Organization.Identity && task.TaskData().PartyId != dataSupplier.Organization.PartyId {
+			wg.Add(1)
+			go sendTaskCancelMsgFn(&wg, proposalId, types.DataSupplier, dataSupplier.Organization.PartyId,
+				dataSupplier.Organization.Identity, dataSupplier.Organization.NodeId, task.TaskId(), errCh)
+		}
+	}
+	for i := 0; i < len(task.TaskData().ResourceSupplier); i++ {
+		powerSupplier := task.TaskData().ResourceSupplier[i]
+		wg.Add(1)
+		go sendTaskCancelMsgFn(&wg, proposalId, types.PowerSupplier, powerSupplier.Organization.PartyId,
+			powerSupplier.Organization.Identity, powerSupplier.Organization.NodeId, task.TaskId(), errCh)
+	}
+	for i := 0; i < len(task.TaskData().Receivers); i++ {
+		receiver := task.TaskData().Receivers[i]
+		wg.Add(1)
+		go sendTaskCancelMsgFn(&wg, proposalId, types.ResultSupplier, receiver.Receiver.PartyId,
+			receiver.Receiver.Identity, receiver.Receiver.NodeId, task.TaskId(), errCh)
+	}
+
+	wg.Wait()
+	close(errCh)
+
+	errStrs := make([]string, 0)
+
+	for err := range errCh {
+		if nil != err {
+			errStrs = append(errStrs, err.Error())
+		}
+	}
+	if len(errStrs) != 0 {
+		return fmt.Errorf(
+			"\n######################################################## \n%s\n########################################################\n",
+			strings.Join(errStrs, "\n"))
+	}
+	return nil
+}
+
+func (t *TwoPC) sendCancelTaskToTaskManager(taskId string) error {
+	cancelWrap := types.NewCancelTaskWrap(taskId)
+	t.cancelTaskCh <- cancelWrap
+	return cancelWrap.RecvResult()
+}
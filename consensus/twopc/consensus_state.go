@@ -3,6 +3,7 @@ package twopc
 import (
 	"github.com/RosettaFlow/Carrier-Go/common"
 	ctypes "github.com/RosettaFlow/Carrier-Go/consensus/twopc/types"
+	"github.com/RosettaFlow/Carrier-Go/core/iface"
 	pb "github.com/RosettaFlow/Carrier-Go/lib/consensus/twopc"
 	"github.com/RosettaFlow/Carrier-Go/types"
 	"sync"
@@ -23,6 +24,9 @@ type state struct {
 	proposalPeerInfoCache map[common.Hash]*pb.ConfirmTaskPeerInfo
 	// the global empty proposalState
 	empty *ctypes.ProposalState
+	// the local db, all the proposal data will be persisted into it,
+	// so that the proposal can be recovered after carrier restarted.
+	db iface.LocalStoreCarrierDB
 
 	proposalsLock         sync.RWMutex
 	selfPeerInfoCacheLock sync.RWMutex
@@ -31,7 +35,7 @@ type state struct {
 	confirmPeerInfoLock   sync.RWMutex
 }
 
-func newState() *state {
+func newState(db iface.LocalStoreCarrierDB) *state {
 	return &state{
 		runningProposals:      make(map[common.Hash]*ctypes.ProposalState, 0),
 		selfPeerInfoCache:     make(map[common.Hash]*types.PrepareVoteResource, 0),
@@ -40,6 +44,61 @@ func newState() *state {
 		confirmVotes:          make(map[common.Hash]*confirmVoteState, 0),
 		proposalPeerInfoCache: make(map[common.Hash]*pb.ConfirmTaskPeerInfo, 0),
 		empty:                 ctypes.EmptyProposalState,
+		db:                    db,
+	}
+}
+
+// RecoverProposal loads the proposal data that read from local db into memory (won't write db again).
+func (s *state) RecoverProposal(
+	proposalState *ctypes.ProposalState,
+	selfPeerInfo *types.PrepareVoteResource,
+	prepareVotes []*types.PrepareVote,
+	confirmVotes []*types.ConfirmVote,
+	peerDesc *pb.ConfirmTaskPeerInfo,
+) {
+	proposalId := proposalState.ProposalId
+
+	s.proposalsLock.Lock()
+	s.runningProposals[proposalId] = proposalState
+	s.proposalsLock.Unlock()
+
+	if nil != selfPeerInfo {
+		s.selfPeerInfoCacheLock.Lock()
+		s.selfPeerInfoCache[proposalId] = selfPeerInfo
+		s.selfPeerInfoCacheLock.Unlock()
+	}
+
+	if len(prepareVotes) != 0 {
+		pvs := newPrepareVoteState()
+		for _, vote := range prepareVotes {
+			pvs.addVote(vote)
+		}
+		s.prepareVotesLock.Lock()
+		s.prepareVotes[proposalId] = pvs
+		s.prepareVotesLock.Unlock()
+	}
+
+	if len(confirmVotes) != 0 {
+		cvs := newConfirmVoteState()
+		for _, vote := range confirmVotes {
+			cvs.addVote(vote)
+		}
+		s.confirmVotesLock.Lock()
+		s.confirmVotes[proposalId] = cvs
+		s.confirmVotesLock.Unlock()
+	}
+
+	if nil != peerDesc {
+		s.confirmPeerInfoLock.Lock()
+		s.proposalPeerInfoCache[proposalId] = peerDesc
+		s.confirmPeerInfoLock.Unlock()
+	}
+}
+
+func (s *state) storeProposalState(proposalState *ctypes.ProposalState) {
+	if err := s.db.StoreProposalState(ctypes.ConvertProposalState(proposalState)); nil != err {
+		log.Errorf("Failed to store proposalState into local db, proposalId: {%s}, taskId: {%s}, err: {%s}",
+			proposalState.ProposalId.String(), proposalState.TaskId, err)
 	}
 }
 
@@ -100,18 +159,23 @@ func (s *state) GetProposalState(proposalId common.Hash) *ctypes.ProposalState {
 func (s *state) AddProposalState(proposalState *ctypes.ProposalState) {
 	s.proposalsLock.Lock()
 	s.runningProposals[proposalState.ProposalId] = proposalState
+	s.storeProposalState(proposalState)
 	s.proposalsLock.Unlock()
 }
 func (s *state) UpdateProposalState(proposalState *ctypes.ProposalState) {
 	s.proposalsLock.Lock()
 	if _, ok := s.runningProposals[proposalState.ProposalId]; ok {
 		s.runningProposals[proposalState.ProposalId] = proposalState
+		s.storeProposalState(proposalState)
 	}
 	s.proposalsLock.Unlock()
 }
 func (s *state) DelProposalState(proposalId common.Hash) {
 	s.proposalsLock.Lock()
 	delete(s.runningProposals, proposalId)
+	if err := s.db.RemoveProposalState(proposalId); nil != err {
+		log.Errorf("Failed to remove proposalState from local db, proposalId: {%s}, err: {%s}", proposalId.String(), err)
+	}
 	s.proposalsLock.Unlock()
 }
 
@@ -122,6 +186,18 @@ func (s *state) GetProposalStates() map[common.Hash]*ctypes.ProposalState {
 	return proposals
 }
 
+func (s *state) GetProposalStateByTaskId(taskId string) *ctypes.ProposalState {
+	s.proposalsLock.RLock()
+	defer s.proposalsLock.RUnlock()
+
+	for _, proposalState := range s.runningProposals {
+		if proposalState.TaskId == taskId {
+			return proposalState
+		}
+	}
+	return s.empty
+}
+
 func (s *state) ChangeToConfirm(proposalId common.Hash, startTime uint64) {
 	s.proposalsLock.Lock()
 	defer s.proposalsLock.Unlock()
@@ -134,6 +210,7 @@ func (s *state) ChangeToConfirm(proposalId common.Hash, startTime uint64) {
 	}
 	proposalState.ChangeToConfirm(startTime)
 	s.runningProposals[proposalId] = proposalState
+	s.storeProposalState(proposalState)
 }
 
 func (s *state) ChangeToCommit(proposalId common.Hash, startTime uint64) {
@@ -148,6 +225,7 @@ func (s *state) ChangeToCommit(proposalId common.Hash, startTime uint64) {
 	}
 	proposalState.ChangeToCommit(startTime)
 	s.runningProposals[proposalId] = proposalState
+	s.storeProposalState(proposalState)
 }
 
 //func (s *state) ChangeToFinished(proposalId common.Hash, startTime uint64) {
@@ -169,6 +247,9 @@ func (s *state) StoreSelfPeerInfo(proposalId common.Hash, peerInfo *types.Prepar
 	log.Debugf("Start Store selfPeerInfo, proposalId: {%s}, peerInfo: {%s}", proposalId.String(), peerInfo.String())
 	s.selfPeerInfoCacheLock.Lock()
 	s.selfPeerInfoCache[proposalId] = peerInfo
+	if err := s.db.StoreProposalSelfPeerInfo(proposalId, peerInfo); nil != err {
+		log.Errorf("Failed to store selfPeerInfo into local db, proposalId: {%s}, err: {%s}", proposalId.String(), err)
+	}
 	s.selfPeerInfoCacheLock.Unlock()
 }
 func (s *state) GetSelfPeerInfo(proposalId common.Hash) *types.PrepareVoteResource {
@@ -181,12 +262,18 @@ func (s *state) GetSelfPeerInfo(proposalId common.Hash) *types.PrepareVoteResour
 func (s *state) RemoveSelfPeerInfo(proposalId common.Hash) {
 	s.selfPeerInfoCacheLock.Lock()
 	delete(s.selfPeerInfoCache, proposalId)
+	if err := s.db.RemoveProposalSelfPeerInfo(proposalId); nil != err {
+		log.Errorf("Failed to remove selfPeerInfo from local db, proposalId: {%s}, err: {%s}", proposalId.String(), err)
+	}
 	s.selfPeerInfoCacheLock.Unlock()
 }
 
 func (s *state) StoreConfirmTaskPeerInfo(proposalId common.Hash, peerDesc *pb.ConfirmTaskPeerInfo) {
 	s.confirmPeerInfoLock.Lock()
 	s.proposalPeerInfoCache[proposalId] = peerDesc
+	if err := s.db.StoreProposalConfirmPeerInfo(proposalId, peerDesc); nil != err {
+		log.Errorf("Failed to store confirmTaskPeerInfo into local db, proposalId: {%s}, err: {%s}", proposalId.String(), err)
+	}
 	s.confirmPeerInfoLock.Unlock()
 }
 func (s *state) GetConfirmTaskPeerInfo(proposalId common.Hash) *pb.ConfirmTaskPeerInfo {
@@ -199,6 +286,9 @@ func (s *state) GetConfirmTaskPeerInfo(proposalId common.Hash) *pb.ConfirmTaskPe
 func (s *state) RemoveConfirmTaskPeerInfo(proposalId common.Hash) {
 	s.confirmPeerInfoLock.Lock()
 	delete(s.proposalPeerInfoCache, proposalId)
+	if err := s.db.RemoveProposalConfirmPeerInfo(proposalId); nil != err {
+		log.Errorf("Failed to remove confirmTaskPeerInfo from local db, proposalId: {%s}, err: {%s}", proposalId.String(), err)
+	}
 	s.confirmPeerInfoLock.Unlock()
 }
 
@@ -230,6 +320,19 @@ func (s *state) CleanProposalState(proposalId common.Hash) {
 	s.CleanConfirmVoteState(proposalId)
 	s.RemoveSelfPeerInfo(proposalId)
 	s.RemoveConfirmTaskPeerInfo(proposalId)
+	s.RemoveProposalTask(proposalId)
+}
+
+// The task cache of proposal is held by TwoPC, here only persist it with the proposalId.
+func (s *state) StoreProposalTask(proposalId common.Hash, task *types.Task) {
+	if err := s.db.StoreProposalTask(proposalId, task); nil != err {
+		log.Errorf("Failed to store proposal task into local db, proposalId: {%s}, taskId: {%s}, err: {%s}", proposalId.String(), task.TaskId(), err)
+	}
+}
+func (s *state) RemoveProposalTask(proposalId common.Hash) {
+	if err := s.db.RemoveProposalTask(proposalId); nil != err {
+		log.Errorf("Failed to remove proposal task from local db, proposalId: {%s}, err: {%s}", proposalId.String(), err)
+	}
 }
 
 // ---------------- PrepareVote ----------------
@@ -250,6 +353,9 @@ func (s *state) StorePrepareVote(vote *types.PrepareVote) {
 	}
 	pvs.addVote(vote)
 	s.prepareVotes[vote.ProposalId] = pvs
+	if err := s.db.StoreProposalPrepareVote(vote); nil != err {
+		log.Errorf("Failed to store prepareVote into local db, proposalId: {%s}, err: {%s}", vote.ProposalId.String(), err)
+	}
 	s.prepareVotesLock.Unlock()
 }
 
@@ -266,6 +372,9 @@ func (s *state) GetPrepareVoteArr(proposalId common.Hash) []*types.PrepareVote {
 func (s *state) CleanPrepareVoteState(proposalId common.Hash) {
 	s.prepareVotesLock.Lock()
 	delete(s.prepareVotes, proposalId)
+	if err := s.db.RemoveProposalPrepareVotes(proposalId); nil != err {
+		log.Errorf("Failed to remove prepareVotes from local db, proposalId: {%s}, err: {%s}", proposalId.String(), err)
+	}
 	s.prepareVotesLock.Unlock()
 }
 func (s *state) GetTaskPrepareYesVoteCount(proposalId common.Hash) uint32 {
@@ -352,11 +461,17 @@ func (s *state) StoreConfirmVote(vote *types.ConfirmVote) {
 	}
 	cvs.addVote(vote)
 	s.confirmVotes[vote.ProposalId] = cvs
+	if err := s.db.StoreProposalConfirmVote(vote); nil != err {
+		log.Errorf("Failed to store confirmVote into local db, proposalId: {%s}, err: {%s}", vote.ProposalId.String(), err)
+	}
 	s.confirmVotesLock.Unlock()
 }
 func (s *state) CleanConfirmVoteState(proposalId common.Hash) {
 	s.confirmVotesLock.Lock()
 	delete(s.confirmVotes, proposalId)
+	if err := s.db.RemoveProposalConfirmVotes(proposalId); nil != err {
+		log.Errorf("Failed to remove confirmVotes from local db, proposalId: {%s}, err: {%s}", proposalId.String(), err)
+	}
 	s.confirmVotesLock.Unlock()
 }
 func (s *state) GetTaskConfirmYesVoteCount(proposalId common.Hash) uint32 {
@@ -12,111 +12,76 @@ import (
 	"github.com/RosettaFlow/Carrier-Go/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/libp2p/go-libp2p-core/peer"
+	"strings"
 )
 
 
 
 // With subscriber
 func (t *TwoPC) validatePrepareMsg(pid peer.ID, prepareMsg *types.PrepareMsgWrap) error {
-	//proposalId := common.BytesToHash(prepareMsg.ProposalId)
-	//if t.state.HasProposal(proposalId) {
-	//	return ctypes.ErrProposalAlreadyProcessed
-	//}
-	//// Now, we has not  proposalState (on subscriber)
-	//
-	//now := uint64(timeutils.UnixMsec())
-	//if prepareMsg.CreateAt >= now {
-	//	return ctypes.ErrProposalInTheFuture
-	//}
-	//
-	//if len(prepareMsg.TaskRole) != 1 ||
-	//	types.TaskRoleFromBytes(prepareMsg.TaskRole) == types.TaskRoleUnknown {
-	//	return ctypes.ErrPrososalTaskRoleIsUnknown
-	//}
-	//taskId := string(prepareMsg.TaskOption.TaskId)
-	//
-	//if t.isProcessingTask(taskId) {
-	//	return ctypes.ErrPrososalTaskIsProcessed
-	//}
-	//
-	//// Verify the signature
-	//_, err := t.verifyMsgSigned(prepareMsg.TaskOption.Owner.NodeId, prepareMsg.SealHash().Bytes(), prepareMsg.Signature())
-	//if err != nil {
-	//	return err
-	//}
-	//
-	//// validate the owner
-	//if err := t.validateOrganizationIdentity(prepareMsg.TaskOption.Owner); nil != err {
-	//	log.Error("Failed to validate prepareMsg, the owner organization identity is invalid", "err", err)
-	//	return err
-	//}
-	//
-	//// validate the algo supplier
-	//if err := t.validateOrganizationIdentity(prepareMsg.TaskOption.AlgoSupplier); nil != err {
-	//	log.Error("Failed to validate prepareMsg, the algoSupplier organization identity is invalid", "err", err)
-	//	return err
-	//}
-	//
-	//// validate data suppliers
-	//if len(prepareMsg.TaskOption.DataSupplier) == 0 {
-	//	return fmt.Errorf("Failed to validate prepareMsg, the data suppliers is empty")
-	//}
-	//for _, supplier := range prepareMsg.TaskOption.DataSupplier {
-	//	if err := t.validateOrganizationIdentity(supplier.MemberInfo); nil != err {
-	//		log.Error("Failed to validate prepareMsg, the dataSupplier organization identity is invalid", "err", err)
-	//		return err
-	//	}
-	//}
-	//
-	//// validate power suppliers
-	//if len(prepareMsg.TaskOption.PowerSupplier) == 0 {
-	//	return fmt.Errorf("Failed to validate prepareMsg, the data suppliers is empty")
-	//}
-	//powerSuppliers := make(map[string]struct{}, len(prepareMsg.TaskOption.PowerSupplier))
-	//for _, supplier := range prepareMsg.TaskOption.PowerSupplier {
-	//	if err := t.validateOrganizationIdentity(supplier.MemberInfo); nil != err {
-	//		log.Error("Failed to validate prepareMsg, the powerSupplier organization identity is invalid", "err", err)
-	//		return err
-	//	}
-	//	powerSuppliers[string(supplier.MemberInfo.IdentityId)] = struct{}{}
-	//}
-	//
-	//// validate receicers
-	//if len(prepareMsg.TaskOption.Receivers) == 0 {
-	//	return fmt.Errorf("Failed to validate prepareMsg, the data suppliers is empty")
-	//}
-	//
-	//for _, supplier := range prepareMsg.TaskOption.Receivers {
-	//	if err := t.validateOrganizationIdentity(supplier.MemberInfo); nil != err {
-	//		log.Error("Failed to validate prepareMsg, the powerSupplier organization identity is invalid", "err", err)
-	//		return err
-	//	}
-	//	receiverIdentityId := string(supplier.MemberInfo.IdentityId)
-	//	for _, provider := range supplier.Providers {
-	//		providerIdentityId := string(provider.IdentityId)
-	//		if _, ok := powerSuppliers[providerIdentityId]; !ok {
-	//			return fmt.Errorf("Has invalid provider with receiver", "providerIdentityId", providerIdentityId, "receiverIndentityId", receiverIdentityId)
-	//		}
-	//	}
-	//}
-	//
-	//// validate OperationCost
-	//if 0 == prepareMsg.TaskOption.OperationCost.CostProcessor ||
-	//	0 == prepareMsg.TaskOption.OperationCost.CostMem ||
-	//	0 == prepareMsg.TaskOption.OperationCost.CostBandwidth ||
-	//	0 == prepareMsg.TaskOption.OperationCost.Duration {
-	//	return ctypes.ErrProposalTaskOperationCostInvalid
-	//}
-	//
-	//// validate contractCode
-	//if 0 == len(prepareMsg.TaskOption.CalculateContractCode) {
-	//	return ctypes.ErrProposalTaskCalculateContractCodeEmpty
-	//}
-	//
-	//// validate task create time
-	//if prepareMsg.TaskOption.CreateAt >= prepareMsg.CreateAt {
-	//	return ctypes.ErrProposalParamsInvalid
-	//}
+	proposalId := common.BytesToHash(prepareMsg.ProposalId)
+	if t.state.HasProposal(proposalId) {
+		return ctypes.ErrProposalAlreadyProcessed
+	}
+	// Now, we has not  proposalState (on subscriber)
+
+	now := uint64(timeutils.UnixMsec())
+	if prepareMsg.CreateAt >= now {
+		return ctypes.ErrProposalInTheFuture
+	}
+
+	taskRole := types.TaskRoleFromBytes(prepareMsg.TaskRole)
+	if taskRole == types.TaskRoleUnknown || taskRole == types.TaskOnwer {
+		return ctypes.ErrPrososalTaskRoleIsUnknown
+	}
+	if 0 == len(prepareMsg.TaskPartyId) {
+		return ctypes.ErrProposalParamsInvalid
+	}
+	// The powers of task must be proved by the election proof of the owner
+	if 0 == len(prepareMsg.ElectionSeed) || 0 == len(prepareMsg.ElectionProof) {
+		return ctypes.ErrProposalElectionEmpty
+	}
+	// The periods decided by the owner must be accepted by the local bounds
+	if err := fetchProposalPeriods(prepareMsg).Validate(t.config.Period.Bounds()); nil != err {
+		return err
+	}
+
+	// Verify the signature And the owner
+	if err := t.validateMsgOwner(pid, prepareMsg.Owner, prepareMsg.SealHash(), prepareMsg.Signature()); nil != err {
+		log.Errorf("Failed to validate prepareMsg, the owner of prepareMsg is invalid, proposalId: {%s}, err: {%s}", proposalId.String(), err)
+		return err
+	}
+
+	msg, err := fetchPrepareMsg(prepareMsg)
+	if nil != err {
+		return fmt.Errorf("%s, %s", ctypes.ErrProposalParamsInvalid, err)
+	}
+	task := msg.TaskInfo
+
+	if t.isConsensusTask(task.TaskId()) {
+		return ctypes.ErrPrososalTaskIsProcessed
+	}
+
+	// The sender of prepareMsg must be the owner of task
+	if task.TaskData().Identity != msg.Owner.IdentityId ||
+		task.TaskData().NodeId != msg.Owner.NodeId ||
+		task.TaskData().PartyId != msg.Owner.PartyId {
+		return ctypes.ErrProposalIllegal
+	}
+
+	// The role and partyId of myself must be found on task
+	self, err := t.dataCenter.GetIdentity()
+	if nil != err {
+		return fmt.Errorf("query local identity failed, %s", err)
+	}
+	if !isTaskPartner(task, taskRole, self.IdentityId, msg.TaskPartyId) {
+		return ctypes.ErrProposalParamsInvalid
+	}
+
+	// validate task create time
+	if task.TaskData().CreateAt > prepareMsg.CreateAt {
+		return ctypes.ErrProposalParamsInvalid
+	}
 	return nil
 }
 
@@ -148,15 +113,9 @@ func (t *TwoPC) validatePrepareVote(pid peer.ID, prepareVote *types.PrepareVoteW
 		return ctypes.ErrPrososalTaskRoleIsUnknown
 	}
 
-	// Verify the signature
-	_, err := t.verifyMsgSigned(prepareVote.Owner.NodeId, prepareVote.SealHash().Bytes(), prepareVote.Signature())
-	if err != nil {
-		return err
-	}
-
-	// validate the owner
-	if err := t.validateOrganizationIdentity(prepareVote.Owner); nil != err {
-		log.Error("Failed to validate prepareVote, the owner organization identity is invalid", "err", err)
+	// Verify the signature And the owner
+	if err := t.validateMsgOwner(pid, prepareVote.Owner, prepareVote.SealHash(), prepareVote.Signature()); nil != err {
+		log.Errorf("Failed to validate prepareVote, the owner is invalid, proposalId: {%s}, err: {%s}", proposalId.String(), err)
 		return err
 	}
 
@@ -165,7 +124,12 @@ func (t *TwoPC) validatePrepareVote(pid peer.ID, prepareVote *types.PrepareVoteW
 		types.VoteOptionFromBytes(prepareVote.VoteOption) == types.VoteUnknown {
 		return ctypes.ErrPrepareVoteOptionIsUnknown
 	}
-	if 0 == len(prepareVote.PeerInfo.Ip) || 0 == len(prepareVote.PeerInfo.Port) {
+	if nil == prepareVote.PeerInfo {
+		return ctypes.ErrPrepareVoteParamsInvalid
+	}
+	// Only the YES vote carries the internal resource of voter, the NO vote is sent with an empty one
+	if types.VoteOptionFromBytes(prepareVote.VoteOption) == types.Yes &&
+		(0 == len(prepareVote.PeerInfo.Ip) || 0 == len(prepareVote.PeerInfo.Port)) {
 		return ctypes.ErrPrepareVoteParamsInvalid
 	}
 
@@ -185,7 +149,7 @@ func (t *TwoPC) validateConfirmMsg(pid peer.ID, confirmMsg *types.ConfirmMsgWrap
 	// If confirmMsg is first epoch, so still stay preparePeriod
 	// If confirmMsg is second epoch, so stay confirmPeriod
 	proposalState := t.state.GetProposalState(proposalId)
-	if proposalState.IsNotPreparePeriod() || proposalState.IsNotConfirmPeriod() {
+	if proposalState.IsNotPreparePeriod() && proposalState.IsNotConfirmPeriod() {
 		return ctypes.ErrConfirmMsgIllegal
 	}
 	// When comfirm first epoch
@@ -207,18 +171,16 @@ func (t *TwoPC) validateConfirmMsg(pid peer.ID, confirmMsg *types.ConfirmMsgWrap
 		return ctypes.ErrConfirmMsgInTheFuture
 	}
 
-	// Verify the signature
-	_, err := t.verifyMsgSigned(confirmMsg.Owner.NodeId, confirmMsg.SealHash().Bytes(), confirmMsg.Signature())
-	if err != nil {
+	// Verify the signature And the owner
+	if err := t.validateMsgOwner(pid, confirmMsg.Owner, confirmMsg.SealHash(), confirmMsg.Signature()); nil != err {
+		log.Errorf("Failed to validate confirmMsg, the owner is invalid, proposalId: {%s}, err: {%s}", proposalId.String(), err)
 		return err
 	}
 
-	// validate the owner
-	if err := t.validateOrganizationIdentity(confirmMsg.Owner); nil != err {
-		log.Error("Failed to validate confirmMsg, the owner organization identity is invalid", "err", err)
-		return err
+	// The sender of confirmMsg must be the owner of task
+	if !t.isProposalTaskOwner(proposalState, confirmMsg.Owner) {
+		return fmt.Errorf("%s, the sender is not the owner of task", ctypes.ErrConfirmMsgIllegal)
 	}
-
 	return nil
 }
 
@@ -251,15 +213,9 @@ func (t *TwoPC) validateConfirmVote(pid peer.ID, confirmVote *types.ConfirmVoteW
 		return ctypes.ErrPrososalTaskRoleIsUnknown
 	}
 
-	// Verify the signature
-	_, err := t.verifyMsgSigned(confirmVote.Owner.NodeId, confirmVote.SealHash().Bytes(), confirmVote.Signature())
-	if err != nil {
-		return err
-	}
-
-	// validate the owner
-	if err := t.validateOrganizationIdentity(confirmVote.Owner); nil != err {
-		log.Error("Failed to validate confirmVote, the owner organization identity is invalid", "err", err)
+	// Verify the signature And the owner
+	if err := t.validateMsgOwner(pid, confirmVote.Owner, confirmVote.SealHash(), confirmVote.Signature()); nil != err {
+		log.Errorf("Failed to validate confirmVote, the owner is invalid, proposalId: {%s}, err: {%s}", proposalId.String(), err)
 		return err
 	}
 
@@ -297,23 +253,174 @@ func (t *TwoPC) validateCommitMsg(pid peer.ID, commitMsg *types.CommitMsgWrap) e
 		return ctypes.ErrCommitMsgInTheFuture
 	}
 
+	// Verify the signature And the owner
+	if err := t.validateMsgOwner(pid, commitMsg.Owner, commitMsg.SealHash(), commitMsg.Signature()); nil != err {
+		log.Errorf("Failed to validate commitMsg, the owner is invalid, proposalId: {%s}, err: {%s}", proposalId.String(), err)
+		return err
+	}
+
+	// The sender of commitMsg must be the owner of task
+	if !t.isProposalTaskOwner(proposalState, commitMsg.Owner) {
+		return fmt.Errorf("%s, the sender is not the owner of task", ctypes.ErrCommitMsgIllegal)
+	}
+	return nil
+}
+
+// isProposalTaskOwner reports whether the owner of msg is the owner of the task received with the proposal.
+func (t *TwoPC) isProposalTaskOwner(proposalState *ctypes.ProposalState, owner *pb.TaskOrganizationIdentityInfo) bool {
+	task, ok := t.GetRecvTaskWithOk(proposalState.TaskId)
+	if !ok {
+		return false
+	}
+	return task.TaskData().Identity == string(owner.IdentityId) &&
+		task.TaskData().NodeId == string(owner.NodeId)
+}
+
+// With publisher
+func (t *TwoPC) validateTaskResultMsg(pid peer.ID, taskResultMsg *types.TaskResultMsgWrap) error {
+
+	// (On publisher)
+	// The proposalState maybe had been cleaned when the task is executing,
+	// so we only validate the msg self here.
+	if 0 == len(taskResultMsg.TaskId) {
+		return ctypes.ErrTaskResultMsgInvalid
+	}
+
+	now := uint64(timeutils.UnixMsec())
+	if taskResultMsg.CreateAt >= now {
+		return ctypes.ErrTaskResultMsgInvalid
+	}
+
+	taskRole := types.TaskRoleFromBytes(taskResultMsg.TaskRole)
+	if taskRole == types.TaskRoleUnknown || taskRole == types.TaskOnwer {
+		return ctypes.ErrPrososalTaskRoleIsUnknown
+	}
+
+	// Verify the signature And the owner
+	if err := t.validateMsgOwner(pid, taskResultMsg.Owner, taskResultMsg.SealHash(), taskResultMsg.Signature()); nil != err {
+		log.Errorf("Failed to validate taskResultMsg, the owner is invalid, taskId: {%s}, err: {%s}", string(taskResultMsg.TaskId), err)
+		return err
+	}
+
+	// The events must belong to the task
+	for _, event := range taskResultMsg.TaskEventList {
+		if string(event.TaskId) != string(taskResultMsg.TaskId) {
+			return ctypes.ErrTaskResultMsgInvalid
+		}
+	}
+	return nil
+}
+
+// With subscriber
+func (t *TwoPC) validateTaskCancelMsg(pid peer.ID, taskCancelMsg *types.TaskCancelMsgWrap) error {
+
+	// The proposalId is empty, if the task is executing.
+	if 0 == len(taskCancelMsg.TaskId) || 0 == len(taskCancelMsg.TaskPartyId) {
+		return ctypes.ErrTaskCancelMsgInvalid
+	}
+
+	now := uint64(timeutils.UnixMsec())
+	if taskCancelMsg.CreateAt >= now {
+		return ctypes.ErrTaskCancelMsgInvalid
+	}
+
+	taskRole := types.TaskRoleFromBytes(taskCancelMsg.TaskRole)
+	if taskRole == types.TaskRoleUnknown || taskRole == types.TaskOnwer {
+		return ctypes.ErrPrososalTaskRoleIsUnknown
+	}
+
+	// Verify the signature And the owner
+	if err := t.validateMsgOwner(pid, taskCancelMsg.Owner, taskCancelMsg.SealHash(), taskCancelMsg.Signature()); nil != err {
+		log.Errorf("Failed to validate taskCancelMsg, the owner is invalid, taskId: {%s}, err: {%s}", string(taskCancelMsg.TaskId), err)
+		return err
+	}
+
+	// The sender of taskCancelMsg must be the owner of task
+	taskId := string(taskCancelMsg.TaskId)
+	task, ok := t.GetRecvTaskWithOk(taskId)
+	if !ok {
+		localTask, err := t.dataCenter.GetLocalTask(taskId)
+		if nil != err {
+			return fmt.Errorf("%s, the local task is not found", ctypes.ErrTaskCancelMsgInvalid)
+		}
+		task = localTask
+	}
+	if task.TaskData().Identity != string(taskCancelMsg.Owner.IdentityId) ||
+		task.TaskData().NodeId != string(taskCancelMsg.Owner.NodeId) {
+		return fmt.Errorf("%s, the sender is not the owner of task", ctypes.ErrTaskCancelMsgInvalid)
+	}
+	return nil
+}
+
+// (On publisher)
+func (t *TwoPC) validateTaskProgressMsg(pid peer.ID, taskProgressMsg *types.TaskProgressMsgWrap) error {
+
+	if 0 == len(taskProgressMsg.TaskId) || nil == taskProgressMsg.Owner {
+		return ctypes.ErrTaskProgressMsgInvalid
+	}
+
+	now := uint64(timeutils.UnixMsec())
+	if taskProgressMsg.CreateAt >= now {
+		return ctypes.ErrTaskProgressMsgInvalid
+	}
+
+	taskRole := types.TaskRoleFromBytes(taskProgressMsg.TaskRole)
+	if taskRole == types.TaskRoleUnknown || taskRole == types.TaskOnwer {
+		return ctypes.ErrPrososalTaskRoleIsUnknown
+	}
+
+	// Verify the signature And the owner
+	if err := t.validateMsgOwner(pid, taskProgressMsg.Owner, taskProgressMsg.SealHash(), taskProgressMsg.Signature()); nil != err {
+		log.Errorf("Failed to validate taskProgressMsg, the owner is invalid, taskId: {%s}, err: {%s}", string(taskProgressMsg.TaskId), err)
+		return err
+	}
+
+	// The sender must be a partner of task with the role and partyId it claims
+	if !t.isLocalTaskPartner(string(taskProgressMsg.TaskId), taskRole, string(taskProgressMsg.Owner.IdentityId), string(taskProgressMsg.Owner.PartyId)) {
+		return fmt.Errorf("%s, the sender is not the task partner", ctypes.ErrTaskProgressMsgInvalid)
+	}
+
+	// The progress must belong to the task
+	for _, progress := range taskProgressMsg.ProgressList {
+		if string(progress.TaskId) != string(taskProgressMsg.TaskId) {
+			return ctypes.ErrTaskProgressMsgInvalid
+		}
+	}
+	return nil
+}
+
+// validateMsgOwner verifies the owner of msg was the sender peer and signed the msg,
+// and the owner is a valid organization identity.
+func (t *TwoPC) validateMsgOwner(pid peer.ID, owner *pb.TaskOrganizationIdentityInfo, sealHash common.Hash, sig []byte) error {
+	if nil == owner {
+		return ctypes.ErrOrganizationIdentity
+	}
+
+	// The sender peer must be the owner of msg
+	if err := t.verifyMsgSender(pid, owner.NodeId); nil != err {
+		return err
+	}
+
 	// Verify the signature
-	_, err := t.verifyMsgSigned(commitMsg.Owner.NodeId, commitMsg.SealHash().Bytes(), commitMsg.Signature())
-	if err != nil {
+	if _, err := t.verifyMsgSigned(owner.NodeId, sealHash.Bytes(), sig); nil != err {
 		return err
 	}
 
 	// validate the owner
-	if err := t.validateOrganizationIdentity(commitMsg.Owner); nil != err {
-		log.Error("Failed to validate commitMsg, the owner organization identity is invalid", "err", err)
+	if err := t.validateOrganizationIdentity(owner); nil != err {
 		return err
 	}
-
 	return nil
 }
 
-// With publisher
-func (t *TwoPC) validateTaskResultMsg(pid peer.ID, taskResultMsg *types.TaskResultMsgWrap) error {
+func (t *TwoPC) verifyMsgSender(pid peer.ID, nodeId []byte) error {
+	ownerPid, err := p2p.HexPeerID(string(nodeId))
+	if nil != err {
+		return ctypes.ErrMsgOwnerNodeIdInvalid
+	}
+	if ownerPid != pid {
+		return ctypes.ErrMsgSenderInvalid
+	}
 	return nil
 }
 
@@ -322,23 +429,24 @@ func (t *TwoPC) validateOrganizationIdentity(identityInfo *pb.TaskOrganizationId
 	if "" == string(identityInfo.Name) {
 		return ctypes.ErrOrganizationIdentity
 	}
-	_, err := p2p.BytesID(identityInfo.NodeId)
+	_, err := p2p.HexID(string(identityInfo.NodeId))
 	if nil != err {
 		return ctypes.ErrOrganizationIdentity
 	}
-	has, err := t.dataCenter.HasIdentity(&types.NodeAlias{
-		Name:       string(identityInfo.Name),
-		NodeId:     string(identityInfo.NodeId),
-		IdentityId: string(identityInfo.IdentityId),
-	})
+	identityList, err := t.dataCenter.GetIdentityList()
 	if nil != err {
 		return fmt.Errorf("Failed to validate organization identity from all identity list")
 	}
-	if !has {
-		return ctypes.ErrOrganizationIdentity
+	// the identityId and nodeId must be matched
+	for _, identity := range identityList {
+		if identity.IdentityId() == string(identityInfo.IdentityId) {
+			if strings.TrimPrefix(identity.NodeId(), "0x") != strings.TrimPrefix(string(identityInfo.NodeId), "0x") {
+				return ctypes.ErrOrganizationIdentity
+			}
+			return nil
+		}
 	}
-
-	return nil
+	return ctypes.ErrOrganizationIdentity
 }
 
 func (t *TwoPC) verifyMsgSigned(nodeId []byte, m []byte, sig []byte) (bool, error) {
@@ -352,7 +460,7 @@ func (t *TwoPC) verifyMsgSigned(nodeId []byte, m []byte, sig []byte) (bool, erro
 		return false, err
 	}
 
-	ownerNodeId, err := p2p.BytesID(nodeId)
+	ownerNodeId, err := p2p.HexID(string(nodeId))
 	if nil != err {
 		return false, ctypes.ErrMsgOwnerNodeIdInvalid
 	}
@@ -396,4 +504,39 @@ func (t *TwoPC) verifySelfSigned(m []byte, sig []byte) bool {
 func (t *TwoPC) validateRecvTask(task *types.Task) error {
 
 	return nil
+}
+
+// isLocalTaskPartner checks whether the organization with the role and partyId is a partner of the local task (on publisher).
+func (t *TwoPC) isLocalTaskPartner(taskId string, taskRole types.TaskRole, identityId, partyId string) bool {
+	task, err := t.dataCenter.GetLocalTask(taskId)
+	if nil != err {
+		log.Warnf("Failed to query local task on isLocalTaskPartner, taskId: {%s}, err: {%s}", taskId, err)
+		return false
+	}
+	return isTaskPartner(task, taskRole, identityId, partyId)
+}
+
+// isTaskPartner checks whether the organization with the role and partyId is a partner of task.
+func isTaskPartner(task *types.Task, taskRole types.TaskRole, identityId, partyId string) bool {
+	switch taskRole {
+	case types.DataSupplier:
+		for _, dataSupplier := range task.TaskData().MetadataSupplier {
+			if identityId == dataSupplier.Organization.Identity && partyId == dataSupplier.Organization.PartyId {
+				return true
+			}
+		}
+	case types.PowerSupplier:
+		for _, powerSupplier := range task.TaskData().ResourceSupplier {
+			if identityId == powerSupplier.Organization.Identity && partyId == powerSupplier.Organization.PartyId {
+				return true
+			}
+		}
+	case types.ResultSupplier:
+		for _, receiver := range task.TaskData().Receivers {
+			if identityId == receiver.Receiver.Identity && partyId == receiver.Receiver.PartyId {
+				return true
+			}
+		}
+	}
+	return false
 }
\ No newline at end of file
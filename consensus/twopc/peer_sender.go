@@ -0,0 +1,189 @@
+package twopc
+
+import (
+	"context"
+	"fmt"
+	"github.com/RosettaFlow/Carrier-Go/common"
+	ctypes "github.com/RosettaFlow/Carrier-Go/consensus/twopc/types"
+	"github.com/RosettaFlow/Carrier-Go/handler"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"sync"
+	"time"
+)
+
+const (
+	defaultSendRetryBaseInterval = 100 * time.Millisecond
+	defaultSendRetryMaxInterval  = 2 * time.Second
+	// The msgs which are not bound to a proposal period (taskResultMsg, taskCancelMsg)
+	// are resent during this duration.
+	defaultSendDeadlineWithoutProposal = 10 * time.Second
+)
+
+// peerSendJob is a msg waiting in the outbound queue of a peer.
+type peerSendJob struct {
+	proposalId common.Hash
+	desc       string
+	deadline   time.Time
+	send       func(ctx context.Context) error
+	errCh      chan error
+}
+
+// peerSender sends the msgs of its queue to one remote peer. The msgs of the same proposal
+// are sent in order on the lane of the proposal, and the lanes of different proposals are
+// sent concurrently, so a msg retrying on one proposal never delays the msgs of the others.
+type peerSender struct {
+	pid       peer.ID
+	queueSize uint64
+	ctx       context.Context
+	// the count of the msgs waiting on all the lanes
+	pending uint64
+	// the msgs waiting on the lane of each proposal (proposalId -> msgs),
+	// a lane is running as long as it is in the map.
+	lanes map[common.Hash][]*peerSendJob
+	lock  sync.Mutex
+}
+
+// push puts the job on the lane of its proposal and starts the lane if it is idle,
+// it returns false when the queue of the peer is full.
+func (s *peerSender) push(job *peerSendJob) bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	if s.pending >= s.queueSize {
+		return false
+	}
+	s.pending++
+	jobs, running := s.lanes[job.proposalId]
+	s.lanes[job.proposalId] = append(jobs, job)
+	if !running {
+		go s.runLane(job.proposalId)
+	}
+	return true
+}
+
+// next pops the next job of the lane, the lane is stopped when it is empty.
+func (s *peerSender) next(proposalId common.Hash) (*peerSendJob, bool) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	jobs := s.lanes[proposalId]
+	if len(jobs) == 0 {
+		delete(s.lanes, proposalId)
+		return nil, false
+	}
+	s.lanes[proposalId] = jobs[1:]
+	s.pending--
+	return jobs[0], true
+}
+
+func (s *peerSender) runLane(proposalId common.Hash) {
+	for {
+		job, ok := s.next(proposalId)
+		if !ok {
+			return
+		}
+		job.errCh <- s.sendWithRetry(job)
+	}
+}
+
+// sendWithRetry resends the same msg with exponential backoff until the remote peer
+// accepted or refused it, or the next attempt would start after the deadline of the job.
+// The receiver deduplicates the msg, so the retries are idempotent.
+func (s *peerSender) sendWithRetry(job *peerSendJob) error {
+	interval := defaultSendRetryBaseInterval
+	for attempt := 1; ; attempt++ {
+		if nil != s.ctx.Err() {
+			return ctypes.ErrPeerMsgSenderClosed
+		}
+		err := job.send(s.ctx)
+		if nil == err || handler.IsTwoPcMsgRejected(err) {
+			return err
+		}
+		if time.Now().Add(interval).After(job.deadline) {
+			return fmt.Errorf("%s, gave up after %d attempts", err, attempt)
+		}
+		log.Warnf("Failed to send %s to peer, will retry after %s, pid: {%s}, proposalId: {%s}, attempt: %d, err: {%s}",
+			job.desc, interval, s.pid, job.proposalId.String(), attempt, err)
+
+		timer := time.NewTimer(interval)
+		select {
+		case <-timer.C:
+		case <-s.ctx.Done():
+			timer.Stop()
+			return ctypes.ErrPeerMsgSenderClosed
+		}
+		if interval *= 2; interval > defaultSendRetryMaxInterval {
+			interval = defaultSendRetryMaxInterval
+		}
+	}
+}
+
+// peerSenderSet holds the outbound queues of all remote peers, which are created lazily.
+type peerSenderSet struct {
+	queueSize uint64
+	senders   map[peer.ID]*peerSender
+	lock      sync.Mutex
+	// canceled on close, which interrupts the msgs being sent
+	ctx    context.Context
+	cancel context.CancelFunc
+}
+
+func newPeerSenderSet(queueSize uint64) *peerSenderSet {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &peerSenderSet{
+		queueSize: queueSize,
+		senders:   make(map[peer.ID]*peerSender),
+		ctx:       ctx,
+		cancel:    cancel,
+	}
+}
+
+func (set *peerSenderSet) getOrCreate(pid peer.ID) *peerSender {
+	set.lock.Lock()
+	defer set.lock.Unlock()
+	sender, ok := set.senders[pid]
+	if !ok {
+		sender = &peerSender{
+			pid:       pid,
+			queueSize: set.queueSize,
+			ctx:       set.ctx,
+			lanes:     make(map[common.Hash][]*peerSendJob),
+		}
+		set.senders[pid] = sender
+	}
+	return sender
+}
+
+// send puts the msg of the proposal into the outbound queue of the peer and waits for the result of sending.
+// The msg is refused immediately when the queue is full.
+func (set *peerSenderSet) send(pid peer.ID, proposalId common.Hash, desc string, deadline time.Time, send func(ctx context.Context) error) error {
+	job := &peerSendJob{
+		proposalId: proposalId,
+		desc:       desc,
+		deadline:   deadline,
+		send:       send,
+		errCh:      make(chan error, 1),
+	}
+	if !set.getOrCreate(pid).push(job) {
+		return ctypes.ErrPeerMsgQueueFull
+	}
+	select {
+	case err := <-job.errCh:
+		return err
+	case <-set.ctx.Done():
+		return ctypes.ErrPeerMsgSenderClosed
+	}
+}
+
+// close interrupts the msgs being sent, and refuses the msgs waiting on the queues.
+func (set *peerSenderSet) close() {
+	set.cancel()
+}
+
+// sendToPeer sends the msg of the proposal to the peer through the outbound queue of the peer.
+func (t *TwoPC) sendToPeer(pid peer.ID, proposalId common.Hash, desc string, deadline time.Time, send func(ctx context.Context) error) error {
+	return t.peerSenders.send(pid, proposalId, desc, deadline, send)
+}
+
+// periodDeadline returns the end time of the proposal period which starts at `startTime` (ms).
+func periodDeadline(startTime uint64, duration time.Duration) time.Time {
+	return time.Unix(0, int64(startTime)*int64(time.Millisecond)).Add(duration)
+}
@@ -0,0 +1,168 @@
+package twopc
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/RosettaFlow/Carrier-Go/common"
+	ctypes "github.com/RosettaFlow/Carrier-Go/consensus/twopc/types"
+	"github.com/RosettaFlow/Carrier-Go/handler"
+	"github.com/libp2p/go-libp2p-core/peer"
+)
+
+var errTestTransport = errors.New("transport failed")
+
+const testPid = peer.ID("peer-01")
+
+func TestPeerSenderRetry(t *testing.T) {
+	set := newPeerSenderSet(8)
+	defer set.close()
+
+	var attempts int32
+	err := set.send(testPid, common.BytesToHash([]byte("proposal")), "prepareMsg", time.Now().Add(time.Second), func(ctx context.Context) error {
+		if atomic.AddInt32(&attempts, 1) < 3 {
+			return errTestTransport
+		}
+		return nil
+	})
+	if nil != err {
+		t.Fatalf("send failed, err: %s", err)
+	}
+	if attempts != 3 {
+		t.Fatalf("attempts mismatch, want: 3, got: %d", attempts)
+	}
+
+	// the msg refused by the peer is not retried
+	attempts = 0
+	err = set.send(testPid, common.BytesToHash([]byte("proposal")), "prepareMsg", time.Now().Add(time.Second), func(ctx context.Context) error {
+		atomic.AddInt32(&attempts, 1)
+		return &handler.TwoPcMsgRejectedError{Code: 1, Reason: "refused"}
+	})
+	if !handler.IsTwoPcMsgRejected(err) || attempts != 1 {
+		t.Fatalf("the refused msg is retried, attempts: %d, err: %v", attempts, err)
+	}
+
+	// the msg is given up at the deadline
+	err = set.send(testPid, common.BytesToHash([]byte("proposal")), "prepareMsg", time.Now().Add(defaultSendRetryBaseInterval), func(ctx context.Context) error {
+		return errTestTransport
+	})
+	if nil == err {
+		t.Fatal("the msg failing until the deadline is sent")
+	}
+}
+
+func TestPeerSenderLanes(t *testing.T) {
+	set := newPeerSenderSet(8)
+	defer set.close()
+
+	// the msg of a proposal keeps retrying until its deadline
+	blocked := make(chan error, 1)
+	go func() {
+		blocked <- set.send(testPid, common.BytesToHash([]byte("result")), "taskResultMsg", time.Now().Add(time.Second), func(ctx context.Context) error {
+			return errTestTransport
+		})
+	}()
+	time.Sleep(10 * time.Millisecond)
+
+	// the msg of another proposal to the same peer is not delayed by it
+	start := time.Now()
+	if err := set.send(testPid, common.BytesToHash([]byte("proposal")), "prepareVote", time.Now().Add(time.Second), func(ctx context.Context) error {
+		return nil
+	}); nil != err {
+		t.Fatalf("send failed, err: %s", err)
+	}
+	if elapsed := time.Since(start); elapsed > defaultSendRetryBaseInterval {
+		t.Fatalf("the msg is delayed by the msg of another proposal, elapsed: %s", elapsed)
+	}
+
+	// the msgs of the same proposal are sent in order
+	var (
+		lock  sync.Mutex
+		order []int
+		wg    sync.WaitGroup
+	)
+	proposalId := common.BytesToHash([]byte("ordered"))
+	release := make(chan struct{})
+	for i := 0; i < 3; i++ {
+		i := i
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			set.send(testPid, proposalId, "confirmMsg", time.Now().Add(time.Second), func(ctx context.Context) error {
+				<-release
+				lock.Lock()
+				order = append(order, i)
+				lock.Unlock()
+				return nil
+			})
+		}()
+		// wait for the msg being queued before the next one
+		time.Sleep(10 * time.Millisecond)
+	}
+	close(release)
+	wg.Wait()
+	for i, v := range order {
+		if i != v {
+			t.Fatalf("the msgs of the same proposal are out of order: %v", order)
+		}
+	}
+	if err := <-blocked; nil == err {
+		t.Fatal("the msg failing until the deadline is sent")
+	}
+}
+
+func TestPeerSenderQueueFull(t *testing.T) {
+	set := newPeerSenderSet(1)
+	defer set.close()
+
+	release := make(chan struct{})
+	go set.send(testPid, common.BytesToHash([]byte("first")), "prepareMsg", time.Now().Add(time.Second), func(ctx context.Context) error {
+		<-release
+		return nil
+	})
+	time.Sleep(10 * time.Millisecond)
+	// the first msg is being sent, so it does not take the queue any more
+	go set.send(testPid, common.BytesToHash([]byte("first")), "confirmMsg", time.Now().Add(time.Second), func(ctx context.Context) error {
+		return nil
+	})
+	time.Sleep(10 * time.Millisecond)
+
+	err := set.send(testPid, common.BytesToHash([]byte("second")), "prepareMsg", time.Now().Add(time.Second), func(ctx context.Context) error {
+		return nil
+	})
+	if err != ctypes.ErrPeerMsgQueueFull {
+		t.Fatalf("the msg is queued on the full queue, err: %v", err)
+	}
+	close(release)
+}
+
+func TestPeerSenderClose(t *testing.T) {
+	set := newPeerSenderSet(8)
+
+	sending := make(chan struct{})
+	canceled := make(chan struct{})
+	result := make(chan error, 1)
+	go func() {
+		result <- set.send(testPid, common.BytesToHash([]byte("proposal")), "commitMsg", time.Now().Add(time.Minute), func(ctx context.Context) error {
+			close(sending)
+			<-ctx.Done()
+			close(canceled)
+			return ctx.Err()
+		})
+	}()
+	<-sending
+	set.close()
+
+	select {
+	case <-canceled:
+	case <-time.After(time.Second):
+		t.Fatal("the msg being sent is not interrupted by close")
+	}
+	if err := <-result; err != ctypes.ErrPeerMsgSenderClosed {
+		t.Fatalf("err mismatch, want: %s, got: %v", ctypes.ErrPeerMsgSenderClosed, err)
+	}
+}
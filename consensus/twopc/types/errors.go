@@ -55,6 +55,7 @@ var (
 
 	ErrMsgSignInvalid        = errors.New("The msg signature is invalid")
 	ErrMsgOwnerNodeIdInvalid = errors.New("The msg's owner nodeId is invalid")
+	ErrMsgSenderInvalid      = errors.New("The msg's sender is not the owner of msg")
 
 	ErrProposalWithoutPreparePeriod = errors.New("The proposal without prepare period")
 	ErrProposalWithoutConfirmPeriod = errors.New("The proposal without confirm period")
@@ -67,6 +68,9 @@ var (
 	ErrProposalConfirmVoteTimeout = errors.New("Receiving confirmVote of proposal timeout")
 	ErrProposalCommitMsgTimeout = errors.New("Receiving commitMsg of proposal timeout")
 	ErrTaskResultMsgInvalid = errors.New("Receiving taskResultMsg is invalid")
+	ErrTaskCancelMsgInvalid = errors.New("Receiving taskCancelMsg is invalid")
+	ErrTaskProgressMsgInvalid = errors.New("Receiving taskProgressMsg is invalid")
+	ErrCancelTaskNotFound   = errors.New("The task to cancel is not found on consensus or executing")
 
 
 	ErrProposalPrepareVoteFuture = errors.New("Receiving prepareVote of proposal is future msg")
@@ -79,4 +83,10 @@ var (
 	ErrProposalConfirmVoteVoteOwnerInvalid = errors.New("The owner of proposal's confirmVote is invalid")
 
 	ErrVoteCountOverflow = errors.New("The vote count has overflow")
+
+	ErrProposalPeriodsInvalid = errors.New("The periods of proposal are invalid")
+	ErrProposalElectionEmpty  = errors.New("The proposal without election proof")
+
+	ErrPeerMsgQueueFull    = errors.New("The outbound msg queue of peer is full")
+	ErrPeerMsgSenderClosed = errors.New("The outbound msg sender of peer has been closed")
 )
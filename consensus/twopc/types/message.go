@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
 	"github.com/RosettaFlow/Carrier-Go/common"
 	"github.com/RosettaFlow/Carrier-Go/common/bytesutil"
 	"github.com/RosettaFlow/Carrier-Go/common/rlputil"
@@ -110,9 +111,10 @@ const (
 	// but at this time the `State` of `proposal` itself has not reached the `Deadline`.
 	PeriodFinished ProposalStatePeriod = 4
 
-	PrepareMsgVotingTimeout = 3 * time.Second // 3s
-	ConfirmMsgVotingTimeout = 1 * time.Second // 1s
-	CommitMsgEndingTimeout  = 1 * time.Second // 1s
+	DefaultPrepareMsgVotingTimeout  = 3 * time.Second  // 3s
+	DefaultConfirmMsgVotingTimeout  = 1 * time.Second  // 1s
+	DefaultCommitMsgEndingTimeout   = 1 * time.Second  // 1s
+	DefaultProposalDeadlineDuration = 60 * time.Second // during 60s, if the proposal haven't been done, kill it
 
 	//ConfirmEpochUnknown ConfirmEpoch = 0
 	//ConfirmEpochFirst   ConfirmEpoch = 1
@@ -120,10 +122,73 @@ const (
 
 )
 
-var (
-	// during 60s, if the proposal haven't been done, kill it
-	ProposalDeadlineDuration = uint64(60 * (time.Second.Milliseconds()))
-)
+// ProposalPeriods is the durations of the periods of a proposal.
+// They are decided by the task owner and carried in the prepareMsg,
+// so that all the partners of the proposal use the same values.
+type ProposalPeriods struct {
+	PrepareVotingTimeout time.Duration
+	ConfirmVotingTimeout time.Duration
+	CommitEndingTimeout  time.Duration
+	Deadline             time.Duration
+}
+
+func DefaultProposalPeriods() *ProposalPeriods {
+	return &ProposalPeriods{
+		PrepareVotingTimeout: DefaultPrepareMsgVotingTimeout,
+		ConfirmVotingTimeout: DefaultConfirmMsgVotingTimeout,
+		CommitEndingTimeout:  DefaultCommitMsgEndingTimeout,
+		Deadline:             DefaultProposalDeadlineDuration,
+	}
+}
+
+// ProposalPeriodsFromMsec builds the periods from the millisecond values of the prepareMsg,
+// a zero value (sent by an owner which does not carry it) takes the default one.
+func ProposalPeriodsFromMsec(prepare, confirm, commit, deadline uint64) *ProposalPeriods {
+	periods := DefaultProposalPeriods()
+	if 0 != prepare {
+		periods.PrepareVotingTimeout = time.Duration(prepare) * time.Millisecond
+	}
+	if 0 != confirm {
+		periods.ConfirmVotingTimeout = time.Duration(confirm) * time.Millisecond
+	}
+	if 0 != commit {
+		periods.CommitEndingTimeout = time.Duration(commit) * time.Millisecond
+	}
+	if 0 != deadline {
+		periods.Deadline = time.Duration(deadline) * time.Millisecond
+	}
+	return periods
+}
+
+// ProposalPeriodBounds limits the periods of the proposals started by other owners.
+type ProposalPeriodBounds struct {
+	MinPeriodTimeout time.Duration
+	MaxPeriodTimeout time.Duration
+	MaxDeadline      time.Duration
+}
+
+// Validate checks the periods against the bounds,
+// the deadline must be long enough to hold all the periods.
+func (p *ProposalPeriods) Validate(bounds *ProposalPeriodBounds) error {
+	for _, timeout := range []time.Duration{p.PrepareVotingTimeout, p.ConfirmVotingTimeout, p.CommitEndingTimeout} {
+		if timeout < bounds.MinPeriodTimeout || timeout > bounds.MaxPeriodTimeout {
+			return fmt.Errorf("%s, the period timeout %s is out of [%s, %s]",
+				ErrProposalPeriodsInvalid, timeout, bounds.MinPeriodTimeout, bounds.MaxPeriodTimeout)
+		}
+	}
+	if p.Deadline > bounds.MaxDeadline {
+		return fmt.Errorf("%s, the deadline %s is longer than %s", ErrProposalPeriodsInvalid, p.Deadline, bounds.MaxDeadline)
+	}
+	if p.Deadline < p.PrepareVotingTimeout+p.ConfirmVotingTimeout+p.CommitEndingTimeout {
+		return fmt.Errorf("%s, the deadline %s is shorter than the sum of periods", ErrProposalPeriodsInvalid, p.Deadline)
+	}
+	return nil
+}
+
+func (p *ProposalPeriods) String() string {
+	return fmt.Sprintf(`{"prepareVotingTimeout": "%s", "confirmVotingTimeout": "%s", "commitEndingTimeout": "%s", "deadline": "%s"}`,
+		p.PrepareVotingTimeout, p.ConfirmVotingTimeout, p.CommitEndingTimeout, p.Deadline)
+}
 
 //type ConfirmEpoch uint64
 //
@@ -142,12 +207,16 @@ type ProposalState struct {
 	// when the current time is greater than the `DeadlineDuration` createAt of proposalState
 	DeadlineDuration uint64
 	CreateAt         uint64
+	// The timeouts (ms) of prepare, confirm and commit periods
+	PrepareTimeout uint64
+	ConfirmTimeout uint64
+	CommitTimeout  uint64
 }
 
 var EmptyProposalState = new(ProposalState)
 
 func NewProposalState(proposalId common.Hash, taskId string,
-	TaskDir types.ProposalTaskDir, taskRole types.TaskRole, selfIdentity *types.TaskNodeAlias, startTime uint64) *ProposalState {
+	TaskDir types.ProposalTaskDir, taskRole types.TaskRole, selfIdentity *types.TaskNodeAlias, startTime uint64, periods *ProposalPeriods) *ProposalState {
 
 	return &ProposalState{
 		ProposalId:       proposalId,
@@ -157,8 +226,51 @@ func NewProposalState(proposalId common.Hash, taskId string,
 		SelfIdentity:     selfIdentity,
 		PeriodNum:        PeriodPrepare,
 		PeriodStartTime:  startTime,
-		DeadlineDuration: ProposalDeadlineDuration,
+		DeadlineDuration: uint64(periods.Deadline.Milliseconds()),
 		CreateAt:         uint64(timeutils.UnixMsec()),
+		PrepareTimeout:   uint64(periods.PrepareVotingTimeout.Milliseconds()),
+		ConfirmTimeout:   uint64(periods.ConfirmVotingTimeout.Milliseconds()),
+		CommitTimeout:    uint64(periods.CommitEndingTimeout.Milliseconds()),
+	}
+}
+
+func ConvertProposalState(pstate *ProposalState) *types.ProposalStateRecord {
+	return &types.ProposalStateRecord{
+		ProposalId:         pstate.ProposalId,
+		TaskDir:            pstate.TaskDir,
+		TaskRole:           pstate.TaskRole,
+		SelfIdentity:       pstate.SelfIdentity,
+		TaskId:             pstate.TaskId,
+		PeriodNum:          uint32(pstate.PeriodNum),
+		PrePeriodStartTime: pstate.PrePeriodStartTime,
+		PeriodStartTime:    pstate.PeriodStartTime,
+		DeadlineDuration:   pstate.DeadlineDuration,
+		CreateAt:           pstate.CreateAt,
+		PeriodTimeouts:     []uint64{pstate.PrepareTimeout, pstate.ConfirmTimeout, pstate.CommitTimeout},
+	}
+}
+func FetchProposalState(record *types.ProposalStateRecord) *ProposalState {
+	// the record stored before the periods became configurable has no period timeouts
+	timeouts := []uint64{
+		uint64(DefaultPrepareMsgVotingTimeout.Milliseconds()),
+		uint64(DefaultConfirmMsgVotingTimeout.Milliseconds()),
+		uint64(DefaultCommitMsgEndingTimeout.Milliseconds()),
+	}
+	copy(timeouts, record.PeriodTimeouts)
+	return &ProposalState{
+		ProposalId:         record.ProposalId,
+		TaskDir:            record.TaskDir,
+		TaskRole:           record.TaskRole,
+		SelfIdentity:       record.SelfIdentity,
+		TaskId:             record.TaskId,
+		PeriodNum:          ProposalStatePeriod(record.PeriodNum),
+		PrePeriodStartTime: record.PrePeriodStartTime,
+		PeriodStartTime:    record.PeriodStartTime,
+		DeadlineDuration:   record.DeadlineDuration,
+		CreateAt:           record.CreateAt,
+		PrepareTimeout:     timeouts[0],
+		ConfirmTimeout:     timeouts[1],
+		CommitTimeout:      timeouts[2],
 	}
 }
 
@@ -193,7 +305,7 @@ func (pstate *ProposalState) IsNotCommitPeriod() bool        { return !pstate.Is
 func (pstate *ProposalState) IsNotFinishedPeriod() bool      { return !pstate.IsFinishedPeriod() }
 func (pstate *ProposalState) IsDeadline() bool {
 	now := uint64(timeutils.UnixMsec())
-	return (now - pstate.CreateAt) >= ProposalDeadlineDuration
+	return (now - pstate.CreateAt) >= pstate.DeadlineDuration
 }
 
 //func (pstate *ProposalState) IsFirstConfirmEpoch() bool {
@@ -223,7 +335,7 @@ func (pstate *ProposalState) IsPrepareTimeout() bool {
 	}
 
 	now := uint64(timeutils.UnixMsec())
-	duration := uint64(PrepareMsgVotingTimeout.Milliseconds())
+	duration := pstate.PrepareTimeout
 
 	// Due to the time boundary problem, the value `==`
 	if pstate.IsPreparePeriod() && (now-pstate.PeriodStartTime) >= duration {
@@ -247,7 +359,7 @@ func (pstate *ProposalState) IsConfirmTimeout() bool {
 	}
 
 	now := uint64(timeutils.UnixMsec())
-	duration := uint64(ConfirmMsgVotingTimeout.Milliseconds())
+	duration := pstate.ConfirmTimeout
 
 	if pstate.IsConfirmPeriod() && (now-pstate.PeriodStartTime) >= duration {
 		return true
@@ -267,7 +379,7 @@ func (pstate *ProposalState) IsCommitTimeout() bool {
 	}
 
 	now := uint64(timeutils.UnixMsec())
-	duration := uint64(CommitMsgEndingTimeout.Milliseconds())
+	duration := pstate.CommitTimeout
 
 	// Due to the time boundary problem, the value `==`
 	if pstate.IsCommitPeriod() && (now-pstate.PeriodStartTime) >= duration {
@@ -4,11 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"github.com/RosettaFlow/Carrier-Go/common"
 	"github.com/RosettaFlow/Carrier-Go/common/timeutils"
 	"github.com/RosettaFlow/Carrier-Go/core/rawdb"
 	"github.com/RosettaFlow/Carrier-Go/db"
+	"github.com/RosettaFlow/Carrier-Go/event"
 	"github.com/RosettaFlow/Carrier-Go/grpclient"
 	"github.com/RosettaFlow/Carrier-Go/lib/center/api"
+	pb "github.com/RosettaFlow/Carrier-Go/lib/consensus/twopc"
 	"github.com/RosettaFlow/Carrier-Go/params"
 	"github.com/RosettaFlow/Carrier-Go/types"
 	"github.com/sirupsen/logrus"
@@ -27,6 +30,10 @@ type DataCenter struct {
 
 	db db.Database // Low level persistent database to store final content.
 
+	// the stored task events are sent to the subscribers by taskEventFeed,
+	// which never blocks the storing on a slow subscriber.
+	taskEventFeed taskEventFeed
+
 	processor     Processor      // block processor interface
 	running       int32          // running must be called atomically
 	procInterrupt int32          // interrupt signaler for block processing
@@ -173,6 +180,26 @@ func (dc *DataCenter) GetRegisterNodeList(typ types.RegisteredNodeType) ([]*type
 	return rawdb.ReadAllRegisterNodes(dc.db, typ)
 }
 
+func (dc *DataCenter) StoreDrainedNode(typ types.RegisteredNodeType, id string) error {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+	rawdb.WriteDrainedNode(dc.db, typ, id)
+	return nil
+}
+
+func (dc *DataCenter) RemoveDrainedNode(typ types.RegisteredNodeType, id string) error {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+	rawdb.DeleteDrainedNode(dc.db, typ, id)
+	return nil
+}
+
+func (dc *DataCenter) GetDrainedNodeIdList(typ types.RegisteredNodeType) ([]string, error) {
+	dc.mu.RLock()
+	defer dc.mu.RUnlock()
+	return rawdb.ReadAllDrainedNodeIds(dc.db, typ)
+}
+
 // about metaData
 // on datecenter
 func (dc *DataCenter) InsertMetadata(metadata *types.Metadata) error {
@@ -768,6 +795,44 @@ func (dc *DataCenter) QueryLocalTaskPowerUseds() ([]*types.LocalTaskPowerUsed, e
 	return res, nil
 }
 
+// about OrgQuota
+func (dc *DataCenter) StoreOrgQuota(quota *types.OrgQuota) error {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+	return rawdb.StoreOrgQuota(dc.db, quota)
+}
+
+func (dc *DataCenter) RemoveOrgQuota(identityId string) error {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+	return rawdb.RemoveOrgQuota(dc.db, identityId)
+}
+
+func (dc *DataCenter) QueryOrgQuota(identityId string) (*types.OrgQuota, error) {
+	dc.mu.RLock()
+	defer dc.mu.RUnlock()
+	return rawdb.QueryOrgQuota(dc.db, identityId)
+}
+
+func (dc *DataCenter) QueryOrgQuotas() ([]*types.OrgQuota, error) {
+	dc.mu.RLock()
+	defer dc.mu.RUnlock()
+	return rawdb.QueryOrgQuotas(dc.db)
+}
+
+// about OrgDailySlotUsage
+func (dc *DataCenter) StoreOrgDailySlotUsage(usage *types.OrgDailySlotUsage) error {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+	return rawdb.StoreOrgDailySlotUsage(dc.db, usage)
+}
+
+func (dc *DataCenter) QueryOrgDailySlotUsage(identityId string) (*types.OrgDailySlotUsage, error) {
+	dc.mu.RLock()
+	defer dc.mu.RUnlock()
+	return rawdb.QueryOrgDailySlotUsage(dc.db, identityId)
+}
+
 // about DataResourceTable
 func (dc *DataCenter) StoreDataResourceTable(dataResourceTable *types.DataResourceTable) error {
 	dc.mu.Lock()
@@ -867,14 +932,130 @@ func (dc *DataCenter)  HasLocalTaskExecute(taskId string) (bool, error)  {
 }
 
 
-func (dc *DataCenter) StoreTaskEvent(event *types.TaskEventInfo) error {
+// about 2pc proposal
+func (dc *DataCenter) StoreProposalState(state *types.ProposalStateRecord) error {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+	return rawdb.WriteProposalState(dc.db, state)
+}
+func (dc *DataCenter) RemoveProposalState(proposalId common.Hash) error {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+	return rawdb.DeleteProposalState(dc.db, proposalId)
+}
+func (dc *DataCenter) QueryProposalStates() ([]*types.ProposalStateRecord, error) {
+	dc.mu.RLock()
+	defer dc.mu.RUnlock()
+	return rawdb.ReadAllProposalStates(dc.db)
+}
+
+func (dc *DataCenter) StoreProposalTask(proposalId common.Hash, task *types.Task) error {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+	return rawdb.WriteProposalTask(dc.db, proposalId, task)
+}
+func (dc *DataCenter) RemoveProposalTask(proposalId common.Hash) error {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+	return rawdb.DeleteProposalTask(dc.db, proposalId)
+}
+func (dc *DataCenter) QueryProposalTask(proposalId common.Hash) (*types.Task, error) {
+	dc.mu.RLock()
+	defer dc.mu.RUnlock()
+	return rawdb.ReadProposalTask(dc.db, proposalId)
+}
+
+func (dc *DataCenter) StoreProposalSelfPeerInfo(proposalId common.Hash, peerInfo *types.PrepareVoteResource) error {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+	return rawdb.WriteProposalSelfPeerInfo(dc.db, proposalId, peerInfo)
+}
+func (dc *DataCenter) RemoveProposalSelfPeerInfo(proposalId common.Hash) error {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+	return rawdb.DeleteProposalSelfPeerInfo(dc.db, proposalId)
+}
+func (dc *DataCenter) QueryProposalSelfPeerInfo(proposalId common.Hash) (*types.PrepareVoteResource, error) {
+	dc.mu.RLock()
+	defer dc.mu.RUnlock()
+	return rawdb.ReadProposalSelfPeerInfo(dc.db, proposalId)
+}
+
+func (dc *DataCenter) StoreProposalPrepareVote(vote *types.PrepareVote) error {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+	return rawdb.WriteProposalPrepareVote(dc.db, vote)
+}
+func (dc *DataCenter) RemoveProposalPrepareVotes(proposalId common.Hash) error {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+	return rawdb.DeleteProposalPrepareVotes(dc.db, proposalId)
+}
+func (dc *DataCenter) QueryProposalPrepareVotes(proposalId common.Hash) ([]*types.PrepareVote, error) {
+	dc.mu.RLock()
+	defer dc.mu.RUnlock()
+	return rawdb.ReadProposalPrepareVotes(dc.db, proposalId)
+}
+
+func (dc *DataCenter) StoreProposalConfirmVote(vote *types.ConfirmVote) error {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+	return rawdb.WriteProposalConfirmVote(dc.db, vote)
+}
+func (dc *DataCenter) RemoveProposalConfirmVotes(proposalId common.Hash) error {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+	return rawdb.DeleteProposalConfirmVotes(dc.db, proposalId)
+}
+func (dc *DataCenter) QueryProposalConfirmVotes(proposalId common.Hash) ([]*types.ConfirmVote, error) {
+	dc.mu.RLock()
+	defer dc.mu.RUnlock()
+	return rawdb.ReadProposalConfirmVotes(dc.db, proposalId)
+}
+
+func (dc *DataCenter) StoreProposalConfirmPeerInfo(proposalId common.Hash, peerInfo *pb.ConfirmTaskPeerInfo) error {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+	return rawdb.WriteProposalConfirmPeerInfo(dc.db, proposalId, peerInfo)
+}
+func (dc *DataCenter) RemoveProposalConfirmPeerInfo(proposalId common.Hash) error {
 	dc.mu.Lock()
 	defer dc.mu.Unlock()
+	return rawdb.DeleteProposalConfirmPeerInfo(dc.db, proposalId)
+}
+func (dc *DataCenter) QueryProposalConfirmPeerInfo(proposalId common.Hash) (*pb.ConfirmTaskPeerInfo, error) {
+	dc.mu.RLock()
+	defer dc.mu.RUnlock()
+	return rawdb.ReadProposalConfirmPeerInfo(dc.db, proposalId)
+}
+
+func (dc *DataCenter) StoreTaskEvent(event *types.TaskEventInfo) error {
+	dc.mu.Lock()
 	rawdb.WriteTaskEvent(dc.db, event)
+	dc.mu.Unlock()
 	log.Debugf("Store task eventList, event: %s", event.String())
+
+	dc.taskEventFeed.Send(event)
 	return nil
 }
 
+func (dc *DataCenter) GetAllTaskEventList() ([]*types.TaskEventInfo, error) {
+	dc.mu.RLock()
+	defer dc.mu.RUnlock()
+	return rawdb.ReadAllTaskEvents(dc.db)
+}
+
+// SubscribeTaskEvent registers a subscription of the task events which are stored after that.
+func (dc *DataCenter) SubscribeTaskEvent(ch chan<- *types.TaskEventInfo) event.Subscription {
+	return dc.taskEventFeed.Subscribe(ch)
+}
+
+// PublishNodeEvent sends the event of registered node to the subscriptions of task events,
+// the event is not related to any task, so it is not stored.
+func (dc *DataCenter) PublishNodeEvent(event *types.TaskEventInfo) {
+	dc.taskEventFeed.Send(event)
+}
+
 func (dc *DataCenter) GetTaskEventList(taskId string) ([]*types.TaskEventInfo, error) {
 	dc.mu.RLock()
 	defer dc.mu.RUnlock()
@@ -896,8 +1077,114 @@ func (dc *DataCenter) RemoveTaskEventList(taskId string) error  {
 	return nil
 }
 
+func (dc *DataCenter) StoreTaskResourceUsage(usage *types.TaskResourceUsage) error {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+	rawdb.WriteTaskResourceUsage(dc.db, usage)
+	log.Debugf("Store task resource usage, usage: %s", usage.String())
+	return nil
+}
+
+func (dc *DataCenter) GetTaskResourceUsageList(taskId string) ([]*types.TaskResourceUsage, error) {
+	dc.mu.RLock()
+	defer dc.mu.RUnlock()
+	return rawdb.ReadTaskResourceUsages(dc.db, taskId)
+}
+
+func (dc *DataCenter) StoreTaskProgress(progress *types.TaskProgress) error {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+	rawdb.WriteTaskProgress(dc.db, progress)
+	return nil
+}
+
+func (dc *DataCenter) GetTaskProgressList(taskId string) ([]*types.TaskProgress, error) {
+	dc.mu.RLock()
+	defer dc.mu.RUnlock()
+	return rawdb.ReadTaskProgressList(dc.db, taskId)
+}
+
+func (dc *DataCenter) RemoveTaskProgressList(taskId string) error {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+	rawdb.DeleteTaskProgressList(dc.db, taskId)
+	return nil
+}
+
+func (dc *DataCenter) StoreWorkflow(workflow *types.Workflow) error {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+	rawdb.WriteWorkflow(dc.db, workflow)
+	return nil
+}
+
+func (dc *DataCenter) GetWorkflow(workflowId string) (*types.Workflow, error) {
+	dc.mu.RLock()
+	defer dc.mu.RUnlock()
+	return rawdb.ReadWorkflow(dc.db, workflowId)
+}
+
+func (dc *DataCenter) GetWorkflowList() ([]*types.Workflow, error) {
+	dc.mu.RLock()
+	defer dc.mu.RUnlock()
+	return rawdb.ReadAllWorkflows(dc.db)
+}
+
+// StoreScheduleTaskBullets writes the states of the queued tasks in one batch.
+func (dc *DataCenter) StoreScheduleTaskBullets(records []*types.TaskBulletRecord) error {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+	batch := dc.db.NewBatch()
+	for _, record := range records {
+		rawdb.WriteScheduleTaskBullet(batch, record)
+	}
+	return batch.Write()
+}
+
+func (dc *DataCenter) RemoveScheduleTaskBullet(taskId string) error {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+	rawdb.DeleteScheduleTaskBullet(dc.db, taskId)
+	return nil
+}
+
+func (dc *DataCenter) GetScheduleTaskBulletList() ([]*types.TaskBulletRecord, error) {
+	dc.mu.RLock()
+	defer dc.mu.RUnlock()
+	return rawdb.ReadAllScheduleTaskBullets(dc.db)
+}
+
+func (dc *DataCenter) StorePendingTaskRetry(record *types.TaskRetryRecord) error {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+	rawdb.WritePendingTaskRetry(dc.db, record)
+	return nil
+}
+
+func (dc *DataCenter) RemovePendingTaskRetry(taskId string) error {
+	dc.mu.Lock()
+	defer dc.mu.Unlock()
+	rawdb.DeletePendingTaskRetry(dc.db, taskId)
+	return nil
+}
+
+func (dc *DataCenter) GetPendingTaskRetryList() ([]*types.TaskRetryRecord, error) {
+	dc.mu.RLock()
+	defer dc.mu.RUnlock()
+	return rawdb.ReadAllPendingTaskRetries(dc.db)
+}
+
 // ****************************************************************************************************************
 
+// BackupDatabase writes a consistent snapshot of the local database into the backup file.
+func (dc *DataCenter) BackupDatabase(file string) (int, error) {
+	backuper, ok := dc.db.(db.Backuper)
+	if !ok {
+		return 0, errors.New("the database does not support backup")
+	}
+	return db.BackupFile(backuper, file)
+}
+
 func (dc *DataCenter) Stop() {
 	if !atomic.CompareAndSwapInt32(&dc.running, 0, 1) {
 		return
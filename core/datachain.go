@@ -2,6 +2,7 @@ package core
 
 import (
 	"context"
+	"fmt"
 	"github.com/RosettaFlow/Carrier-Go/common"
 	"github.com/RosettaFlow/Carrier-Go/core/rawdb"
 	"github.com/RosettaFlow/Carrier-Go/db"
@@ -53,6 +54,14 @@ func NewDataChain(ctx context.Context, db db.Database, chainConfig *params.DataC
 		bodyCache:   bodyCache,
 		bodyPbCache: bodyPbCache,
 	}
+	// restore the head of the data chain which was written before restarted.
+	if head := rawdb.ReadHeadBlockHash(db); head != (common.Hash{}) {
+		block := dc.GetBlockByHash(head)
+		if block == nil {
+			return nil, fmt.Errorf("missing the head block of datachain, hash: %s", head.String())
+		}
+		dc.currentBlock.Store(block)
+	}
 	return dc, nil
 }
 
@@ -87,9 +96,10 @@ func (dc *DataChain) HasBlock(hash common.Hash, number uint64) bool {
 	return rawdb.HasBody(dc.db, hash, number)
 }
 
+// CurrentBlock retrieves the current head block of the data chain, nil if the chain is empty.
 func (dc *DataChain) CurrentBlock() *types.Block {
-	// convert type
-	return dc.currentBlock.Load().(*types.Block)
+	block, _ := dc.currentBlock.Load().(*types.Block)
+	return block
 }
 
 // GetBodyPb retrieves a block body in PB encoding from the database by hash, caching it if found
@@ -163,10 +173,37 @@ func (dc *DataChain) GetBlockByNumber(number uint64) *types.Block {
 	return dc.GetBlock(hash, number)
 }
 
-// InsertChain saves the data of block to the database.
+// InsertData appends the blocks to the head of the data chain in order, and returns
+// the count of the inserted blocks. The first block of an empty chain must be
+// numbered 0 with an empty parent hash, every other block must extend the current head.
 func (dc *DataChain) InsertData(blocks types.Blocks) (int, error) {
-	// todo: implements by datacenter
-	return 0, nil
+	dc.chainmu.Lock()
+	defer dc.chainmu.Unlock()
+
+	for i, block := range blocks {
+		var (
+			number uint64
+			parent common.Hash
+		)
+		if head := dc.CurrentBlock(); head != nil {
+			number, parent = head.NumberU64()+1, head.Hash()
+		}
+		if block.NumberU64() != number || block.ParentHash() != parent {
+			return i, fmt.Errorf("non contiguous insert, number: %d, parent: %s, want number: %d, parent: %s",
+				block.NumberU64(), block.ParentHash().String(), number, parent.String())
+		}
+		batch := dc.db.NewBatch()
+		rawdb.WriteBlock(batch, block)
+		rawdb.WriteDataHash(batch, block.Hash(), block.NumberU64())
+		rawdb.WriteHeadHeaderHash(batch, block.Hash())
+		rawdb.WriteHeadBlockHash(batch, block.Hash())
+		if err := batch.Write(); err != nil {
+			return i, err
+		}
+		dc.blockCache.Add(block.Hash(), block)
+		dc.currentBlock.Store(block)
+	}
+	return len(blocks), nil
 }
 
 // TODO 本地存储event事件
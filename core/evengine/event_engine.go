@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"github.com/RosettaFlow/Carrier-Go/common/timeutils"
 	"github.com/RosettaFlow/Carrier-Go/core/iface"
+	"github.com/RosettaFlow/Carrier-Go/event"
 	"github.com/RosettaFlow/Carrier-Go/types"
 )
 
@@ -34,6 +35,10 @@ func  (e *EventEngine) StoreEvent(event *types.TaskEventInfo) {
 	}
 	return
 }
+// SubscribeEvent registers a subscription of the task events stored after that.
+func  (e *EventEngine) SubscribeEvent(ch chan<- *types.TaskEventInfo) event.Subscription {
+	return e.dataCenter.SubscribeTaskEvent(ch)
+}
 func  (e *EventEngine) GetTaskEventList(taskId string) ([]*types.TaskEventInfo, error) {
 	return e.dataCenter.GetTaskEventList(taskId)
 }
@@ -49,17 +49,42 @@ var (
 	TaskFailed                 = NewEventType("0100004", "The task was failed")
 	TaskSucceed                = NewEventType("0100005", "The task was succeed")
 	TaskResourceElectionFailed = NewEventType("0100006", "The resource of task was failed on election")
+	TaskCancelled              = NewEventType("0100007", "The task was cancelled")
+	TaskResourceUsageExceeded  = NewEventType("0100008", "The resource usage of task exceeded the declared cost")
+	TaskRetried                = NewEventType("0100009", "The task was retried with a new attempt")
+	TaskRetryAttempt           = NewEventType("0100010", "The task is a retry attempt of the failed task")
+	TaskPriorityDowngraded     = NewEventType("0100011", "The priority of task was downgraded by the cap of identity")
+	TaskDeadlineMissed         = NewEventType("0100012", "The task was discarded for it can not start before its deadline")
 	TaskStartConsensus         = NewEventType("0101001", "The task was started to consensus")
 	TaskFailedConsensus        = NewEventType("0101002", "The task was failed to consensus")
 	TaskProposalStateDeadline  = NewEventType("0101003", "The task proposalState was deadline")
 )
 
+// 工作流事件
+var (
+	WorkflowCreated       = NewEventType("0102000", "The workflow was created")
+	WorkflowTaskPublished = NewEventType("0102001", "The task of workflow was published")
+	WorkflowTaskRetried   = NewEventType("0102002", "The task of workflow was retried with a new attempt")
+	WorkflowTaskSucceed   = NewEventType("0102003", "The task of workflow was succeed")
+	WorkflowTaskFailed    = NewEventType("0102004", "The task of workflow was failed")
+	WorkflowTaskCancelled = NewEventType("0102005", "The task of workflow was cancelled")
+	WorkflowSucceed       = NewEventType("0102006", "The workflow was succeed")
+	WorkflowFailed        = NewEventType("0102007", "The workflow was failed")
+	WorkflowCancelled     = NewEventType("0102008", "The workflow was cancelled")
+)
+
 var ScheduleEvent = map[string]string{
 	TaskCreate.Type:          TaskCreate.Msg,
 	TaskNeedRescheduled.Type: TaskNeedRescheduled.Msg,
 	TaskDiscarded.Type:       TaskDiscarded.Msg,
 	TaskFailed.Type:          TaskFailed.Msg,
 	TaskSucceed.Type:         TaskSucceed.Msg,
+	TaskCancelled.Type:       TaskCancelled.Msg,
+	TaskResourceUsageExceeded.Type: TaskResourceUsageExceeded.Msg,
+	TaskRetried.Type:               TaskRetried.Msg,
+	TaskRetryAttempt.Type:          TaskRetryAttempt.Msg,
+	TaskPriorityDowngraded.Type:    TaskPriorityDowngraded.Msg,
+	TaskDeadlineMissed.Type:        TaskDeadlineMissed.Msg,
 	TaskStartConsensus.Type:  TaskStartConsensus.Msg,
 	TaskFailedConsensus.Type: TaskFailedConsensus.Msg,
 }
@@ -73,6 +98,18 @@ func MakeScheduleEventInfo(event *types.TaskEventInfo) (*types.TaskEventInfo, er
 	return event, IncEventType
 }
 
+// 节点健康事件 (只推送给订阅方, 不存储)
+var (
+	DataNodeHealthUnknown = NewEventType("0200000", "The health of dataNode is unknown")
+	DataNodeHealthy       = NewEventType("0200001", "The dataNode became healthy")
+	DataNodeUnhealthy     = NewEventType("0200002", "The dataNode became unhealthy")
+	DataNodeDrained       = NewEventType("0200003", "The dataNode was drained")
+	JobNodeHealthUnknown  = NewEventType("0300000", "The health of jobNode is unknown")
+	JobNodeHealthy        = NewEventType("0300001", "The jobNode became healthy")
+	JobNodeUnhealthy      = NewEventType("0300002", "The jobNode became unhealthy")
+	JobNodeDrained        = NewEventType("0300003", "The jobNode was drained")
+)
+
 // 数据服务事件
 var (
 	SourceUpLoadSucceed   = NewEventType("0207000", "Source data uploaded successfully.")
@@ -1,7 +1,10 @@
 package iface
 
 import (
+	"github.com/RosettaFlow/Carrier-Go/common"
+	"github.com/RosettaFlow/Carrier-Go/event"
 	"github.com/RosettaFlow/Carrier-Go/lib/center/api"
+	pb "github.com/RosettaFlow/Carrier-Go/lib/consensus/twopc"
 	"github.com/RosettaFlow/Carrier-Go/types"
 )
 
@@ -15,6 +18,10 @@ type LocalStoreCarrierDB interface {
 	DeleteRegisterNode(typ types.RegisteredNodeType, id string) error
 	GetRegisterNode(typ types.RegisteredNodeType, id string) (*types.RegisteredNodeInfo, error)
 	GetRegisterNodeList(typ types.RegisteredNodeType) ([]*types.RegisteredNodeInfo, error)
+	// about the registered nodes drained by operator (nodeType + nodeId -> drained)
+	StoreDrainedNode(typ types.RegisteredNodeType, id string) error
+	RemoveDrainedNode(typ types.RegisteredNodeType, id string) error
+	GetDrainedNodeIdList(typ types.RegisteredNodeType) ([]string, error)
 
 	InsertLocalResource(resource *types.LocalResource) error
 	RemoveLocalResource(jobNodeId string) error
@@ -47,6 +54,14 @@ type LocalStoreCarrierDB interface {
 	RemoveLocalTaskPowerUsed(taskId string) error
 	QueryLocalTaskPowerUsed(taskId string) (*types.LocalTaskPowerUsed, error)
 	QueryLocalTaskPowerUseds() ([]*types.LocalTaskPowerUsed, error)
+	// about OrgQuota (identityId -> {identityId, maxConcurrentTasks, maxSlots, maxDailySlotHours})
+	StoreOrgQuota(quota *types.OrgQuota) error
+	RemoveOrgQuota(identityId string) error
+	QueryOrgQuota(identityId string) (*types.OrgQuota, error)
+	QueryOrgQuotas() ([]*types.OrgQuota, error)
+	// about OrgDailySlotUsage (identityId -> {identityId, day, slotMsec})
+	StoreOrgDailySlotUsage(usage *types.OrgDailySlotUsage) error
+	QueryOrgDailySlotUsage(identityId string) (*types.OrgDailySlotUsage, error)
 	// resourceTaskIds Mapping (jobNodeId -> [taskId, taskId, ..., taskId])
 	StoreJobNodeRunningTaskId(jobNodeId, taskId string) error
 	RemoveJobNodeRunningTaskId(jobNodeId, taskId string) error
@@ -72,6 +87,25 @@ type LocalStoreCarrierDB interface {
 	StoreLocalTaskExecuteStatus(taskId string) error
 	RemoveLocalTaskExecuteStatus(taskId string) error
 	HasLocalTaskExecute(taskId string) (bool, error)
+	// about 2pc proposal (proposalId -> {proposalState, task, selfPeerInfo, prepareVotes, confirmVotes, confirmPeerInfo})
+	StoreProposalState(state *types.ProposalStateRecord) error
+	RemoveProposalState(proposalId common.Hash) error
+	QueryProposalStates() ([]*types.ProposalStateRecord, error)
+	StoreProposalTask(proposalId common.Hash, task *types.Task) error
+	RemoveProposalTask(proposalId common.Hash) error
+	QueryProposalTask(proposalId common.Hash) (*types.Task, error)
+	StoreProposalSelfPeerInfo(proposalId common.Hash, peerInfo *types.PrepareVoteResource) error
+	RemoveProposalSelfPeerInfo(proposalId common.Hash) error
+	QueryProposalSelfPeerInfo(proposalId common.Hash) (*types.PrepareVoteResource, error)
+	StoreProposalPrepareVote(vote *types.PrepareVote) error
+	RemoveProposalPrepareVotes(proposalId common.Hash) error
+	QueryProposalPrepareVotes(proposalId common.Hash) ([]*types.PrepareVote, error)
+	StoreProposalConfirmVote(vote *types.ConfirmVote) error
+	RemoveProposalConfirmVotes(proposalId common.Hash) error
+	QueryProposalConfirmVotes(proposalId common.Hash) ([]*types.ConfirmVote, error)
+	StoreProposalConfirmPeerInfo(proposalId common.Hash, peerInfo *pb.ConfirmTaskPeerInfo) error
+	RemoveProposalConfirmPeerInfo(proposalId common.Hash) error
+	QueryProposalConfirmPeerInfo(proposalId common.Hash) (*pb.ConfirmTaskPeerInfo, error)
 }
 
 type MetadataCarrierDB interface {
@@ -103,7 +137,26 @@ type IdentityCarrierDB interface {
 type TaskCarrierDB interface {
 	StoreTaskEvent(event *types.TaskEventInfo) error
 	GetTaskEventList(taskId string) ([]*types.TaskEventInfo, error)
+	GetAllTaskEventList() ([]*types.TaskEventInfo, error)
+	SubscribeTaskEvent(ch chan<- *types.TaskEventInfo) event.Subscription
+	PublishNodeEvent(event *types.TaskEventInfo)
 	RemoveTaskEventList(taskId string) error
+	StoreTaskResourceUsage(usage *types.TaskResourceUsage) error
+	GetTaskResourceUsageList(taskId string) ([]*types.TaskResourceUsage, error)
+	StoreTaskProgress(progress *types.TaskProgress) error
+	GetTaskProgressList(taskId string) ([]*types.TaskProgress, error)
+	RemoveTaskProgressList(taskId string) error
+	StoreWorkflow(workflow *types.Workflow) error
+	GetWorkflow(workflowId string) (*types.Workflow, error)
+	GetWorkflowList() ([]*types.Workflow, error)
+	// about the local tasks waiting on the queue of scheduler (taskId -> {taskId, powerPartyIds, starve, term, resched, priority, enqueueAt})
+	StoreScheduleTaskBullets(records []*types.TaskBulletRecord) error
+	RemoveScheduleTaskBullet(taskId string) error
+	GetScheduleTaskBulletList() ([]*types.TaskBulletRecord, error)
+	// about the next attempts of the failed local tasks waiting for their backoff (taskId -> {taskId, powerPartyIds, requeueAt})
+	StorePendingTaskRetry(record *types.TaskRetryRecord) error
+	RemovePendingTaskRetry(taskId string) error
+	GetPendingTaskRetryList() ([]*types.TaskRetryRecord, error)
 	StoreLocalTask(task *types.Task) error
 	RemoveLocalTask(taskId string) error
 	//UpdateLocalTaskState(taskId, state string) error // 任务的状态 (pending: 等在中; running: 计算中; failed: 失败; success: 成功)
@@ -12,5 +12,6 @@ type CarrierDB interface {
 	iface.IdentityCarrierDB
 	iface.TaskCarrierDB
 	InsertData(blocks types.Blocks) (int, error)
+	BackupDatabase(file string) (int, error)
 	Stop()
 }
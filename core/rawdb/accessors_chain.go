@@ -48,6 +48,25 @@ func ReadAllHashes(db db.Iteratee, number uint64) []common.Hash {
 	return hashes
 }
 
+// ReadDatabaseVersion retrieves the version number of the database.
+func ReadDatabaseVersion(db DatabaseReader) *uint64 {
+	data, _ := db.Get(databaseVersionKey)
+	if len(data) != 8 {
+		return nil
+	}
+	version := binary.BigEndian.Uint64(data)
+	return &version
+}
+
+// WriteDatabaseVersion stores the version number of the database
+func WriteDatabaseVersion(db DatabaseWriter, version uint64) {
+	enc := make([]byte, 8)
+	binary.BigEndian.PutUint64(enc, version)
+	if err := db.Put(databaseVersionKey, enc); err != nil {
+		log.WithError(err).Fatal("Failed to store the database version")
+	}
+}
+
 // ReadHeaderNumber returns the header number assigned to a hash.
 func ReadHeaderNumber(db DatabaseReader, hash common.Hash) *uint64 {
 	data, _ := db.Get(headerNumberKey(hash))
@@ -112,11 +131,10 @@ func ReadHeader(db DatabaseReader, hash common.Hash, number uint64) *types.Heade
 	}
 	header := new(libTypes.HeaderPb)
 	if err := header.Unmarshal(data); err != nil {
-		log.WithField("hash", hash).WithError(err).Fatal("Invalid block header ProtoBuf")
+		log.WithField("hash", hash).WithError(err).Error("Invalid block header ProtoBuf")
 		return nil
 	}
-	// todo: To be continued..
-	return nil
+	return (*types.Header)(header)
 }
 
 // WriteHeader stores a block header into the database and also stores the hash-to-number mapping.
@@ -130,15 +148,14 @@ func WriteHeader(db DatabaseWriter, header *types.Header) {
 	if err := db.Put(key, encoded); err != nil {
 		log.WithError(err).Fatal("Failed to store hash to number mapping")
 	}
-	// todo: To be continued..
-	//data, err := header.Marshal()
-	//if err != nil {
-	//	log.WithError(err).Error("Failed to PB encode header")
-	//}
-	//key  = headerKey(number, hash)
-	//if err := db.Put(key, data); err != nil {
-	//	log.WithError(err).Error("Failed to store header")
-	//}
+	data, err := header.GetHeaderPb().Marshal()
+	if err != nil {
+		log.WithError(err).Fatal("Failed to PB encode header")
+	}
+	key = headerKey(number, hash)
+	if err := db.Put(key, data); err != nil {
+		log.WithError(err).Fatal("Failed to store header")
+	}
 }
 
 // DeleteHeader remove all block header data associated with a hash.
@@ -205,6 +222,8 @@ func DeleteBody(db DatabaseDeleter, hash common.Hash, number uint64) {
 	// todo: remove metadata/resource/identity/task at the same time.
 }
 
+// ReadBlock retrieves an entire block corresponding to the hash, assembling it
+// back from the stored header and body.
 func ReadBlock(db DatabaseReader, hash common.Hash, number uint64) *types.Block {
 	header := ReadHeader(db, hash , number)
 	if header == nil {
@@ -214,19 +233,60 @@ func ReadBlock(db DatabaseReader, hash common.Hash, number uint64) *types.Block
 	if body == nil {
 		return nil
 	}
-	// todo: generated block by header and body.
-	// return types.NewBlockWithHeader(header).WithBody(body.Transactions, body.ExtraData)
-	return nil
+	return types.NewBlockWithHeader(header).WithBody(
+		types.NewMetadataArray(body.Metadata),
+		types.NewResourceArray(body.Resourcedata),
+		types.NewIdentityArray(body.Identitydata),
+		types.NewTaskDataArray(body.Taskdata),
+		body.ExtraData)
 }
 
 // WriteBlock serializes a block into the database, header and body separately.
-func WriteBlock(db DatabaseWriter, block *libTypes.BlockData) {
-	//WriteBody(db, block.Hash(), block.NumberU64(), block.Body())
-	//WriteHeader(db, &block.Header)
+func WriteBlock(db DatabaseWriter, block *types.Block) {
+	WriteBody(db, block.Hash(), block.NumberU64(), block.Body())
+	WriteHeader(db, block.Header())
 }
 
 // DeleteBlock removes all block data associated with a hash.
 func DeleteBlock(db DatabaseDeleter, hash common.Hash, number uint64) {
 	DeleteHeader(db, hash, number)
 	DeleteBody(db, hash, number)
-}
\ No newline at end of file
+}
+
+// ReadLedgerTaskIndex retrieves the numbers of the ledger blocks which record the consensus of the task.
+func ReadLedgerTaskIndex(db DatabaseReader, taskId string) []uint64 {
+	data, _ := db.Get(ledgerTaskIndexKey(taskId))
+	numbers := make([]uint64, 0, len(data)/8)
+	for i := 0; i+8 <= len(data); i += 8 {
+		numbers = append(numbers, binary.BigEndian.Uint64(data[i:i+8]))
+	}
+	return numbers
+}
+
+// WriteLedgerTaskIndex stores the numbers of the ledger blocks which record the consensus of the task.
+func WriteLedgerTaskIndex(db DatabaseWriter, taskId string, numbers []uint64) {
+	data := make([]byte, 0, len(numbers)*8)
+	for _, number := range numbers {
+		data = append(data, encodeNumber(number)...)
+	}
+	if err := db.Put(ledgerTaskIndexKey(taskId), data); err != nil {
+		log.WithError(err).Fatal("Failed to store ledger task index")
+	}
+}
+
+// ReadLedgerIndexHead retrieves the number of the last ledger block which has been indexed.
+func ReadLedgerIndexHead(db DatabaseReader) *uint64 {
+	data, _ := db.Get(ledgerIndexHeadKey)
+	if len(data) != 8 {
+		return nil
+	}
+	number := binary.BigEndian.Uint64(data)
+	return &number
+}
+
+// WriteLedgerIndexHead stores the number of the last ledger block which has been indexed.
+func WriteLedgerIndexHead(db DatabaseWriter, number uint64) {
+	if err := db.Put(ledgerIndexHeadKey, encodeNumber(number)); err != nil {
+		log.WithError(err).Fatal("Failed to store ledger index head")
+	}
+}
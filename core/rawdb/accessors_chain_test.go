@@ -0,0 +1,52 @@
+package rawdb
+
+import (
+	"github.com/RosettaFlow/Carrier-Go/common"
+	"github.com/RosettaFlow/Carrier-Go/db"
+	libTypes "github.com/RosettaFlow/Carrier-Go/lib/types"
+	"github.com/RosettaFlow/Carrier-Go/types"
+	"gotest.tools/assert"
+	"testing"
+)
+
+func TestBlock(t *testing.T) {
+	database := db.NewMemoryDatabase()
+	header := &types.Header{
+		ParentHash: common.BytesToHash([]byte("parent")).Bytes(),
+		Version:    3,
+		Timestamp:  1000,
+		Extra:      []byte("extra"),
+	}
+	task := types.NewTask(&libTypes.TaskData{TaskId: "taskID-01", TaskName: "task"})
+	block := types.NewBlockWithHeader(header).WithBody(nil, nil, nil, []*types.Task{task}, []byte("extraData"))
+	assert.Assert(t, ReadBlock(database, block.Hash(), block.NumberU64()) == nil)
+
+	WriteBlock(database, block)
+	assert.Assert(t, HasHeader(database, block.Hash(), block.NumberU64()))
+	assert.Assert(t, HasBody(database, block.Hash(), block.NumberU64()))
+	assert.Equal(t, *ReadHeaderNumber(database, block.Hash()), block.NumberU64())
+
+	stored := ReadBlock(database, block.Hash(), block.NumberU64())
+	assert.Assert(t, stored != nil)
+	assert.Equal(t, stored.Hash(), block.Hash())
+	assert.Equal(t, stored.ParentHash(), block.ParentHash())
+	assert.DeepEqual(t, stored.ExtraData(), block.ExtraData())
+	assert.Assert(t, len(stored.TaskDatas()) == 1)
+	assert.Equal(t, stored.TaskDatas()[0].TaskId(), task.TaskId())
+
+	DeleteBlock(database, block.Hash(), block.NumberU64())
+	assert.Assert(t, ReadBlock(database, block.Hash(), block.NumberU64()) == nil)
+	assert.Assert(t, ReadHeaderNumber(database, block.Hash()) == nil)
+}
+
+func TestLedgerIndex(t *testing.T) {
+	database := db.NewMemoryDatabase()
+	assert.Assert(t, ReadLedgerIndexHead(database) == nil)
+	assert.Equal(t, len(ReadLedgerTaskIndex(database, "taskID-01")), 0)
+
+	WriteLedgerTaskIndex(database, "taskID-01", []uint64{0, 3, 256})
+	WriteLedgerIndexHead(database, 256)
+	assert.DeepEqual(t, ReadLedgerTaskIndex(database, "taskID-01"), []uint64{0, 3, 256})
+	assert.Equal(t, len(ReadLedgerTaskIndex(database, "taskID-02")), 0)
+	assert.Equal(t, *ReadLedgerIndexHead(database), uint64(256))
+}
@@ -0,0 +1,202 @@
+// Copyright (C) 2021 The RosettaNet Authors.
+
+package rawdb
+
+import (
+	"fmt"
+	"github.com/RosettaFlow/Carrier-Go/common"
+	pb "github.com/RosettaFlow/Carrier-Go/lib/consensus/twopc"
+	libtypes "github.com/RosettaFlow/Carrier-Go/lib/types"
+	"github.com/RosettaFlow/Carrier-Go/types"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// WriteProposalState serializes the proposalState of 2pc into the database.
+func WriteProposalState(db DatabaseWriter, state *types.ProposalStateRecord) error {
+	val, err := rlp.EncodeToBytes(state)
+	if nil != err {
+		return err
+	}
+	return db.Put(proposalStateKey(state.ProposalId), val)
+}
+
+// DeleteProposalState deletes the proposalState of 2pc from the database with a special proposalId.
+func DeleteProposalState(db DatabaseDeleter, proposalId common.Hash) error {
+	return db.Delete(proposalStateKey(proposalId))
+}
+
+// ReadAllProposalStates retrieves all the proposalState of 2pc in the database,
+// any broken proposalState fails the whole reading.
+func ReadAllProposalStates(db KeyValueStore) ([]*types.ProposalStateRecord, error) {
+	it := db.NewIteratorWithPrefixAndStart(proposalStatePrefix, nil)
+	defer it.Release()
+
+	arr := make([]*types.ProposalStateRecord, 0)
+	for it.Next() {
+		var state types.ProposalStateRecord
+		if err := rlp.DecodeBytes(it.Value(), &state); nil != err {
+			return nil, fmt.Errorf("decode proposalState failed, key: {%x}, %s", it.Key(), err)
+		}
+		arr = append(arr, &state)
+	}
+	if err := it.Error(); nil != err {
+		return nil, err
+	}
+	return arr, nil
+}
+
+// WriteProposalTask serializes the task of 2pc proposal into the database.
+func WriteProposalTask(db DatabaseWriter, proposalId common.Hash, task *types.Task) error {
+	val, err := task.TaskData().Marshal()
+	if nil != err {
+		return err
+	}
+	return db.Put(proposalTaskKey(proposalId), val)
+}
+
+// DeleteProposalTask deletes the task of 2pc proposal from the database with a special proposalId.
+func DeleteProposalTask(db DatabaseDeleter, proposalId common.Hash) error {
+	return db.Delete(proposalTaskKey(proposalId))
+}
+
+// ReadProposalTask retrieves the task of 2pc proposal with the corresponding proposalId.
+func ReadProposalTask(db DatabaseReader, proposalId common.Hash) (*types.Task, error) {
+	blob, err := readProposalItem(db, proposalTaskKey(proposalId))
+	if nil != err {
+		return nil, err
+	}
+	var task libtypes.TaskData
+	if err := task.Unmarshal(blob); nil != err {
+		return nil, err
+	}
+	return types.NewTask(&task), nil
+}
+
+// WriteProposalSelfPeerInfo serializes the self peerInfo of 2pc proposal into the database.
+func WriteProposalSelfPeerInfo(db DatabaseWriter, proposalId common.Hash, peerInfo *types.PrepareVoteResource) error {
+	val, err := rlp.EncodeToBytes(peerInfo)
+	if nil != err {
+		return err
+	}
+	return db.Put(proposalSelfPeerInfoKey(proposalId), val)
+}
+
+// DeleteProposalSelfPeerInfo deletes the self peerInfo of 2pc proposal from the database with a special proposalId.
+func DeleteProposalSelfPeerInfo(db DatabaseDeleter, proposalId common.Hash) error {
+	return db.Delete(proposalSelfPeerInfoKey(proposalId))
+}
+
+// ReadProposalSelfPeerInfo retrieves the self peerInfo of 2pc proposal with the corresponding proposalId.
+func ReadProposalSelfPeerInfo(db DatabaseReader, proposalId common.Hash) (*types.PrepareVoteResource, error) {
+	blob, err := readProposalItem(db, proposalSelfPeerInfoKey(proposalId))
+	if nil != err {
+		return nil, err
+	}
+	var peerInfo types.PrepareVoteResource
+	if err := rlp.DecodeBytes(blob, &peerInfo); nil != err {
+		return nil, err
+	}
+	return &peerInfo, nil
+}
+
+// WriteProposalPrepareVote appends the prepareVote into the prepareVote list of 2pc proposal.
+func WriteProposalPrepareVote(db KeyValueStore, vote *types.PrepareVote) error {
+	votes, err := ReadProposalPrepareVotes(db, vote.ProposalId)
+	if nil != err && !IsDBNotFoundErr(err) {
+		return err
+	}
+	votes = append(votes, vote)
+	val, err := rlp.EncodeToBytes(votes)
+	if nil != err {
+		return err
+	}
+	return db.Put(proposalPrepareVoteKey(vote.ProposalId), val)
+}
+
+// DeleteProposalPrepareVotes deletes the prepareVote list of 2pc proposal from the database with a special proposalId.
+func DeleteProposalPrepareVotes(db DatabaseDeleter, proposalId common.Hash) error {
+	return db.Delete(proposalPrepareVoteKey(proposalId))
+}
+
+// ReadProposalPrepareVotes retrieves the prepareVote list of 2pc proposal with the corresponding proposalId.
+func ReadProposalPrepareVotes(db DatabaseReader, proposalId common.Hash) ([]*types.PrepareVote, error) {
+	blob, err := readProposalItem(db, proposalPrepareVoteKey(proposalId))
+	if nil != err {
+		return nil, err
+	}
+	var votes []*types.PrepareVote
+	if err := rlp.DecodeBytes(blob, &votes); nil != err {
+		return nil, err
+	}
+	return votes, nil
+}
+
+// WriteProposalConfirmVote appends the confirmVote into the confirmVote list of 2pc proposal.
+func WriteProposalConfirmVote(db KeyValueStore, vote *types.ConfirmVote) error {
+	votes, err := ReadProposalConfirmVotes(db, vote.ProposalId)
+	if nil != err && !IsDBNotFoundErr(err) {
+		return err
+	}
+	votes = append(votes, vote)
+	val, err := rlp.EncodeToBytes(votes)
+	if nil != err {
+		return err
+	}
+	return db.Put(proposalConfirmVoteKey(vote.ProposalId), val)
+}
+
+// DeleteProposalConfirmVotes deletes the confirmVote list of 2pc proposal from the database with a special proposalId.
+func DeleteProposalConfirmVotes(db DatabaseDeleter, proposalId common.Hash) error {
+	return db.Delete(proposalConfirmVoteKey(proposalId))
+}
+
+// ReadProposalConfirmVotes retrieves the confirmVote list of 2pc proposal with the corresponding proposalId.
+func ReadProposalConfirmVotes(db DatabaseReader, proposalId common.Hash) ([]*types.ConfirmVote, error) {
+	blob, err := readProposalItem(db, proposalConfirmVoteKey(proposalId))
+	if nil != err {
+		return nil, err
+	}
+	var votes []*types.ConfirmVote
+	if err := rlp.DecodeBytes(blob, &votes); nil != err {
+		return nil, err
+	}
+	return votes, nil
+}
+
+// WriteProposalConfirmPeerInfo serializes the confirm peerInfo of 2pc proposal into the database.
+func WriteProposalConfirmPeerInfo(db DatabaseWriter, proposalId common.Hash, peerInfo *pb.ConfirmTaskPeerInfo) error {
+	val, err := peerInfo.Marshal()
+	if nil != err {
+		return err
+	}
+	return db.Put(proposalConfirmPeerInfoKey(proposalId), val)
+}
+
+// DeleteProposalConfirmPeerInfo deletes the confirm peerInfo of 2pc proposal from the database with a special proposalId.
+func DeleteProposalConfirmPeerInfo(db DatabaseDeleter, proposalId common.Hash) error {
+	return db.Delete(proposalConfirmPeerInfoKey(proposalId))
+}
+
+// ReadProposalConfirmPeerInfo retrieves the confirm peerInfo of 2pc proposal with the corresponding proposalId.
+func ReadProposalConfirmPeerInfo(db DatabaseReader, proposalId common.Hash) (*pb.ConfirmTaskPeerInfo, error) {
+	blob, err := readProposalItem(db, proposalConfirmPeerInfoKey(proposalId))
+	if nil != err {
+		return nil, err
+	}
+	var peerInfo pb.ConfirmTaskPeerInfo
+	if err := peerInfo.Unmarshal(blob); nil != err {
+		return nil, err
+	}
+	return &peerInfo, nil
+}
+
+func readProposalItem(db DatabaseReader, key []byte) ([]byte, error) {
+	has, err := db.Has(key)
+	if IsNoDBNotFoundErr(err) {
+		return nil, err
+	}
+	if !has {
+		return nil, ErrNotFound
+	}
+	return db.Get(key)
+}
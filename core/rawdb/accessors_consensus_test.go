@@ -0,0 +1,106 @@
+package rawdb
+
+import (
+	"github.com/RosettaFlow/Carrier-Go/common"
+	"github.com/RosettaFlow/Carrier-Go/db"
+	"github.com/RosettaFlow/Carrier-Go/types"
+	"github.com/ethereum/go-ethereum/rlp"
+	"gotest.tools/assert"
+	"testing"
+)
+
+func TestProposalState(t *testing.T) {
+	database := db.NewMemoryDatabase()
+	proposalId := common.BytesToHash([]byte("proposalId-01"))
+	state := &types.ProposalStateRecord{
+		ProposalId: proposalId,
+		TaskDir:    types.RecvTaskDir,
+		TaskRole:   types.PowerSupplier,
+		SelfIdentity: &types.TaskNodeAlias{
+			PartyId:    "p1",
+			Name:       "name",
+			NodeId:     "nodeId",
+			IdentityId: "identity",
+		},
+		TaskId:          "taskID-01",
+		PeriodNum:       2,
+		PeriodStartTime: 100,
+		CreateAt:        99,
+		PeriodTimeouts:  []uint64{3000, 1000, 1000},
+	}
+	assert.NilError(t, WriteProposalState(database, state))
+
+	list, err := ReadAllProposalStates(database)
+	assert.NilError(t, err)
+	assert.Assert(t, len(list) == 1)
+	assert.DeepEqual(t, state, list[0])
+
+	assert.NilError(t, DeleteProposalState(database, proposalId))
+	list, err = ReadAllProposalStates(database)
+	assert.NilError(t, err)
+	assert.Assert(t, len(list) == 0)
+}
+
+func TestProposalStateWithoutPeriodTimeouts(t *testing.T) {
+	database := db.NewMemoryDatabase()
+	proposalId := common.BytesToHash([]byte("proposalId-01"))
+
+	// the record stored before the period timeouts were added
+	legacy := struct {
+		ProposalId         common.Hash
+		TaskDir            types.ProposalTaskDir
+		TaskRole           types.TaskRole
+		SelfIdentity       *types.TaskNodeAlias
+		TaskId             string
+		PeriodNum          uint32
+		PrePeriodStartTime uint64
+		PeriodStartTime    uint64
+		DeadlineDuration   uint64
+		CreateAt           uint64
+	}{ProposalId: proposalId, SelfIdentity: &types.TaskNodeAlias{}, TaskId: "taskID-01", PeriodNum: 1, CreateAt: 99}
+	val, err := rlp.EncodeToBytes(&legacy)
+	assert.NilError(t, err)
+	assert.NilError(t, database.Put(proposalStateKey(proposalId), val))
+
+	list, err := ReadAllProposalStates(database)
+	assert.NilError(t, err)
+	assert.Assert(t, len(list) == 1)
+	assert.Equal(t, list[0].TaskId, "taskID-01")
+	assert.Assert(t, len(list[0].PeriodTimeouts) == 0)
+}
+
+func TestProposalVotes(t *testing.T) {
+	database := db.NewMemoryDatabase()
+	proposalId := common.BytesToHash([]byte("proposalId-01"))
+
+	_, err := ReadProposalPrepareVotes(database, proposalId)
+	assert.Assert(t, IsDBNotFoundErr(err))
+
+	for _, partyId := range []string{"p1", "p2"} {
+		assert.NilError(t, WriteProposalPrepareVote(database, &types.PrepareVote{
+			ProposalId: proposalId,
+			TaskRole:   types.DataSupplier,
+			Owner:      &types.TaskNodeAlias{PartyId: partyId},
+			VoteOption: types.Yes,
+			PeerInfo:   &types.PrepareVoteResource{Ip: "127.0.0.1", Port: "8080", PartyId: partyId},
+			CreateAt:   1,
+			Sign:       []byte("sign"),
+		}))
+	}
+	votes, err := ReadProposalPrepareVotes(database, proposalId)
+	assert.NilError(t, err)
+	assert.Assert(t, len(votes) == 2)
+	assert.Equal(t, "p2", votes[1].PeerInfo.PartyId)
+
+	assert.NilError(t, DeleteProposalPrepareVotes(database, proposalId))
+	_, err = ReadProposalPrepareVotes(database, proposalId)
+	assert.Assert(t, IsDBNotFoundErr(err))
+}
+
+func TestProposalStateBroken(t *testing.T) {
+	database := db.NewMemoryDatabase()
+	assert.NilError(t, database.Put(proposalStateKey(common.BytesToHash([]byte("proposalId-01"))), []byte("broken")))
+
+	_, err := ReadAllProposalStates(database)
+	assert.ErrorContains(t, err, "decode proposalState failed")
+}
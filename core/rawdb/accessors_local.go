@@ -7,6 +7,7 @@ import (
 	dbtype "github.com/RosettaFlow/Carrier-Go/lib/db"
 	libtypes "github.com/RosettaFlow/Carrier-Go/lib/types"
 	"github.com/RosettaFlow/Carrier-Go/types"
+	"github.com/ethereum/go-ethereum/rlp"
 	"github.com/sirupsen/logrus"
 	"strings"
 	"sync/atomic"
@@ -615,6 +616,304 @@ func DeleteTaskEvent(db KeyValueStore, taskId string) {
 	}
 }
 
+// ReadTaskResourceUsages retrieves the resource usages of task reported by the jobNodes.
+func ReadTaskResourceUsages(db DatabaseReader, taskId string) ([]*types.TaskResourceUsage, error) {
+	result := make([]*types.TaskResourceUsage, 0)
+	if has, err := db.Has(taskResourceUsageKey(taskId)); nil != err || !has {
+		return result, err
+	}
+	blob, err := db.Get(taskResourceUsageKey(taskId))
+	if nil != err {
+		return nil, err
+	}
+	var array dbtype.TaskResourceUsageArrayPB
+	if err := array.Unmarshal(blob); nil != err {
+		return nil, err
+	}
+	for _, u := range array.GetUsageList() {
+		result = append(result, &types.TaskResourceUsage{
+			TaskId:        u.GetTaskId(),
+			PartyId:       u.GetPartyId(),
+			IdentityId:    u.GetIdentityId(),
+			JobNodeId:     u.GetJobNodeId(),
+			UsedMem:       u.GetUsedMem(),
+			UsedProcessor: u.GetUsedProcessor(),
+			UsedBandwidth: u.GetUsedBandwidth(),
+			UsedDuration:  u.GetUsedDuration(),
+			UpdateAt:      u.GetUpdateAt(),
+		})
+	}
+	return result, nil
+}
+
+// WriteTaskResourceUsage serializes the resource usage of task into the database,
+// the usage reported later by the same partyId and jobNodeId replaces the earlier one.
+func WriteTaskResourceUsage(db KeyValueStore, usage *types.TaskResourceUsage) {
+	var array dbtype.TaskResourceUsageArrayPB
+	if blob, err := db.Get(taskResourceUsageKey(usage.TaskId)); nil == err && len(blob) > 0 {
+		if err := array.Unmarshal(blob); nil != err {
+			log.WithError(err).Fatal("Failed to decode old task resource usages")
+		}
+	}
+	item := &dbtype.TaskResourceUsagePB{
+		TaskId:        usage.TaskId,
+		PartyId:       usage.PartyId,
+		IdentityId:    usage.IdentityId,
+		JobNodeId:     usage.JobNodeId,
+		UsedMem:       usage.UsedMem,
+		UsedProcessor: usage.UsedProcessor,
+		UsedBandwidth: usage.UsedBandwidth,
+		UsedDuration:  usage.UsedDuration,
+		UpdateAt:      usage.UpdateAt,
+	}
+	replaced := false
+	for i, u := range array.GetUsageList() {
+		if u.GetPartyId() == usage.PartyId && u.GetJobNodeId() == usage.JobNodeId {
+			array.UsageList[i] = item
+			replaced = true
+			break
+		}
+	}
+	if !replaced {
+		array.UsageList = append(array.UsageList, item)
+	}
+
+	data, err := array.Marshal()
+	if nil != err {
+		log.WithError(err).Fatal("Failed to encode task resource usages")
+	}
+	if err := db.Put(taskResourceUsageKey(usage.TaskId), data); nil != err {
+		log.WithError(err).Fatal("Failed to write task resource usages")
+	}
+}
+
+// DeleteTaskResourceUsages deletes the resource usages of task from the database.
+func DeleteTaskResourceUsages(db DatabaseDeleter, taskId string) {
+	if err := db.Delete(taskResourceUsageKey(taskId)); nil != err {
+		log.WithError(err).Fatal("Failed to delete task resource usages")
+	}
+}
+
+// ReadTaskProgressList retrieves the latest progress of task on the jobNodes of all task partners.
+func ReadTaskProgressList(db DatabaseReader, taskId string) ([]*types.TaskProgress, error) {
+	result := make([]*types.TaskProgress, 0)
+	if has, err := db.Has(taskProgressKey(taskId)); nil != err || !has {
+		return result, err
+	}
+	blob, err := db.Get(taskProgressKey(taskId))
+	if nil != err {
+		return nil, err
+	}
+	var array dbtype.TaskProgressArrayPB
+	if err := array.Unmarshal(blob); nil != err {
+		return nil, err
+	}
+	for _, p := range array.GetProgressList() {
+		result = append(result, &types.TaskProgress{
+			TaskId:      p.GetTaskId(),
+			PartyId:     p.GetPartyId(),
+			IdentityId:  p.GetIdentityId(),
+			JobNodeId:   p.GetJobNodeId(),
+			Phase:       p.GetPhase(),
+			Percent:     uint32(p.GetPercent()),
+			ElapsedTime: p.GetElapsedTime(),
+			RemainTime:  p.GetRemainTime(),
+			UpdateAt:    p.GetUpdateAt(),
+		})
+	}
+	return result, nil
+}
+
+// WriteTaskProgress serializes the progress of task into the database,
+// the progress of the same partyId and jobNodeId is replaced by the later one.
+func WriteTaskProgress(db KeyValueStore, progress *types.TaskProgress) {
+	var array dbtype.TaskProgressArrayPB
+	if blob, err := db.Get(taskProgressKey(progress.TaskId)); nil == err && len(blob) > 0 {
+		if err := array.Unmarshal(blob); nil != err {
+			log.WithError(err).Fatal("Failed to decode old task progress list")
+		}
+	}
+	item := &dbtype.TaskProgressPB{
+		TaskId:      progress.TaskId,
+		PartyId:     progress.PartyId,
+		IdentityId:  progress.IdentityId,
+		JobNodeId:   progress.JobNodeId,
+		Phase:       progress.Phase,
+		Percent:     uint64(progress.Percent),
+		ElapsedTime: progress.ElapsedTime,
+		RemainTime:  progress.RemainTime,
+		UpdateAt:    progress.UpdateAt,
+	}
+	replaced := false
+	for i, p := range array.GetProgressList() {
+		if p.GetPartyId() == progress.PartyId && p.GetJobNodeId() == progress.JobNodeId {
+			array.ProgressList[i] = item
+			replaced = true
+			break
+		}
+	}
+	if !replaced {
+		array.ProgressList = append(array.ProgressList, item)
+	}
+
+	data, err := array.Marshal()
+	if nil != err {
+		log.WithError(err).Fatal("Failed to encode task progress list")
+	}
+	if err := db.Put(taskProgressKey(progress.TaskId), data); nil != err {
+		log.WithError(err).Fatal("Failed to write task progress list")
+	}
+}
+
+// DeleteTaskProgressList deletes the progress of task from the database.
+func DeleteTaskProgressList(db DatabaseDeleter, taskId string) {
+	if err := db.Delete(taskProgressKey(taskId)); nil != err {
+		log.WithError(err).Fatal("Failed to delete task progress list")
+	}
+}
+
+// ReadWorkflow retrieves the workflow with the corresponding workflowId.
+func ReadWorkflow(db DatabaseReader, workflowId string) (*types.Workflow, error) {
+	blob, err := db.Get(workflowKey(workflowId))
+	if nil != err {
+		return nil, err
+	}
+	var workflow libtypes.WorkflowData
+	if err := workflow.Unmarshal(blob); nil != err {
+		return nil, err
+	}
+	return types.NewWorkflow(&workflow), nil
+}
+
+// ReadAllWorkflows retrieves all the workflows.
+func ReadAllWorkflows(db KeyValueStore) ([]*types.Workflow, error) {
+	it := db.NewIteratorWithPrefixAndStart(workflowPrefix, nil)
+	defer it.Release()
+	result := make([]*types.Workflow, 0)
+	for it.Next() {
+		if len(it.Value()) == 0 {
+			continue
+		}
+		var workflow libtypes.WorkflowData
+		if err := workflow.Unmarshal(it.Value()); nil != err {
+			return nil, err
+		}
+		result = append(result, types.NewWorkflow(&workflow))
+	}
+	return result, it.Error()
+}
+
+// WriteWorkflow serializes the workflow into the database.
+func WriteWorkflow(db DatabaseWriter, workflow *types.Workflow) {
+	data, err := workflow.WorkflowData().Marshal()
+	if nil != err {
+		log.WithError(err).Fatal("Failed to encode workflow")
+	}
+	if err := db.Put(workflowKey(workflow.WorkflowId()), data); nil != err {
+		log.WithError(err).Fatal("Failed to write workflow")
+	}
+}
+
+// ReadAllScheduleTaskBullets retrieves the states of all the local tasks waiting on the queue of scheduler.
+func ReadAllScheduleTaskBullets(db KeyValueStore) ([]*types.TaskBulletRecord, error) {
+	it := db.NewIteratorWithPrefixAndStart(scheduleTaskBulletPrefix, nil)
+	defer it.Release()
+	result := make([]*types.TaskBulletRecord, 0)
+	for it.Next() {
+		if len(it.Value()) == 0 {
+			continue
+		}
+		var record types.TaskBulletRecord
+		if err := rlp.DecodeBytes(it.Value(), &record); nil != err {
+			return nil, err
+		}
+		result = append(result, &record)
+	}
+	return result, it.Error()
+}
+
+// WriteScheduleTaskBullet serializes the state of the local task on the queue of scheduler into the database.
+func WriteScheduleTaskBullet(db DatabaseWriter, record *types.TaskBulletRecord) {
+	data, err := rlp.EncodeToBytes(record)
+	if nil != err {
+		log.WithError(err).Fatal("Failed to encode schedule task bullet")
+	}
+	if err := db.Put(scheduleTaskBulletKey(record.TaskId), data); nil != err {
+		log.WithError(err).Fatal("Failed to write schedule task bullet")
+	}
+}
+
+// DeleteScheduleTaskBullet deletes the state of the local task which leaves the queue of scheduler.
+func DeleteScheduleTaskBullet(db DatabaseDeleter, taskId string) {
+	if err := db.Delete(scheduleTaskBulletKey(taskId)); nil != err {
+		log.WithError(err).Fatal("Failed to delete schedule task bullet")
+	}
+}
+
+// ReadAllPendingTaskRetries retrieves the next attempts of all the failed local tasks which are waiting for their backoff.
+func ReadAllPendingTaskRetries(db KeyValueStore) ([]*types.TaskRetryRecord, error) {
+	it := db.NewIteratorWithPrefixAndStart(pendingTaskRetryPrefix, nil)
+	defer it.Release()
+	result := make([]*types.TaskRetryRecord, 0)
+	for it.Next() {
+		if len(it.Value()) == 0 {
+			continue
+		}
+		var record types.TaskRetryRecord
+		if err := rlp.DecodeBytes(it.Value(), &record); nil != err {
+			return nil, err
+		}
+		result = append(result, &record)
+	}
+	return result, it.Error()
+}
+
+// WritePendingTaskRetry serializes the next attempt of the failed local task into the database.
+func WritePendingTaskRetry(db DatabaseWriter, record *types.TaskRetryRecord) {
+	data, err := rlp.EncodeToBytes(record)
+	if nil != err {
+		log.WithError(err).Fatal("Failed to encode pending task retry")
+	}
+	if err := db.Put(pendingTaskRetryKey(record.TaskId), data); nil != err {
+		log.WithError(err).Fatal("Failed to write pending task retry")
+	}
+}
+
+// DeletePendingTaskRetry deletes the next attempt of the failed local task which has been requeued.
+func DeletePendingTaskRetry(db DatabaseDeleter, taskId string) {
+	if err := db.Delete(pendingTaskRetryKey(taskId)); nil != err {
+		log.WithError(err).Fatal("Failed to delete pending task retry")
+	}
+}
+
+// ReadAllDrainedNodeIds retrieves the ids of all the drained nodes with the corresponding nodeType.
+func ReadAllDrainedNodeIds(db KeyValueStore, nodeType types.RegisteredNodeType) ([]string, error) {
+	prefix := drainedNodeTypeKey(nodeType)
+	it := db.NewIteratorWithPrefixAndStart(prefix, nil)
+	defer it.Release()
+	result := make([]string, 0)
+	for it.Next() {
+		if key := it.Key(); len(key) > len(prefix) {
+			result = append(result, string(key[len(prefix):]))
+		}
+	}
+	return result, it.Error()
+}
+
+// WriteDrainedNode marks the registered node as drained in the database.
+func WriteDrainedNode(db DatabaseWriter, nodeType types.RegisteredNodeType, nodeId string) {
+	if err := db.Put(drainedNodeKey(nodeType, nodeId), []byte{0x01}); nil != err {
+		log.WithError(err).Fatal("Failed to write drained node")
+	}
+}
+
+// DeleteDrainedNode deletes the drained mark of the registered node.
+func DeleteDrainedNode(db DatabaseDeleter, nodeType types.RegisteredNodeType, nodeId string) {
+	if err := db.Delete(drainedNodeKey(nodeType, nodeId)); nil != err {
+		log.WithError(err).Fatal("Failed to delete drained node")
+	}
+}
+
 // ReadLocalResource retrieves the resource of local with the corresponding jobNodeId.
 func ReadLocalResource(db DatabaseReader, jobNodeId string) (*types.LocalResource, error) {
 	blob, err := db.Get(localResourceKey(jobNodeId))
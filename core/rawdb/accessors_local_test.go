@@ -160,6 +160,169 @@ func TestTaskEvent(t *testing.T) {
 	assert.Assert(t, len(taskEvents) == 0)
 }
 
+func TestTaskResourceUsage(t *testing.T) {
+	database := db.NewMemoryDatabase()
+
+	usages, err := ReadTaskResourceUsages(database, "taskId")
+	assert.NilError(t, err)
+	assert.Assert(t, len(usages) == 0)
+
+	WriteTaskResourceUsage(database, &types.TaskResourceUsage{
+		TaskId:        "taskId",
+		PartyId:       "P1",
+		JobNodeId:     "jobNode-01",
+		UsedMem:       1024,
+		UsedProcessor: 2,
+	})
+	WriteTaskResourceUsage(database, &types.TaskResourceUsage{
+		TaskId:    "taskId",
+		PartyId:   "P2",
+		JobNodeId: "jobNode-02",
+		UsedMem:   2048,
+	})
+	// the later report of the same jobNode replaces the earlier one
+	WriteTaskResourceUsage(database, &types.TaskResourceUsage{
+		TaskId:        "taskId",
+		PartyId:       "P1",
+		JobNodeId:     "jobNode-01",
+		UsedMem:       4096,
+		UsedProcessor: 4,
+	})
+
+	usages, err = ReadTaskResourceUsages(database, "taskId")
+	assert.NilError(t, err)
+	assert.Assert(t, len(usages) == 2)
+	assert.Equal(t, usages[0].UsedMem, uint64(4096))
+	assert.Equal(t, usages[0].UsedProcessor, uint64(4))
+	assert.Equal(t, usages[1].PartyId, "P2")
+
+	DeleteTaskResourceUsages(database, "taskId")
+	usages, err = ReadTaskResourceUsages(database, "taskId")
+	assert.NilError(t, err)
+	assert.Assert(t, len(usages) == 0)
+}
+
+func TestWorkflow(t *testing.T) {
+	database := db.NewMemoryDatabase()
+
+	_, err := ReadWorkflow(database, "workflow:0x01")
+	assert.Assert(t, nil != err)
+
+	workflow := types.NewWorkflow(&libtypes.WorkflowData{
+		WorkflowId:   "workflow:0x01",
+		WorkflowName: "pipeline",
+		State:        types.TaskStatePending.String(),
+		TaskList: []*libtypes.WorkflowTaskData{
+			{Name: "psi", State: types.TaskStatePending.String(), Task: &libtypes.TaskData{TaskName: "psi"}},
+			{Name: "train", Depends: []string{"psi"}, State: types.TaskStatePending.String(), Task: &libtypes.TaskData{TaskName: "train"}},
+		},
+	})
+	WriteWorkflow(database, workflow)
+	WriteWorkflow(database, types.NewWorkflow(&libtypes.WorkflowData{WorkflowId: "workflow:0x02", WorkflowName: "other"}))
+
+	// update the stored workflow
+	workflow.Task("psi").TaskId = "task:0x01"
+	workflow.Task("psi").State = types.TaskStateRunning.String()
+	WriteWorkflow(database, workflow)
+
+	res, err := ReadWorkflow(database, "workflow:0x01")
+	assert.NilError(t, err)
+	assert.Equal(t, res.WorkflowData().GetWorkflowName(), "pipeline")
+	assert.Equal(t, res.Task("psi").GetTaskId(), "task:0x01")
+	assert.Equal(t, res.Task("train").GetDepends()[0], "psi")
+
+	list, err := ReadAllWorkflows(database)
+	assert.NilError(t, err)
+	assert.Assert(t, len(list) == 2)
+}
+
+func TestScheduleTaskBullet(t *testing.T) {
+	database := db.NewMemoryDatabase()
+
+	list, err := ReadAllScheduleTaskBullets(database)
+	assert.NilError(t, err)
+	assert.Assert(t, len(list) == 0)
+
+	WriteScheduleTaskBullet(database, &types.TaskBulletRecord{
+		TaskId:        "task:0x01",
+		PowerPartyIds: []string{"P1", "P2"},
+		Term:          2,
+		Resched:       1,
+		EnqueueAt:     1000,
+	})
+	WriteScheduleTaskBullet(database, &types.TaskBulletRecord{TaskId: "task:0x02", Priority: 1})
+	// the later state of the same task replaces the earlier one
+	WriteScheduleTaskBullet(database, &types.TaskBulletRecord{
+		TaskId:        "task:0x01",
+		PowerPartyIds: []string{"P1", "P2"},
+		Starve:        true,
+		Term:          5,
+		Resched:       2,
+		EnqueueAt:     1000,
+	})
+
+	list, err = ReadAllScheduleTaskBullets(database)
+	assert.NilError(t, err)
+	assert.Assert(t, len(list) == 2)
+	assert.Equal(t, list[0].TaskId, "task:0x01")
+	assert.Equal(t, list[0].Starve, true)
+	assert.Equal(t, list[0].Term, uint32(5))
+	assert.Equal(t, list[0].Resched, uint32(2))
+	assert.Equal(t, list[0].EnqueueAt, uint64(1000))
+	assert.DeepEqual(t, list[0].PowerPartyIds, []string{"P1", "P2"})
+
+	DeleteScheduleTaskBullet(database, "task:0x01")
+	list, err = ReadAllScheduleTaskBullets(database)
+	assert.NilError(t, err)
+	assert.Assert(t, len(list) == 1)
+	assert.Equal(t, list[0].TaskId, "task:0x02")
+	assert.Equal(t, list[0].Priority, uint32(1))
+}
+
+func TestTaskProgress(t *testing.T) {
+	database := db.NewMemoryDatabase()
+
+	list, err := ReadTaskProgressList(database, "taskId")
+	assert.NilError(t, err)
+	assert.Assert(t, len(list) == 0)
+
+	WriteTaskProgress(database, &types.TaskProgress{
+		TaskId:    "taskId",
+		PartyId:   "P1",
+		JobNodeId: "jobNode-01",
+		Phase:     "training",
+		Percent:   10,
+	})
+	WriteTaskProgress(database, &types.TaskProgress{
+		TaskId:    "taskId",
+		PartyId:   "P2",
+		JobNodeId: "jobNode-02",
+		Percent:   20,
+	})
+	// the later progress of the same jobNode replaces the earlier one
+	WriteTaskProgress(database, &types.TaskProgress{
+		TaskId:      "taskId",
+		PartyId:     "P1",
+		JobNodeId:   "jobNode-01",
+		Phase:       "predicting",
+		Percent:     80,
+		ElapsedTime: 1000,
+	})
+
+	list, err = ReadTaskProgressList(database, "taskId")
+	assert.NilError(t, err)
+	assert.Assert(t, len(list) == 2)
+	assert.Equal(t, list[0].Phase, "predicting")
+	assert.Equal(t, list[0].Percent, uint32(80))
+	assert.Equal(t, list[0].ElapsedTime, uint64(1000))
+	assert.Equal(t, list[1].PartyId, "P2")
+
+	DeleteTaskProgressList(database, "taskId")
+	list, err = ReadTaskProgressList(database, "taskId")
+	assert.NilError(t, err)
+	assert.Assert(t, len(list) == 0)
+}
+
 func TestLocalIdentity(t *testing.T) {
 	database := db.NewMemoryDatabase()
 	nodeAlias := &types.NodeAlias{
@@ -229,4 +392,56 @@ func TestLocalResource(t *testing.T) {
 	DeleteLocalResource(database, localResource01.JobNodeId)
 	array, _ = ReadAllLocalResource(database)
 	require.True(t, array.Len() == 1)
-}
\ No newline at end of file
+}
+func TestPendingTaskRetry(t *testing.T) {
+	database := db.NewMemoryDatabase()
+
+	list, err := ReadAllPendingTaskRetries(database)
+	assert.NilError(t, err)
+	assert.Assert(t, len(list) == 0)
+
+	WritePendingTaskRetry(database, &types.TaskRetryRecord{
+		TaskId:        "task:0x01",
+		PowerPartyIds: []string{"P1", "P2"},
+		RequeueAt:     1000,
+	})
+	WritePendingTaskRetry(database, &types.TaskRetryRecord{TaskId: "task:0x02", RequeueAt: 2000})
+
+	list, err = ReadAllPendingTaskRetries(database)
+	assert.NilError(t, err)
+	assert.Assert(t, len(list) == 2)
+	assert.Equal(t, list[0].TaskId, "task:0x01")
+	assert.Equal(t, list[0].RequeueAt, uint64(1000))
+	assert.DeepEqual(t, list[0].PowerPartyIds, []string{"P1", "P2"})
+
+	DeletePendingTaskRetry(database, "task:0x01")
+	list, err = ReadAllPendingTaskRetries(database)
+	assert.NilError(t, err)
+	assert.Assert(t, len(list) == 1)
+	assert.Equal(t, list[0].TaskId, "task:0x02")
+}
+
+func TestDrainedNode(t *testing.T) {
+	database := db.NewMemoryDatabase()
+
+	ids, err := ReadAllDrainedNodeIds(database, types.PREFIX_TYPE_JOBNODE)
+	assert.NilError(t, err)
+	assert.Assert(t, len(ids) == 0)
+
+	WriteDrainedNode(database, types.PREFIX_TYPE_JOBNODE, "jobNode1")
+	WriteDrainedNode(database, types.PREFIX_TYPE_JOBNODE, "jobNode2")
+	WriteDrainedNode(database, types.PREFIX_TYPE_DATANODE, "dataNode1")
+
+	ids, err = ReadAllDrainedNodeIds(database, types.PREFIX_TYPE_JOBNODE)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, ids, []string{"jobNode1", "jobNode2"})
+
+	ids, err = ReadAllDrainedNodeIds(database, types.PREFIX_TYPE_DATANODE)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, ids, []string{"dataNode1"})
+
+	DeleteDrainedNode(database, types.PREFIX_TYPE_JOBNODE, "jobNode1")
+	ids, err = ReadAllDrainedNodeIds(database, types.PREFIX_TYPE_JOBNODE)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, ids, []string{"jobNode2"})
+}
@@ -1344,4 +1344,83 @@ func HasLocalTaskExecute(db DatabaseReader, taskId string) (bool, error) {
 	return true, nil
 }
 
-//
\ No newline at end of file
+//
+
+// 操作 远端组织 使用本地算力的配额
+func StoreOrgQuota(db DatabaseWriter, quota *types.OrgQuota) error {
+	val, err := rlp.EncodeToBytes(quota)
+	if nil != err {
+		return err
+	}
+	return db.Put(GetOrgQuotaKey(quota.IdentityId), val)
+}
+
+func RemoveOrgQuota(db DatabaseDeleter, identityId string) error {
+	return db.Delete(GetOrgQuotaKey(identityId))
+}
+
+func QueryOrgQuota(db DatabaseReader, identityId string) (*types.OrgQuota, error) {
+	key := GetOrgQuotaKey(identityId)
+	has, err := db.Has(key)
+	if IsNoDBNotFoundErr(err) {
+		return nil, err
+	}
+	if !has {
+		return nil, ErrNotFound
+	}
+	vb, err := db.Get(key)
+	if nil != err {
+		return nil, err
+	}
+	var quota types.OrgQuota
+	if err := rlp.DecodeBytes(vb, &quota); nil != err {
+		return nil, err
+	}
+	return &quota, nil
+}
+
+func QueryOrgQuotas(db KeyValueStore) ([]*types.OrgQuota, error) {
+	it := db.NewIteratorWithPrefixAndStart(orgQuotaKeyPrefix, nil)
+	defer it.Release()
+	arr := make([]*types.OrgQuota, 0)
+	for it.Next() {
+		if len(it.Value()) == 0 {
+			continue
+		}
+		var quota types.OrgQuota
+		if err := rlp.DecodeBytes(it.Value(), &quota); nil != err {
+			return nil, err
+		}
+		arr = append(arr, &quota)
+	}
+	return arr, it.Error()
+}
+
+// 操作 远端组织 当天已结束任务所使用的 slot 时长
+func StoreOrgDailySlotUsage(db DatabaseWriter, usage *types.OrgDailySlotUsage) error {
+	val, err := rlp.EncodeToBytes(usage)
+	if nil != err {
+		return err
+	}
+	return db.Put(GetOrgDailySlotUsageKey(usage.IdentityId), val)
+}
+
+func QueryOrgDailySlotUsage(db DatabaseReader, identityId string) (*types.OrgDailySlotUsage, error) {
+	key := GetOrgDailySlotUsageKey(identityId)
+	has, err := db.Has(key)
+	if IsNoDBNotFoundErr(err) {
+		return nil, err
+	}
+	if !has {
+		return nil, ErrNotFound
+	}
+	vb, err := db.Get(key)
+	if nil != err {
+		return nil, err
+	}
+	var usage types.OrgDailySlotUsage
+	if err := rlp.DecodeBytes(vb, &usage); nil != err {
+		return nil, err
+	}
+	return &usage, nil
+}
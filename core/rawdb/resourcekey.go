@@ -32,6 +32,10 @@ var (
 	dataResourceDiskUsedKeyPrefix = []byte("DataResourceDiskUsedKeyPrefix:")
 	// taskId -> executeStatus
 	localTaskExecuteStatusPrefix = []byte("localTaskExecuteStatus")
+	// identityId -> OrgQuota{identityId, maxConcurrentTasks, maxSlots, maxDailySlotHours}
+	orgQuotaKeyPrefix = []byte("OrgQuotaKey:")
+	// identityId -> OrgDailySlotUsage{identityId, day, slotMsec}
+	orgDailySlotUsageKeyPrefix = []byte("OrgDailySlotUsageKey:")
 )
 
 // nodeResourceKey = NodeResourceKeyPrefix + jobNodeId
@@ -92,3 +96,11 @@ func GetDataResourceDiskUsedKey(metaDataId string) []byte {
 func GetLocalTaskExecuteStatus(taskId string) []byte {
 	return append(localTaskExecuteStatusPrefix, []byte(taskId)...)
 }
+
+func GetOrgQuotaKey(identityId string) []byte {
+	return append(orgQuotaKeyPrefix, []byte(identityId)...)
+}
+
+func GetOrgDailySlotUsageKey(identityId string) []byte {
+	return append(orgDailySlotUsageKeyPrefix, []byte(identityId)...)
+}
@@ -3,8 +3,13 @@ package rawdb
 import (
 	"encoding/binary"
 	"github.com/RosettaFlow/Carrier-Go/common"
+	"github.com/RosettaFlow/Carrier-Go/types"
 )
 
+// DatabaseVersion is the version of the low level database schema,
+// it must be increased when the schema is changed incompatibly.
+const DatabaseVersion uint64 = 1
+
 // The fields below define the low level database schema prefixing.
 var (
 	// yarnNameKey tracks the name of yarn
@@ -26,6 +31,28 @@ var (
 	// taskEventKey tracks the task event list of a task.
 	taskEventPrefix = []byte("TaskEvent")	// taskEventPrefix + taskId -> the event of task.
 
+	// taskResourceUsagePrefix tracks the resource used by a local task on every jobNode.
+	taskResourceUsagePrefix = []byte("TaskResourceUsage") // taskResourceUsagePrefix + taskId -> the list of resource usage
+
+	// taskProgressPrefix tracks the latest progress of a running task on every jobNode of the task partners.
+	taskProgressPrefix = []byte("TaskProgress") // taskProgressPrefix + taskId -> the list of task progress
+
+	// workflowPrefix tracks the workflows published by the local identity.
+	workflowPrefix = []byte("Workflow") // workflowPrefix + workflowId -> workflow
+
+	// scheduleTaskBulletPrefix tracks the local tasks waiting on the queue of scheduler.
+	scheduleTaskBulletPrefix = []byte("ScheduleTaskBullet") // scheduleTaskBulletPrefix + taskId -> the state of task on queue
+
+	// pendingTaskRetryPrefix tracks the next attempts of the failed local tasks which are waiting for their backoff.
+	pendingTaskRetryPrefix = []byte("PendingTaskRetry") // pendingTaskRetryPrefix + taskId -> the next attempt of task
+
+	// drainedNodePrefix tracks the registered jobNodes and dataNodes which are drained by operator.
+	drainedNodePrefix = []byte("DrainedNode") // drainedNodePrefix + nodeType + nodeId -> the node is drained
+
+	// the index of the ledger appended by chaincons.
+	ledgerTaskIndexPrefix = []byte("LedgerTaskIndex") // ledgerTaskIndexPrefix + taskId -> the numbers of the blocks which record the consensus of task
+	ledgerIndexHeadKey    = []byte("LedgerIndexHead") // the number of the last block which has been indexed
+
 	// databaseVersionKey tracks the current database version
 	databaseVersionKey = []byte("DatabaseVersion")
 
@@ -49,6 +76,14 @@ var (
 	// taskListKey tracks the running task list.
 	runningTaskKey = []byte("RunningTask")
 
+	// the 2pc consensus proposal which is being processed on local.
+	proposalStatePrefix           = []byte("ProposalState")           // proposalStatePrefix + proposalId -> proposalState
+	proposalTaskPrefix            = []byte("ProposalTask")            // proposalTaskPrefix + proposalId -> task of proposal
+	proposalSelfPeerInfoPrefix    = []byte("ProposalSelfPeerInfo")    // proposalSelfPeerInfoPrefix + proposalId -> self peerInfo of proposal
+	proposalPrepareVotePrefix     = []byte("ProposalPrepareVote")     // proposalPrepareVotePrefix + proposalId -> the list of prepareVote
+	proposalConfirmVotePrefix     = []byte("ProposalConfirmVote")     // proposalConfirmVotePrefix + proposalId -> the list of confirmVote
+	proposalConfirmPeerInfoPrefix = []byte("ProposalConfirmPeerInfo") // proposalConfirmPeerInfoPrefix + proposalId -> confirm peerInfo of proposal
+
 	// Data item prefixes
 	headerPrefix       = []byte("h") // headerPrefix + num (uint64 big endian) + hash -> header
 	headerHashSuffix   = []byte("n") // headerPrefix + num (uint64 big endian) + headerHashSuffix -> hash
@@ -99,6 +134,76 @@ func taskEventKey(taskId string) []byte {
 	return append(taskEventPrefix, []byte(taskId)...)
 }
 
+// taskResourceUsageKey = taskResourceUsagePrefix + taskId
+func taskResourceUsageKey(taskId string) []byte {
+	return append(taskResourceUsagePrefix, []byte(taskId)...)
+}
+
+// taskProgressKey = taskProgressPrefix + taskId
+func taskProgressKey(taskId string) []byte {
+	return append(taskProgressPrefix, []byte(taskId)...)
+}
+
+// workflowKey = workflowPrefix + workflowId
+func workflowKey(workflowId string) []byte {
+	return append(workflowPrefix, []byte(workflowId)...)
+}
+
+// scheduleTaskBulletKey = scheduleTaskBulletPrefix + taskId
+func scheduleTaskBulletKey(taskId string) []byte {
+	return append(scheduleTaskBulletPrefix, []byte(taskId)...)
+}
+
+// ledgerTaskIndexKey = ledgerTaskIndexPrefix + taskId
+func ledgerTaskIndexKey(taskId string) []byte {
+	return append(ledgerTaskIndexPrefix, []byte(taskId)...)
+}
+
+// pendingTaskRetryKey = pendingTaskRetryPrefix + taskId
+func pendingTaskRetryKey(taskId string) []byte {
+	return append(pendingTaskRetryPrefix, []byte(taskId)...)
+}
+
+// drainedNodeKey = drainedNodePrefix + nodeType + nodeId
+func drainedNodeKey(nodeType types.RegisteredNodeType, nodeId string) []byte {
+	return append(drainedNodeTypeKey(nodeType), []byte(nodeId)...)
+}
+
+// drainedNodeTypeKey = drainedNodePrefix + nodeType
+func drainedNodeTypeKey(nodeType types.RegisteredNodeType) []byte {
+	return append(drainedNodePrefix, []byte(nodeType.String())...)
+}
+
+// proposalStateKey = proposalStatePrefix + proposalId
+func proposalStateKey(proposalId common.Hash) []byte {
+	return append(proposalStatePrefix, proposalId.Bytes()...)
+}
+
+// proposalTaskKey = proposalTaskPrefix + proposalId
+func proposalTaskKey(proposalId common.Hash) []byte {
+	return append(proposalTaskPrefix, proposalId.Bytes()...)
+}
+
+// proposalSelfPeerInfoKey = proposalSelfPeerInfoPrefix + proposalId
+func proposalSelfPeerInfoKey(proposalId common.Hash) []byte {
+	return append(proposalSelfPeerInfoPrefix, proposalId.Bytes()...)
+}
+
+// proposalPrepareVoteKey = proposalPrepareVotePrefix + proposalId
+func proposalPrepareVoteKey(proposalId common.Hash) []byte {
+	return append(proposalPrepareVotePrefix, proposalId.Bytes()...)
+}
+
+// proposalConfirmVoteKey = proposalConfirmVotePrefix + proposalId
+func proposalConfirmVoteKey(proposalId common.Hash) []byte {
+	return append(proposalConfirmVotePrefix, proposalId.Bytes()...)
+}
+
+// proposalConfirmPeerInfoKey = proposalConfirmPeerInfoPrefix + proposalId
+func proposalConfirmPeerInfoKey(proposalId common.Hash) []byte {
+	return append(proposalConfirmPeerInfoPrefix, proposalId.Bytes()...)
+}
+
 // localResourceKey = localResourcePrefix + jobNodeId
 func localResourceKey(jobNodeId string) []byte {
 	return append(localResourcePrefix, []byte(jobNodeId)...)
@@ -1,12 +1,15 @@
 package resource
 
 import (
+	"errors"
 	"fmt"
 	"github.com/RosettaFlow/Carrier-Go/common/fileutil"
+	"github.com/RosettaFlow/Carrier-Go/common/timeutils"
 	"github.com/RosettaFlow/Carrier-Go/core/iface"
 	"github.com/RosettaFlow/Carrier-Go/core/rawdb"
 	"github.com/RosettaFlow/Carrier-Go/types"
 	log "github.com/sirupsen/logrus"
+	"sync"
 	"time"
 )
 
@@ -14,26 +17,37 @@ const (
 	defaultRefreshOrgResourceInterval = 300 * time.Second
 )
 
+var (
+	ErrSlotUnitInvalid       = errors.New("the mem, processor and bandwidth of slot unit must be greater than zero")
+	ErrOrgQuotaIdentityEmpty = errors.New("the identityId of org quota is empty")
+)
+
 type Manager struct {
-	// TODO 这里需要一个 config <SlotUnit 的>
 	dataCenter iface.ForResourceDB // Low level persistent database to store final content.
 	//eventCh                chan *types.TaskEventInfo
 	slotUnit *types.Slot
+	// the slot unit from config, nil if it is not configured
+	configSlotUnit *types.Slot
+	// guard the slotUnit and the read-modify-write of localResourceTables
+	slotLock sync.Mutex
+	// guard the check of orgQuota and the lock of local resource, and the daily slot usage of orgs
+	quotaLock sync.Mutex
 	//remoteTables     map[string]*types.RemoteResourceTable
 	remoteTableQueue     []*types.RemoteResourceTable
 	mockIdentityIdsFile  string
 	mockIdentityIdsCache map[string]struct{}
 }
 
-func NewResourceManager(dataCenter iface.ForResourceDB, mockIdentityIdsFile string) *Manager {
+func NewResourceManager(dataCenter iface.ForResourceDB, mockIdentityIdsFile string, slotUnit *types.Slot) *Manager {
 	m := &Manager{
 		dataCenter: dataCenter,
 		//eventCh:          make(chan *types.TaskEventInfo, 0),
 		//localTables:      make(map[string]*types.LocalResourceTable),
 		//localTableQueue:  make([]*types.LocalResourceTable, 0),
 		remoteTableQueue:    make([]*types.RemoteResourceTable, 0),
-		slotUnit:            types.DefaultSlotUnit, // TODO for test
-		mockIdentityIdsFile: mockIdentityIdsFile,   //TODO for test
+		slotUnit:            types.DefaultSlotUnit,
+		configSlotUnit:      slotUnit,
+		mockIdentityIdsFile: mockIdentityIdsFile, //TODO for test
 		mockIdentityIdsCache: make(map[string]struct{}, 0),
 	}
 
@@ -69,15 +83,23 @@ func (m *Manager) Start() error {
 		log.Debugf("Finished load mock identityIds, mock identityId size: %d", len(m.mockIdentityIdsCache))
 	}
 
-	slotUnit, err := m.dataCenter.QueryNodeResourceSlotUnit()
-	if nil != err {
-		log.Warnf("Failed to load local slotUnit on resourceManager Start(), err: {%s}", err)
-	} else {
-		m.SetSlotUnit(slotUnit.Mem, slotUnit.Processor, slotUnit.Bandwidth)
+	// The slotUnit from config takes precedence over the stored one.
+	slotUnit := m.configSlotUnit
+	if nil == slotUnit {
+		stored, err := m.dataCenter.QueryNodeResourceSlotUnit()
+		if nil != err {
+			log.Warnf("Failed to load local slotUnit on resourceManager Start(), err: {%s}", err)
+			slotUnit = types.DefaultSlotUnit
+		} else {
+			slotUnit = stored
+		}
 	}
-
-	// store slotUnit
-	if err := m.dataCenter.StoreNodeResourceSlotUnit(m.slotUnit); nil != err {
+	// SetSlotUnit stores the slotUnit and recomputes the slotTotal of all localResourceTables
+	if err := m.SetSlotUnit(slotUnit.Mem, slotUnit.Processor, slotUnit.Bandwidth); nil != err {
+		return err
+	}
+	// rebuild the slotUsed of all localResourceTables from the slots used by local tasks
+	if err := m.reconcileSlotUsed(); nil != err {
 		return err
 	}
 	// load remote org resource Tables
@@ -100,7 +122,7 @@ func (m *Manager) Start() error {
 
 func (m *Manager) Stop() error {
 	// store slotUnit
-	if err := m.dataCenter.StoreNodeResourceSlotUnit(m.slotUnit); nil != err {
+	if err := m.dataCenter.StoreNodeResourceSlotUnit(m.GetSlotUnit()); nil != err {
 		return err
 	}
 	// store remote org resource Tables
@@ -111,22 +133,88 @@ func (m *Manager) Stop() error {
 	return nil
 }
 
-func (m *Manager) SetSlotUnit(mem, p, b uint64) {
-	//m.slotUnit = &types.Slot{
-	//	Mem:       mem,
-	//	Processor: p,
-	//	Bandwidth: b,
-	//}
-	m.slotUnit = types.DefaultSlotUnit // TODO for test
-	//if len(m.localTables) != 0 {
-	//	for _, re := range m.localTables {
-	//		re.SetSlotUnit(m.slotUnit)
-	//	}
-	//}
+// SetSlotUnit changes the slot unit, and recomputes the slotTotal of all localResourceTables with it.
+//
+// NOTE: the slots used by the running tasks are counted by the old slot unit,
+// so the slot unit should be changed when there is not any running task.
+func (m *Manager) SetSlotUnit(mem, p, b uint64) error {
+	slotUnit := &types.Slot{
+		Mem:       mem,
+		Processor: p,
+		Bandwidth: b,
+	}
+	if !slotUnit.IsValid() {
+		return fmt.Errorf("%s, slotUnit: %s", ErrSlotUnitInvalid, slotUnit.String())
+	}
+
+	m.slotLock.Lock()
+	defer m.slotLock.Unlock()
+
+	if err := m.dataCenter.StoreNodeResourceSlotUnit(slotUnit); nil != err {
+		return err
+	}
+	old := m.slotUnit
+	m.slotUnit = slotUnit
+
+	tables, err := m.dataCenter.QueryLocalResourceTables()
+	if nil != err && err != rawdb.ErrNotFound {
+		return err
+	}
+	for _, table := range tables {
+		table.SetSlotUnit(slotUnit)
+		if err := m.dataCenter.StoreLocalResourceTable(table); nil != err {
+			return err
+		}
+	}
+	log.Infof("Set slotUnit on resourceManager, old slotUnit: %s, new slotUnit: %s, localResourceTable count: %d",
+		old.String(), slotUnit.String(), len(tables))
+	return nil
+}
+func (m *Manager) GetSlotUnit() *types.Slot {
+	m.slotLock.Lock()
+	defer m.slotLock.Unlock()
+	return m.slotUnit
+}
+
+// reconcileSlotUsed rebuilds the slotUsed of all localResourceTables from the localTaskPowerUsed records,
+// so that the slot accounting never drifts from the tasks which are holding the slots.
+func (m *Manager) reconcileSlotUsed() error {
+
+	m.slotLock.Lock()
+	defer m.slotLock.Unlock()
+
+	powerUseds, err := m.dataCenter.QueryLocalTaskPowerUseds()
+	if nil != err && err != rawdb.ErrNotFound {
+		return err
+	}
+	usedCache := make(map[string]uint32, 0)
+	for _, used := range powerUseds {
+		usedCache[used.GetNodeId()] += uint32(used.GetSlotCount())
+	}
+
+	tables, err := m.dataCenter.QueryLocalResourceTables()
+	if nil != err && err != rawdb.ErrNotFound {
+		return err
+	}
+	for _, table := range tables {
+		slotUsed := usedCache[table.GetNodeId()]
+		if table.GetSlotUsed() == slotUsed {
+			continue
+		}
+		log.Warnf("Reconcile the slotUsed of localResourceTable, jobNodeId: {%s}, slotUsed: {%d}, slotUsed of tasks: {%d}",
+			table.GetNodeId(), table.GetSlotUsed(), slotUsed)
+		table.SetSlotUsed(slotUsed)
+		if err := m.dataCenter.StoreLocalResourceTable(table); nil != err {
+			return err
+		}
+	}
+	return nil
 }
-func (m *Manager) GetSlotUnit() *types.Slot { return m.slotUnit }
 
 func (m *Manager) UseSlot(nodeId string, slotCount uint32) error {
+	m.slotLock.Lock()
+	defer m.slotLock.Unlock()
+
 	table, err := m.GetLocalResourceTable(nodeId)
 	if nil != err {
 		return fmt.Errorf("No found the resource table of node: %s, %s", nodeId, err)
@@ -140,6 +228,9 @@ func (m *Manager) UseSlot(nodeId string, slotCount uint32) error {
 	return m.SetLocalResourceTable(table)
 }
 func (m *Manager) FreeSlot(nodeId string, slotCount uint32) error {
+	m.slotLock.Lock()
+	defer m.slotLock.Unlock()
+
 	table, err := m.GetLocalResourceTable(nodeId)
 	if nil != err {
 		return fmt.Errorf("No found the resource table of node: %s, %s", nodeId, err)
@@ -235,6 +326,16 @@ func (m *Manager) LockLocalResourceWithTask(jobNodeId string, needSlotCount uint
 
 	log.Infof("Start lock local resource with taskId {%s}, jobNodeId {%s}, slotCount {%d}", task.TaskId(), jobNodeId, needSlotCount)
 
+	m.quotaLock.Lock()
+	defer m.quotaLock.Unlock()
+
+	// the quota of task owner is checked with the lock, so that the concurrent tasks can not exceed it together
+	if err := m.checkOrgQuota(task.TaskData().GetIdentity(), needSlotCount); nil != err {
+		log.Warnf("Failed to lock internal power resource, the quota of task owner is exceeded, taskId: {%s}, jobNodeId: {%s}, err: {%s}",
+			task.TaskId(), jobNodeId, err)
+		return err
+	}
+
 	// Lock local resource (jobNode)
 	if err := m.UseSlot(jobNodeId, uint32(needSlotCount)); nil != err {
 		log.Errorf("Failed to lock internal power resource, taskId: {%s}, jobNodeId: {%s}, usedSlotCount: {%s}, err: {%s}",
@@ -250,7 +351,8 @@ func (m *Manager) LockLocalResourceWithTask(jobNodeId string, needSlotCount uint
 			task.TaskId(), jobNodeId, needSlotCount, err)
 		return fmt.Errorf("failed to store local taskId and jobNodeId index, {%s}", err)
 	}
-	if err := m.dataCenter.StoreLocalTaskPowerUsed(types.NewLocalTaskPowerUsed(task.TaskId(), jobNodeId, needSlotCount)); nil != err {
+	if err := m.dataCenter.StoreLocalTaskPowerUsed(types.NewLocalTaskPowerUsed(task.TaskId(), jobNodeId, needSlotCount,
+		task.TaskData().GetIdentity(), uint64(timeutils.UnixMsec()))); nil != err {
 
 		m.FreeSlot(jobNodeId, uint32(needSlotCount))
 		m.dataCenter.RemoveJobNodeRunningTaskId(jobNodeId, task.TaskId())
@@ -274,9 +376,10 @@ func (m *Manager) LockLocalResourceWithTask(jobNodeId string, needSlotCount uint
 	}
 
 	// 更新 本地 jobNodeResource 的资源使用信息
-	usedMem := m.slotUnit.Mem * needSlotCount
-	usedProcessor := m.slotUnit.Processor * needSlotCount
-	usedBandwidth := m.slotUnit.Bandwidth * needSlotCount
+	slotUnit := m.GetSlotUnit()
+	usedMem := slotUnit.Mem * needSlotCount
+	usedProcessor := slotUnit.Processor * needSlotCount
+	usedBandwidth := slotUnit.Bandwidth * needSlotCount
 
 	jobNodeResource.GetData().UsedMem += usedMem
 	jobNodeResource.GetData().UsedProcessor += usedProcessor
@@ -317,6 +420,9 @@ func (m *Manager) UnLockLocalResourceWithTask(taskId string) error {
 
 	log.Infof("Start unlock local resource with taskId {%s}, jobNodeId {%s}, slotCount {%d}", taskId, jobNodeId, localTaskPowerUsed.GetSlotCount())
 
+	m.quotaLock.Lock()
+	defer m.quotaLock.Unlock()
+
 	// Lock local resource (jobNode)
 	if err := m.FreeSlot(localTaskPowerUsed.GetNodeId(), uint32(freeSlotUnitCount)); nil != err {
 		log.Errorf("Failed to unlock internal power resource, taskId: {%s}, jobNodeId: {%s}, freeSlotUnitCount: {%s}, err: {%s}",
@@ -340,6 +446,7 @@ func (m *Manager) UnLockLocalResourceWithTask(taskId string) error {
 			taskId, jobNodeId, freeSlotUnitCount, err)
 		return fmt.Errorf("failed to remove local taskId use jobNode slot, {%s}", err)
 	}
+	m.settleOrgDailySlotUsage(localTaskPowerUsed)
 
 	// 更新本地 resource 资源信息 [释放资源使用情况]
 	jobNodeResource, err := m.dataCenter.GetLocalResource(jobNodeId)
@@ -350,9 +457,10 @@ func (m *Manager) UnLockLocalResourceWithTask(taskId string) error {
 	}
 
 	// 更新 本地 jobNodeResource 的资源使用信息
-	usedMem := m.slotUnit.Mem * freeSlotUnitCount
-	usedProcessor := m.slotUnit.Processor * freeSlotUnitCount
-	usedBandwidth := m.slotUnit.Bandwidth * freeSlotUnitCount
+	slotUnit := m.GetSlotUnit()
+	usedMem := slotUnit.Mem * freeSlotUnitCount
+	usedProcessor := slotUnit.Processor * freeSlotUnitCount
+	usedBandwidth := slotUnit.Bandwidth * freeSlotUnitCount
 
 	jobNodeResource.GetData().UsedMem -= usedMem
 	jobNodeResource.GetData().UsedProcessor -= usedProcessor
@@ -417,3 +525,85 @@ func (m *Manager) IsMockIdentityId (identityId string) bool {
 	}
 	return false
 }
+
+// SetOrgQuota adds or changes the quota of the local power used by the tasks of a remote identity.
+func (m *Manager) SetOrgQuota(quota *types.OrgQuota) error {
+	if "" == quota.IdentityId {
+		return ErrOrgQuotaIdentityEmpty
+	}
+	m.quotaLock.Lock()
+	defer m.quotaLock.Unlock()
+	return m.dataCenter.StoreOrgQuota(quota)
+}
+
+// RemoveOrgQuota removes the quota of identity, then its tasks are only limited by the local resource.
+func (m *Manager) RemoveOrgQuota(identityId string) error {
+	m.quotaLock.Lock()
+	defer m.quotaLock.Unlock()
+	return m.dataCenter.RemoveOrgQuota(identityId)
+}
+
+func (m *Manager) GetOrgQuota(identityId string) (*types.OrgQuota, error) {
+	return m.dataCenter.QueryOrgQuota(identityId)
+}
+
+func (m *Manager) GetOrgQuotas() ([]*types.OrgQuota, error) {
+	return m.dataCenter.QueryOrgQuotas()
+}
+
+// GetOrgQuotaUsage returns the current usage of the local power by the tasks of identity.
+func (m *Manager) GetOrgQuotaUsage(identityId string) (*types.OrgQuotaUsage, error) {
+	m.quotaLock.Lock()
+	defer m.quotaLock.Unlock()
+	return m.queryOrgQuotaUsage(identityId)
+}
+
+func (m *Manager) queryOrgQuotaUsage(identityId string) (*types.OrgQuotaUsage, error) {
+	powerUseds, err := m.dataCenter.QueryLocalTaskPowerUseds()
+	if nil != err && err != rawdb.ErrNotFound {
+		return nil, err
+	}
+	daily, err := m.dataCenter.QueryOrgDailySlotUsage(identityId)
+	if nil != err && err != rawdb.ErrNotFound {
+		return nil, err
+	}
+	return types.NewOrgQuotaUsage(identityId, powerUseds, daily, uint64(timeutils.UnixMsec())), nil
+}
+
+// checkOrgQuota checks whether the task of identity using `needSlotCount` slots exceeds the quota of identity.
+// (called with quotaLock held)
+func (m *Manager) checkOrgQuota(identityId string, needSlotCount uint64) error {
+	quota, err := m.dataCenter.QueryOrgQuota(identityId)
+	if rawdb.IsDBNotFoundErr(err) {
+		return nil
+	}
+	if nil != err {
+		return fmt.Errorf("failed to query the quota of identity, %s", err)
+	}
+	usage, err := m.queryOrgQuotaUsage(identityId)
+	if nil != err {
+		return fmt.Errorf("failed to query the quota usage of identity, %s", err)
+	}
+	return quota.Check(usage, needSlotCount)
+}
+
+// settleOrgDailySlotUsage adds the slot-time used today by the finished task into the daily usage of its owner.
+// (called with quotaLock held)
+func (m *Manager) settleOrgDailySlotUsage(used *types.LocalTaskPowerUsed) {
+	if "" == used.GetIdentityId() {
+		return
+	}
+	now := uint64(timeutils.UnixMsec())
+	daily, err := m.dataCenter.QueryOrgDailySlotUsage(used.GetIdentityId())
+	if nil != err && !rawdb.IsDBNotFoundErr(err) {
+		log.Errorf("Failed to query the daily slot usage of identity, identityId: {%s}, err: {%s}", used.GetIdentityId(), err)
+		return
+	}
+	if nil == daily || daily.Day != types.UnixDay(now) {
+		daily = &types.OrgDailySlotUsage{IdentityId: used.GetIdentityId(), Day: types.UnixDay(now)}
+	}
+	daily.SlotMsec += types.TodaySlotMsec(used, now)
+	if err := m.dataCenter.StoreOrgDailySlotUsage(daily); nil != err {
+		log.Errorf("Failed to store the daily slot usage of identity, identityId: {%s}, err: {%s}", used.GetIdentityId(), err)
+	}
+}
@@ -1,9 +1,15 @@
 package core
 
+import "github.com/RosettaFlow/Carrier-Go/types"
+
 
 type Scheduler interface {
 	Start() error
 	Stop() error
 	Error () error
 	Name() string
+	// remove the local task which is still waiting to be scheduled
+	RemoveTask(taskId string) error
+	// run the election of the task without queueing it, and explain the result
+	ExplainSchedule(task *types.TaskMsg) (*types.ScheduleExplain, error)
 }
\ No newline at end of file
@@ -0,0 +1,136 @@
+package scheduler
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"fmt"
+	libTypes "github.com/RosettaFlow/Carrier-Go/lib/types"
+	"github.com/RosettaFlow/Carrier-Go/p2p"
+	"github.com/RosettaFlow/Carrier-Go/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"sort"
+)
+
+// the domain of the hash signed by task owner, so that the proof can not be reused as other signatures.
+const electionProofDomain = "carrier-power-org-election"
+
+// the domain of the hash of the powers attested by task owner.
+const electionPowersDomain = "carrier-power-org-attestation"
+
+var (
+	ErrElectionProofInvalid      = fmt.Errorf("the election proof of task is invalid")
+	ErrElectionPowersDiffer      = fmt.Errorf("the powers of task differ from the powers re-elected by the election seed")
+	ErrElectionPowersSignInvalid = fmt.Errorf("the attestation of the powers of task is invalid")
+)
+
+// makeElectionProof makes the verifiable random seed of the power org election of task.
+// The proof is the signature of task owner on the taskId, the signature of secp256k1 is
+// deterministic (RFC6979) and normalized to the lower S, so the owner can not choose the seed.
+func makeElectionProof(priKey *ecdsa.PrivateKey, taskId string) (*types.ElectionProof, error) {
+	if nil == priKey {
+		return nil, fmt.Errorf("%s, the node private key is empty", ErrElectionProofInvalid)
+	}
+	proof, err := crypto.Sign(electionProofHash(taskId), priKey)
+	if nil != err {
+		return nil, err
+	}
+	return &types.ElectionProof{
+		Seed:  electionSeed(proof),
+		Proof: proof,
+	}, nil
+}
+
+// verifyElectionProof checks that the proof is signed by the task owner on the taskId, and the seed is derived from the proof.
+func verifyElectionProof(ownerNodeId, taskId string, election *types.ElectionProof) error {
+	if nil == election || len(election.Proof) != crypto.SignatureLength {
+		return fmt.Errorf("%s, the proof is empty or malformed", ErrElectionProofInvalid)
+	}
+	nodeId, err := p2p.HexID(ownerNodeId)
+	if nil != err {
+		return fmt.Errorf("%s, the owner nodeId is invalid, %s", ErrElectionProofInvalid, err)
+	}
+	pubKey, err := nodeId.Pubkey()
+	if nil != err {
+		return fmt.Errorf("%s, the owner nodeId is invalid, %s", ErrElectionProofInvalid, err)
+	}
+	// VerifySignature rejects the malleable signature with the upper S
+	if !crypto.VerifySignature(crypto.FromECDSAPub(pubKey), electionProofHash(taskId), election.Proof[:crypto.RecoveryIDOffset]) {
+		return fmt.Errorf("%s, the proof is not signed by the task owner", ErrElectionProofInvalid)
+	}
+	if !bytes.Equal(election.Seed, electionSeed(election.Proof)) {
+		return fmt.Errorf("%s, the seed is not derived from the proof", ErrElectionProofInvalid)
+	}
+	return nil
+}
+
+// attestElectionPowers signs the powers which are not elected by the seed of the proof (reused from the previous attempt),
+// the attestation binds the powers to the proof of the task.
+func attestElectionPowers(priKey *ecdsa.PrivateKey, election *types.ElectionProof, powers []*libTypes.TaskResourceSupplierData) error {
+	if nil == priKey {
+		return fmt.Errorf("%s, the node private key is empty", ErrElectionPowersSignInvalid)
+	}
+	sign, err := crypto.Sign(electionPowersHash(election.Proof, powers), priKey)
+	if nil != err {
+		return err
+	}
+	election.PowersSign = sign
+	return nil
+}
+
+// verifyElectionPowers checks that the powers are attested by the task owner with the proof.
+func verifyElectionPowers(ownerNodeId string, election *types.ElectionProof, powers []*libTypes.TaskResourceSupplierData) error {
+	if len(election.PowersSign) != crypto.SignatureLength {
+		return fmt.Errorf("%s, the attestation is empty or malformed", ErrElectionPowersSignInvalid)
+	}
+	nodeId, err := p2p.HexID(ownerNodeId)
+	if nil != err {
+		return fmt.Errorf("%s, the owner nodeId is invalid, %s", ErrElectionPowersSignInvalid, err)
+	}
+	pubKey, err := nodeId.Pubkey()
+	if nil != err {
+		return fmt.Errorf("%s, the owner nodeId is invalid, %s", ErrElectionPowersSignInvalid, err)
+	}
+	if !crypto.VerifySignature(crypto.FromECDSAPub(pubKey), electionPowersHash(election.Proof, powers), election.PowersSign[:crypto.RecoveryIDOffset]) {
+		return fmt.Errorf("%s, the powers are not attested by the task owner", ErrElectionPowersSignInvalid)
+	}
+	return nil
+}
+
+// electionPowersHash hashes the proof and the sorted identityIds of the powers, so the order of the powers is not attested.
+func electionPowersHash(proof []byte, powers []*libTypes.TaskResourceSupplierData) []byte {
+	identityIds := make([]string, len(powers))
+	for i, power := range powers {
+		identityIds[i] = power.GetOrganization().GetIdentity()
+	}
+	sort.Strings(identityIds)
+	// the identityIds are rlp encoded, so that they can not be joined to another list
+	data, _ := rlp.EncodeToBytes([]interface{}{electionPowersDomain, proof, identityIds})
+	return crypto.Keccak256(data)
+}
+
+func electionProofHash(taskId string) []byte {
+	return crypto.Keccak256([]byte(electionProofDomain), []byte(taskId))
+}
+
+func electionSeed(proof []byte) []byte {
+	return crypto.Keccak256(proof[:crypto.RecoveryIDOffset])
+}
+
+// electOrgsBySeed ranks the candidates by keccak256(seed || identityId) and elects the first `count` of them,
+// so that anyone who has the same seed and candidates gets the same result.
+func electOrgsBySeed(seed []byte, candidates []*types.RemoteResourceTable, count int) ([]*types.RemoteResourceTable, error) {
+	if count > len(candidates) {
+		return nil, ErrElectionCandidatesLess
+	}
+	scores := make(map[string][]byte, len(candidates))
+	sorted := make([]*types.RemoteResourceTable, len(candidates))
+	copy(sorted, candidates)
+	for _, r := range sorted {
+		scores[r.GetIdentityId()] = crypto.Keccak256(seed, []byte(r.GetIdentityId()))
+	}
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return bytes.Compare(scores[sorted[i].GetIdentityId()], scores[sorted[j].GetIdentityId()]) < 0
+	})
+	return sorted[:count], nil
+}
@@ -0,0 +1,495 @@
+package scheduler
+
+import (
+	"container/heap"
+	"fmt"
+	"github.com/RosettaFlow/Carrier-Go/types"
+	"math/rand"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	// The queueing policies of the local tasks waiting to be scheduled
+	QueuePolicyStarveFIFO = "starve-fifo"
+	QueuePolicyFIFO       = "fifo"
+
+	// The strategies of org election and local jobNode placement
+	PolicyVRF            = "vrf" // only for org election
+	PolicyRoundRobin     = "round-robin"
+	PolicyLeastLoaded    = "least-loaded"
+	PolicyWeightedRandom = "weighted-random"
+
+	// The default max count of the queued high priority tasks of every identity
+	DefaultHighPriorityCap = 8
+)
+
+var (
+	ErrUnknownQueuePolicy     = fmt.Errorf("unknown queue policy of scheduler")
+	ErrUnknownElectionPolicy  = fmt.Errorf("unknown org election policy of scheduler")
+	ErrUnknownPlacementPolicy = fmt.Errorf("unknown jobNode placement policy of scheduler")
+	ErrElectionCandidatesLess = fmt.Errorf("the candidates count is less than the count to election")
+	ErrPlacementCandidateless = fmt.Errorf("there is no candidate to place the task")
+)
+
+// TaskQueuePolicy decides which local task will be scheduled next.
+//
+// NOTE: the implementations are not concurrent safe, the scheduler calls them with its queueLock held.
+type TaskQueuePolicy interface {
+	Name() string
+	// Push puts a new task or a task need to be rescheduled into the queue.
+	Push(bullet *types.TaskBullet)
+	// Pop takes the next task to be scheduled, returns nil if the queue is empty.
+	Pop() *types.TaskBullet
+	// Remove takes the task away from the queue, returns nil if it is not found.
+	Remove(taskId string) *types.TaskBullet
+	// Tick is called at the beginning of every round of schedule.
+	Tick()
+	Len() int
+	// Count returns the count of the queued tasks which match the filter.
+	Count(filter func(bullet *types.TaskBullet) bool) int
+	// List returns all the queued tasks (in no particular order).
+	List() []*types.TaskBullet
+}
+
+// OrgElectionPolicy elects the power orgs of task from the remote orgs which have enough resource for the task.
+type OrgElectionPolicy interface {
+	Name() string
+	// Verifiable reports whether the task partners can re-run the election with the seed of task,
+	// the partners refuse the powers elected by the policy which is not verifiable.
+	Verifiable() bool
+	// Elect elects `count` orgs from the candidates, the policy which is not verifiable ignores the seed.
+	Elect(seed []byte, candidates []*types.RemoteResourceTable, count int) ([]*types.RemoteResourceTable, error)
+	// Peek elects like `Elect` but never changes the state of the policy, it is used by the dry-run of schedule.
+	Peek(seed []byte, candidates []*types.RemoteResourceTable, count int) ([]*types.RemoteResourceTable, error)
+}
+
+// NodePlacementPolicy picks the local jobNode for task from the jobNodes which have enough slots for the task.
+type NodePlacementPolicy interface {
+	Name() string
+	Place(candidates []*types.LocalResourceTable, needSlotCount uint32) (*types.LocalResourceTable, error)
+	// Peek places like `Place` but never changes the state of the policy, it is used by the dry-run of schedule.
+	Peek(candidates []*types.LocalResourceTable, needSlotCount uint32) (*types.LocalResourceTable, error)
+}
+
+// SchedulePolicy is the set of policies used by the scheduler.
+type SchedulePolicy struct {
+	Queue     TaskQueuePolicy
+	Election  OrgElectionPolicy
+	Placement NodePlacementPolicy
+	// The max count of the queued tasks with high priority of every identity,
+	// the priority of the task beyond the cap is downgraded to normal, 0 means unlimited.
+	HighPriorityCap uint32
+}
+
+// NewSchedulePolicy makes the SchedulePolicy by the names of policies,
+// and the default one is used if the name is empty.
+func NewSchedulePolicy(queue, election, placement string) (*SchedulePolicy, error) {
+	policy := &SchedulePolicy{}
+
+	switch queue {
+	case QueuePolicyStarveFIFO, "":
+		policy.Queue = newStarveFIFOQueue()
+	case QueuePolicyFIFO:
+		policy.Queue = newFIFOQueue()
+	default:
+		return nil, fmt.Errorf("%s, policy: {%s}", ErrUnknownQueuePolicy, queue)
+	}
+
+	switch election {
+	case PolicyVRF, "":
+		policy.Election = &vrfElection{}
+	case PolicyRoundRobin:
+		policy.Election = &roundRobinElection{}
+	case PolicyLeastLoaded:
+		policy.Election = &leastLoadedElection{}
+	case PolicyWeightedRandom:
+		policy.Election = &weightedRandomElection{rnd: rand.New(rand.NewSource(time.Now().UnixNano()))}
+	default:
+		return nil, fmt.Errorf("%s, policy: {%s}", ErrUnknownElectionPolicy, election)
+	}
+
+	switch placement {
+	case PolicyRoundRobin, "":
+		policy.Placement = &roundRobinPlacement{}
+	case PolicyLeastLoaded:
+		policy.Placement = &leastLoadedPlacement{}
+	case PolicyWeightedRandom:
+		policy.Placement = &weightedRandomPlacement{rnd: rand.New(rand.NewSource(time.Now().UnixNano()))}
+	default:
+		return nil, fmt.Errorf("%s, policy: {%s}", ErrUnknownPlacementPolicy, placement)
+	}
+	return policy, nil
+}
+
+func (p *SchedulePolicy) String() string {
+	return fmt.Sprintf(`{"queue": "%s", "election": "%s", "placement": "%s", "highPriorityCap": %d}`,
+		p.Queue.Name(), p.Election.Name(), p.Placement.Name(), p.HighPriorityCap)
+}
+
+// ------------------------------------------- queue policies -------------------------------------------
+
+// starveFIFOQueue pops the task with the highest priority first, and the one which has waited
+// for the longest time in the same priority, the task which has waited for `StarveTerm` rounds
+// is moved into the starveQueue, the tasks on starveQueue are always scheduled before the tasks on queue.
+type starveFIFOQueue struct {
+	// the local task into this queue, first
+	queue *types.TaskBullets
+	// the very very starve local task by priority
+	starveQueue *types.TaskBullets
+}
+
+func newStarveFIFOQueue() *starveFIFOQueue {
+	return &starveFIFOQueue{
+		queue:       new(types.TaskBullets),
+		starveQueue: new(types.TaskBullets),
+	}
+}
+
+func (q *starveFIFOQueue) Name() string { return QueuePolicyStarveFIFO }
+func (q *starveFIFOQueue) Len() int     { return q.queue.Len() + q.starveQueue.Len() }
+func (q *starveFIFOQueue) Push(bullet *types.TaskBullet) {
+	if bullet.Starve {
+		heap.Push(q.starveQueue, bullet)
+	} else {
+		heap.Push(q.queue, bullet)
+	}
+}
+
+func (q *starveFIFOQueue) Pop() *types.TaskBullet {
+	if q.starveQueue.Len() != 0 {
+		return heap.Pop(q.starveQueue).(*types.TaskBullet)
+	}
+	if q.queue.Len() != 0 {
+		return heap.Pop(q.queue).(*types.TaskBullet)
+	}
+	return nil
+}
+
+func (q *starveFIFOQueue) Remove(taskId string) *types.TaskBullet {
+	if bullet := removeTaskBulletFromQueue(q.starveQueue, taskId); nil != bullet {
+		return bullet
+	}
+	return removeTaskBulletFromQueue(q.queue, taskId)
+}
+
+func (q *starveFIFOQueue) Count(filter func(bullet *types.TaskBullet) bool) int {
+	return countTaskBullets(*(q.starveQueue), filter) + countTaskBullets(*(q.queue), filter)
+}
+
+func (q *starveFIFOQueue) List() []*types.TaskBullet {
+	bullets := make([]*types.TaskBullet, 0, q.Len())
+	bullets = append(bullets, *(q.starveQueue)...)
+	return append(bullets, *(q.queue)...)
+}
+
+func (q *starveFIFOQueue) Tick() {
+	// handle starve queue
+	q.starveQueue.IncreaseTerm()
+
+	// handle queue
+	i := 0
+	for {
+		if i == q.queue.Len() {
+			return
+		}
+		bullet := (*(q.queue))[i]
+		bullet.IncreaseTerm()
+
+		// When the task in the queue meets hunger, it will be transferred to starveQueue
+		if bullet.Term >= StarveTerm {
+			bullet.Starve = true
+			heap.Push(q.starveQueue, bullet)
+			heap.Remove(q.queue, i)
+			i = 0
+			continue
+		}
+		(*(q.queue))[i] = bullet
+		i++
+	}
+}
+
+// fifoQueue pops the tasks by the order they are pushed in the same priority,
+// the task need to be rescheduled is put at the tail of its priority.
+type fifoQueue struct {
+	bullets []*types.TaskBullet
+}
+
+func newFIFOQueue() *fifoQueue { return &fifoQueue{bullets: make([]*types.TaskBullet, 0)} }
+
+func (q *fifoQueue) Name() string { return QueuePolicyFIFO }
+func (q *fifoQueue) Len() int     { return len(q.bullets) }
+func (q *fifoQueue) Tick()        {}
+func (q *fifoQueue) Push(bullet *types.TaskBullet) {
+	// behind the last task which should not be scheduled after it (by priority and deadline)
+	i := sort.Search(len(q.bullets), func(i int) bool { return bullet.Before(q.bullets[i]) })
+	q.bullets = append(q.bullets, nil)
+	copy(q.bullets[i+1:], q.bullets[i:])
+	q.bullets[i] = bullet
+}
+func (q *fifoQueue) Count(filter func(bullet *types.TaskBullet) bool) int {
+	return countTaskBullets(q.bullets, filter)
+}
+func (q *fifoQueue) List() []*types.TaskBullet {
+	return append(make([]*types.TaskBullet, 0, len(q.bullets)), q.bullets...)
+}
+func (q *fifoQueue) Pop() *types.TaskBullet {
+	if len(q.bullets) == 0 {
+		return nil
+	}
+	bullet := q.bullets[0]
+	q.bullets[0] = nil
+	q.bullets = q.bullets[1:]
+	return bullet
+}
+
+func (q *fifoQueue) Remove(taskId string) *types.TaskBullet {
+	for i, bullet := range q.bullets {
+		if bullet.UnschedTask.Data.TaskId() == taskId {
+			q.bullets = append(q.bullets[:i], q.bullets[i+1:]...)
+			return bullet
+		}
+	}
+	return nil
+}
+
+func countTaskBullets(bullets []*types.TaskBullet, filter func(bullet *types.TaskBullet) bool) int {
+	count := 0
+	for _, bullet := range bullets {
+		if filter(bullet) {
+			count++
+		}
+	}
+	return count
+}
+
+// ------------------------------------------- election policies -------------------------------------------
+
+// vrfElection elects the orgs by the verifiable random seed of task, see `electOrgsBySeed`.
+type vrfElection struct{}
+
+func (e *vrfElection) Name() string     { return PolicyVRF }
+func (e *vrfElection) Verifiable() bool { return true }
+func (e *vrfElection) Elect(seed []byte, candidates []*types.RemoteResourceTable, count int) ([]*types.RemoteResourceTable, error) {
+	if 0 == len(seed) {
+		return nil, fmt.Errorf("%s, the seed is empty", ErrElectionProofInvalid)
+	}
+	return electOrgsBySeed(seed, candidates, count)
+}
+func (e *vrfElection) Peek(seed []byte, candidates []*types.RemoteResourceTable, count int) ([]*types.RemoteResourceTable, error) {
+	return e.Elect(seed, candidates, count)
+}
+
+// roundRobinElection elects the orgs one by one from where the last election stopped.
+type roundRobinElection struct {
+	lock   sync.Mutex
+	cursor int
+}
+
+func (e *roundRobinElection) Name() string     { return PolicyRoundRobin }
+func (e *roundRobinElection) Verifiable() bool { return false }
+func (e *roundRobinElection) Elect(seed []byte, candidates []*types.RemoteResourceTable, count int) ([]*types.RemoteResourceTable, error) {
+	if count > len(candidates) || 0 == len(candidates) {
+		return nil, ErrElectionCandidatesLess
+	}
+	e.lock.Lock()
+	start := e.cursor % len(candidates)
+	e.cursor = (start + count) % len(candidates)
+	e.lock.Unlock()
+	return electFrom(start, candidates, count), nil
+}
+func (e *roundRobinElection) Peek(seed []byte, candidates []*types.RemoteResourceTable, count int) ([]*types.RemoteResourceTable, error) {
+	if count > len(candidates) || 0 == len(candidates) {
+		return nil, ErrElectionCandidatesLess
+	}
+	e.lock.Lock()
+	start := e.cursor % len(candidates)
+	e.lock.Unlock()
+	return electFrom(start, candidates, count), nil
+}
+
+// electFrom elects `count` orgs one by one from the candidate at `start`.
+func electFrom(start int, candidates []*types.RemoteResourceTable, count int) []*types.RemoteResourceTable {
+	elected := make([]*types.RemoteResourceTable, count)
+	for i := 0; i < count; i++ {
+		elected[i] = candidates[(start+i)%len(candidates)]
+	}
+	return elected
+}
+
+// leastLoadedElection elects the orgs which have the most remaining resource.
+type leastLoadedElection struct{}
+
+func (e *leastLoadedElection) Name() string     { return PolicyLeastLoaded }
+func (e *leastLoadedElection) Verifiable() bool { return false }
+func (e *leastLoadedElection) Elect(seed []byte, candidates []*types.RemoteResourceTable, count int) ([]*types.RemoteResourceTable, error) {
+	if count > len(candidates) {
+		return nil, ErrElectionCandidatesLess
+	}
+	sorted := make([]*types.RemoteResourceTable, len(candidates))
+	copy(sorted, candidates)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return remoteRemainRatio(sorted[i]) > remoteRemainRatio(sorted[j])
+	})
+	return sorted[:count], nil
+}
+func (e *leastLoadedElection) Peek(seed []byte, candidates []*types.RemoteResourceTable, count int) ([]*types.RemoteResourceTable, error) {
+	return e.Elect(seed, candidates, count)
+}
+
+// weightedRandomElection elects the orgs randomly, and the org which has more remaining resource is more likely to be elected.
+type weightedRandomElection struct {
+	lock sync.Mutex
+	rnd  *rand.Rand
+}
+
+func (e *weightedRandomElection) Name() string     { return PolicyWeightedRandom }
+func (e *weightedRandomElection) Verifiable() bool { return false }
+func (e *weightedRandomElection) Elect(seed []byte, candidates []*types.RemoteResourceTable, count int) ([]*types.RemoteResourceTable, error) {
+	if count > len(candidates) {
+		return nil, ErrElectionCandidatesLess
+	}
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	return electWeighted(e.rnd, candidates, count), nil
+}
+
+// Peek elects by its own random source, so the random sequence of the policy is not consumed.
+func (e *weightedRandomElection) Peek(seed []byte, candidates []*types.RemoteResourceTable, count int) ([]*types.RemoteResourceTable, error) {
+	if count > len(candidates) {
+		return nil, ErrElectionCandidatesLess
+	}
+	return electWeighted(rand.New(rand.NewSource(time.Now().UnixNano())), candidates, count), nil
+}
+
+func electWeighted(rnd *rand.Rand, candidates []*types.RemoteResourceTable, count int) []*types.RemoteResourceTable {
+	remain := make([]*types.RemoteResourceTable, len(candidates))
+	copy(remain, candidates)
+	weights := make([]float64, len(candidates))
+	for i, r := range remain {
+		weights[i] = remoteRemainRatio(r)
+	}
+
+	elected := make([]*types.RemoteResourceTable, 0, count)
+	for len(elected) < count {
+		i := weightedIndex(rnd, weights)
+		elected = append(elected, remain[i])
+		remain = append(remain[:i], remain[i+1:]...)
+		weights = append(weights[:i], weights[i+1:]...)
+	}
+	return elected
+}
+
+// remoteRemainRatio is the sum of the remaining ratio of mem, processor and bandwidth of the org.
+func remoteRemainRatio(r *types.RemoteResourceTable) float64 {
+	mem, processor, bandwidth := r.Remain()
+	return ratio(mem, r.GetTotalMem()) + ratio(processor, r.GetTotalProcessor()) + ratio(bandwidth, r.GetTotalBandwidth())
+}
+
+func ratio(remain, total uint64) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(remain) / float64(total)
+}
+
+// ------------------------------------------- placement policies -------------------------------------------
+
+// roundRobinPlacement places the tasks on the jobNodes in turn.
+type roundRobinPlacement struct {
+	lock   sync.Mutex
+	cursor int
+}
+
+func (p *roundRobinPlacement) Name() string { return PolicyRoundRobin }
+func (p *roundRobinPlacement) Place(candidates []*types.LocalResourceTable, needSlotCount uint32) (*types.LocalResourceTable, error) {
+	if len(candidates) == 0 {
+		return nil, ErrPlacementCandidateless
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	index := p.cursor % len(candidates)
+	p.cursor = index + 1
+	return candidates[index], nil
+}
+func (p *roundRobinPlacement) Peek(candidates []*types.LocalResourceTable, needSlotCount uint32) (*types.LocalResourceTable, error) {
+	if len(candidates) == 0 {
+		return nil, ErrPlacementCandidateless
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return candidates[p.cursor%len(candidates)], nil
+}
+
+// leastLoadedPlacement places the task on the jobNode which has the most remaining slots.
+type leastLoadedPlacement struct{}
+
+func (p *leastLoadedPlacement) Name() string { return PolicyLeastLoaded }
+func (p *leastLoadedPlacement) Place(candidates []*types.LocalResourceTable, needSlotCount uint32) (*types.LocalResourceTable, error) {
+	if len(candidates) == 0 {
+		return nil, ErrPlacementCandidateless
+	}
+	picked := candidates[0]
+	for _, r := range candidates[1:] {
+		if r.RemianSlot() > picked.RemianSlot() {
+			picked = r
+		}
+	}
+	return picked, nil
+}
+func (p *leastLoadedPlacement) Peek(candidates []*types.LocalResourceTable, needSlotCount uint32) (*types.LocalResourceTable, error) {
+	return p.Place(candidates, needSlotCount)
+}
+
+// weightedRandomPlacement places the task randomly, and the jobNode which has more remaining slots is more likely to be picked.
+type weightedRandomPlacement struct {
+	lock sync.Mutex
+	rnd  *rand.Rand
+}
+
+func (p *weightedRandomPlacement) Name() string { return PolicyWeightedRandom }
+func (p *weightedRandomPlacement) Place(candidates []*types.LocalResourceTable, needSlotCount uint32) (*types.LocalResourceTable, error) {
+	if len(candidates) == 0 {
+		return nil, ErrPlacementCandidateless
+	}
+	weights := make([]float64, len(candidates))
+	for i, r := range candidates {
+		weights[i] = float64(r.RemianSlot())
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return candidates[weightedIndex(p.rnd, weights)], nil
+}
+
+// Peek places by its own random source, so the random sequence of the policy is not consumed.
+func (p *weightedRandomPlacement) Peek(candidates []*types.LocalResourceTable, needSlotCount uint32) (*types.LocalResourceTable, error) {
+	if len(candidates) == 0 {
+		return nil, ErrPlacementCandidateless
+	}
+	weights := make([]float64, len(candidates))
+	for i, r := range candidates {
+		weights[i] = float64(r.RemianSlot())
+	}
+	return candidates[weightedIndex(rand.New(rand.NewSource(time.Now().UnixNano())), weights)], nil
+}
+
+// weightedIndex picks an index randomly by the weights,
+// and picks it uniformly if all of the weights are zero.
+func weightedIndex(rnd *rand.Rand, weights []float64) int {
+	var total float64
+	for _, w := range weights {
+		total += w
+	}
+	if total <= 0 {
+		return rnd.Intn(len(weights))
+	}
+	point := rnd.Float64() * total
+	for i, w := range weights {
+		if point < w {
+			return i
+		}
+		point -= w
+	}
+	return len(weights) - 1
+}
@@ -2,6 +2,7 @@ package scheduler
 
 import (
 	"container/heap"
+	"crypto/ecdsa"
 	"fmt"
 	"github.com/RosettaFlow/Carrier-Go/common"
 	"github.com/RosettaFlow/Carrier-Go/common/timeutils"
@@ -14,7 +15,9 @@ import (
 	"github.com/RosettaFlow/Carrier-Go/types"
 	"github.com/pkg/errors"
 	log "github.com/sirupsen/logrus"
+	"reflect"
 	"strings"
+	"sync"
 	"time"
 )
 
@@ -22,23 +25,26 @@ const (
 	ReschedMaxCount             = 8
 	StarveTerm                  = 3
 	defaultScheduleTaskInterval = 2 * time.Second
-	electionOrgCondition        = 10000
-	electionLocalSeed           = 2
 	//taskComputeOrgCount         = 3
 )
 
 var (
 	ErrEnoughResourceOrgCountLessCalculateCount = fmt.Errorf("the enough resource org count is less calculate count")
 	ErrEnoughInternalResourceCount              = fmt.Errorf("has not enough internal resource count")
+	ErrTaskNotFoundOnQueue                      = fmt.Errorf("the task is not found on the queue of scheduler")
 )
 
 type SchedulerStarveFIFO struct {
 	internalNodeSet *grpclient.InternalResourceClientSet
 	resourceMng     *resource.Manager
-	// the local task into this queue, first
-	queue *types.TaskBullets
-	// the very very starve local task by priority
-	starveQueue *types.TaskBullets
+	// the queueing policy, org election and local jobNode placement strategies of scheduler
+	policy *SchedulePolicy
+	// sign the seed of org election
+	nodePriKey *ecdsa.PrivateKey
+	// guard the queue of policy and storedBullets
+	queueLock sync.Mutex
+	// the last stored states of the tasks on scheduling (taskId -> record), so that only the changed ones are stored
+	storedBullets map[string]*types.TaskBulletRecord
 
 	// fetch local task from taskManager`
 	localTaskMsgCh chan types.TaskMsgs
@@ -63,19 +69,22 @@ func NewSchedulerStarveFIFO(
 	needConsensusTaskCh chan *types.ConsensusTaskWrap,
 	replayScheduleTaskCh chan *types.ReplayScheduleTaskWrap,
 	doneSchedTaskCh chan *types.DoneScheduleTaskChWrap,
+	policy *SchedulePolicy,
+	nodePriKey *ecdsa.PrivateKey,
 ) *SchedulerStarveFIFO {
 
 	return &SchedulerStarveFIFO{
 		internalNodeSet:      internalNodeSet,
 		resourceMng:          mng,
-		queue:                new(types.TaskBullets),
-		starveQueue:          new(types.TaskBullets),
+		policy:               policy,
+		nodePriKey:           nodePriKey,
 		localTaskMsgCh:       localTaskMsgCh,
 		needConsensusTaskCh:  needConsensusTaskCh,
 		replayScheduleTaskCh: replayScheduleTaskCh,
 		doneSchedTaskCh:      doneSchedTaskCh,
 		dataCenter:           dataCenter,
 		eventEngine:          eventEngine,
+		storedBullets:        make(map[string]*types.TaskBulletRecord),
 		quit:                 make(chan struct{}),
 	}
 }
@@ -89,7 +98,7 @@ func (sche *SchedulerStarveFIFO) loop() {
 
 			for _, task := range tasks {
 
-				log.Debugf("Received local task, taskId: {%s}, partyId: {%s}", task.TaskId, task.Data.TaskData().PartyId)
+				log.Debugf("Received local task, taskId: {%s}, partyId: {%s}, priority: {%s}", task.TaskId, task.Data.TaskData().PartyId, task.Data.Priority().String())
 
 				if err := sche.dataCenter.StoreLocalTask(task.Data); nil != err {
 
@@ -151,8 +160,9 @@ func (sche *SchedulerStarveFIFO) loop() {
 }
 
 func (sche *SchedulerStarveFIFO) Start() error {
+	sche.recoverQueue()
 	go sche.loop()
-	log.Info("Started SchedulerStarveFIFO ...")
+	log.Infof("Started SchedulerStarveFIFO ..., policy: %s", sche.policy.String())
 	return nil
 }
 func (sche *SchedulerStarveFIFO) Stop() error {
@@ -162,25 +172,219 @@ func (sche *SchedulerStarveFIFO) Stop() error {
 func (sche *SchedulerStarveFIFO) Error() error { return sche.err }
 func (sche *SchedulerStarveFIFO) Name() string { return "SchedulerStarveFIFO" }
 func (sche *SchedulerStarveFIFO) addTaskBullet(bullet *types.TaskBullet) {
-	heap.Push(sche.queue, bullet)
+	sche.queueLock.Lock()
+	downgraded := sche.capTaskPriority(bullet)
+	sche.policy.Queue.Push(bullet)
+	sche.storeTaskBullets(bullet)
+	sche.queueLock.Unlock()
+
+	if downgraded {
+		sche.eventEngine.StoreEvent(sche.eventEngine.GenerateEvent(evengine.TaskPriorityDowngraded.Type,
+			bullet.UnschedTask.Data.TaskId(), bullet.UnschedTask.Data.TaskData().Identity,
+			fmt.Sprintf("the high priority cap of identity is %d", sche.policy.HighPriorityCap)))
+	}
+}
+
+// capTaskPriority downgrades the priority of task to normal, if the identity of task owner
+// already has `HighPriorityCap` tasks with high priority on the queue. (called with queueLock held)
+func (sche *SchedulerStarveFIFO) capTaskPriority(bullet *types.TaskBullet) bool {
+	if 0 == sche.policy.HighPriorityCap || !bullet.Priority.IsHigh() {
+		return false
+	}
+	identity := bullet.UnschedTask.Data.TaskData().Identity
+	count := sche.policy.Queue.Count(func(b *types.TaskBullet) bool {
+		return b.Priority.IsHigh() && b.UnschedTask.Data.TaskData().Identity == identity
+	})
+	if uint32(count) < sche.policy.HighPriorityCap {
+		return false
+	}
+	log.Warnf("The high priority tasks of identity on queue reach the cap, downgrade the task priority to normal, taskId: {%s}, identity: {%s}, priority: {%s}, cap: {%d}",
+		bullet.UnschedTask.Data.TaskId(), identity, bullet.Priority.String(), sche.policy.HighPriorityCap)
+	bullet.Priority = types.TaskPriorityNormal
+	return true
 }
+
+// RemoveTask removes the local task which is still waiting on the queue to be scheduled,
+// and sends it to taskManager to finish it with the `cancelled` state.
+func (sche *SchedulerStarveFIFO) RemoveTask(taskId string) error {
+
+	sche.queueLock.Lock()
+	bullet := sche.policy.Queue.Remove(taskId)
+	if nil != bullet {
+		sche.removeTaskBullet(taskId)
+	}
+	sche.queueLock.Unlock()
+
+	if nil == bullet {
+		return fmt.Errorf("%s, taskId: {%s}", ErrTaskNotFoundOnQueue, taskId)
+	}
+
+	log.Infof("Removed task from the queue of scheduler, taskId: {%s}, reschedCount: {%d}", taskId, bullet.Resched)
+
+	sche.SendTaskToTaskManager(makeUnschedTaskDoneWrap(bullet.UnschedTask, types.TaskStateCancelled))
+	return nil
+}
+
+func removeTaskBulletFromQueue(queue *types.TaskBullets, taskId string) *types.TaskBullet {
+	for i := 0; i < queue.Len(); i++ {
+		if (*queue)[i].UnschedTask.Data.TaskId() == taskId {
+			return heap.Remove(queue, i).(*types.TaskBullet)
+		}
+	}
+	return nil
+}
+
+// recoverQueue rebuilds the queue from the stored states of the local tasks which were waiting on it before restart.
+func (sche *SchedulerStarveFIFO) recoverQueue() {
+	records, err := sche.dataCenter.GetScheduleTaskBulletList()
+	if nil != err {
+		log.Errorf("Failed to load the stored queue of scheduler, err: {%s}", err)
+		return
+	}
+
+	sche.queueLock.Lock()
+	defer sche.queueLock.Unlock()
+
+	for _, record := range records {
+		task, err := sche.dataCenter.GetLocalTask(record.TaskId)
+		if nil != err {
+			log.Warnf("Failed to query the local task of the stored queue, drop it, taskId: {%s}, err: {%s}", record.TaskId, err)
+			sche.removeTaskBullet(record.TaskId)
+			continue
+		}
+		sche.storedBullets[record.TaskId] = record
+		sche.policy.Queue.Push(types.NewTaskBulletByRecord(record, task))
+	}
+	if len(records) != 0 {
+		log.Infof("Recovered the queue of scheduler, stored count: {%d}, queued count: {%d}", len(records), sche.policy.Queue.Len())
+	}
+}
+
+// removeExpiredTaskBullets takes the tasks which can no longer start in time away from the queue. (called with queueLock held)
+func (sche *SchedulerStarveFIFO) removeExpiredTaskBullets(now uint64) []*types.TaskBullet {
+	expired := make([]*types.TaskBullet, 0)
+	for _, bullet := range sche.policy.Queue.List() {
+		if nil == bullet.UnschedTask.Data.CheckDeadline(now) {
+			continue
+		}
+		taskId := bullet.UnschedTask.Data.TaskId()
+		sche.policy.Queue.Remove(taskId)
+		sche.removeTaskBullet(taskId)
+		expired = append(expired, bullet)
+	}
+	return expired
+}
+
+// discardExpiredTasks discards the tasks which can no longer start in time, and hands them over to taskManager as failed.
+func (sche *SchedulerStarveFIFO) discardExpiredTasks(bullets []*types.TaskBullet) {
+	now := uint64(timeutils.UnixMsec())
+	for _, bullet := range bullets {
+		task := bullet.UnschedTask
+		log.Warnf("Discard the task which can no longer start in time, taskId: {%s}, startDeadline: {%d}, finishDeadline: {%d}, duration: {%d}, now: {%d}",
+			task.Data.TaskId(), task.Data.StartDeadline(), task.Data.FinishDeadline(), task.Data.TaskData().GetTaskResource().GetDuration(), now)
+		sche.eventEngine.StoreEvent(sche.eventEngine.GenerateEvent(evengine.TaskDeadlineMissed.Type,
+			task.Data.TaskId(), task.Data.TaskData().Identity, fmt.Sprintf("%s, startDeadline: %d, finishDeadline: %d, now: %d",
+				task.Data.CheckDeadline(now), task.Data.StartDeadline(), task.Data.FinishDeadline(), now)))
+		sche.SendTaskToTaskManager(makeUnschedTaskDoneWrap(task, types.TaskStateFailed))
+	}
+}
+
+// storeTaskBullets persists the states of the queued tasks which changed since they were stored last time. (called with queueLock held)
+func (sche *SchedulerStarveFIFO) storeTaskBullets(bullets ...*types.TaskBullet) {
+	records := make([]*types.TaskBulletRecord, 0, len(bullets))
+	for _, bullet := range bullets {
+		record := bullet.Record()
+		if taskBulletRecordChanged(sche.storedBullets[record.TaskId], record) {
+			records = append(records, record)
+		}
+	}
+	if len(records) == 0 {
+		return
+	}
+	if err := sche.dataCenter.StoreScheduleTaskBullets(records); nil != err {
+		log.Errorf("Failed to store the queued tasks of scheduler, count: {%d}, err: {%s}", len(records), err)
+		return
+	}
+	for _, record := range records {
+		sche.storedBullets[record.TaskId] = record
+	}
+}
+
+// taskBulletRecordChanged reports whether the state of task differs from the stored one, the term of the starving
+// task is ignored, since it grows on every tick while the task waits on the starve queue.
+func taskBulletRecordChanged(stored, record *types.TaskBulletRecord) bool {
+	if nil == stored {
+		return true
+	}
+	cmp := *record
+	if stored.Starve && record.Starve {
+		cmp.Term = stored.Term
+	}
+	return !reflect.DeepEqual(*stored, cmp)
+}
+
+// removeTaskBullet deletes the stored state of the task which leaves scheduling for good. (called with queueLock held)
+func (sche *SchedulerStarveFIFO) removeTaskBullet(taskId string) {
+	if err := sche.dataCenter.RemoveScheduleTaskBullet(taskId); nil != err {
+		log.Errorf("Failed to remove the stored state of task on queue, taskId: {%s}, err: {%s}", taskId, err)
+		return
+	}
+	delete(sche.storedBullets, taskId)
+}
+
+// leaveSchedule deletes the stored state of the popped task which will never be repushed.
+func (sche *SchedulerStarveFIFO) leaveSchedule(taskId string) {
+	sche.queueLock.Lock()
+	sche.removeTaskBullet(taskId)
+	sche.queueLock.Unlock()
+}
+
+// makeUnschedTaskDoneWrap makes the task which will never be scheduled any more (as discarded or cancelled)
+// to the DoneScheduleTaskChWrap, so that taskManager can finish it.
+func makeUnschedTaskDoneWrap(task *types.UnSchedTaskWrap, state types.TaskState) *types.DoneScheduleTaskChWrap {
+	return &types.DoneScheduleTaskChWrap{
+		ProposalId:   common.Hash{},
+		SelfTaskRole: types.TaskOnwer,
+		SelfIdentity: &libTypes.OrganizationData{
+			PartyId:  task.Data.TaskData().PartyId,
+			Identity: task.Data.TaskData().Identity,
+			NodeId:   task.Data.TaskData().NodeId,
+			NodeName: task.Data.TaskData().NodeName,
+		},
+		Task: &types.ConsensusScheduleTask{
+			TaskDir:   types.SendTaskDir,
+			TaskState: state,
+			SchedTask: types.ConvertTaskMsgToTaskWithPowers(task.Data, nil),
+		},
+		ResultCh: make(chan *types.TaskResultMsgWrap, 0),
+	}
+}
+
 func (sche *SchedulerStarveFIFO) trySchedule() error {
 
-	sche.increaseTaskTerm()
+	sche.queueLock.Lock()
 
-	var bullet *types.TaskBullet
+	sche.policy.Queue.Tick()
 
-	if sche.starveQueue.Len() != 0 {
-		x := heap.Pop(sche.starveQueue)
-		bullet = x.(*types.TaskBullet)
-	} else {
-		if sche.queue.Len() != 0 {
-			x := heap.Pop(sche.queue)
-			bullet = x.(*types.TaskBullet)
-		} else {
-			//log.Info("There is not task on FIFO scheduler, finished try schedule timer ...")
-			return nil
+	// take away the tasks which can no longer start in time, before the next one is popped
+	expired := sche.removeExpiredTaskBullets(uint64(timeutils.UnixMsec()))
+
+	bullet := sche.policy.Queue.Pop()
+	// the term and starve state of the queued tasks may be changed by Tick
+	sche.storeTaskBullets(sche.policy.Queue.List()...)
+	if nil == bullet {
+		sche.queueLock.Unlock()
+		if len(expired) != 0 {
+			go sche.discardExpiredTasks(expired)
 		}
+		//log.Info("There is not task on FIFO scheduler, finished try schedule timer ...")
+		return nil
+	}
+	// the popped task keeps its stored state until it leaves scheduling for good (or is stored again by repushing),
+	// so that it is recovered to the queue if the node stops before its proposal is done.
+	sche.queueLock.Unlock()
+	if len(expired) != 0 {
+		go sche.discardExpiredTasks(expired)
 	}
 
 	go func() {
@@ -197,34 +401,12 @@ func (sche *SchedulerStarveFIFO) trySchedule() error {
 					bullet.UnschedTask.Data.TaskId(), bullet.UnschedTask.Data.TaskData().Identity, fmt.Sprintf(
 						"Task rescheduled exceeds the expected threshold")))
 
-				failedTask := &types.DoneScheduleTaskChWrap{
-					ProposalId:   common.Hash{},
-					SelfTaskRole: types.TaskOnwer,
-					SelfIdentity: &libTypes.OrganizationData{
-						PartyId:  task.Data.TaskData().PartyId,
-						Identity: task.Data.TaskData().Identity,
-						NodeId:   task.Data.TaskData().NodeId,
-						NodeName: task.Data.TaskData().NodeName,
-					},
-					Task: &types.ConsensusScheduleTask{
-						TaskDir:   types.SendTaskDir,
-						TaskState: types.TaskStateFailed,
-						SchedTask: types.ConvertTaskMsgToTaskWithPowers(task.Data, nil),
-					},
-					ResultCh: make(chan *types.TaskResultMsgWrap, 0),
-				}
-				sche.SendTaskToTaskManager(failedTask)
+				sche.leaveSchedule(bullet.UnschedTask.Data.TaskId())
+				sche.SendTaskToTaskManager(makeUnschedTaskDoneWrap(task, types.TaskStateFailed))
 			} else {
-				if bullet.Starve {
-					// 被丢弃掉的 task  也要清理掉  本地任务的资源, 并提交到数据中心 ...
-					log.Debugf("Task repush  into starve queue, taskId: {%s}, reschedCount: {%d}, max threshold: {%d}",
-						bullet.UnschedTask.Data.TaskId(), bullet.Resched, ReschedMaxCount)
-					heap.Push(sche.starveQueue, bullet)
-				} else {
-					log.Debugf("Task repush  into queue, taskId: {%s}, reschedCount: {%d}, max threshold: {%d}",
-						bullet.UnschedTask.Data.TaskId(), bullet.Resched, ReschedMaxCount)
-					heap.Push(sche.queue, bullet)
-				}
+				log.Debugf("Task repush  into queue, taskId: {%s}, starve: {%v}, reschedCount: {%d}, max threshold: {%d}",
+					bullet.UnschedTask.Data.TaskId(), bullet.Starve, bullet.Resched, ReschedMaxCount)
+				sche.addTaskBullet(bullet)
 			}
 		}
 
@@ -271,9 +453,30 @@ func (sche *SchedulerStarveFIFO) trySchedule() error {
 		for _, receiver := range task.Data.TaskData().Receivers {
 			dataIdentityIdCache[receiver.Receiver.Identity] = struct{}{}
 		}
-		// 【选出 其他组织的算力】
-		powers, err := sche.electionConputeOrg(task.PowerPartyIds, dataIdentityIdCache, cost)
+		// 选举的随机种子由 task 发起方对 taskId 的签名推导而来, 参与方可以据此重演选举
+		// 每个任务都必须携带选举证明, 重试的任务如果沿用上一次的算力提供方, 则由发起方对选举证明和算力签名背书
+		reusePowers := task.Data.ReusePrevPowers()
+
+		election, err := makeElectionProof(sche.nodePriKey, task.Data.TaskId())
 		if nil != err {
+			log.Errorf("Failed to make election proof on trySchedule, taskId: {%s}, err: {%s}", task.Data.TaskId(), err)
+			sche.eventEngine.StoreEvent(sche.eventEngine.GenerateEvent(evengine.TaskFailedConsensus.Type,
+				task.Data.TaskData().TaskId, task.Data.TaskData().Identity, err.Error()))
+			repushFn(bullet)
+			return
+		}
+
+		// 任务声明的运行时长必须在截止时间之前完成, 否则不再选举算力
+		if err := task.Data.CheckDeadline(uint64(timeutils.UnixMsec())); nil != err {
+			log.Errorf("Failed to check deadline of task before election powers org on trySchedule, taskId: {%s}, err: {%s}", task.Data.TaskId(), err)
+			sche.leaveSchedule(task.Data.TaskId())
+			sche.discardExpiredTasks([]*types.TaskBullet{bullet})
+			return
+		}
+
+		// 算力提供方只接受可由随机种子重演的选举结果 (沿用上一次算力的重试除外)
+		if !reusePowers && !sche.policy.Election.Verifiable() {
+			err := fmt.Errorf("%s, the %s election policy can not be verified by the power suppliers", ErrElectionPowersDiffer, sche.policy.Election.Name())
 			log.Errorf("Failed to election powers org on trySchedule, taskId: {%s}, err: {%s}", task.Data.TaskId(), err)
 			sche.eventEngine.StoreEvent(sche.eventEngine.GenerateEvent(evengine.TaskFailedConsensus.Type,
 				task.Data.TaskData().TaskId, task.Data.TaskData().Identity, err.Error()))
@@ -281,7 +484,34 @@ func (sche *SchedulerStarveFIFO) trySchedule() error {
 			return
 		}
 
-		log.Debugf("Succeed to election powers org on trySchedule, taskId {%s}, powers: %s", task.Data.TaskId(), utilOrgPowerArrString(powers))
+		// 【选出 其他组织的算力】
+		var powers []*libTypes.TaskResourceSupplierData
+		if reusePowers {
+			powers = task.Data.TaskData().ResourceSupplier
+		} else {
+			var err error
+			powers, err = sche.electionConputeOrg(task.PowerPartyIds, dataIdentityIdCache, cost, election, nil)
+			if nil != err {
+				log.Errorf("Failed to election powers org on trySchedule, taskId: {%s}, err: {%s}", task.Data.TaskId(), err)
+				sche.eventEngine.StoreEvent(sche.eventEngine.GenerateEvent(evengine.TaskFailedConsensus.Type,
+					task.Data.TaskData().TaskId, task.Data.TaskData().Identity, err.Error()))
+				repushFn(bullet)
+				return
+			}
+		}
+
+		// the powers reused from the previous attempt are attested with the proof
+		if reusePowers {
+			if err := attestElectionPowers(sche.nodePriKey, election, powers); nil != err {
+				log.Errorf("Failed to attest election powers on trySchedule, taskId: {%s}, err: {%s}", task.Data.TaskId(), err)
+				sche.eventEngine.StoreEvent(sche.eventEngine.GenerateEvent(evengine.TaskFailedConsensus.Type,
+					task.Data.TaskData().TaskId, task.Data.TaskData().Identity, err.Error()))
+				repushFn(bullet)
+				return
+			}
+		}
+
+		log.Debugf("Succeed to election powers org on trySchedule, taskId {%s}, reusePowers: {%v}, powers: %s", task.Data.TaskId(), reusePowers, utilOrgPowerArrString(powers))
 
 		// 获取 metaData 所在的dataNode 资源
 		dataResourceDiskUsed, err := sche.dataCenter.QueryDataResourceDiskUsed(metaDataId)
@@ -293,6 +523,16 @@ func (sche *SchedulerStarveFIFO) trySchedule() error {
 			repushFn(bullet)
 			return
 		}
+		// the metaData is held by the dataNode only, wait for the dataNode recovering if it is unavailable now
+		if !sche.internalNodeSet.IsDataNodeAvailable(dataResourceDiskUsed.GetNodeId()) {
+			log.Warnf("The dataNode of task owner is unavailable on trySchedule, taskId: {%s}, metaDataId: {%s}, dataNodeId: {%s}",
+				task.Data.TaskId(), metaDataId, dataResourceDiskUsed.GetNodeId())
+			sche.eventEngine.StoreEvent(sche.eventEngine.GenerateEvent(evengine.TaskFailedConsensus.Type,
+				bullet.UnschedTask.Data.TaskId(), bullet.UnschedTask.Data.TaskData().Identity,
+				fmt.Sprintf("the dataNode {%s} of metaData is unavailable", dataResourceDiskUsed.GetNodeId())))
+			repushFn(bullet)
+			return
+		}
 		dataNodeResource, err := sche.dataCenter.GetRegisterNode(types.PREFIX_TYPE_DATANODE, dataResourceDiskUsed.GetNodeId())
 		if nil != err {
 			log.Errorf("Failed to query localResourceInfo By dataNodeId: {%s}, taskId: {%s}, err: {%s}",
@@ -320,6 +560,7 @@ func (sche *SchedulerStarveFIFO) trySchedule() error {
 				Port:    dataNodeResource.ExternalPort,
 				PartyId: task.Data.TaskData().PartyId,
 			},
+			Election: election,
 			ResultCh: make(chan *types.ConsensuResult, 0),
 		}
 		sche.SendTaskToConsensus(toConsensusTask)
@@ -327,6 +568,13 @@ func (sche *SchedulerStarveFIFO) trySchedule() error {
 
 		log.Debugf("Received task result from consensus, taskId: {%s}, result status: {%s}", consensusRes.TaskId, consensusRes.Status)
 
+		// The task was cancelled by the owner while it is on consensus, it will never be rescheduled
+		if consensusRes.Status == types.TaskConsensusCancel {
+			sche.leaveSchedule(task.Data.TaskId())
+			sche.SendTaskToTaskManager(makeUnschedTaskDoneWrap(task, types.TaskStateCancelled))
+			return
+		}
+
 		// Consensus failed, task needs to be suspended and rescheduled
 		if consensusRes.Status == types.TaskConsensusInterrupt {
 			sche.eventEngine.StoreEvent(sche.eventEngine.GenerateEvent(evengine.TaskFailedConsensus.Type,
@@ -335,6 +583,8 @@ func (sche *SchedulerStarveFIFO) trySchedule() error {
 			return
 		}
 
+		// the task is handed over to taskManager by consensus
+		sche.leaveSchedule(task.Data.TaskId())
 	}()
 
 	return nil
@@ -362,65 +612,36 @@ func (sche *SchedulerStarveFIFO) replaySchedule(replayScheduleTask *types.Replay
 		replayScheduleTask.SendFailedResult(replayScheduleTask.Task.TaskId(), fmt.Errorf("task ower can not replay schedule task"))
 		return
 	}
+	// 任务声明的运行时长必须在截止时间之前完成
+	if err := replayScheduleTask.Task.CheckDeadline(uint64(timeutils.UnixMsec())); nil != err {
+		log.Errorf("failed to check deadline of task on replaySchedule(), taskId: {%s}, err: {%s}", replayScheduleTask.Task.TaskId(), err)
+		replayScheduleTask.SendFailedResult(replayScheduleTask.Task.TaskId(), err)
+		return
+	}
 
 	switch replayScheduleTask.Role {
 
 	// 如果 当前参与方为 DataSupplier   [重新 演算 选 powers]
 	case types.DataSupplier:
 
-		powerPartyIds := make([]string, len(replayScheduleTask.Task.TaskData().ResourceSupplier))
-		for i, power := range replayScheduleTask.Task.TaskData().ResourceSupplier {
-			powerPartyIds[i] = power.Organization.PartyId
-		}
-
-		dataIdentityIdCache := make(map[string]struct{})
 		// 选出 关于自己 metaDataId 所在的 dataNode
 		var metaDataId string
 
 		for _, dataSupplier := range replayScheduleTask.Task.TaskData().MetadataSupplier {
-			dataIdentityIdCache[dataSupplier.Organization.Identity] = struct{}{}
-
 			// 取出 自己的 disk used 信息, identity 和 partyId 都一致, 才是同一个人 ..
 			if selfIdentityId == dataSupplier.Organization.Identity && replayScheduleTask.PartyId == dataSupplier.Organization.PartyId {
 				metaDataId = dataSupplier.MetaId
 			}
 		}
-		for _, receiver := range replayScheduleTask.Task.TaskData().Receivers {
-			dataIdentityIdCache[receiver.Receiver.Identity] = struct{}{}
-		}
-		// mock election power orgs
-		powers, err := sche.electionConputeOrg(powerPartyIds, dataIdentityIdCache, cost)
-		if nil != err {
-			log.Errorf("Failed to election powers org on replaySchedule task, taskId: {%s}, err: {%s}", replayScheduleTask.Task.TaskId(), err)
-			replayScheduleTask.SendFailedResult(replayScheduleTask.Task.TaskId(), fmt.Errorf("failed to election power org on replay schedule task, %s", err))
-			return
-		}
-
-		log.Debugf("Succeed to election powers org on replaySchedule(), taskId {%s}, powers: %s", replayScheduleTask.Task.TaskId(), utilOrgPowerArrString(powers))
 
-		// compare powerSuppliers of task And powerSuppliers of election
-		if len(powers) != len(replayScheduleTask.Task.TaskData().ResourceSupplier) {
-			log.Errorf("reschedule powers len and task powers len is not match on replay schedule task, taskId: {%s}, reschedule power len: {%d}, task powers len: {%d}",
-				replayScheduleTask.Task.TaskId(), len(powers), len(replayScheduleTask.Task.TaskData().ResourceSupplier))
-			replayScheduleTask.SendFailedResult(replayScheduleTask.Task.TaskId(),
-				fmt.Errorf("reschedule powers len and task powers len is not match on replay schedule task"))
+		if err := sche.verifyTaskPowers(replayScheduleTask, cost); nil != err {
+			log.Errorf("Failed to verify powers org on replaySchedule(), taskId: {%s}, err: {%s}", replayScheduleTask.Task.TaskId(), err)
+			replayScheduleTask.SendFailedResult(replayScheduleTask.Task.TaskId(), err)
 			return
 		}
 
-		tmp := make(map[string]struct{}, len(powers))
-
-		for _, power := range powers {
-			tmp[power.Organization.Identity] = struct{}{}
-		}
-		for _, power := range replayScheduleTask.Task.TaskData().ResourceSupplier {
-			if _, ok := tmp[power.Organization.Identity]; !ok {
-				log.Errorf("task power identityId not found on reschedule powers on replay schedule task, taskId: {%s}, task power identityId: {%s}",
-					replayScheduleTask.Task.TaskId(), power.Organization.Identity)
-				replayScheduleTask.SendFailedResult(replayScheduleTask.Task.TaskId(),
-					fmt.Errorf("task power identityId not found on reschedule powers on replay schedule task"))
-				return
-			}
-		}
+		log.Debugf("Succeed to verify powers org on replaySchedule(), taskId {%s}, powers: %s",
+			replayScheduleTask.Task.TaskId(), utilOrgPowerArrString(replayScheduleTask.Task.TaskData().ResourceSupplier))
 
 		// 获取 metaData 所在的dataNode 资源
 		dataResourceDiskUsed, err := sche.dataCenter.QueryDataResourceDiskUsed(metaDataId)
@@ -430,6 +651,13 @@ func (sche *SchedulerStarveFIFO) replaySchedule(replayScheduleTask *types.Replay
 				fmt.Errorf("failed query internal data node by metaDataId on replay schedule task"))
 			return
 		}
+		if !sche.internalNodeSet.IsDataNodeAvailable(dataResourceDiskUsed.GetNodeId()) {
+			log.Errorf("the internal data node of metaData is unavailable, taskId: {%s}, metaDataId: {%s}, dataNodeId: {%s}",
+				replayScheduleTask.Task.TaskId(), metaDataId, dataResourceDiskUsed.GetNodeId())
+			replayScheduleTask.SendFailedResult(replayScheduleTask.Task.TaskId(),
+				fmt.Errorf("the internal data node of metaData is unavailable on replay schedule task"))
+			return
+		}
 		dataNode, err := sche.dataCenter.GetRegisterNode(types.PREFIX_TYPE_DATANODE, dataResourceDiskUsed.GetNodeId())
 		if nil != err {
 			log.Errorf("failed query internal data node by metaDataId, taskId: {%s}, metaDataId: {%s}", replayScheduleTask.Task.TaskId(), metaDataId)
@@ -453,8 +681,15 @@ func (sche *SchedulerStarveFIFO) replaySchedule(replayScheduleTask *types.Replay
 
 	// 如果 当前参与方为 PowerSupplier  [选出自己的 内部 power 资源, 并锁定, todo 在最后 DoneXxxxWrap 中解锁]
 	case types.PowerSupplier:
+
+		if err := sche.verifyTaskPowers(replayScheduleTask, cost); nil != err {
+			log.Errorf("Failed to verify powers org on replaySchedule(), taskId: {%s}, err: {%s}", replayScheduleTask.Task.TaskId(), err)
+			replayScheduleTask.SendFailedResult(replayScheduleTask.Task.TaskId(), err)
+			return
+		}
+
 		needSlotCount := sche.resourceMng.GetSlotUnit().CalculateSlotCount(cost.Mem, cost.Processor, cost.Bandwidth)
-		jobNode, err := sche.electionConputeNode(uint32(needSlotCount))
+		jobNode, err := sche.electionConputeNode(uint32(needSlotCount), nil)
 		if nil != err {
 			log.Errorf("Failed to election internal power resource, taskId: {%s}, err: {%s}", replayScheduleTask.Task.TaskId(), err)
 			replayScheduleTask.SendFailedResult(replayScheduleTask.Task.TaskId(),
@@ -464,6 +699,7 @@ func (sche *SchedulerStarveFIFO) replaySchedule(replayScheduleTask *types.Replay
 
 		log.Debugf("Succeed powerSupplier jobNode on replaySchedule(), taskId: {%s}, jobNode: %s", replayScheduleTask.Task.TaskId(), jobNode.String())
 
+		// 锁定资源前会校验任务发起方的配额 (并发任务数, slot 数, 当天的 slot 小时数), 超出配额则不投赞成票
 		if err := sche.resourceMng.LockLocalResourceWithTask(jobNode.Id, needSlotCount,
 			replayScheduleTask.Task); nil != err {
 			log.Errorf("Failed to Lock LocalResource {%s} With Task {%s}, err: {%s}",
@@ -500,7 +736,20 @@ func (sche *SchedulerStarveFIFO) replaySchedule(replayScheduleTask *types.Replay
 
 		log.Debugf("QueryDataResourceTables on replaySchedule by taskRole is the resuler, dataResourceTables: %s", utilDataResourceArrString(dataResourceTables))
 
-		resource := dataResourceTables[len(dataResourceTables)-1]
+		// choose the last available dataNode to receive the result
+		var resource *types.DataResourceTable
+		for i := len(dataResourceTables) - 1; i >= 0; i-- {
+			if sche.internalNodeSet.IsDataNodeAvailable(dataResourceTables[i].GetNodeId()) {
+				resource = dataResourceTables[i]
+				break
+			}
+		}
+		if nil == resource {
+			log.Errorf("Not found available internal data node with replay schedule task, taskId: {%s}", replayScheduleTask.Task.TaskId())
+			replayScheduleTask.SendFailedResult(replayScheduleTask.Task.TaskId(),
+				fmt.Errorf("not found available internal data node"))
+			return
+		}
 		resourceInfo, err := sche.dataCenter.GetRegisterNode(types.PREFIX_TYPE_DATANODE, resource.GetNodeId())
 		if nil != err {
 			log.Errorf("Failed to query internal data node resource,taskId: {%s}, dataNodeId: {%s}, err: {%s}",
@@ -526,39 +775,14 @@ func (sche *SchedulerStarveFIFO) replaySchedule(replayScheduleTask *types.Replay
 	return
 }
 
-func (sche *SchedulerStarveFIFO) increaseTaskTerm() {
-	// handle starve queue
-	sche.starveQueue.IncreaseTerm()
-
-	// handle queue
-	i := 0
-	for {
-		if i == sche.queue.Len() {
-			return
-		}
-		bullet := (*(sche.queue))[i]
-		bullet.IncreaseTerm()
-
-		// When the task in the queue meets hunger, it will be transferred to starveQueue
-		if bullet.Term >= StarveTerm {
-			bullet.Starve = true
-			heap.Push(sche.starveQueue, bullet)
-			heap.Remove(sche.queue, i)
-			i = 0
-			continue
-		}
-		(*(sche.queue))[i] = bullet
-		i++
-	}
-}
-
-func (sche *SchedulerStarveFIFO) electionConputeNode(needSlotCount uint32) (*types.RegisteredNodeInfo, error) {
+// electionConputeNode places the task on a local jobNode, and records the reason of every jobNode into explain if it is not nil.
+func (sche *SchedulerStarveFIFO) electionConputeNode(needSlotCount uint32, explain *types.ScheduleExplain) (*types.RegisteredNodeInfo, error) {
 
 	if nil == sche.internalNodeSet || 0 == sche.internalNodeSet.JobNodeClientSize() {
 		return nil, errors.New("not found alive jobNode")
 	}
 
-	resourceNodeIdArr := make([]string, 0)
+	candidates := make([]*types.LocalResourceTable, 0)
 
 	tables, err := sche.resourceMng.GetLocalResourceTables()
 	if nil != err {
@@ -566,27 +790,42 @@ func (sche *SchedulerStarveFIFO) electionConputeNode(needSlotCount uint32) (*typ
 	}
 	log.Debugf("GetLocalResourceTables on electionConputeNode, localResources: %s", utilLocalResourceArrString(tables))
 	for _, r := range tables {
-		if r.IsEnough(needSlotCount) {
-
-			jobNodeClient, find := sche.internalNodeSet.QueryJobNodeClient(r.GetNodeId())
-			if find && jobNodeClient.IsConnected() {
-				resourceNodeIdArr = append(resourceNodeIdArr, r.GetNodeId())
-			}
+		if !r.IsEnough(needSlotCount) {
+			explain.RejectNode(r.GetNodeId(), "not enough slots, remain: %d, need: %d", r.RemianSlot(), needSlotCount)
+			continue
+		}
+		// skip the jobNode which is disconnected, unhealthy or drained
+		if !sche.internalNodeSet.IsJobNodeAvailable(r.GetNodeId()) {
+			explain.RejectNode(r.GetNodeId(), "the jobNode is disconnected, unhealthy or drained")
+			continue
 		}
+		explain.AcceptNode(r.GetNodeId(), "enough slots, remain: %d, need: %d", r.RemianSlot(), needSlotCount)
+		candidates = append(candidates, r)
 	}
 
-	if len(resourceNodeIdArr) == 0 {
+	if len(candidates) == 0 {
 		return nil, ErrEnoughInternalResourceCount
 	}
 
-	resourceId := resourceNodeIdArr[len(resourceNodeIdArr)%electionLocalSeed]
-	jobNode, err := sche.dataCenter.GetRegisterNode(types.PREFIX_TYPE_JOBNODE, resourceId)
+	// the dry-run of schedule must not change the state of the placement policy
+	place := sche.policy.Placement.Place
+	if nil != explain {
+		place = sche.policy.Placement.Peek
+	}
+	table, err := place(candidates, needSlotCount)
+	if nil != err {
+		return nil, err
+	}
+	jobNode, err := sche.dataCenter.GetRegisterNode(types.PREFIX_TYPE_JOBNODE, table.GetNodeId())
 	if nil != err {
 		return nil, err
 	}
 	if nil == jobNode {
 		return nil, errors.New("not found jobNode information")
 	}
+	if nil != explain {
+		explain.PlacedJobNode = jobNode.Id
+	}
 	return jobNode, nil
 }
 
@@ -594,43 +833,36 @@ func (sche *SchedulerStarveFIFO) electionConputeOrg(
 	powerPartyIds []string,
 	dataIdentityIdCache map[string]struct{},
 	cost *types.TaskOperationCost,
+	election *types.ElectionProof,
+	explain *types.ScheduleExplain,
 ) ([]*libTypes.TaskResourceSupplierData, error) {
 
 	calculateCount := len(powerPartyIds)
-	identityIds := make([]string, 0)
-
-	remoteReources := sche.resourceMng.GetRemoteResouceTables()
-	log.Debugf("GetRemoteResouceTables on electionConputeOrg, remoteResources: %s", utilRemoteResourceArrString(remoteReources))
-	for _, r := range remoteReources {
-
-		// Skip the mock identityId
-		if sche.resourceMng.IsMockIdentityId(r.GetIdentityId()) {
-			log.Debugf("Filter remoteResource on electionConputeOrg, IsMockIdentityId: %s", r.GetIdentityId())
-			continue
-		}
-
-		// 计算方不可以是任务发起方 和 数据参与方 和 接收方
-		if _, ok := dataIdentityIdCache[r.GetIdentityId()]; ok {
-			continue
-		}
-		// 还需要有足够的 资源
-		if r.IsEnough(cost.Mem, cost.Processor, cost.Bandwidth) {
-			identityIds = append(identityIds, r.GetIdentityId())
-		}
-	}
 
-	if calculateCount > len(identityIds) {
+	candidates := sche.filterComputeOrgCandidates(dataIdentityIdCache, cost, explain)
+	if calculateCount > len(candidates) {
 		return nil, ErrEnoughResourceOrgCountLessCalculateCount
 	}
 
 	// Election
-	index := electionOrgCondition % len(identityIds)
+	var seed []byte
+	if nil != election {
+		seed = election.Seed
+	}
+	// the dry-run of schedule must not change the state of the election policy
+	elect := sche.policy.Election.Elect
+	if nil != explain {
+		elect = sche.policy.Election.Peek
+	}
+	elected, err := elect(seed, candidates, calculateCount)
+	if nil != err {
+		return nil, fmt.Errorf("%s, %s", ErrEnoughResourceOrgCountLessCalculateCount, err)
+	}
 	identityIdTmp := make(map[string]struct{}, calculateCount)
-	for i := calculateCount; i > 0; i-- {
-		identityIdTmp[identityIds[index]] = struct{}{}
-		index++
-
+	for _, r := range elected {
+		identityIdTmp[r.GetIdentityId()] = struct{}{}
 	}
+	explain.RejectOrgsExcept(identityIdTmp, fmt.Sprintf("not elected by the %s election policy", sche.policy.Election.Name()))
 
 	if len(identityIdTmp) != calculateCount {
 		return nil, ErrEnoughResourceOrgCountLessCalculateCount
@@ -655,6 +887,11 @@ func (sche *SchedulerStarveFIFO) electionConputeOrg(
 			identityInfoTmp[identityInfo.IdentityId()] = identityInfo
 		}
 	}
+	for identityId := range identityIdTmp {
+		if _, ok := identityInfoTmp[identityId]; !ok {
+			explain.RejectOrg(identityId, "the identity is not found on dataCenter, or it is a mock identity")
+		}
+	}
 
 	if len(identityInfoTmp) != calculateCount {
 		return nil, ErrEnoughResourceOrgCountLessCalculateCount
@@ -693,18 +930,211 @@ func (sche *SchedulerStarveFIFO) electionConputeOrg(
 					UsedBandwidth:  cost.Bandwidth,
 				},
 			}
+			explain.AcceptOrg(iden.GetIdentityId(), "elected as the power org, partyId: %s", powerPartyIds[orgNo])
+			if nil != explain {
+				explain.ElectedOrgs = append(explain.ElectedOrgs, iden.GetIdentityId())
+			}
 			orgNo++
 			delete(identityInfoTmp, iden.GetIdentityId())
 		}
 	}
+	for identityId := range identityInfoTmp {
+		explain.RejectOrg(identityId, "the power resource of identity is not found on dataCenter")
+	}
 
 	if orgNo != calculateCount {
 		return nil, ErrEnoughResourceOrgCountLessCalculateCount
 	}
-	
+
 	return orgs, nil
 }
 
+// verifyTaskPowers checks the powers of remote task before voting on it, the election proof of task owner is required.
+// If the powers are elected by the verifiable seed, the election is re-run with the seed and the result must be the same,
+// otherwise the powers (reused from the previous attempt, or elected by a policy local to task owner) must be attested
+// by task owner with the proof, and every power org of task must be a valid candidate.
+func (sche *SchedulerStarveFIFO) verifyTaskPowers(replayScheduleTask *types.ReplayScheduleTaskWrap, cost *types.TaskOperationCost) error {
+
+	task := replayScheduleTask.Task
+	powers := task.TaskData().ResourceSupplier
+	if len(powers) == 0 {
+		return fmt.Errorf("task powers is empty on replay schedule task")
+	}
+	election := replayScheduleTask.Election
+	if err := verifyElectionProof(task.TaskData().NodeId, task.TaskId(), election); nil != err {
+		return err
+	}
+	// the attestation is accepted only for the retry attempt which reuses the powers of the previous attempt,
+	// the other powers must be re-elected by the seed (the policy which is not verifiable is rejected).
+	attested := 0 != len(election.PowersSign)
+	if attested {
+		if !task.ReusePrevPowers() {
+			return fmt.Errorf("%s, only the retry attempt reusing the previous powers can be attested", ErrElectionPowersSignInvalid)
+		}
+		if err := verifyElectionPowers(task.TaskData().NodeId, election, powers); nil != err {
+			return err
+		}
+		if err := sche.verifyPrevAttemptPowers(task); nil != err {
+			return err
+		}
+	}
+
+	dataIdentityIdCache := make(map[string]struct{})
+	for _, dataSupplier := range task.TaskData().MetadataSupplier {
+		dataIdentityIdCache[dataSupplier.Organization.Identity] = struct{}{}
+	}
+	for _, receiver := range task.TaskData().Receivers {
+		dataIdentityIdCache[receiver.Receiver.Identity] = struct{}{}
+	}
+	candidates := sche.filterComputeOrgCandidates(dataIdentityIdCache, cost, nil)
+
+	if !attested {
+		// the candidates are narrowed to the elected orgs
+		elected, err := electOrgsBySeed(election.Seed, candidates, len(powers))
+		if nil != err {
+			return fmt.Errorf("%s, %s", ErrElectionPowersDiffer, err)
+		}
+		candidates = elected
+	}
+
+	tmp := make(map[string]struct{}, len(candidates))
+	for _, r := range candidates {
+		tmp[r.GetIdentityId()] = struct{}{}
+	}
+	for _, power := range powers {
+		if _, ok := tmp[power.Organization.Identity]; !ok {
+			if !attested {
+				return fmt.Errorf("%s, task power identityId: {%s}", ErrElectionPowersDiffer, power.Organization.Identity)
+			}
+			return fmt.Errorf("task power identityId is not a valid candidate on replay schedule task, identityId: {%s}", power.Organization.Identity)
+		}
+		delete(tmp, power.Organization.Identity)
+	}
+	return nil
+}
+
+// verifyPrevAttemptPowers checks that the powers of the retry attempt are the same as the powers of its previous attempt,
+// which has been published to the dataCenter by the task owner before the retry.
+func (sche *SchedulerStarveFIFO) verifyPrevAttemptPowers(task *types.Task) error {
+	taskList, err := sche.dataCenter.GetTaskListByIdentityId(task.TaskData().Identity)
+	if nil != err {
+		return fmt.Errorf("query the previous attempt of task failed, %s", err)
+	}
+	var prev *types.Task
+	for _, t := range taskList {
+		if t.TaskData().Identity == task.TaskData().Identity && t.OriginTaskId() == task.OriginTaskId() && t.Attempt() == task.Attempt()-1 {
+			prev = t
+			break
+		}
+	}
+	if nil == prev {
+		return fmt.Errorf("%s, the previous attempt of task is not found, originTaskId: {%s}, attempt: {%d}",
+			ErrElectionPowersDiffer, task.OriginTaskId(), task.Attempt()-1)
+	}
+	prevPowers := make(map[string]struct{}, len(prev.TaskData().ResourceSupplier))
+	for _, power := range prev.TaskData().ResourceSupplier {
+		prevPowers[power.Organization.Identity] = struct{}{}
+	}
+	if len(prevPowers) != len(task.TaskData().ResourceSupplier) {
+		return fmt.Errorf("%s, the count of powers differs from the previous attempt", ErrElectionPowersDiffer)
+	}
+	for _, power := range task.TaskData().ResourceSupplier {
+		if _, ok := prevPowers[power.Organization.Identity]; !ok {
+			return fmt.Errorf("%s, task power identityId is not a power of the previous attempt: {%s}", ErrElectionPowersDiffer, power.Organization.Identity)
+		}
+	}
+	return nil
+}
+
+// filterComputeOrgCandidates returns the remote orgs which can be elected as the power org of task,
+// and records the reason of every org into explain if it is not nil.
+func (sche *SchedulerStarveFIFO) filterComputeOrgCandidates(
+	dataIdentityIdCache map[string]struct{},
+	cost *types.TaskOperationCost,
+	explain *types.ScheduleExplain,
+) []*types.RemoteResourceTable {
+
+	candidates := make([]*types.RemoteResourceTable, 0)
+
+	remoteReources := sche.resourceMng.GetRemoteResouceTables()
+	log.Debugf("GetRemoteResouceTables on electionConputeOrg, remoteResources: %s", utilRemoteResourceArrString(remoteReources))
+	for _, r := range remoteReources {
+
+		// Skip the mock identityId
+		if sche.resourceMng.IsMockIdentityId(r.GetIdentityId()) {
+			log.Debugf("Filter remoteResource on electionConputeOrg, IsMockIdentityId: %s", r.GetIdentityId())
+			explain.RejectOrg(r.GetIdentityId(), "it is a mock identity")
+			continue
+		}
+
+		// 计算方不可以是任务发起方 和 数据参与方 和 接收方
+		if _, ok := dataIdentityIdCache[r.GetIdentityId()]; ok {
+			explain.RejectOrg(r.GetIdentityId(), "it is the task owner, a data supplier or a result receiver of task")
+			continue
+		}
+		// 还需要有足够的 资源
+		rMem, rProcessor, rBandwidth := r.Remain()
+		if r.IsEnough(cost.Mem, cost.Processor, cost.Bandwidth) {
+			explain.AcceptOrg(r.GetIdentityId(), "enough resource, remain: {mem: %d, processor: %d, bandwidth: %d}", rMem, rProcessor, rBandwidth)
+			candidates = append(candidates, r)
+		} else {
+			explain.RejectOrg(r.GetIdentityId(), "not enough resource, remain: {mem: %d, processor: %d, bandwidth: %d}, need: {mem: %d, processor: %d, bandwidth: %d}",
+				rMem, rProcessor, rBandwidth, cost.Mem, cost.Processor, cost.Bandwidth)
+		}
+	}
+	return candidates
+}
+
+// ExplainSchedule runs the org election and the local jobNode placement for the task without queueing it,
+// and explains why every candidate was accepted or rejected.
+//
+// NOTE: the explaining only peeks the policies and never changes their state, but the election of vrf depends on
+// the taskId, and the ones of round-robin and weighted-random depend on the state of policy at the real schedule,
+// so the real schedule of the task may elect the other orgs.
+func (sche *SchedulerStarveFIFO) ExplainSchedule(task *types.TaskMsg) (*types.ScheduleExplain, error) {
+
+	cost := &types.TaskOperationCost{
+		Mem:       task.Data.TaskData().TaskResource.CostMem,
+		Processor: uint64(task.Data.TaskData().TaskResource.CostProcessor),
+		Bandwidth: task.Data.TaskData().TaskResource.CostBandwidth,
+	}
+
+	dataIdentityIdCache := make(map[string]struct{})
+	for _, dataSupplier := range task.Data.TaskData().MetadataSupplier {
+		dataIdentityIdCache[dataSupplier.Organization.Identity] = struct{}{}
+	}
+	for _, receiver := range task.Data.TaskData().Receivers {
+		dataIdentityIdCache[receiver.Receiver.Identity] = struct{}{}
+	}
+
+	explain := &types.ScheduleExplain{}
+	if err := task.Data.CheckDeadline(uint64(timeutils.UnixMsec())); nil != err {
+		explain.OrgErr = err.Error()
+	}
+
+	var election *types.ElectionProof
+	if sche.policy.Election.Verifiable() {
+		proof, err := makeElectionProof(sche.nodePriKey, task.TaskId)
+		if nil != err {
+			return nil, err
+		}
+		election = proof
+	}
+	if _, err := sche.electionConputeOrg(task.PowerPartyIds, dataIdentityIdCache, cost, election, explain); nil != err && "" == explain.OrgErr {
+		explain.OrgErr = err.Error()
+	}
+
+	// the local jobNode which would be placed on, if we were a power supplier of the task
+	explain.NeedSlotCount = uint32(sche.resourceMng.GetSlotUnit().CalculateSlotCount(cost.Mem, cost.Processor, cost.Bandwidth))
+	if _, err := sche.electionConputeNode(explain.NeedSlotCount, explain); nil != err {
+		explain.NodeErr = err.Error()
+	}
+
+	log.Debugf("Explained the schedule of task, taskId: {%s}, electedOrgs: %v, placedJobNode: {%s}, orgErr: {%s}, nodeErr: {%s}",
+		task.TaskId, explain.ElectedOrgs, explain.PlacedJobNode, explain.OrgErr, explain.NodeErr)
+	return explain, nil
+}
+
 func (sche *SchedulerStarveFIFO) SendTaskToConsensus(task *types.ConsensusTaskWrap) {
 	sche.needConsensusTaskCh <- task
 }
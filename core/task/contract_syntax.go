@@ -0,0 +1,276 @@
+package task
+
+import (
+	"fmt"
+	"strings"
+)
+
+// The contract code is the python source executed by Fighter-Py, checkPythonSyntax runs a tokenizer pass over it
+// (as the python tokenizer does), so that the broken code is rejected before the task is scheduled.
+// It finds the unclosed string literals and brackets, the inconsistent use of tabs and spaces in indentation,
+// the unexpected indent or dedent and the compound statement without an indented block.
+// The grammar of the statements and expressions is still left to the python parser on Fighter.
+
+// pythonSyntaxError is the position and the reason of the syntax error in the python source.
+type pythonSyntaxError struct {
+	line int
+	msg  string
+}
+
+func (e *pythonSyntaxError) Error() string {
+	return fmt.Sprintf("line %d: %s", e.line, e.msg)
+}
+
+// pythonIndent is the width of an indentation with the tab size 8 and 1,
+// python refuses the indentation whose order differs between the two sizes.
+type pythonIndent struct {
+	col    int
+	altCol int
+}
+
+var pythonBrackets = map[byte]byte{')': '(', ']': '[', '}': '{'}
+
+func checkPythonSyntax(code string) error {
+
+	src := strings.ReplaceAll(strings.ReplaceAll(code, "\r\n", "\n"), "\r", "\n")
+	if !strings.HasSuffix(src, "\n") {
+		src += "\n"
+	}
+
+	var (
+		line    = 1
+		indents = []pythonIndent{{0, 0}}
+		// the open brackets and the lines of them
+		brackets     []byte
+		bracketLines []int
+		// at the beginning of a logical line
+		atLineStart = true
+		// the last significant char of the current logical line
+		lastChar byte
+		// the last logical line ends with ':', the next one must be indented
+		expectIndent bool
+		expectLine   int
+	)
+
+	for i := 0; i < len(src); {
+		if atLineStart {
+			atLineStart = false
+
+			var indent pythonIndent
+			j := i
+		indentLoop:
+			for ; j < len(src); j++ {
+				switch src[j] {
+				case ' ':
+					indent.col++
+					indent.altCol++
+				case '\t':
+					indent.col = (indent.col/8 + 1) * 8
+					indent.altCol++
+				case '\f':
+					indent.col, indent.altCol = 0, 0
+				default:
+					break indentLoop
+				}
+			}
+			i = j
+
+			// the blank lines and the comment lines do not take part in the indentation
+			if src[i] == '\n' || src[i] == '#' {
+				for src[i] != '\n' {
+					i++
+				}
+				i++
+				line++
+				atLineStart = true
+				continue
+			}
+
+			top := indents[len(indents)-1]
+			switch {
+			case indent.col == top.col:
+				if indent.altCol != top.altCol {
+					return &pythonSyntaxError{line, "inconsistent use of tabs and spaces in indentation"}
+				}
+				if expectIndent {
+					return &pythonSyntaxError{line, fmt.Sprintf("expected an indented block after line %d", expectLine)}
+				}
+			case indent.col > top.col:
+				if indent.altCol <= top.altCol {
+					return &pythonSyntaxError{line, "inconsistent use of tabs and spaces in indentation"}
+				}
+				if !expectIndent {
+					return &pythonSyntaxError{line, "unexpected indent"}
+				}
+				indents = append(indents, indent)
+			default:
+				if expectIndent {
+					return &pythonSyntaxError{line, fmt.Sprintf("expected an indented block after line %d", expectLine)}
+				}
+				for len(indents) > 1 && indent.col < indents[len(indents)-1].col {
+					indents = indents[:len(indents)-1]
+				}
+				top = indents[len(indents)-1]
+				if indent.col != top.col {
+					return &pythonSyntaxError{line, "unindent does not match any outer indentation level"}
+				}
+				if indent.altCol != top.altCol {
+					return &pythonSyntaxError{line, "inconsistent use of tabs and spaces in indentation"}
+				}
+			}
+			expectIndent = false
+			lastChar = 0
+		}
+
+		c := src[i]
+		switch {
+		case c == '\n':
+			line++
+			i++
+			// the newline in brackets does not end the logical line
+			if len(brackets) == 0 {
+				atLineStart = true
+				if lastChar == ':' {
+					expectIndent, expectLine = true, line-1
+				}
+			}
+
+		case c == ' ' || c == '\t' || c == '\f':
+			i++
+
+		case c == '#':
+			for src[i] != '\n' {
+				i++
+			}
+
+		case c == '\\':
+			// the explicit line joining
+			if i+1 >= len(src) || src[i+1] != '\n' {
+				return &pythonSyntaxError{line, "unexpected character after line continuation character"}
+			}
+			if i+2 >= len(src) {
+				return &pythonSyntaxError{line, "unexpected EOF after line continuation character"}
+			}
+			i += 2
+			line++
+
+		case c == '\'' || c == '"':
+			next, lines, err := scanPythonString(src, i, line)
+			if nil != err {
+				return err
+			}
+			i = next
+			line += lines
+			lastChar = c
+
+		case c == '(' || c == '[' || c == '{':
+			brackets = append(brackets, c)
+			bracketLines = append(bracketLines, line)
+			lastChar = c
+			i++
+
+		case c == ')' || c == ']' || c == '}':
+			if len(brackets) == 0 {
+				return &pythonSyntaxError{line, fmt.Sprintf("unmatched '%c'", c)}
+			}
+			if open := brackets[len(brackets)-1]; open != pythonBrackets[c] {
+				return &pythonSyntaxError{line, fmt.Sprintf("closing parenthesis '%c' does not match opening parenthesis '%c' on line %d",
+					c, open, bracketLines[len(bracketLines)-1])}
+			}
+			brackets = brackets[:len(brackets)-1]
+			bracketLines = bracketLines[:len(bracketLines)-1]
+			lastChar = c
+			i++
+
+		case c == '$' || c == '?' || c == '`' || (c == '!' && src[i+1] != '='):
+			return &pythonSyntaxError{line, fmt.Sprintf("invalid character '%c'", c)}
+
+		case isPythonNameStart(c):
+			j := i
+			for j < len(src) && isPythonNameChar(src[j]) {
+				j++
+			}
+			// the prefix of string literal, e.g. r'', b"", f'''''', rb""
+			if j < len(src) && (src[j] == '\'' || src[j] == '"') && isPythonStringPrefix(src[i:j]) {
+				next, lines, err := scanPythonString(src, j, line)
+				if nil != err {
+					return err
+				}
+				i = next
+				line += lines
+				lastChar = src[j]
+				continue
+			}
+			i = j
+			lastChar = src[j-1]
+
+		default:
+			lastChar = c
+			i++
+		}
+	}
+
+	if len(brackets) != 0 {
+		return &pythonSyntaxError{bracketLines[len(bracketLines)-1], fmt.Sprintf("'%c' was never closed", brackets[len(brackets)-1])}
+	}
+	if expectIndent {
+		return &pythonSyntaxError{line, fmt.Sprintf("expected an indented block after line %d", expectLine)}
+	}
+	return nil
+}
+
+// scanPythonString skips the string literal starting with the quote at start,
+// and returns the index after the literal and the count of newlines in it.
+func scanPythonString(src string, start, line int) (int, int, error) {
+	quote := src[start]
+	triple := start+2 < len(src) && src[start+1] == quote && src[start+2] == quote
+
+	i, lines := start+1, 0
+	if triple {
+		i = start + 3
+	}
+	for i < len(src) {
+		switch c := src[i]; {
+		case c == '\\':
+			// the escaped char (also in raw string) never ends the literal
+			if i+1 < len(src) && src[i+1] == '\n' {
+				lines++
+			}
+			i += 2
+		case c == '\n':
+			if !triple {
+				return 0, 0, &pythonSyntaxError{line + lines, "unterminated string literal"}
+			}
+			lines++
+			i++
+		case c == quote:
+			if !triple {
+				return i + 1, lines, nil
+			}
+			if i+2 < len(src) && src[i+1] == quote && src[i+2] == quote {
+				return i + 3, lines, nil
+			}
+			i++
+		default:
+			i++
+		}
+	}
+	return 0, 0, &pythonSyntaxError{line, "unterminated triple-quoted string literal"}
+}
+
+func isPythonNameStart(c byte) bool {
+	// the non-ascii identifiers are accepted as they are
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || c >= 0x80
+}
+
+func isPythonNameChar(c byte) bool {
+	return isPythonNameStart(c) || (c >= '0' && c <= '9')
+}
+
+func isPythonStringPrefix(s string) bool {
+	switch strings.ToLower(s) {
+	case "r", "u", "b", "f", "br", "rb", "fr", "rf":
+		return true
+	}
+	return false
+}
@@ -0,0 +1,71 @@
+package task
+
+import (
+	"strings"
+	"testing"
+)
+
+const testContractCode = `# coding:utf-8
+import sys
+import numpy as np
+
+
+def main(cfg_dict: dict, data, result_dir):
+    """
+    the entry of contract, it's called by Fighter-Py.
+    """
+    params = cfg_dict.get("dynamic_parameter", {})
+    columns = [
+        params.get("label_column", 'Y'),  # the label
+        params.get("id_column", "id"),
+    ]
+    if len(columns) != 2 and \
+            not result_dir:
+        raise ValueError(f"invalid columns: {columns!r}")
+    for col in columns:
+        if col:
+            print(r'col: \d', col, b'\x00')
+        else:
+	        pass
+    with open(result_dir + '/result.csv', 'w') as f:
+        f.write('''header
+''')
+    return {'count': len(columns)}
+`
+
+func TestCheckPythonSyntax(t *testing.T) {
+	if err := checkPythonSyntax(testContractCode); nil != err {
+		t.Fatalf("the valid contract code is refused, err: %v", err)
+	}
+	if err := checkPythonSyntax("print('a')\r\nprint(\"b\")"); nil != err {
+		t.Fatalf("the valid contract code with CRLF is refused, err: %v", err)
+	}
+
+	testCases := []struct {
+		code string
+		err  string
+	}{
+		{"x = (1, 2\ny = 3\n", "line 1: '(' was never closed"},
+		{"x = [1, 2)\n", "line 1: closing parenthesis ')' does not match opening parenthesis '[' on line 1"},
+		{"x = 1)\n", "line 1: unmatched ')'"},
+		{"x = 'abc\n", "line 1: unterminated string literal"},
+		{"x = 1\ny = \"\"\"abc\n", "line 2: unterminated triple-quoted string literal"},
+		{"x = 1\n    y = 2\n", "line 2: unexpected indent"},
+		{"if x:\ny = 2\n", "line 2: expected an indented block after line 1"},
+		{"def f():\n", "line 2: expected an indented block after line 1"},
+		{"if x:\n        y = 1\n    z = 2\n", "line 3: unindent does not match any outer indentation level"},
+		{"if x:\n\ty = 1\n        z = 2\n", "line 3: inconsistent use of tabs and spaces in indentation"},
+		{"x = 1 \\ y\n", "line 1: unexpected character after line continuation character"},
+		{"x = $y\n", "line 1: invalid character '$'"},
+	}
+	for _, tc := range testCases {
+		err := checkPythonSyntax(tc.code)
+		if nil == err {
+			t.Errorf("the broken contract code is accepted, code: %q", tc.code)
+			continue
+		}
+		if !strings.Contains(err.Error(), tc.err) {
+			t.Errorf("unexpected error, code: %q, want: %s, got: %v", tc.code, tc.err, err)
+		}
+	}
+}
@@ -1,16 +1,34 @@
 package task
 
 import (
+	"errors"
 	"fmt"
+	"github.com/RosettaFlow/Carrier-Go/common"
+	"github.com/RosettaFlow/Carrier-Go/common/timeutils"
 	"github.com/RosettaFlow/Carrier-Go/core"
 	ev "github.com/RosettaFlow/Carrier-Go/core/evengine"
+	"github.com/RosettaFlow/Carrier-Go/core/rawdb"
 	"github.com/RosettaFlow/Carrier-Go/core/resource"
+	"github.com/RosettaFlow/Carrier-Go/event"
 	"github.com/RosettaFlow/Carrier-Go/grpclient"
+	libTypes "github.com/RosettaFlow/Carrier-Go/lib/types"
 	"github.com/RosettaFlow/Carrier-Go/types"
+	"strings"
 	"sync"
 	"time"
 )
 
+const (
+	// the interval of polling the progress of the running tasks from the local jobNodes
+	taskProgressPollInterval = 10 * time.Second
+	// the timeout of querying the task details from a jobNode
+	taskProgressRequestTimeout = 3 * time.Second
+)
+
+var (
+	ErrTaskRetryNotPending = errors.New("the task is not a retry attempt waiting for its backoff")
+)
+
 type Manager struct {
 	dataCenter  core.CarrierDB
 	eventEngine *ev.EventEngine
@@ -26,8 +44,17 @@ type Manager struct {
 	localTaskMsgCh chan<- types.TaskMsgs
 	// 接收 被调度好的 task, 准备发给自己的  Fighter-Py 或者 发给 dataCenter
 	doneScheduleTaskCh   chan *types.DoneScheduleTaskChWrap
+	// 接收 需要被取消的 正在执行中的 task
+	cancelTaskCh         chan *types.CancelTaskWrap
 	runningTaskCache     map[string]*types.DoneScheduleTaskChWrap
 	runningTaskCacheLock sync.RWMutex
+	// the backoff timers of the retry attempts which are not requeued yet (taskId -> timer)
+	pendingRetryTimers    map[string]*time.Timer
+	pendingRetryTimerLock sync.Mutex
+	// 1 while polling the progress of running tasks from the local jobNodes
+	progressPolling int32
+	// notify the local tasks (as the owner) finished
+	taskFinishedFeed event.Feed
 }
 
 func NewTaskManager(
@@ -37,6 +64,7 @@ func NewTaskManager(
 	resourceClientSet *grpclient.InternalResourceClientSet,
 	localTaskMsgCh chan types.TaskMsgs,
 	doneScheduleTaskCh chan *types.DoneScheduleTaskChWrap,
+	cancelTaskCh chan *types.CancelTaskWrap,
 ) *Manager {
 
 	m := &Manager{
@@ -45,17 +73,22 @@ func NewTaskManager(
 		resourceMng:        resourceMng,
 		resourceClientSet:  resourceClientSet,
 		parser:             newTaskParser(),
-		validator:          newTaskValidator(),
+		validator:          newTaskValidator(dataCenter),
 		eventCh:            make(chan *types.TaskEventInfo, 10),
 		localTaskMsgCh:     localTaskMsgCh,
 		doneScheduleTaskCh: doneScheduleTaskCh,
+		cancelTaskCh:       cancelTaskCh,
 		runningTaskCache:   make(map[string]*types.DoneScheduleTaskChWrap, 0),
+		pendingRetryTimers: make(map[string]*time.Timer, 0),
 		quit:               make(chan struct{}),
 	}
 	return m
 }
 
 func (m *Manager) Start() error {
+	if err := m.recoverPendingRetries(); nil != err {
+		return err
+	}
 	go m.loop()
 	log.Info("Started taskManager ...")
 	return nil
@@ -68,6 +101,7 @@ func (m *Manager) Stop() error {
 func (m *Manager) loop() {
 
 	taskMonitorTicker := time.NewTicker(30*time.Second)
+	taskProgressTicker := time.NewTicker(taskProgressPollInterval)
 
 	for {
 		select {
@@ -86,9 +120,16 @@ func (m *Manager) loop() {
 			m.addRunningTaskCache(task)
 			m.handleDoneScheduleTask(task.Task.SchedTask.TaskId())
 
+		// 接收 被取消的 task, 停止自己的 Fighter-Py 上正在执行的 task
+		case cancelWrap := <-m.cancelTaskCh:
+			cancelWrap.SendResult(m.handleCancelTask(cancelWrap.TaskId))
+
 		case <- taskMonitorTicker.C:
 			m.expireTaskMonitor()
 
+		case <-taskProgressTicker.C:
+			go m.pollTaskProgress()
+
 		case <-m.quit:
 			log.Info("Stopped taskManager ...")
 			return
@@ -96,41 +137,103 @@ func (m *Manager) loop() {
 	}
 }
 
+// SubscribeTaskFinishedEvent registers a subscription of TaskFinishedEvent,
+// which is sent while a local task (as the owner) finished.
+func (m *Manager) SubscribeTaskFinishedEvent(ch chan<- *types.TaskFinishedEvent) event.Subscription {
+	return m.taskFinishedFeed.Subscribe(ch)
+}
+
+// CancelPendingRetry cancels the retry attempt of a failed task which is still waiting for its backoff,
+// the attempt will never be requeued and is finished with the `cancelled` state.
+// ErrTaskRetryNotPending is returned if the task is not a pending retry attempt.
+func (m *Manager) CancelPendingRetry(taskId string) error {
+	records, err := m.dataCenter.GetPendingTaskRetryList()
+	if nil != err {
+		return fmt.Errorf("query the pending retries of task failed, %s", err)
+	}
+	var pending bool
+	for _, record := range records {
+		if record.TaskId == taskId {
+			pending = true
+			break
+		}
+	}
+	if !pending {
+		return ErrTaskRetryNotPending
+	}
+
+	// the timer is removed by itself once the backoff passed, the attempt is being requeued to the scheduler then,
+	// and it can be removed from the queue of scheduler later
+	m.pendingRetryTimerLock.Lock()
+	timer, ok := m.pendingRetryTimers[taskId]
+	if ok {
+		timer.Stop()
+		delete(m.pendingRetryTimers, taskId)
+	}
+	m.pendingRetryTimerLock.Unlock()
+	if !ok {
+		return ErrTaskRetryNotPending
+	}
+
+	if err := m.dataCenter.RemovePendingTaskRetry(taskId); nil != err {
+		log.Warnf("Failed to remove the pending retry of task on CancelPendingRetry, taskId: {%s}, err: {%s}", taskId, err)
+	}
+	task, err := m.dataCenter.GetLocalTask(taskId)
+	if nil != err {
+		return fmt.Errorf("query the local task of pending retry failed, %s", err)
+	}
+
+	log.Infof("Cancelled the pending retry of task, taskId: {%s}, attempt: {%d}", taskId, task.Attempt())
+
+	// finish the attempt as the task removed from the queue of scheduler
+	done := &types.DoneScheduleTaskChWrap{
+		ProposalId:   common.Hash{},
+		SelfTaskRole: types.TaskOnwer,
+		SelfIdentity: &libTypes.OrganizationData{
+			PartyId:  task.TaskData().PartyId,
+			Identity: task.TaskData().Identity,
+			NodeId:   task.TaskData().NodeId,
+			NodeName: task.TaskData().NodeName,
+		},
+		Task: &types.ConsensusScheduleTask{
+			TaskDir:   types.SendTaskDir,
+			TaskState: types.TaskStateCancelled,
+			SchedTask: task,
+		},
+		ResultCh: make(chan *types.TaskResultMsgWrap, 0),
+	}
+	select {
+	case m.doneScheduleTaskCh <- done:
+	case <-m.quit:
+		return fmt.Errorf("the taskManager was stopped, taskId: {%s}", taskId)
+	}
+	return nil
+}
+
 func (m *Manager) SendTaskMsgs(msgs types.TaskMsgs) error {
 	if len(msgs) == 0 {
 		return fmt.Errorf("Receive some empty task msgs")
 	}
 
-	if errTasks, err := m.parser.ParseTask(msgs); nil != err {
-		for _, errtask := range errTasks {
-
-			events, _ := m.dataCenter.GetTaskEventList(errtask.TaskId)
-			events = append(events, m.eventEngine.GenerateEvent(ev.TaskFailed.Type,
-				errtask.TaskId, errtask.OwnerIdentityId(), fmt.Sprintf("failed to parse taskMsg")))
+	msgs, badTasks := m.parser.ParseTask(msgs)
+	m.storeBadTaskMsgs(badTasks, "failed to parse taskMsg")
 
-			if e := m.storeErrTaskMsg(errtask, types.ConvertTaskEventArrToDataCenter(events), "failed to parse taskMsg"); nil != e {
-				log.Error("Failed to store the err taskMsg on taskManager", "taskId", errtask.TaskId)
-			}
-		}
-		return err
+	var badValidateTasks []*badTaskMsg
+	if len(msgs) != 0 {
+		msgs, badValidateTasks = m.validator.validateTaskMsg(msgs)
+		m.storeBadTaskMsgs(badValidateTasks, "failed to validate taskMsg")
 	}
 
-	if errTasks, err := m.validator.validateTaskMsg(msgs); nil != err {
-		for _, errtask := range errTasks {
-			events, _ := m.dataCenter.GetTaskEventList(errtask.TaskId)
-			events = append(events, m.eventEngine.GenerateEvent(ev.TaskFailed.Type,
-				errtask.TaskId, errtask.OwnerIdentityId(), fmt.Sprintf("failed to validate taskMsg")))
+	if len(msgs) != 0 {
+		// transfer `taskMsgs` to Scheduler
+		go func(msgs types.TaskMsgs) {
+			m.sendTaskMsgsToScheduler(msgs)
+		}(msgs)
+	}
 
-			if e := m.storeErrTaskMsg(errtask, types.ConvertTaskEventArrToDataCenter(events), "failed to validate taskMsg"); nil != e {
-				log.Error("Failed to store the err taskMsg on taskManager", "taskId", errtask.TaskId)
-			}
-		}
-		return err
+	if count := len(badTasks) + len(badValidateTasks); count != 0 {
+		return fmt.Errorf("%d taskMsgs was rejected, %d taskMsgs was sent to scheduler", count, len(msgs))
 	}
-	// transfer `taskMsgs` to Scheduler
-	go func() {
-		m.sendTaskMsgsToScheduler(msgs)
-	}()
 	return nil
 }
 
@@ -144,3 +247,69 @@ func (m *Manager) SendTaskEvent(event *types.TaskEventInfo) error {
 	m.sendTaskEvent(event)
 	return nil
 }
+
+// ReportTaskResourceExpense stores the resource actually used by the task on a local jobNode,
+// and records a `TaskResourceUsageExceeded` event while the usage exceeds the declared cost of task.
+func (m *Manager) ReportTaskResourceExpense(usage *types.TaskResourceUsage) error {
+	if _, err := m.dataCenter.GetRegisterNode(types.PREFIX_TYPE_JOBNODE, usage.JobNodeId); nil != err {
+		return fmt.Errorf("query local jobNode failed, jobNodeId: {%s}, %s", usage.JobNodeId, err)
+	}
+	// only the jobNode which is running the task could report the expense of the task
+	running, err := m.isTaskRunningOnJobNode(usage.TaskId, usage.JobNodeId)
+	if nil != err {
+		return fmt.Errorf("query the running tasks of jobNode failed, jobNodeId: {%s}, %s", usage.JobNodeId, err)
+	}
+	if !running {
+		log.Warnf("Refused the task resource expense reported by the jobNode which is not running the task, taskId: {%s}, jobNodeId: {%s}",
+			usage.TaskId, usage.JobNodeId)
+		return fmt.Errorf("the task is not running on the jobNode, taskId: {%s}, jobNodeId: {%s}", usage.TaskId, usage.JobNodeId)
+	}
+	identityId, err := m.dataCenter.GetIdentityId()
+	if nil != err {
+		log.Errorf("Failed to query self identityId on taskManager.ReportTaskResourceExpense(), %s", err)
+		return fmt.Errorf("query local identityId failed, %s", err)
+	}
+	usage.IdentityId = identityId
+	usage.UpdateAt = uint64(timeutils.UnixMsec())
+
+	if err := m.dataCenter.StoreTaskResourceUsage(usage); nil != err {
+		return fmt.Errorf("store task resource usage failed, %s", err)
+	}
+
+	task, err := m.dataCenter.GetLocalTask(usage.TaskId)
+	if nil != err {
+		log.Warnf("Not found local task on taskManager.ReportTaskResourceExpense(), skip to compare with the declared cost, taskId: {%s}, err: {%s}",
+			usage.TaskId, err)
+		return nil
+	}
+	declared := task.OperationCost()
+	if items := usage.ExceededItems(declared); len(items) != 0 {
+		log.Warnf("The resource usage of task exceeded the declared cost, taskId: {%s}, partyId: {%s}, jobNodeId: {%s}, exceeded: %v, usage: %s, declared: %s",
+			usage.TaskId, usage.PartyId, usage.JobNodeId, items, usage.String(), declared.String())
+		m.eventEngine.StoreEvent(m.eventEngine.GenerateEvent(ev.TaskResourceUsageExceeded.Type, usage.TaskId, identityId,
+			fmt.Sprintf("partyId: %s, jobNodeId: %s, exceeded: %s", usage.PartyId, usage.JobNodeId, strings.Join(items, ","))))
+	}
+	return nil
+}
+
+// isTaskRunningOnJobNode checks whether the task is bound to the jobNode,
+// by the slots locked for the task or by the running tasks of the jobNode.
+func (m *Manager) isTaskRunningOnJobNode(taskId, jobNodeId string) (bool, error) {
+	used, err := m.dataCenter.QueryLocalTaskPowerUsed(taskId)
+	if rawdb.IsNoDBNotFoundErr(err) {
+		return false, err
+	}
+	if nil == err && used.GetNodeId() == jobNodeId {
+		return true, nil
+	}
+	taskIds, err := m.dataCenter.GetJobNodeRunningTaskIdList(jobNodeId)
+	if rawdb.IsNoDBNotFoundErr(err) {
+		return false, err
+	}
+	for _, id := range taskIds {
+		if id == taskId {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -1,6 +1,7 @@
 package task
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"github.com/RosettaFlow/Carrier-Go/common/timeutils"
@@ -8,10 +9,14 @@ import (
 	"github.com/RosettaFlow/Carrier-Go/core/resource"
 	pb "github.com/RosettaFlow/Carrier-Go/lib/consensus/twopc"
 	"github.com/RosettaFlow/Carrier-Go/lib/fighter/common"
+	"github.com/RosettaFlow/Carrier-Go/lib/fighter/computesvc"
 	libTypes "github.com/RosettaFlow/Carrier-Go/lib/types"
 	"github.com/RosettaFlow/Carrier-Go/types"
 	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"strconv"
+	"sync/atomic"
 	"time"
 )
 
@@ -144,15 +149,20 @@ func (m *Manager) publishFinishedTaskToDataCenter(taskId string) {
 		log.Errorf("Failed to Query all task event list for sending datacenter on publishFinishedTaskToDataCenter, taskId: {%s}, err: {%s}", taskWrap.Task.SchedTask.TaskId(), err)
 		return
 	}
-	var isFailed bool
+	var isFailed, isCancelled bool
 	for _, event := range eventList {
 		if event.Type == ev.TaskFailed.Type {
 			isFailed = true
+		}
+		if event.Type == ev.TaskCancelled.Type {
+			isCancelled = true
 			break
 		}
 	}
 	var taskState string
-	if isFailed {
+	if isCancelled {
+		taskState = types.TaskStateCancelled.String()
+	} else if isFailed {
 		taskState = types.TaskStateFailed.String()
 	} else {
 		taskState = types.TaskStateSuccess.String()
@@ -160,6 +170,23 @@ func (m *Manager) publishFinishedTaskToDataCenter(taskId string) {
 
 	log.Debugf("Start publishFinishedTaskToDataCenter, taskId: {%s}, taskState: {%s}", taskId, taskState)
 
+	// the failed task will be requeued with a new attempt, if its retry policy allows
+	var retryMsg *types.TaskMsg
+	if taskState == types.TaskStateFailed.String() && taskWrap.Task.SchedTask.CanRetry() {
+		msg, err := types.NewRetryTaskMsg(taskWrap.Task.SchedTask)
+		if nil != err {
+			log.Errorf("Failed to make the next attempt of task on publishFinishedTaskToDataCenter, taskId: {%s}, err: {%s}", taskId, err)
+		} else {
+			retryMsg = msg
+			event := m.eventEngine.GenerateEvent(ev.TaskRetried.Type, taskId, taskWrap.Task.SchedTask.TaskData().Identity,
+				fmt.Sprintf("next attempt: %d, taskId: %s", msg.Data.Attempt(), msg.TaskId))
+			if err := m.dataCenter.StoreTaskEvent(event); nil != err {
+				log.Warnf("Failed to store the retried event of task on publishFinishedTaskToDataCenter, taskId: {%s}, err: {%s}", taskId, err)
+			}
+			eventList = append(eventList, event)
+		}
+	}
+
 	finalTask := m.convertScheduleTaskToTask(taskWrap.Task.SchedTask, eventList, taskState)
 
 	if err := m.dataCenter.InsertTask(finalTask); nil != err {
@@ -170,6 +197,11 @@ func (m *Manager) publishFinishedTaskToDataCenter(taskId string) {
 	// 发送到 dataCenter 成功后 ...
 	close(taskWrap.ResultCh)
 
+	// the progress is useless after the task finished
+	if err := m.dataCenter.RemoveTaskProgressList(taskId); nil != err {
+		log.Warnf("Failed to remove task progress on publishFinishedTaskToDataCenter, taskId: {%s}, err: {%s}", taskId, err)
+	}
+
 	if err := m.dataCenter.RemoveLocalTaskExecuteStatus(taskId); nil != err {
 		log.Errorf("Failed to remove task executing status on publishFinishedTaskToDataCenter, taskId: {%s}, err: {%s}", taskWrap.Task.SchedTask.TaskId(), err)
 		return
@@ -179,6 +211,96 @@ func (m *Manager) publishFinishedTaskToDataCenter(taskId string) {
 	m.resourceMng.ReleaseLocalResourceWithTask("on taskManager.publishFinishedTaskToDataCenter()", taskId, resource.SetAllReleaseResourceOption())
 
 	log.Debugf("Finished pulishFinishedTaskToDataCenter, taskId: {%s}, taskState: {%s}", taskId, taskState)
+
+	finished := &types.TaskFinishedEvent{TaskId: taskId, State: types.TaskState(taskState)}
+	if nil != retryMsg {
+		finished.RetryTaskId = retryMsg.TaskId
+		m.retryTask(retryMsg)
+	}
+	m.taskFinishedFeed.Send(finished)
+}
+
+// retryTask requeues the next attempt of the failed task to the scheduler after the backoff of its retry policy,
+// the attempt is persisted until it is requeued, so that it survives the restart of the node.
+func (m *Manager) retryTask(msg *types.TaskMsg) {
+	backoff := msg.Data.RetryBackoff()
+
+	log.Infof("Start retry the failed task, originTaskId: {%s}, taskId: {%s}, attempt: {%d}, maxAttempts: {%d}, backoff: {%s}",
+		msg.Data.OriginTaskId(), msg.TaskId, msg.Data.Attempt(), msg.Data.TaskData().GetRetryPolicy().GetMaxAttempts(), backoff)
+
+	record := &types.TaskRetryRecord{
+		TaskId:        msg.TaskId,
+		PowerPartyIds: msg.PowerPartyIds,
+		RequeueAt:     uint64(timeutils.UnixMsec()) + uint64(backoff.Milliseconds()),
+	}
+	if err := m.dataCenter.StoreLocalTask(msg.Data); nil != err {
+		log.Errorf("Failed to store the retry attempt of task, taskId: {%s}, err: {%s}", msg.TaskId, err)
+	} else if err := m.dataCenter.StorePendingTaskRetry(record); nil != err {
+		log.Errorf("Failed to store the pending retry of task, taskId: {%s}, err: {%s}", msg.TaskId, err)
+	}
+	m.requeueRetryTask(msg, backoff)
+}
+
+// requeueRetryTask sends the next attempt of the failed task to the scheduler after the delay,
+// unless it was cancelled by `CancelPendingRetry` before.
+func (m *Manager) requeueRetryTask(msg *types.TaskMsg, delay time.Duration) {
+	m.pendingRetryTimerLock.Lock()
+	defer m.pendingRetryTimerLock.Unlock()
+
+	m.pendingRetryTimers[msg.TaskId] = time.AfterFunc(delay, func() {
+		m.pendingRetryTimerLock.Lock()
+		if _, ok := m.pendingRetryTimers[msg.TaskId]; !ok {
+			m.pendingRetryTimerLock.Unlock()
+			return
+		}
+		delete(m.pendingRetryTimers, msg.TaskId)
+		m.pendingRetryTimerLock.Unlock()
+
+		event := m.eventEngine.GenerateEvent(ev.TaskRetryAttempt.Type, msg.TaskId, msg.Data.TaskData().Identity,
+			fmt.Sprintf("attempt: %d, originTaskId: %s", msg.Data.Attempt(), msg.Data.OriginTaskId()))
+		if err := m.dataCenter.StoreTaskEvent(event); nil != err {
+			log.Warnf("Failed to store the retry attempt event of task, taskId: {%s}, err: {%s}", msg.TaskId, err)
+		}
+		select {
+		case m.localTaskMsgCh <- types.TaskMsgs{msg}:
+			// the scheduler persists the attempt on its queue from now on
+			if err := m.dataCenter.RemovePendingTaskRetry(msg.TaskId); nil != err {
+				log.Warnf("Failed to remove the pending retry of task, taskId: {%s}, err: {%s}", msg.TaskId, err)
+			}
+		case <-m.quit:
+			log.Warnf("The taskManager was stopped before requeue the retry attempt of task, taskId: {%s}", msg.TaskId)
+		}
+	})
+}
+
+// recoverPendingRetries restarts the backoff of the retry attempts which were not requeued before the node stopped.
+func (m *Manager) recoverPendingRetries() error {
+	records, err := m.dataCenter.GetPendingTaskRetryList()
+	if nil != err {
+		return err
+	}
+	now := uint64(timeutils.UnixMsec())
+	for _, record := range records {
+		task, err := m.dataCenter.GetLocalTask(record.TaskId)
+		if nil != err {
+			log.Errorf("Failed to query the local task of pending retry, drop it, taskId: {%s}, err: {%s}", record.TaskId, err)
+			if err := m.dataCenter.RemovePendingTaskRetry(record.TaskId); nil != err {
+				log.Warnf("Failed to remove the pending retry of task, taskId: {%s}, err: {%s}", record.TaskId, err)
+			}
+			continue
+		}
+		var delay time.Duration
+		if record.RequeueAt > now {
+			delay = time.Duration(record.RequeueAt-now) * time.Millisecond
+		}
+		log.Infof("Recover the pending retry of task, taskId: {%s}, attempt: {%d}, delay: {%s}", record.TaskId, task.Attempt(), delay)
+		m.requeueRetryTask(&types.TaskMsg{
+			TaskId:        record.TaskId,
+			PowerPartyIds: record.PowerPartyIds,
+			Data:          task,
+		}, delay)
+	}
+	return nil
 }
 func (m *Manager) sendTaskResultMsgToConsensus(taskId string) {
 
@@ -201,6 +323,10 @@ func (m *Manager) sendTaskResultMsgToConsensus(taskId string) {
 	}
 	close(taskWrap.ResultCh)
 
+	if err := m.dataCenter.RemoveTaskProgressList(taskId); nil != err {
+		log.Warnf("Failed to remove task progress on sendTaskResultMsgToConsensus, taskId: {%s}, err: {%s}", taskId, err)
+	}
+
 	// clean local task cache
 	m.removeRunningTaskCache(taskWrap.Task.SchedTask.TaskId())
 
@@ -214,7 +340,23 @@ func (m *Manager) sendTaskEvent(event *types.TaskEventInfo) {
 	m.eventCh <- event
 }
 
+// storeBadTaskMsgs stores the rejected taskMsgs into dataCenter with the `TaskFailed` event which carry the rule that failed.
+func (m *Manager) storeBadTaskMsgs(badTasks []*badTaskMsg, reason string) {
+	for _, bad := range badTasks {
+		errtask := bad.msg
+		events, _ := m.dataCenter.GetTaskEventList(errtask.TaskId)
+		events = append(events, m.eventEngine.GenerateEvent(ev.TaskFailed.Type,
+			errtask.TaskId, errtask.OwnerIdentityId(), fmt.Sprintf("%s, %s", reason, bad.err)))
+
+		if e := m.storeErrTaskMsg(errtask, types.ConvertTaskEventArrToDataCenter(events), fmt.Sprintf("%s, %s", reason, bad.err)); nil != e {
+			log.Errorf("Failed to store the err taskMsg on taskManager, taskId: {%s}, err: {%s}", errtask.TaskId, e)
+		}
+		m.taskFinishedFeed.Send(&types.TaskFinishedEvent{TaskId: errtask.TaskId, State: types.TaskStateFailed})
+	}
+}
+
 func (m *Manager) storeErrTaskMsg(msg *types.TaskMsg, events []*libTypes.EventData, reason string) error {
+	msg.Data.TaskData().State = types.TaskStateFailed.String()
 	msg.Data.TaskData().EventDataList = events
 	msg.Data.TaskData().EventCount = uint32(len(events))
 	msg.Data.TaskData().Reason = reason
@@ -424,6 +566,12 @@ func (m *Manager) makeTaskResultByEventList(taskWrap *types.DoneScheduleTaskChWr
 		log.Errorf("Failed to make TaskResultMsg with query task eventList, taskId {%s}, err {%s}", taskWrap.Task.SchedTask.TaskId(), err)
 		return nil
 	}
+	// the final resource usage reported by local jobNodes, for the task owner's accounting.
+	usageList, err := m.dataCenter.GetTaskResourceUsageList(taskWrap.Task.SchedTask.TaskId())
+	if nil != err {
+		log.Warnf("Failed to query task resource usage on makeTaskResultByEventList, taskId {%s}, err {%s}", taskWrap.Task.SchedTask.TaskId(), err)
+		usageList = make([]*types.TaskResourceUsage, 0)
+	}
 	return &types.TaskResultMsgWrap{
 		TaskResultMsg: &pb.TaskResultMsg{
 			ProposalId: taskWrap.ProposalId.Bytes(),
@@ -438,10 +586,110 @@ func (m *Manager) makeTaskResultByEventList(taskWrap *types.DoneScheduleTaskChWr
 			TaskEventList: types.ConvertTaskEventArr(eventList),
 			CreateAt:      uint64(timeutils.UnixMsec()),
 			Sign:          nil,
+			ResourceUsageList: types.ConvertTaskResourceUsageArr(usageList),
+		},
+	}
+}
+
+// pollTaskProgress queries the progress of the running tasks from the local jobNodes,
+// stores the latest progress and sends it to the task owner, if the task was published by the remote.
+func (m *Manager) pollTaskProgress() {
+	if !atomic.CompareAndSwapInt32(&m.progressPolling, 0, 1) {
+		return
+	}
+	defer atomic.StoreInt32(&m.progressPolling, 0)
+
+	// only the powerSupplier executes the task on the jobNode
+	jobNodeTasks := make(map[string][]*types.DoneScheduleTaskChWrap)
+	m.runningTaskCacheLock.RLock()
+	for _, task := range m.runningTaskCache {
+		if task.SelfTaskRole != types.PowerSupplier || nil == task.Task.SelfVotePeerInfo {
+			continue
+		}
+		jobNodeId := task.Task.SelfVotePeerInfo.Id
+		jobNodeTasks[jobNodeId] = append(jobNodeTasks[jobNodeId], task)
+	}
+	m.runningTaskCacheLock.RUnlock()
+
+	for jobNodeId, tasks := range jobNodeTasks {
+		client, has := m.resourceClientSet.QueryJobNodeClient(jobNodeId)
+		if !has || client.IsNotConnected() {
+			continue
+		}
+		taskIds := make([]string, len(tasks))
+		for i, task := range tasks {
+			taskIds[i] = task.Task.SchedTask.TaskId()
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), taskProgressRequestTimeout)
+		reply, err := client.GetTaskDetails(ctx, taskIds)
+		cancel()
+		if nil != err {
+			log.Warnf("Failed to query task details from jobNode on pollTaskProgress, jobNodeId: {%s}, taskIds: %v, err: {%s}", jobNodeId, taskIds, err)
+			continue
+		}
+		details := make(map[string]*computesvc.GetTaskDetailsReply_Detail, len(reply.GetTaskDetails()))
+		for _, detail := range reply.GetTaskDetails() {
+			details[detail.GetTaskId()] = detail
+		}
+
+		now := uint64(timeutils.UnixMsec())
+		for _, task := range tasks {
+			detail, ok := details[task.Task.SchedTask.TaskId()]
+			if !ok {
+				continue
+			}
+			progress := &types.TaskProgress{
+				TaskId:      task.Task.SchedTask.TaskId(),
+				PartyId:     task.SelfIdentity.PartyId,
+				IdentityId:  task.SelfIdentity.Identity,
+				JobNodeId:   jobNodeId,
+				Phase:       detail.GetPhase(),
+				Percent:     types.ParseTaskProgressPercent(detail.GetProgress()),
+				ElapsedTime: nonNegative(detail.GetElapsedTime()),
+				RemainTime:  nonNegative(detail.GetRemainTime()),
+				UpdateAt:    now,
+			}
+			if err := m.dataCenter.StoreTaskProgress(progress); nil != err {
+				log.Errorf("Failed to store task progress on pollTaskProgress, taskId: {%s}, err: {%s}", progress.TaskId, err)
+			}
+			if task.Task.TaskDir == types.RecvTaskDir {
+				// never block the polling, the progress will be sent in next round
+				select {
+				case task.ProgressCh <- m.makeTaskProgressMsg(task, progress):
+				default:
+				}
+			}
+		}
+	}
+}
+
+func (m *Manager) makeTaskProgressMsg(taskWrap *types.DoneScheduleTaskChWrap, progress *types.TaskProgress) *types.TaskProgressMsgWrap {
+	return &types.TaskProgressMsgWrap{
+		TaskProgressMsg: &pb.TaskProgressMsg{
+			ProposalId: taskWrap.ProposalId.Bytes(),
+			TaskRole:   taskWrap.SelfTaskRole.Bytes(),
+			TaskId:     []byte(taskWrap.Task.SchedTask.TaskId()),
+			Owner: &pb.TaskOrganizationIdentityInfo{
+				PartyId:    []byte(taskWrap.SelfIdentity.PartyId),
+				Name:       []byte(taskWrap.SelfIdentity.NodeName),
+				NodeId:     []byte(taskWrap.SelfIdentity.NodeId),
+				IdentityId: []byte(taskWrap.SelfIdentity.Identity),
+			},
+			ProgressList: types.ConvertTaskProgressArr([]*types.TaskProgress{progress}),
+			CreateAt:     uint64(timeutils.UnixMsec()),
+			Sign:         nil,
 		},
 	}
 }
 
+func nonNegative(v int64) uint64 {
+	if v < 0 {
+		return 0
+	}
+	return uint64(v)
+}
+
 func (m *Manager) handleEvent(event *types.TaskEventInfo) error {
 	eventType := event.Type
 	if len(eventType) != ev.EventTypeCharLen {
@@ -493,7 +741,7 @@ func (m *Manager) handleDoneScheduleTask(taskId string) {
 	switch task.SelfTaskRole {
 	case types.TaskOnwer:
 		switch task.Task.TaskState {
-		case types.TaskStateFailed, types.TaskStateSuccess:
+		case types.TaskStateFailed, types.TaskStateSuccess, types.TaskStateCancelled:
 
 			m.storeTaskFinalEvent(task.Task.SchedTask.TaskId(), task.SelfIdentity.Identity, "", task.Task.TaskState)
 			m.publishFinishedTaskToDataCenter(taskId)
@@ -514,7 +762,7 @@ func (m *Manager) handleDoneScheduleTask(taskId string) {
 
 	default:
 		switch task.Task.TaskState {
-		case types.TaskStateFailed, types.TaskStateSuccess:
+		case types.TaskStateFailed, types.TaskStateSuccess, types.TaskStateCancelled:
 			// 因为是 task 参与者, 所以需要构造 taskResult 发送给 task 发起者..  (里面有解锁 本地资源 ...)
 			m.storeTaskFinalEvent(task.Task.SchedTask.TaskId(), task.SelfIdentity.Identity, "", task.Task.TaskState)
 			m.sendTaskResultMsgToConsensus(taskId)
@@ -534,6 +782,107 @@ func (m *Manager) handleDoneScheduleTask(taskId string) {
 	}
 }
 
+// handleCancelTask stops the task executing on the local Fighter node,
+// and then finishes it with the `cancelled` state (the local resource of task will be released with it).
+// handleCancelTask stops the task on the local Fighter and then finishes it.
+//
+// If the Fighter refused or was unreachable, the task keeps running with its slots locked
+// and the error is returned, so that the canceling can be tried again.
+func (m *Manager) handleCancelTask(taskId string) error {
+
+	task, ok := m.queryRunningTaskCacheOk(taskId)
+	if !ok {
+		log.Warnf("Not found local running task cache on handleCancelTask, taskId: {%s}", taskId)
+		return fmt.Errorf("not found local running task cache, taskId: {%s}", taskId)
+	}
+
+	log.Debugf("Start handle cancelTask, taskId: {%s}, taskRole: {%s}, taskState: {%s}", taskId, task.SelfTaskRole.String(), task.Task.TaskState.String())
+
+	if task.Task.TaskState == types.TaskStateRunning {
+		if err := m.cancelTaskOnFighter(task); nil != err {
+			log.Errorf("Failed to cancel task on %s node, keep the task running, taskId: {%s}, err: {%s}", task.SelfTaskRole.String(), taskId, err)
+			return fmt.Errorf("cancel task on %s node failed, %s", task.SelfTaskRole.String(), err)
+		}
+	}
+
+	m.storeTaskFinalEvent(taskId, task.SelfIdentity.Identity, "", types.TaskStateCancelled)
+
+	switch task.SelfTaskRole {
+	case types.TaskOnwer:
+		m.publishFinishedTaskToDataCenter(taskId)
+	default:
+		// 因为是 task 参与者, 所以需要构造 taskResult 发送给 task 发起者..  (里面有解锁 本地资源 ...)
+		m.sendTaskResultMsgToConsensus(taskId)
+	}
+	return nil
+}
+
+func (m *Manager) cancelTaskOnFighter(task *types.DoneScheduleTaskChWrap) error {
+
+	if nil == task.Task.SelfVotePeerInfo {
+		return errors.New("the internal resource node of task is not found")
+	}
+
+	req := &common.TaskCancelReq{
+		TaskId:  task.Task.SchedTask.TaskId(),
+		PartyId: task.SelfIdentity.PartyId,
+	}
+
+	var (
+		resp *common.TaskCancelReply
+		err  error
+	)
+
+	switch task.SelfTaskRole {
+	case types.TaskOnwer, types.DataSupplier, types.ResultSupplier:
+
+		client, has := m.resourceClientSet.QueryDataNodeClient(task.Task.SelfVotePeerInfo.Id)
+		if !has {
+			return errors.New("data node client not found")
+		}
+		if client.IsNotConnected() {
+			if err := client.Reconnect(); nil != err {
+				return err
+			}
+		}
+		resp, err = client.HandleCancelTask(req)
+
+	case types.PowerSupplier:
+
+		client, has := m.resourceClientSet.QueryJobNodeClient(task.Task.SelfVotePeerInfo.Id)
+		if !has {
+			return errors.New("job node client not found")
+		}
+		if client.IsNotConnected() {
+			if err := client.Reconnect(); nil != err {
+				return err
+			}
+		}
+		resp, err = client.HandleCancelTask(req)
+
+	default:
+		return errors.New("Unknown resource node type")
+	}
+
+	if status.Code(err) == codes.Unimplemented {
+		// the older Fighter can not cancel task, the task is cancelled on carrier only,
+		// and its process on Fighter keeps running until it is finished or expired.
+		log.Warnf("The %s node does not support canceling task, only cancel it on carrier, taskId: {%s}, nodeId: {%s}",
+			task.SelfTaskRole.String(), task.Task.SchedTask.TaskId(), task.Task.SelfVotePeerInfo.Id)
+		return nil
+	}
+	if nil != err {
+		return err
+	}
+	if !resp.Ok {
+		return fmt.Errorf("the Fighter node refused to cancel task, %s", resp.Msg)
+	}
+
+	log.Infof("Success to cancel task on %s node, taskId: {%s}, nodeId: {%s}", task.SelfTaskRole.String(),
+		task.Task.SchedTask.TaskId(), task.Task.SelfVotePeerInfo.Id)
+	return nil
+}
+
 func (m *Manager) expireTaskMonitor () {
 
 	for taskId, task := range m.runningTaskCache {
@@ -565,6 +914,9 @@ func (m *Manager) storeTaskFinalEvent(taskId, identityId, extra string, state ty
 	if state == types.TaskStateFailed {
 		evTyp = ev.TaskFailed.Type
 		evMsg = ev.TaskFailed.Msg
+	} else if state == types.TaskStateCancelled {
+		evTyp = ev.TaskCancelled.Type
+		evMsg = ev.TaskCancelled.Msg
 	} else {
 		evTyp = ev.TaskSucceed.Type
 		evMsg = ev.TaskSucceed.Msg
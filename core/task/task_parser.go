@@ -1,15 +1,136 @@
 package task
 
-import "github.com/RosettaFlow/Carrier-Go/types"
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/RosettaFlow/Carrier-Go/types"
+	"strings"
+	"unicode/utf8"
+)
 
-type TaskParser struct {
+var (
+	ErrTaskMsgOwnerInvalid          = errors.New("the owner of task is invalid")
+	ErrTaskMsgNameEmpty             = errors.New("the taskName of task is empty")
+	ErrTaskMsgDataSupplierEmpty     = errors.New("the dataSuppliers of task is empty")
+	ErrTaskMsgReceiverEmpty         = errors.New("the receivers of task is empty")
+	ErrTaskMsgPowerPartyIdEmpty     = errors.New("the powerPartyIds of task is empty")
+	ErrTaskMsgPartyIdEmpty          = errors.New("the partyId of task partner is empty")
+	ErrTaskMsgPartyIdDuplicated     = errors.New("the partyId of task partner is duplicated")
+	ErrTaskMsgContractCodeEmpty     = errors.New("the calculateContractCode of task is empty")
+	ErrTaskMsgContractCodeNotText   = errors.New("the contract code of task is not utf8 text")
+	ErrTaskMsgContractCodeSyntax    = errors.New("the contract code of task is not valid python source")
+	ErrTaskMsgContractParamsInvalid = errors.New("the contractExtraParams of task is not valid json")
+)
 
+type TaskParser struct {
 }
 
-func newTaskParser () *TaskParser {
+func newTaskParser() *TaskParser {
 	return &TaskParser{}
 }
 
-func (tp *TaskParser) ParseTask(tasks types.TaskMsgs) (types.TaskMsgs, error) {
-	return nil, nil
-}
\ No newline at end of file
+// ParseTask checks the format of taskMsgs (which need not to query any other data),
+// and returns the taskMsgs passed and the taskMsgs failed with the rule that failed.
+func (tp *TaskParser) ParseTask(tasks types.TaskMsgs) (types.TaskMsgs, []*badTaskMsg) {
+
+	goodTasks, badTasks := make(types.TaskMsgs, 0), make([]*badTaskMsg, 0)
+
+	for _, task := range tasks {
+		if err := tp.parseTaskMsg(task); nil != err {
+			log.Warnf("Failed to parse taskMsg, taskId: {%s}, err: {%s}", task.TaskId, err)
+			badTasks = append(badTasks, &badTaskMsg{msg: task, err: err})
+			continue
+		}
+		goodTasks = append(goodTasks, task)
+	}
+	return goodTasks, badTasks
+}
+
+func (tp *TaskParser) parseTaskMsg(task *types.TaskMsg) error {
+
+	if "" == task.OwnerIdentityId() || "" == task.OwnerNodeId() || "" == task.OwnerPartyId() {
+		return fmt.Errorf("%s, identityId: {%s}, nodeId: {%s}, partyId: {%s}",
+			ErrTaskMsgOwnerInvalid, task.OwnerIdentityId(), task.OwnerNodeId(), task.OwnerPartyId())
+	}
+	if "" == strings.TrimSpace(task.TaskName()) {
+		return ErrTaskMsgNameEmpty
+	}
+	if len(task.TaskMetadataSupplierDatas()) == 0 {
+		return ErrTaskMsgDataSupplierEmpty
+	}
+	if len(task.TaskResultReceiverDatas()) == 0 {
+		return ErrTaskMsgReceiverEmpty
+	}
+	if len(task.GetPowerPartyIds()) == 0 {
+		return ErrTaskMsgPowerPartyIdEmpty
+	}
+
+	// The partyId is the only mark of the task partner on the task,
+	// so it must be unique among owner, dataSuppliers, powerSuppliers and receivers.
+	partyIds := make(map[string]string, 0)
+	checkPartyId := func(role types.TaskRole, partyId string) error {
+		if "" == partyId {
+			return fmt.Errorf("%s, role: {%s}", ErrTaskMsgPartyIdEmpty, role.String())
+		}
+		if r, ok := partyIds[partyId]; ok {
+			return fmt.Errorf("%s, partyId: {%s}, role: {%s} and {%s}", ErrTaskMsgPartyIdDuplicated, partyId, r, role.String())
+		}
+		partyIds[partyId] = role.String()
+		return nil
+	}
+	if err := checkPartyId(types.TaskOnwer, task.OwnerPartyId()); nil != err {
+		return err
+	}
+	for _, supplier := range task.TaskMetadataSupplierDatas() {
+		if nil == supplier.GetOrganization() {
+			return fmt.Errorf("%s, role: {%s}", ErrTaskMsgPartyIdEmpty, types.DataSupplier.String())
+		}
+		if err := checkPartyId(types.DataSupplier, supplier.GetOrganization().GetPartyId()); nil != err {
+			return err
+		}
+	}
+	for _, partyId := range task.GetPowerPartyIds() {
+		if err := checkPartyId(types.PowerSupplier, partyId); nil != err {
+			return err
+		}
+	}
+	for _, receiver := range task.TaskResultReceiverDatas() {
+		if nil == receiver.GetReceiver() {
+			return fmt.Errorf("%s, role: {%s}", ErrTaskMsgPartyIdEmpty, types.ResultSupplier.String())
+		}
+		if err := checkPartyId(types.ResultSupplier, receiver.GetReceiver().GetPartyId()); nil != err {
+			return err
+		}
+	}
+
+	// The contract code will be handed to Fighter-Py as the python source, so it must be a non-empty utf8 text
+	// which passes the tokenizer check (the dataSplitContractCode is optional).
+	if "" == strings.TrimSpace(task.CalculateContractCode()) {
+		return ErrTaskMsgContractCodeEmpty
+	}
+	if !utf8.ValidString(task.CalculateContractCode()) {
+		return fmt.Errorf("%s, calculateContractCode", ErrTaskMsgContractCodeNotText)
+	}
+	if err := checkPythonSyntax(task.CalculateContractCode()); nil != err {
+		return fmt.Errorf("%s, calculateContractCode, %s", ErrTaskMsgContractCodeSyntax, err)
+	}
+	if !utf8.ValidString(task.DataSplitContractCode()) {
+		return fmt.Errorf("%s, dataSplitContractCode", ErrTaskMsgContractCodeNotText)
+	}
+	if "" != strings.TrimSpace(task.DataSplitContractCode()) {
+		if err := checkPythonSyntax(task.DataSplitContractCode()); nil != err {
+			return fmt.Errorf("%s, dataSplitContractCode, %s", ErrTaskMsgContractCodeSyntax, err)
+		}
+	}
+	if "" != strings.TrimSpace(task.ContractExtraParams()) && !json.Valid([]byte(task.ContractExtraParams())) {
+		return ErrTaskMsgContractParamsInvalid
+	}
+	return nil
+}
+
+// badTaskMsg is the taskMsg which failed on parsing or validating.
+type badTaskMsg struct {
+	msg *types.TaskMsg
+	err error
+}
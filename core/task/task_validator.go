@@ -1,15 +1,142 @@
 package task
 
-import "github.com/RosettaFlow/Carrier-Go/types"
+import (
+	"errors"
+	"fmt"
+	"github.com/RosettaFlow/Carrier-Go/common/timeutils"
+	"github.com/RosettaFlow/Carrier-Go/core"
+	"github.com/RosettaFlow/Carrier-Go/types"
+	"time"
+)
 
-type  TaskValidator struct {
+const (
+	// the sane bounds of `TaskOperationCostDeclare`
+	maxTaskCostProcessor = 1024                                   // cores
+	maxTaskCostMem       = 1 << 40                                // 1TB, unit: byte
+	maxTaskCostBandwidth = 1 << 40                                // 1TB/s, unit: byte/s
+	maxTaskDuration      = 30 * 24 * 60 * 60 * 1000               // 30 days, unit: ms
+	minTaskDuration      = uint64(time.Second / time.Millisecond) // 1s, unit: ms
+)
 
+var (
+	ErrTaskMsgOwnerNotSelf           = errors.New("the owner of task is not the local identity")
+	ErrTaskMsgOperationCostInvalid   = errors.New("the operationCost of task is out of bounds")
+	ErrTaskMsgDeadlineInvalid        = errors.New("the deadline of task can not be met")
+	ErrTaskMsgMetadataNotFound       = errors.New("the metadata of dataSupplier is not found")
+	ErrTaskMsgMetadataRevoked        = errors.New("the metadata of dataSupplier has been revoked")
+	ErrTaskMsgMetadataOwnerMismatch  = errors.New("the metadata is not owned by the dataSupplier")
+	ErrTaskMsgMetadataColumnNotFound = errors.New("the column of metadata is not found")
+)
+
+type TaskValidator struct {
+	dataCenter core.CarrierDB
 }
 
-func newTaskValidator () *TaskValidator {
-	return &TaskValidator{}
+func newTaskValidator(dataCenter core.CarrierDB) *TaskValidator {
+	return &TaskValidator{
+		dataCenter: dataCenter,
+	}
 }
 
-func (tv *TaskValidator) validateTaskMsg (tasks types.TaskMsgs) (types.TaskMsgs, error) {
-	return nil, nil
-}
\ No newline at end of file
+// validateTaskMsg checks the taskMsgs against the local identity and the metadata on dataCenter,
+// and returns the taskMsgs passed and the taskMsgs failed with the rule that failed.
+func (tv *TaskValidator) validateTaskMsg(tasks types.TaskMsgs) (types.TaskMsgs, []*badTaskMsg) {
+
+	goodTasks, badTasks := make(types.TaskMsgs, 0), make([]*badTaskMsg, 0)
+
+	identity, err := tv.dataCenter.GetIdentity()
+	if nil != err {
+		log.Errorf("Failed to query local identity on taskValidator, err: {%s}", err)
+		for _, task := range tasks {
+			badTasks = append(badTasks, &badTaskMsg{msg: task, err: fmt.Errorf("%s, query local identity failed, %s", ErrTaskMsgOwnerNotSelf, err)})
+		}
+		return goodTasks, badTasks
+	}
+
+	for _, task := range tasks {
+		if err := tv.validate(identity, task); nil != err {
+			log.Warnf("Failed to validate taskMsg, taskId: {%s}, err: {%s}", task.TaskId, err)
+			badTasks = append(badTasks, &badTaskMsg{msg: task, err: err})
+			continue
+		}
+		goodTasks = append(goodTasks, task)
+	}
+	return goodTasks, badTasks
+}
+
+func (tv *TaskValidator) validate(identity *types.NodeAlias, task *types.TaskMsg) error {
+
+	if task.OwnerIdentityId() != identity.GetNodeIdentityId() || task.OwnerNodeId() != identity.GetNodeIdStr() {
+		return fmt.Errorf("%s, task owner: {%s/%s}, local: {%s/%s}", ErrTaskMsgOwnerNotSelf,
+			task.OwnerIdentityId(), task.OwnerNodeId(), identity.GetNodeIdentityId(), identity.GetNodeIdStr())
+	}
+
+	if err := validateOperationCost(task); nil != err {
+		return err
+	}
+
+	if err := validateDeadline(task); nil != err {
+		return err
+	}
+
+	for _, supplier := range task.TaskMetadataSupplierDatas() {
+
+		metadataId := supplier.GetMetaId()
+		metadata, err := tv.dataCenter.GetMetadataByDataId(metadataId)
+		if nil != err || nil == metadata {
+			return fmt.Errorf("%s, metadataId: {%s}", ErrTaskMsgMetadataNotFound, metadataId)
+		}
+		data := metadata.MetadataData()
+		if data.GetState() == types.MetaDataStateRevoke.String() || data.GetDataStatus() == types.DataStatusDeleted.String() {
+			return fmt.Errorf("%s, metadataId: {%s}", ErrTaskMsgMetadataRevoked, metadataId)
+		}
+		if data.GetIdentity() != supplier.GetOrganization().GetIdentity() {
+			return fmt.Errorf("%s, metadataId: {%s}, metadata owner: {%s}, dataSupplier: {%s}", ErrTaskMsgMetadataOwnerMismatch,
+				metadataId, data.GetIdentity(), supplier.GetOrganization().GetIdentity())
+		}
+
+		columns := make(map[uint32]struct{}, len(data.GetColumnMetaList()))
+		for _, col := range data.GetColumnMetaList() {
+			columns[col.GetCindex()] = struct{}{}
+		}
+		for _, col := range supplier.GetColumnList() {
+			if _, ok := columns[col.GetCindex()]; !ok {
+				return fmt.Errorf("%s, metadataId: {%s}, columnIndex: {%d}", ErrTaskMsgMetadataColumnNotFound, metadataId, col.GetCindex())
+			}
+		}
+	}
+	return nil
+}
+
+func validateOperationCost(task *types.TaskMsg) error {
+	cost := task.OperationCost()
+	if nil == cost {
+		return fmt.Errorf("%s, operationCost is empty", ErrTaskMsgOperationCostInvalid)
+	}
+	if cost.GetCostProcessor() == 0 || cost.GetCostProcessor() > maxTaskCostProcessor {
+		return fmt.Errorf("%s, costProcessor: {%d}, must be in (0, %d]", ErrTaskMsgOperationCostInvalid, cost.GetCostProcessor(), maxTaskCostProcessor)
+	}
+	if cost.GetCostMem() == 0 || cost.GetCostMem() > maxTaskCostMem {
+		return fmt.Errorf("%s, costMem: {%d}, must be in (0, %d]", ErrTaskMsgOperationCostInvalid, cost.GetCostMem(), uint64(maxTaskCostMem))
+	}
+	if cost.GetCostBandwidth() > maxTaskCostBandwidth {
+		return fmt.Errorf("%s, costBandwidth: {%d}, must be in [0, %d]", ErrTaskMsgOperationCostInvalid, cost.GetCostBandwidth(), uint64(maxTaskCostBandwidth))
+	}
+	if cost.GetDuration() < minTaskDuration || cost.GetDuration() > maxTaskDuration {
+		return fmt.Errorf("%s, duration: {%d}, must be in [%d, %d]", ErrTaskMsgOperationCostInvalid, cost.GetDuration(), minTaskDuration, uint64(maxTaskDuration))
+	}
+	return nil
+}
+
+func validateDeadline(task *types.TaskMsg) error {
+	data := task.Data.TaskData()
+	if 0 != data.GetFinishDeadline() && data.GetStartDeadline() > data.GetFinishDeadline() {
+		return fmt.Errorf("%s, startDeadline: {%d} is later than finishDeadline: {%d}", ErrTaskMsgDeadlineInvalid,
+			data.GetStartDeadline(), data.GetFinishDeadline())
+	}
+	if err := task.Data.CheckDeadline(uint64(timeutils.UnixMsec())); nil != err {
+		return fmt.Errorf("%s, %s, startDeadline: {%d}, finishDeadline: {%d}, duration: {%d}", ErrTaskMsgDeadlineInvalid,
+			err, data.GetStartDeadline(), data.GetFinishDeadline(), data.GetTaskResource().GetDuration())
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package core
+
+import (
+	"sync"
+
+	"github.com/RosettaFlow/Carrier-Go/event"
+	"github.com/RosettaFlow/Carrier-Go/types"
+)
+
+// taskEventFeed sends the task events to the subscribers without blocking, the subscriber whose channel
+// is full is disconnected with ErrTaskEventSubscriberSlow, so that a slow one never stalls the others.
+type taskEventFeed struct {
+	lock sync.Mutex
+	subs map[*taskEventSubscription]struct{}
+}
+
+type taskEventSubscription struct {
+	feed *taskEventFeed
+	ch   chan<- *types.TaskEventInfo
+	err  chan error
+	once sync.Once
+}
+
+func (f *taskEventFeed) Subscribe(ch chan<- *types.TaskEventInfo) event.Subscription {
+	sub := &taskEventSubscription{
+		feed: f,
+		ch:   ch,
+		err:  make(chan error, 1),
+	}
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	if nil == f.subs {
+		f.subs = make(map[*taskEventSubscription]struct{})
+	}
+	f.subs[sub] = struct{}{}
+	return sub
+}
+
+// Send delivers the event to every subscriber, and returns the count of the subscribers which received it.
+func (f *taskEventFeed) Send(ev *types.TaskEventInfo) int {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	var sent int
+	for sub := range f.subs {
+		select {
+		case sub.ch <- ev:
+			sent++
+		default:
+			delete(f.subs, sub)
+			sub.err <- types.ErrTaskEventSubscriberSlow
+		}
+	}
+	return sent
+}
+
+func (s *taskEventSubscription) Unsubscribe() {
+	s.once.Do(func() {
+		s.feed.lock.Lock()
+		delete(s.feed.subs, s)
+		s.feed.lock.Unlock()
+		close(s.err)
+	})
+}
+
+func (s *taskEventSubscription) Err() <-chan error {
+	return s.err
+}
@@ -0,0 +1,43 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/RosettaFlow/Carrier-Go/types"
+)
+
+func TestTaskEventFeedDropSlowSubscriber(t *testing.T) {
+	var feed taskEventFeed
+
+	fastCh := make(chan *types.TaskEventInfo, 4)
+	fast := feed.Subscribe(fastCh)
+	defer fast.Unsubscribe()
+	slowCh := make(chan *types.TaskEventInfo, 1)
+	slow := feed.Subscribe(slowCh)
+	defer slow.Unsubscribe()
+
+	if sent := feed.Send(&types.TaskEventInfo{TaskId: "task:0x01"}); sent != 2 {
+		t.Fatalf("unexpected sent count: %d", sent)
+	}
+	// the slow subscriber never blocks the others, it is dropped once its channel is full
+	if sent := feed.Send(&types.TaskEventInfo{TaskId: "task:0x02"}); sent != 1 {
+		t.Fatalf("unexpected sent count: %d", sent)
+	}
+	if err := <-slow.Err(); err != types.ErrTaskEventSubscriberSlow {
+		t.Fatalf("expect the slow subscriber error, got: %v", err)
+	}
+	if sent := feed.Send(&types.TaskEventInfo{TaskId: "task:0x03"}); sent != 1 {
+		t.Fatalf("unexpected sent count: %d", sent)
+	}
+	if len(fastCh) != 3 || len(slowCh) != 1 {
+		t.Fatalf("unexpected received count, fast: %d, slow: %d", len(fastCh), len(slowCh))
+	}
+
+	fast.Unsubscribe()
+	if _, ok := <-fast.Err(); ok {
+		t.Fatal("the err channel should be closed after unsubscribe")
+	}
+	if sent := feed.Send(&types.TaskEventInfo{TaskId: "task:0x04"}); sent != 0 {
+		t.Fatalf("unexpected sent count: %d", sent)
+	}
+}
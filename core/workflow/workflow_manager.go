@@ -0,0 +1,445 @@
+package workflow
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/RosettaFlow/Carrier-Go/common/timeutils"
+	"github.com/RosettaFlow/Carrier-Go/core"
+	ev "github.com/RosettaFlow/Carrier-Go/core/evengine"
+	"github.com/RosettaFlow/Carrier-Go/core/rawdb"
+	"github.com/RosettaFlow/Carrier-Go/event"
+	libTypes "github.com/RosettaFlow/Carrier-Go/lib/types"
+	"github.com/RosettaFlow/Carrier-Go/types"
+)
+
+const (
+	// the interval to retry publishing the tasks which wait for the result metadata of their upstream
+	defaultResultWaitInterval = 10 * time.Second
+	// the task fails if the result metadata of its upstream is not published in time after the upstream succeed
+	defaultResultWaitTimeout = 30 * time.Minute
+)
+
+var (
+	ErrWorkflowNotFound   = errors.New("the workflow is not found")
+	ErrWorkflowTerminated = errors.New("the workflow has been terminated")
+)
+
+// TaskFinishedSubscriber is implemented by the taskManager.
+type TaskFinishedSubscriber interface {
+	SubscribeTaskFinishedEvent(ch chan<- *types.TaskFinishedEvent) event.Subscription
+}
+
+// Manager drives the workflows published by the local identity, it publishes a task of workflow
+// only when all its predecessors succeed, and propagates the failure or cancellation of a task to its downstream.
+type Manager struct {
+	dataCenter core.CarrierDB
+	// publish the taskMsg of the workflow task (same as `PublishTaskDeclare`)
+	publishTask func(msg types.Msg) error
+	// cancel the published task of workflow (same as `CancelTask`)
+	cancelTask func(taskId string) error
+
+	taskFinished    TaskFinishedSubscriber
+	taskFinishedCh  chan *types.TaskFinishedEvent
+	taskFinishedSub event.Subscription
+
+	lock sync.Mutex
+	// the workflows which are not terminated yet
+	workflows map[string]*types.Workflow
+	// taskId -> workflowId of the running tasks of workflows
+	taskIndex map[string]string
+	quit      chan struct{}
+}
+
+func NewWorkflowManager(
+	dataCenter core.CarrierDB,
+	taskFinished TaskFinishedSubscriber,
+	publishTask func(msg types.Msg) error,
+	cancelTask func(taskId string) error,
+) *Manager {
+	return &Manager{
+		dataCenter:     dataCenter,
+		publishTask:    publishTask,
+		cancelTask:     cancelTask,
+		taskFinished:   taskFinished,
+		taskFinishedCh: make(chan *types.TaskFinishedEvent, 64),
+		workflows:      make(map[string]*types.Workflow),
+		taskIndex:      make(map[string]string),
+		quit:           make(chan struct{}),
+	}
+}
+
+func (m *Manager) Start() error {
+	m.taskFinishedSub = m.taskFinished.SubscribeTaskFinishedEvent(m.taskFinishedCh)
+
+	workflows, err := m.dataCenter.GetWorkflowList()
+	if nil != err {
+		log.Warnf("Failed to load the stored workflows on workflowManager.Start(), err: {%s}", err)
+	}
+	m.lock.Lock()
+	for _, w := range workflows {
+		if w.IsTerminated() {
+			continue
+		}
+		m.workflows[w.WorkflowId()] = w
+		for _, task := range w.WorkflowData().GetTaskList() {
+			if types.TaskState(task.GetState()) == types.TaskStateRunning {
+				m.taskIndex[task.GetTaskId()] = w.WorkflowId()
+			}
+		}
+	}
+	m.lock.Unlock()
+
+	go m.loop()
+
+	// the running tasks which finished while the node was down will never emit TaskFinishedEvent
+	m.reconcileRunningTasks()
+
+	m.lock.Lock()
+	for _, w := range m.workflows {
+		m.advance(w)
+		m.tryFinish(w)
+		m.storeWorkflow(w)
+	}
+	count := len(m.workflows)
+	m.lock.Unlock()
+
+	log.Infof("Started workflowManager, unterminated workflow count: {%d}", count)
+	return nil
+}
+
+// reconcileRunningTasks finishes the running tasks of workflows which are no longer handled by the local carrier,
+// with their final state on the dataCenter, or failed if they are unknown (e.g. lost before they were published).
+func (m *Manager) reconcileRunningTasks() {
+	m.lock.Lock()
+	// taskId -> the identity of workflow
+	running := make(map[string]string, len(m.taskIndex))
+	for taskId, workflowId := range m.taskIndex {
+		running[taskId] = m.workflows[workflowId].WorkflowData().GetIdentity()
+	}
+	m.lock.Unlock()
+	if len(running) == 0 {
+		return
+	}
+
+	localTasks, err := m.dataCenter.GetLocalTaskList()
+	if rawdb.IsNoDBNotFoundErr(err) {
+		log.Warnf("Failed to query the local tasks on workflowManager.reconcileRunningTasks(), err: {%s}", err)
+		return
+	}
+	for _, task := range localTasks {
+		// still scheduling, executing or waiting for retry
+		delete(running, task.TaskId())
+	}
+
+	// identity -> taskId -> the final state of task on dataCenter
+	finishedTasks := make(map[string]map[string]types.TaskState)
+	for taskId, identity := range running {
+		if _, ok := finishedTasks[identity]; !ok {
+			tasks, err := m.dataCenter.GetTaskListByIdentityId(identity)
+			if nil != err {
+				log.Warnf("Failed to query the tasks of identity on workflowManager.reconcileRunningTasks(), identityId: {%s}, err: {%s}", identity, err)
+				continue
+			}
+			states := make(map[string]types.TaskState, len(tasks))
+			for _, task := range tasks {
+				states[task.TaskId()] = types.TaskState(task.TaskData().GetState())
+			}
+			finishedTasks[identity] = states
+		}
+
+		state, ok := finishedTasks[identity][taskId]
+		switch {
+		case !ok:
+			log.Warnf("The running task of workflow is unknown, fail it, taskId: {%s}", taskId)
+			m.onTaskFinished(&types.TaskFinishedEvent{TaskId: taskId, State: types.TaskStateFailed},
+				"the task is lost, it is neither handled by the carrier nor finished")
+		case types.IsTaskStateTerminated(state):
+			log.Infof("The running task of workflow finished while the workflowManager stopped, taskId: {%s}, state: {%s}", taskId, state.String())
+			m.onTaskFinished(&types.TaskFinishedEvent{TaskId: taskId, State: state}, "")
+		}
+	}
+}
+
+func (m *Manager) Stop() error {
+	close(m.quit)
+	log.Info("Stopped workflowManager ...")
+	return nil
+}
+
+func (m *Manager) loop() {
+	resultWaitTicker := time.NewTicker(defaultResultWaitInterval)
+	defer resultWaitTicker.Stop()
+
+	for {
+		select {
+		case finished := <-m.taskFinishedCh:
+			m.onTaskFinished(finished, "")
+		case <-resultWaitTicker.C:
+			m.retryAdvance()
+		case err := <-m.taskFinishedSub.Err():
+			if nil != err {
+				log.WithError(err).Error("The subscription of task finished event was terminated")
+			}
+			return
+		case <-m.quit:
+			m.taskFinishedSub.Unsubscribe()
+			return
+		}
+	}
+}
+
+// PublishWorkflow validates the workflow and publishes the tasks without predecessors immediately.
+func (m *Manager) PublishWorkflow(w *types.Workflow) (string, error) {
+	if err := w.Validate(); nil != err {
+		return "", err
+	}
+
+	data := w.WorkflowData()
+	data.State = types.TaskStateRunning.String()
+	data.Reason = ""
+	data.CreateAt = uint64(timeutils.UnixMsec())
+	data.EndAt = 0
+	data.EventList = nil
+	for _, task := range data.GetTaskList() {
+		task.TaskId = ""
+		task.State = types.TaskStatePending.String()
+		task.Reason = ""
+	}
+	workflowId := w.SetWorkflowId()
+	w.AddEvent(ev.WorkflowCreated.Type, "", "", fmt.Sprintf("task count: %d", len(data.GetTaskList())))
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	m.workflows[workflowId] = w
+	m.advance(w)
+	m.storeWorkflow(w)
+
+	log.Infof("Published workflow, workflowId: {%s}, workflowName: {%s}, taskCount: {%d}",
+		workflowId, data.GetWorkflowName(), len(data.GetTaskList()))
+	return workflowId, nil
+}
+
+// CancelWorkflow cancels the pending tasks of workflow, and the running ones (their downstream
+// will be cancelled with them).
+func (m *Manager) CancelWorkflow(workflowId string) error {
+	m.lock.Lock()
+	w, ok := m.workflows[workflowId]
+	if !ok {
+		m.lock.Unlock()
+		if _, err := m.dataCenter.GetWorkflow(workflowId); nil != err {
+			return fmt.Errorf("%s, workflowId: {%s}", ErrWorkflowNotFound, workflowId)
+		}
+		return fmt.Errorf("%s, workflowId: {%s}", ErrWorkflowTerminated, workflowId)
+	}
+
+	runningTaskIds := make([]string, 0)
+	for _, task := range w.WorkflowData().GetTaskList() {
+		switch types.TaskState(task.GetState()) {
+		case types.TaskStatePending:
+			m.terminateTask(w, task, types.TaskStateCancelled, "the workflow was cancelled")
+		case types.TaskStateRunning:
+			runningTaskIds = append(runningTaskIds, task.GetTaskId())
+		}
+	}
+	m.tryFinish(w)
+	m.storeWorkflow(w)
+	m.lock.Unlock()
+
+	// the running tasks will be finished with the `cancelled` state by taskManager
+	for _, taskId := range runningTaskIds {
+		if err := m.cancelTask(taskId); nil != err {
+			log.Warnf("Failed to cancel the running task of workflow, workflowId: {%s}, taskId: {%s}, err: {%s}",
+				workflowId, taskId, err)
+		}
+	}
+	log.Infof("Cancelled workflow, workflowId: {%s}, running task count: {%d}", workflowId, len(runningTaskIds))
+	return nil
+}
+
+func (m *Manager) onTaskFinished(finished *types.TaskFinishedEvent, reason string) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	workflowId, ok := m.taskIndex[finished.TaskId]
+	if !ok {
+		return
+	}
+	delete(m.taskIndex, finished.TaskId)
+
+	w, ok := m.workflows[workflowId]
+	if !ok {
+		return
+	}
+	task := w.TaskByTaskId(finished.TaskId)
+	if nil == task || types.TaskState(task.GetState()) != types.TaskStateRunning {
+		return
+	}
+
+	log.Debugf("The task of workflow finished, workflowId: {%s}, taskName: {%s}, taskId: {%s}, state: {%s}, retryTaskId: {%s}",
+		workflowId, task.GetName(), finished.TaskId, finished.State.String(), finished.RetryTaskId)
+
+	switch {
+	case "" != finished.RetryTaskId:
+		// follow the next attempt of the failed task
+		task.TaskId = finished.RetryTaskId
+		m.taskIndex[finished.RetryTaskId] = workflowId
+		w.AddEvent(ev.WorkflowTaskRetried.Type, task.GetName(), finished.RetryTaskId,
+			fmt.Sprintf("the failed attempt: %s", finished.TaskId))
+
+	case finished.State == types.TaskStateSuccess:
+		task.State = types.TaskStateSuccess.String()
+		task.EndAt = uint64(timeutils.UnixMsec())
+		w.AddEvent(ev.WorkflowTaskSucceed.Type, task.GetName(), task.GetTaskId(), "")
+		m.advance(w)
+
+	case finished.State == types.TaskStateCancelled:
+		m.terminateTask(w, task, types.TaskStateCancelled, reason)
+
+	default:
+		m.terminateTask(w, task, types.TaskStateFailed, reason)
+	}
+
+	m.tryFinish(w)
+	m.storeWorkflow(w)
+}
+
+// retryAdvance publishes the ready tasks which were waiting for the result metadata of their upstream.
+func (m *Manager) retryAdvance() {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	for _, w := range m.workflows {
+		if len(w.ReadyTasks()) == 0 {
+			continue
+		}
+		eventCount := len(w.WorkflowData().GetEventList())
+		m.advance(w)
+		m.tryFinish(w)
+		// nothing changed while the tasks are still waiting
+		if len(w.WorkflowData().GetEventList()) != eventCount {
+			m.storeWorkflow(w)
+		}
+	}
+}
+
+// advance publishes the pending tasks whose predecessors all succeed,
+// the task waits until the result metadata of its upstream bound are published.
+func (m *Manager) advance(w *types.Workflow) {
+	if w.IsTerminated() {
+		return
+	}
+	for _, task := range w.ReadyTasks() {
+		msg, err := w.NewWorkflowTaskMsg(task, m.resolveResultMetadata)
+		if err == types.ErrWorkflowResultNotReady &&
+			uint64(timeutils.UnixMsec()) < upstreamEndAt(w, task)+uint64(defaultResultWaitTimeout.Milliseconds()) {
+			log.Debugf("The task of workflow is waiting for the result metadata of upstream, workflowId: {%s}, taskName: {%s}",
+				w.WorkflowId(), task.GetName())
+			continue
+		}
+		if nil != err {
+			log.Errorf("Failed to make the taskMsg of workflow task, workflowId: {%s}, taskName: {%s}, err: {%s}",
+				w.WorkflowId(), task.GetName(), err)
+			m.terminateTask(w, task, types.TaskStateFailed, err.Error())
+			continue
+		}
+
+		task.TaskId = msg.TaskId
+		task.State = types.TaskStateRunning.String()
+		task.PublishAt = uint64(timeutils.UnixMsec())
+		m.taskIndex[msg.TaskId] = w.WorkflowId()
+		w.AddEvent(ev.WorkflowTaskPublished.Type, task.GetName(), msg.TaskId, "")
+
+		// the task is published asynchronously, since the taskManager may be sending TaskFinishedEvent to us
+		go func(workflowId, taskName string, msg *types.TaskMsg) {
+			if err := m.publishTask(msg); nil != err {
+				log.Errorf("Failed to publish the task of workflow, workflowId: {%s}, taskName: {%s}, taskId: {%s}, err: {%s}",
+					workflowId, taskName, msg.TaskId, err)
+				m.onTaskFinished(&types.TaskFinishedEvent{TaskId: msg.TaskId, State: types.TaskStateFailed},
+					fmt.Sprintf("failed to publish task, %s", err))
+			}
+		}(w.WorkflowId(), task.GetName(), msg)
+	}
+}
+
+// resolveResultMetadata finds the result metadata of the task which is held by the identity, the result file of
+// task is uploaded with the taskId as its originId, and published as the metadata by the result receiver.
+func (m *Manager) resolveResultMetadata(identity, taskId string) (*types.Metadata, error) {
+	metadataList, err := m.dataCenter.GetMetadataList()
+	if nil != err {
+		log.Warnf("Failed to query the metadata list on workflowManager.resolveResultMetadata(), taskId: {%s}, err: {%s}", taskId, err)
+		return nil, types.ErrWorkflowResultNotReady
+	}
+	for _, metadata := range metadataList {
+		data := metadata.MetadataData()
+		if data.GetIdentity() == identity && data.GetOriginId() == taskId && data.GetDataStatus() == types.DataStatusNormal.String() {
+			return metadata, nil
+		}
+	}
+	return nil, types.ErrWorkflowResultNotReady
+}
+
+// upstreamEndAt returns the time when the last upstream bound by the task succeed.
+func upstreamEndAt(w *types.Workflow, task *libTypes.WorkflowTaskData) uint64 {
+	var endAt uint64
+	for _, binding := range task.GetBindings() {
+		if from := w.Task(binding.GetFromTask()); nil != from && from.GetEndAt() > endAt {
+			endAt = from.GetEndAt()
+		}
+	}
+	return endAt
+}
+
+// terminateTask finishes the task of workflow with the failed or cancelled state,
+// and its pending downstream tasks will never be published.
+func (m *Manager) terminateTask(w *types.Workflow, task *libTypes.WorkflowTaskData, state types.TaskState, reason string) {
+	evTyp := ev.WorkflowTaskFailed.Type
+	if state == types.TaskStateCancelled {
+		evTyp = ev.WorkflowTaskCancelled.Type
+	}
+
+	now := uint64(timeutils.UnixMsec())
+	task.State = state.String()
+	task.Reason = reason
+	task.EndAt = now
+	w.AddEvent(evTyp, task.GetName(), task.GetTaskId(), reason)
+
+	for _, downstream := range w.PendingDownstream(task.GetName()) {
+		downstream.State = state.String()
+		downstream.Reason = fmt.Sprintf("the upstream task %s was %s", task.GetName(), state.String())
+		downstream.EndAt = now
+		w.AddEvent(evTyp, downstream.GetName(), "", downstream.Reason)
+	}
+}
+
+func (m *Manager) tryFinish(w *types.Workflow) {
+	finished, state := w.Finished()
+	if !finished || w.IsTerminated() {
+		return
+	}
+
+	var evTyp string
+	switch state {
+	case types.TaskStateSuccess:
+		evTyp = ev.WorkflowSucceed.Type
+	case types.TaskStateCancelled:
+		evTyp = ev.WorkflowCancelled.Type
+	default:
+		evTyp = ev.WorkflowFailed.Type
+	}
+	w.WorkflowData().State = state.String()
+	w.WorkflowData().EndAt = uint64(timeutils.UnixMsec())
+	w.AddEvent(evTyp, "", "", "")
+	delete(m.workflows, w.WorkflowId())
+
+	log.Infof("Finished workflow, workflowId: {%s}, state: {%s}", w.WorkflowId(), state.String())
+}
+
+func (m *Manager) storeWorkflow(w *types.Workflow) {
+	if err := m.dataCenter.StoreWorkflow(w); nil != err {
+		log.Errorf("Failed to store workflow, workflowId: {%s}, err: {%s}", w.WorkflowId(), err)
+	}
+}
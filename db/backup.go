@@ -0,0 +1,246 @@
+package db
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// The backup file is a gzip stream of:
+//
+//	backupMagic | backupFormatVersion | record... | endMark | uvarint(count)
+//
+// where each record is `recordMark | uvarint(len(key)) | key | uvarint(len(value)) | value`.
+// The trailing count lets the restorer detect a truncated backup file.
+const (
+	backupFormatVersion = byte(1)
+
+	backupRecordMark = byte(0x01)
+	backupEndMark    = byte(0x00)
+
+	// the max length of key or value which can be read from the backup file.
+	backupMaxItemSize = 1 << 30
+
+	// restoreBatchSize is the data size of batch to flush into the target database while restoring.
+	restoreBatchSize = 4 * 1024 * 1024
+)
+
+var (
+	backupMagic = []byte("CARRIER-DB-BACKUP")
+
+	ErrBackupMagicMismatch   = errors.New("the file is not a carrier database backup")
+	ErrBackupVersionMismatch = errors.New("the format version of backup file is not supported")
+	ErrBackupCorrupted       = errors.New("the backup file is corrupted")
+	ErrBackupFileExists      = errors.New("the backup file already exists")
+)
+
+// Backuper wraps the Backup method of the database which can
+// write a consistent snapshot of itself into the writer.
+type Backuper interface {
+	Backup(w io.Writer) (int, error)
+}
+
+// Backup writes a consistent snapshot of the whole database into w,
+// and returns the count of key/value pairs written.
+func (db *LDBDatabase) Backup(w io.Writer) (int, error) {
+	snap, err := db.db.GetSnapshot()
+	if err != nil {
+		return 0, err
+	}
+	defer snap.Release()
+
+	it := snap.NewIterator(nil, nil)
+	defer it.Release()
+	return writeBackup(w, it)
+}
+
+// Backup writes a copy of the whole memory database into w,
+// and returns the count of key/value pairs written.
+func (db *MemoryDatabase) Backup(w io.Writer) (int, error) {
+	// the memory iterator is a deep copy of the database content.
+	it := db.NewIteratorWithPrefixAndStart(nil, nil)
+	defer it.Release()
+	return writeBackup(w, it)
+}
+
+// BackupFile writes a backup of the database into the file, which must not exist before,
+// and returns the count of key/value pairs written.
+// The backup is written into a temporary file first and then renamed to the target file,
+// so that a half-written backup file will never be left.
+func BackupFile(db Backuper, file string) (int, error) {
+	if _, err := os.Stat(file); err == nil {
+		return 0, fmt.Errorf("%s, file: {%s}", ErrBackupFileExists, file)
+	}
+	if err := os.MkdirAll(filepath.Dir(file), 0700); err != nil {
+		return 0, err
+	}
+	tmp := file + ".tmp"
+	f, err := os.OpenFile(tmp, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return 0, err
+	}
+	count, err := db.Backup(f)
+	if err == nil {
+		err = f.Sync()
+	}
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(tmp)
+		return count, err
+	}
+	return count, os.Rename(tmp, file)
+}
+
+func writeBackup(w io.Writer, it Iterator) (int, error) {
+	zw := gzip.NewWriter(w)
+	bw := bufio.NewWriter(zw)
+
+	if _, err := bw.Write(backupMagic); err != nil {
+		return 0, err
+	}
+	if err := bw.WriteByte(backupFormatVersion); err != nil {
+		return 0, err
+	}
+
+	var (
+		count int
+		buf   = make([]byte, binary.MaxVarintLen64)
+	)
+	writeItem := func(item []byte) error {
+		n := binary.PutUvarint(buf, uint64(len(item)))
+		if _, err := bw.Write(buf[:n]); err != nil {
+			return err
+		}
+		_, err := bw.Write(item)
+		return err
+	}
+	for it.Next() {
+		if err := bw.WriteByte(backupRecordMark); err != nil {
+			return count, err
+		}
+		if err := writeItem(it.Key()); err != nil {
+			return count, err
+		}
+		if err := writeItem(it.Value()); err != nil {
+			return count, err
+		}
+		count++
+	}
+	if err := it.Error(); err != nil {
+		return count, err
+	}
+
+	if err := bw.WriteByte(backupEndMark); err != nil {
+		return count, err
+	}
+	n := binary.PutUvarint(buf, uint64(count))
+	if _, err := bw.Write(buf[:n]); err != nil {
+		return count, err
+	}
+	if err := bw.Flush(); err != nil {
+		return count, err
+	}
+	if err := zw.Close(); err != nil {
+		return count, err
+	}
+	return count, nil
+}
+
+// Restore reads a backup file written by Backup from r and puts all the key/value pairs of it
+// into the target database, and returns the count of key/value pairs restored.
+// The whole backup file is validated while reading, an error is returned if it is corrupted,
+// and the content that has been put into the target database is undefined in that case.
+func Restore(r io.Reader, target Database) (int, error) {
+	zr, err := gzip.NewReader(r)
+	if err != nil {
+		return 0, fmt.Errorf("%s, %s", ErrBackupMagicMismatch, err)
+	}
+	defer zr.Close()
+	br := bufio.NewReader(zr)
+
+	magic := make([]byte, len(backupMagic))
+	if _, err := io.ReadFull(br, magic); err != nil || !bytes.Equal(magic, backupMagic) {
+		return 0, ErrBackupMagicMismatch
+	}
+	version, err := br.ReadByte()
+	if err != nil {
+		return 0, fmt.Errorf("%s, %s", ErrBackupCorrupted, err)
+	}
+	if version != backupFormatVersion {
+		return 0, fmt.Errorf("%s, version: {%d}", ErrBackupVersionMismatch, version)
+	}
+
+	readItem := func() ([]byte, error) {
+		size, err := binary.ReadUvarint(br)
+		if err != nil {
+			return nil, err
+		}
+		if size > backupMaxItemSize {
+			return nil, fmt.Errorf("item size too large: {%d}", size)
+		}
+		item := make([]byte, size)
+		if _, err := io.ReadFull(br, item); err != nil {
+			return nil, err
+		}
+		return item, nil
+	}
+
+	var (
+		count int
+		batch = target.NewBatch()
+	)
+	for {
+		mark, err := br.ReadByte()
+		if err != nil {
+			return count, fmt.Errorf("%s, %s", ErrBackupCorrupted, err)
+		}
+		if mark == backupEndMark {
+			break
+		}
+		if mark != backupRecordMark {
+			return count, fmt.Errorf("%s, unknown record mark: {%d}", ErrBackupCorrupted, mark)
+		}
+		key, err := readItem()
+		if err != nil {
+			return count, fmt.Errorf("%s, read key failed, %s", ErrBackupCorrupted, err)
+		}
+		value, err := readItem()
+		if err != nil {
+			return count, fmt.Errorf("%s, read value failed, %s", ErrBackupCorrupted, err)
+		}
+		if err := batch.Put(key, value); err != nil {
+			return count, err
+		}
+		count++
+		if batch.ValueSize() >= restoreBatchSize {
+			if err := batch.Write(); err != nil {
+				return count, err
+			}
+			batch.Reset()
+		}
+	}
+
+	total, err := binary.ReadUvarint(br)
+	if err != nil {
+		return count, fmt.Errorf("%s, read record count failed, %s", ErrBackupCorrupted, err)
+	}
+	if total != uint64(count) {
+		return count, fmt.Errorf("%s, record count mismatch, expect: {%d}, actual: {%d}", ErrBackupCorrupted, total, count)
+	}
+	// drain the gzip stream so that its checksum is verified.
+	if _, err := io.Copy(io.Discard, br); err != nil {
+		return count, fmt.Errorf("%s, %s", ErrBackupCorrupted, err)
+	}
+	if err := batch.Write(); err != nil {
+		return count, err
+	}
+	return count, nil
+}
@@ -0,0 +1,69 @@
+package db_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/RosettaFlow/Carrier-Go/db"
+)
+
+func TestLDB_BackupRestore(t *testing.T) {
+	source, remove := newTestLDB()
+	defer remove()
+
+	for _, v := range test_values {
+		if err := source.Put([]byte("key"+v), []byte(v)); err != nil {
+			t.Fatalf("put failed: %v", err)
+		}
+	}
+
+	var buf bytes.Buffer
+	count, err := source.Backup(&buf)
+	if err != nil {
+		t.Fatalf("backup failed: %v", err)
+	}
+	if count != len(test_values) {
+		t.Fatalf("wrong backup count, got %d, want %d", count, len(test_values))
+	}
+
+	target, remove := newTestLDB()
+	defer remove()
+
+	count, err = db.Restore(bytes.NewReader(buf.Bytes()), target)
+	if err != nil {
+		t.Fatalf("restore failed: %v", err)
+	}
+	if count != len(test_values) {
+		t.Fatalf("wrong restore count, got %d, want %d", count, len(test_values))
+	}
+	for _, v := range test_values {
+		data, err := target.Get([]byte("key" + v))
+		if err != nil {
+			t.Fatalf("get failed: %v", err)
+		}
+		if !bytes.Equal(data, []byte(v)) {
+			t.Fatalf("get returned wrong result, got %q expected %q", string(data), v)
+		}
+	}
+}
+
+func TestRestore_Corrupted(t *testing.T) {
+	source := db.NewMemoryDatabase()
+	for _, v := range test_values {
+		source.Put([]byte("key"+v), []byte(v))
+	}
+	var buf bytes.Buffer
+	if _, err := source.Backup(&buf); err != nil {
+		t.Fatalf("backup failed: %v", err)
+	}
+	backup := buf.Bytes()
+
+	// truncated backup file
+	if _, err := db.Restore(bytes.NewReader(backup[:len(backup)-10]), db.NewMemoryDatabase()); err == nil {
+		t.Fatalf("restore truncated backup should fail")
+	}
+	// not a backup file
+	if _, err := db.Restore(bytes.NewReader([]byte("not a backup file")), db.NewMemoryDatabase()); err == nil {
+		t.Fatalf("restore invalid backup should fail")
+	}
+}
@@ -79,6 +79,9 @@ func (g *Gateway) Start() error {
 
 	g.conn = conn
 
+	// The `Authorization` header of http request is forwarded as the `authorization` metadata
+	// of gRPC call, so the api token is authenticated by the carrier gRPC server the same as
+	// the direct gRPC callers.
 	gwmux := gwruntime.NewServeMux(
 		gwruntime.WithMarshalerOption(
 			gwruntime.MIMEWildcard,
@@ -103,6 +106,7 @@ func (g *Gateway) Start() error {
 		}
 	}
 
+	g.mux.HandleFunc(taskEventsSSEPath, g.serveTaskEventsSSE)
 	g.mux.Handle("/", gwmux)
 
 	g.server = &http.Server{
@@ -13,6 +13,7 @@ import (
 
 var (
 	carrierRPC              = flag.String("carrier-rpc", "localhost:4000", "Beacon chain gRPC endpoint")
+	carrierRPCCert          = flag.String("carrier-rpc-cert", "", "Certificate to verify the secure carrier gRPC endpoint")
 	port                    = flag.Int("port", 8000, "Port to serve on")
 	host                    = flag.String("host", "127.0.0.1", "Host to serve on")
 	debug                   = flag.Bool("debug", false, "Enable debug logging")
@@ -35,7 +36,7 @@ func main() {
 	gw := gateway.New(
 		context.Background(),
 		*carrierRPC,
-		"", // remoteCert
+		*carrierRPCCert,
 		fmt.Sprintf("%s:%d", *host, *port),
 		mux,
 		strings.Split(*allowedOrigins, ","),
@@ -0,0 +1,116 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+
+	rpcapipb "github.com/RosettaFlow/Carrier-Go/lib/api"
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// taskEventsSSEPath is the path of Server-Sent Events stream of `TaskService.SubscribeTaskEvents`.
+const taskEventsSSEPath = "/carrier/v1/task/subscribeTaskEvents"
+
+// queryValues returns the values of the query parameter, which may be repeated or comma separated.
+func queryValues(r *http.Request, key string) []string {
+	values := make([]string, 0)
+	for _, v := range r.URL.Query()[key] {
+		for _, s := range strings.Split(v, ",") {
+			if s = strings.TrimSpace(s); "" != s {
+				values = append(values, s)
+			}
+		}
+	}
+	return values
+}
+
+// serveTaskEventsSSE serves `TaskService.SubscribeTaskEvents` as Server-Sent Events.
+//
+//	GET /carrier/v1/task/subscribeTaskEvents?task_ids=...&type_prefixes=01&identity_ids=...&since=<ms>&cursor=<cursor>
+//
+// Every event is sent with its cursor as the event id, so a reconnected EventSource
+// (with the `Last-Event-ID` header) resumes from the last event it received.
+func (g *Gateway) serveTaskEventsSSE(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+		return
+	}
+
+	req := &rpcapipb.SubscribeTaskEventsRequest{
+		TaskIds:      queryValues(r, "task_ids"),
+		TypePrefixes: queryValues(r, "type_prefixes"),
+		IdentityIds:  queryValues(r, "identity_ids"),
+	}
+	req.Cursor = r.URL.Query().Get("cursor")
+	if lastEventId := r.Header.Get("Last-Event-ID"); "" != lastEventId {
+		req.Cursor = lastEventId
+	}
+	if since := r.URL.Query().Get("since"); "" != since {
+		v, err := strconv.ParseUint(since, 10, 64)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid since: %s", since), http.StatusBadRequest)
+			return
+		}
+		req.Since = v
+	}
+
+	// carry the credentials of caller the same as the other gateway requests.
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+	md := metadata.Pairs("x-forwarded-host", r.Host)
+	if auth := r.Header.Get("Authorization"); "" != auth {
+		md.Set("authorization", auth)
+	}
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	stream, err := rpcapipb.NewTaskServiceClient(g.conn).SubscribeTaskEvents(ctx, req)
+	if err == nil {
+		// the carrier sends the header once the subscription is accepted.
+		_, err = stream.Header()
+	}
+	if err != nil {
+		st, _ := status.FromError(err)
+		http.Error(w, st.Message(), gwruntime.HTTPStatusFromCode(st.Code()))
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	marshaler := &gwruntime.JSONPb{OrigName: false, EmitDefaults: true}
+	for {
+		event, err := stream.Recv()
+		if err != nil {
+			if err == io.EOF || ctx.Err() != nil {
+				return
+			}
+			st, _ := status.FromError(err)
+			fmt.Fprintf(w, "event: error\ndata: %s\n\n", st.Message())
+			flusher.Flush()
+			return
+		}
+		data, err := marshaler.Marshal(event)
+		if err != nil {
+			log.WithError(err).Error("Failed to marshal task event")
+			continue
+		}
+		if _, err := fmt.Fprintf(w, "id: %s\nevent: taskEvent\ndata: %s\n\n", event.Cursor, data); err != nil {
+			return
+		}
+		flusher.Flush()
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/RosettaFlow/Carrier-Go/common/timeutils"
 	"github.com/RosettaFlow/Carrier-Go/lib/fighter/common"
 	"github.com/RosettaFlow/Carrier-Go/lib/fighter/datasvc"
+	"github.com/golang/protobuf/ptypes/empty"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/connectivity"
 	"sync"
@@ -47,6 +48,7 @@ func NewDataNodeClientWithConn(ctx context.Context, addr string, nodeId string)
 	ctx, cancel := context.WithCancel(ctx)
 	conn, err := dialContext(ctx, addr)
 	if err != nil {
+		cancel()
 		return nil, err
 	}
 	return &DataNodeClient{
@@ -125,8 +127,11 @@ func (c *DataNodeClient) RunningDuration() int64 {
 }
 
 
-func (c *DataNodeClient) GetStatus() (*datasvc.GetStatusReply, error) {
-	return nil, errors.New("method GetStatus not implemented")
+func (c *DataNodeClient) GetStatus(ctx context.Context) (*datasvc.GetStatusReply, error) {
+	if nil == c.dataProviderClient {
+		return nil, errors.New("the dataNode is not connected")
+	}
+	return c.dataProviderClient.GetStatus(ctx, new(empty.Empty))
 }
 
 func (c *DataNodeClient) ListData() (*datasvc.ListDataReply, error) {
@@ -157,4 +162,10 @@ func (c *DataNodeClient) HandleTaskReadyGo(req *common.TaskReadyGoReq) (*common.
 	ctx, cancel := context.WithTimeout(c.ctx, defaultRequestTime)
 	defer cancel()
 	return c.dataProviderClient.HandleTaskReadyGo(ctx, req)
+}
+
+func (c *DataNodeClient) HandleCancelTask(req *common.TaskCancelReq) (*common.TaskCancelReply, error) {
+	ctx, cancel := context.WithTimeout(c.ctx, defaultRequestTime)
+	defer cancel()
+	return c.dataProviderClient.HandleCancelTask(ctx, req)
 }
\ No newline at end of file
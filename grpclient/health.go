@@ -0,0 +1,262 @@
+package grpclient
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/RosettaFlow/Carrier-Go/common/timeutils"
+	"github.com/RosettaFlow/Carrier-Go/event"
+	"github.com/RosettaFlow/Carrier-Go/types"
+)
+
+const (
+	DefaultHealthCheckInterval         = 10 * time.Second
+	DefaultHealthCheckTimeout          = 2 * time.Second
+	DefaultHealthCheckFailureThreshold = 3
+)
+
+// NodeHealthState is the health state of a registered jobNode or dataNode.
+type NodeHealthState int
+
+const (
+	NodeHealthUnknown NodeHealthState = iota
+	NodeHealthHealthy
+	NodeHealthUnhealthy
+	NodeHealthDrained
+)
+
+func (s NodeHealthState) String() string {
+	switch s {
+	case NodeHealthHealthy:
+		return "healthy"
+	case NodeHealthUnhealthy:
+		return "unhealthy"
+	case NodeHealthDrained:
+		return "drained"
+	default:
+		return "unknown"
+	}
+}
+
+// NodeHealth is the result of the health checking on a registered node.
+type NodeHealth struct {
+	// the status probed by the health checker (unknown, healthy or unhealthy)
+	Status NodeHealthState
+	// the node is drained by operator, no new task will be scheduled on it
+	Drained bool
+	// the latency of the last succeed probing
+	Latency time.Duration
+	// the count of the consecutive failed probing
+	FailureCount uint32
+	// the timestamp (ms) of the last probing
+	LastCheckAt int64
+	LastError   string
+}
+
+// State returns the effective state of node, the drained flag overrides the probed status.
+func (h *NodeHealth) State() NodeHealthState {
+	if h.Drained {
+		return NodeHealthDrained
+	}
+	return h.Status
+}
+
+func (h *NodeHealth) String() string {
+	return fmt.Sprintf(`{"state": %s, "latency": %d, "failureCount": %d, "lastCheckAt": %d, "lastError": %s}`,
+		h.State().String(), h.Latency.Milliseconds(), h.FailureCount, h.LastCheckAt, h.LastError)
+}
+
+func copyNodeHealth(health *NodeHealth) *NodeHealth {
+	if nil == health {
+		return &NodeHealth{Status: NodeHealthUnknown}
+	}
+	cpy := *health
+	return &cpy
+}
+
+// NodeHealthEvent is published while the health state of a registered node changed.
+type NodeHealthEvent struct {
+	NodeType types.RegisteredNodeType
+	NodeId   string
+	OldState NodeHealthState
+	NewState NodeHealthState
+	Health   *NodeHealth
+}
+
+type HealthCheckConfig struct {
+	// the interval of probing all registered nodes
+	Interval time.Duration
+	// the timeout of each probing
+	Timeout time.Duration
+	// the node is marked as unhealthy after so many consecutive failed probing
+	FailureThreshold uint32
+}
+
+func DefaultHealthCheckConfig() *HealthCheckConfig {
+	return &HealthCheckConfig{
+		Interval:         DefaultHealthCheckInterval,
+		Timeout:          DefaultHealthCheckTimeout,
+		FailureThreshold: DefaultHealthCheckFailureThreshold,
+	}
+}
+
+// HealthChecker polls `GetStatus` of all registered Fighter nodes (jobNode and dataNode) periodically,
+// and maintains their health in the InternalResourceClientSet.
+type HealthChecker struct {
+	nodeSet *InternalResourceClientSet
+	config  *HealthCheckConfig
+	feed    event.Feed
+	quit    chan struct{}
+	wg      sync.WaitGroup
+}
+
+func NewHealthChecker(nodeSet *InternalResourceClientSet, config *HealthCheckConfig) *HealthChecker {
+	if nil == config {
+		config = DefaultHealthCheckConfig()
+	}
+	if config.Interval <= 0 {
+		config.Interval = DefaultHealthCheckInterval
+	}
+	if config.Timeout <= 0 {
+		config.Timeout = DefaultHealthCheckTimeout
+	}
+	if config.FailureThreshold == 0 {
+		config.FailureThreshold = DefaultHealthCheckFailureThreshold
+	}
+	return &HealthChecker{
+		nodeSet: nodeSet,
+		config:  config,
+		quit:    make(chan struct{}),
+	}
+}
+
+func (hc *HealthChecker) Start() error {
+	hc.wg.Add(1)
+	go hc.loop()
+	log.Infof("Started node health checker, interval: {%s}, timeout: {%s}, failureThreshold: {%d}",
+		hc.config.Interval, hc.config.Timeout, hc.config.FailureThreshold)
+	return nil
+}
+
+func (hc *HealthChecker) Stop() error {
+	close(hc.quit)
+	hc.wg.Wait()
+	log.Info("Stopped node health checker ...")
+	return nil
+}
+
+// SubscribeNodeHealthEvent registers a subscription of NodeHealthEvent.
+func (hc *HealthChecker) SubscribeNodeHealthEvent(ch chan<- *NodeHealthEvent) event.Subscription {
+	return hc.feed.Subscribe(ch)
+}
+
+// SetDrained marks the node as drained (or undrained), the drained node is skipped by the scheduler.
+func (hc *HealthChecker) SetDrained(nodeType types.RegisteredNodeType, nodeId string, drained bool) error {
+	old, cur, health, ok := hc.nodeSet.updateNodeHealth(nodeType, nodeId, func(health *NodeHealth) {
+		health.Drained = drained
+	})
+	if !ok {
+		return fmt.Errorf("not found %s client, nodeId: {%s}", nodeType.String(), nodeId)
+	}
+	hc.publish(nodeType, nodeId, old, cur, health)
+	return nil
+}
+
+func (hc *HealthChecker) loop() {
+	defer hc.wg.Done()
+
+	ticker := time.NewTicker(hc.config.Interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			hc.checkAll()
+		case <-hc.quit:
+			return
+		}
+	}
+}
+
+func (hc *HealthChecker) checkAll() {
+	var wg sync.WaitGroup
+	for _, client := range hc.nodeSet.QueryJobNodeClients() {
+		wg.Add(1)
+		go func(client *JobNodeClient) {
+			defer wg.Done()
+			hc.check(types.PREFIX_TYPE_JOBNODE, client.nodeId, func(ctx context.Context) error {
+				if client.IsNotConnected() {
+					if err := client.Reconnect(); nil != err {
+						return err
+					}
+				}
+				_, err := client.GetStatus(ctx)
+				return err
+			})
+		}(client)
+	}
+	for _, client := range hc.nodeSet.QueryDataNodeClients() {
+		wg.Add(1)
+		go func(client *DataNodeClient) {
+			defer wg.Done()
+			hc.check(types.PREFIX_TYPE_DATANODE, client.nodeId, func(ctx context.Context) error {
+				if client.IsNotConnected() {
+					if err := client.Reconnect(); nil != err {
+						return err
+					}
+				}
+				_, err := client.GetStatus(ctx)
+				return err
+			})
+		}(client)
+	}
+	wg.Wait()
+}
+
+func (hc *HealthChecker) check(nodeType types.RegisteredNodeType, nodeId string, probe func(ctx context.Context) error) {
+	ctx, cancel := context.WithTimeout(context.Background(), hc.config.Timeout)
+	start := time.Now()
+	err := probe(ctx)
+	latency := time.Since(start)
+	cancel()
+
+	old, cur, health, ok := hc.nodeSet.updateNodeHealth(nodeType, nodeId, func(health *NodeHealth) {
+		health.LastCheckAt = timeutils.UnixMsec()
+		if nil != err {
+			health.FailureCount++
+			health.LastError = err.Error()
+			if health.FailureCount >= hc.config.FailureThreshold {
+				health.Status = NodeHealthUnhealthy
+			}
+			return
+		}
+		health.Status = NodeHealthHealthy
+		health.FailureCount = 0
+		health.LastError = ""
+		health.Latency = latency
+	})
+	if !ok {
+		// the node was removed while probing
+		return
+	}
+	if nil != err {
+		log.WithError(err).Debugf("Failed to probe the health of %s, nodeId: {%s}, failureCount: {%d}",
+			nodeType.String(), nodeId, health.FailureCount)
+	}
+	hc.publish(nodeType, nodeId, old, cur, health)
+}
+
+func (hc *HealthChecker) publish(nodeType types.RegisteredNodeType, nodeId string, old, cur NodeHealthState, health *NodeHealth) {
+	if old == cur {
+		return
+	}
+	hc.feed.Send(&NodeHealthEvent{
+		NodeType: nodeType,
+		NodeId:   nodeId,
+		OldState: old,
+		NewState: cur,
+		Health:   health,
+	})
+}
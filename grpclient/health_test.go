@@ -0,0 +1,120 @@
+package grpclient
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/RosettaFlow/Carrier-Go/types"
+	"google.golang.org/grpc"
+)
+
+func newTestDataNodeSet(t *testing.T, nodeId string) *InternalResourceClientSet {
+	// the connection stays idle until the first rpc, which is treated as connected.
+	conn, err := grpc.Dial("127.0.0.1:1", grpc.WithInsecure())
+	if nil != err {
+		t.Fatalf("failed to dial, err: %v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	nodeSet := NewInternalResourceNodeSet()
+	nodeSet.StoreDataNodeClient(nodeId, &DataNodeClient{conn: conn, nodeId: nodeId})
+	return nodeSet
+}
+
+func receiveHealthEvent(t *testing.T, ch <-chan *NodeHealthEvent) *NodeHealthEvent {
+	select {
+	case ev := <-ch:
+		return ev
+	case <-time.After(time.Second):
+		t.Fatal("no node health event received")
+		return nil
+	}
+}
+
+func TestHealthCheckerSetDrained(t *testing.T) {
+	nodeSet := newTestDataNodeSet(t, "dataNode1")
+	hc := NewHealthChecker(nodeSet, nil)
+
+	ch := make(chan *NodeHealthEvent, 4)
+	sub := hc.SubscribeNodeHealthEvent(ch)
+	defer sub.Unsubscribe()
+
+	if !nodeSet.IsDataNodeAvailable("dataNode1") {
+		t.Fatal("the connected dataNode should be available before checking")
+	}
+	if nodeSet.IsDataNodeAvailable("dataNode2") {
+		t.Fatal("the unknown dataNode should not be available")
+	}
+
+	if err := hc.SetDrained(types.PREFIX_TYPE_DATANODE, "dataNode1", true); nil != err {
+		t.Fatalf("failed to drain dataNode, err: %v", err)
+	}
+	ev := receiveHealthEvent(t, ch)
+	if ev.NodeId != "dataNode1" || ev.OldState != NodeHealthUnknown || ev.NewState != NodeHealthDrained {
+		t.Fatalf("unexpected event, nodeId: %s, oldState: %s, newState: %s", ev.NodeId, ev.OldState, ev.NewState)
+	}
+	if nodeSet.IsDataNodeAvailable("dataNode1") {
+		t.Fatal("the drained dataNode should not be available")
+	}
+
+	// draining again does not change the state, so nothing is published
+	if err := hc.SetDrained(types.PREFIX_TYPE_DATANODE, "dataNode1", true); nil != err {
+		t.Fatalf("failed to drain dataNode, err: %v", err)
+	}
+	select {
+	case ev := <-ch:
+		t.Fatalf("unexpected event, oldState: %s, newState: %s", ev.OldState, ev.NewState)
+	default:
+	}
+
+	if err := hc.SetDrained(types.PREFIX_TYPE_DATANODE, "dataNode1", false); nil != err {
+		t.Fatalf("failed to undrain dataNode, err: %v", err)
+	}
+	ev = receiveHealthEvent(t, ch)
+	if ev.OldState != NodeHealthDrained || ev.NewState != NodeHealthUnknown {
+		t.Fatalf("unexpected event, oldState: %s, newState: %s", ev.OldState, ev.NewState)
+	}
+	if !nodeSet.IsDataNodeAvailable("dataNode1") {
+		t.Fatal("the undrained dataNode should be available")
+	}
+
+	if err := hc.SetDrained(types.PREFIX_TYPE_DATANODE, "dataNode2", true); nil == err {
+		t.Fatal("draining the unknown dataNode should be failed")
+	}
+}
+
+func TestHealthCheckerUnhealthy(t *testing.T) {
+	nodeSet := newTestDataNodeSet(t, "dataNode1")
+	hc := NewHealthChecker(nodeSet, &HealthCheckConfig{FailureThreshold: 2})
+
+	ch := make(chan *NodeHealthEvent, 4)
+	sub := hc.SubscribeNodeHealthEvent(ch)
+	defer sub.Unsubscribe()
+
+	failed := func(ctx context.Context) error { return errors.New("connection refused") }
+	succeed := func(ctx context.Context) error { return nil }
+
+	hc.check(types.PREFIX_TYPE_DATANODE, "dataNode1", failed)
+	if !nodeSet.IsDataNodeAvailable("dataNode1") {
+		t.Fatal("the dataNode should be available before reaching the failure threshold")
+	}
+	hc.check(types.PREFIX_TYPE_DATANODE, "dataNode1", failed)
+	ev := receiveHealthEvent(t, ch)
+	if ev.NewState != NodeHealthUnhealthy || ev.Health.FailureCount != 2 {
+		t.Fatalf("unexpected event, newState: %s, failureCount: %d", ev.NewState, ev.Health.FailureCount)
+	}
+	if nodeSet.IsDataNodeAvailable("dataNode1") {
+		t.Fatal("the unhealthy dataNode should not be available")
+	}
+
+	hc.check(types.PREFIX_TYPE_DATANODE, "dataNode1", succeed)
+	ev = receiveHealthEvent(t, ch)
+	if ev.OldState != NodeHealthUnhealthy || ev.NewState != NodeHealthHealthy {
+		t.Fatalf("unexpected event, oldState: %s, newState: %s", ev.OldState, ev.NewState)
+	}
+	if !nodeSet.IsDataNodeAvailable("dataNode1") {
+		t.Fatal("the recovered dataNode should be available")
+	}
+}
@@ -1,27 +1,44 @@
 package grpclient
 
+import (
+	"sync"
+
+	"github.com/RosettaFlow/Carrier-Go/types"
+)
 
 type InternalResourceClientSet struct {
 	// GRPC Client
 	jobNodes  map[string]*JobNodeClient
 	dataNodes map[string]*DataNodeClient
+	// the health of jobNodes and dataNodes, maintained by HealthChecker
+	jobNodeHealths  map[string]*NodeHealth
+	dataNodeHealths map[string]*NodeHealth
+	lock            sync.RWMutex
 }
 
 func NewInternalResourceNodeSet () *InternalResourceClientSet {
 	return &InternalResourceClientSet{
-		jobNodes:  make(map[string]*JobNodeClient),
-		dataNodes: make(map[string]*DataNodeClient),
+		jobNodes:        make(map[string]*JobNodeClient),
+		dataNodes:       make(map[string]*DataNodeClient),
+		jobNodeHealths:  make(map[string]*NodeHealth),
+		dataNodeHealths: make(map[string]*NodeHealth),
 	}
 }
 
 func (nodeSet *InternalResourceClientSet) StoreJobNodeClient(nodeId string, client *JobNodeClient) {
+	nodeSet.lock.Lock()
 	nodeSet.jobNodes[nodeId] = client
+	nodeSet.lock.Unlock()
 }
 func (nodeSet *InternalResourceClientSet) QueryJobNodeClient(nodeId string) (*JobNodeClient, bool) {
+	nodeSet.lock.RLock()
 	client, ok := nodeSet.jobNodes[nodeId]
+	nodeSet.lock.RUnlock()
 	return client, ok
 }
 func (nodeSet *InternalResourceClientSet) QueryJobNodeClients() []*JobNodeClient {
+	nodeSet.lock.RLock()
+	defer nodeSet.lock.RUnlock()
 	arr := make([]*JobNodeClient, 0)
 	for _, client := range nodeSet.jobNodes {
 		arr = append(arr, client)
@@ -29,21 +46,54 @@ func (nodeSet *InternalResourceClientSet) QueryJobNodeClients() []*JobNodeClient
 	return arr
 }
 func (nodeSet *InternalResourceClientSet) RemoveJobNodeClient(nodeId string)  {
+	nodeSet.lock.Lock()
 	delete(nodeSet.jobNodes, nodeId)
+	delete(nodeSet.jobNodeHealths, nodeId)
+	nodeSet.lock.Unlock()
 }
 func (nodeSet *InternalResourceClientSet) JobNodeClientSize() int {
+	nodeSet.lock.RLock()
+	defer nodeSet.lock.RUnlock()
 	return len(nodeSet.jobNodes)
 }
+// QueryJobNodeHealth returns a copy of the health of jobNode,
+// the health is `NodeHealthUnknown` before the first checking.
+func (nodeSet *InternalResourceClientSet) QueryJobNodeHealth(nodeId string) *NodeHealth {
+	nodeSet.lock.RLock()
+	defer nodeSet.lock.RUnlock()
+	return copyNodeHealth(nodeSet.jobNodeHealths[nodeId])
+}
+// IsJobNodeAvailable returns true while the jobNode is connected, not unhealthy and not drained,
+// only the available jobNode could be elected to run a new task.
+func (nodeSet *InternalResourceClientSet) IsJobNodeAvailable(nodeId string) bool {
+	nodeSet.lock.RLock()
+	client, ok := nodeSet.jobNodes[nodeId]
+	health := nodeSet.jobNodeHealths[nodeId]
+	nodeSet.lock.RUnlock()
+	if !ok || !client.IsConnected() {
+		return false
+	}
+	if nil == health {
+		return true
+	}
+	return !health.Drained && health.Status != NodeHealthUnhealthy
+}
 
 
 func (nodeSet *InternalResourceClientSet) StoreDataNodeClient(nodeId string, client *DataNodeClient) {
+	nodeSet.lock.Lock()
 	nodeSet.dataNodes[nodeId] = client
+	nodeSet.lock.Unlock()
 }
 func (nodeSet *InternalResourceClientSet) QueryDataNodeClient(nodeId string) (*DataNodeClient, bool) {
+	nodeSet.lock.RLock()
 	client, ok := nodeSet.dataNodes[nodeId]
+	nodeSet.lock.RUnlock()
 	return client, ok
 }
 func (nodeSet *InternalResourceClientSet) QueryDataNodeClients() []*DataNodeClient {
+	nodeSet.lock.RLock()
+	defer nodeSet.lock.RUnlock()
 	arr := make([]*DataNodeClient, 0)
 	for _, client := range nodeSet.dataNodes {
 		arr = append(arr, client)
@@ -51,8 +101,63 @@ func (nodeSet *InternalResourceClientSet) QueryDataNodeClients() []*DataNodeClie
 	return arr
 }
 func (nodeSet *InternalResourceClientSet) RemoveDataNodeClient(nodeId string)  {
+	nodeSet.lock.Lock()
 	delete(nodeSet.dataNodes, nodeId)
+	delete(nodeSet.dataNodeHealths, nodeId)
+	nodeSet.lock.Unlock()
 }
 func (nodeSet *InternalResourceClientSet) DataNodeClientSize() int {
+	nodeSet.lock.RLock()
+	defer nodeSet.lock.RUnlock()
 	return len(nodeSet.dataNodes)
-}
\ No newline at end of file
+}
+// QueryDataNodeHealth returns a copy of the health of dataNode,
+// the health is `NodeHealthUnknown` before the first checking.
+func (nodeSet *InternalResourceClientSet) QueryDataNodeHealth(nodeId string) *NodeHealth {
+	nodeSet.lock.RLock()
+	defer nodeSet.lock.RUnlock()
+	return copyNodeHealth(nodeSet.dataNodeHealths[nodeId])
+}
+// IsDataNodeAvailable returns true while the dataNode is connected, not unhealthy and not drained.
+func (nodeSet *InternalResourceClientSet) IsDataNodeAvailable(nodeId string) bool {
+	nodeSet.lock.RLock()
+	client, ok := nodeSet.dataNodes[nodeId]
+	health := nodeSet.dataNodeHealths[nodeId]
+	nodeSet.lock.RUnlock()
+	if !ok || !client.IsConnected() {
+		return false
+	}
+	if nil == health {
+		return true
+	}
+	return !health.Drained && health.Status != NodeHealthUnhealthy
+}
+
+// updateNodeHealth applies fn on the health of node with the lock held,
+// and returns the state before and after updating.
+func (nodeSet *InternalResourceClientSet) updateNodeHealth(nodeType types.RegisteredNodeType, nodeId string, fn func(health *NodeHealth)) (NodeHealthState, NodeHealthState, *NodeHealth, bool) {
+	nodeSet.lock.Lock()
+	defer nodeSet.lock.Unlock()
+
+	var healths map[string]*NodeHealth
+	var exist bool
+	switch nodeType {
+	case types.PREFIX_TYPE_JOBNODE:
+		healths = nodeSet.jobNodeHealths
+		_, exist = nodeSet.jobNodes[nodeId]
+	case types.PREFIX_TYPE_DATANODE:
+		healths = nodeSet.dataNodeHealths
+		_, exist = nodeSet.dataNodes[nodeId]
+	}
+	if !exist {
+		return NodeHealthUnknown, NodeHealthUnknown, nil, false
+	}
+	health, ok := healths[nodeId]
+	if !ok {
+		health = &NodeHealth{Status: NodeHealthUnknown}
+		healths[nodeId] = health
+	}
+	old := health.State()
+	fn(health)
+	return old, health.State(), copyNodeHealth(health), true
+}
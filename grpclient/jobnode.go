@@ -6,6 +6,7 @@ import (
 	"github.com/RosettaFlow/Carrier-Go/common/timeutils"
 	"github.com/RosettaFlow/Carrier-Go/lib/fighter/common"
 	"github.com/RosettaFlow/Carrier-Go/lib/fighter/computesvc"
+	"github.com/golang/protobuf/ptypes/empty"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/connectivity"
@@ -45,6 +46,7 @@ func NewJobNodeClientWithConn(ctx context.Context, addr string, nodeId string) (
 	ctx, cancel := context.WithCancel(ctx)
 	conn, err := dialContext(ctx, addr)
 	if err != nil {
+		cancel()
 		return nil, err
 	}
 	return &JobNodeClient{
@@ -123,12 +125,18 @@ func (c *JobNodeClient) RunningDuration() int64 {
 	return timeutils.UnixMsec() - c.connStartAt
 }
 
-func (c *JobNodeClient) GetStatus() (*computesvc.GetStatusReply, error) {
-	return nil, errors.New("method GetStatus not implemented")
+func (c *JobNodeClient) GetStatus(ctx context.Context) (*computesvc.GetStatusReply, error) {
+	if nil == c.computeProviderClient {
+		return nil, errors.New("the jobNode is not connected")
+	}
+	return c.computeProviderClient.GetStatus(ctx, new(empty.Empty))
 }
 
 func (c *JobNodeClient) GetTaskDetails(ctx context.Context, taskIds []string) (*computesvc.GetTaskDetailsReply, error) {
-	return nil, errors.New("method GetTaskDetails not implemented")
+	if nil == c.computeProviderClient {
+		return nil, errors.New("the jobNode is not connected")
+	}
+	return c.computeProviderClient.GetTaskDetails(ctx, &computesvc.GetTaskDetailsReq{TaskIds: taskIds})
 }
 
 func (c *JobNodeClient) UploadShard(ctx context.Context) (computesvc.ComputeProvider_UploadShardClient, error){
@@ -140,3 +148,9 @@ func (c *JobNodeClient) HandleTaskReadyGo(req *common.TaskReadyGoReq) (*common.T
 	defer cancel()
 	return c.computeProviderClient.HandleTaskReadyGo(ctx, req)
 }
+
+func (c *JobNodeClient) HandleCancelTask(req *common.TaskCancelReq) (*common.TaskCancelReply, error) {
+	ctx, cancel := context.WithTimeout(c.ctx, defaultRequestTime)
+	defer cancel()
+	return c.computeProviderClient.HandleCancelTask(ctx, req)
+}
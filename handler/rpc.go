@@ -80,6 +80,16 @@ func (s *Service) registerRPCHandlers() {
 		s.taskResultMsgRPCHandler,
 	)
 
+	s.registerRPC(
+		p2p.RPCTwoPcTaskCancelMsgTopic,
+		s.taskCancelMsgRPCHandler,
+	)
+
+	s.registerRPC(
+		p2p.RPCTwoPcTaskProgressMsgTopic,
+		s.taskProgressMsgRPCHandler,
+	)
+
 	// for test.
 	s.registerRPC(
 		p2p.RPCGossipTestDataByRangeTopic,
@@ -24,6 +24,7 @@ var backOffTime = map[types.SSZUint64]time.Duration{
 	// back up again.
 	p2ptypes.GoodbyeCodeBadScore:       2 * time.Hour,
 	p2ptypes.GoodbyeCodeBanned:         2 * time.Hour,
+	p2ptypes.GoodbyeCodeNotAdmitted:    2 * time.Hour,
 	p2ptypes.GoodbyeCodeClientShutdown: 1 * time.Hour,
 	// Wait 5 minutes before dialing a peer who is
 	// 'full'
@@ -37,6 +37,13 @@ func (s *Service) maintainPeerStatuses() {
 					s.cfg.P2P.Peers().SetConnectionState(id, peers.PeerDisconnected)
 					return
 				}
+				// Disconnect from peers whose identity is not registered (or has been revoked) on the data center.
+				if !s.cfg.P2P.IsPeerAdmitted(id) {
+					if err := s.sendGoodByeAndDisconnect(s.ctx, p2ptypes.GoodbyeCodeNotAdmitted, id); err != nil {
+						log.WithField("peer", id).WithError(err).Debug("Could not disconnect with peer not admitted")
+					}
+					return
+				}
 				// Disconnect from peers that are considered bad by any of the registered scorers.
 				if s.cfg.P2P.Peers().IsBad(id) {
 					s.disconnectBadPeer(s.ctx, id)
@@ -2,42 +2,33 @@ package handler
 
 import (
 	"context"
-	"errors"
 	pb "github.com/RosettaFlow/Carrier-Go/lib/consensus/twopc"
 	"github.com/RosettaFlow/Carrier-Go/p2p"
 	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/pkg/errors"
 )
 
-// SendTwoPcPrepareMsg sends 2pc prepareMsg to other peer.
-func SendTwoPcPrepareMsg(ctx context.Context, p2pProvider p2p.P2P, pid peer.ID, req *pb.PrepareMsg) error {
-	ctx, cancel := context.WithTimeout(ctx, respTimeout)
-	defer cancel()
+// TwoPcMsgRejectedError is returned by the 2pc send helpers when the remote peer
+// has received the msg but refused it, so sending the same msg again is useless.
+type TwoPcMsgRejectedError struct {
+	Code   uint8
+	Reason string
+}
 
-	// send request on the special topic.
-	stream, err := p2pProvider.Send(ctx, req, p2p.RPCTwoPcPrepareMsgTopic, pid)
-	if err != nil {
-		return err
-	}
-	defer closeStream(stream, log)
-	code, errMsg, err := ReadStatusCode(stream, p2pProvider.Encoding())
-	if err != nil {
-		return err
-	}
-	if code != 0 {
-		return errors.New(errMsg)
-	}
-	return nil
+func (e *TwoPcMsgRejectedError) Error() string { return e.Reason }
+
+// IsTwoPcMsgRejected reports whether the err means that the remote peer refused the 2pc msg.
+func IsTwoPcMsgRejected(err error) bool {
+	_, ok := errors.Cause(err).(*TwoPcMsgRejectedError)
+	return ok
 }
 
-// SendTwoPcPrepareVote sends 2pc prepareVote to other peer.
-func SendTwoPcPrepareVote (ctx context.Context, p2pProvider p2p.P2P, pid peer.ID, req *pb.PrepareVote) error {
+// sendTwoPcMsg sends the 2pc msg on the special topic once and reads the response code of remote peer.
+func sendTwoPcMsg(ctx context.Context, p2pProvider p2p.P2P, pid peer.ID, req interface{}, topic string) error {
 	ctx, cancel := context.WithTimeout(ctx, respTimeout)
 	defer cancel()
 
-	//log.Debugf("======================================== vote partyId len: %d", len(req.PeerInfo.PartyId))
-
-	// send request on the special topic.
-	stream, err := p2pProvider.Send(ctx, req, p2p.RPCTwoPcPrepareVoteTopic, pid)
+	stream, err := p2pProvider.Send(ctx, req, topic, pid)
 	if err != nil {
 		return err
 	}
@@ -47,91 +38,47 @@ func SendTwoPcPrepareVote (ctx context.Context, p2pProvider p2p.P2P, pid peer.ID
 		return err
 	}
 	if code != 0 {
-		return errors.New(errMsg)
+		return &TwoPcMsgRejectedError{Code: code, Reason: errMsg}
 	}
 	return nil
 }
 
-// SendTwoPcConfirmMsg sends 2pc ConfirmMsg to other peer.
-func SendTwoPcConfirmMsg (ctx context.Context, p2pProvider p2p.P2P, pid peer.ID, req *pb.ConfirmMsg) error {
-	ctx, cancel := context.WithTimeout(ctx, respTimeout)
-	defer cancel()
+// SendTwoPcPrepareMsg sends 2pc prepareMsg to other peer.
+func SendTwoPcPrepareMsg(ctx context.Context, p2pProvider p2p.P2P, pid peer.ID, req *pb.PrepareMsg) error {
+	return sendTwoPcMsg(ctx, p2pProvider, pid, req, p2p.RPCTwoPcPrepareMsgTopic)
+}
 
-	// send request on the special topic.
-	stream, err := p2pProvider.Send(ctx, req, p2p.RPCTwoPcConfirmMsgTopic, pid)
-	if err != nil {
-		return err
-	}
-	defer closeStream(stream, log)
-	code, errMsg, err := ReadStatusCode(stream, p2pProvider.Encoding())
-	if err != nil {
-		return err
-	}
-	if code != 0 {
-		return errors.New(errMsg)
-	}
-	return nil
+// SendTwoPcPrepareVote sends 2pc prepareVote to other peer.
+func SendTwoPcPrepareVote(ctx context.Context, p2pProvider p2p.P2P, pid peer.ID, req *pb.PrepareVote) error {
+	return sendTwoPcMsg(ctx, p2pProvider, pid, req, p2p.RPCTwoPcPrepareVoteTopic)
 }
 
-// SendTwoPcConfirmVote sends 2pc ConfirmVote to other peer.
-func SendTwoPcConfirmVote (ctx context.Context, p2pProvider p2p.P2P, pid peer.ID, req *pb.ConfirmVote) error {
-	ctx, cancel := context.WithTimeout(ctx, respTimeout)
-	defer cancel()
+// SendTwoPcConfirmMsg sends 2pc ConfirmMsg to other peer.
+func SendTwoPcConfirmMsg(ctx context.Context, p2pProvider p2p.P2P, pid peer.ID, req *pb.ConfirmMsg) error {
+	return sendTwoPcMsg(ctx, p2pProvider, pid, req, p2p.RPCTwoPcConfirmMsgTopic)
+}
 
-	// send request on the special topic.
-	stream, err := p2pProvider.Send(ctx, req, p2p.RPCTwoPcConfirmVoteTopic, pid)
-	if err != nil {
-		return err
-	}
-	defer closeStream(stream, log)
-	code, errMsg, err := ReadStatusCode(stream, p2pProvider.Encoding())
-	if err != nil {
-		return err
-	}
-	if code != 0 {
-		return errors.New(errMsg)
-	}
-	return nil
+// SendTwoPcConfirmVote sends 2pc ConfirmVote to other peer.
+func SendTwoPcConfirmVote(ctx context.Context, p2pProvider p2p.P2P, pid peer.ID, req *pb.ConfirmVote) error {
+	return sendTwoPcMsg(ctx, p2pProvider, pid, req, p2p.RPCTwoPcConfirmVoteTopic)
 }
 
 // SendTwoPcCommitMsg sends 2pc CommitMsg to other peer.
-func SendTwoPcCommitMsg (ctx context.Context, p2pProvider p2p.P2P, pid peer.ID, req *pb.CommitMsg) error {
-	ctx, cancel := context.WithTimeout(ctx, respTimeout)
-	defer cancel()
-
-	// send request on the special topic.
-	stream, err := p2pProvider.Send(ctx, req, p2p.RPCTwoPcCommitMsgTopic, pid)
-	if err != nil {
-		return err
-	}
-	defer closeStream(stream, log)
-	code, errMsg, err := ReadStatusCode(stream, p2pProvider.Encoding())
-	if err != nil {
-		return err
-	}
-	if code != 0 {
-		return errors.New(errMsg)
-	}
-	return nil
+func SendTwoPcCommitMsg(ctx context.Context, p2pProvider p2p.P2P, pid peer.ID, req *pb.CommitMsg) error {
+	return sendTwoPcMsg(ctx, p2pProvider, pid, req, p2p.RPCTwoPcCommitMsgTopic)
 }
 
 // SendTwoPcTaskResultMsg sends taskResult to other peer, if the task has finished.
-func SendTwoPcTaskResultMsg (ctx context.Context, p2pProvider p2p.P2P, pid peer.ID, req *pb.TaskResultMsg) error {
-	ctx, cancel := context.WithTimeout(ctx, respTimeout)
-	defer cancel()
+func SendTwoPcTaskResultMsg(ctx context.Context, p2pProvider p2p.P2P, pid peer.ID, req *pb.TaskResultMsg) error {
+	return sendTwoPcMsg(ctx, p2pProvider, pid, req, p2p.RPCTwoPcTaskResultMsgTopic)
+}
 
-	// send request on the special topic.
-	stream, err := p2pProvider.Send(ctx, req, p2p.RPCTwoPcTaskResultMsgTopic, pid)
-	if err != nil {
-		return err
-	}
-	defer closeStream(stream, log)
-	code, errMsg, err := ReadStatusCode(stream, p2pProvider.Encoding())
-	if err != nil {
-		return err
-	}
-	if code != 0 {
-		return errors.New(errMsg)
-	}
-	return nil
-}
\ No newline at end of file
+// SendTwoPcTaskCancelMsg sends taskCancel to other peer, if the task owner cancel the task.
+func SendTwoPcTaskCancelMsg(ctx context.Context, p2pProvider p2p.P2P, pid peer.ID, req *pb.TaskCancelMsg) error {
+	return sendTwoPcMsg(ctx, p2pProvider, pid, req, p2p.RPCTwoPcTaskCancelMsgTopic)
+}
+
+// SendTwoPcTaskProgressMsg sends the progress of the executing task to the task owner.
+func SendTwoPcTaskProgressMsg(ctx context.Context, p2pProvider p2p.P2P, pid peer.ID, req *pb.TaskProgressMsg) error {
+	return sendTwoPcMsg(ctx, p2pProvider, pid, req, p2p.RPCTwoPcTaskProgressMsgTopic)
+}
@@ -231,11 +231,82 @@ func (s *Service) taskResultMsgRPCHandler(ctx context.Context, msg interface{},
 	return nil
 }
 
+func (s *Service) taskCancelMsgRPCHandler(ctx context.Context, msg interface{}, stream libp2pcore.Stream) error {
+
+	SetRPCStreamDeadlines(stream)
+
+	m, ok := msg.(*pb.TaskCancelMsg)
+	if !ok {
+		log.Errorf("Failed to convert `TaskCancelMsg` from msg, proposalId: {%s}, taskId: {%s}", common.BytesToHash(m.ProposalId).String(), string(m.TaskId))
+		return errors.New("message is not type *pb.TaskCancelMsg")
+	}
+
+	// validate TaskCancelMsg
+	if err := s.validateTaskCancelMsg(stream.Conn().RemotePeer(), m); err != nil {
+		s.writeErrorResponseToStream(responseCodeInvalidRequest, err.Error(), stream)
+		s.cfg.P2P.Peers().Scorers().BadResponsesScorer().Increment(stream.Conn().RemotePeer())
+		log.WithError(err).Errorf("Failed to call `validateTaskCancelMsg`, proposalId: {%s}, taskId: {%s}", common.BytesToHash(m.ProposalId).String(), string(m.TaskId))
+		return err
+	}
+
+	// handle TaskCancelMsg
+	if err := s.onTaskCancelMsg(stream.Conn().RemotePeer(), m); err != nil {
+		s.writeErrorResponseToStream(responseCodeInvalidRequest, err.Error(), stream)
+		s.cfg.P2P.Peers().Scorers().BadResponsesScorer().Increment(stream.Conn().RemotePeer())
+		log.WithError(err).Warnf("Warning to call `onTaskCancelMsg`, proposalId: {%s}, taskId: {%s}", common.BytesToHash(m.ProposalId).String(), string(m.TaskId))
+		return err
+	}
+
+	// response code
+	if _, err := stream.Write([]byte{responseCodeSuccess}); err != nil {
+		log.WithError(err).Errorf("Could not write to stream for response, after to call `onTaskCancelMsg`, proposalId: {%s}, taskId: {%s}", common.BytesToHash(m.ProposalId).String(), string(m.TaskId))
+		return err
+	}
+
+	closeStream(stream, log)
+	return nil
+}
+
+func (s *Service) taskProgressMsgRPCHandler(ctx context.Context, msg interface{}, stream libp2pcore.Stream) error {
+
+	SetRPCStreamDeadlines(stream)
+
+	m, ok := msg.(*pb.TaskProgressMsg)
+	if !ok {
+		log.Errorf("Failed to convert `TaskProgressMsg` from msg")
+		return errors.New("message is not type *pb.TaskProgressMsg")
+	}
+
+	// validate TaskProgressMsg
+	if err := s.validateTaskProgressMsg(stream.Conn().RemotePeer(), m); err != nil {
+		s.writeErrorResponseToStream(responseCodeInvalidRequest, err.Error(), stream)
+		s.cfg.P2P.Peers().Scorers().BadResponsesScorer().Increment(stream.Conn().RemotePeer())
+		log.WithError(err).Errorf("Failed to call `validateTaskProgressMsg`, proposalId: {%s}, taskId: {%s}", common.BytesToHash(m.ProposalId).String(), string(m.TaskId))
+		return err
+	}
+
+	// handle TaskProgressMsg
+	if err := s.onTaskProgressMsg(stream.Conn().RemotePeer(), m); err != nil {
+		s.writeErrorResponseToStream(responseCodeInvalidRequest, err.Error(), stream)
+		log.WithError(err).Warnf("Warning to call `onTaskProgressMsg`, proposalId: {%s}, taskId: {%s}", common.BytesToHash(m.ProposalId).String(), string(m.TaskId))
+		return err
+	}
+
+	// response code
+	if _, err := stream.Write([]byte{responseCodeSuccess}); err != nil {
+		log.WithError(err).Errorf("Could not write to stream for response, after to call `onTaskProgressMsg`, proposalId: {%s}, taskId: {%s}", common.BytesToHash(m.ProposalId).String(), string(m.TaskId))
+		return err
+	}
+
+	closeStream(stream, log)
+	return nil
+}
+
 
 // ------------------------------------  some validate Fn  ------------------------------------
 
 func (s *Service) validatePrepareMsg(pid peer.ID, r *pb.PrepareMsg) error {
-	engine, ok := s.cfg.Engines[types.TwopcTyp]
+	engine, ok := s.cfg.Engines[s.cfg.ConsensusEngine]
 	if !ok {
 		return fmt.Errorf("Failed to fecth 2pc engine instanse ...")
 	}
@@ -243,7 +314,7 @@ func (s *Service) validatePrepareMsg(pid peer.ID, r *pb.PrepareMsg) error {
 }
 
 func (s *Service) validatePrepareVote(pid peer.ID, r *pb.PrepareVote) error {
-	engine, ok := s.cfg.Engines[types.TwopcTyp]
+	engine, ok := s.cfg.Engines[s.cfg.ConsensusEngine]
 	if !ok {
 		return fmt.Errorf("Failed to fecth 2pc engine instanse ...")
 	}
@@ -251,7 +322,7 @@ func (s *Service) validatePrepareVote(pid peer.ID, r *pb.PrepareVote) error {
 }
 
 func (s *Service) validateConfirmMsg(pid peer.ID, r *pb.ConfirmMsg) error {
-	engine, ok := s.cfg.Engines[types.TwopcTyp]
+	engine, ok := s.cfg.Engines[s.cfg.ConsensusEngine]
 	if !ok {
 		return fmt.Errorf("Failed to fecth 2pc engine instanse ...")
 	}
@@ -259,7 +330,7 @@ func (s *Service) validateConfirmMsg(pid peer.ID, r *pb.ConfirmMsg) error {
 }
 
 func (s *Service) validateConfirmVote(pid peer.ID, r *pb.ConfirmVote) error {
-	engine, ok := s.cfg.Engines[types.TwopcTyp]
+	engine, ok := s.cfg.Engines[s.cfg.ConsensusEngine]
 	if !ok {
 		return fmt.Errorf("Failed to fecth 2pc engine instanse ...")
 	}
@@ -267,7 +338,7 @@ func (s *Service) validateConfirmVote(pid peer.ID, r *pb.ConfirmVote) error {
 }
 
 func (s *Service) validateCommitMsg(pid peer.ID, r *pb.CommitMsg) error {
-	engine, ok := s.cfg.Engines[types.TwopcTyp]
+	engine, ok := s.cfg.Engines[s.cfg.ConsensusEngine]
 	if !ok {
 		return fmt.Errorf("Failed to fecth 2pc engine instanse ...")
 	}
@@ -275,18 +346,34 @@ func (s *Service) validateCommitMsg(pid peer.ID, r *pb.CommitMsg) error {
 }
 
 func (s *Service) validateTaskResultMsg(pid peer.ID, r *pb.TaskResultMsg) error {
-	engine, ok := s.cfg.Engines[types.TwopcTyp]
+	engine, ok := s.cfg.Engines[s.cfg.ConsensusEngine]
 	if !ok {
 		return fmt.Errorf("Failed to fecth 2pc engine instanse ...")
 	}
 	return engine.ValidateConsensusMsg(pid, &types.TaskResultMsgWrap{TaskResultMsg: r})
 }
 
+func (s *Service) validateTaskCancelMsg(pid peer.ID, r *pb.TaskCancelMsg) error {
+	engine, ok := s.cfg.Engines[s.cfg.ConsensusEngine]
+	if !ok {
+		return fmt.Errorf("Failed to fecth 2pc engine instanse ...")
+	}
+	return engine.ValidateConsensusMsg(pid, &types.TaskCancelMsgWrap{TaskCancelMsg: r})
+}
+
+func (s *Service) validateTaskProgressMsg(pid peer.ID, r *pb.TaskProgressMsg) error {
+	engine, ok := s.cfg.Engines[s.cfg.ConsensusEngine]
+	if !ok {
+		return fmt.Errorf("Failed to fecth 2pc engine instanse ...")
+	}
+	return engine.ValidateConsensusMsg(pid, &types.TaskProgressMsgWrap{TaskProgressMsg: r})
+}
+
 
 // ------------------------------------  some handle Fn  ------------------------------------
 
 func (s *Service) onPrepareMsg(pid peer.ID, r *pb.PrepareMsg) error {
-	engine, ok := s.cfg.Engines[types.TwopcTyp]
+	engine, ok := s.cfg.Engines[s.cfg.ConsensusEngine]
 	if !ok {
 		return fmt.Errorf("Failed to fecth 2pc engine instanse ...")
 	}
@@ -294,7 +381,7 @@ func (s *Service) onPrepareMsg(pid peer.ID, r *pb.PrepareMsg) error {
 }
 
 func (s *Service) onPrepareVote(pid peer.ID, r *pb.PrepareVote) error {
-	engine, ok := s.cfg.Engines[types.TwopcTyp]
+	engine, ok := s.cfg.Engines[s.cfg.ConsensusEngine]
 	if !ok {
 		return fmt.Errorf("Failed to fecth 2pc engine instanse ...")
 	}
@@ -302,7 +389,7 @@ func (s *Service) onPrepareVote(pid peer.ID, r *pb.PrepareVote) error {
 }
 
 func (s *Service) onConfirmMsg(pid peer.ID, r *pb.ConfirmMsg) error {
-	engine, ok := s.cfg.Engines[types.TwopcTyp]
+	engine, ok := s.cfg.Engines[s.cfg.ConsensusEngine]
 	if !ok {
 		return fmt.Errorf("Failed to fecth 2pc engine instanse ...")
 	}
@@ -310,7 +397,7 @@ func (s *Service) onConfirmMsg(pid peer.ID, r *pb.ConfirmMsg) error {
 }
 
 func (s *Service) onConfirmVote(pid peer.ID, r *pb.ConfirmVote) error {
-	engine, ok := s.cfg.Engines[types.TwopcTyp]
+	engine, ok := s.cfg.Engines[s.cfg.ConsensusEngine]
 	if !ok {
 		return fmt.Errorf("Failed to fecth 2pc engine instanse ...")
 	}
@@ -318,7 +405,7 @@ func (s *Service) onConfirmVote(pid peer.ID, r *pb.ConfirmVote) error {
 }
 
 func (s *Service) onCommitMsg(pid peer.ID, r *pb.CommitMsg) error {
-	engine, ok := s.cfg.Engines[types.TwopcTyp]
+	engine, ok := s.cfg.Engines[s.cfg.ConsensusEngine]
 	if !ok {
 		return fmt.Errorf("Failed to fecth 2pc engine instanse ...")
 	}
@@ -326,9 +413,25 @@ func (s *Service) onCommitMsg(pid peer.ID, r *pb.CommitMsg) error {
 }
 
 func (s *Service) onTaskResultMsg(pid peer.ID, r *pb.TaskResultMsg) error {
-	engine, ok := s.cfg.Engines[types.TwopcTyp]
+	engine, ok := s.cfg.Engines[s.cfg.ConsensusEngine]
 	if !ok {
 		return fmt.Errorf("Failed to fecth 2pc engine instanse ...")
 	}
 	return engine.OnConsensusMsg(pid, &types.TaskResultMsgWrap{TaskResultMsg: r})
-}
\ No newline at end of file
+}
+
+func (s *Service) onTaskCancelMsg(pid peer.ID, r *pb.TaskCancelMsg) error {
+	engine, ok := s.cfg.Engines[s.cfg.ConsensusEngine]
+	if !ok {
+		return fmt.Errorf("Failed to fecth 2pc engine instanse ...")
+	}
+	return engine.OnConsensusMsg(pid, &types.TaskCancelMsgWrap{TaskCancelMsg: r})
+}
+
+func (s *Service) onTaskProgressMsg(pid peer.ID, r *pb.TaskProgressMsg) error {
+	engine, ok := s.cfg.Engines[s.cfg.ConsensusEngine]
+	if !ok {
+		return fmt.Errorf("Failed to fecth 2pc engine instanse ...")
+	}
+	return engine.OnConsensusMsg(pid, &types.TaskProgressMsgWrap{TaskProgressMsg: r})
+}
@@ -39,6 +39,8 @@ type Config struct {
 	InitialSync   Checker
 	StateNotifier statefeed.Notifier
 	Engines       map[types.ConsensusEngineType]Engine
+	// the engine which the consensus msgs are handled by
+	ConsensusEngine types.ConsensusEngineType
 }
 
 // Service is responsible for handling all run time p2p related operations as the
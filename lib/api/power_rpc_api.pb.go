@@ -123,7 +123,7 @@ func (m *PowerSingleDetail) GetState() string {
 	return ""
 }
 
-//  总算力详情
+// 总算力详情
 type PowerTotalDetail struct {
 	Information          *ResourceUsedDetailShow `protobuf:"bytes,1,opt,name=information,proto3" json:"information,omitempty"`
 	TotalTaskCount       uint32                  `protobuf:"varint,2,opt,name=total_task_count,json=totalTaskCount,proto3" json:"total_task_count,omitempty"`
@@ -701,6 +701,354 @@ func (m *RevokePowerRequest) GetPowerId() string {
 	return ""
 }
 
+// 远端组织使用本地算力的配额 (为 0 的项不限制)
+type OrgQuota struct {
+	IdentityId           string   `protobuf:"bytes,1,opt,name=identity_id,json=identityId,proto3" json:"identity_id,omitempty"`
+	MaxConcurrentTasks   uint32   `protobuf:"varint,2,opt,name=max_concurrent_tasks,json=maxConcurrentTasks,proto3" json:"max_concurrent_tasks,omitempty"`
+	MaxSlots             uint64   `protobuf:"varint,3,opt,name=max_slots,json=maxSlots,proto3" json:"max_slots,omitempty"`
+	MaxDailySlotHours    uint64   `protobuf:"varint,4,opt,name=max_daily_slot_hours,json=maxDailySlotHours,proto3" json:"max_daily_slot_hours,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OrgQuota) Reset()         { *m = OrgQuota{} }
+func (m *OrgQuota) String() string { return proto.CompactTextString(m) }
+func (*OrgQuota) ProtoMessage()    {}
+func (*OrgQuota) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5594bc2f9a3f125, []int{10}
+}
+func (m *OrgQuota) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrgQuota) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrgQuota.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OrgQuota) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrgQuota.Merge(m, src)
+}
+func (m *OrgQuota) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrgQuota) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrgQuota.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrgQuota proto.InternalMessageInfo
+
+func (m *OrgQuota) GetIdentityId() string {
+	if m != nil {
+		return m.IdentityId
+	}
+	return ""
+}
+
+func (m *OrgQuota) GetMaxConcurrentTasks() uint32 {
+	if m != nil {
+		return m.MaxConcurrentTasks
+	}
+	return 0
+}
+
+func (m *OrgQuota) GetMaxSlots() uint64 {
+	if m != nil {
+		return m.MaxSlots
+	}
+	return 0
+}
+
+func (m *OrgQuota) GetMaxDailySlotHours() uint64 {
+	if m != nil {
+		return m.MaxDailySlotHours
+	}
+	return 0
+}
+
+// 远端组织当前使用本地算力的情况
+type OrgQuotaUsage struct {
+	RunningTasks         uint32   `protobuf:"varint,1,opt,name=running_tasks,json=runningTasks,proto3" json:"running_tasks,omitempty"`
+	UsedSlots            uint64   `protobuf:"varint,2,opt,name=used_slots,json=usedSlots,proto3" json:"used_slots,omitempty"`
+	DailySlotMsec        uint64   `protobuf:"varint,3,opt,name=daily_slot_msec,json=dailySlotMsec,proto3" json:"daily_slot_msec,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OrgQuotaUsage) Reset()         { *m = OrgQuotaUsage{} }
+func (m *OrgQuotaUsage) String() string { return proto.CompactTextString(m) }
+func (*OrgQuotaUsage) ProtoMessage()    {}
+func (*OrgQuotaUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5594bc2f9a3f125, []int{11}
+}
+func (m *OrgQuotaUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrgQuotaUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrgQuotaUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OrgQuotaUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrgQuotaUsage.Merge(m, src)
+}
+func (m *OrgQuotaUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrgQuotaUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrgQuotaUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrgQuotaUsage proto.InternalMessageInfo
+
+func (m *OrgQuotaUsage) GetRunningTasks() uint32 {
+	if m != nil {
+		return m.RunningTasks
+	}
+	return 0
+}
+
+func (m *OrgQuotaUsage) GetUsedSlots() uint64 {
+	if m != nil {
+		return m.UsedSlots
+	}
+	return 0
+}
+
+func (m *OrgQuotaUsage) GetDailySlotMsec() uint64 {
+	if m != nil {
+		return m.DailySlotMsec
+	}
+	return 0
+}
+
+type OrgQuotaShow struct {
+	Quota                *OrgQuota      `protobuf:"bytes,1,opt,name=quota,proto3" json:"quota,omitempty"`
+	Usage                *OrgQuotaUsage `protobuf:"bytes,2,opt,name=usage,proto3" json:"usage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *OrgQuotaShow) Reset()         { *m = OrgQuotaShow{} }
+func (m *OrgQuotaShow) String() string { return proto.CompactTextString(m) }
+func (*OrgQuotaShow) ProtoMessage()    {}
+func (*OrgQuotaShow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5594bc2f9a3f125, []int{12}
+}
+func (m *OrgQuotaShow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OrgQuotaShow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OrgQuotaShow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OrgQuotaShow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrgQuotaShow.Merge(m, src)
+}
+func (m *OrgQuotaShow) XXX_Size() int {
+	return m.Size()
+}
+func (m *OrgQuotaShow) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrgQuotaShow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrgQuotaShow proto.InternalMessageInfo
+
+func (m *OrgQuotaShow) GetQuota() *OrgQuota {
+	if m != nil {
+		return m.Quota
+	}
+	return nil
+}
+
+func (m *OrgQuotaShow) GetUsage() *OrgQuotaUsage {
+	if m != nil {
+		return m.Usage
+	}
+	return nil
+}
+
+type GetOrgQuotaListResponse struct {
+	Status               int32           `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Msg                  string          `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	QuotaList            []*OrgQuotaShow `protobuf:"bytes,3,rep,name=quota_list,json=quotaList,proto3" json:"quota_list,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *GetOrgQuotaListResponse) Reset()         { *m = GetOrgQuotaListResponse{} }
+func (m *GetOrgQuotaListResponse) String() string { return proto.CompactTextString(m) }
+func (*GetOrgQuotaListResponse) ProtoMessage()    {}
+func (*GetOrgQuotaListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5594bc2f9a3f125, []int{13}
+}
+func (m *GetOrgQuotaListResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetOrgQuotaListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetOrgQuotaListResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GetOrgQuotaListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOrgQuotaListResponse.Merge(m, src)
+}
+func (m *GetOrgQuotaListResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetOrgQuotaListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOrgQuotaListResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetOrgQuotaListResponse proto.InternalMessageInfo
+
+func (m *GetOrgQuotaListResponse) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *GetOrgQuotaListResponse) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
+}
+
+func (m *GetOrgQuotaListResponse) GetQuotaList() []*OrgQuotaShow {
+	if m != nil {
+		return m.QuotaList
+	}
+	return nil
+}
+
+type SetOrgQuotaRequest struct {
+	Quota                *OrgQuota `protobuf:"bytes,1,opt,name=quota,proto3" json:"quota,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *SetOrgQuotaRequest) Reset()         { *m = SetOrgQuotaRequest{} }
+func (m *SetOrgQuotaRequest) String() string { return proto.CompactTextString(m) }
+func (*SetOrgQuotaRequest) ProtoMessage()    {}
+func (*SetOrgQuotaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5594bc2f9a3f125, []int{14}
+}
+func (m *SetOrgQuotaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SetOrgQuotaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SetOrgQuotaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SetOrgQuotaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetOrgQuotaRequest.Merge(m, src)
+}
+func (m *SetOrgQuotaRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SetOrgQuotaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetOrgQuotaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetOrgQuotaRequest proto.InternalMessageInfo
+
+func (m *SetOrgQuotaRequest) GetQuota() *OrgQuota {
+	if m != nil {
+		return m.Quota
+	}
+	return nil
+}
+
+type RemoveOrgQuotaRequest struct {
+	IdentityId           string   `protobuf:"bytes,1,opt,name=identity_id,json=identityId,proto3" json:"identity_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveOrgQuotaRequest) Reset()         { *m = RemoveOrgQuotaRequest{} }
+func (m *RemoveOrgQuotaRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveOrgQuotaRequest) ProtoMessage()    {}
+func (*RemoveOrgQuotaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e5594bc2f9a3f125, []int{15}
+}
+func (m *RemoveOrgQuotaRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveOrgQuotaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveOrgQuotaRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemoveOrgQuotaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveOrgQuotaRequest.Merge(m, src)
+}
+func (m *RemoveOrgQuotaRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveOrgQuotaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveOrgQuotaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveOrgQuotaRequest proto.InternalMessageInfo
+
+func (m *RemoveOrgQuotaRequest) GetIdentityId() string {
+	if m != nil {
+		return m.IdentityId
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*PowerSingleDetail)(nil), "rpcapi.PowerSingleDetail")
 	proto.RegisterType((*PowerTotalDetail)(nil), "rpcapi.PowerTotalDetail")
@@ -712,69 +1060,94 @@ func init() {
 	proto.RegisterType((*GetPowerSingleDetailListResponse)(nil), "rpcapi.GetPowerSingleDetailListResponse")
 	proto.RegisterType((*PublishPowerResponse)(nil), "rpcapi.PublishPowerResponse")
 	proto.RegisterType((*RevokePowerRequest)(nil), "rpcapi.RevokePowerRequest")
+	proto.RegisterType((*OrgQuota)(nil), "rpcapi.OrgQuota")
+	proto.RegisterType((*OrgQuotaUsage)(nil), "rpcapi.OrgQuotaUsage")
+	proto.RegisterType((*OrgQuotaShow)(nil), "rpcapi.OrgQuotaShow")
+	proto.RegisterType((*GetOrgQuotaListResponse)(nil), "rpcapi.GetOrgQuotaListResponse")
+	proto.RegisterType((*SetOrgQuotaRequest)(nil), "rpcapi.SetOrgQuotaRequest")
+	proto.RegisterType((*RemoveOrgQuotaRequest)(nil), "rpcapi.RemoveOrgQuotaRequest")
 }
 
 func init() { proto.RegisterFile("lib/api/power_rpc_api.proto", fileDescriptor_e5594bc2f9a3f125) }
 
 var fileDescriptor_e5594bc2f9a3f125 = []byte{
-	// 911 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x41, 0x6f, 0x1b, 0x45,
-	0x14, 0xd6, 0xc4, 0xb1, 0x13, 0x3f, 0xb7, 0x25, 0x1d, 0xaa, 0x76, 0xb3, 0x36, 0xc6, 0x6c, 0x2b,
-	0x62, 0x2a, 0xf0, 0x0a, 0x23, 0x40, 0xca, 0x01, 0x41, 0x5d, 0x88, 0x2c, 0xa1, 0xb6, 0xda, 0xc0,
-	0x05, 0x0e, 0xd6, 0x78, 0xf7, 0xd5, 0x99, 0x64, 0x77, 0x67, 0x99, 0x19, 0x27, 0x2a, 0xc7, 0x4a,
-	0x15, 0x47, 0x84, 0xf8, 0x0d, 0x88, 0xbf, 0xc2, 0x11, 0x89, 0x3f, 0x80, 0x22, 0x6e, 0xfc, 0x01,
-	0x8e, 0x68, 0x67, 0xbd, 0xf6, 0xae, 0xe3, 0xa4, 0x09, 0xf4, 0xb6, 0x33, 0xef, 0x7b, 0xf3, 0x7d,
-	0xdf, 0x7b, 0x6f, 0x67, 0x17, 0x9a, 0x21, 0x1f, 0xbb, 0x2c, 0xe1, 0x6e, 0x22, 0x4e, 0x50, 0x8e,
-	0x64, 0xe2, 0x8f, 0x58, 0xc2, 0x7b, 0x89, 0x14, 0x5a, 0xd0, 0x9a, 0x4c, 0x7c, 0x96, 0x70, 0xbb,
-	0x95, 0x83, 0x7c, 0x11, 0x45, 0x22, 0x1e, 0x45, 0xa8, 0x14, 0x9b, 0x60, 0x86, 0xb2, 0xed, 0x3c,
-	0xaa, 0x99, 0x3a, 0x2a, 0x9f, 0x60, 0xb7, 0x26, 0x42, 0x4c, 0x42, 0x34, 0x61, 0x16, 0xc7, 0x42,
-	0x33, 0xcd, 0x45, 0xac, 0xb2, 0xa8, 0xf3, 0xeb, 0x1a, 0xdc, 0x7c, 0x92, 0xf2, 0xee, 0xf3, 0x78,
-	0x12, 0xe2, 0x43, 0xd4, 0x8c, 0x87, 0xf4, 0x53, 0x68, 0xf0, 0xf8, 0xa9, 0x90, 0x91, 0xc1, 0x5a,
-	0xa4, 0x43, 0xba, 0x8d, 0x7e, 0xbb, 0x97, 0x69, 0xe9, 0x79, 0xa8, 0xc4, 0x54, 0xfa, 0xf8, 0xb5,
-	0xc2, 0x20, 0x4b, 0xd8, 0x3f, 0x10, 0x27, 0x5e, 0x31, 0x85, 0xb6, 0xa1, 0x71, 0x28, 0xc6, 0xa3,
-	0x58, 0x04, 0x38, 0xe2, 0x81, 0xb5, 0xd6, 0x21, 0xdd, 0xba, 0x57, 0x3f, 0x14, 0xe3, 0x47, 0x22,
-	0xc0, 0x61, 0x40, 0xb7, 0x61, 0x33, 0xb3, 0xcb, 0x03, 0xab, 0x62, 0x82, 0x1b, 0x66, 0x3d, 0x0c,
-	0x68, 0x17, 0xb6, 0xb4, 0xd0, 0x2c, 0x1c, 0x19, 0x33, 0xbe, 0x98, 0xc6, 0xda, 0x5a, 0xef, 0x90,
-	0xee, 0x75, 0xef, 0x86, 0xd9, 0xff, 0x8a, 0xa9, 0xa3, 0x41, 0xba, 0x4b, 0xdf, 0x05, 0xea, 0x4f,
-	0xa5, 0xc4, 0x58, 0x17, 0xb1, 0x55, 0x83, 0xdd, 0x9a, 0x45, 0x16, 0xe8, 0x1d, 0xa8, 0xa6, 0x28,
-	0x65, 0xd5, 0x3a, 0x95, 0x6e, 0xa3, 0x7f, 0x33, 0xb7, 0x63, 0xec, 0xa7, 0x30, 0x2f, 0x8b, 0xd3,
-	0x5b, 0x50, 0x55, 0x9a, 0x69, 0xb4, 0x36, 0x8c, 0xb0, 0x6c, 0xe1, 0xfc, 0x4d, 0x60, 0x2b, 0x83,
-	0xa6, 0x22, 0x5e, 0x59, 0xa1, 0x56, 0xb9, 0x5d, 0xbb, 0x82, 0xdb, 0xca, 0xcb, 0xdc, 0xae, 0x5f,
-	0xd6, 0x6d, 0xb5, 0xe8, 0xf6, 0x97, 0x0a, 0xd4, 0xe7, 0x50, 0x7a, 0x07, 0x36, 0x0c, 0x25, 0x0f,
-	0x8c, 0xc5, 0xba, 0x57, 0x4b, 0x97, 0xc3, 0x80, 0x36, 0xa1, 0x6e, 0x02, 0x31, 0x8b, 0x70, 0xd6,
-	0xe4, 0xcd, 0x74, 0xe3, 0x11, 0x8b, 0x90, 0x7e, 0x04, 0x55, 0x71, 0x12, 0xa3, 0x34, 0x1a, 0x1b,
-	0xfd, 0x4e, 0x2e, 0xe1, 0xb1, 0x9c, 0xb0, 0x98, 0x7f, 0x6f, 0xfc, 0x0f, 0x03, 0x8c, 0x35, 0xd7,
-	0xcf, 0x86, 0xf1, 0x53, 0xe1, 0x65, 0x70, 0xba, 0x0b, 0x1b, 0x09, 0xd3, 0x31, 0xca, 0x5c, 0xfc,
-	0xcb, 0x33, 0xf3, 0x04, 0xfa, 0x09, 0xd4, 0x25, 0xfa, 0xc8, 0x8f, 0xd3, 0xec, 0xea, 0x25, 0xb3,
-	0x17, 0x29, 0x74, 0x0f, 0x6e, 0x88, 0x04, 0xa5, 0xc1, 0x8c, 0x7c, 0xa1, 0xb4, 0x55, 0x2b, 0x8b,
-	0x4f, 0xeb, 0xf1, 0x38, 0x47, 0x0c, 0x84, 0xd2, 0x0f, 0xd1, 0x0f, 0x99, 0x44, 0xef, 0xba, 0x28,
-	0xee, 0xd2, 0x21, 0xbc, 0xb6, 0x38, 0x48, 0x25, 0x18, 0x07, 0x66, 0x9c, 0x2e, 0x73, 0xd2, 0x42,
-	0xc1, 0x7e, 0x9a, 0x47, 0x6d, 0xd8, 0xf4, 0x25, 0x32, 0x8d, 0x9f, 0x69, 0x6b, 0xb3, 0x43, 0xba,
-	0xeb, 0xde, 0x7c, 0xed, 0xbc, 0x20, 0xd0, 0xdc, 0x43, 0xbd, 0x3c, 0x98, 0x1e, 0xaa, 0x44, 0xc4,
-	0xaa, 0xd0, 0x03, 0x72, 0xb5, 0x1e, 0xf4, 0xa0, 0x6a, 0xde, 0x47, 0xd3, 0xd4, 0x46, 0xdf, 0x2a,
-	0x8f, 0x4f, 0x81, 0x28, 0x83, 0x39, 0x3f, 0x12, 0x78, 0x73, 0x85, 0x8e, 0x2f, 0xb9, 0xd2, 0x73,
-	0x2d, 0xb7, 0xa1, 0x96, 0x0e, 0xd7, 0x54, 0x19, 0x31, 0x55, 0x6f, 0xb6, 0xa2, 0x5b, 0x50, 0x89,
-	0xd4, 0x64, 0x36, 0x3e, 0xe9, 0x23, 0x7d, 0x00, 0x90, 0xdd, 0x0e, 0x21, 0x57, 0xe9, 0x88, 0xa7,
-	0x6d, 0xbc, 0x9b, 0x4b, 0xb8, 0xc0, 0xae, 0x57, 0x37, 0x69, 0x29, 0xab, 0xf3, 0x21, 0xbc, 0xfe,
-	0x64, 0x3a, 0x0e, 0xb9, 0x3a, 0x30, 0x68, 0x0f, 0xbf, 0x9b, 0xa2, 0xd2, 0xcb, 0x17, 0x13, 0x59,
-	0xba, 0x98, 0x9c, 0x1f, 0x08, 0xb4, 0x72, 0x86, 0xe2, 0x9d, 0xf8, 0xbf, 0x2b, 0xea, 0x96, 0x2b,
-	0xba, 0x5d, 0xaa, 0x68, 0x89, 0x69, 0x56, 0xd2, 0x9f, 0x08, 0x74, 0x56, 0x29, 0xf9, 0x8f, 0x35,
-	0x1d, 0xac, 0xa8, 0xe9, 0xbd, 0xe5, 0x9a, 0xae, 0x72, 0x5c, 0x2c, 0xea, 0xb7, 0x70, 0xab, 0x5c,
-	0xd4, 0x2b, 0xcb, 0x38, 0xff, 0xe2, 0x77, 0x5c, 0xa0, 0x1e, 0x1e, 0x8b, 0x23, 0x2c, 0x35, 0xac,
-	0x98, 0x40, 0x4a, 0x09, 0xfd, 0x7f, 0x2a, 0x70, 0x2d, 0x93, 0x8d, 0xf2, 0x98, 0xfb, 0x48, 0x9f,
-	0x13, 0xb8, 0x73, 0xce, 0x14, 0xd2, 0xdb, 0xb9, 0xd7, 0xcf, 0xa3, 0x44, 0x3f, 0x4b, 0x51, 0x4c,
-	0xb2, 0x48, 0xd9, 0x3b, 0x17, 0xcc, 0x55, 0xb1, 0xd4, 0xce, 0xdb, 0xcf, 0xff, 0xf8, 0xeb, 0xe7,
-	0xb5, 0x8e, 0xd3, 0x74, 0x7d, 0x26, 0x25, 0x47, 0xe9, 0x1e, 0xbf, 0x9f, 0x7d, 0xb3, 0x5d, 0x73,
-	0x53, 0xa7, 0xe0, 0x5d, 0x72, 0x9f, 0xbe, 0x20, 0x60, 0x9d, 0xd7, 0xb7, 0x73, 0x55, 0x74, 0x2f,
-	0xea, 0x44, 0x49, 0xc6, 0x8e, 0x91, 0xf1, 0x96, 0xd3, 0x3a, 0x2b, 0x43, 0x99, 0x9c, 0x5c, 0x87,
-	0x80, 0x6b, 0xc5, 0x5e, 0xd1, 0xe6, 0x7c, 0xe2, 0xce, 0xbe, 0x16, 0x76, 0x6b, 0x75, 0x70, 0xc6,
-	0x79, 0xcf, 0x70, 0xb6, 0x9d, 0xed, 0xb3, 0x9c, 0x49, 0x86, 0x4f, 0x09, 0x0f, 0xa1, 0x51, 0xe8,
-	0x1f, 0xb5, 0x17, 0x9f, 0xc1, 0xe5, 0xa6, 0xda, 0xf3, 0xd8, 0x3e, 0x8f, 0x92, 0x10, 0x73, 0xa2,
-	0x81, 0x08, 0xd0, 0xb9, 0x6b, 0xc8, 0xde, 0x70, 0xac, 0xb3, 0x64, 0xd2, 0x9c, 0xb4, 0x4b, 0xee,
-	0x3f, 0xf8, 0xf8, 0xb7, 0xd3, 0x36, 0xf9, 0xfd, 0xb4, 0x4d, 0xfe, 0x3c, 0x6d, 0x93, 0x6f, 0xde,
-	0x99, 0x70, 0x7d, 0x30, 0x1d, 0xf7, 0x7c, 0x11, 0xb9, 0x9e, 0x50, 0xa8, 0x35, 0xfb, 0x22, 0x14,
-	0x27, 0xee, 0x20, 0x3b, 0xe0, 0xbd, 0x3d, 0xe1, 0xce, 0xfe, 0x90, 0xc6, 0x35, 0xf3, 0xdf, 0xf3,
-	0xc1, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xfe, 0x4d, 0x78, 0x8b, 0x76, 0x09, 0x00, 0x00,
+	// 1213 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xdd, 0x6e, 0xe3, 0x44,
+	0x14, 0x96, 0xdb, 0x26, 0x4d, 0x4e, 0xda, 0x6d, 0x3b, 0x74, 0x77, 0xdd, 0xb4, 0x4d, 0x83, 0xbb,
+	0xb4, 0xe1, 0xaf, 0x81, 0xae, 0xf8, 0xd1, 0x0a, 0x21, 0xd8, 0x14, 0x4a, 0x24, 0xd8, 0x2e, 0x0e,
+	0x7b, 0x03, 0x17, 0xd1, 0xc4, 0x9e, 0x4d, 0xa7, 0xb5, 0x3d, 0xee, 0xcc, 0xb8, 0x3f, 0x70, 0xb7,
+	0xd2, 0x8a, 0x4b, 0x84, 0x78, 0x06, 0xc4, 0x05, 0x2f, 0xc2, 0x25, 0x88, 0x17, 0x40, 0x15, 0x77,
+	0xbc, 0x04, 0x9a, 0xb1, 0x9d, 0x38, 0x69, 0x9a, 0xb6, 0x0b, 0x77, 0x99, 0x39, 0xdf, 0x39, 0xdf,
+	0x77, 0xce, 0x9c, 0x39, 0x9e, 0xc0, 0xb2, 0x47, 0x3b, 0x75, 0x1c, 0xd2, 0x7a, 0xc8, 0x4e, 0x08,
+	0x6f, 0xf3, 0xd0, 0x69, 0xe3, 0x90, 0x6e, 0x85, 0x9c, 0x49, 0x86, 0xf2, 0x3c, 0x74, 0x70, 0x48,
+	0xcb, 0x2b, 0x29, 0xc8, 0x61, 0xbe, 0xcf, 0x82, 0xb6, 0x4f, 0x84, 0xc0, 0x5d, 0x12, 0xa3, 0xca,
+	0xe5, 0xd4, 0x2a, 0xb1, 0x38, 0x1c, 0x8c, 0x50, 0x5e, 0xe9, 0x32, 0xd6, 0xf5, 0x88, 0x36, 0xe3,
+	0x20, 0x60, 0x12, 0x4b, 0xca, 0x02, 0x11, 0x5b, 0xad, 0x5f, 0x26, 0x60, 0xe1, 0xb1, 0xe2, 0x6d,
+	0xd1, 0xa0, 0xeb, 0x91, 0x1d, 0x22, 0x31, 0xf5, 0xd0, 0x47, 0x50, 0xa2, 0xc1, 0x53, 0xc6, 0x7d,
+	0x8d, 0x35, 0x8d, 0xaa, 0x51, 0x2b, 0x6d, 0x57, 0xb6, 0x62, 0x2d, 0x5b, 0x36, 0x11, 0x2c, 0xe2,
+	0x0e, 0x79, 0x22, 0x88, 0x1b, 0x3b, 0xb4, 0xf6, 0xd9, 0x89, 0x9d, 0x75, 0x41, 0x15, 0x28, 0x1d,
+	0xb0, 0x4e, 0x3b, 0x60, 0x2e, 0x69, 0x53, 0xd7, 0x9c, 0xa8, 0x1a, 0xb5, 0xa2, 0x5d, 0x3c, 0x60,
+	0x9d, 0x47, 0xcc, 0x25, 0x4d, 0x17, 0x2d, 0x41, 0x21, 0x4e, 0x97, 0xba, 0xe6, 0xa4, 0x36, 0x4e,
+	0xeb, 0x75, 0xd3, 0x45, 0x35, 0x98, 0x97, 0x4c, 0x62, 0xaf, 0xad, 0x93, 0x71, 0x58, 0x14, 0x48,
+	0x73, 0xaa, 0x6a, 0xd4, 0x66, 0xed, 0x5b, 0x7a, 0xff, 0x2b, 0x2c, 0x0e, 0x1b, 0x6a, 0x17, 0xbd,
+	0x01, 0xc8, 0x89, 0x38, 0x27, 0x81, 0xcc, 0x62, 0x73, 0x1a, 0x3b, 0x9f, 0x58, 0xfa, 0xe8, 0x4d,
+	0xc8, 0x29, 0x94, 0x30, 0xf3, 0xd5, 0xc9, 0x5a, 0x69, 0x7b, 0x21, 0x4d, 0x47, 0xa7, 0xaf, 0x60,
+	0x76, 0x6c, 0x47, 0x8b, 0x90, 0x13, 0x12, 0x4b, 0x62, 0x4e, 0x6b, 0x61, 0xf1, 0xc2, 0xfa, 0xc7,
+	0x80, 0xf9, 0x18, 0xaa, 0x44, 0xfc, 0x6f, 0x85, 0x1a, 0x95, 0xed, 0xc4, 0x0d, 0xb2, 0x9d, 0xbc,
+	0x2a, 0xdb, 0xa9, 0xeb, 0x66, 0x9b, 0xcb, 0x66, 0xfb, 0xf3, 0x24, 0x14, 0x7b, 0x50, 0x74, 0x17,
+	0xa6, 0x35, 0x25, 0x75, 0x75, 0x8a, 0x45, 0x3b, 0xaf, 0x96, 0x4d, 0x17, 0x2d, 0x43, 0x51, 0x1b,
+	0x02, 0xec, 0x93, 0xe4, 0x90, 0x0b, 0x6a, 0xe3, 0x11, 0xf6, 0x09, 0x7a, 0x17, 0x72, 0xec, 0x24,
+	0x20, 0x5c, 0x6b, 0x2c, 0x6d, 0x57, 0x53, 0x09, 0x7b, 0xbc, 0x8b, 0x03, 0xfa, 0xad, 0xce, 0xbf,
+	0xe9, 0x92, 0x40, 0x52, 0x79, 0xd6, 0x0c, 0x9e, 0x32, 0x3b, 0x86, 0xa3, 0x07, 0x30, 0x1d, 0x62,
+	0x19, 0x10, 0x9e, 0x8a, 0xbf, 0xda, 0x33, 0x75, 0x40, 0x1f, 0x42, 0x91, 0x13, 0x87, 0xd0, 0x63,
+	0xe5, 0x9d, 0xbb, 0xa6, 0x77, 0xdf, 0x05, 0xed, 0xc2, 0x2d, 0x16, 0x12, 0xae, 0x31, 0x6d, 0x87,
+	0x09, 0x69, 0xe6, 0x07, 0xc5, 0xab, 0x7a, 0xec, 0xa5, 0x88, 0x06, 0x13, 0x72, 0x87, 0x38, 0x1e,
+	0xe6, 0xc4, 0x9e, 0x65, 0xd9, 0x5d, 0xd4, 0x84, 0xb9, 0x7e, 0x20, 0x11, 0x92, 0xc0, 0x35, 0xa7,
+	0xaf, 0x19, 0xa9, 0xaf, 0xa0, 0xa5, 0xfc, 0x50, 0x19, 0x0a, 0x0e, 0x27, 0x58, 0x92, 0x8f, 0xa5,
+	0x59, 0xa8, 0x1a, 0xb5, 0x29, 0xbb, 0xb7, 0xb6, 0x9e, 0x1b, 0xb0, 0xbc, 0x4b, 0xe4, 0x70, 0x63,
+	0xda, 0x44, 0x84, 0x2c, 0x10, 0x99, 0x33, 0x30, 0x6e, 0x76, 0x06, 0x5b, 0x90, 0xd3, 0xf7, 0x51,
+	0x1f, 0x6a, 0x69, 0xdb, 0x1c, 0x6c, 0x9f, 0x0c, 0x51, 0x0c, 0xb3, 0x7e, 0x30, 0x60, 0x6d, 0x84,
+	0x8e, 0xcf, 0xa9, 0x90, 0x3d, 0x2d, 0x77, 0x20, 0xaf, 0x9a, 0x2b, 0x12, 0x5a, 0x4c, 0xce, 0x4e,
+	0x56, 0x68, 0x1e, 0x26, 0x7d, 0xd1, 0x4d, 0xda, 0x47, 0xfd, 0x44, 0x0f, 0x01, 0xe2, 0xe9, 0xe0,
+	0x51, 0xa1, 0x5a, 0x5c, 0x1d, 0xe3, 0x7a, 0x2a, 0x61, 0x4c, 0xba, 0x76, 0x51, 0xbb, 0x29, 0x56,
+	0xeb, 0x1d, 0x78, 0xe9, 0x71, 0xd4, 0xf1, 0xa8, 0xd8, 0xd7, 0x68, 0x9b, 0x1c, 0x45, 0x44, 0xc8,
+	0xe1, 0xc1, 0x64, 0x0c, 0x0d, 0x26, 0xeb, 0x7b, 0x03, 0x56, 0x52, 0x86, 0xec, 0x4c, 0xfc, 0xcf,
+	0x15, 0xad, 0x0f, 0x56, 0x74, 0x69, 0xa0, 0xa2, 0x03, 0x4c, 0x49, 0x49, 0x7f, 0x34, 0xa0, 0x3a,
+	0x4a, 0xc9, 0x0b, 0xd6, 0xb4, 0x31, 0xa2, 0xa6, 0xf7, 0x86, 0x6b, 0x3a, 0x2a, 0xe3, 0x6c, 0x51,
+	0xbf, 0x81, 0xc5, 0xc1, 0xa2, 0xde, 0x58, 0xc6, 0xe5, 0x83, 0xdf, 0xaa, 0x03, 0xb2, 0xc9, 0x31,
+	0x3b, 0x24, 0x03, 0x07, 0x96, 0x75, 0x30, 0x06, 0x1d, 0x7e, 0x35, 0xa0, 0xb0, 0xc7, 0xbb, 0x5f,
+	0x46, 0x4c, 0x62, 0xb4, 0x06, 0x25, 0x9a, 0x94, 0xbd, 0x0f, 0x85, 0x74, 0xab, 0xe9, 0xa2, 0xb7,
+	0x60, 0xd1, 0xc7, 0xa7, 0x6d, 0x87, 0x05, 0xd9, 0x31, 0x2a, 0x92, 0x69, 0x8b, 0x7c, 0x7c, 0xda,
+	0xe8, 0x99, 0xd4, 0xdd, 0x14, 0x6a, 0xba, 0x29, 0x0f, 0xe1, 0x31, 0x29, 0xb4, 0xd8, 0x29, 0xbb,
+	0xe0, 0xe3, 0xd3, 0x96, 0x5a, 0xa3, 0x7a, 0x1c, 0xce, 0xc5, 0xd4, 0x3b, 0xd3, 0x90, 0xf6, 0x3e,
+	0x8b, 0xf4, 0xc8, 0x52, 0xb8, 0x05, 0x1f, 0x9f, 0xee, 0x28, 0x93, 0x02, 0x7f, 0xa6, 0x0c, 0xd6,
+	0x77, 0x30, 0x9b, 0x8a, 0x7d, 0xa2, 0xbe, 0xdd, 0x68, 0x1d, 0x66, 0x79, 0x14, 0x04, 0x34, 0xe8,
+	0x26, 0x4a, 0x0c, 0xad, 0x64, 0x26, 0xd9, 0x8c, 0x35, 0xac, 0x02, 0x44, 0x82, 0xb8, 0x89, 0x88,
+	0x09, 0x1d, 0xbc, 0xa8, 0x76, 0x62, 0x15, 0x1b, 0x30, 0x97, 0x51, 0xe0, 0x0b, 0xe2, 0x24, 0x42,
+	0x67, 0xdd, 0x94, 0xfd, 0x0b, 0x41, 0x1c, 0xcb, 0x81, 0x99, 0x94, 0x5c, 0x7d, 0x83, 0xd0, 0x06,
+	0xe4, 0x8e, 0xd4, 0x22, 0xe9, 0xe2, 0xf9, 0x4c, 0x17, 0x6b, 0x90, 0x1d, 0x9b, 0xd1, 0xeb, 0x90,
+	0x8b, 0x94, 0xd8, 0xa4, 0x6b, 0x6f, 0x0f, 0xe3, 0x74, 0x26, 0x76, 0x8c, 0xb1, 0x4e, 0xe1, 0xee,
+	0x2e, 0x91, 0xa9, 0xe9, 0x05, 0xfb, 0xf4, 0x3e, 0x80, 0xa6, 0xce, 0xf6, 0xe9, 0xe2, 0x30, 0xad,
+	0xfe, 0x8e, 0x16, 0x8f, 0x52, 0x1a, 0xeb, 0x03, 0x40, 0xad, 0x3e, 0x73, 0xda, 0x3a, 0xd7, 0x4c,
+	0xd2, 0x7a, 0x1f, 0x6e, 0xdb, 0xc4, 0x67, 0xc7, 0x64, 0x38, 0xc0, 0x55, 0x3d, 0xb5, 0xfd, 0x47,
+	0x1e, 0x66, 0xe2, 0x8b, 0x43, 0xf8, 0x31, 0x75, 0x08, 0x7a, 0x66, 0xe8, 0x1a, 0x8c, 0x9a, 0x83,
+	0xe8, 0x4e, 0xca, 0xff, 0x89, 0x1f, 0xca, 0x33, 0x85, 0xc2, 0x1c, 0xfb, 0xa2, 0xbc, 0x39, 0x66,
+	0xb2, 0x65, 0x8b, 0x68, 0x6d, 0x3c, 0xfb, 0xf3, 0xef, 0x9f, 0x26, 0xaa, 0xd6, 0x72, 0xdd, 0xc1,
+	0x9c, 0x53, 0xc2, 0xeb, 0xc7, 0x6f, 0xc7, 0xaf, 0xc6, 0xba, 0x7e, 0x2b, 0x28, 0xf0, 0x03, 0xe3,
+	0x35, 0xf4, 0xdc, 0x00, 0xf3, 0xb2, 0xc9, 0x71, 0xa9, 0x8a, 0xda, 0xb8, 0x59, 0x30, 0x20, 0x63,
+	0x53, 0xcb, 0x78, 0xd9, 0x5a, 0xb9, 0x28, 0x43, 0x68, 0x9f, 0x54, 0x07, 0x83, 0x99, 0xec, 0xb4,
+	0x40, 0xcb, 0xbd, 0x99, 0x77, 0x71, 0x30, 0x97, 0x57, 0x46, 0x1b, 0x13, 0xce, 0x7b, 0x9a, 0xb3,
+	0x62, 0x2d, 0x5d, 0xe4, 0x0c, 0x63, 0xbc, 0x22, 0x3c, 0x80, 0x52, 0x66, 0x82, 0xa0, 0x72, 0xff,
+	0x21, 0x36, 0x3c, 0x56, 0xca, 0x3d, 0x5b, 0x8b, 0xfa, 0xa1, 0x47, 0x52, 0xa2, 0x06, 0x73, 0x89,
+	0xb5, 0xae, 0xc9, 0x56, 0x2d, 0xf3, 0x22, 0x19, 0xd7, 0x91, 0x14, 0x17, 0x87, 0xb9, 0xa1, 0x66,
+	0xbf, 0xb4, 0xb4, 0x6b, 0x99, 0xd2, 0x8e, 0xba, 0x1d, 0xe3, 0x0e, 0xb6, 0xd7, 0xe3, 0x8a, 0xd3,
+	0x83, 0x52, 0xa6, 0xcd, 0xfb, 0xf9, 0x5d, 0xec, 0xfd, 0xb1, 0xf9, 0xbd, 0xa2, 0xe9, 0xd6, 0xac,
+	0xf2, 0x88, 0x03, 0x24, 0x52, 0x87, 0x51, 0x6c, 0x11, 0xdc, 0x1a, 0xbc, 0x16, 0x68, 0xb5, 0x5f,
+	0xd0, 0x11, 0xd7, 0x65, 0x2c, 0x67, 0x4d, 0x73, 0x5a, 0xd6, 0xea, 0xa8, 0x9a, 0xaa, 0x60, 0x29,
+	0xed, 0xc3, 0xf7, 0x7e, 0x3b, 0xaf, 0x18, 0xbf, 0x9f, 0x57, 0x8c, 0xbf, 0xce, 0x2b, 0xc6, 0xd7,
+	0xaf, 0x76, 0xa9, 0xdc, 0x8f, 0x3a, 0x5b, 0x0e, 0xf3, 0xeb, 0x36, 0x13, 0x44, 0x4a, 0xfc, 0xa9,
+	0xc7, 0x4e, 0xea, 0x8d, 0x38, 0xca, 0x9b, 0xbb, 0xac, 0x9e, 0xfc, 0xf9, 0xe9, 0xe4, 0xf5, 0x5f,
+	0x9a, 0xfb, 0xff, 0x0e, 0x00, 0x23, 0x7c, 0x43, 0x36, 0x51, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -797,6 +1170,12 @@ type PowerServiceClient interface {
 	PublishPower(ctx context.Context, in *PublishPowerRequest, opts ...grpc.CallOption) (*PublishPowerResponse, error)
 	// 停用算力 (撤销算力)
 	RevokePower(ctx context.Context, in *RevokePowerRequest, opts ...grpc.CallOption) (*SimpleResponseCode, error)
+	// 查看远端组织使用本地算力的配额及使用情况列表
+	GetOrgQuotaList(ctx context.Context, in *EmptyGetParams, opts ...grpc.CallOption) (*GetOrgQuotaListResponse, error)
+	// 新增或修改远端组织使用本地算力的配额 (运行时生效)
+	SetOrgQuota(ctx context.Context, in *SetOrgQuotaRequest, opts ...grpc.CallOption) (*SimpleResponseCode, error)
+	// 删除远端组织使用本地算力的配额
+	RemoveOrgQuota(ctx context.Context, in *RemoveOrgQuotaRequest, opts ...grpc.CallOption) (*SimpleResponseCode, error)
 }
 
 type powerServiceClient struct {
@@ -843,6 +1222,33 @@ func (c *powerServiceClient) RevokePower(ctx context.Context, in *RevokePowerReq
 	return out, nil
 }
 
+func (c *powerServiceClient) GetOrgQuotaList(ctx context.Context, in *EmptyGetParams, opts ...grpc.CallOption) (*GetOrgQuotaListResponse, error) {
+	out := new(GetOrgQuotaListResponse)
+	err := c.cc.Invoke(ctx, "/rpcapi.PowerService/GetOrgQuotaList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *powerServiceClient) SetOrgQuota(ctx context.Context, in *SetOrgQuotaRequest, opts ...grpc.CallOption) (*SimpleResponseCode, error) {
+	out := new(SimpleResponseCode)
+	err := c.cc.Invoke(ctx, "/rpcapi.PowerService/SetOrgQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *powerServiceClient) RemoveOrgQuota(ctx context.Context, in *RemoveOrgQuotaRequest, opts ...grpc.CallOption) (*SimpleResponseCode, error) {
+	out := new(SimpleResponseCode)
+	err := c.cc.Invoke(ctx, "/rpcapi.PowerService/RemoveOrgQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PowerServiceServer is the server API for PowerService service.
 type PowerServiceServer interface {
 	// 查看各个节点的总算力详情列表
@@ -853,6 +1259,12 @@ type PowerServiceServer interface {
 	PublishPower(context.Context, *PublishPowerRequest) (*PublishPowerResponse, error)
 	// 停用算力 (撤销算力)
 	RevokePower(context.Context, *RevokePowerRequest) (*SimpleResponseCode, error)
+	// 查看远端组织使用本地算力的配额及使用情况列表
+	GetOrgQuotaList(context.Context, *EmptyGetParams) (*GetOrgQuotaListResponse, error)
+	// 新增或修改远端组织使用本地算力的配额 (运行时生效)
+	SetOrgQuota(context.Context, *SetOrgQuotaRequest) (*SimpleResponseCode, error)
+	// 删除远端组织使用本地算力的配额
+	RemoveOrgQuota(context.Context, *RemoveOrgQuotaRequest) (*SimpleResponseCode, error)
 }
 
 // UnimplementedPowerServiceServer can be embedded to have forward compatible implementations.
@@ -871,6 +1283,15 @@ func (*UnimplementedPowerServiceServer) PublishPower(ctx context.Context, req *P
 func (*UnimplementedPowerServiceServer) RevokePower(ctx context.Context, req *RevokePowerRequest) (*SimpleResponseCode, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RevokePower not implemented")
 }
+func (*UnimplementedPowerServiceServer) GetOrgQuotaList(ctx context.Context, req *EmptyGetParams) (*GetOrgQuotaListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrgQuotaList not implemented")
+}
+func (*UnimplementedPowerServiceServer) SetOrgQuota(ctx context.Context, req *SetOrgQuotaRequest) (*SimpleResponseCode, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOrgQuota not implemented")
+}
+func (*UnimplementedPowerServiceServer) RemoveOrgQuota(ctx context.Context, req *RemoveOrgQuotaRequest) (*SimpleResponseCode, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveOrgQuota not implemented")
+}
 
 func RegisterPowerServiceServer(s *grpc.Server, srv PowerServiceServer) {
 	s.RegisterService(&_PowerService_serviceDesc, srv)
@@ -948,32 +1369,98 @@ func _PowerService_RevokePower_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
-var _PowerService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "rpcapi.PowerService",
-	HandlerType: (*PowerServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetPowerTotalDetailList",
-			Handler:    _PowerService_GetPowerTotalDetailList_Handler,
-		},
-		{
-			MethodName: "GetPowerSingleDetailList",
-			Handler:    _PowerService_GetPowerSingleDetailList_Handler,
-		},
-		{
-			MethodName: "PublishPower",
-			Handler:    _PowerService_PublishPower_Handler,
-		},
-		{
-			MethodName: "RevokePower",
-			Handler:    _PowerService_RevokePower_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "lib/api/power_rpc_api.proto",
-}
-
-func (m *PowerSingleDetail) Marshal() (dAtA []byte, err error) {
+func _PowerService_GetOrgQuotaList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyGetParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PowerServiceServer).GetOrgQuotaList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcapi.PowerService/GetOrgQuotaList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PowerServiceServer).GetOrgQuotaList(ctx, req.(*EmptyGetParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PowerService_SetOrgQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetOrgQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PowerServiceServer).SetOrgQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcapi.PowerService/SetOrgQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PowerServiceServer).SetOrgQuota(ctx, req.(*SetOrgQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PowerService_RemoveOrgQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveOrgQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PowerServiceServer).RemoveOrgQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcapi.PowerService/RemoveOrgQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PowerServiceServer).RemoveOrgQuota(ctx, req.(*RemoveOrgQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _PowerService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "rpcapi.PowerService",
+	HandlerType: (*PowerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetPowerTotalDetailList",
+			Handler:    _PowerService_GetPowerTotalDetailList_Handler,
+		},
+		{
+			MethodName: "GetPowerSingleDetailList",
+			Handler:    _PowerService_GetPowerSingleDetailList_Handler,
+		},
+		{
+			MethodName: "PublishPower",
+			Handler:    _PowerService_PublishPower_Handler,
+		},
+		{
+			MethodName: "RevokePower",
+			Handler:    _PowerService_RevokePower_Handler,
+		},
+		{
+			MethodName: "GetOrgQuotaList",
+			Handler:    _PowerService_GetOrgQuotaList_Handler,
+		},
+		{
+			MethodName: "SetOrgQuota",
+			Handler:    _PowerService_SetOrgQuota_Handler,
+		},
+		{
+			MethodName: "RemoveOrgQuota",
+			Handler:    _PowerService_RemoveOrgQuota_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "lib/api/power_rpc_api.proto",
+}
+
+func (m *PowerSingleDetail) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1559,137 +2046,405 @@ func (m *RevokePowerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintPowerRpcApi(dAtA []byte, offset int, v uint64) int {
-	offset -= sovPowerRpcApi(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *OrgQuota) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *PowerSingleDetail) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *OrgQuota) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrgQuota) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Information != nil {
-		l = m.Information.Size()
-		n += 1 + l + sovPowerRpcApi(uint64(l))
-	}
-	l = len(m.JobNodeId)
-	if l > 0 {
-		n += 1 + l + sovPowerRpcApi(uint64(l))
-	}
-	l = len(m.PowerId)
-	if l > 0 {
-		n += 1 + l + sovPowerRpcApi(uint64(l))
-	}
-	if m.TotalTaskCount != 0 {
-		n += 1 + sovPowerRpcApi(uint64(m.TotalTaskCount))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.CurrentTaskCount != 0 {
-		n += 1 + sovPowerRpcApi(uint64(m.CurrentTaskCount))
+	if m.MaxDailySlotHours != 0 {
+		i = encodeVarintPowerRpcApi(dAtA, i, uint64(m.MaxDailySlotHours))
+		i--
+		dAtA[i] = 0x20
 	}
-	if len(m.Tasks) > 0 {
-		for _, e := range m.Tasks {
-			l = e.Size()
-			n += 1 + l + sovPowerRpcApi(uint64(l))
-		}
+	if m.MaxSlots != 0 {
+		i = encodeVarintPowerRpcApi(dAtA, i, uint64(m.MaxSlots))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.State)
-	if l > 0 {
-		n += 1 + l + sovPowerRpcApi(uint64(l))
+	if m.MaxConcurrentTasks != 0 {
+		i = encodeVarintPowerRpcApi(dAtA, i, uint64(m.MaxConcurrentTasks))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if len(m.IdentityId) > 0 {
+		i -= len(m.IdentityId)
+		copy(dAtA[i:], m.IdentityId)
+		i = encodeVarintPowerRpcApi(dAtA, i, uint64(len(m.IdentityId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PowerTotalDetail) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *OrgQuotaUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *OrgQuotaUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrgQuotaUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Information != nil {
-		l = m.Information.Size()
-		n += 1 + l + sovPowerRpcApi(uint64(l))
-	}
-	if m.TotalTaskCount != 0 {
-		n += 1 + sovPowerRpcApi(uint64(m.TotalTaskCount))
-	}
-	if m.CurrentTaskCount != 0 {
-		n += 1 + sovPowerRpcApi(uint64(m.CurrentTaskCount))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Tasks) > 0 {
-		for _, e := range m.Tasks {
-			l = e.Size()
-			n += 1 + l + sovPowerRpcApi(uint64(l))
-		}
+	if m.DailySlotMsec != 0 {
+		i = encodeVarintPowerRpcApi(dAtA, i, uint64(m.DailySlotMsec))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.State)
-	if l > 0 {
-		n += 1 + l + sovPowerRpcApi(uint64(l))
+	if m.UsedSlots != 0 {
+		i = encodeVarintPowerRpcApi(dAtA, i, uint64(m.UsedSlots))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.RunningTasks != 0 {
+		i = encodeVarintPowerRpcApi(dAtA, i, uint64(m.RunningTasks))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *PowerTask) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *OrgQuotaShow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *OrgQuotaShow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OrgQuotaShow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.TaskId)
-	if l > 0 {
-		n += 1 + l + sovPowerRpcApi(uint64(l))
-	}
-	l = len(m.TaskName)
-	if l > 0 {
-		n += 1 + l + sovPowerRpcApi(uint64(l))
-	}
-	if m.Owner != nil {
-		l = m.Owner.Size()
-		n += 1 + l + sovPowerRpcApi(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.Patners) > 0 {
-		for _, e := range m.Patners {
-			l = e.Size()
-			n += 1 + l + sovPowerRpcApi(uint64(l))
+	if m.Usage != nil {
+		{
+			size, err := m.Usage.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPowerRpcApi(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Receivers) > 0 {
-		for _, e := range m.Receivers {
-			l = e.Size()
-			n += 1 + l + sovPowerRpcApi(uint64(l))
+	if m.Quota != nil {
+		{
+			size, err := m.Quota.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPowerRpcApi(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.OperationCost != nil {
-		l = m.OperationCost.Size()
-		n += 1 + l + sovPowerRpcApi(uint64(l))
-	}
-	if m.OperationSpend != nil {
-		l = m.OperationSpend.Size()
-		n += 1 + l + sovPowerRpcApi(uint64(l))
-	}
-	if m.CreateAt != 0 {
-		n += 1 + sovPowerRpcApi(uint64(m.CreateAt))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *GetPowerTotalDetailResponse) Size() (n int) {
+func (m *GetOrgQuotaListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetOrgQuotaListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetOrgQuotaListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.QuotaList) > 0 {
+		for iNdEx := len(m.QuotaList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QuotaList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPowerRpcApi(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintPowerRpcApi(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintPowerRpcApi(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SetOrgQuotaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SetOrgQuotaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SetOrgQuotaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Quota != nil {
+		{
+			size, err := m.Quota.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPowerRpcApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoveOrgQuotaRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveOrgQuotaRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveOrgQuotaRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.IdentityId) > 0 {
+		i -= len(m.IdentityId)
+		copy(dAtA[i:], m.IdentityId)
+		i = encodeVarintPowerRpcApi(dAtA, i, uint64(len(m.IdentityId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPowerRpcApi(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPowerRpcApi(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PowerSingleDetail) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Information != nil {
+		l = m.Information.Size()
+		n += 1 + l + sovPowerRpcApi(uint64(l))
+	}
+	l = len(m.JobNodeId)
+	if l > 0 {
+		n += 1 + l + sovPowerRpcApi(uint64(l))
+	}
+	l = len(m.PowerId)
+	if l > 0 {
+		n += 1 + l + sovPowerRpcApi(uint64(l))
+	}
+	if m.TotalTaskCount != 0 {
+		n += 1 + sovPowerRpcApi(uint64(m.TotalTaskCount))
+	}
+	if m.CurrentTaskCount != 0 {
+		n += 1 + sovPowerRpcApi(uint64(m.CurrentTaskCount))
+	}
+	if len(m.Tasks) > 0 {
+		for _, e := range m.Tasks {
+			l = e.Size()
+			n += 1 + l + sovPowerRpcApi(uint64(l))
+		}
+	}
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovPowerRpcApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PowerTotalDetail) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Information != nil {
+		l = m.Information.Size()
+		n += 1 + l + sovPowerRpcApi(uint64(l))
+	}
+	if m.TotalTaskCount != 0 {
+		n += 1 + sovPowerRpcApi(uint64(m.TotalTaskCount))
+	}
+	if m.CurrentTaskCount != 0 {
+		n += 1 + sovPowerRpcApi(uint64(m.CurrentTaskCount))
+	}
+	if len(m.Tasks) > 0 {
+		for _, e := range m.Tasks {
+			l = e.Size()
+			n += 1 + l + sovPowerRpcApi(uint64(l))
+		}
+	}
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovPowerRpcApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PowerTask) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovPowerRpcApi(uint64(l))
+	}
+	l = len(m.TaskName)
+	if l > 0 {
+		n += 1 + l + sovPowerRpcApi(uint64(l))
+	}
+	if m.Owner != nil {
+		l = m.Owner.Size()
+		n += 1 + l + sovPowerRpcApi(uint64(l))
+	}
+	if len(m.Patners) > 0 {
+		for _, e := range m.Patners {
+			l = e.Size()
+			n += 1 + l + sovPowerRpcApi(uint64(l))
+		}
+	}
+	if len(m.Receivers) > 0 {
+		for _, e := range m.Receivers {
+			l = e.Size()
+			n += 1 + l + sovPowerRpcApi(uint64(l))
+		}
+	}
+	if m.OperationCost != nil {
+		l = m.OperationCost.Size()
+		n += 1 + l + sovPowerRpcApi(uint64(l))
+	}
+	if m.OperationSpend != nil {
+		l = m.OperationSpend.Size()
+		n += 1 + l + sovPowerRpcApi(uint64(l))
+	}
+	if m.CreateAt != 0 {
+		n += 1 + sovPowerRpcApi(uint64(m.CreateAt))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetPowerTotalDetailResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1834,13 +2589,1015 @@ func (m *RevokePowerRequest) Size() (n int) {
 	return n
 }
 
-func sovPowerRpcApi(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *OrgQuota) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IdentityId)
+	if l > 0 {
+		n += 1 + l + sovPowerRpcApi(uint64(l))
+	}
+	if m.MaxConcurrentTasks != 0 {
+		n += 1 + sovPowerRpcApi(uint64(m.MaxConcurrentTasks))
+	}
+	if m.MaxSlots != 0 {
+		n += 1 + sovPowerRpcApi(uint64(m.MaxSlots))
+	}
+	if m.MaxDailySlotHours != 0 {
+		n += 1 + sovPowerRpcApi(uint64(m.MaxDailySlotHours))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *OrgQuotaUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RunningTasks != 0 {
+		n += 1 + sovPowerRpcApi(uint64(m.RunningTasks))
+	}
+	if m.UsedSlots != 0 {
+		n += 1 + sovPowerRpcApi(uint64(m.UsedSlots))
+	}
+	if m.DailySlotMsec != 0 {
+		n += 1 + sovPowerRpcApi(uint64(m.DailySlotMsec))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *OrgQuotaShow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Quota != nil {
+		l = m.Quota.Size()
+		n += 1 + l + sovPowerRpcApi(uint64(l))
+	}
+	if m.Usage != nil {
+		l = m.Usage.Size()
+		n += 1 + l + sovPowerRpcApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetOrgQuotaListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovPowerRpcApi(uint64(m.Status))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovPowerRpcApi(uint64(l))
+	}
+	if len(m.QuotaList) > 0 {
+		for _, e := range m.QuotaList {
+			l = e.Size()
+			n += 1 + l + sovPowerRpcApi(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SetOrgQuotaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Quota != nil {
+		l = m.Quota.Size()
+		n += 1 + l + sovPowerRpcApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *RemoveOrgQuotaRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IdentityId)
+	if l > 0 {
+		n += 1 + l + sovPowerRpcApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovPowerRpcApi(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozPowerRpcApi(x uint64) (n int) {
 	return sovPowerRpcApi(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *PowerSingleDetail) Unmarshal(dAtA []byte) error {
+func (m *PowerSingleDetail) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPowerRpcApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PowerSingleDetail: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PowerSingleDetail: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Information", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPowerRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPowerRpcApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPowerRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Information == nil {
+				m.Information = &ResourceUsedDetailShow{}
+			}
+			if err := m.Information.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobNodeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPowerRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPowerRpcApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPowerRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobNodeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPowerRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPowerRpcApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPowerRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PowerId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalTaskCount", wireType)
+			}
+			m.TotalTaskCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPowerRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalTaskCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentTaskCount", wireType)
+			}
+			m.CurrentTaskCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPowerRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentTaskCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPowerRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPowerRpcApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPowerRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tasks = append(m.Tasks, &PowerTask{})
+			if err := m.Tasks[len(m.Tasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPowerRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPowerRpcApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPowerRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.State = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPowerRpcApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPowerRpcApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PowerTotalDetail) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPowerRpcApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PowerTotalDetail: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PowerTotalDetail: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Information", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPowerRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPowerRpcApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPowerRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Information == nil {
+				m.Information = &ResourceUsedDetailShow{}
+			}
+			if err := m.Information.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalTaskCount", wireType)
+			}
+			m.TotalTaskCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPowerRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalTaskCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentTaskCount", wireType)
+			}
+			m.CurrentTaskCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPowerRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentTaskCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPowerRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPowerRpcApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPowerRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tasks = append(m.Tasks, &PowerTask{})
+			if err := m.Tasks[len(m.Tasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPowerRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPowerRpcApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPowerRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.State = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPowerRpcApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPowerRpcApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PowerTask) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPowerRpcApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PowerTask: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PowerTask: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPowerRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPowerRpcApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPowerRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPowerRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPowerRpcApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPowerRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPowerRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPowerRpcApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPowerRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Owner == nil {
+				m.Owner = &OrganizationIdentityInfo{}
+			}
+			if err := m.Owner.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Patners", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPowerRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPowerRpcApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPowerRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Patners = append(m.Patners, &OrganizationIdentityInfo{})
+			if err := m.Patners[len(m.Patners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receivers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPowerRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPowerRpcApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPowerRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receivers = append(m.Receivers, &OrganizationIdentityInfo{})
+			if err := m.Receivers[len(m.Receivers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationCost", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPowerRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPowerRpcApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPowerRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OperationCost == nil {
+				m.OperationCost = &TaskOperationCostDeclare{}
+			}
+			if err := m.OperationCost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationSpend", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPowerRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPowerRpcApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPowerRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OperationSpend == nil {
+				m.OperationSpend = &TaskOperationCostDeclare{}
+			}
+			if err := m.OperationSpend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateAt", wireType)
+			}
+			m.CreateAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPowerRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreateAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPowerRpcApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPowerRpcApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetPowerTotalDetailResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPowerRpcApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetPowerTotalDetailResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetPowerTotalDetailResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPowerRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPowerRpcApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPowerRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Owner == nil {
+				m.Owner = &OrganizationIdentityInfo{}
+			}
+			if err := m.Owner.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPowerRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPowerRpcApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPowerRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Power == nil {
+				m.Power = &PowerTotalDetail{}
+			}
+			if err := m.Power.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPowerRpcApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPowerRpcApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetPowerTotalDetailListResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1863,17 +3620,17 @@ func (m *PowerSingleDetail) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PowerSingleDetail: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetPowerTotalDetailListResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PowerSingleDetail: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetPowerTotalDetailListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Information", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var msglen int
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPowerRpcApi
@@ -1883,31 +3640,14 @@ func (m *PowerSingleDetail) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Status |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPowerRpcApi
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPowerRpcApi
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Information == nil {
-				m.Information = &ResourceUsedDetailShow{}
-			}
-			if err := m.Information.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobNodeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1935,13 +3675,13 @@ func (m *PowerSingleDetail) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.JobNodeId = string(dAtA[iNdEx:postIndex])
+			m.Msg = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PowerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerList", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPowerRpcApi
@@ -1951,99 +3691,80 @@ func (m *PowerSingleDetail) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPowerRpcApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPowerRpcApi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PowerId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalTaskCount", wireType)
-			}
-			m.TotalTaskCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPowerRpcApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TotalTaskCount |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentTaskCount", wireType)
-			}
-			m.CurrentTaskCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPowerRpcApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CurrentTaskCount |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
+			m.PowerList = append(m.PowerList, &GetPowerTotalDetailResponse{})
+			if err := m.PowerList[len(m.PowerList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPowerRpcApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPowerRpcApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPowerRpcApi
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPowerRpcApi
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PublishPowerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPowerRpcApi
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tasks = append(m.Tasks, &PowerTask{})
-			if err := m.Tasks[len(m.Tasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 7:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PublishPowerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PublishPowerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobNodeId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2071,7 +3792,7 @@ func (m *PowerSingleDetail) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.State = string(dAtA[iNdEx:postIndex])
+			m.JobNodeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2095,7 +3816,7 @@ func (m *PowerSingleDetail) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PowerTotalDetail) Unmarshal(dAtA []byte) error {
+func (m *GetPowerSingleDetailResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2118,15 +3839,15 @@ func (m *PowerTotalDetail) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PowerTotalDetail: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetPowerSingleDetailResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PowerTotalDetail: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetPowerSingleDetailResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Information", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2153,54 +3874,16 @@ func (m *PowerTotalDetail) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Information == nil {
-				m.Information = &ResourceUsedDetailShow{}
+			if m.Owner == nil {
+				m.Owner = &OrganizationIdentityInfo{}
 			}
-			if err := m.Information.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Owner.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalTaskCount", wireType)
-			}
-			m.TotalTaskCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPowerRpcApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TotalTaskCount |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentTaskCount", wireType)
-			}
-			m.CurrentTaskCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPowerRpcApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CurrentTaskCount |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2227,42 +3910,12 @@ func (m *PowerTotalDetail) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tasks = append(m.Tasks, &PowerTask{})
-			if err := m.Tasks[len(m.Tasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPowerRpcApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPowerRpcApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPowerRpcApi
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.State = string(dAtA[iNdEx:postIndex])
+			if m.Power == nil {
+				m.Power = &PowerSingleDetail{}
+			}
+			if err := m.Power.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2286,7 +3939,7 @@ func (m *PowerTotalDetail) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PowerTask) Unmarshal(dAtA []byte) error {
+func (m *GetPowerSingleDetailListResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2309,17 +3962,17 @@ func (m *PowerTask) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PowerTask: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetPowerSingleDetailListResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PowerTask: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetPowerSingleDetailListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPowerRpcApi
@@ -2329,27 +3982,14 @@ func (m *PowerTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Status |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPowerRpcApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPowerRpcApi
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TaskId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2377,11 +4017,11 @@ func (m *PowerTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaskName = string(dAtA[iNdEx:postIndex])
+			m.Msg = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2408,52 +4048,67 @@ func (m *PowerTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Owner == nil {
-				m.Owner = &OrganizationIdentityInfo{}
-			}
-			if err := m.Owner.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.PowerList = append(m.PowerList, &GetPowerSingleDetailResponse{})
+			if err := m.PowerList[len(m.PowerList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Patners", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPowerRpcApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPowerRpcApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthPowerRpcApi
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPowerRpcApi
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PublishPowerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPowerRpcApi
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Patners = append(m.Patners, &OrganizationIdentityInfo{})
-			if err := m.Patners[len(m.Patners)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receivers", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PublishPowerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PublishPowerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var msglen int
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPowerRpcApi
@@ -2463,31 +4118,16 @@ func (m *PowerTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Status |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPowerRpcApi
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPowerRpcApi
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Receivers = append(m.Receivers, &OrganizationIdentityInfo{})
-			if err := m.Receivers[len(m.Receivers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperationCost", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPowerRpcApi
@@ -2497,33 +4137,29 @@ func (m *PowerTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPowerRpcApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPowerRpcApi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OperationCost == nil {
-				m.OperationCost = &TaskOperationCostDeclare{}
-			}
-			if err := m.OperationCost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperationSpend", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPowerRpcApi
@@ -2533,47 +4169,24 @@ func (m *PowerTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPowerRpcApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPowerRpcApi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OperationSpend == nil {
-				m.OperationSpend = &TaskOperationCostDeclare{}
-			}
-			if err := m.OperationSpend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PowerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateAt", wireType)
-			}
-			m.CreateAt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPowerRpcApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CreateAt |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPowerRpcApi(dAtA[iNdEx:])
@@ -2595,77 +4208,41 @@ func (m *PowerTask) Unmarshal(dAtA []byte) error {
 		return io.ErrUnexpectedEOF
 	}
 	return nil
-}
-func (m *GetPowerTotalDetailResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPowerRpcApi
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetPowerTotalDetailResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetPowerTotalDetailResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPowerRpcApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthPowerRpcApi
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPowerRpcApi
+}
+func (m *RevokePowerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPowerRpcApi
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Owner == nil {
-				m.Owner = &OrganizationIdentityInfo{}
-			}
-			if err := m.Owner.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 2:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RevokePowerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RevokePowerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPowerRpcApi
@@ -2675,27 +4252,23 @@ func (m *GetPowerTotalDetailResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthPowerRpcApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthPowerRpcApi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Power == nil {
-				m.Power = &PowerTotalDetail{}
-			}
-			if err := m.Power.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PowerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2719,7 +4292,7 @@ func (m *GetPowerTotalDetailResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetPowerTotalDetailListResponse) Unmarshal(dAtA []byte) error {
+func (m *OrgQuota) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2742,34 +4315,15 @@ func (m *GetPowerTotalDetailListResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetPowerTotalDetailListResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: OrgQuota: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetPowerTotalDetailListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OrgQuota: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPowerRpcApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IdentityId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2797,13 +4351,13 @@ func (m *GetPowerTotalDetailListResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Msg = string(dAtA[iNdEx:postIndex])
+			m.IdentityId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PowerList", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxConcurrentTasks", wireType)
 			}
-			var msglen int
+			m.MaxConcurrentTasks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPowerRpcApi
@@ -2813,26 +4367,49 @@ func (m *GetPowerTotalDetailListResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.MaxConcurrentTasks |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthPowerRpcApi
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSlots", wireType)
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthPowerRpcApi
+			m.MaxSlots = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPowerRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSlots |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDailySlotHours", wireType)
 			}
-			m.PowerList = append(m.PowerList, &GetPowerTotalDetailResponse{})
-			if err := m.PowerList[len(m.PowerList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.MaxDailySlotHours = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPowerRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDailySlotHours |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPowerRpcApi(dAtA[iNdEx:])
@@ -2855,7 +4432,7 @@ func (m *GetPowerTotalDetailListResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PublishPowerRequest) Unmarshal(dAtA []byte) error {
+func (m *OrgQuotaUsage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2878,17 +4455,17 @@ func (m *PublishPowerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PublishPowerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: OrgQuotaUsage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PublishPowerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OrgQuotaUsage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field JobNodeId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RunningTasks", wireType)
 			}
-			var stringLen uint64
+			m.RunningTasks = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPowerRpcApi
@@ -2898,24 +4475,49 @@ func (m *PublishPowerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.RunningTasks |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPowerRpcApi
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsedSlots", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPowerRpcApi
+			m.UsedSlots = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPowerRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UsedSlots |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DailySlotMsec", wireType)
+			}
+			m.DailySlotMsec = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPowerRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DailySlotMsec |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.JobNodeId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPowerRpcApi(dAtA[iNdEx:])
@@ -2938,7 +4540,7 @@ func (m *PublishPowerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetPowerSingleDetailResponse) Unmarshal(dAtA []byte) error {
+func (m *OrgQuotaShow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2961,15 +4563,15 @@ func (m *GetPowerSingleDetailResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetPowerSingleDetailResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: OrgQuotaShow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetPowerSingleDetailResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OrgQuotaShow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2996,16 +4598,16 @@ func (m *GetPowerSingleDetailResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Owner == nil {
-				m.Owner = &OrganizationIdentityInfo{}
+			if m.Quota == nil {
+				m.Quota = &OrgQuota{}
 			}
-			if err := m.Owner.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Quota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3032,10 +4634,10 @@ func (m *GetPowerSingleDetailResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Power == nil {
-				m.Power = &PowerSingleDetail{}
+			if m.Usage == nil {
+				m.Usage = &OrgQuotaUsage{}
 			}
-			if err := m.Power.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Usage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3061,7 +4663,7 @@ func (m *GetPowerSingleDetailResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetPowerSingleDetailListResponse) Unmarshal(dAtA []byte) error {
+func (m *GetOrgQuotaListResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3084,10 +4686,10 @@ func (m *GetPowerSingleDetailListResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetPowerSingleDetailListResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetOrgQuotaListResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetPowerSingleDetailListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetOrgQuotaListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3143,7 +4745,7 @@ func (m *GetPowerSingleDetailListResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PowerList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QuotaList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3170,8 +4772,8 @@ func (m *GetPowerSingleDetailListResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PowerList = append(m.PowerList, &GetPowerSingleDetailResponse{})
-			if err := m.PowerList[len(m.PowerList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.QuotaList = append(m.QuotaList, &OrgQuotaShow{})
+			if err := m.QuotaList[len(m.QuotaList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3197,7 +4799,7 @@ func (m *GetPowerSingleDetailListResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PublishPowerResponse) Unmarshal(dAtA []byte) error {
+func (m *SetOrgQuotaRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3220,36 +4822,17 @@ func (m *PublishPowerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PublishPowerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetOrgQuotaRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PublishPowerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetOrgQuotaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPowerRpcApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPowerRpcApi
@@ -3259,55 +4842,27 @@ func (m *PublishPowerResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthPowerRpcApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthPowerRpcApi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Msg = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PowerId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPowerRpcApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthPowerRpcApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthPowerRpcApi
+			if m.Quota == nil {
+				m.Quota = &OrgQuota{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Quota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.PowerId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3331,7 +4886,7 @@ func (m *PublishPowerResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RevokePowerRequest) Unmarshal(dAtA []byte) error {
+func (m *RemoveOrgQuotaRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3354,15 +4909,15 @@ func (m *RevokePowerRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RevokePowerRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RemoveOrgQuotaRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RevokePowerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RemoveOrgQuotaRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PowerId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IdentityId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3390,7 +4945,7 @@ func (m *RevokePowerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PowerId = string(dAtA[iNdEx:postIndex])
+			m.IdentityId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -491,6 +491,7 @@ type YarnRegisteredJobNode struct {
 	Information          *ResourceUsedDetailShow       `protobuf:"bytes,7,opt,name=information,proto3" json:"information,omitempty"`
 	Duration             uint64                        `protobuf:"varint,8,opt,name=duration,proto3" json:"duration,omitempty"`
 	Task                 *YarnRegisteredJobNodeTaskIds `protobuf:"bytes,9,opt,name=task,proto3" json:"task,omitempty"`
+	Health               *YarnRegisteredNodeHealth     `protobuf:"bytes,10,opt,name=health,proto3" json:"health,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
 	XXX_unrecognized     []byte                        `json:"-"`
 	XXX_sizecache        int32                         `json:"-"`
@@ -585,6 +586,13 @@ func (m *YarnRegisteredJobNode) GetTask() *YarnRegisteredJobNodeTaskIds {
 	return nil
 }
 
+func (m *YarnRegisteredJobNode) GetHealth() *YarnRegisteredNodeHealth {
+	if m != nil {
+		return m.Health
+	}
+	return nil
+}
+
 type YarnRegisteredJobNodeTaskIds struct {
 	Count                uint32   `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
 	TaskIds              []string `protobuf:"bytes,2,rep,name=task_ids,json=taskIds,proto3" json:"task_ids,omitempty"`
@@ -649,6 +657,7 @@ type YarnRegisteredDataNode struct {
 	Information          *ResourceUsedDetailShow      `protobuf:"bytes,7,opt,name=information,proto3" json:"information,omitempty"`
 	Duration             uint64                       `protobuf:"varint,8,opt,name=duration,proto3" json:"duration,omitempty"`
 	Delta                *YarnRegisteredDataNodeDelta `protobuf:"bytes,9,opt,name=delta,proto3" json:"delta,omitempty"`
+	Health               *YarnRegisteredNodeHealth    `protobuf:"bytes,10,opt,name=health,proto3" json:"health,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
 	XXX_unrecognized     []byte                       `json:"-"`
 	XXX_sizecache        int32                        `json:"-"`
@@ -743,6 +752,148 @@ func (m *YarnRegisteredDataNode) GetDelta() *YarnRegisteredDataNodeDelta {
 	return nil
 }
 
+func (m *YarnRegisteredDataNode) GetHealth() *YarnRegisteredNodeHealth {
+	if m != nil {
+		return m.Health
+	}
+	return nil
+}
+
+// 计算or数据服务的健康状况 (由调度服务定期调用 GetStatus 探测)
+type YarnRegisteredNodeHealth struct {
+	State                string   `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	Latency              uint64   `protobuf:"varint,2,opt,name=latency,proto3" json:"latency,omitempty"`
+	FailureCount         uint32   `protobuf:"varint,3,opt,name=failure_count,json=failureCount,proto3" json:"failure_count,omitempty"`
+	LastCheckAt          uint64   `protobuf:"varint,4,opt,name=last_check_at,json=lastCheckAt,proto3" json:"last_check_at,omitempty"`
+	LastError            string   `protobuf:"bytes,5,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *YarnRegisteredNodeHealth) Reset()         { *m = YarnRegisteredNodeHealth{} }
+func (m *YarnRegisteredNodeHealth) String() string { return proto.CompactTextString(m) }
+func (*YarnRegisteredNodeHealth) ProtoMessage()    {}
+func (*YarnRegisteredNodeHealth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9da989a22daaf207, []int{8}
+}
+func (m *YarnRegisteredNodeHealth) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *YarnRegisteredNodeHealth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_YarnRegisteredNodeHealth.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *YarnRegisteredNodeHealth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_YarnRegisteredNodeHealth.Merge(m, src)
+}
+func (m *YarnRegisteredNodeHealth) XXX_Size() int {
+	return m.Size()
+}
+func (m *YarnRegisteredNodeHealth) XXX_DiscardUnknown() {
+	xxx_messageInfo_YarnRegisteredNodeHealth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_YarnRegisteredNodeHealth proto.InternalMessageInfo
+
+func (m *YarnRegisteredNodeHealth) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *YarnRegisteredNodeHealth) GetLatency() uint64 {
+	if m != nil {
+		return m.Latency
+	}
+	return 0
+}
+
+func (m *YarnRegisteredNodeHealth) GetFailureCount() uint32 {
+	if m != nil {
+		return m.FailureCount
+	}
+	return 0
+}
+
+func (m *YarnRegisteredNodeHealth) GetLastCheckAt() uint64 {
+	if m != nil {
+		return m.LastCheckAt
+	}
+	return 0
+}
+
+func (m *YarnRegisteredNodeHealth) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+type DrainRegisteredNodeRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Drained              bool     `protobuf:"varint,2,opt,name=drained,proto3" json:"drained,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DrainRegisteredNodeRequest) Reset()         { *m = DrainRegisteredNodeRequest{} }
+func (m *DrainRegisteredNodeRequest) String() string { return proto.CompactTextString(m) }
+func (*DrainRegisteredNodeRequest) ProtoMessage()    {}
+func (*DrainRegisteredNodeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9da989a22daaf207, []int{9}
+}
+func (m *DrainRegisteredNodeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DrainRegisteredNodeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DrainRegisteredNodeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DrainRegisteredNodeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DrainRegisteredNodeRequest.Merge(m, src)
+}
+func (m *DrainRegisteredNodeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *DrainRegisteredNodeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DrainRegisteredNodeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DrainRegisteredNodeRequest proto.InternalMessageInfo
+
+func (m *DrainRegisteredNodeRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *DrainRegisteredNodeRequest) GetDrained() bool {
+	if m != nil {
+		return m.Drained
+	}
+	return false
+}
+
 type YarnRegisteredDataNodeDelta struct {
 	FileCount            uint32   `protobuf:"varint,1,opt,name=fileCount,proto3" json:"fileCount,omitempty"`
 	FileTotalSize        uint32   `protobuf:"varint,2,opt,name=fileTotalSize,proto3" json:"fileTotalSize,omitempty"`
@@ -755,7 +906,7 @@ func (m *YarnRegisteredDataNodeDelta) Reset()         { *m = YarnRegisteredDataN
 func (m *YarnRegisteredDataNodeDelta) String() string { return proto.CompactTextString(m) }
 func (*YarnRegisteredDataNodeDelta) ProtoMessage()    {}
 func (*YarnRegisteredDataNodeDelta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9da989a22daaf207, []int{8}
+	return fileDescriptor_9da989a22daaf207, []int{10}
 }
 func (m *YarnRegisteredDataNodeDelta) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -811,7 +962,7 @@ func (m *GetNodeInfoResponse) Reset()         { *m = GetNodeInfoResponse{} }
 func (m *GetNodeInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*GetNodeInfoResponse) ProtoMessage()    {}
 func (*GetNodeInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9da989a22daaf207, []int{9}
+	return fileDescriptor_9da989a22daaf207, []int{11}
 }
 func (m *GetNodeInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -875,7 +1026,7 @@ func (m *GetRegisteredPeersResponse) Reset()         { *m = GetRegisteredPeersRe
 func (m *GetRegisteredPeersResponse) String() string { return proto.CompactTextString(m) }
 func (*GetRegisteredPeersResponse) ProtoMessage()    {}
 func (*GetRegisteredPeersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9da989a22daaf207, []int{10}
+	return fileDescriptor_9da989a22daaf207, []int{12}
 }
 func (m *GetRegisteredPeersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -944,7 +1095,7 @@ func (m *SetSeedNodeRequest) Reset()         { *m = SetSeedNodeRequest{} }
 func (m *SetSeedNodeRequest) String() string { return proto.CompactTextString(m) }
 func (*SetSeedNodeRequest) ProtoMessage()    {}
 func (*SetSeedNodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9da989a22daaf207, []int{11}
+	return fileDescriptor_9da989a22daaf207, []int{13}
 }
 func (m *SetSeedNodeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1000,7 +1151,7 @@ func (m *SetSeedNodeResponse) Reset()         { *m = SetSeedNodeResponse{} }
 func (m *SetSeedNodeResponse) String() string { return proto.CompactTextString(m) }
 func (*SetSeedNodeResponse) ProtoMessage()    {}
 func (*SetSeedNodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9da989a22daaf207, []int{12}
+	return fileDescriptor_9da989a22daaf207, []int{14}
 }
 func (m *SetSeedNodeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1063,7 +1214,7 @@ func (m *UpdateSeedNodeRequest) Reset()         { *m = UpdateSeedNodeRequest{} }
 func (m *UpdateSeedNodeRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateSeedNodeRequest) ProtoMessage()    {}
 func (*UpdateSeedNodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9da989a22daaf207, []int{13}
+	return fileDescriptor_9da989a22daaf207, []int{15}
 }
 func (m *UpdateSeedNodeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1126,7 +1277,7 @@ func (m *GetSeedNodeListResponse) Reset()         { *m = GetSeedNodeListResponse
 func (m *GetSeedNodeListResponse) String() string { return proto.CompactTextString(m) }
 func (*GetSeedNodeListResponse) ProtoMessage()    {}
 func (*GetSeedNodeListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9da989a22daaf207, []int{14}
+	return fileDescriptor_9da989a22daaf207, []int{16}
 }
 func (m *GetSeedNodeListResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1190,7 +1341,7 @@ func (m *SetDataNodeRequest) Reset()         { *m = SetDataNodeRequest{} }
 func (m *SetDataNodeRequest) String() string { return proto.CompactTextString(m) }
 func (*SetDataNodeRequest) ProtoMessage()    {}
 func (*SetDataNodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9da989a22daaf207, []int{15}
+	return fileDescriptor_9da989a22daaf207, []int{17}
 }
 func (m *SetDataNodeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1260,7 +1411,7 @@ func (m *SetDataNodeResponse) Reset()         { *m = SetDataNodeResponse{} }
 func (m *SetDataNodeResponse) String() string { return proto.CompactTextString(m) }
 func (*SetDataNodeResponse) ProtoMessage()    {}
 func (*SetDataNodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9da989a22daaf207, []int{16}
+	return fileDescriptor_9da989a22daaf207, []int{18}
 }
 func (m *SetDataNodeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1325,7 +1476,7 @@ func (m *UpdateDataNodeRequest) Reset()         { *m = UpdateDataNodeRequest{} }
 func (m *UpdateDataNodeRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateDataNodeRequest) ProtoMessage()    {}
 func (*UpdateDataNodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9da989a22daaf207, []int{17}
+	return fileDescriptor_9da989a22daaf207, []int{19}
 }
 func (m *UpdateDataNodeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1402,7 +1553,7 @@ func (m *GetRegisteredNodeListResponse) Reset()         { *m = GetRegisteredNode
 func (m *GetRegisteredNodeListResponse) String() string { return proto.CompactTextString(m) }
 func (*GetRegisteredNodeListResponse) ProtoMessage()    {}
 func (*GetRegisteredNodeListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9da989a22daaf207, []int{18}
+	return fileDescriptor_9da989a22daaf207, []int{20}
 }
 func (m *GetRegisteredNodeListResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1466,7 +1617,7 @@ func (m *SetJobNodeRequest) Reset()         { *m = SetJobNodeRequest{} }
 func (m *SetJobNodeRequest) String() string { return proto.CompactTextString(m) }
 func (*SetJobNodeRequest) ProtoMessage()    {}
 func (*SetJobNodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9da989a22daaf207, []int{19}
+	return fileDescriptor_9da989a22daaf207, []int{21}
 }
 func (m *SetJobNodeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1536,7 +1687,7 @@ func (m *SetJobNodeResponse) Reset()         { *m = SetJobNodeResponse{} }
 func (m *SetJobNodeResponse) String() string { return proto.CompactTextString(m) }
 func (*SetJobNodeResponse) ProtoMessage()    {}
 func (*SetJobNodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9da989a22daaf207, []int{20}
+	return fileDescriptor_9da989a22daaf207, []int{22}
 }
 func (m *SetJobNodeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1601,7 +1752,7 @@ func (m *UpdateJobNodeRequest) Reset()         { *m = UpdateJobNodeRequest{} }
 func (m *UpdateJobNodeRequest) String() string { return proto.CompactTextString(m) }
 func (*UpdateJobNodeRequest) ProtoMessage()    {}
 func (*UpdateJobNodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9da989a22daaf207, []int{21}
+	return fileDescriptor_9da989a22daaf207, []int{23}
 }
 func (m *UpdateJobNodeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1676,7 +1827,7 @@ func (m *ReportTaskEventRequest) Reset()         { *m = ReportTaskEventRequest{}
 func (m *ReportTaskEventRequest) String() string { return proto.CompactTextString(m) }
 func (*ReportTaskEventRequest) ProtoMessage()    {}
 func (*ReportTaskEventRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9da989a22daaf207, []int{22}
+	return fileDescriptor_9da989a22daaf207, []int{24}
 }
 func (m *ReportTaskEventRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1712,8 +1863,15 @@ func (m *ReportTaskEventRequest) GetTaskEvent() *TaskEventDeclare {
 	return nil
 }
 
-// 未定义
+// 计算服务 上报某个任务 在自身上实际消耗的资源 (同一个 task_id + party_id + job_node_id 后报的覆盖先报的)
 type ReportTaskResourceExpenseRequest struct {
+	TaskId               string   `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	PartyId              string   `protobuf:"bytes,2,opt,name=party_id,json=partyId,proto3" json:"party_id,omitempty"`
+	JobNodeId            string   `protobuf:"bytes,3,opt,name=job_node_id,json=jobNodeId,proto3" json:"job_node_id,omitempty"`
+	UsedMem              uint64   `protobuf:"varint,4,opt,name=used_mem,json=usedMem,proto3" json:"used_mem,omitempty"`
+	UsedProcessor        uint64   `protobuf:"varint,5,opt,name=used_processor,json=usedProcessor,proto3" json:"used_processor,omitempty"`
+	UsedBandwidth        uint64   `protobuf:"varint,6,opt,name=used_bandwidth,json=usedBandwidth,proto3" json:"used_bandwidth,omitempty"`
+	UsedDuration         uint64   `protobuf:"varint,7,opt,name=used_duration,json=usedDuration,proto3" json:"used_duration,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -1723,7 +1881,7 @@ func (m *ReportTaskResourceExpenseRequest) Reset()         { *m = ReportTaskReso
 func (m *ReportTaskResourceExpenseRequest) String() string { return proto.CompactTextString(m) }
 func (*ReportTaskResourceExpenseRequest) ProtoMessage()    {}
 func (*ReportTaskResourceExpenseRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9da989a22daaf207, []int{23}
+	return fileDescriptor_9da989a22daaf207, []int{25}
 }
 func (m *ReportTaskResourceExpenseRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1752,6 +1910,55 @@ func (m *ReportTaskResourceExpenseRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ReportTaskResourceExpenseRequest proto.InternalMessageInfo
 
+func (m *ReportTaskResourceExpenseRequest) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
+	}
+	return ""
+}
+
+func (m *ReportTaskResourceExpenseRequest) GetPartyId() string {
+	if m != nil {
+		return m.PartyId
+	}
+	return ""
+}
+
+func (m *ReportTaskResourceExpenseRequest) GetJobNodeId() string {
+	if m != nil {
+		return m.JobNodeId
+	}
+	return ""
+}
+
+func (m *ReportTaskResourceExpenseRequest) GetUsedMem() uint64 {
+	if m != nil {
+		return m.UsedMem
+	}
+	return 0
+}
+
+func (m *ReportTaskResourceExpenseRequest) GetUsedProcessor() uint64 {
+	if m != nil {
+		return m.UsedProcessor
+	}
+	return 0
+}
+
+func (m *ReportTaskResourceExpenseRequest) GetUsedBandwidth() uint64 {
+	if m != nil {
+		return m.UsedBandwidth
+	}
+	return 0
+}
+
+func (m *ReportTaskResourceExpenseRequest) GetUsedDuration() uint64 {
+	if m != nil {
+		return m.UsedDuration
+	}
+	return 0
+}
+
 type ReportUpFileSummaryRequest struct {
 	OriginId             string   `protobuf:"bytes,1,opt,name=origin_id,json=originId,proto3" json:"origin_id,omitempty"`
 	FilePath             string   `protobuf:"bytes,2,opt,name=file_path,json=filePath,proto3" json:"file_path,omitempty"`
@@ -1766,7 +1973,7 @@ func (m *ReportUpFileSummaryRequest) Reset()         { *m = ReportUpFileSummaryR
 func (m *ReportUpFileSummaryRequest) String() string { return proto.CompactTextString(m) }
 func (*ReportUpFileSummaryRequest) ProtoMessage()    {}
 func (*ReportUpFileSummaryRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9da989a22daaf207, []int{24}
+	return fileDescriptor_9da989a22daaf207, []int{26}
 }
 func (m *ReportUpFileSummaryRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1835,7 +2042,7 @@ func (m *QueryAvailableDataNodeRequest) Reset()         { *m = QueryAvailableDat
 func (m *QueryAvailableDataNodeRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAvailableDataNodeRequest) ProtoMessage()    {}
 func (*QueryAvailableDataNodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9da989a22daaf207, []int{25}
+	return fileDescriptor_9da989a22daaf207, []int{27}
 }
 func (m *QueryAvailableDataNodeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1890,7 +2097,7 @@ func (m *QueryAvailableDataNodeResponse) Reset()         { *m = QueryAvailableDa
 func (m *QueryAvailableDataNodeResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAvailableDataNodeResponse) ProtoMessage()    {}
 func (*QueryAvailableDataNodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9da989a22daaf207, []int{26}
+	return fileDescriptor_9da989a22daaf207, []int{28}
 }
 func (m *QueryAvailableDataNodeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1944,7 +2151,7 @@ func (m *QueryFilePositionRequest) Reset()         { *m = QueryFilePositionReque
 func (m *QueryFilePositionRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryFilePositionRequest) ProtoMessage()    {}
 func (*QueryFilePositionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9da989a22daaf207, []int{27}
+	return fileDescriptor_9da989a22daaf207, []int{29}
 }
 func (m *QueryFilePositionRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1993,7 +2200,7 @@ func (m *QueryFilePositionResponse) Reset()         { *m = QueryFilePositionResp
 func (m *QueryFilePositionResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryFilePositionResponse) ProtoMessage()    {}
 func (*QueryFilePositionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9da989a22daaf207, []int{28}
+	return fileDescriptor_9da989a22daaf207, []int{30}
 }
 func (m *QueryFilePositionResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2043,6 +2250,124 @@ func (m *QueryFilePositionResponse) GetFilePath() string {
 	return ""
 }
 
+type BackupDatabaseRequest struct {
+	OutputFile           string   `protobuf:"bytes,1,opt,name=output_file,json=outputFile,proto3" json:"output_file,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BackupDatabaseRequest) Reset()         { *m = BackupDatabaseRequest{} }
+func (m *BackupDatabaseRequest) String() string { return proto.CompactTextString(m) }
+func (*BackupDatabaseRequest) ProtoMessage()    {}
+func (*BackupDatabaseRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9da989a22daaf207, []int{31}
+}
+func (m *BackupDatabaseRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BackupDatabaseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BackupDatabaseRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BackupDatabaseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackupDatabaseRequest.Merge(m, src)
+}
+func (m *BackupDatabaseRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BackupDatabaseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackupDatabaseRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackupDatabaseRequest proto.InternalMessageInfo
+
+func (m *BackupDatabaseRequest) GetOutputFile() string {
+	if m != nil {
+		return m.OutputFile
+	}
+	return ""
+}
+
+type BackupDatabaseResponse struct {
+	Status               int32    `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Msg                  string   `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	FilePath             string   `protobuf:"bytes,3,opt,name=file_path,json=filePath,proto3" json:"file_path,omitempty"`
+	KeyCount             uint64   `protobuf:"varint,4,opt,name=key_count,json=keyCount,proto3" json:"key_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *BackupDatabaseResponse) Reset()         { *m = BackupDatabaseResponse{} }
+func (m *BackupDatabaseResponse) String() string { return proto.CompactTextString(m) }
+func (*BackupDatabaseResponse) ProtoMessage()    {}
+func (*BackupDatabaseResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9da989a22daaf207, []int{32}
+}
+func (m *BackupDatabaseResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BackupDatabaseResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BackupDatabaseResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BackupDatabaseResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackupDatabaseResponse.Merge(m, src)
+}
+func (m *BackupDatabaseResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BackupDatabaseResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackupDatabaseResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackupDatabaseResponse proto.InternalMessageInfo
+
+func (m *BackupDatabaseResponse) GetStatus() int32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *BackupDatabaseResponse) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
+}
+
+func (m *BackupDatabaseResponse) GetFilePath() string {
+	if m != nil {
+		return m.FilePath
+	}
+	return ""
+}
+
+func (m *BackupDatabaseResponse) GetKeyCount() uint64 {
+	if m != nil {
+		return m.KeyCount
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*YarnNodeInfo)(nil), "rpcapi.YarnNodeInfo")
 	proto.RegisterType((*YarnNodeSysInfo)(nil), "rpcapi.YarnNodeSysInfo")
@@ -2052,6 +2377,8 @@ func init() {
 	proto.RegisterType((*YarnRegisteredJobNode)(nil), "rpcapi.YarnRegisteredJobNode")
 	proto.RegisterType((*YarnRegisteredJobNodeTaskIds)(nil), "rpcapi.YarnRegisteredJobNodeTaskIds")
 	proto.RegisterType((*YarnRegisteredDataNode)(nil), "rpcapi.YarnRegisteredDataNode")
+	proto.RegisterType((*YarnRegisteredNodeHealth)(nil), "rpcapi.YarnRegisteredNodeHealth")
+	proto.RegisterType((*DrainRegisteredNodeRequest)(nil), "rpcapi.DrainRegisteredNodeRequest")
 	proto.RegisterType((*YarnRegisteredDataNodeDelta)(nil), "rpcapi.YarnRegisteredDataNodeDelta")
 	proto.RegisterType((*GetNodeInfoResponse)(nil), "rpcapi.GetNodeInfoResponse")
 	proto.RegisterType((*GetRegisteredPeersResponse)(nil), "rpcapi.GetRegisteredPeersResponse")
@@ -2073,126 +2400,147 @@ func init() {
 	proto.RegisterType((*QueryAvailableDataNodeResponse)(nil), "rpcapi.QueryAvailableDataNodeResponse")
 	proto.RegisterType((*QueryFilePositionRequest)(nil), "rpcapi.QueryFilePositionRequest")
 	proto.RegisterType((*QueryFilePositionResponse)(nil), "rpcapi.QueryFilePositionResponse")
+	proto.RegisterType((*BackupDatabaseRequest)(nil), "rpcapi.BackupDatabaseRequest")
+	proto.RegisterType((*BackupDatabaseResponse)(nil), "rpcapi.BackupDatabaseResponse")
 }
 
 func init() { proto.RegisterFile("lib/api/sys_rpc_api.proto", fileDescriptor_9da989a22daaf207) }
 
 var fileDescriptor_9da989a22daaf207 = []byte{
-	// 1823 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0x5f, 0x6f, 0x13, 0xcb,
-	0x15, 0xd7, 0xfa, 0x4f, 0x62, 0x1f, 0xc7, 0x01, 0x06, 0x08, 0x8e, 0x93, 0x18, 0x33, 0x09, 0x24,
-	0xa4, 0x25, 0xa6, 0xa9, 0x5a, 0x5a, 0x2a, 0xa4, 0x42, 0x02, 0x51, 0xaa, 0xfe, 0x09, 0x6b, 0x78,
-	0x00, 0x55, 0xb2, 0x26, 0xde, 0x21, 0x59, 0xf0, 0xfe, 0x61, 0x67, 0x9c, 0x60, 0x10, 0xad, 0xd4,
-	0x87, 0xf6, 0x03, 0xf4, 0xa5, 0x52, 0x1f, 0xda, 0xcf, 0xd0, 0x7e, 0x82, 0xbe, 0x55, 0xaa, 0x5a,
-	0x55, 0xaa, 0xfa, 0x7e, 0x85, 0xee, 0xc3, 0xfd, 0x12, 0x57, 0xba, 0x9a, 0xd9, 0x9d, 0xf5, 0xae,
-	0x77, 0xbd, 0x4e, 0xe0, 0x5e, 0x81, 0x74, 0xdf, 0x76, 0xcf, 0x9c, 0x39, 0xbf, 0x33, 0xe7, 0x77,
-	0xe6, 0xec, 0xcf, 0x09, 0xcc, 0xf7, 0xcc, 0xfd, 0x16, 0x71, 0xcd, 0x16, 0x1b, 0xb0, 0x8e, 0xe7,
-	0x76, 0x3b, 0xc4, 0x35, 0x37, 0x5c, 0xcf, 0xe1, 0x0e, 0x9a, 0xf2, 0xdc, 0x2e, 0x71, 0xcd, 0xfa,
-	0xa2, 0x72, 0xe9, 0x3a, 0x96, 0xe5, 0xd8, 0x1d, 0x8b, 0x32, 0x46, 0x0e, 0xa8, 0xef, 0x55, 0xaf,
-	0xab, 0x55, 0x4e, 0xd8, 0x8b, 0x78, 0x84, 0xfa, 0xe2, 0x81, 0xe3, 0x1c, 0xf4, 0xa8, 0x5c, 0x26,
-	0xb6, 0xed, 0x70, 0xc2, 0x4d, 0xc7, 0x66, 0xfe, 0x2a, 0xfe, 0x22, 0x0f, 0x33, 0x4f, 0x88, 0x67,
-	0xff, 0xd2, 0x31, 0xe8, 0xae, 0xfd, 0xcc, 0x41, 0x0b, 0x50, 0xb6, 0x1d, 0x83, 0x76, 0xf8, 0xc0,
-	0xa5, 0x35, 0xad, 0xa9, 0xad, 0x95, 0xf5, 0x92, 0x30, 0x3c, 0x1a, 0xb8, 0x14, 0x5d, 0x82, 0x69,
-	0xb9, 0x68, 0x1a, 0xb5, 0x9c, 0x5c, 0x9a, 0x12, 0xaf, 0xbb, 0x06, 0xba, 0x0c, 0x15, 0xd3, 0xe6,
-	0xd4, 0xb3, 0x49, 0xaf, 0x63, 0xba, 0xb5, 0xbc, 0x5c, 0x04, 0x65, 0xda, 0x75, 0x85, 0x03, 0x7d,
-	0x35, 0x74, 0x28, 0xf8, 0x0e, 0xca, 0xb4, 0xeb, 0xa2, 0x65, 0xa8, 0x86, 0x11, 0x5c, 0xc7, 0xe3,
-	0xb5, 0xa2, 0x74, 0x99, 0x51, 0xc6, 0x3d, 0xc7, 0xe3, 0xc2, 0x29, 0x8c, 0x22, 0x9d, 0xa6, 0x7c,
-	0x27, 0x65, 0x54, 0x4e, 0xa6, 0x41, 0x6d, 0x6e, 0xf2, 0x81, 0x7f, 0x8a, 0xe9, 0x20, 0x52, 0x60,
-	0x94, 0x27, 0x11, 0x09, 0x2b, 0x27, 0xd3, 0xa8, 0x95, 0x82, 0x84, 0x03, 0xd3, 0xae, 0x81, 0xb6,
-	0xa0, 0xea, 0x51, 0xe6, 0xf4, 0xbd, 0x2e, 0xed, 0xf4, 0x19, 0x35, 0x6a, 0xe5, 0xa6, 0xb6, 0x56,
-	0xd9, 0x6c, 0x6c, 0xf8, 0x84, 0x6c, 0xe8, 0xc1, 0xe2, 0x63, 0x46, 0x8d, 0x6d, 0xca, 0x89, 0xd9,
-	0x6b, 0x1f, 0x3a, 0xc7, 0xfa, 0x8c, 0x17, 0xb1, 0xa3, 0x9b, 0x50, 0x74, 0x29, 0xf5, 0x58, 0x0d,
-	0x9a, 0xf9, 0xb5, 0xca, 0x66, 0x5d, 0x6d, 0x16, 0x15, 0xd7, 0xe9, 0x81, 0xc9, 0x38, 0xf5, 0xa8,
-	0xb1, 0x47, 0xa9, 0xa7, 0xfb, 0x8e, 0xa8, 0x05, 0xc0, 0x28, 0x35, 0x3a, 0xfe, 0xb6, 0x8a, 0xdc,
-	0x76, 0x56, 0x6d, 0x6b, 0xd3, 0xc0, 0xb9, 0xcc, 0x82, 0x27, 0x86, 0x2e, 0x40, 0x91, 0x71, 0xc2,
-	0x69, 0x6d, 0x46, 0x1e, 0xc1, 0x7f, 0x41, 0x08, 0x0a, 0x36, 0xb1, 0x68, 0xad, 0x2a, 0x8d, 0xf2,
-	0x19, 0x7f, 0xa9, 0xc1, 0x19, 0x45, 0x75, 0x7b, 0xc0, 0x24, 0xdb, 0xca, 0x4f, 0x1b, 0xfa, 0x89,
-	0x0e, 0xe0, 0x0e, 0x27, 0xbd, 0x8e, 0x45, 0x2d, 0x49, 0x73, 0x41, 0x2f, 0x49, 0xc3, 0x2f, 0xa8,
-	0x85, 0xe6, 0xa1, 0x24, 0xaa, 0x21, 0xd7, 0xf2, 0x72, 0x6d, 0x5a, 0xbc, 0x8b, 0xa5, 0x55, 0x38,
-	0xe3, 0xef, 0x73, 0x3d, 0xa7, 0x4b, 0x19, 0x73, 0x3c, 0x49, 0x73, 0x41, 0x9f, 0x95, 0xe6, 0x3d,
-	0x65, 0x45, 0x57, 0x61, 0x56, 0xc6, 0x18, 0xfa, 0x15, 0xa5, 0x5f, 0x55, 0x58, 0x87, 0x6e, 0x61,
-	0xbc, 0x7d, 0x62, 0x1b, 0xc7, 0xa6, 0xc1, 0x0f, 0x25, 0xdd, 0x2a, 0xde, 0x3d, 0x65, 0x0d, 0xe3,
-	0x0d, 0xfd, 0xa6, 0x87, 0xf1, 0x42, 0x37, 0xcc, 0x01, 0x25, 0xeb, 0x9e, 0xdd, 0xef, 0x77, 0xa1,
-	0x22, 0x17, 0x0d, 0x49, 0xb0, 0x2c, 0x46, 0x65, 0xb3, 0x39, 0x9e, 0x45, 0xbf, 0x11, 0x74, 0x10,
-	0x9b, 0xfc, 0x67, 0xfc, 0x7f, 0x0d, 0x6a, 0xe3, 0x1c, 0xd1, 0x2c, 0xe4, 0x4c, 0x23, 0x40, 0xcd,
-	0x99, 0x89, 0x6b, 0x94, 0x9b, 0x74, 0x8d, 0xf2, 0x93, 0xaf, 0x51, 0xe1, 0x24, 0xd7, 0xa8, 0x98,
-	0x72, 0x8d, 0x96, 0x00, 0xba, 0x8e, 0x6d, 0x77, 0xfc, 0xee, 0x12, 0x95, 0x2f, 0xea, 0x65, 0x61,
-	0x69, 0x0b, 0x03, 0xfe, 0x2d, 0x94, 0x54, 0x3b, 0x9e, 0xfe, 0x18, 0x89, 0x2c, 0xf3, 0x29, 0x59,
-	0xc6, 0x13, 0x28, 0x8c, 0x26, 0xf0, 0xaf, 0x1c, 0x5c, 0x8c, 0x17, 0xf6, 0x67, 0xce, 0xbe, 0xe8,
-	0xed, 0x20, 0x9d, 0xdc, 0xb8, 0x74, 0x3e, 0xea, 0x70, 0xfa, 0xa9, 0xc8, 0xe5, 0x99, 0xe3, 0x59,
-	0x72, 0x0a, 0xcb, 0x46, 0x9d, 0x3c, 0x54, 0xa2, 0x5b, 0x50, 0x1d, 0x4a, 0x46, 0xdf, 0xf3, 0xb7,
-	0x97, 0xfc, 0xdb, 0xa9, 0xde, 0xd1, 0x8f, 0xa0, 0x20, 0xbe, 0x00, 0xc1, 0xac, 0x5a, 0x49, 0x6f,
-	0xd4, 0xa0, 0x4c, 0x8f, 0x08, 0x7b, 0xb1, 0x6b, 0x30, 0x5d, 0xee, 0xc0, 0xbf, 0x82, 0xc5, 0x2c,
-	0x2f, 0x31, 0x66, 0xba, 0x4e, 0xdf, 0xe6, 0x92, 0xe5, 0xaa, 0xee, 0xbf, 0x88, 0x69, 0x20, 0xbf,
-	0x38, 0xa6, 0xc1, 0x6a, 0xb9, 0x66, 0x7e, 0xad, 0xac, 0x4f, 0x73, 0x7f, 0x03, 0xfe, 0x77, 0x0e,
-	0xe6, 0xe2, 0x11, 0xb7, 0x09, 0x27, 0xdf, 0x72, 0x7e, 0x7e, 0x0c, 0x45, 0x83, 0xf6, 0x38, 0x09,
-	0x08, 0x5a, 0x4e, 0x27, 0x48, 0x15, 0x6a, 0x5b, 0xb8, 0xea, 0xfe, 0x0e, 0x4c, 0x60, 0x21, 0xc3,
-	0x0b, 0x2d, 0x42, 0xf9, 0x99, 0xd9, 0xa3, 0x5b, 0x11, 0x8e, 0x86, 0x06, 0xb4, 0x02, 0x55, 0xf1,
-	0xf2, 0x48, 0xcc, 0xcd, 0xb6, 0xf9, 0x9a, 0xca, 0xe2, 0x57, 0xf5, 0xb8, 0x11, 0x1f, 0xc3, 0xf9,
-	0x1d, 0xca, 0x95, 0x12, 0xd0, 0x29, 0x73, 0x1d, 0x9b, 0x51, 0x34, 0x07, 0x53, 0xe2, 0x0a, 0xf6,
-	0x99, 0x8c, 0x5b, 0xd4, 0x83, 0x37, 0x74, 0x16, 0xf2, 0x16, 0x3b, 0x08, 0x78, 0x14, 0x8f, 0xe8,
-	0x87, 0xf1, 0xe2, 0xe5, 0xe5, 0x21, 0x2f, 0x44, 0x0f, 0x19, 0x06, 0x8f, 0x3a, 0xe2, 0x7f, 0x68,
-	0x50, 0xdf, 0xa1, 0x3c, 0x3e, 0x22, 0xd9, 0x7b, 0x24, 0x70, 0x1b, 0xca, 0xcf, 0x9d, 0xfd, 0x8e,
-	0x18, 0xbf, 0xac, 0x96, 0x97, 0x1f, 0xcf, 0xa5, 0xcc, 0x4b, 0xa0, 0x97, 0x9e, 0xfb, 0x0f, 0x0c,
-	0xdd, 0x01, 0x30, 0x08, 0x27, 0xc1, 0xe6, 0x82, 0xdc, 0xdc, 0xc8, 0x26, 0x48, 0x2f, 0x1b, 0xc1,
-	0x13, 0xc3, 0x4f, 0x01, 0xb5, 0x29, 0x17, 0x23, 0x51, 0xae, 0xd0, 0x97, 0x7d, 0xca, 0xf8, 0x68,
-	0x6b, 0x6b, 0x93, 0x27, 0x61, 0x2e, 0xd9, 0xb9, 0xd8, 0x86, 0xf3, 0xb1, 0xd8, 0xa7, 0xae, 0xcb,
-	0x0d, 0x28, 0x87, 0xaa, 0x22, 0xa0, 0x25, 0x29, 0x2a, 0x4a, 0x4a, 0x54, 0x60, 0x0b, 0x2e, 0x3e,
-	0x76, 0x0d, 0xc2, 0xe9, 0xe8, 0x71, 0xbe, 0x91, 0x41, 0x8f, 0x39, 0x5c, 0xda, 0x19, 0x1e, 0xef,
-	0xe7, 0x26, 0xe3, 0xef, 0x71, 0xc4, 0xb8, 0x70, 0xca, 0x4f, 0x14, 0x4e, 0xf8, 0xaf, 0x9a, 0x64,
-	0x2c, 0xe4, 0x32, 0x9d, 0xb1, 0x8f, 0x39, 0x8c, 0xf0, 0x6f, 0x24, 0xef, 0xc3, 0x0c, 0x4f, 0x5d,
-	0x94, 0x3b, 0x50, 0x0e, 0x7b, 0x3a, 0xe0, 0x7d, 0xb2, 0x7a, 0x29, 0xa9, 0xa6, 0xc6, 0x7f, 0xd7,
-	0x54, 0x23, 0x8c, 0x56, 0x69, 0x42, 0x23, 0x7c, 0xd4, 0xaa, 0xbd, 0x81, 0xa5, 0xd8, 0x30, 0xf9,
-	0x80, 0xa6, 0xba, 0x09, 0xc5, 0xe8, 0x2c, 0xc9, 0xd4, 0xef, 0xd2, 0x11, 0xff, 0x45, 0x83, 0x73,
-	0x6d, 0xca, 0xd5, 0x78, 0xf9, 0x04, 0x9b, 0xea, 0x8d, 0x6c, 0xfb, 0x30, 0xc1, 0x53, 0xd7, 0xe4,
-	0x27, 0x50, 0x52, 0x33, 0xf6, 0xc4, 0x2d, 0x35, 0x1d, 0x4c, 0x59, 0xfc, 0x37, 0x0d, 0x2e, 0xf8,
-	0x1d, 0x35, 0x52, 0xa1, 0x4f, 0xb9, 0xa1, 0x1e, 0xc2, 0x9c, 0x4e, 0xc5, 0xaa, 0x10, 0x43, 0xf7,
-	0x8f, 0xa8, 0xcd, 0x55, 0xd6, 0xb7, 0x00, 0xa4, 0xfe, 0xa1, 0xc2, 0x28, 0xb3, 0xaf, 0x6c, 0xd6,
-	0x54, 0x35, 0x42, 0xef, 0x6d, 0xda, 0xed, 0x11, 0x8f, 0xea, 0x65, 0xae, 0x2c, 0x18, 0x43, 0x73,
-	0x18, 0x52, 0xa9, 0x8a, 0xfb, 0xaf, 0x5c, 0x6a, 0x33, 0x55, 0x12, 0xfc, 0x1a, 0xea, 0xbe, 0xcf,
-	0x63, 0xf7, 0x81, 0xd9, 0xa3, 0xed, 0xbe, 0x65, 0x11, 0x6f, 0xa0, 0xa0, 0x17, 0xa0, 0xec, 0x78,
-	0xe6, 0x81, 0x69, 0x77, 0xc2, 0xba, 0x95, 0x7c, 0xc3, 0xae, 0x21, 0x16, 0xc5, 0xa7, 0xbd, 0xe3,
-	0x12, 0x7e, 0x18, 0x70, 0x57, 0x12, 0x86, 0x3d, 0xc2, 0x0f, 0x65, 0xa9, 0x55, 0x45, 0x73, 0xa6,
-	0x2b, 0x7e, 0x03, 0x46, 0x7e, 0x29, 0xc8, 0x67, 0xfc, 0x04, 0x96, 0x1e, 0xf6, 0xa9, 0x37, 0xb8,
-	0x7b, 0x44, 0xcc, 0x1e, 0xd9, 0xef, 0x25, 0x06, 0x80, 0x42, 0x60, 0x42, 0x4d, 0x68, 0xbe, 0xcc,
-	0x11, 0x06, 0x21, 0x24, 0xc2, 0x45, 0xf9, 0x9b, 0x2a, 0x02, 0x2f, 0x7e, 0x53, 0xe1, 0x6d, 0x68,
-	0x8c, 0x0b, 0x1d, 0xf4, 0xa2, 0x9f, 0xa0, 0x96, 0x48, 0x30, 0x17, 0x49, 0xf0, 0x16, 0xd4, 0x64,
-	0x14, 0x51, 0x99, 0x3d, 0x87, 0x99, 0x42, 0x47, 0x9c, 0xa4, 0x34, 0xf8, 0xd7, 0x30, 0x9f, 0xb2,
-	0xf1, 0xe4, 0xc8, 0xf1, 0xda, 0xe6, 0xe3, 0xb5, 0xdd, 0xfc, 0x0f, 0x82, 0x8a, 0xb8, 0x05, 0x6d,
-	0xea, 0x1d, 0x99, 0x5d, 0x8a, 0x0e, 0xa1, 0x12, 0x91, 0x54, 0x68, 0x4e, 0xf5, 0xc6, 0x7d, 0xcb,
-	0xe5, 0x83, 0x1d, 0xca, 0xf7, 0x88, 0x47, 0x2c, 0x56, 0x5f, 0x50, 0xf6, 0x14, 0xfd, 0x85, 0x57,
-	0x7e, 0xf7, 0xbf, 0xcf, 0xff, 0x98, 0x6b, 0xe0, 0xf9, 0x56, 0x97, 0x78, 0x9e, 0x49, 0xbd, 0xd6,
-	0xd1, 0xf7, 0x5a, 0x03, 0xe2, 0xd9, 0x2d, 0x3b, 0x70, 0xbd, 0xad, 0xad, 0xa3, 0xb7, 0x80, 0x92,
-	0x12, 0x6a, 0x2c, 0x20, 0x8e, 0x00, 0x8e, 0x91, 0x5d, 0xf8, 0x3b, 0x12, 0xf7, 0x2a, 0x6e, 0x26,
-	0x70, 0xbd, 0xf8, 0x0e, 0x01, 0xff, 0x02, 0x2a, 0x11, 0x89, 0x82, 0xea, 0xc3, 0x2f, 0xef, 0xa8,
-	0x26, 0x1a, 0x1e, 0x36, 0x45, 0xd3, 0xe0, 0x65, 0x09, 0xba, 0x84, 0x6b, 0x09, 0x50, 0xe6, 0x7b,
-	0x0b, 0x30, 0x0e, 0xb3, 0x71, 0x7d, 0x82, 0x42, 0x95, 0x97, 0xaa, 0x5b, 0xb2, 0x21, 0xaf, 0x49,
-	0xc8, 0x26, 0x5e, 0x48, 0x40, 0xf6, 0xc3, 0x60, 0x02, 0x75, 0x00, 0xb3, 0xdb, 0xb4, 0x47, 0x23,
-	0xa8, 0xa1, 0x7e, 0xf7, 0xed, 0xf1, 0x4f, 0x8e, 0xc2, 0x1e, 0x96, 0xc2, 0xb4, 0xdc, 0x5e, 0x08,
-	0xbb, 0x25, 0xc6, 0xe1, 0x78, 0x68, 0x23, 0x44, 0x12, 0xd0, 0x2e, 0x9c, 0x19, 0x51, 0x48, 0x63,
-	0x99, 0xbd, 0x1c, 0x61, 0x36, 0x4d, 0x52, 0x65, 0xb4, 0x93, 0xd0, 0x46, 0xc2, 0x55, 0x20, 0x3a,
-	0x92, 0xcf, 0xf0, 0x27, 0x5b, 0x94, 0xcf, 0x91, 0x51, 0x10, 0x2b, 0xee, 0xe8, 0x5d, 0xc6, 0xab,
-	0x12, 0xed, 0x0a, 0x5e, 0x4c, 0xe3, 0x53, 0x79, 0x0b, 0xc0, 0x57, 0x8a, 0xd3, 0x10, 0x73, 0x84,
-	0xd3, 0x53, 0xc1, 0xae, 0x4b, 0xd8, 0x15, 0x7c, 0x79, 0x0c, 0xa7, 0x51, 0xe4, 0xb7, 0x8a, 0xd7,
-	0x10, 0xf9, 0x83, 0x79, 0x1d, 0x0f, 0x6f, 0xc4, 0x90, 0x04, 0xfc, 0x6b, 0xc9, 0xad, 0xb2, 0x64,
-	0x72, 0x7b, 0x35, 0xf5, 0xd6, 0x26, 0x18, 0x5e, 0x93, 0xe8, 0x18, 0x2f, 0x25, 0xd1, 0x23, 0x28,
-	0xfe, 0xad, 0x85, 0xa1, 0x16, 0x40, 0xf3, 0x91, 0x8a, 0xc6, 0x3f, 0xcf, 0xf5, 0x7a, 0xda, 0xd2,
-	0xc4, 0xfb, 0xc3, 0x42, 0x67, 0xff, 0xd6, 0x56, 0x63, 0x9f, 0x7e, 0xb4, 0x18, 0x27, 0xf8, 0x14,
-	0x90, 0xd7, 0x25, 0xe4, 0x32, 0x6e, 0x8c, 0xa1, 0x37, 0x82, 0xfa, 0x06, 0xaa, 0x3e, 0x8b, 0x0a,
-	0xf5, 0x83, 0xc9, 0x1d, 0x0f, 0x6e, 0x44, 0x81, 0x82, 0xa6, 0xde, 0x09, 0xb3, 0xff, 0x3a, 0xa8,
-	0x1d, 0x7f, 0x9d, 0x9e, 0x0f, 0x41, 0x82, 0xae, 0x1a, 0xd1, 0x2c, 0x28, 0xf2, 0x57, 0x8c, 0x34,
-	0x31, 0x93, 0x79, 0xe6, 0xac, 0x6f, 0x41, 0x2c, 0x98, 0xc0, 0xfe, 0x93, 0x06, 0xf3, 0x63, 0xd5,
-	0x0d, 0x5a, 0x4b, 0xa6, 0x91, 0x2e, 0x80, 0x32, 0x13, 0xfa, 0x81, 0x4c, 0xa8, 0x85, 0xd7, 0x33,
-	0x12, 0x1a, 0x09, 0x2b, 0x52, 0xfb, 0xbd, 0x06, 0xe7, 0x53, 0x44, 0x15, 0xc2, 0xf1, 0xa4, 0xd2,
-	0x14, 0x57, 0x66, 0x3a, 0x2d, 0x99, 0xce, 0x75, 0xbc, 0x32, 0x26, 0x9d, 0x58, 0x40, 0x91, 0xc8,
-	0x9f, 0x35, 0x98, 0x4b, 0x97, 0x41, 0x28, 0x6c, 0x85, 0x4c, 0x05, 0x56, 0xbf, 0x36, 0xc9, 0x2d,
-	0x68, 0x99, 0x4d, 0x99, 0xda, 0x77, 0xf1, 0x6a, 0x22, 0xb5, 0x97, 0xa9, 0x1b, 0x45, 0x76, 0x7f,
-	0xd0, 0xe0, 0x5c, 0x42, 0x25, 0xa1, 0x66, 0x0c, 0x31, 0x45, 0x79, 0xd5, 0xaf, 0x64, 0x78, 0x04,
-	0xe9, 0xdc, 0x90, 0xe9, 0xac, 0x62, 0x9c, 0x9e, 0x4e, 0x74, 0xcf, 0x6d, 0x6d, 0xfd, 0xde, 0xad,
-	0x7f, 0xbe, 0x6b, 0x68, 0xff, 0x7d, 0xd7, 0xd0, 0x3e, 0x7b, 0xd7, 0xd0, 0x9e, 0x5e, 0x3f, 0x30,
-	0xf9, 0x61, 0x7f, 0x7f, 0xa3, 0xeb, 0x58, 0x2d, 0xdd, 0x61, 0x94, 0x73, 0xf2, 0xa0, 0xe7, 0x1c,
-	0xb7, 0xb6, 0xfc, 0x50, 0x37, 0x76, 0x9c, 0x56, 0xf0, 0x9f, 0xb0, 0xfd, 0x29, 0xf9, 0xff, 0xad,
-	0xef, 0x7f, 0x15, 0x00, 0x00, 0xff, 0xff, 0x7c, 0x88, 0x2b, 0xc5, 0x5c, 0x1b, 0x00, 0x00,
+	// 2126 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0x5f, 0x6f, 0xdb, 0xc8,
+	0x11, 0x07, 0x25, 0xdb, 0x92, 0x46, 0x96, 0x73, 0xb7, 0x49, 0x1c, 0x5a, 0xfe, 0x13, 0xdd, 0xc6,
+	0xb9, 0x38, 0x69, 0x13, 0x5d, 0x5d, 0xb4, 0x49, 0x53, 0x1c, 0xd0, 0xc4, 0x4e, 0x5c, 0x17, 0xfd,
+	0xe3, 0xa3, 0x92, 0x87, 0x3b, 0x14, 0x10, 0xd6, 0xe2, 0xc6, 0x66, 0x2c, 0x91, 0x3c, 0xee, 0x2a,
+	0xb1, 0x92, 0x5e, 0x0b, 0x14, 0x6d, 0xef, 0x03, 0xf4, 0xa5, 0x40, 0x1f, 0xda, 0xcf, 0xd0, 0x7e,
+	0x82, 0x7b, 0xeb, 0x63, 0x81, 0xa2, 0xef, 0x45, 0xd0, 0x87, 0x7e, 0x89, 0x02, 0xc5, 0xfe, 0xa3,
+	0x48, 0x91, 0xa2, 0xed, 0xf3, 0x01, 0x39, 0xa0, 0x6f, 0xe4, 0xec, 0xcc, 0xfc, 0x86, 0x33, 0xb3,
+	0xb3, 0xbf, 0x95, 0x60, 0xa9, 0xef, 0xed, 0xb7, 0x49, 0xe8, 0xb5, 0xd9, 0x88, 0x75, 0xa3, 0xb0,
+	0xd7, 0x25, 0xa1, 0x77, 0x27, 0x8c, 0x02, 0x1e, 0xa0, 0xb9, 0x28, 0xec, 0x91, 0xd0, 0x6b, 0xae,
+	0x18, 0x95, 0x5e, 0x30, 0x18, 0x04, 0x7e, 0x77, 0x40, 0x19, 0x23, 0x07, 0x54, 0x69, 0x35, 0x9b,
+	0x66, 0x95, 0x13, 0x76, 0x94, 0xf6, 0xd0, 0x5c, 0x39, 0x08, 0x82, 0x83, 0x3e, 0x95, 0xcb, 0xc4,
+	0xf7, 0x03, 0x4e, 0xb8, 0x17, 0xf8, 0x4c, 0xad, 0xe2, 0xff, 0x94, 0x61, 0xfe, 0x63, 0x12, 0xf9,
+	0x3f, 0x0d, 0x5c, 0xba, 0xeb, 0x3f, 0x0b, 0xd0, 0x32, 0xd4, 0xfc, 0xc0, 0xa5, 0x5d, 0x3e, 0x0a,
+	0xa9, 0x6d, 0xb5, 0xac, 0x8d, 0x9a, 0x53, 0x15, 0x82, 0x27, 0xa3, 0x90, 0xa2, 0x2b, 0x50, 0x91,
+	0x8b, 0x9e, 0x6b, 0x97, 0xe4, 0xd2, 0x9c, 0x78, 0xdd, 0x75, 0xd1, 0x55, 0xa8, 0x7b, 0x3e, 0xa7,
+	0x91, 0x4f, 0xfa, 0x5d, 0x2f, 0xb4, 0xcb, 0x72, 0x11, 0x8c, 0x68, 0x37, 0x14, 0x0a, 0xf4, 0x78,
+	0xac, 0x30, 0xa3, 0x14, 0x8c, 0x68, 0x37, 0x44, 0xd7, 0xa0, 0x11, 0x7b, 0x08, 0x83, 0x88, 0xdb,
+	0xb3, 0x52, 0x65, 0xde, 0x08, 0xf7, 0x82, 0x88, 0x0b, 0x25, 0x7a, 0x9c, 0x54, 0x9a, 0x53, 0x4a,
+	0xf4, 0x38, 0xad, 0xe4, 0xb9, 0xd4, 0xe7, 0x1e, 0x1f, 0xa9, 0xaf, 0xa8, 0x68, 0x4f, 0x5a, 0x28,
+	0xbf, 0x44, 0x04, 0x6c, 0x94, 0x3c, 0xd7, 0xae, 0xea, 0x80, 0xb5, 0x68, 0xd7, 0x45, 0x5b, 0xd0,
+	0x88, 0x28, 0x0b, 0x86, 0x51, 0x8f, 0x76, 0x87, 0x8c, 0xba, 0x76, 0xad, 0x65, 0x6d, 0xd4, 0x37,
+	0xd7, 0xee, 0xa8, 0x82, 0xdc, 0x71, 0xf4, 0xe2, 0x53, 0x46, 0xdd, 0x6d, 0xca, 0x89, 0xd7, 0xef,
+	0x1c, 0x06, 0x2f, 0x9d, 0xf9, 0x28, 0x21, 0x47, 0x1f, 0xc0, 0x6c, 0x48, 0x69, 0xc4, 0x6c, 0x68,
+	0x95, 0x37, 0xea, 0x9b, 0x4d, 0x63, 0x2c, 0x32, 0xee, 0xd0, 0x03, 0x8f, 0x71, 0x1a, 0x51, 0x77,
+	0x8f, 0xd2, 0xc8, 0x51, 0x8a, 0xa8, 0x0d, 0xc0, 0x28, 0x75, 0xbb, 0xca, 0xac, 0x2e, 0xcd, 0xde,
+	0x31, 0x66, 0x1d, 0xaa, 0x95, 0x6b, 0x4c, 0x3f, 0x31, 0x74, 0x09, 0x66, 0x19, 0x27, 0x9c, 0xda,
+	0xf3, 0xf2, 0x13, 0xd4, 0x0b, 0x42, 0x30, 0xe3, 0x93, 0x01, 0xb5, 0x1b, 0x52, 0x28, 0x9f, 0xf1,
+	0x7f, 0x2d, 0xb8, 0x60, 0x4a, 0xdd, 0x19, 0x31, 0x59, 0x6d, 0xa3, 0x67, 0x8d, 0xf5, 0x44, 0x07,
+	0xf0, 0x80, 0x93, 0x7e, 0x77, 0x40, 0x07, 0xb2, 0xcc, 0x33, 0x4e, 0x55, 0x0a, 0x7e, 0x42, 0x07,
+	0x68, 0x09, 0xaa, 0x22, 0x1b, 0x72, 0xad, 0x2c, 0xd7, 0x2a, 0xe2, 0x5d, 0x2c, 0xdd, 0x80, 0x0b,
+	0xca, 0x2e, 0x8c, 0x82, 0x1e, 0x65, 0x2c, 0x88, 0x64, 0x99, 0x67, 0x9c, 0x05, 0x29, 0xde, 0x33,
+	0x52, 0x74, 0x1d, 0x16, 0xa4, 0x8f, 0xb1, 0xde, 0xac, 0xd4, 0x6b, 0x08, 0xe9, 0x58, 0x2d, 0xf6,
+	0xb7, 0x4f, 0x7c, 0xf7, 0xa5, 0xe7, 0xf2, 0x43, 0x7b, 0x2e, 0xe1, 0xef, 0xa1, 0x91, 0xc6, 0xfe,
+	0xc6, 0x7a, 0x95, 0xb1, 0xbf, 0x58, 0x0d, 0x73, 0x40, 0xd9, 0xbc, 0x17, 0xf7, 0xfb, 0x03, 0xa8,
+	0xcb, 0x45, 0x57, 0x16, 0x58, 0x26, 0xa3, 0xbe, 0xd9, 0x9a, 0x5e, 0x45, 0xd5, 0x08, 0x0e, 0x08,
+	0x23, 0xf5, 0x8c, 0xff, 0x69, 0x81, 0x3d, 0x4d, 0x11, 0x2d, 0x40, 0xc9, 0x73, 0x35, 0x6a, 0xc9,
+	0xcb, 0x6c, 0xa3, 0xd2, 0x49, 0xdb, 0xa8, 0x7c, 0xf2, 0x36, 0x9a, 0x39, 0xcd, 0x36, 0x9a, 0xcd,
+	0xd9, 0x46, 0xab, 0x00, 0xbd, 0xc0, 0xf7, 0xbb, 0xaa, 0xbb, 0x44, 0xe6, 0x67, 0x9d, 0x9a, 0x90,
+	0x74, 0x84, 0x00, 0xff, 0x0a, 0xaa, 0xa6, 0x1d, 0xcf, 0xfe, 0x19, 0x99, 0x28, 0xcb, 0x39, 0x51,
+	0xa6, 0x03, 0x98, 0x99, 0x0c, 0xe0, 0xb7, 0x65, 0xb8, 0x9c, 0x4e, 0xec, 0x8f, 0x82, 0x7d, 0xd1,
+	0xdb, 0x3a, 0x9c, 0xd2, 0xb4, 0x70, 0xde, 0xea, 0x70, 0xfa, 0x81, 0x88, 0xe5, 0x59, 0x10, 0x0d,
+	0xe4, 0x14, 0xb6, 0x2b, 0xa7, 0x1a, 0x2a, 0x49, 0x13, 0xd4, 0x84, 0xaa, 0x3b, 0x8c, 0x94, 0x79,
+	0x55, 0xed, 0x4e, 0xf3, 0x8e, 0xee, 0xc1, 0x8c, 0x38, 0x01, 0xf4, 0xac, 0x5a, 0xcf, 0x6f, 0x54,
+	0x9d, 0xa6, 0x27, 0x84, 0x1d, 0xed, 0xba, 0xcc, 0x91, 0x16, 0xe8, 0x1e, 0xcc, 0x1d, 0x52, 0xd2,
+	0xe7, 0x87, 0x36, 0x14, 0x35, 0xb9, 0x30, 0xfc, 0xa1, 0xd4, 0x73, 0xb4, 0x3e, 0xfe, 0x19, 0xac,
+	0x14, 0xf9, 0x17, 0x03, 0xaa, 0x17, 0x0c, 0x7d, 0x2e, 0xfb, 0xa3, 0xe1, 0xa8, 0x17, 0x31, 0x47,
+	0xe4, 0x59, 0xe5, 0xb9, 0xcc, 0x2e, 0xb5, 0xca, 0x1b, 0x35, 0xa7, 0xc2, 0x95, 0x01, 0xfe, 0xbc,
+	0x0c, 0x8b, 0x69, 0x8f, 0xdb, 0x84, 0x93, 0xff, 0xf3, 0xca, 0x7e, 0x0f, 0x66, 0x5d, 0xda, 0xe7,
+	0x44, 0x97, 0xf6, 0x5a, 0x7e, 0x79, 0x4c, 0xa2, 0xb6, 0x85, 0xaa, 0xa3, 0x2c, 0xce, 0x51, 0xda,
+	0xbf, 0x64, 0x66, 0xd7, 0x58, 0x69, 0x7c, 0xf0, 0x58, 0xc9, 0x83, 0xc7, 0x86, 0x4a, 0x9f, 0x70,
+	0xea, 0xf7, 0x46, 0xfa, 0xe8, 0x30, 0xaf, 0x22, 0x89, 0xcf, 0x88, 0xd7, 0x1f, 0x46, 0xb4, 0xab,
+	0xfa, 0xa1, 0x2c, 0xfb, 0x61, 0x5e, 0x0b, 0xb7, 0x84, 0x0c, 0x61, 0x68, 0xf4, 0x09, 0xe3, 0xdd,
+	0xde, 0x21, 0xed, 0x1d, 0x75, 0x09, 0xd7, 0x27, 0x48, 0x5d, 0x08, 0xb7, 0x84, 0xec, 0x81, 0x9c,
+	0x0b, 0x52, 0x87, 0x46, 0x91, 0x3e, 0x3a, 0x6a, 0x4e, 0x4d, 0x48, 0x1e, 0x09, 0x01, 0x7e, 0x0c,
+	0xcd, 0xed, 0x88, 0x78, 0x13, 0x41, 0x3b, 0xf4, 0xd3, 0x21, 0x65, 0x3c, 0x33, 0xaa, 0x6c, 0xa8,
+	0xb8, 0x42, 0x9b, 0xaa, 0xb6, 0xaa, 0x3a, 0xe6, 0x15, 0x13, 0x58, 0x2e, 0x48, 0x2e, 0x5a, 0x81,
+	0xda, 0x33, 0xaf, 0xaf, 0xc2, 0xd6, 0xad, 0x3d, 0x16, 0xa0, 0x75, 0x68, 0x88, 0x97, 0x27, 0xe2,
+	0xa0, 0xea, 0x78, 0xaf, 0xa8, 0x74, 0xde, 0x70, 0xd2, 0x42, 0xfc, 0x12, 0x2e, 0xee, 0x50, 0x6e,
+	0xa8, 0x97, 0x43, 0x59, 0x18, 0xf8, 0x8c, 0xa2, 0x45, 0x98, 0x13, 0xc9, 0x1c, 0x32, 0xe9, 0x77,
+	0xd6, 0xd1, 0x6f, 0xe8, 0x1d, 0x28, 0x0f, 0xd8, 0x81, 0x6e, 0x7f, 0xf1, 0x88, 0xbe, 0x9b, 0xee,
+	0xb9, 0xb2, 0xac, 0xef, 0xa5, 0x64, 0x7d, 0x63, 0xe7, 0x49, 0x45, 0xfc, 0x85, 0x05, 0xcd, 0x1d,
+	0xca, 0xd3, 0x67, 0x12, 0xfb, 0x12, 0x01, 0xdc, 0x87, 0xda, 0xf3, 0x60, 0xbf, 0x2b, 0xce, 0x3b,
+	0x66, 0x97, 0x25, 0x5b, 0x59, 0x2d, 0x9c, 0x3a, 0x4e, 0xf5, 0xb9, 0x7a, 0x60, 0xe8, 0x43, 0x00,
+	0x97, 0x70, 0xa2, 0x8d, 0x67, 0x5a, 0xe5, 0xe4, 0x7e, 0xc9, 0x4f, 0xbd, 0x53, 0x73, 0xf5, 0x13,
+	0xc3, 0x9f, 0x00, 0xea, 0x50, 0x2e, 0xce, 0xa0, 0x64, 0x7d, 0x27, 0x26, 0x82, 0x75, 0xf2, 0xd1,
+	0x53, 0xca, 0x6e, 0x78, 0xec, 0xc3, 0xc5, 0x94, 0xef, 0x33, 0xe7, 0xe5, 0x36, 0xd4, 0x62, 0x1a,
+	0xa7, 0xcb, 0x92, 0x65, 0x71, 0x55, 0xc3, 0xe2, 0xf0, 0x00, 0x2e, 0x3f, 0x0d, 0x5d, 0xc2, 0x69,
+	0x87, 0x16, 0xb7, 0xeb, 0x57, 0x72, 0xb2, 0x62, 0x0e, 0x57, 0x76, 0xc6, 0x9f, 0xf7, 0x63, 0x8f,
+	0xf1, 0x2f, 0xf1, 0x89, 0x69, 0xa6, 0x5a, 0x3e, 0x91, 0xa9, 0xe2, 0x3f, 0x5b, 0xb2, 0x62, 0x71,
+	0x2d, 0xf3, 0x2b, 0xf6, 0x36, 0x67, 0x38, 0xfe, 0x25, 0x5c, 0x4c, 0x45, 0x78, 0xe6, 0xa4, 0x7c,
+	0x08, 0xb5, 0xb8, 0xa7, 0xed, 0x72, 0xd1, 0xb8, 0x4d, 0xd0, 0xc5, 0xaa, 0x69, 0x6a, 0xfc, 0x57,
+	0xcb, 0x34, 0xc2, 0x64, 0x96, 0x4e, 0x68, 0x84, 0xb7, 0x9a, 0xb5, 0xd7, 0xb0, 0x9a, 0x1a, 0x26,
+	0xe7, 0x68, 0xaa, 0x0f, 0x60, 0x36, 0x39, 0x4b, 0x0a, 0x2f, 0x4c, 0x52, 0x11, 0xff, 0xc9, 0x82,
+	0x77, 0x3b, 0x94, 0x9b, 0xf1, 0xf2, 0x35, 0x6c, 0xaa, 0xd7, 0x80, 0x92, 0x01, 0x9e, 0x39, 0x27,
+	0xdf, 0x87, 0xaa, 0x99, 0xb1, 0xa7, 0x6e, 0xa9, 0x8a, 0x9e, 0xb2, 0xe2, 0x08, 0xbf, 0xa4, 0x3a,
+	0x6a, 0x22, 0x43, 0x5f, 0xe7, 0x86, 0xfa, 0x08, 0x16, 0x1d, 0x2a, 0x56, 0x05, 0x87, 0x7c, 0xf4,
+	0x82, 0xfa, 0xdc, 0x44, 0x7d, 0x17, 0x40, 0xd2, 0x46, 0x2a, 0x84, 0x32, 0xfa, 0xfa, 0xa6, 0x6d,
+	0xb2, 0x11, 0x6b, 0x6f, 0xd3, 0x5e, 0x9f, 0x44, 0xd4, 0xa9, 0x71, 0x23, 0xc1, 0xbf, 0x29, 0x41,
+	0x6b, 0xec, 0xd3, 0xb0, 0xb1, 0x47, 0xc7, 0x21, 0xf5, 0x59, 0x9c, 0x93, 0x2b, 0x50, 0xd1, 0xa4,
+	0x54, 0x27, 0x66, 0x4e, 0x71, 0x52, 0xc1, 0x56, 0x43, 0x12, 0xa9, 0x9f, 0x0a, 0x54, 0x65, 0x2a,
+	0xf2, 0x7d, 0xd7, 0x45, 0x6b, 0x50, 0x37, 0xd5, 0x11, 0xab, 0x2a, 0x6f, 0x35, 0x9d, 0x7e, 0x65,
+	0x1a, 0x5f, 0x98, 0x67, 0xd2, 0x17, 0xe6, 0x53, 0xde, 0x83, 0xb3, 0xd7, 0xdb, 0xb9, 0x9c, 0xeb,
+	0xad, 0xc8, 0xac, 0x54, 0x8b, 0x29, 0xa4, 0xba, 0x04, 0xcf, 0x0b, 0xe1, 0xb6, 0x96, 0xe1, 0x57,
+	0xd0, 0x54, 0x59, 0x78, 0x1a, 0x3e, 0xf6, 0xfa, 0xb4, 0x33, 0x1c, 0x0c, 0x48, 0x34, 0x32, 0xdf,
+	0xbf, 0x0c, 0xb5, 0x20, 0xf2, 0x0e, 0x3c, 0x7f, 0x9c, 0x81, 0xaa, 0x12, 0xec, 0xba, 0x62, 0x51,
+	0xb0, 0x97, 0x6e, 0x48, 0xf8, 0xa1, 0x4e, 0x42, 0x55, 0x08, 0xf6, 0x08, 0x3f, 0x94, 0xdd, 0x64,
+	0x9a, 0xa6, 0xe4, 0x85, 0xe2, 0x77, 0x85, 0xc4, 0xed, 0x53, 0x3e, 0xe3, 0x8f, 0x61, 0xf5, 0xa3,
+	0x21, 0x8d, 0x46, 0x0f, 0x5e, 0x10, 0xaf, 0x4f, 0xf6, 0xfb, 0x99, 0x19, 0x67, 0x10, 0x98, 0x20,
+	0x4c, 0x96, 0x22, 0xc0, 0x42, 0x20, 0xb8, 0x52, 0xbc, 0x28, 0xef, 0xe9, 0x09, 0x78, 0x71, 0x4f,
+	0xc7, 0xdb, 0xb0, 0x36, 0xcd, 0xb5, 0xde, 0x6e, 0x2a, 0x40, 0x2b, 0x13, 0x60, 0x29, 0x11, 0xe0,
+	0x5d, 0xb0, 0xa5, 0x17, 0x91, 0x99, 0xbd, 0x80, 0x79, 0x22, 0x63, 0xa7, 0x49, 0x0d, 0xfe, 0x39,
+	0x2c, 0xe5, 0x18, 0x9e, 0x1e, 0x39, 0x9d, 0xdb, 0x72, 0x3a, 0xb7, 0xf8, 0x1e, 0x5c, 0x7e, 0x48,
+	0x7a, 0x47, 0xc3, 0x50, 0x7c, 0xd4, 0x3e, 0x61, 0xc9, 0x21, 0x17, 0x0c, 0x79, 0x38, 0xe4, 0x5d,
+	0xa1, 0xab, 0x21, 0x40, 0x89, 0x44, 0x28, 0xf8, 0x17, 0xb0, 0x38, 0x69, 0x79, 0xe6, 0xe9, 0x53,
+	0x14, 0x9a, 0x58, 0x3c, 0xa2, 0x23, 0xcd, 0xe7, 0x55, 0x77, 0x57, 0x8f, 0xe8, 0x48, 0x72, 0xe0,
+	0xcd, 0x2f, 0x2e, 0x43, 0x5d, 0x0c, 0xa8, 0x0e, 0x8d, 0x5e, 0x78, 0x3d, 0x8a, 0x0e, 0xa1, 0x9e,
+	0x60, 0xbb, 0x68, 0xd1, 0x6c, 0xdb, 0x47, 0x83, 0x90, 0x8f, 0x76, 0x28, 0xdf, 0x23, 0x11, 0x19,
+	0xb0, 0xe6, 0xb2, 0x91, 0xe7, 0x50, 0x63, 0xbc, 0xfe, 0xeb, 0x7f, 0xfc, 0xfb, 0xf7, 0xa5, 0x35,
+	0xbc, 0xd4, 0xee, 0x91, 0x28, 0xf2, 0x68, 0xd4, 0x7e, 0xf1, 0xad, 0xf6, 0x88, 0x44, 0x7e, 0xdb,
+	0xd7, 0xaa, 0xf7, 0xad, 0x5b, 0xe8, 0x33, 0x40, 0x59, 0x76, 0x3b, 0x15, 0x10, 0x27, 0x00, 0xa7,
+	0x30, 0x62, 0xfc, 0x0d, 0x89, 0x7b, 0x1d, 0xb7, 0x32, 0xb8, 0x51, 0xda, 0x42, 0xc0, 0x1f, 0x41,
+	0x3d, 0xc1, 0x1e, 0x51, 0x73, 0x4c, 0x8a, 0x26, 0xe9, 0x6a, 0x73, 0x39, 0x77, 0x4d, 0x83, 0x5e,
+	0x93, 0xa0, 0xab, 0xd8, 0xce, 0x80, 0x32, 0xa5, 0x2d, 0xc0, 0x38, 0x2c, 0xa4, 0xa9, 0x23, 0x8a,
+	0x09, 0x78, 0x2e, 0xa5, 0x2c, 0x86, 0x7c, 0x5f, 0x42, 0xb6, 0xf0, 0x72, 0x06, 0x72, 0x18, 0x3b,
+	0x13, 0xa8, 0x23, 0x58, 0xd8, 0xa6, 0x7d, 0x9a, 0x40, 0x8d, 0x6f, 0xa4, 0x4a, 0x9e, 0x7b, 0xfb,
+	0x6a, 0x8e, 0x53, 0xe1, 0x0d, 0xc2, 0x7e, 0x0c, 0xbb, 0x15, 0xb8, 0x45, 0xd0, 0x6e, 0x8c, 0x24,
+	0xa0, 0x43, 0xb8, 0x30, 0x41, 0x5e, 0xa7, 0x56, 0xf6, 0x6a, 0xa2, 0xb2, 0x79, 0x6c, 0xb7, 0xa0,
+	0x9d, 0x18, 0xa5, 0xae, 0x50, 0x15, 0x88, 0x81, 0xac, 0x67, 0xfc, 0x23, 0x44, 0xb2, 0x9e, 0x13,
+	0x23, 0xac, 0xb9, 0x9c, 0xbb, 0xa6, 0xd1, 0x6e, 0x48, 0xb4, 0xf7, 0xf0, 0x4a, 0x5e, 0x3d, 0x8d,
+	0xb6, 0x00, 0x3c, 0x36, 0x35, 0x8d, 0x31, 0x27, 0x6a, 0x7a, 0x26, 0xd8, 0x5b, 0x12, 0x76, 0x1d,
+	0x5f, 0x9d, 0x52, 0xd3, 0x24, 0xf2, 0x67, 0xa6, 0xae, 0x31, 0xf2, 0xb9, 0xeb, 0x3a, 0x1d, 0xde,
+	0x4d, 0x21, 0x09, 0xf8, 0x57, 0xd0, 0x90, 0x77, 0xf7, 0x18, 0x3d, 0xde, 0x9b, 0xd3, 0xaf, 0xf4,
+	0x85, 0xe0, 0x37, 0x25, 0xf8, 0x35, 0xbc, 0x96, 0x05, 0x4f, 0xe2, 0x28, 0x6c, 0xd1, 0x57, 0x46,
+	0x52, 0xd8, 0x57, 0xd7, 0x73, 0x27, 0x46, 0xa6, 0xbb, 0x36, 0x24, 0x38, 0xc6, 0xab, 0x59, 0xf0,
+	0x04, 0x8a, 0x9a, 0x18, 0x30, 0xa6, 0x88, 0x68, 0x29, 0x51, 0xcd, 0x34, 0x6b, 0x6b, 0x36, 0xf3,
+	0x96, 0x4e, 0xdc, 0xbb, 0x2c, 0x56, 0x56, 0x13, 0xa3, 0x91, 0x62, 0x84, 0x68, 0x25, 0xdd, 0x5c,
+	0x67, 0x80, 0x9c, 0x9e, 0xde, 0x61, 0xd2, 0x95, 0x40, 0x7d, 0x0d, 0x0d, 0xd5, 0x41, 0x06, 0xf5,
+	0xdc, 0x8d, 0x55, 0x50, 0xdb, 0x24, 0x90, 0xda, 0x50, 0xf3, 0xb2, 0x81, 0x0c, 0xf6, 0x79, 0xdb,
+	0xaa, 0xa0, 0xb2, 0x09, 0x18, 0xbd, 0x95, 0x77, 0xe2, 0xbc, 0x7d, 0x15, 0x4d, 0x35, 0x7d, 0x88,
+	0x3c, 0x1f, 0x83, 0xe8, 0x7e, 0x9e, 0x20, 0xd1, 0x28, 0xf1, 0x6b, 0x64, 0x1e, 0xbb, 0x2e, 0xfc,
+	0xe4, 0xa2, 0x13, 0x30, 0xe5, 0x4c, 0x60, 0xff, 0xc1, 0x82, 0xa5, 0xa9, 0x6c, 0x1b, 0x6d, 0x64,
+	0xc3, 0xc8, 0x27, 0xe4, 0x85, 0x01, 0x7d, 0x47, 0x06, 0xd4, 0xc6, 0xb7, 0x0a, 0x02, 0x9a, 0x70,
+	0x2b, 0x42, 0xfb, 0x9d, 0x05, 0x17, 0x73, 0x28, 0xf0, 0xb8, 0x25, 0xa6, 0xf3, 0xe3, 0xc2, 0x70,
+	0xda, 0x32, 0x9c, 0x9b, 0x78, 0x7d, 0x4a, 0x38, 0x29, 0x87, 0x22, 0x90, 0x3f, 0x5a, 0xb0, 0x98,
+	0x4f, 0x5a, 0x51, 0xdc, 0x0a, 0x85, 0x7c, 0xb9, 0xf9, 0xfe, 0x49, 0x6a, 0xba, 0x65, 0x36, 0x65,
+	0x68, 0xdf, 0xc4, 0x37, 0x32, 0xa1, 0x7d, 0x9a, 0x6b, 0x28, 0xa2, 0xfb, 0xdc, 0x82, 0x77, 0x33,
+	0x9c, 0x16, 0xb5, 0x52, 0x88, 0x39, 0x3c, 0xb9, 0xf9, 0x5e, 0x81, 0x86, 0x0e, 0xe7, 0xb6, 0x0c,
+	0xe7, 0x06, 0xc6, 0xf9, 0xe1, 0x24, 0x6d, 0xd4, 0xe0, 0x58, 0x48, 0x93, 0xd8, 0xf1, 0x61, 0x98,
+	0x4b, 0x8b, 0x9b, 0x6b, 0xd3, 0x96, 0x4f, 0x3c, 0x0f, 0xf7, 0x53, 0x06, 0xf7, 0xad, 0x5b, 0x0f,
+	0xef, 0xfe, 0xed, 0xcd, 0x9a, 0xf5, 0xf7, 0x37, 0x6b, 0xd6, 0xbf, 0xde, 0xac, 0x59, 0x9f, 0xdc,
+	0x3c, 0xf0, 0xf8, 0xe1, 0x70, 0xff, 0x4e, 0x2f, 0x18, 0xb4, 0x9d, 0x80, 0x51, 0xce, 0xc9, 0xe3,
+	0x7e, 0xf0, 0xb2, 0xbd, 0xa5, 0xfc, 0xdc, 0xde, 0x09, 0xda, 0xfa, 0x8f, 0xf8, 0xfd, 0x39, 0xf9,
+	0xf7, 0xfa, 0xb7, 0xff, 0x37, 0x00, 0xdb, 0x95, 0x56, 0x7d, 0xdb, 0x1f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2228,6 +2576,8 @@ type YarnServiceClient interface {
 	UpdateDataNode(ctx context.Context, in *UpdateDataNodeRequest, opts ...grpc.CallOption) (*SetDataNodeResponse, error)
 	// 删除数据服务信息
 	DeleteDataNode(ctx context.Context, in *DeleteRegisteredNodeRequest, opts ...grpc.CallOption) (*SimpleResponseCode, error)
+	// 排空or恢复数据服务 (被排空的数据服务不再参与新任务的调度)
+	DrainDataNode(ctx context.Context, in *DrainRegisteredNodeRequest, opts ...grpc.CallOption) (*SimpleResponseCode, error)
 	// 查询数据服务列表
 	GetDataNodeList(ctx context.Context, in *EmptyGetParams, opts ...grpc.CallOption) (*GetRegisteredNodeListResponse, error)
 	// about jobNode
@@ -2237,6 +2587,8 @@ type YarnServiceClient interface {
 	UpdateJobNode(ctx context.Context, in *UpdateJobNodeRequest, opts ...grpc.CallOption) (*SetJobNodeResponse, error)
 	// 删除计算服务信息
 	DeleteJobNode(ctx context.Context, in *DeleteRegisteredNodeRequest, opts ...grpc.CallOption) (*SimpleResponseCode, error)
+	// 排空or恢复计算服务 (被排空的计算服务不再参与新任务的调度)
+	DrainJobNode(ctx context.Context, in *DrainRegisteredNodeRequest, opts ...grpc.CallOption) (*SimpleResponseCode, error)
 	// 查询计算服务列表
 	GetJobNodeList(ctx context.Context, in *EmptyGetParams, opts ...grpc.CallOption) (*GetRegisteredNodeListResponse, error)
 	// about report
@@ -2250,6 +2602,9 @@ type YarnServiceClient interface {
 	QueryAvailableDataNode(ctx context.Context, in *QueryAvailableDataNodeRequest, opts ...grpc.CallOption) (*QueryAvailableDataNodeResponse, error)
 	// 查询需要下载的目标原始文件所在的 数据服务信息和文件的完整相对路径
 	QueryFilePosition(ctx context.Context, in *QueryFilePositionRequest, opts ...grpc.CallOption) (*QueryFilePositionResponse, error)
+	// about admin
+	// 备份调度服务的本地数据库
+	BackupDatabase(ctx context.Context, in *BackupDatabaseRequest, opts ...grpc.CallOption) (*BackupDatabaseResponse, error)
 }
 
 type yarnServiceClient struct {
@@ -2341,9 +2696,18 @@ func (c *yarnServiceClient) DeleteDataNode(ctx context.Context, in *DeleteRegist
 	return out, nil
 }
 
-func (c *yarnServiceClient) GetDataNodeList(ctx context.Context, in *EmptyGetParams, opts ...grpc.CallOption) (*GetRegisteredNodeListResponse, error) {
-	out := new(GetRegisteredNodeListResponse)
-	err := c.cc.Invoke(ctx, "/rpcapi.YarnService/GetDataNodeList", in, out, opts...)
+func (c *yarnServiceClient) DrainDataNode(ctx context.Context, in *DrainRegisteredNodeRequest, opts ...grpc.CallOption) (*SimpleResponseCode, error) {
+	out := new(SimpleResponseCode)
+	err := c.cc.Invoke(ctx, "/rpcapi.YarnService/DrainDataNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *yarnServiceClient) GetDataNodeList(ctx context.Context, in *EmptyGetParams, opts ...grpc.CallOption) (*GetRegisteredNodeListResponse, error) {
+	out := new(GetRegisteredNodeListResponse)
+	err := c.cc.Invoke(ctx, "/rpcapi.YarnService/GetDataNodeList", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -2377,6 +2741,15 @@ func (c *yarnServiceClient) DeleteJobNode(ctx context.Context, in *DeleteRegiste
 	return out, nil
 }
 
+func (c *yarnServiceClient) DrainJobNode(ctx context.Context, in *DrainRegisteredNodeRequest, opts ...grpc.CallOption) (*SimpleResponseCode, error) {
+	out := new(SimpleResponseCode)
+	err := c.cc.Invoke(ctx, "/rpcapi.YarnService/DrainJobNode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *yarnServiceClient) GetJobNodeList(ctx context.Context, in *EmptyGetParams, opts ...grpc.CallOption) (*GetRegisteredNodeListResponse, error) {
 	out := new(GetRegisteredNodeListResponse)
 	err := c.cc.Invoke(ctx, "/rpcapi.YarnService/GetJobNodeList", in, out, opts...)
@@ -2431,6 +2804,15 @@ func (c *yarnServiceClient) QueryFilePosition(ctx context.Context, in *QueryFile
 	return out, nil
 }
 
+func (c *yarnServiceClient) BackupDatabase(ctx context.Context, in *BackupDatabaseRequest, opts ...grpc.CallOption) (*BackupDatabaseResponse, error) {
+	out := new(BackupDatabaseResponse)
+	err := c.cc.Invoke(ctx, "/rpcapi.YarnService/BackupDatabase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // YarnServiceServer is the server API for YarnService service.
 type YarnServiceServer interface {
 	// Getter YarnNode ...
@@ -2454,6 +2836,8 @@ type YarnServiceServer interface {
 	UpdateDataNode(context.Context, *UpdateDataNodeRequest) (*SetDataNodeResponse, error)
 	// 删除数据服务信息
 	DeleteDataNode(context.Context, *DeleteRegisteredNodeRequest) (*SimpleResponseCode, error)
+	// 排空or恢复数据服务 (被排空的数据服务不再参与新任务的调度)
+	DrainDataNode(context.Context, *DrainRegisteredNodeRequest) (*SimpleResponseCode, error)
 	// 查询数据服务列表
 	GetDataNodeList(context.Context, *EmptyGetParams) (*GetRegisteredNodeListResponse, error)
 	// about jobNode
@@ -2463,6 +2847,8 @@ type YarnServiceServer interface {
 	UpdateJobNode(context.Context, *UpdateJobNodeRequest) (*SetJobNodeResponse, error)
 	// 删除计算服务信息
 	DeleteJobNode(context.Context, *DeleteRegisteredNodeRequest) (*SimpleResponseCode, error)
+	// 排空or恢复计算服务 (被排空的计算服务不再参与新任务的调度)
+	DrainJobNode(context.Context, *DrainRegisteredNodeRequest) (*SimpleResponseCode, error)
 	// 查询计算服务列表
 	GetJobNodeList(context.Context, *EmptyGetParams) (*GetRegisteredNodeListResponse, error)
 	// about report
@@ -2476,6 +2862,9 @@ type YarnServiceServer interface {
 	QueryAvailableDataNode(context.Context, *QueryAvailableDataNodeRequest) (*QueryAvailableDataNodeResponse, error)
 	// 查询需要下载的目标原始文件所在的 数据服务信息和文件的完整相对路径
 	QueryFilePosition(context.Context, *QueryFilePositionRequest) (*QueryFilePositionResponse, error)
+	// about admin
+	// 备份调度服务的本地数据库
+	BackupDatabase(context.Context, *BackupDatabaseRequest) (*BackupDatabaseResponse, error)
 }
 
 // UnimplementedYarnServiceServer can be embedded to have forward compatible implementations.
@@ -2509,6 +2898,9 @@ func (*UnimplementedYarnServiceServer) UpdateDataNode(ctx context.Context, req *
 func (*UnimplementedYarnServiceServer) DeleteDataNode(ctx context.Context, req *DeleteRegisteredNodeRequest) (*SimpleResponseCode, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteDataNode not implemented")
 }
+func (*UnimplementedYarnServiceServer) DrainDataNode(ctx context.Context, req *DrainRegisteredNodeRequest) (*SimpleResponseCode, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DrainDataNode not implemented")
+}
 func (*UnimplementedYarnServiceServer) GetDataNodeList(ctx context.Context, req *EmptyGetParams) (*GetRegisteredNodeListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDataNodeList not implemented")
 }
@@ -2521,6 +2913,9 @@ func (*UnimplementedYarnServiceServer) UpdateJobNode(ctx context.Context, req *U
 func (*UnimplementedYarnServiceServer) DeleteJobNode(ctx context.Context, req *DeleteRegisteredNodeRequest) (*SimpleResponseCode, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteJobNode not implemented")
 }
+func (*UnimplementedYarnServiceServer) DrainJobNode(ctx context.Context, req *DrainRegisteredNodeRequest) (*SimpleResponseCode, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DrainJobNode not implemented")
+}
 func (*UnimplementedYarnServiceServer) GetJobNodeList(ctx context.Context, req *EmptyGetParams) (*GetRegisteredNodeListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetJobNodeList not implemented")
 }
@@ -2539,6 +2934,9 @@ func (*UnimplementedYarnServiceServer) QueryAvailableDataNode(ctx context.Contex
 func (*UnimplementedYarnServiceServer) QueryFilePosition(ctx context.Context, req *QueryFilePositionRequest) (*QueryFilePositionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method QueryFilePosition not implemented")
 }
+func (*UnimplementedYarnServiceServer) BackupDatabase(ctx context.Context, req *BackupDatabaseRequest) (*BackupDatabaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BackupDatabase not implemented")
+}
 
 func RegisterYarnServiceServer(s *grpc.Server, srv YarnServiceServer) {
 	s.RegisterService(&_YarnService_serviceDesc, srv)
@@ -2706,6 +3104,24 @@ func _YarnService_DeleteDataNode_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _YarnService_DrainDataNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrainRegisteredNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(YarnServiceServer).DrainDataNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcapi.YarnService/DrainDataNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YarnServiceServer).DrainDataNode(ctx, req.(*DrainRegisteredNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _YarnService_GetDataNodeList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(EmptyGetParams)
 	if err := dec(in); err != nil {
@@ -2778,6 +3194,24 @@ func _YarnService_DeleteJobNode_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _YarnService_DrainJobNode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DrainRegisteredNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(YarnServiceServer).DrainJobNode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcapi.YarnService/DrainJobNode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YarnServiceServer).DrainJobNode(ctx, req.(*DrainRegisteredNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _YarnService_GetJobNodeList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(EmptyGetParams)
 	if err := dec(in); err != nil {
@@ -2886,6 +3320,24 @@ func _YarnService_QueryFilePosition_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _YarnService_BackupDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BackupDatabaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(YarnServiceServer).BackupDatabase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcapi.YarnService/BackupDatabase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(YarnServiceServer).BackupDatabase(ctx, req.(*BackupDatabaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _YarnService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "rpcapi.YarnService",
 	HandlerType: (*YarnServiceServer)(nil),
@@ -2926,6 +3378,10 @@ var _YarnService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteDataNode",
 			Handler:    _YarnService_DeleteDataNode_Handler,
 		},
+		{
+			MethodName: "DrainDataNode",
+			Handler:    _YarnService_DrainDataNode_Handler,
+		},
 		{
 			MethodName: "GetDataNodeList",
 			Handler:    _YarnService_GetDataNodeList_Handler,
@@ -2942,6 +3398,10 @@ var _YarnService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteJobNode",
 			Handler:    _YarnService_DeleteJobNode_Handler,
 		},
+		{
+			MethodName: "DrainJobNode",
+			Handler:    _YarnService_DrainJobNode_Handler,
+		},
 		{
 			MethodName: "GetJobNodeList",
 			Handler:    _YarnService_GetJobNodeList_Handler,
@@ -2966,6 +3426,10 @@ var _YarnService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "QueryFilePosition",
 			Handler:    _YarnService_QueryFilePosition_Handler,
 		},
+		{
+			MethodName: "BackupDatabase",
+			Handler:    _YarnService_BackupDatabase_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "lib/api/sys_rpc_api.proto",
@@ -3362,6 +3826,18 @@ func (m *YarnRegisteredJobNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Health != nil {
+		{
+			size, err := m.Health.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSysRpcApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
 	if m.Task != nil {
 		{
 			size, err := m.Task.MarshalToSizedBuffer(dAtA[:i])
@@ -3494,6 +3970,18 @@ func (m *YarnRegisteredDataNode) MarshalToSizedBuffer(dAtA []byte) (int, error)
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Health != nil {
+		{
+			size, err := m.Health.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintSysRpcApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
 	if m.Delta != nil {
 		{
 			size, err := m.Delta.MarshalToSizedBuffer(dAtA[:i])
@@ -3561,6 +4049,106 @@ func (m *YarnRegisteredDataNode) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *YarnRegisteredNodeHealth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *YarnRegisteredNodeHealth) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *YarnRegisteredNodeHealth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.LastError) > 0 {
+		i -= len(m.LastError)
+		copy(dAtA[i:], m.LastError)
+		i = encodeVarintSysRpcApi(dAtA, i, uint64(len(m.LastError)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.LastCheckAt != 0 {
+		i = encodeVarintSysRpcApi(dAtA, i, uint64(m.LastCheckAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FailureCount != 0 {
+		i = encodeVarintSysRpcApi(dAtA, i, uint64(m.FailureCount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Latency != 0 {
+		i = encodeVarintSysRpcApi(dAtA, i, uint64(m.Latency))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.State) > 0 {
+		i -= len(m.State)
+		copy(dAtA[i:], m.State)
+		i = encodeVarintSysRpcApi(dAtA, i, uint64(len(m.State)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DrainRegisteredNodeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DrainRegisteredNodeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DrainRegisteredNodeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Drained {
+		i--
+		if m.Drained {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintSysRpcApi(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *YarnRegisteredDataNodeDelta) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4361,6 +4949,47 @@ func (m *ReportTaskResourceExpenseRequest) MarshalToSizedBuffer(dAtA []byte) (in
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.UsedDuration != 0 {
+		i = encodeVarintSysRpcApi(dAtA, i, uint64(m.UsedDuration))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.UsedBandwidth != 0 {
+		i = encodeVarintSysRpcApi(dAtA, i, uint64(m.UsedBandwidth))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.UsedProcessor != 0 {
+		i = encodeVarintSysRpcApi(dAtA, i, uint64(m.UsedProcessor))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.UsedMem != 0 {
+		i = encodeVarintSysRpcApi(dAtA, i, uint64(m.UsedMem))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.JobNodeId) > 0 {
+		i -= len(m.JobNodeId)
+		copy(dAtA[i:], m.JobNodeId)
+		i = encodeVarintSysRpcApi(dAtA, i, uint64(len(m.JobNodeId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PartyId) > 0 {
+		i -= len(m.PartyId)
+		copy(dAtA[i:], m.PartyId)
+		i = encodeVarintSysRpcApi(dAtA, i, uint64(len(m.PartyId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintSysRpcApi(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -4581,48 +5210,133 @@ func (m *QueryFilePositionResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintSysRpcApi(dAtA []byte, offset int, v uint64) int {
-	offset -= sovSysRpcApi(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *BackupDatabaseRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *YarnNodeInfo) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *BackupDatabaseRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BackupDatabaseRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.NodeType)
-	if l > 0 {
-		n += 1 + l + sovSysRpcApi(uint64(l))
-	}
-	l = len(m.NodeId)
-	if l > 0 {
-		n += 1 + l + sovSysRpcApi(uint64(l))
-	}
-	l = len(m.InternalIp)
-	if l > 0 {
-		n += 1 + l + sovSysRpcApi(uint64(l))
-	}
-	l = len(m.ExternalIp)
-	if l > 0 {
-		n += 1 + l + sovSysRpcApi(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.InternalPort)
-	if l > 0 {
-		n += 1 + l + sovSysRpcApi(uint64(l))
+	if len(m.OutputFile) > 0 {
+		i -= len(m.OutputFile)
+		copy(dAtA[i:], m.OutputFile)
+		i = encodeVarintSysRpcApi(dAtA, i, uint64(len(m.OutputFile)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.ExternalPort)
-	if l > 0 {
-		n += 1 + l + sovSysRpcApi(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *BackupDatabaseResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.IdentityType)
+	return dAtA[:n], nil
+}
+
+func (m *BackupDatabaseResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BackupDatabaseResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.KeyCount != 0 {
+		i = encodeVarintSysRpcApi(dAtA, i, uint64(m.KeyCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.FilePath) > 0 {
+		i -= len(m.FilePath)
+		copy(dAtA[i:], m.FilePath)
+		i = encodeVarintSysRpcApi(dAtA, i, uint64(len(m.FilePath)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintSysRpcApi(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintSysRpcApi(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSysRpcApi(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSysRpcApi(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *YarnNodeInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NodeType)
+	if l > 0 {
+		n += 1 + l + sovSysRpcApi(uint64(l))
+	}
+	l = len(m.NodeId)
+	if l > 0 {
+		n += 1 + l + sovSysRpcApi(uint64(l))
+	}
+	l = len(m.InternalIp)
+	if l > 0 {
+		n += 1 + l + sovSysRpcApi(uint64(l))
+	}
+	l = len(m.ExternalIp)
+	if l > 0 {
+		n += 1 + l + sovSysRpcApi(uint64(l))
+	}
+	l = len(m.InternalPort)
+	if l > 0 {
+		n += 1 + l + sovSysRpcApi(uint64(l))
+	}
+	l = len(m.ExternalPort)
+	if l > 0 {
+		n += 1 + l + sovSysRpcApi(uint64(l))
+	}
+	l = len(m.IdentityType)
 	if l > 0 {
 		n += 1 + l + sovSysRpcApi(uint64(l))
 	}
@@ -4813,6 +5527,10 @@ func (m *YarnRegisteredJobNode) Size() (n int) {
 		l = m.Task.Size()
 		n += 1 + l + sovSysRpcApi(uint64(l))
 	}
+	if m.Health != nil {
+		l = m.Health.Size()
+		n += 1 + l + sovSysRpcApi(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -4877,6 +5595,58 @@ func (m *YarnRegisteredDataNode) Size() (n int) {
 		l = m.Delta.Size()
 		n += 1 + l + sovSysRpcApi(uint64(l))
 	}
+	if m.Health != nil {
+		l = m.Health.Size()
+		n += 1 + l + sovSysRpcApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *YarnRegisteredNodeHealth) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovSysRpcApi(uint64(l))
+	}
+	if m.Latency != 0 {
+		n += 1 + sovSysRpcApi(uint64(m.Latency))
+	}
+	if m.FailureCount != 0 {
+		n += 1 + sovSysRpcApi(uint64(m.FailureCount))
+	}
+	if m.LastCheckAt != 0 {
+		n += 1 + sovSysRpcApi(uint64(m.LastCheckAt))
+	}
+	l = len(m.LastError)
+	if l > 0 {
+		n += 1 + l + sovSysRpcApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *DrainRegisteredNodeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovSysRpcApi(uint64(l))
+	}
+	if m.Drained {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -5260,6 +6030,30 @@ func (m *ReportTaskResourceExpenseRequest) Size() (n int) {
 	}
 	var l int
 	_ = l
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovSysRpcApi(uint64(l))
+	}
+	l = len(m.PartyId)
+	if l > 0 {
+		n += 1 + l + sovSysRpcApi(uint64(l))
+	}
+	l = len(m.JobNodeId)
+	if l > 0 {
+		n += 1 + l + sovSysRpcApi(uint64(l))
+	}
+	if m.UsedMem != 0 {
+		n += 1 + sovSysRpcApi(uint64(m.UsedMem))
+	}
+	if m.UsedProcessor != 0 {
+		n += 1 + sovSysRpcApi(uint64(m.UsedProcessor))
+	}
+	if m.UsedBandwidth != 0 {
+		n += 1 + sovSysRpcApi(uint64(m.UsedBandwidth))
+	}
+	if m.UsedDuration != 0 {
+		n += 1 + sovSysRpcApi(uint64(m.UsedDuration))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -5373,6 +6167,48 @@ func (m *QueryFilePositionResponse) Size() (n int) {
 	return n
 }
 
+func (m *BackupDatabaseRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OutputFile)
+	if l > 0 {
+		n += 1 + l + sovSysRpcApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *BackupDatabaseResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovSysRpcApi(uint64(m.Status))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovSysRpcApi(uint64(l))
+	}
+	l = len(m.FilePath)
+	if l > 0 {
+		n += 1 + l + sovSysRpcApi(uint64(l))
+	}
+	if m.KeyCount != 0 {
+		n += 1 + sovSysRpcApi(uint64(m.KeyCount))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovSysRpcApi(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -6846,6 +7682,42 @@ func (m *YarnRegisteredJobNode) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSysRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSysRpcApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSysRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Health == nil {
+				m.Health = &YarnRegisteredNodeHealth{}
+			}
+			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSysRpcApi(dAtA[iNdEx:])
@@ -7250,6 +8122,42 @@ func (m *YarnRegisteredDataNode) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSysRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSysRpcApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSysRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Health == nil {
+				m.Health = &YarnRegisteredNodeHealth{}
+			}
+			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSysRpcApi(dAtA[iNdEx:])
@@ -7272,7 +8180,7 @@ func (m *YarnRegisteredDataNode) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *YarnRegisteredDataNodeDelta) Unmarshal(dAtA []byte) error {
+func (m *YarnRegisteredNodeHealth) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7295,17 +8203,17 @@ func (m *YarnRegisteredDataNodeDelta) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: YarnRegisteredDataNodeDelta: wiretype end group for non-group")
+			return fmt.Errorf("proto: YarnRegisteredNodeHealth: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: YarnRegisteredDataNodeDelta: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: YarnRegisteredNodeHealth: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FileCount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			m.FileCount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSysRpcApi
@@ -7315,13 +8223,288 @@ func (m *YarnRegisteredDataNodeDelta) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FileCount |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSysRpcApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSysRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.State = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Latency", wireType)
+			}
+			m.Latency = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSysRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Latency |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureCount", wireType)
+			}
+			m.FailureCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSysRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailureCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastCheckAt", wireType)
+			}
+			m.LastCheckAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSysRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastCheckAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSysRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSysRpcApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSysRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSysRpcApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSysRpcApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DrainRegisteredNodeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSysRpcApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DrainRegisteredNodeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DrainRegisteredNodeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSysRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSysRpcApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSysRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Drained", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSysRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Drained = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSysRpcApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSysRpcApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *YarnRegisteredDataNodeDelta) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSysRpcApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: YarnRegisteredDataNodeDelta: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: YarnRegisteredDataNodeDelta: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileCount", wireType)
+			}
+			m.FileCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSysRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FileCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field FileTotalSize", wireType)
 			}
 			m.FileTotalSize = 0
@@ -9494,25 +10677,197 @@ func (m *ReportTaskResourceExpenseRequest) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowSysRpcApi
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReportTaskResourceExpenseRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReportTaskResourceExpenseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSysRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSysRpcApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSysRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSysRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSysRpcApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSysRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PartyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobNodeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSysRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSysRpcApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSysRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobNodeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsedMem", wireType)
+			}
+			m.UsedMem = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSysRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UsedMem |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsedProcessor", wireType)
+			}
+			m.UsedProcessor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSysRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UsedProcessor |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsedBandwidth", wireType)
+			}
+			m.UsedBandwidth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSysRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UsedBandwidth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsedDuration", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.UsedDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSysRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UsedDuration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ReportTaskResourceExpenseRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReportTaskResourceExpenseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSysRpcApi(dAtA[iNdEx:])
@@ -10161,6 +11516,242 @@ func (m *QueryFilePositionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *BackupDatabaseRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSysRpcApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BackupDatabaseRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BackupDatabaseRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutputFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSysRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSysRpcApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSysRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OutputFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSysRpcApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSysRpcApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BackupDatabaseResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSysRpcApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BackupDatabaseResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BackupDatabaseResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSysRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSysRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSysRpcApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSysRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FilePath", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSysRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSysRpcApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSysRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FilePath = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyCount", wireType)
+			}
+			m.KeyCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSysRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSysRpcApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSysRpcApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipSysRpcApi(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -41,6 +41,12 @@ type TaskDetailShow struct {
 	EndAt                uint64                          `protobuf:"varint,10,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`
 	State                string                          `protobuf:"bytes,11,opt,name=state,proto3" json:"state,omitempty"`
 	OperationCost        *TaskOperationCostDeclare       `protobuf:"bytes,12,opt,name=operation_cost,json=operationCost,proto3" json:"operation_cost,omitempty"`
+	Attempt              uint32                          `protobuf:"varint,13,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	MaxAttempts          uint32                          `protobuf:"varint,14,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
+	OriginTaskId         string                          `protobuf:"bytes,15,opt,name=origin_task_id,json=originTaskId,proto3" json:"origin_task_id,omitempty"`
+	Priority             uint32                          `protobuf:"varint,16,opt,name=priority,proto3" json:"priority,omitempty"`
+	StartDeadline        uint64                          `protobuf:"varint,17,opt,name=start_deadline,json=startDeadline,proto3" json:"start_deadline,omitempty"`
+	FinishDeadline       uint64                          `protobuf:"varint,18,opt,name=finish_deadline,json=finishDeadline,proto3" json:"finish_deadline,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
 	XXX_unrecognized     []byte                          `json:"-"`
 	XXX_sizecache        int32                           `json:"-"`
@@ -163,6 +169,48 @@ func (m *TaskDetailShow) GetOperationCost() *TaskOperationCostDeclare {
 	return nil
 }
 
+func (m *TaskDetailShow) GetAttempt() uint32 {
+	if m != nil {
+		return m.Attempt
+	}
+	return 0
+}
+
+func (m *TaskDetailShow) GetMaxAttempts() uint32 {
+	if m != nil {
+		return m.MaxAttempts
+	}
+	return 0
+}
+
+func (m *TaskDetailShow) GetOriginTaskId() string {
+	if m != nil {
+		return m.OriginTaskId
+	}
+	return ""
+}
+
+func (m *TaskDetailShow) GetPriority() uint32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *TaskDetailShow) GetStartDeadline() uint64 {
+	if m != nil {
+		return m.StartDeadline
+	}
+	return 0
+}
+
+func (m *TaskDetailShow) GetFinishDeadline() uint64 {
+	if m != nil {
+		return m.FinishDeadline
+	}
+	return 0
+}
+
 // 任务数据提供方信息 (任务详情展示用)
 type TaskDataSupplierShow struct {
 	MemberInfo           *TaskOrganizationIdentityInfo `protobuf:"bytes,1,opt,name=member_info,json=memberInfo,proto3" json:"member_info,omitempty"`
@@ -290,6 +338,7 @@ type TaskEventShow struct {
 	Owner                *OrganizationIdentityInfo `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
 	Content              string                    `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
 	CreateAt             uint64                    `protobuf:"varint,5,opt,name=create_at,json=createAt,proto3" json:"create_at,omitempty"`
+	Cursor               string                    `protobuf:"bytes,6,opt,name=cursor,proto3" json:"cursor,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
 	XXX_unrecognized     []byte                    `json:"-"`
 	XXX_sizecache        int32                     `json:"-"`
@@ -363,6 +412,13 @@ func (m *TaskEventShow) GetCreateAt() uint64 {
 	return 0
 }
 
+func (m *TaskEventShow) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
 type TaskEventDeclare struct {
 	Type                 string   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
 	TaskId               string   `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
@@ -892,6 +948,85 @@ func (m *GetTaskEventListByTaskIdsRequest) GetTaskIds() []string {
 	return nil
 }
 
+type SubscribeTaskEventsRequest struct {
+	TaskIds              []string `protobuf:"bytes,1,rep,name=task_ids,json=taskIds,proto3" json:"task_ids,omitempty"`
+	TypePrefixes         []string `protobuf:"bytes,2,rep,name=type_prefixes,json=typePrefixes,proto3" json:"type_prefixes,omitempty"`
+	IdentityIds          []string `protobuf:"bytes,3,rep,name=identity_ids,json=identityIds,proto3" json:"identity_ids,omitempty"`
+	Since                uint64   `protobuf:"varint,4,opt,name=since,proto3" json:"since,omitempty"`
+	Cursor               string   `protobuf:"bytes,5,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubscribeTaskEventsRequest) Reset()         { *m = SubscribeTaskEventsRequest{} }
+func (m *SubscribeTaskEventsRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeTaskEventsRequest) ProtoMessage()    {}
+func (*SubscribeTaskEventsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a744901dce4e8cd, []int{13}
+}
+func (m *SubscribeTaskEventsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SubscribeTaskEventsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SubscribeTaskEventsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SubscribeTaskEventsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeTaskEventsRequest.Merge(m, src)
+}
+func (m *SubscribeTaskEventsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *SubscribeTaskEventsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeTaskEventsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeTaskEventsRequest proto.InternalMessageInfo
+
+func (m *SubscribeTaskEventsRequest) GetTaskIds() []string {
+	if m != nil {
+		return m.TaskIds
+	}
+	return nil
+}
+
+func (m *SubscribeTaskEventsRequest) GetTypePrefixes() []string {
+	if m != nil {
+		return m.TypePrefixes
+	}
+	return nil
+}
+
+func (m *SubscribeTaskEventsRequest) GetIdentityIds() []string {
+	if m != nil {
+		return m.IdentityIds
+	}
+	return nil
+}
+
+func (m *SubscribeTaskEventsRequest) GetSince() uint64 {
+	if m != nil {
+		return m.Since
+	}
+	return 0
+}
+
+func (m *SubscribeTaskEventsRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
 type GetTaskEventListResponse struct {
 	Status               int32            `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
 	Msg                  string           `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
@@ -905,7 +1040,7 @@ func (m *GetTaskEventListResponse) Reset()         { *m = GetTaskEventListRespon
 func (m *GetTaskEventListResponse) String() string { return proto.CompactTextString(m) }
 func (*GetTaskEventListResponse) ProtoMessage()    {}
 func (*GetTaskEventListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a744901dce4e8cd, []int{13}
+	return fileDescriptor_7a744901dce4e8cd, []int{14}
 }
 func (m *GetTaskEventListResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -965,6 +1100,10 @@ type PublishTaskDeclareRequest struct {
 	CalculateContractcode string                        `protobuf:"bytes,7,opt,name=calculate_contractcode,json=calculateContractcode,proto3" json:"calculate_contractcode,omitempty"`
 	DatasplitContractcode string                        `protobuf:"bytes,8,opt,name=datasplit_contractcode,json=datasplitContractcode,proto3" json:"datasplit_contractcode,omitempty"`
 	ContractExtraParams   string                        `protobuf:"bytes,9,opt,name=contract_extra_params,json=contractExtraParams,proto3" json:"contract_extra_params,omitempty"`
+	RetryPolicy           *TaskRetryPolicyDeclare       `protobuf:"bytes,10,opt,name=retry_policy,json=retryPolicy,proto3" json:"retry_policy,omitempty"`
+	Priority              uint32                        `protobuf:"varint,11,opt,name=priority,proto3" json:"priority,omitempty"`
+	StartDeadline         uint64                        `protobuf:"varint,12,opt,name=start_deadline,json=startDeadline,proto3" json:"start_deadline,omitempty"`
+	FinishDeadline        uint64                        `protobuf:"varint,13,opt,name=finish_deadline,json=finishDeadline,proto3" json:"finish_deadline,omitempty"`
 	XXX_NoUnkeyedLiteral  struct{}                      `json:"-"`
 	XXX_unrecognized      []byte                        `json:"-"`
 	XXX_sizecache         int32                         `json:"-"`
@@ -974,7 +1113,7 @@ func (m *PublishTaskDeclareRequest) Reset()         { *m = PublishTaskDeclareReq
 func (m *PublishTaskDeclareRequest) String() string { return proto.CompactTextString(m) }
 func (*PublishTaskDeclareRequest) ProtoMessage()    {}
 func (*PublishTaskDeclareRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a744901dce4e8cd, []int{14}
+	return fileDescriptor_7a744901dce4e8cd, []int{15}
 }
 func (m *PublishTaskDeclareRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1066,6 +1205,98 @@ func (m *PublishTaskDeclareRequest) GetContractExtraParams() string {
 	return ""
 }
 
+func (m *PublishTaskDeclareRequest) GetRetryPolicy() *TaskRetryPolicyDeclare {
+	if m != nil {
+		return m.RetryPolicy
+	}
+	return nil
+}
+
+func (m *PublishTaskDeclareRequest) GetPriority() uint32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *PublishTaskDeclareRequest) GetStartDeadline() uint64 {
+	if m != nil {
+		return m.StartDeadline
+	}
+	return 0
+}
+
+func (m *PublishTaskDeclareRequest) GetFinishDeadline() uint64 {
+	if m != nil {
+		return m.FinishDeadline
+	}
+	return 0
+}
+
+// 任务的重试策略声明
+type TaskRetryPolicyDeclare struct {
+	MaxAttempts          uint32   `protobuf:"varint,1,opt,name=max_attempts,json=maxAttempts,proto3" json:"max_attempts,omitempty"`
+	Backoff              uint64   `protobuf:"varint,2,opt,name=backoff,proto3" json:"backoff,omitempty"`
+	ReelectPower         bool     `protobuf:"varint,3,opt,name=reelect_power,json=reelectPower,proto3" json:"reelect_power,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TaskRetryPolicyDeclare) Reset()         { *m = TaskRetryPolicyDeclare{} }
+func (m *TaskRetryPolicyDeclare) String() string { return proto.CompactTextString(m) }
+func (*TaskRetryPolicyDeclare) ProtoMessage()    {}
+func (*TaskRetryPolicyDeclare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a744901dce4e8cd, []int{16}
+}
+func (m *TaskRetryPolicyDeclare) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskRetryPolicyDeclare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskRetryPolicyDeclare.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TaskRetryPolicyDeclare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskRetryPolicyDeclare.Merge(m, src)
+}
+func (m *TaskRetryPolicyDeclare) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskRetryPolicyDeclare) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskRetryPolicyDeclare.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskRetryPolicyDeclare proto.InternalMessageInfo
+
+func (m *TaskRetryPolicyDeclare) GetMaxAttempts() uint32 {
+	if m != nil {
+		return m.MaxAttempts
+	}
+	return 0
+}
+
+func (m *TaskRetryPolicyDeclare) GetBackoff() uint64 {
+	if m != nil {
+		return m.Backoff
+	}
+	return 0
+}
+
+func (m *TaskRetryPolicyDeclare) GetReelectPower() bool {
+	if m != nil {
+		return m.ReelectPower
+	}
+	return false
+}
+
 type PublishTaskDeclareResponse struct {
 	Status               int32    `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
 	Msg                  string   `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
@@ -1079,7 +1310,7 @@ func (m *PublishTaskDeclareResponse) Reset()         { *m = PublishTaskDeclareRe
 func (m *PublishTaskDeclareResponse) String() string { return proto.CompactTextString(m) }
 func (*PublishTaskDeclareResponse) ProtoMessage()    {}
 func (*PublishTaskDeclareResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7a744901dce4e8cd, []int{15}
+	return fileDescriptor_7a744901dce4e8cd, []int{17}
 }
 func (m *PublishTaskDeclareResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1129,1723 +1360,10175 @@ func (m *PublishTaskDeclareResponse) GetTaskId() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*TaskDetailShow)(nil), "rpcapi.TaskDetailShow")
-	proto.RegisterType((*TaskDataSupplierShow)(nil), "rpcapi.TaskDataSupplierShow")
-	proto.RegisterType((*TaskPowerSupplierShow)(nil), "rpcapi.TaskPowerSupplierShow")
-	proto.RegisterType((*TaskEventShow)(nil), "rpcapi.TaskEventShow")
-	proto.RegisterType((*TaskEventDeclare)(nil), "rpcapi.TaskEventDeclare")
-	proto.RegisterType((*TaskDataSupplierDeclare)(nil), "rpcapi.TaskDataSupplierDeclare")
-	proto.RegisterType((*TaskMetaDataDeclare)(nil), "rpcapi.TaskMetaDataDeclare")
-	proto.RegisterType((*TaskResultReceiverDeclare)(nil), "rpcapi.TaskResultReceiverDeclare")
-	proto.RegisterType((*TaskOperationCostDeclare)(nil), "rpcapi.TaskOperationCostDeclare")
-	proto.RegisterType((*GetTaskDetailResponse)(nil), "rpcapi.GetTaskDetailResponse")
-	proto.RegisterType((*GetTaskDetailListResponse)(nil), "rpcapi.GetTaskDetailListResponse")
-	proto.RegisterType((*GetTaskEventListRequest)(nil), "rpcapi.GetTaskEventListRequest")
-	proto.RegisterType((*GetTaskEventListByTaskIdsRequest)(nil), "rpcapi.GetTaskEventListByTaskIdsRequest")
-	proto.RegisterType((*GetTaskEventListResponse)(nil), "rpcapi.GetTaskEventListResponse")
-	proto.RegisterType((*PublishTaskDeclareRequest)(nil), "rpcapi.PublishTaskDeclareRequest")
-	proto.RegisterType((*PublishTaskDeclareResponse)(nil), "rpcapi.PublishTaskDeclareResponse")
+type CancelTaskRequest struct {
+	TaskId               string   `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() { proto.RegisterFile("lib/api/task_rpc_api.proto", fileDescriptor_7a744901dce4e8cd) }
-
-var fileDescriptor_7a744901dce4e8cd = []byte{
-	// 1297 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcb, 0x6e, 0xdb, 0x46,
-	0x17, 0x06, 0x25, 0x59, 0x96, 0x8e, 0x2c, 0x27, 0x99, 0x44, 0x09, 0xad, 0x24, 0xb6, 0xc2, 0x24,
-	0x3f, 0xf4, 0x07, 0xa8, 0x85, 0xba, 0xe8, 0x05, 0x06, 0x82, 0xc2, 0x8e, 0x5d, 0x43, 0x40, 0xd3,
-	0x1a, 0x4c, 0xba, 0xe9, 0x86, 0x18, 0x93, 0x13, 0x79, 0x10, 0x92, 0xc3, 0xce, 0x8c, 0xec, 0xb8,
-	0xe8, 0xa2, 0x97, 0x7d, 0x37, 0x5d, 0x04, 0x28, 0x50, 0x74, 0x51, 0xb4, 0x9b, 0xbe, 0x48, 0x96,
-	0x05, 0xfa, 0x02, 0x45, 0xd0, 0x07, 0x29, 0x66, 0x86, 0xa4, 0x48, 0x5d, 0xda, 0x38, 0xc8, 0x8e,
-	0x73, 0xae, 0xdf, 0x9c, 0xdb, 0x1c, 0x42, 0x37, 0xa4, 0x47, 0x03, 0x9c, 0xd0, 0x81, 0xc4, 0xe2,
-	0xa9, 0xc7, 0x13, 0xdf, 0xc3, 0x09, 0xdd, 0x4c, 0x38, 0x93, 0x0c, 0xd5, 0x79, 0xe2, 0xe3, 0x84,
-	0x76, 0x6f, 0x64, 0x32, 0x3e, 0x8b, 0x22, 0x16, 0x7b, 0x11, 0x11, 0x02, 0x8f, 0x88, 0x91, 0xea,
-	0xde, 0x18, 0x31, 0x36, 0x0a, 0x89, 0x16, 0xc0, 0x71, 0xcc, 0x24, 0x96, 0x94, 0xc5, 0xc2, 0x70,
-	0x9d, 0x17, 0x35, 0x58, 0x7d, 0x8c, 0xc5, 0xd3, 0x3d, 0x22, 0x31, 0x0d, 0x1f, 0x1d, 0xb3, 0x53,
-	0x74, 0x0d, 0x96, 0xb5, 0x33, 0x1a, 0xd8, 0x56, 0xcf, 0xea, 0x37, 0xdd, 0xba, 0x3a, 0x0e, 0x03,
-	0x74, 0x1d, 0x9a, 0x9a, 0x11, 0xe3, 0x88, 0xd8, 0x15, 0xcd, 0x6a, 0x28, 0xc2, 0x27, 0x38, 0x22,
-	0x68, 0x1b, 0x96, 0xd8, 0x69, 0x4c, 0xb8, 0x5d, 0xed, 0x59, 0xfd, 0xd6, 0xd6, 0x9d, 0x4d, 0x03,
-	0x6e, 0x53, 0x19, 0xff, 0x94, 0x8f, 0x70, 0x4c, 0xbf, 0xd4, 0x8e, 0x87, 0x01, 0x89, 0x25, 0x95,
-	0x67, 0xc3, 0xf8, 0x09, 0x73, 0x8d, 0x0a, 0x1a, 0x42, 0x1b, 0x87, 0x23, 0xe6, 0x89, 0x71, 0x92,
-	0x84, 0x94, 0x70, 0xbb, 0x76, 0x0e, 0x1b, 0x2b, 0x4a, 0xf5, 0x51, 0xaa, 0x89, 0x76, 0xa0, 0x1d,
-	0x60, 0x89, 0x27, 0xa6, 0x96, 0x7a, 0xd5, 0x7e, 0x6b, 0xeb, 0x46, 0xd1, 0xd4, 0x1e, 0x96, 0x38,
-	0x53, 0x50, 0x37, 0x76, 0x57, 0x82, 0x02, 0x05, 0xed, 0xc1, 0x6a, 0xc2, 0x4e, 0x09, 0x9f, 0xd8,
-	0xa8, 0x6b, 0x1b, 0x37, 0x8b, 0x36, 0x0e, 0x95, 0x44, 0xc9, 0x48, 0x3b, 0x29, 0x92, 0xd0, 0x2e,
-	0x34, 0x39, 0xf1, 0x09, 0x3d, 0x21, 0x5c, 0xd8, 0xcb, 0xda, 0xc0, 0xab, 0xdd, 0x67, 0xa2, 0xa6,
-	0x02, 0xee, 0x73, 0x82, 0x25, 0xf1, 0xb0, 0xb4, 0x1b, 0x3d, 0xab, 0x5f, 0x73, 0x1b, 0x86, 0xb0,
-	0x23, 0xd1, 0x1a, 0x34, 0x84, 0xc4, 0x5c, 0x2a, 0x5e, 0x53, 0xf3, 0x96, 0xf5, 0x79, 0x47, 0xa2,
-	0x0e, 0xd4, 0x49, 0x1c, 0x28, 0x06, 0x68, 0xc6, 0x12, 0x89, 0x83, 0x1d, 0x89, 0xae, 0xc0, 0x92,
-	0x90, 0x58, 0x12, 0xbb, 0xa5, 0x73, 0x67, 0x0e, 0xe8, 0x00, 0x56, 0x59, 0x42, 0xb8, 0x06, 0xe2,
-	0xf9, 0x4c, 0x48, 0x7b, 0x45, 0x47, 0xbf, 0x57, 0x42, 0x9b, 0x49, 0x3c, 0x60, 0x42, 0xee, 0x11,
-	0x3f, 0xc4, 0x9c, 0xb8, 0x6d, 0x56, 0xa4, 0x3a, 0xbf, 0x5a, 0x70, 0x65, 0x5e, 0x78, 0xd1, 0x3e,
-	0xb4, 0x22, 0x12, 0x1d, 0x11, 0xee, 0xd1, 0xf8, 0x09, 0xd3, 0x45, 0xf5, 0xaa, 0xc1, 0x00, 0xa3,
-	0xa8, 0xbe, 0x51, 0x0f, 0x56, 0x22, 0x22, 0xb1, 0xa7, 0xf3, 0x4b, 0x83, 0xb4, 0x02, 0x41, 0xd1,
-	0x94, 0xcb, 0x61, 0x80, 0xee, 0xc0, 0xea, 0x44, 0x42, 0x57, 0x69, 0x55, 0xcb, 0xac, 0x64, 0x32,
-	0xaa, 0x52, 0x9d, 0x9f, 0x2c, 0xe8, 0xcc, 0x4d, 0xe1, 0x9b, 0x02, 0x7a, 0x1f, 0xc0, 0x14, 0x90,
-	0xb6, 0x52, 0xd1, 0x56, 0xd6, 0x33, 0x2b, 0x2e, 0x11, 0x6c, 0xcc, 0x7d, 0xf2, 0x99, 0x20, 0xc1,
-	0xa4, 0xe9, 0xdc, 0xa6, 0xd6, 0x50, 0xea, 0xce, 0xef, 0x16, 0xb4, 0x95, 0xaf, 0xfd, 0x13, 0x12,
-	0x4b, 0x8d, 0x0b, 0x41, 0x4d, 0x9e, 0x25, 0x24, 0x6d, 0x47, 0xfd, 0x5d, 0xec, 0xd2, 0x4a, 0xa9,
-	0x4b, 0xdf, 0x2b, 0x37, 0x62, 0x9e, 0xc6, 0xff, 0x6a, 0x42, 0x1b, 0x96, 0x7d, 0x16, 0x4b, 0x12,
-	0x4b, 0xdd, 0x7e, 0x4d, 0x37, 0x3b, 0x96, 0xcb, 0x70, 0xa9, 0x5c, 0x86, 0xce, 0x73, 0x0b, 0x2e,
-	0xe6, 0x68, 0xd3, 0xca, 0x38, 0x1f, 0xe0, 0x0d, 0x68, 0xd1, 0x14, 0x8f, 0x62, 0x9a, 0x94, 0x41,
-	0x46, 0x1a, 0x06, 0xaf, 0x8b, 0xec, 0x17, 0x0b, 0xae, 0x4d, 0xd7, 0x63, 0x06, 0xf0, 0x0d, 0x65,
-	0x7a, 0xa7, 0x58, 0x70, 0x85, 0x6c, 0x5f, 0x2f, 0x5a, 0x7a, 0x98, 0x16, 0x5f, 0xd6, 0x36, 0x79,
-	0x35, 0xea, 0x6c, 0xfb, 0x70, 0x79, 0x8e, 0xd0, 0x4c, 0xb1, 0x5b, 0x33, 0xc5, 0x7e, 0x0f, 0x2e,
-	0xf9, 0x2c, 0x1c, 0x47, 0xb1, 0x47, 0xe3, 0x80, 0x3c, 0xf3, 0x42, 0x2a, 0xa4, 0x5d, 0xe9, 0x55,
-	0xfb, 0x35, 0xf7, 0x82, 0x61, 0x0c, 0x15, 0xfd, 0x63, 0x2a, 0xa4, 0xf3, 0x9b, 0x05, 0x6b, 0xca,
-	0x8b, 0x4b, 0xc4, 0x38, 0x94, 0x6e, 0x3a, 0x60, 0xde, 0x70, 0x30, 0x76, 0xa1, 0x99, 0x70, 0x76,
-	0x42, 0x03, 0x35, 0xf1, 0x2a, 0xe7, 0x99, 0x78, 0xb9, 0x9a, 0xf3, 0xb3, 0x05, 0xf6, 0xa2, 0x79,
-	0xa3, 0x26, 0x9e, 0x9a, 0x4f, 0x5e, 0x44, 0x22, 0x0d, 0xb2, 0xa6, 0x0a, 0x41, 0xc8, 0x87, 0x24,
-	0x42, 0x77, 0x61, 0x55, 0xb3, 0x12, 0xce, 0x7c, 0x22, 0x04, 0xe3, 0x3a, 0x11, 0x35, 0xb7, 0xad,
-	0xa8, 0x87, 0x19, 0x31, 0x17, 0x3b, 0xc2, 0x71, 0x70, 0x4a, 0x03, 0x79, 0xac, 0xab, 0x2d, 0x15,
-	0xdb, 0xcd, 0x88, 0xa8, 0x0b, 0x8d, 0x60, 0x6c, 0xfc, 0xeb, 0x8a, 0xab, 0xb9, 0xf9, 0xd9, 0x21,
-	0xd0, 0x39, 0x20, 0x72, 0xf2, 0x64, 0xba, 0x44, 0x24, 0x2c, 0x16, 0x04, 0x7d, 0x00, 0x2d, 0x15,
-	0x3e, 0x1e, 0x19, 0x3d, 0x13, 0xc5, 0xab, 0xa5, 0x77, 0x67, 0xd2, 0xee, 0x45, 0x51, 0xd5, 0x2d,
-	0x9c, 0x85, 0xd9, 0x93, 0xaa, 0xbf, 0x9d, 0x6f, 0x2c, 0x58, 0x2b, 0xf9, 0x51, 0x79, 0xcc, 0x7d,
-	0x5d, 0x85, 0xba, 0x1a, 0xde, 0x63, 0xa1, 0xdd, 0x2c, 0xb9, 0xe9, 0x09, 0x5d, 0x84, 0x6a, 0x24,
-	0x46, 0xa9, 0x21, 0xf5, 0x89, 0xb6, 0xd3, 0x37, 0x5b, 0x57, 0x47, 0xb5, 0xfc, 0x8e, 0xcd, 0xbd,
-	0x87, 0x79, 0xd2, 0x75, 0xd5, 0x6c, 0xc1, 0xb5, 0x54, 0x44, 0x37, 0xb7, 0x41, 0xf0, 0xc5, 0x98,
-	0x08, 0xb9, 0x70, 0x47, 0x70, 0xee, 0x43, 0x6f, 0x5a, 0x67, 0xf7, 0xec, 0xb1, 0xe6, 0x89, 0x4c,
-	0x79, 0x0d, 0x1a, 0xa9, 0xb2, 0xc2, 0x5f, 0x55, 0x0d, 0x6d, 0xb4, 0x85, 0xf3, 0x9d, 0x05, 0xf6,
-	0xac, 0xcf, 0x73, 0xdf, 0xfa, 0x3e, 0x5c, 0xd0, 0x1e, 0x88, 0xb2, 0x51, 0xbc, 0x7b, 0xa7, 0x98,
-	0x8f, 0x7c, 0xc0, 0xba, 0x6d, 0x59, 0x74, 0xe8, 0x7c, 0x5f, 0x83, 0xb5, 0xc3, 0xf1, 0x51, 0x48,
-	0xc5, 0xb1, 0x09, 0x90, 0x69, 0xdc, 0x14, 0x7e, 0x69, 0x0d, 0xb2, 0x16, 0xad, 0x41, 0x95, 0xf3,
-	0xaf, 0x41, 0x7b, 0xd3, 0xbb, 0x8b, 0xc1, 0xbc, 0xb1, 0x68, 0x77, 0xc9, 0x07, 0x4a, 0x69, 0x7d,
-	0xf9, 0x1f, 0x5c, 0x30, 0xaf, 0x4f, 0x82, 0xb9, 0x9e, 0xa8, 0xc2, 0xae, 0xe9, 0x20, 0x9b, 0x05,
-	0xe5, 0x50, 0x51, 0x87, 0x81, 0x40, 0x1f, 0x16, 0x17, 0x14, 0xb3, 0x25, 0xdd, 0x2a, 0x7a, 0x9a,
-	0x3b, 0x2b, 0x8a, 0xdb, 0xc9, 0xec, 0xe2, 0x50, 0x7f, 0xad, 0xc5, 0x01, 0xbd, 0x0b, 0x57, 0x7d,
-	0x1c, 0xfa, 0xe3, 0x50, 0x0d, 0x72, 0x35, 0xda, 0x39, 0xf6, 0xa5, 0xcf, 0x02, 0x62, 0x2f, 0xeb,
-	0xe8, 0x76, 0x72, 0xee, 0x83, 0x02, 0x53, 0xa9, 0xa9, 0x8b, 0x8b, 0x24, 0xa4, 0xb2, 0xac, 0xd6,
-	0x30, 0x6a, 0x39, 0xb7, 0xa4, 0xb6, 0x05, 0x9d, 0x4c, 0xd8, 0x23, 0xcf, 0x24, 0xc7, 0x2a, 0x50,
-	0x38, 0x12, 0x7a, 0x89, 0x6a, 0xba, 0x97, 0x33, 0xe6, 0xbe, 0xe2, 0x1d, 0x6a, 0x96, 0xe3, 0x41,
-	0x77, 0x5e, 0x3d, 0x9c, 0xbb, 0x2e, 0x0b, 0x6d, 0x53, 0x2d, 0xb6, 0xcd, 0xd6, 0x8f, 0x35, 0x68,
-	0x29, 0xd3, 0x8f, 0x08, 0x3f, 0xa1, 0x3e, 0x41, 0x09, 0x5c, 0x9a, 0xe9, 0x7e, 0x94, 0x0f, 0x93,
-	0xfd, 0x28, 0x91, 0x67, 0x07, 0x44, 0x1a, 0x74, 0xdd, 0x5b, 0x73, 0x1b, 0xba, 0xd8, 0x3a, 0x4e,
-	0xef, 0xdb, 0x3f, 0xff, 0xfe, 0xa1, 0xd2, 0x75, 0x3a, 0x03, 0x1f, 0x73, 0x4e, 0x09, 0x1f, 0x9c,
-	0xbc, 0xad, 0x7f, 0x29, 0x06, 0xaa, 0x39, 0xb6, 0xad, 0x7b, 0xe8, 0x2b, 0xb8, 0x38, 0xdd, 0x78,
-	0x68, 0x63, 0xca, 0xf0, 0xf4, 0x18, 0xe8, 0xf6, 0x16, 0x0b, 0xa4, 0x8e, 0xef, 0x6a, 0xc7, 0x1b,
-	0x4e, 0x77, 0xc6, 0x31, 0xc9, 0x64, 0x95, 0xf7, 0xe7, 0x93, 0x71, 0x37, 0x3b, 0x37, 0x50, 0x7f,
-	0x91, 0x9b, 0xe9, 0xd1, 0xf2, 0x0a, 0x80, 0x36, 0x35, 0xa0, 0xbe, 0x73, 0x7b, 0x31, 0xa0, 0xdc,
-	0xaa, 0x42, 0xf6, 0xb5, 0x05, 0x68, 0x36, 0xf7, 0x28, 0x8f, 0xf9, 0xc2, 0x39, 0xd1, 0x75, 0xfe,
-	0x4d, 0x24, 0x45, 0x73, 0x5b, 0xa3, 0xb9, 0xe9, 0xd8, 0x33, 0x68, 0x12, 0xa3, 0xb4, 0x6d, 0xdd,
-	0xdb, 0x7d, 0xff, 0xc5, 0xcb, 0x75, 0xeb, 0x8f, 0x97, 0xeb, 0xd6, 0x5f, 0x2f, 0xd7, 0xad, 0xcf,
-	0xff, 0x3f, 0xa2, 0xf2, 0x78, 0x7c, 0xb4, 0xe9, 0xb3, 0x68, 0xe0, 0x32, 0x41, 0xa4, 0xc4, 0x1f,
-	0x85, 0xec, 0x74, 0xf0, 0xc0, 0x18, 0x78, 0xeb, 0x80, 0x0d, 0xd2, 0xff, 0xc1, 0xa3, 0xba, 0xfe,
-	0xc7, 0x7b, 0xe7, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe2, 0xcb, 0x55, 0x51, 0x45, 0x0e, 0x00,
-	0x00,
+func (m *CancelTaskRequest) Reset()         { *m = CancelTaskRequest{} }
+func (m *CancelTaskRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelTaskRequest) ProtoMessage()    {}
+func (*CancelTaskRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a744901dce4e8cd, []int{18}
+}
+func (m *CancelTaskRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CancelTaskRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CancelTaskRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CancelTaskRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelTaskRequest.Merge(m, src)
+}
+func (m *CancelTaskRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CancelTaskRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelTaskRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_CancelTaskRequest proto.InternalMessageInfo
 
-// TaskServiceClient is the client API for TaskService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type TaskServiceClient interface {
-	// 查看全部任务详情列表
-	GetTaskDetailList(ctx context.Context, in *EmptyGetParams, opts ...grpc.CallOption) (*GetTaskDetailListResponse, error)
-	// 查看某个任务的全部事件列表
-	GetTaskEventList(ctx context.Context, in *GetTaskEventListRequest, opts ...grpc.CallOption) (*GetTaskEventListResponse, error)
-	// 查看某个任务的全部事件列表
-	GetTaskEventListByTaskIds(ctx context.Context, in *GetTaskEventListByTaskIdsRequest, opts ...grpc.CallOption) (*GetTaskEventListResponse, error)
-	// 发布任务
-	PublishTaskDeclare(ctx context.Context, in *PublishTaskDeclareRequest, opts ...grpc.CallOption) (*PublishTaskDeclareResponse, error)
+func (m *CancelTaskRequest) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
+	}
+	return ""
 }
 
-type taskServiceClient struct {
-	cc *grpc.ClientConn
+type GetTaskResourceUsageRequest struct {
+	TaskId               string   `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func NewTaskServiceClient(cc *grpc.ClientConn) TaskServiceClient {
-	return &taskServiceClient{cc}
+func (m *GetTaskResourceUsageRequest) Reset()         { *m = GetTaskResourceUsageRequest{} }
+func (m *GetTaskResourceUsageRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTaskResourceUsageRequest) ProtoMessage()    {}
+func (*GetTaskResourceUsageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a744901dce4e8cd, []int{19}
 }
-
-func (c *taskServiceClient) GetTaskDetailList(ctx context.Context, in *EmptyGetParams, opts ...grpc.CallOption) (*GetTaskDetailListResponse, error) {
-	out := new(GetTaskDetailListResponse)
-	err := c.cc.Invoke(ctx, "/rpcapi.TaskService/GetTaskDetailList", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetTaskResourceUsageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetTaskResourceUsageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetTaskResourceUsageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *GetTaskResourceUsageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTaskResourceUsageRequest.Merge(m, src)
+}
+func (m *GetTaskResourceUsageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetTaskResourceUsageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTaskResourceUsageRequest.DiscardUnknown(m)
 }
 
-func (c *taskServiceClient) GetTaskEventList(ctx context.Context, in *GetTaskEventListRequest, opts ...grpc.CallOption) (*GetTaskEventListResponse, error) {
-	out := new(GetTaskEventListResponse)
-	err := c.cc.Invoke(ctx, "/rpcapi.TaskService/GetTaskEventList", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetTaskResourceUsageRequest proto.InternalMessageInfo
+
+func (m *GetTaskResourceUsageRequest) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *taskServiceClient) GetTaskEventListByTaskIds(ctx context.Context, in *GetTaskEventListByTaskIdsRequest, opts ...grpc.CallOption) (*GetTaskEventListResponse, error) {
-	out := new(GetTaskEventListResponse)
-	err := c.cc.Invoke(ctx, "/rpcapi.TaskService/GetTaskEventListByTaskIds", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
-
-func (c *taskServiceClient) PublishTaskDeclare(ctx context.Context, in *PublishTaskDeclareRequest, opts ...grpc.CallOption) (*PublishTaskDeclareResponse, error) {
-	out := new(PublishTaskDeclareResponse)
-	err := c.cc.Invoke(ctx, "/rpcapi.TaskService/PublishTaskDeclare", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// 任务在某个参与方的计算服务上实际消耗的资源
+type TaskResourceUsageShow struct {
+	PartyId              string   `protobuf:"bytes,1,opt,name=party_id,json=partyId,proto3" json:"party_id,omitempty"`
+	IdentityId           string   `protobuf:"bytes,2,opt,name=identity_id,json=identityId,proto3" json:"identity_id,omitempty"`
+	JobNodeId            string   `protobuf:"bytes,3,opt,name=job_node_id,json=jobNodeId,proto3" json:"job_node_id,omitempty"`
+	UsedMem              uint64   `protobuf:"varint,4,opt,name=used_mem,json=usedMem,proto3" json:"used_mem,omitempty"`
+	UsedProcessor        uint64   `protobuf:"varint,5,opt,name=used_processor,json=usedProcessor,proto3" json:"used_processor,omitempty"`
+	UsedBandwidth        uint64   `protobuf:"varint,6,opt,name=used_bandwidth,json=usedBandwidth,proto3" json:"used_bandwidth,omitempty"`
+	UsedDuration         uint64   `protobuf:"varint,7,opt,name=used_duration,json=usedDuration,proto3" json:"used_duration,omitempty"`
+	UpdateAt             uint64   `protobuf:"varint,8,opt,name=update_at,json=updateAt,proto3" json:"update_at,omitempty"`
+	ExceededItems        []string `protobuf:"bytes,9,rep,name=exceeded_items,json=exceededItems,proto3" json:"exceeded_items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-// TaskServiceServer is the server API for TaskService service.
-type TaskServiceServer interface {
-	// 查看全部任务详情列表
-	GetTaskDetailList(context.Context, *EmptyGetParams) (*GetTaskDetailListResponse, error)
-	// 查看某个任务的全部事件列表
-	GetTaskEventList(context.Context, *GetTaskEventListRequest) (*GetTaskEventListResponse, error)
-	// 查看某个任务的全部事件列表
-	GetTaskEventListByTaskIds(context.Context, *GetTaskEventListByTaskIdsRequest) (*GetTaskEventListResponse, error)
-	// 发布任务
-	PublishTaskDeclare(context.Context, *PublishTaskDeclareRequest) (*PublishTaskDeclareResponse, error)
+func (m *TaskResourceUsageShow) Reset()         { *m = TaskResourceUsageShow{} }
+func (m *TaskResourceUsageShow) String() string { return proto.CompactTextString(m) }
+func (*TaskResourceUsageShow) ProtoMessage()    {}
+func (*TaskResourceUsageShow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a744901dce4e8cd, []int{20}
 }
-
-// UnimplementedTaskServiceServer can be embedded to have forward compatible implementations.
-type UnimplementedTaskServiceServer struct {
+func (m *TaskResourceUsageShow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (*UnimplementedTaskServiceServer) GetTaskDetailList(ctx context.Context, req *EmptyGetParams) (*GetTaskDetailListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTaskDetailList not implemented")
+func (m *TaskResourceUsageShow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskResourceUsageShow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedTaskServiceServer) GetTaskEventList(ctx context.Context, req *GetTaskEventListRequest) (*GetTaskEventListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTaskEventList not implemented")
+func (m *TaskResourceUsageShow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskResourceUsageShow.Merge(m, src)
 }
-func (*UnimplementedTaskServiceServer) GetTaskEventListByTaskIds(ctx context.Context, req *GetTaskEventListByTaskIdsRequest) (*GetTaskEventListResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTaskEventListByTaskIds not implemented")
+func (m *TaskResourceUsageShow) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedTaskServiceServer) PublishTaskDeclare(ctx context.Context, req *PublishTaskDeclareRequest) (*PublishTaskDeclareResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PublishTaskDeclare not implemented")
+func (m *TaskResourceUsageShow) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskResourceUsageShow.DiscardUnknown(m)
 }
 
-func RegisterTaskServiceServer(s *grpc.Server, srv TaskServiceServer) {
-	s.RegisterService(&_TaskService_serviceDesc, srv)
+var xxx_messageInfo_TaskResourceUsageShow proto.InternalMessageInfo
+
+func (m *TaskResourceUsageShow) GetPartyId() string {
+	if m != nil {
+		return m.PartyId
+	}
+	return ""
 }
 
-func _TaskService_GetTaskDetailList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(EmptyGetParams)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *TaskResourceUsageShow) GetIdentityId() string {
+	if m != nil {
+		return m.IdentityId
 	}
-	if interceptor == nil {
-		return srv.(TaskServiceServer).GetTaskDetailList(ctx, in)
+	return ""
+}
+
+func (m *TaskResourceUsageShow) GetJobNodeId() string {
+	if m != nil {
+		return m.JobNodeId
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/rpcapi.TaskService/GetTaskDetailList",
+	return ""
+}
+
+func (m *TaskResourceUsageShow) GetUsedMem() uint64 {
+	if m != nil {
+		return m.UsedMem
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TaskServiceServer).GetTaskDetailList(ctx, req.(*EmptyGetParams))
+	return 0
+}
+
+func (m *TaskResourceUsageShow) GetUsedProcessor() uint64 {
+	if m != nil {
+		return m.UsedProcessor
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _TaskService_GetTaskEventList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTaskEventListRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *TaskResourceUsageShow) GetUsedBandwidth() uint64 {
+	if m != nil {
+		return m.UsedBandwidth
 	}
-	if interceptor == nil {
-		return srv.(TaskServiceServer).GetTaskEventList(ctx, in)
+	return 0
+}
+
+func (m *TaskResourceUsageShow) GetUsedDuration() uint64 {
+	if m != nil {
+		return m.UsedDuration
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/rpcapi.TaskService/GetTaskEventList",
+	return 0
+}
+
+func (m *TaskResourceUsageShow) GetUpdateAt() uint64 {
+	if m != nil {
+		return m.UpdateAt
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TaskServiceServer).GetTaskEventList(ctx, req.(*GetTaskEventListRequest))
+	return 0
+}
+
+func (m *TaskResourceUsageShow) GetExceededItems() []string {
+	if m != nil {
+		return m.ExceededItems
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _TaskService_GetTaskEventListByTaskIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTaskEventListByTaskIdsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+type GetTaskResourceUsageResponse struct {
+	Status               int32                     `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Msg                  string                    `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	TaskId               string                    `protobuf:"bytes,3,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Declared             *TaskOperationCostDeclare `protobuf:"bytes,4,opt,name=declared,proto3" json:"declared,omitempty"`
+	UsageList            []*TaskResourceUsageShow  `protobuf:"bytes,5,rep,name=usage_list,json=usageList,proto3" json:"usage_list,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *GetTaskResourceUsageResponse) Reset()         { *m = GetTaskResourceUsageResponse{} }
+func (m *GetTaskResourceUsageResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTaskResourceUsageResponse) ProtoMessage()    {}
+func (*GetTaskResourceUsageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a744901dce4e8cd, []int{21}
+}
+func (m *GetTaskResourceUsageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetTaskResourceUsageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetTaskResourceUsageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(TaskServiceServer).GetTaskEventListByTaskIds(ctx, in)
+}
+func (m *GetTaskResourceUsageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTaskResourceUsageResponse.Merge(m, src)
+}
+func (m *GetTaskResourceUsageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetTaskResourceUsageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTaskResourceUsageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTaskResourceUsageResponse proto.InternalMessageInfo
+
+func (m *GetTaskResourceUsageResponse) GetStatus() int32 {
+	if m != nil {
+		return m.Status
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/rpcapi.TaskService/GetTaskEventListByTaskIds",
+	return 0
+}
+
+func (m *GetTaskResourceUsageResponse) GetMsg() string {
+	if m != nil {
+		return m.Msg
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TaskServiceServer).GetTaskEventListByTaskIds(ctx, req.(*GetTaskEventListByTaskIdsRequest))
+	return ""
+}
+
+func (m *GetTaskResourceUsageResponse) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _TaskService_PublishTaskDeclare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(PublishTaskDeclareRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *GetTaskResourceUsageResponse) GetDeclared() *TaskOperationCostDeclare {
+	if m != nil {
+		return m.Declared
 	}
-	if interceptor == nil {
-		return srv.(TaskServiceServer).PublishTaskDeclare(ctx, in)
+	return nil
+}
+
+func (m *GetTaskResourceUsageResponse) GetUsageList() []*TaskResourceUsageShow {
+	if m != nil {
+		return m.UsageList
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/rpcapi.TaskService/PublishTaskDeclare",
+	return nil
+}
+
+type GetTaskProgressRequest struct {
+	TaskId               string   `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetTaskProgressRequest) Reset()         { *m = GetTaskProgressRequest{} }
+func (m *GetTaskProgressRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTaskProgressRequest) ProtoMessage()    {}
+func (*GetTaskProgressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a744901dce4e8cd, []int{22}
+}
+func (m *GetTaskProgressRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetTaskProgressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetTaskProgressRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(TaskServiceServer).PublishTaskDeclare(ctx, req.(*PublishTaskDeclareRequest))
+}
+func (m *GetTaskProgressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTaskProgressRequest.Merge(m, src)
+}
+func (m *GetTaskProgressRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetTaskProgressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTaskProgressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTaskProgressRequest proto.InternalMessageInfo
+
+func (m *GetTaskProgressRequest) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _TaskService_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "rpcapi.TaskService",
-	HandlerType: (*TaskServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GetTaskDetailList",
-			Handler:    _TaskService_GetTaskDetailList_Handler,
-		},
-		{
-			MethodName: "GetTaskEventList",
-			Handler:    _TaskService_GetTaskEventList_Handler,
-		},
-		{
-			MethodName: "GetTaskEventListByTaskIds",
-			Handler:    _TaskService_GetTaskEventListByTaskIds_Handler,
-		},
-		{
-			MethodName: "PublishTaskDeclare",
-			Handler:    _TaskService_PublishTaskDeclare_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "lib/api/task_rpc_api.proto",
+// 任务在某个参与方的计算服务上的执行进度
+type TaskProgressShow struct {
+	PartyId              string   `protobuf:"bytes,1,opt,name=party_id,json=partyId,proto3" json:"party_id,omitempty"`
+	IdentityId           string   `protobuf:"bytes,2,opt,name=identity_id,json=identityId,proto3" json:"identity_id,omitempty"`
+	JobNodeId            string   `protobuf:"bytes,3,opt,name=job_node_id,json=jobNodeId,proto3" json:"job_node_id,omitempty"`
+	Phase                string   `protobuf:"bytes,4,opt,name=phase,proto3" json:"phase,omitempty"`
+	Percent              uint32   `protobuf:"varint,5,opt,name=percent,proto3" json:"percent,omitempty"`
+	ElapsedTime          uint64   `protobuf:"varint,6,opt,name=elapsed_time,json=elapsedTime,proto3" json:"elapsed_time,omitempty"`
+	RemainTime           uint64   `protobuf:"varint,7,opt,name=remain_time,json=remainTime,proto3" json:"remain_time,omitempty"`
+	UpdateAt             uint64   `protobuf:"varint,8,opt,name=update_at,json=updateAt,proto3" json:"update_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *TaskDetailShow) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TaskProgressShow) Reset()         { *m = TaskProgressShow{} }
+func (m *TaskProgressShow) String() string { return proto.CompactTextString(m) }
+func (*TaskProgressShow) ProtoMessage()    {}
+func (*TaskProgressShow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a744901dce4e8cd, []int{23}
+}
+func (m *TaskProgressShow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskProgressShow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskProgressShow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *TaskProgressShow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskProgressShow.Merge(m, src)
+}
+func (m *TaskProgressShow) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskProgressShow) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskProgressShow.DiscardUnknown(m)
 }
 
-func (m *TaskDetailShow) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_TaskProgressShow proto.InternalMessageInfo
+
+func (m *TaskProgressShow) GetPartyId() string {
+	if m != nil {
+		return m.PartyId
+	}
+	return ""
 }
 
-func (m *TaskDetailShow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.OperationCost != nil {
-		{
-			size, err := m.OperationCost.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTaskRpcApi(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x62
-	}
-	if len(m.State) > 0 {
-		i -= len(m.State)
-		copy(dAtA[i:], m.State)
-		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.State)))
-		i--
-		dAtA[i] = 0x5a
+func (m *TaskProgressShow) GetIdentityId() string {
+	if m != nil {
+		return m.IdentityId
 	}
-	if m.EndAt != 0 {
-		i = encodeVarintTaskRpcApi(dAtA, i, uint64(m.EndAt))
-		i--
-		dAtA[i] = 0x50
+	return ""
+}
+
+func (m *TaskProgressShow) GetJobNodeId() string {
+	if m != nil {
+		return m.JobNodeId
 	}
-	if m.StartAt != 0 {
-		i = encodeVarintTaskRpcApi(dAtA, i, uint64(m.StartAt))
-		i--
-		dAtA[i] = 0x48
+	return ""
+}
+
+func (m *TaskProgressShow) GetPhase() string {
+	if m != nil {
+		return m.Phase
 	}
-	if m.CreateAt != 0 {
-		i = encodeVarintTaskRpcApi(dAtA, i, uint64(m.CreateAt))
-		i--
-		dAtA[i] = 0x40
+	return ""
+}
+
+func (m *TaskProgressShow) GetPercent() uint32 {
+	if m != nil {
+		return m.Percent
 	}
-	if len(m.Receivers) > 0 {
-		for iNdEx := len(m.Receivers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Receivers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTaskRpcApi(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3a
-		}
+	return 0
+}
+
+func (m *TaskProgressShow) GetElapsedTime() uint64 {
+	if m != nil {
+		return m.ElapsedTime
 	}
-	if len(m.PowerSupplier) > 0 {
-		for iNdEx := len(m.PowerSupplier) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PowerSupplier[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTaskRpcApi(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
-		}
+	return 0
+}
+
+func (m *TaskProgressShow) GetRemainTime() uint64 {
+	if m != nil {
+		return m.RemainTime
 	}
-	if len(m.DataSupplier) > 0 {
-		for iNdEx := len(m.DataSupplier) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DataSupplier[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTaskRpcApi(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
+	return 0
+}
+
+func (m *TaskProgressShow) GetUpdateAt() uint64 {
+	if m != nil {
+		return m.UpdateAt
 	}
-	if m.AlgoSupplier != nil {
-		{
-			size, err := m.AlgoSupplier.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTaskRpcApi(dAtA, i, uint64(size))
+	return 0
+}
+
+type GetTaskProgressResponse struct {
+	Status               int32               `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Msg                  string              `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	TaskId               string              `protobuf:"bytes,3,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	ProgressList         []*TaskProgressShow `protobuf:"bytes,4,rep,name=progress_list,json=progressList,proto3" json:"progress_list,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *GetTaskProgressResponse) Reset()         { *m = GetTaskProgressResponse{} }
+func (m *GetTaskProgressResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTaskProgressResponse) ProtoMessage()    {}
+func (*GetTaskProgressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a744901dce4e8cd, []int{24}
+}
+func (m *GetTaskProgressResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetTaskProgressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetTaskProgressResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x22
+		return b[:n], nil
 	}
-	if m.Owner != nil {
-		{
-			size, err := m.Owner.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTaskRpcApi(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *GetTaskProgressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTaskProgressResponse.Merge(m, src)
+}
+func (m *GetTaskProgressResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetTaskProgressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTaskProgressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTaskProgressResponse proto.InternalMessageInfo
+
+func (m *GetTaskProgressResponse) GetStatus() int32 {
+	if m != nil {
+		return m.Status
 	}
-	if len(m.TaskName) > 0 {
-		i -= len(m.TaskName)
-		copy(dAtA[i:], m.TaskName)
-		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.TaskName)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *GetTaskProgressResponse) GetMsg() string {
+	if m != nil {
+		return m.Msg
 	}
-	if len(m.TaskId) > 0 {
-		i -= len(m.TaskId)
-		copy(dAtA[i:], m.TaskId)
-		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.TaskId)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *GetTaskProgressResponse) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *TaskDataSupplierShow) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *GetTaskProgressResponse) GetProgressList() []*TaskProgressShow {
+	if m != nil {
+		return m.ProgressList
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *TaskDataSupplierShow) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// 工作流中的任务声明
+type WorkflowTaskDeclare struct {
+	Name                 string                          `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Task                 *PublishTaskDeclareRequest      `protobuf:"bytes,2,opt,name=task,proto3" json:"task,omitempty"`
+	Depends              []string                        `protobuf:"bytes,3,rep,name=depends,proto3" json:"depends,omitempty"`
+	Bindings             []*WorkflowResultBindingDeclare `protobuf:"bytes,4,rep,name=bindings,proto3" json:"bindings,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
+	XXX_unrecognized     []byte                          `json:"-"`
+	XXX_sizecache        int32                           `json:"-"`
 }
 
-func (m *TaskDataSupplierShow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.MetaDataName) > 0 {
-		i -= len(m.MetaDataName)
-		copy(dAtA[i:], m.MetaDataName)
-		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.MetaDataName)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.MetaDataId) > 0 {
-		i -= len(m.MetaDataId)
-		copy(dAtA[i:], m.MetaDataId)
-		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.MetaDataId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.MemberInfo != nil {
-		{
-			size, err := m.MemberInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTaskRpcApi(dAtA, i, uint64(size))
+func (m *WorkflowTaskDeclare) Reset()         { *m = WorkflowTaskDeclare{} }
+func (m *WorkflowTaskDeclare) String() string { return proto.CompactTextString(m) }
+func (*WorkflowTaskDeclare) ProtoMessage()    {}
+func (*WorkflowTaskDeclare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a744901dce4e8cd, []int{25}
+}
+func (m *WorkflowTaskDeclare) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowTaskDeclare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowTaskDeclare.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *WorkflowTaskDeclare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowTaskDeclare.Merge(m, src)
+}
+func (m *WorkflowTaskDeclare) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowTaskDeclare) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowTaskDeclare.DiscardUnknown(m)
 }
 
-func (m *TaskPowerSupplierShow) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_WorkflowTaskDeclare proto.InternalMessageInfo
+
+func (m *WorkflowTaskDeclare) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *TaskPowerSupplierShow) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *WorkflowTaskDeclare) GetTask() *PublishTaskDeclareRequest {
+	if m != nil {
+		return m.Task
+	}
+	return nil
 }
 
-func (m *TaskPowerSupplierShow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.PowerInfo != nil {
-		{
-			size, err := m.PowerInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTaskRpcApi(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.MemberInfo != nil {
-		{
-			size, err := m.MemberInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTaskRpcApi(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *WorkflowTaskDeclare) GetDepends() []string {
+	if m != nil {
+		return m.Depends
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *TaskEventShow) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *WorkflowTaskDeclare) GetBindings() []*WorkflowResultBindingDeclare {
+	if m != nil {
+		return m.Bindings
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *TaskEventShow) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// 前置任务的结果到本任务输入的绑定
+// 前置任务的结果 (结果接收方以前置任务的 taskId 作为 originId 发布的元数据) 成为本任务数据提供方的输入元数据
+type WorkflowResultBindingDeclare struct {
+	FromTask             string   `protobuf:"bytes,1,opt,name=from_task,json=fromTask,proto3" json:"from_task,omitempty"`
+	IdentityId           string   `protobuf:"bytes,2,opt,name=identity_id,json=identityId,proto3" json:"identity_id,omitempty"`
+	ParamKey             string   `protobuf:"bytes,3,opt,name=param_key,json=paramKey,proto3" json:"param_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *TaskEventShow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.CreateAt != 0 {
-		i = encodeVarintTaskRpcApi(dAtA, i, uint64(m.CreateAt))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.Content) > 0 {
-		i -= len(m.Content)
-		copy(dAtA[i:], m.Content)
-		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.Content)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.Owner != nil {
-		{
-			size, err := m.Owner.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTaskRpcApi(dAtA, i, uint64(size))
+func (m *WorkflowResultBindingDeclare) Reset()         { *m = WorkflowResultBindingDeclare{} }
+func (m *WorkflowResultBindingDeclare) String() string { return proto.CompactTextString(m) }
+func (*WorkflowResultBindingDeclare) ProtoMessage()    {}
+func (*WorkflowResultBindingDeclare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a744901dce4e8cd, []int{26}
+}
+func (m *WorkflowResultBindingDeclare) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowResultBindingDeclare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowResultBindingDeclare.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.TaskId) > 0 {
-		i -= len(m.TaskId)
-		copy(dAtA[i:], m.TaskId)
-		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.TaskId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Type) > 0 {
-		i -= len(m.Type)
-		copy(dAtA[i:], m.Type)
-		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.Type)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *TaskEventDeclare) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *WorkflowResultBindingDeclare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowResultBindingDeclare.Merge(m, src)
 }
-
-func (m *TaskEventDeclare) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *WorkflowResultBindingDeclare) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowResultBindingDeclare) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowResultBindingDeclare.DiscardUnknown(m)
 }
 
-func (m *TaskEventDeclare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.CreateAt != 0 {
-		i = encodeVarintTaskRpcApi(dAtA, i, uint64(m.CreateAt))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.Content) > 0 {
-		i -= len(m.Content)
-		copy(dAtA[i:], m.Content)
-		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.Content)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.IdentityId) > 0 {
-		i -= len(m.IdentityId)
-		copy(dAtA[i:], m.IdentityId)
-		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.IdentityId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.TaskId) > 0 {
-		i -= len(m.TaskId)
-		copy(dAtA[i:], m.TaskId)
-		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.TaskId)))
-		i--
-		dAtA[i] = 0x12
+var xxx_messageInfo_WorkflowResultBindingDeclare proto.InternalMessageInfo
+
+func (m *WorkflowResultBindingDeclare) GetFromTask() string {
+	if m != nil {
+		return m.FromTask
 	}
-	if len(m.Type) > 0 {
-		i -= len(m.Type)
-		copy(dAtA[i:], m.Type)
-		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.Type)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *WorkflowResultBindingDeclare) GetIdentityId() string {
+	if m != nil {
+		return m.IdentityId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *TaskDataSupplierDeclare) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *WorkflowResultBindingDeclare) GetParamKey() string {
+	if m != nil {
+		return m.ParamKey
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *TaskDataSupplierDeclare) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type PublishWorkflowDeclareRequest struct {
+	WorkflowName         string                 `protobuf:"bytes,1,opt,name=workflow_name,json=workflowName,proto3" json:"workflow_name,omitempty"`
+	TaskList             []*WorkflowTaskDeclare `protobuf:"bytes,2,rep,name=task_list,json=taskList,proto3" json:"task_list,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
 }
 
-func (m *TaskDataSupplierDeclare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.MetaDataInfo != nil {
-		{
-			size, err := m.MetaDataInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTaskRpcApi(dAtA, i, uint64(size))
+func (m *PublishWorkflowDeclareRequest) Reset()         { *m = PublishWorkflowDeclareRequest{} }
+func (m *PublishWorkflowDeclareRequest) String() string { return proto.CompactTextString(m) }
+func (*PublishWorkflowDeclareRequest) ProtoMessage()    {}
+func (*PublishWorkflowDeclareRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a744901dce4e8cd, []int{27}
+}
+func (m *PublishWorkflowDeclareRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PublishWorkflowDeclareRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PublishWorkflowDeclareRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if m.MemberInfo != nil {
-		{
-			size, err := m.MemberInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTaskRpcApi(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+}
+func (m *PublishWorkflowDeclareRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PublishWorkflowDeclareRequest.Merge(m, src)
+}
+func (m *PublishWorkflowDeclareRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *PublishWorkflowDeclareRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PublishWorkflowDeclareRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PublishWorkflowDeclareRequest proto.InternalMessageInfo
+
+func (m *PublishWorkflowDeclareRequest) GetWorkflowName() string {
+	if m != nil {
+		return m.WorkflowName
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *TaskMetaDataDeclare) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *PublishWorkflowDeclareRequest) GetTaskList() []*WorkflowTaskDeclare {
+	if m != nil {
+		return m.TaskList
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *TaskMetaDataDeclare) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type PublishWorkflowDeclareResponse struct {
+	Status               int32    `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Msg                  string   `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	WorkflowId           string   `protobuf:"bytes,3,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *TaskMetaDataDeclare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.ColumnIndexList) > 0 {
-		dAtA11 := make([]byte, len(m.ColumnIndexList)*10)
-		var j10 int
-		for _, num := range m.ColumnIndexList {
-			for num >= 1<<7 {
-				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j10++
-			}
-			dAtA11[j10] = uint8(num)
-			j10++
+func (m *PublishWorkflowDeclareResponse) Reset()         { *m = PublishWorkflowDeclareResponse{} }
+func (m *PublishWorkflowDeclareResponse) String() string { return proto.CompactTextString(m) }
+func (*PublishWorkflowDeclareResponse) ProtoMessage()    {}
+func (*PublishWorkflowDeclareResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a744901dce4e8cd, []int{28}
+}
+func (m *PublishWorkflowDeclareResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PublishWorkflowDeclareResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PublishWorkflowDeclareResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= j10
-		copy(dAtA[i:], dAtA11[:j10])
-		i = encodeVarintTaskRpcApi(dAtA, i, uint64(j10))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.MetaDataId) > 0 {
-		i -= len(m.MetaDataId)
-		copy(dAtA[i:], m.MetaDataId)
-		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.MetaDataId)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *PublishWorkflowDeclareResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PublishWorkflowDeclareResponse.Merge(m, src)
+}
+func (m *PublishWorkflowDeclareResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *PublishWorkflowDeclareResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PublishWorkflowDeclareResponse.DiscardUnknown(m)
 }
 
-func (m *TaskResultReceiverDeclare) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PublishWorkflowDeclareResponse proto.InternalMessageInfo
+
+func (m *PublishWorkflowDeclareResponse) GetStatus() int32 {
+	if m != nil {
+		return m.Status
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *TaskResultReceiverDeclare) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *PublishWorkflowDeclareResponse) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
 }
 
-func (m *TaskResultReceiverDeclare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Providers) > 0 {
-		for iNdEx := len(m.Providers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Providers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTaskRpcApi(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+func (m *PublishWorkflowDeclareResponse) GetWorkflowId() string {
+	if m != nil {
+		return m.WorkflowId
 	}
-	if m.MemberInfo != nil {
-		{
-			size, err := m.MemberInfo.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTaskRpcApi(dAtA, i, uint64(size))
+	return ""
+}
+
+type GetWorkflowDetailRequest struct {
+	WorkflowId           string   `protobuf:"bytes,1,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetWorkflowDetailRequest) Reset()         { *m = GetWorkflowDetailRequest{} }
+func (m *GetWorkflowDetailRequest) String() string { return proto.CompactTextString(m) }
+func (*GetWorkflowDetailRequest) ProtoMessage()    {}
+func (*GetWorkflowDetailRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a744901dce4e8cd, []int{29}
+}
+func (m *GetWorkflowDetailRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetWorkflowDetailRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetWorkflowDetailRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *GetWorkflowDetailRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetWorkflowDetailRequest.Merge(m, src)
+}
+func (m *GetWorkflowDetailRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetWorkflowDetailRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetWorkflowDetailRequest.DiscardUnknown(m)
 }
 
-func (m *TaskOperationCostDeclare) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetWorkflowDetailRequest proto.InternalMessageInfo
+
+func (m *GetWorkflowDetailRequest) GetWorkflowId() string {
+	if m != nil {
+		return m.WorkflowId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *TaskOperationCostDeclare) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type CancelWorkflowRequest struct {
+	WorkflowId           string   `protobuf:"bytes,1,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *TaskOperationCostDeclare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if m.Duration != 0 {
-		i = encodeVarintTaskRpcApi(dAtA, i, uint64(m.Duration))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.CostBandwidth != 0 {
-		i = encodeVarintTaskRpcApi(dAtA, i, uint64(m.CostBandwidth))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.CostProcessor != 0 {
-		i = encodeVarintTaskRpcApi(dAtA, i, uint64(m.CostProcessor))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.CostMem != 0 {
-		i = encodeVarintTaskRpcApi(dAtA, i, uint64(m.CostMem))
-		i--
-		dAtA[i] = 0x8
+func (m *CancelWorkflowRequest) Reset()         { *m = CancelWorkflowRequest{} }
+func (m *CancelWorkflowRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelWorkflowRequest) ProtoMessage()    {}
+func (*CancelWorkflowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a744901dce4e8cd, []int{30}
+}
+func (m *CancelWorkflowRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CancelWorkflowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CancelWorkflowRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *CancelWorkflowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelWorkflowRequest.Merge(m, src)
+}
+func (m *CancelWorkflowRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CancelWorkflowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelWorkflowRequest.DiscardUnknown(m)
 }
 
-func (m *GetTaskDetailResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_CancelWorkflowRequest proto.InternalMessageInfo
+
+func (m *CancelWorkflowRequest) GetWorkflowId() string {
+	if m != nil {
+		return m.WorkflowId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *GetTaskDetailResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// 工作流中的任务 (工作流详情展示用)
+type WorkflowTaskShow struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	TaskId               string   `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	State                string   `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	Reason               string   `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	Depends              []string `protobuf:"bytes,5,rep,name=depends,proto3" json:"depends,omitempty"`
+	PublishAt            uint64   `protobuf:"varint,6,opt,name=publish_at,json=publishAt,proto3" json:"publish_at,omitempty"`
+	EndAt                uint64   `protobuf:"varint,7,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetTaskDetailResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.Role) > 0 {
-		i -= len(m.Role)
-		copy(dAtA[i:], m.Role)
-		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.Role)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Information != nil {
-		{
-			size, err := m.Information.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTaskRpcApi(dAtA, i, uint64(size))
+func (m *WorkflowTaskShow) Reset()         { *m = WorkflowTaskShow{} }
+func (m *WorkflowTaskShow) String() string { return proto.CompactTextString(m) }
+func (*WorkflowTaskShow) ProtoMessage()    {}
+func (*WorkflowTaskShow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a744901dce4e8cd, []int{31}
+}
+func (m *WorkflowTaskShow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowTaskShow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowTaskShow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *WorkflowTaskShow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowTaskShow.Merge(m, src)
+}
+func (m *WorkflowTaskShow) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowTaskShow) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowTaskShow.DiscardUnknown(m)
 }
 
-func (m *GetTaskDetailListResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_WorkflowTaskShow proto.InternalMessageInfo
+
+func (m *WorkflowTaskShow) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *GetTaskDetailListResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *WorkflowTaskShow) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
+	}
+	return ""
 }
 
-func (m *GetTaskDetailListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *WorkflowTaskShow) GetState() string {
+	if m != nil {
+		return m.State
 	}
-	if len(m.TaskList) > 0 {
-		for iNdEx := len(m.TaskList) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TaskList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTaskRpcApi(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+	return ""
+}
+
+func (m *WorkflowTaskShow) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	if len(m.Msg) > 0 {
-		i -= len(m.Msg)
-		copy(dAtA[i:], m.Msg)
-		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.Msg)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *WorkflowTaskShow) GetDepends() []string {
+	if m != nil {
+		return m.Depends
 	}
-	if m.Status != 0 {
-		i = encodeVarintTaskRpcApi(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x8
+	return nil
+}
+
+func (m *WorkflowTaskShow) GetPublishAt() uint64 {
+	if m != nil {
+		return m.PublishAt
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *GetTaskEventListRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *WorkflowTaskShow) GetEndAt() uint64 {
+	if m != nil {
+		return m.EndAt
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *GetTaskEventListRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// 工作流事件
+type WorkflowEventShow struct {
+	Type                 string   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	TaskName             string   `protobuf:"bytes,2,opt,name=task_name,json=taskName,proto3" json:"task_name,omitempty"`
+	TaskId               string   `protobuf:"bytes,3,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Content              string   `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty"`
+	CreateAt             uint64   `protobuf:"varint,5,opt,name=create_at,json=createAt,proto3" json:"create_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetTaskEventListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.TaskId) > 0 {
-		i -= len(m.TaskId)
-		copy(dAtA[i:], m.TaskId)
-		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.TaskId)))
-		i--
-		dAtA[i] = 0xa
+func (m *WorkflowEventShow) Reset()         { *m = WorkflowEventShow{} }
+func (m *WorkflowEventShow) String() string { return proto.CompactTextString(m) }
+func (*WorkflowEventShow) ProtoMessage()    {}
+func (*WorkflowEventShow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a744901dce4e8cd, []int{32}
+}
+func (m *WorkflowEventShow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowEventShow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowEventShow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *WorkflowEventShow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowEventShow.Merge(m, src)
+}
+func (m *WorkflowEventShow) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowEventShow) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowEventShow.DiscardUnknown(m)
 }
 
-func (m *GetTaskEventListByTaskIdsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_WorkflowEventShow proto.InternalMessageInfo
+
+func (m *WorkflowEventShow) GetType() string {
+	if m != nil {
+		return m.Type
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *GetTaskEventListByTaskIdsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *WorkflowEventShow) GetTaskName() string {
+	if m != nil {
+		return m.TaskName
+	}
+	return ""
 }
 
-func (m *GetTaskEventListByTaskIdsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *WorkflowEventShow) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
 	}
-	if len(m.TaskIds) > 0 {
-		for iNdEx := len(m.TaskIds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.TaskIds[iNdEx])
-			copy(dAtA[i:], m.TaskIds[iNdEx])
-			i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.TaskIds[iNdEx])))
-			i--
-			dAtA[i] = 0xa
-		}
+	return ""
+}
+
+func (m *WorkflowEventShow) GetContent() string {
+	if m != nil {
+		return m.Content
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *GetTaskEventListResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *WorkflowEventShow) GetCreateAt() uint64 {
+	if m != nil {
+		return m.CreateAt
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *GetTaskEventListResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type WorkflowDetailShow struct {
+	WorkflowId           string               `protobuf:"bytes,1,opt,name=workflow_id,json=workflowId,proto3" json:"workflow_id,omitempty"`
+	WorkflowName         string               `protobuf:"bytes,2,opt,name=workflow_name,json=workflowName,proto3" json:"workflow_name,omitempty"`
+	State                string               `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	Reason               string               `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreateAt             uint64               `protobuf:"varint,5,opt,name=create_at,json=createAt,proto3" json:"create_at,omitempty"`
+	EndAt                uint64               `protobuf:"varint,6,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`
+	TaskList             []*WorkflowTaskShow  `protobuf:"bytes,7,rep,name=task_list,json=taskList,proto3" json:"task_list,omitempty"`
+	EventList            []*WorkflowEventShow `protobuf:"bytes,8,rep,name=event_list,json=eventList,proto3" json:"event_list,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
-func (m *GetTaskEventListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
-	}
-	if len(m.TaskEventList) > 0 {
-		for iNdEx := len(m.TaskEventList) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TaskEventList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTaskRpcApi(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *WorkflowDetailShow) Reset()         { *m = WorkflowDetailShow{} }
+func (m *WorkflowDetailShow) String() string { return proto.CompactTextString(m) }
+func (*WorkflowDetailShow) ProtoMessage()    {}
+func (*WorkflowDetailShow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a744901dce4e8cd, []int{33}
+}
+func (m *WorkflowDetailShow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WorkflowDetailShow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WorkflowDetailShow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Msg) > 0 {
-		i -= len(m.Msg)
-		copy(dAtA[i:], m.Msg)
-		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.Msg)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Status != 0 {
-		i = encodeVarintTaskRpcApi(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x8
+}
+func (m *WorkflowDetailShow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WorkflowDetailShow.Merge(m, src)
+}
+func (m *WorkflowDetailShow) XXX_Size() int {
+	return m.Size()
+}
+func (m *WorkflowDetailShow) XXX_DiscardUnknown() {
+	xxx_messageInfo_WorkflowDetailShow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WorkflowDetailShow proto.InternalMessageInfo
+
+func (m *WorkflowDetailShow) GetWorkflowId() string {
+	if m != nil {
+		return m.WorkflowId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *PublishTaskDeclareRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *WorkflowDetailShow) GetWorkflowName() string {
+	if m != nil {
+		return m.WorkflowName
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *PublishTaskDeclareRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *WorkflowDetailShow) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
 }
 
-func (m *PublishTaskDeclareRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *WorkflowDetailShow) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	if len(m.ContractExtraParams) > 0 {
-		i -= len(m.ContractExtraParams)
-		copy(dAtA[i:], m.ContractExtraParams)
-		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.ContractExtraParams)))
-		i--
-		dAtA[i] = 0x4a
+	return ""
+}
+
+func (m *WorkflowDetailShow) GetCreateAt() uint64 {
+	if m != nil {
+		return m.CreateAt
 	}
-	if len(m.DatasplitContractcode) > 0 {
-		i -= len(m.DatasplitContractcode)
-		copy(dAtA[i:], m.DatasplitContractcode)
-		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.DatasplitContractcode)))
-		i--
-		dAtA[i] = 0x42
+	return 0
+}
+
+func (m *WorkflowDetailShow) GetEndAt() uint64 {
+	if m != nil {
+		return m.EndAt
 	}
-	if len(m.CalculateContractcode) > 0 {
-		i -= len(m.CalculateContractcode)
-		copy(dAtA[i:], m.CalculateContractcode)
-		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.CalculateContractcode)))
-		i--
-		dAtA[i] = 0x3a
+	return 0
+}
+
+func (m *WorkflowDetailShow) GetTaskList() []*WorkflowTaskShow {
+	if m != nil {
+		return m.TaskList
 	}
-	if m.OperationCost != nil {
-		{
-			size, err := m.OperationCost.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTaskRpcApi(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
+	return nil
+}
+
+func (m *WorkflowDetailShow) GetEventList() []*WorkflowEventShow {
+	if m != nil {
+		return m.EventList
 	}
-	if len(m.Receivers) > 0 {
-		for iNdEx := len(m.Receivers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Receivers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTaskRpcApi(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
+	return nil
+}
+
+type GetWorkflowDetailResponse struct {
+	Status               int32               `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Msg                  string              `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Workflow             *WorkflowDetailShow `protobuf:"bytes,3,opt,name=workflow,proto3" json:"workflow,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *GetWorkflowDetailResponse) Reset()         { *m = GetWorkflowDetailResponse{} }
+func (m *GetWorkflowDetailResponse) String() string { return proto.CompactTextString(m) }
+func (*GetWorkflowDetailResponse) ProtoMessage()    {}
+func (*GetWorkflowDetailResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a744901dce4e8cd, []int{34}
+}
+func (m *GetWorkflowDetailResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetWorkflowDetailResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetWorkflowDetailResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.PowerPartyIds) > 0 {
-		for iNdEx := len(m.PowerPartyIds) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.PowerPartyIds[iNdEx])
-			copy(dAtA[i:], m.PowerPartyIds[iNdEx])
-			i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.PowerPartyIds[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
+}
+func (m *GetWorkflowDetailResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetWorkflowDetailResponse.Merge(m, src)
+}
+func (m *GetWorkflowDetailResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetWorkflowDetailResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetWorkflowDetailResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetWorkflowDetailResponse proto.InternalMessageInfo
+
+func (m *GetWorkflowDetailResponse) GetStatus() int32 {
+	if m != nil {
+		return m.Status
 	}
-	if len(m.DataSupplier) > 0 {
-		for iNdEx := len(m.DataSupplier) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DataSupplier[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTaskRpcApi(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+	return 0
+}
+
+func (m *GetWorkflowDetailResponse) GetMsg() string {
+	if m != nil {
+		return m.Msg
 	}
-	if m.Owner != nil {
-		{
-			size, err := m.Owner.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTaskRpcApi(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *GetWorkflowDetailResponse) GetWorkflow() *WorkflowDetailShow {
+	if m != nil {
+		return m.Workflow
 	}
-	if len(m.TaskName) > 0 {
-		i -= len(m.TaskName)
-		copy(dAtA[i:], m.TaskName)
-		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.TaskName)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+type GetWorkflowListResponse struct {
+	Status               int32                 `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Msg                  string                `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	WorkflowList         []*WorkflowDetailShow `protobuf:"bytes,3,rep,name=workflow_list,json=workflowList,proto3" json:"workflow_list,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *GetWorkflowListResponse) Reset()         { *m = GetWorkflowListResponse{} }
+func (m *GetWorkflowListResponse) String() string { return proto.CompactTextString(m) }
+func (*GetWorkflowListResponse) ProtoMessage()    {}
+func (*GetWorkflowListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a744901dce4e8cd, []int{35}
+}
+func (m *GetWorkflowListResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GetWorkflowListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GetWorkflowListResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *GetWorkflowListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetWorkflowListResponse.Merge(m, src)
+}
+func (m *GetWorkflowListResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GetWorkflowListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetWorkflowListResponse.DiscardUnknown(m)
 }
 
-func (m *PublishTaskDeclareResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetWorkflowListResponse proto.InternalMessageInfo
+
+func (m *GetWorkflowListResponse) GetStatus() int32 {
+	if m != nil {
+		return m.Status
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *PublishTaskDeclareResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *GetWorkflowListResponse) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
 }
 
-func (m *PublishTaskDeclareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XXX_unrecognized != nil {
-		i -= len(m.XXX_unrecognized)
-		copy(dAtA[i:], m.XXX_unrecognized)
+func (m *GetWorkflowListResponse) GetWorkflowList() []*WorkflowDetailShow {
+	if m != nil {
+		return m.WorkflowList
 	}
-	if len(m.TaskId) > 0 {
-		i -= len(m.TaskId)
-		copy(dAtA[i:], m.TaskId)
-		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.TaskId)))
-		i--
-		dAtA[i] = 0x1a
+	return nil
+}
+
+// 调度演练中的候选者 (远端组织 或 本地计算节点) 及其被接受或被拒绝的原因
+type ScheduleCandidateShow struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Accepted             bool     `protobuf:"varint,2,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	Reason               string   `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ScheduleCandidateShow) Reset()         { *m = ScheduleCandidateShow{} }
+func (m *ScheduleCandidateShow) String() string { return proto.CompactTextString(m) }
+func (*ScheduleCandidateShow) ProtoMessage()    {}
+func (*ScheduleCandidateShow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a744901dce4e8cd, []int{36}
+}
+func (m *ScheduleCandidateShow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduleCandidateShow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduleCandidateShow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Msg) > 0 {
-		i -= len(m.Msg)
-		copy(dAtA[i:], m.Msg)
-		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.Msg)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *ScheduleCandidateShow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleCandidateShow.Merge(m, src)
+}
+func (m *ScheduleCandidateShow) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduleCandidateShow) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleCandidateShow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduleCandidateShow proto.InternalMessageInfo
+
+func (m *ScheduleCandidateShow) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	if m.Status != 0 {
-		i = encodeVarintTaskRpcApi(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *ScheduleCandidateShow) GetAccepted() bool {
+	if m != nil {
+		return m.Accepted
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func encodeVarintTaskRpcApi(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTaskRpcApi(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *ScheduleCandidateShow) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *TaskDetailShow) Size() (n int) {
-	if m == nil {
-		return 0
+
+type ExplainScheduleResponse struct {
+	Status               int32                    `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Msg                  string                   `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	OrgCandidates        []*ScheduleCandidateShow `protobuf:"bytes,3,rep,name=org_candidates,json=orgCandidates,proto3" json:"org_candidates,omitempty"`
+	ElectedOrgs          []string                 `protobuf:"bytes,4,rep,name=elected_orgs,json=electedOrgs,proto3" json:"elected_orgs,omitempty"`
+	OrgErr               string                   `protobuf:"bytes,5,opt,name=org_err,json=orgErr,proto3" json:"org_err,omitempty"`
+	NodeCandidates       []*ScheduleCandidateShow `protobuf:"bytes,6,rep,name=node_candidates,json=nodeCandidates,proto3" json:"node_candidates,omitempty"`
+	PlacedJobNode        string                   `protobuf:"bytes,7,opt,name=placed_job_node,json=placedJobNode,proto3" json:"placed_job_node,omitempty"`
+	NeedSlotCount        uint32                   `protobuf:"varint,8,opt,name=need_slot_count,json=needSlotCount,proto3" json:"need_slot_count,omitempty"`
+	NodeErr              string                   `protobuf:"bytes,9,opt,name=node_err,json=nodeErr,proto3" json:"node_err,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *ExplainScheduleResponse) Reset()         { *m = ExplainScheduleResponse{} }
+func (m *ExplainScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*ExplainScheduleResponse) ProtoMessage()    {}
+func (*ExplainScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a744901dce4e8cd, []int{37}
+}
+func (m *ExplainScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExplainScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExplainScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.TaskId)
-	if l > 0 {
-		n += 1 + l + sovTaskRpcApi(uint64(l))
+}
+func (m *ExplainScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExplainScheduleResponse.Merge(m, src)
+}
+func (m *ExplainScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExplainScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExplainScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExplainScheduleResponse proto.InternalMessageInfo
+
+func (m *ExplainScheduleResponse) GetStatus() int32 {
+	if m != nil {
+		return m.Status
 	}
-	l = len(m.TaskName)
-	if l > 0 {
-		n += 1 + l + sovTaskRpcApi(uint64(l))
+	return 0
+}
+
+func (m *ExplainScheduleResponse) GetMsg() string {
+	if m != nil {
+		return m.Msg
 	}
-	if m.Owner != nil {
-		l = m.Owner.Size()
-		n += 1 + l + sovTaskRpcApi(uint64(l))
+	return ""
+}
+
+func (m *ExplainScheduleResponse) GetOrgCandidates() []*ScheduleCandidateShow {
+	if m != nil {
+		return m.OrgCandidates
 	}
-	if m.AlgoSupplier != nil {
-		l = m.AlgoSupplier.Size()
-		n += 1 + l + sovTaskRpcApi(uint64(l))
-	}
-	if len(m.DataSupplier) > 0 {
-		for _, e := range m.DataSupplier {
-			l = e.Size()
-			n += 1 + l + sovTaskRpcApi(uint64(l))
-		}
-	}
-	if len(m.PowerSupplier) > 0 {
-		for _, e := range m.PowerSupplier {
-			l = e.Size()
-			n += 1 + l + sovTaskRpcApi(uint64(l))
-		}
-	}
-	if len(m.Receivers) > 0 {
-		for _, e := range m.Receivers {
-			l = e.Size()
-			n += 1 + l + sovTaskRpcApi(uint64(l))
-		}
-	}
-	if m.CreateAt != 0 {
-		n += 1 + sovTaskRpcApi(uint64(m.CreateAt))
-	}
-	if m.StartAt != 0 {
-		n += 1 + sovTaskRpcApi(uint64(m.StartAt))
-	}
-	if m.EndAt != 0 {
-		n += 1 + sovTaskRpcApi(uint64(m.EndAt))
-	}
-	l = len(m.State)
-	if l > 0 {
-		n += 1 + l + sovTaskRpcApi(uint64(l))
-	}
-	if m.OperationCost != nil {
-		l = m.OperationCost.Size()
-		n += 1 + l + sovTaskRpcApi(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+	return nil
 }
 
-func (m *TaskDataSupplierShow) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MemberInfo != nil {
-		l = m.MemberInfo.Size()
-		n += 1 + l + sovTaskRpcApi(uint64(l))
-	}
-	l = len(m.MetaDataId)
-	if l > 0 {
-		n += 1 + l + sovTaskRpcApi(uint64(l))
-	}
-	l = len(m.MetaDataName)
-	if l > 0 {
-		n += 1 + l + sovTaskRpcApi(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *ExplainScheduleResponse) GetElectedOrgs() []string {
+	if m != nil {
+		return m.ElectedOrgs
 	}
-	return n
+	return nil
 }
 
-func (m *TaskPowerSupplierShow) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MemberInfo != nil {
-		l = m.MemberInfo.Size()
-		n += 1 + l + sovTaskRpcApi(uint64(l))
-	}
-	if m.PowerInfo != nil {
-		l = m.PowerInfo.Size()
-		n += 1 + l + sovTaskRpcApi(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *ExplainScheduleResponse) GetOrgErr() string {
+	if m != nil {
+		return m.OrgErr
 	}
-	return n
+	return ""
 }
 
-func (m *TaskEventShow) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovTaskRpcApi(uint64(l))
-	}
-	l = len(m.TaskId)
-	if l > 0 {
-		n += 1 + l + sovTaskRpcApi(uint64(l))
-	}
-	if m.Owner != nil {
-		l = m.Owner.Size()
-		n += 1 + l + sovTaskRpcApi(uint64(l))
-	}
-	l = len(m.Content)
-	if l > 0 {
-		n += 1 + l + sovTaskRpcApi(uint64(l))
-	}
-	if m.CreateAt != 0 {
-		n += 1 + sovTaskRpcApi(uint64(m.CreateAt))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *ExplainScheduleResponse) GetNodeCandidates() []*ScheduleCandidateShow {
+	if m != nil {
+		return m.NodeCandidates
 	}
-	return n
+	return nil
 }
 
-func (m *TaskEventDeclare) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovTaskRpcApi(uint64(l))
-	}
-	l = len(m.TaskId)
-	if l > 0 {
-		n += 1 + l + sovTaskRpcApi(uint64(l))
-	}
-	l = len(m.IdentityId)
-	if l > 0 {
-		n += 1 + l + sovTaskRpcApi(uint64(l))
-	}
-	l = len(m.Content)
-	if l > 0 {
-		n += 1 + l + sovTaskRpcApi(uint64(l))
-	}
-	if m.CreateAt != 0 {
-		n += 1 + sovTaskRpcApi(uint64(m.CreateAt))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *ExplainScheduleResponse) GetPlacedJobNode() string {
+	if m != nil {
+		return m.PlacedJobNode
 	}
-	return n
+	return ""
 }
 
-func (m *TaskDataSupplierDeclare) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MemberInfo != nil {
-		l = m.MemberInfo.Size()
-		n += 1 + l + sovTaskRpcApi(uint64(l))
-	}
-	if m.MetaDataInfo != nil {
-		l = m.MetaDataInfo.Size()
-		n += 1 + l + sovTaskRpcApi(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+func (m *ExplainScheduleResponse) GetNeedSlotCount() uint32 {
+	if m != nil {
+		return m.NeedSlotCount
 	}
-	return n
+	return 0
 }
 
-func (m *TaskMetaDataDeclare) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.MetaDataId)
-	if l > 0 {
-		n += 1 + l + sovTaskRpcApi(uint64(l))
+func (m *ExplainScheduleResponse) GetNodeErr() string {
+	if m != nil {
+		return m.NodeErr
 	}
-	if len(m.ColumnIndexList) > 0 {
-		l = 0
-		for _, e := range m.ColumnIndexList {
-			l += sovTaskRpcApi(uint64(e))
+	return ""
+}
+
+type VerifyTaskAgreementRequest struct {
+	TaskId               string   `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VerifyTaskAgreementRequest) Reset()         { *m = VerifyTaskAgreementRequest{} }
+func (m *VerifyTaskAgreementRequest) String() string { return proto.CompactTextString(m) }
+func (*VerifyTaskAgreementRequest) ProtoMessage()    {}
+func (*VerifyTaskAgreementRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a744901dce4e8cd, []int{38}
+}
+func (m *VerifyTaskAgreementRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VerifyTaskAgreementRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VerifyTaskAgreementRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		n += 1 + sovTaskRpcApi(uint64(l)) + l
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+		return b[:n], nil
 	}
-	return n
+}
+func (m *VerifyTaskAgreementRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyTaskAgreementRequest.Merge(m, src)
+}
+func (m *VerifyTaskAgreementRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *VerifyTaskAgreementRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyTaskAgreementRequest.DiscardUnknown(m)
 }
 
-func (m *TaskResultReceiverDeclare) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MemberInfo != nil {
-		l = m.MemberInfo.Size()
-		n += 1 + l + sovTaskRpcApi(uint64(l))
+var xxx_messageInfo_VerifyTaskAgreementRequest proto.InternalMessageInfo
+
+func (m *VerifyTaskAgreementRequest) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
 	}
-	if len(m.Providers) > 0 {
-		for _, e := range m.Providers {
-			l = e.Size()
-			n += 1 + l + sovTaskRpcApi(uint64(l))
+	return ""
+}
+
+type VerifyTaskAgreementResponse struct {
+	Status               int32    `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`
+	Msg                  string   `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	TaskId               string   `protobuf:"bytes,3,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Agreed               bool     `protobuf:"varint,4,opt,name=agreed,proto3" json:"agreed,omitempty"`
+	ProposalId           string   `protobuf:"bytes,5,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+	BlockHashes          []string `protobuf:"bytes,6,rep,name=block_hashes,json=blockHashes,proto3" json:"block_hashes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *VerifyTaskAgreementResponse) Reset()         { *m = VerifyTaskAgreementResponse{} }
+func (m *VerifyTaskAgreementResponse) String() string { return proto.CompactTextString(m) }
+func (*VerifyTaskAgreementResponse) ProtoMessage()    {}
+func (*VerifyTaskAgreementResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7a744901dce4e8cd, []int{39}
+}
+func (m *VerifyTaskAgreementResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VerifyTaskAgreementResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VerifyTaskAgreementResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
+}
+func (m *VerifyTaskAgreementResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerifyTaskAgreementResponse.Merge(m, src)
+}
+func (m *VerifyTaskAgreementResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *VerifyTaskAgreementResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerifyTaskAgreementResponse.DiscardUnknown(m)
 }
 
-func (m *TaskOperationCostDeclare) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_VerifyTaskAgreementResponse proto.InternalMessageInfo
+
+func (m *VerifyTaskAgreementResponse) GetStatus() int32 {
+	if m != nil {
+		return m.Status
 	}
-	var l int
-	_ = l
-	if m.CostMem != 0 {
-		n += 1 + sovTaskRpcApi(uint64(m.CostMem))
-	}
-	if m.CostProcessor != 0 {
-		n += 1 + sovTaskRpcApi(uint64(m.CostProcessor))
-	}
-	if m.CostBandwidth != 0 {
-		n += 1 + sovTaskRpcApi(uint64(m.CostBandwidth))
-	}
-	if m.Duration != 0 {
-		n += 1 + sovTaskRpcApi(uint64(m.Duration))
+	return 0
+}
+
+func (m *VerifyTaskAgreementResponse) GetMsg() string {
+	if m != nil {
+		return m.Msg
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return ""
+}
+
+func (m *VerifyTaskAgreementResponse) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
 	}
-	return n
+	return ""
 }
 
-func (m *GetTaskDetailResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *VerifyTaskAgreementResponse) GetAgreed() bool {
+	if m != nil {
+		return m.Agreed
 	}
-	var l int
-	_ = l
-	if m.Information != nil {
-		l = m.Information.Size()
-		n += 1 + l + sovTaskRpcApi(uint64(l))
+	return false
+}
+
+func (m *VerifyTaskAgreementResponse) GetProposalId() string {
+	if m != nil {
+		return m.ProposalId
 	}
-	l = len(m.Role)
-	if l > 0 {
-		n += 1 + l + sovTaskRpcApi(uint64(l))
+	return ""
+}
+
+func (m *VerifyTaskAgreementResponse) GetBlockHashes() []string {
+	if m != nil {
+		return m.BlockHashes
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*TaskDetailShow)(nil), "rpcapi.TaskDetailShow")
+	proto.RegisterType((*TaskDataSupplierShow)(nil), "rpcapi.TaskDataSupplierShow")
+	proto.RegisterType((*TaskPowerSupplierShow)(nil), "rpcapi.TaskPowerSupplierShow")
+	proto.RegisterType((*TaskEventShow)(nil), "rpcapi.TaskEventShow")
+	proto.RegisterType((*TaskEventDeclare)(nil), "rpcapi.TaskEventDeclare")
+	proto.RegisterType((*TaskDataSupplierDeclare)(nil), "rpcapi.TaskDataSupplierDeclare")
+	proto.RegisterType((*TaskMetaDataDeclare)(nil), "rpcapi.TaskMetaDataDeclare")
+	proto.RegisterType((*TaskResultReceiverDeclare)(nil), "rpcapi.TaskResultReceiverDeclare")
+	proto.RegisterType((*TaskOperationCostDeclare)(nil), "rpcapi.TaskOperationCostDeclare")
+	proto.RegisterType((*GetTaskDetailResponse)(nil), "rpcapi.GetTaskDetailResponse")
+	proto.RegisterType((*GetTaskDetailListResponse)(nil), "rpcapi.GetTaskDetailListResponse")
+	proto.RegisterType((*GetTaskEventListRequest)(nil), "rpcapi.GetTaskEventListRequest")
+	proto.RegisterType((*GetTaskEventListByTaskIdsRequest)(nil), "rpcapi.GetTaskEventListByTaskIdsRequest")
+	proto.RegisterType((*SubscribeTaskEventsRequest)(nil), "rpcapi.SubscribeTaskEventsRequest")
+	proto.RegisterType((*GetTaskEventListResponse)(nil), "rpcapi.GetTaskEventListResponse")
+	proto.RegisterType((*PublishTaskDeclareRequest)(nil), "rpcapi.PublishTaskDeclareRequest")
+	proto.RegisterType((*TaskRetryPolicyDeclare)(nil), "rpcapi.TaskRetryPolicyDeclare")
+	proto.RegisterType((*PublishTaskDeclareResponse)(nil), "rpcapi.PublishTaskDeclareResponse")
+	proto.RegisterType((*CancelTaskRequest)(nil), "rpcapi.CancelTaskRequest")
+	proto.RegisterType((*GetTaskResourceUsageRequest)(nil), "rpcapi.GetTaskResourceUsageRequest")
+	proto.RegisterType((*TaskResourceUsageShow)(nil), "rpcapi.TaskResourceUsageShow")
+	proto.RegisterType((*GetTaskResourceUsageResponse)(nil), "rpcapi.GetTaskResourceUsageResponse")
+	proto.RegisterType((*GetTaskProgressRequest)(nil), "rpcapi.GetTaskProgressRequest")
+	proto.RegisterType((*TaskProgressShow)(nil), "rpcapi.TaskProgressShow")
+	proto.RegisterType((*GetTaskProgressResponse)(nil), "rpcapi.GetTaskProgressResponse")
+	proto.RegisterType((*WorkflowTaskDeclare)(nil), "rpcapi.WorkflowTaskDeclare")
+	proto.RegisterType((*WorkflowResultBindingDeclare)(nil), "rpcapi.WorkflowResultBindingDeclare")
+	proto.RegisterType((*PublishWorkflowDeclareRequest)(nil), "rpcapi.PublishWorkflowDeclareRequest")
+	proto.RegisterType((*PublishWorkflowDeclareResponse)(nil), "rpcapi.PublishWorkflowDeclareResponse")
+	proto.RegisterType((*GetWorkflowDetailRequest)(nil), "rpcapi.GetWorkflowDetailRequest")
+	proto.RegisterType((*CancelWorkflowRequest)(nil), "rpcapi.CancelWorkflowRequest")
+	proto.RegisterType((*WorkflowTaskShow)(nil), "rpcapi.WorkflowTaskShow")
+	proto.RegisterType((*WorkflowEventShow)(nil), "rpcapi.WorkflowEventShow")
+	proto.RegisterType((*WorkflowDetailShow)(nil), "rpcapi.WorkflowDetailShow")
+	proto.RegisterType((*GetWorkflowDetailResponse)(nil), "rpcapi.GetWorkflowDetailResponse")
+	proto.RegisterType((*GetWorkflowListResponse)(nil), "rpcapi.GetWorkflowListResponse")
+	proto.RegisterType((*ScheduleCandidateShow)(nil), "rpcapi.ScheduleCandidateShow")
+	proto.RegisterType((*ExplainScheduleResponse)(nil), "rpcapi.ExplainScheduleResponse")
+	proto.RegisterType((*VerifyTaskAgreementRequest)(nil), "rpcapi.VerifyTaskAgreementRequest")
+	proto.RegisterType((*VerifyTaskAgreementResponse)(nil), "rpcapi.VerifyTaskAgreementResponse")
+}
+
+func init() { proto.RegisterFile("lib/api/task_rpc_api.proto", fileDescriptor_7a744901dce4e8cd) }
+
+var fileDescriptor_7a744901dce4e8cd = []byte{
+	// 2751 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x5a, 0x5b, 0x6f, 0x24, 0x47,
+	0xf5, 0x57, 0x8f, 0x3d, 0xb7, 0x33, 0x33, 0xf6, 0x6e, 0xed, 0xda, 0x3b, 0x9e, 0xdd, 0xb5, 0x67,
+	0xdb, 0xbb, 0xf9, 0x3b, 0xf9, 0xc3, 0x9a, 0x2c, 0xda, 0xb0, 0x0a, 0x59, 0x05, 0xaf, 0xed, 0x2c,
+	0x06, 0x92, 0x58, 0xbd, 0x01, 0x24, 0x78, 0x68, 0xd5, 0x74, 0x97, 0xc7, 0x1d, 0x77, 0x77, 0x35,
+	0xd5, 0x35, 0x6b, 0x3b, 0x02, 0x14, 0x42, 0xde, 0xe0, 0x8d, 0x48, 0x79, 0x40, 0x88, 0x07, 0x04,
+	0x6f, 0xf9, 0x06, 0xc9, 0x13, 0x42, 0xe2, 0x11, 0x89, 0x67, 0x24, 0x14, 0xf1, 0x21, 0x78, 0x03,
+	0xd5, 0xa5, 0xaf, 0x73, 0xb1, 0xbd, 0x32, 0x6f, 0xd3, 0xa7, 0xce, 0xa9, 0x73, 0xaa, 0xea, 0x5c,
+	0x7e, 0xe7, 0xd8, 0xd0, 0xf3, 0xbd, 0xc1, 0x26, 0x8e, 0xbc, 0x4d, 0x8e, 0xe3, 0x23, 0x9b, 0x45,
+	0x8e, 0x8d, 0x23, 0xef, 0x7e, 0xc4, 0x28, 0xa7, 0xa8, 0xc6, 0x22, 0x07, 0x47, 0x5e, 0xef, 0x56,
+	0xc2, 0xe3, 0xd0, 0x20, 0xa0, 0xa1, 0x1d, 0x90, 0x38, 0xc6, 0x43, 0xa2, 0xb8, 0x7a, 0xb7, 0x86,
+	0x94, 0x0e, 0x7d, 0x22, 0x19, 0x70, 0x18, 0x52, 0x8e, 0xb9, 0x47, 0xc3, 0x58, 0xad, 0x9a, 0x9f,
+	0xd6, 0x60, 0xe1, 0x3d, 0x1c, 0x1f, 0xed, 0x10, 0x8e, 0x3d, 0xff, 0xd9, 0x21, 0x3d, 0x46, 0x37,
+	0xa0, 0x2e, 0x95, 0x79, 0x6e, 0xd7, 0xe8, 0x1b, 0x1b, 0x4d, 0xab, 0x26, 0x3e, 0xf7, 0x5c, 0x74,
+	0x13, 0x9a, 0x72, 0x21, 0xc4, 0x01, 0xe9, 0x56, 0xe4, 0x52, 0x43, 0x10, 0xde, 0xc1, 0x01, 0x41,
+	0xaf, 0x43, 0x95, 0x1e, 0x87, 0x84, 0x75, 0xe7, 0xfa, 0xc6, 0x46, 0xeb, 0xc1, 0xdd, 0xfb, 0xca,
+	0xb8, 0xfb, 0x62, 0xf3, 0x77, 0xd9, 0x10, 0x87, 0xde, 0x07, 0x52, 0xf1, 0x9e, 0x4b, 0x42, 0xee,
+	0xf1, 0xd3, 0xbd, 0xf0, 0x80, 0x5a, 0x4a, 0x04, 0xed, 0x41, 0x07, 0xfb, 0x43, 0x6a, 0xc7, 0xa3,
+	0x28, 0xf2, 0x3d, 0xc2, 0xba, 0xf3, 0x17, 0xd8, 0xa3, 0x2d, 0x44, 0x9f, 0x69, 0x49, 0xb4, 0x05,
+	0x1d, 0x17, 0x73, 0x9c, 0x6d, 0x55, 0xed, 0xcf, 0x6d, 0xb4, 0x1e, 0xdc, 0xca, 0x6f, 0xb5, 0x83,
+	0x39, 0x4e, 0x04, 0xc4, 0x89, 0xad, 0xb6, 0x9b, 0xa3, 0xa0, 0x1d, 0x58, 0x88, 0xe8, 0x31, 0x61,
+	0xd9, 0x1e, 0x35, 0xb9, 0xc7, 0xed, 0xfc, 0x1e, 0xfb, 0x82, 0xa3, 0xb0, 0x49, 0x27, 0xca, 0x93,
+	0xd0, 0x13, 0x68, 0x32, 0xe2, 0x10, 0xef, 0x39, 0x61, 0x71, 0xb7, 0xde, 0x9f, 0x3b, 0xf7, 0x79,
+	0x32, 0x31, 0x71, 0xe1, 0x0e, 0x23, 0x98, 0x13, 0x1b, 0xf3, 0x6e, 0xa3, 0x6f, 0x6c, 0xcc, 0x5b,
+	0x0d, 0x45, 0xd8, 0xe2, 0x68, 0x05, 0x1a, 0x31, 0xc7, 0x8c, 0x8b, 0xb5, 0xa6, 0x5c, 0xab, 0xcb,
+	0xef, 0x2d, 0x8e, 0x96, 0xa0, 0x46, 0x42, 0x57, 0x2c, 0x80, 0x5c, 0xa8, 0x92, 0xd0, 0xdd, 0xe2,
+	0xe8, 0x3a, 0x54, 0x63, 0x8e, 0x39, 0xe9, 0xb6, 0xe4, 0xdb, 0xa9, 0x0f, 0xf4, 0x14, 0x16, 0x68,
+	0x44, 0x98, 0x34, 0xc4, 0x76, 0x68, 0xcc, 0xbb, 0x6d, 0x79, 0xfb, 0xfd, 0x82, 0xb5, 0x09, 0xc7,
+	0x36, 0x8d, 0xf9, 0x0e, 0x71, 0x7c, 0xcc, 0x88, 0xd5, 0xa1, 0x79, 0x2a, 0xea, 0x42, 0x1d, 0x73,
+	0x4e, 0x82, 0x88, 0x77, 0x3b, 0x7d, 0x63, 0xa3, 0x63, 0x25, 0x9f, 0xe8, 0x0e, 0xb4, 0x03, 0x7c,
+	0x62, 0xeb, 0xcf, 0xb8, 0xbb, 0x20, 0x97, 0x5b, 0x01, 0x3e, 0xd9, 0xd2, 0x24, 0x74, 0x17, 0x16,
+	0x28, 0xf3, 0x86, 0x5e, 0x68, 0x27, 0xbe, 0xb7, 0x28, 0x8d, 0x6c, 0x2b, 0xea, 0x7b, 0xca, 0x03,
+	0x7b, 0xd0, 0x88, 0x98, 0x47, 0x99, 0xc7, 0x4f, 0xbb, 0x57, 0xe4, 0x26, 0xe9, 0x37, 0xba, 0x07,
+	0x0b, 0xea, 0x3e, 0x5c, 0x82, 0x5d, 0xdf, 0x0b, 0x49, 0xf7, 0xaa, 0x3c, 0x7c, 0x47, 0x52, 0x77,
+	0x34, 0x11, 0xfd, 0x1f, 0x2c, 0x1e, 0x78, 0xa1, 0x17, 0x1f, 0x66, 0x7c, 0x48, 0xf2, 0x2d, 0x28,
+	0x72, 0xc2, 0x68, 0xfe, 0xd1, 0x80, 0xeb, 0x93, 0xbc, 0x05, 0xed, 0x42, 0x2b, 0x20, 0xc1, 0x80,
+	0x30, 0xdb, 0x0b, 0x0f, 0xa8, 0x8c, 0x91, 0xf3, 0xbe, 0x2d, 0x28, 0x41, 0xf1, 0x1b, 0xf5, 0xa1,
+	0x1d, 0x10, 0x8e, 0x6d, 0xe9, 0xae, 0x9e, 0xab, 0x03, 0x0a, 0x04, 0x4d, 0xa8, 0xdc, 0x73, 0xc5,
+	0x9d, 0x64, 0x1c, 0x32, 0xe8, 0xe6, 0xd4, 0x9d, 0x24, 0x3c, 0x22, 0xf0, 0xcc, 0xdf, 0x19, 0xb0,
+	0x34, 0xd1, 0x23, 0x2f, 0xcb, 0xd0, 0xc7, 0x00, 0x2a, 0x1e, 0xe4, 0x2e, 0x15, 0xb9, 0xcb, 0x6a,
+	0xb2, 0x8b, 0x45, 0x62, 0x3a, 0x62, 0x0e, 0xf9, 0x7e, 0x4c, 0xdc, 0x2c, 0x87, 0x58, 0x4d, 0x29,
+	0x21, 0xc4, 0xcd, 0x3f, 0x1b, 0xd0, 0x11, 0xba, 0x76, 0x9f, 0x93, 0x90, 0x4b, 0xbb, 0x10, 0xcc,
+	0xf3, 0xd3, 0x88, 0xe8, 0xec, 0x22, 0x7f, 0xe7, 0x93, 0x4e, 0xa5, 0x90, 0x74, 0x5e, 0x2b, 0xe6,
+	0x95, 0xd4, 0x2b, 0xcf, 0xca, 0x29, 0x5d, 0xa8, 0x3b, 0x34, 0xe4, 0x24, 0xe4, 0x32, 0x9b, 0x34,
+	0xad, 0xe4, 0xb3, 0x18, 0x55, 0xd5, 0x52, 0x54, 0x2d, 0x43, 0xcd, 0x19, 0xb1, 0x98, 0x8a, 0xa0,
+	0x97, 0x66, 0xa8, 0x2f, 0xf3, 0x53, 0x03, 0xae, 0xa4, 0xa7, 0xd0, 0x01, 0x70, 0xb1, 0x83, 0xac,
+	0x41, 0xcb, 0xd3, 0x76, 0x8a, 0x45, 0xf5, 0x94, 0x90, 0x90, 0xf6, 0xdc, 0x17, 0xb4, 0xd8, 0xfc,
+	0x83, 0x01, 0x37, 0xca, 0x7e, 0x9a, 0x18, 0x78, 0x49, 0x1e, 0xb0, 0x95, 0x77, 0xc4, 0x9c, 0x17,
+	0xdc, 0xcc, 0xef, 0xf4, 0xb6, 0x76, 0xca, 0x24, 0x3b, 0xa4, 0x5e, 0x2a, 0xbd, 0xc0, 0x81, 0x6b,
+	0x13, 0x98, 0xc6, 0x82, 0xc0, 0x18, 0x0b, 0x82, 0x57, 0xe0, 0xaa, 0x43, 0xfd, 0x51, 0x10, 0xda,
+	0x5e, 0xe8, 0x92, 0x13, 0xdb, 0xf7, 0x62, 0xde, 0xad, 0xf4, 0xe7, 0x36, 0xe6, 0xad, 0x45, 0xb5,
+	0xb0, 0x27, 0xe8, 0xdf, 0xf3, 0x62, 0x6e, 0xfe, 0xc9, 0x80, 0x15, 0xa1, 0xc5, 0x22, 0xf1, 0xc8,
+	0xe7, 0x96, 0xce, 0xa3, 0x97, 0x7c, 0x19, 0x4f, 0xa0, 0x19, 0x31, 0xfa, 0xdc, 0x73, 0x45, 0x62,
+	0xaf, 0x5c, 0x24, 0xb1, 0xa7, 0x62, 0xe6, 0xef, 0x0d, 0xe8, 0x4e, 0x4b, 0xab, 0x22, 0xb1, 0x8b,
+	0x34, 0x6c, 0x07, 0x24, 0x90, 0x46, 0xce, 0x0b, 0x47, 0x88, 0xf9, 0xdb, 0x24, 0x10, 0x39, 0x4e,
+	0x2e, 0x45, 0x8c, 0x3a, 0x24, 0x16, 0x5e, 0x5a, 0x51, 0x39, 0x4e, 0x50, 0xf7, 0x13, 0x62, 0xca,
+	0x36, 0xc0, 0xa1, 0x7b, 0xec, 0xb9, 0xfc, 0xb0, 0x3b, 0x97, 0xb1, 0x3d, 0x49, 0x88, 0x22, 0x9b,
+	0xba, 0x23, 0xa5, 0x5f, 0x7a, 0xdc, 0xbc, 0x95, 0x7e, 0x9b, 0x04, 0x96, 0x9e, 0x12, 0x9e, 0x21,
+	0x03, 0x8b, 0xc4, 0x11, 0x0d, 0x63, 0x82, 0x1e, 0x41, 0x4b, 0x5c, 0x1f, 0x0b, 0x94, 0x9c, 0xba,
+	0xc5, 0xe5, 0x42, 0x79, 0xcd, 0xd2, 0x40, 0x9e, 0x55, 0x44, 0x0b, 0xa3, 0x7e, 0x82, 0x1c, 0xe4,
+	0x6f, 0xf3, 0x17, 0x06, 0xac, 0x14, 0xf4, 0x88, 0x77, 0x4c, 0x75, 0x2d, 0x43, 0x2d, 0xe6, 0x98,
+	0x8f, 0x62, 0xa9, 0xa6, 0x6a, 0xe9, 0x2f, 0x74, 0x05, 0xe6, 0x82, 0x78, 0xa8, 0x37, 0x12, 0x3f,
+	0xd1, 0xeb, 0x1a, 0x9a, 0x48, 0xef, 0x98, 0x2b, 0x96, 0xeb, 0x89, 0xe7, 0x50, 0xc8, 0x45, 0x7a,
+	0xcd, 0x03, 0xb8, 0xa1, 0x59, 0x64, 0x70, 0x2b, 0x0b, 0x7e, 0x32, 0x22, 0x31, 0x9f, 0x0a, 0x85,
+	0xcc, 0xc7, 0xd0, 0x2f, 0xcb, 0x3c, 0x39, 0x55, 0x45, 0x2a, 0x4e, 0x84, 0x57, 0xa0, 0xa1, 0x85,
+	0x85, 0xfd, 0x73, 0x22, 0xa0, 0x95, 0x74, 0x6c, 0x7e, 0x66, 0x40, 0xef, 0xd9, 0x68, 0x10, 0x3b,
+	0xcc, 0x1b, 0x90, 0x74, 0x97, 0x73, 0x48, 0xa2, 0x75, 0xe8, 0x88, 0x34, 0x63, 0x47, 0x8c, 0x1c,
+	0x78, 0x27, 0x44, 0x79, 0x60, 0xd3, 0x6a, 0x0b, 0xe2, 0xbe, 0xa6, 0x89, 0x7a, 0x9b, 0x4b, 0x35,
+	0xb1, 0xbc, 0x90, 0xa6, 0xd5, 0xca, 0x72, 0x4d, 0x2c, 0xb1, 0x80, 0x17, 0x3a, 0x44, 0x3f, 0xbc,
+	0xfa, 0xc8, 0x65, 0xbf, 0x6a, 0x21, 0xfb, 0xfd, 0xd2, 0x80, 0xee, 0xf8, 0x1d, 0x5d, 0xf8, 0x95,
+	0x1e, 0xc3, 0xa2, 0x3c, 0x17, 0x11, 0x7b, 0xe4, 0xdf, 0x6a, 0x29, 0xef, 0x3f, 0x69, 0xa1, 0xb0,
+	0x3a, 0x3c, 0xaf, 0xd0, 0xfc, 0xa2, 0x0a, 0x2b, 0xfb, 0xa3, 0x81, 0xef, 0xc5, 0x87, 0xea, 0x41,
+	0x55, 0xa2, 0xd1, 0x97, 0x56, 0x40, 0xa7, 0xc6, 0x34, 0x74, 0x5a, 0xb9, 0x38, 0x3a, 0xdd, 0x29,
+	0x43, 0x4a, 0x65, 0xf3, 0xda, 0x34, 0x48, 0x99, 0x26, 0xc0, 0x02, 0xaa, 0x7c, 0x09, 0x16, 0x55,
+	0x15, 0x8d, 0x30, 0xd3, 0xcf, 0x32, 0x2f, 0x9f, 0x45, 0xe1, 0xc6, 0x7d, 0xcc, 0xd4, 0xc3, 0xbc,
+	0x99, 0xc7, 0x8d, 0x0a, 0xbc, 0xde, 0xc9, 0x6b, 0x9a, 0x98, 0xdb, 0xf2, 0xa0, 0x71, 0x1c, 0xcf,
+	0xd5, 0x5e, 0x0c, 0xcf, 0x3d, 0x84, 0x65, 0x07, 0xfb, 0xce, 0xc8, 0x17, 0x85, 0x47, 0x94, 0x22,
+	0x86, 0x1d, 0xee, 0x50, 0x97, 0x74, 0xeb, 0xf2, 0x76, 0x97, 0xd2, 0xd5, 0xed, 0xdc, 0xa2, 0x10,
+	0x13, 0x07, 0x8f, 0x23, 0xdf, 0xe3, 0x45, 0xb1, 0x86, 0x12, 0x4b, 0x57, 0x0b, 0x62, 0x0f, 0x60,
+	0x29, 0x61, 0xb6, 0xc9, 0x09, 0x67, 0x58, 0x5c, 0x14, 0x0e, 0x62, 0x89, 0x6d, 0x9b, 0xd6, 0xb5,
+	0x64, 0x71, 0x57, 0xac, 0xed, 0xcb, 0x25, 0xb4, 0x05, 0x6d, 0x46, 0x38, 0x3b, 0xb5, 0x23, 0xea,
+	0x7b, 0xce, 0x69, 0x17, 0x8a, 0xd8, 0x44, 0x5d, 0x17, 0x67, 0xa7, 0xfb, 0x72, 0x39, 0x39, 0x66,
+	0x8b, 0x65, 0xb4, 0x02, 0xa2, 0x6c, 0x9d, 0x89, 0x28, 0xdb, 0xe7, 0x44, 0x94, 0x9d, 0x89, 0x88,
+	0xf2, 0x03, 0x58, 0x9e, 0x6c, 0xd2, 0x18, 0x40, 0x36, 0xc6, 0x01, 0x72, 0x17, 0xea, 0x03, 0xec,
+	0x1c, 0xd1, 0x83, 0x03, 0x9d, 0xf3, 0x93, 0x4f, 0x91, 0x12, 0x18, 0x21, 0x3e, 0x71, 0xb8, 0x2d,
+	0x5d, 0x49, 0x26, 0xfb, 0x86, 0xd5, 0xd6, 0x44, 0x89, 0x0b, 0x4d, 0x1b, 0x7a, 0x93, 0x42, 0xe7,
+	0xc2, 0x21, 0x9c, 0xcb, 0x88, 0x73, 0x85, 0x8c, 0xf8, 0x15, 0xb8, 0xba, 0x8d, 0x43, 0x87, 0xf8,
+	0xea, 0x88, 0x67, 0xe4, 0xcf, 0xd7, 0xe0, 0xa6, 0xce, 0x27, 0x19, 0x80, 0xc4, 0x43, 0x72, 0xa6,
+	0xdc, 0x5f, 0x2a, 0xb0, 0x34, 0x26, 0x25, 0x41, 0xe5, 0x0a, 0x34, 0x92, 0xc8, 0xd2, 0x32, 0xf5,
+	0x48, 0xc5, 0x54, 0x19, 0x79, 0x55, 0xc6, 0x90, 0xd7, 0x2a, 0xb4, 0xde, 0xa7, 0x03, 0x3b, 0xa4,
+	0x2e, 0xc9, 0x0e, 0xd6, 0x7c, 0x9f, 0x0e, 0xde, 0xa1, 0x2e, 0xd9, 0x73, 0xc5, 0xde, 0xa3, 0x98,
+	0xb8, 0xb2, 0x22, 0xab, 0x7c, 0x59, 0x17, 0xdf, 0xba, 0x22, 0xcb, 0xa5, 0xac, 0x22, 0x2b, 0x7c,
+	0xd6, 0x11, 0xd4, 0x42, 0x45, 0x96, 0x6c, 0x59, 0x45, 0xae, 0x65, 0x6c, 0x59, 0x45, 0x5e, 0x07,
+	0x49, 0xb0, 0xd3, 0xb2, 0x5c, 0x97, 0x5c, 0x6d, 0x41, 0xdc, 0xd1, 0x34, 0x91, 0xe8, 0x46, 0x91,
+	0x5b, 0xec, 0x0a, 0x15, 0x61, 0x8b, 0x0b, 0x45, 0xe4, 0xc4, 0x21, 0xc4, 0x25, 0xae, 0xed, 0x71,
+	0x22, 0xe3, 0x47, 0x66, 0x99, 0x84, 0xba, 0x27, 0x88, 0xe6, 0x3f, 0x0c, 0xb8, 0x35, 0xf9, 0x01,
+	0x2e, 0xcd, 0x23, 0xd0, 0x1b, 0xd0, 0x70, 0x95, 0x9f, 0xb9, 0xdd, 0xf9, 0x73, 0xa6, 0xa0, 0x54,
+	0x02, 0xbd, 0x01, 0x30, 0x12, 0x16, 0xa9, 0x32, 0x51, 0x1d, 0xef, 0xc0, 0xc7, 0x5c, 0xc0, 0x6a,
+	0x4a, 0x01, 0x59, 0x2a, 0x5e, 0x85, 0x65, 0x7d, 0xbc, 0x7d, 0x46, 0x87, 0x8c, 0xc4, 0xf1, 0x99,
+	0xae, 0xf5, 0x1f, 0x8d, 0xf0, 0x13, 0x81, 0xff, 0xb9, 0x57, 0x5d, 0x87, 0x6a, 0x74, 0x88, 0x63,
+	0xa2, 0xd1, 0xbe, 0xfa, 0x10, 0x71, 0x1e, 0x11, 0xe6, 0x88, 0x2e, 0xa0, 0xaa, 0xba, 0x68, 0xfd,
+	0x29, 0x92, 0x04, 0xf1, 0x71, 0x24, 0xfc, 0x83, 0x7b, 0x01, 0xd1, 0x1e, 0xd4, 0xd2, 0xb4, 0xf7,
+	0xbc, 0x80, 0x08, 0x9b, 0x18, 0x09, 0xb0, 0x17, 0x2a, 0x0e, 0xe5, 0x3d, 0xa0, 0x48, 0x92, 0x61,
+	0x96, 0xef, 0x98, 0xbf, 0x35, 0x52, 0x24, 0x94, 0xdd, 0xda, 0xe5, 0xf9, 0xc3, 0x63, 0xe8, 0x44,
+	0x7a, 0x5b, 0xf5, 0xa8, 0xf3, 0xf2, 0x51, 0xbb, 0x85, 0xb1, 0x4a, 0xee, 0xf2, 0xad, 0x76, 0xc2,
+	0x2e, 0x9f, 0xf4, 0x73, 0x03, 0xae, 0xfd, 0x90, 0xb2, 0xa3, 0x03, 0x9f, 0x1e, 0xe7, 0x72, 0x98,
+	0x80, 0x95, 0xb9, 0x92, 0x2f, 0x7f, 0xa3, 0x87, 0x30, 0x2f, 0x94, 0xea, 0x6a, 0x9f, 0xd6, 0xcf,
+	0xa9, 0xe0, 0xc1, 0x92, 0xec, 0xe2, 0xee, 0x5d, 0x12, 0x91, 0x30, 0x85, 0x4c, 0xc9, 0x27, 0xfa,
+	0x16, 0x34, 0x06, 0x5e, 0xe8, 0x7a, 0xe1, 0x30, 0xd6, 0x66, 0xa7, 0x10, 0x22, 0xb1, 0x49, 0x15,
+	0xe6, 0x27, 0x8a, 0x2b, 0xf5, 0xe7, 0x44, 0xca, 0x3c, 0x85, 0x5b, 0xb3, 0x38, 0xc5, 0xcb, 0x1c,
+	0x30, 0x1a, 0xc8, 0xf1, 0x47, 0x02, 0x5f, 0x04, 0x41, 0x18, 0x7b, 0xb6, 0xaf, 0xdd, 0x84, 0xa6,
+	0x2c, 0x97, 0xf6, 0x11, 0x39, 0xd5, 0xd7, 0xde, 0x90, 0x84, 0xef, 0x92, 0x53, 0xf3, 0xe7, 0x70,
+	0x5b, 0x9f, 0x3c, 0xb1, 0xa0, 0x04, 0x9d, 0xd6, 0xa1, 0x73, 0xac, 0x57, 0xf2, 0xf0, 0xa9, 0x9d,
+	0x10, 0x25, 0x84, 0x7a, 0x94, 0x87, 0xd8, 0xaa, 0xef, 0xb9, 0x59, 0xbe, 0x83, 0xfc, 0xcd, 0x66,
+	0x00, 0xfb, 0x08, 0x56, 0xa7, 0xe9, 0xbf, 0xb0, 0x77, 0xad, 0x41, 0x2b, 0x35, 0x35, 0xeb, 0xa2,
+	0x13, 0xd2, 0x9e, 0x6b, 0x7e, 0x53, 0x22, 0xd5, 0x4c, 0x91, 0x02, 0xfd, 0xea, 0x9c, 0x25, 0x61,
+	0x63, 0x4c, 0xf8, 0x11, 0x2c, 0xa9, 0x22, 0x96, 0x3d, 0xd5, 0x39, 0x25, 0xbf, 0x30, 0xe0, 0x4a,
+	0xfe, 0x16, 0x92, 0x41, 0xc7, 0x98, 0x6b, 0x4e, 0x9d, 0x0f, 0xa4, 0xd3, 0xb9, 0xb9, 0xfc, 0x74,
+	0x6e, 0x19, 0x6a, 0x8c, 0xe0, 0x58, 0x77, 0x68, 0x4d, 0x4b, 0x7f, 0xe5, 0x5d, 0xb5, 0x5a, 0x74,
+	0xd5, 0xdb, 0x00, 0x91, 0xba, 0x6d, 0x11, 0xe3, 0x2a, 0x49, 0x34, 0x35, 0xa5, 0x30, 0x1b, 0xac,
+	0xe7, 0x66, 0x83, 0xe6, 0x27, 0x06, 0x5c, 0x4d, 0xec, 0x9f, 0x3d, 0xa9, 0x99, 0x39, 0x05, 0x9e,
+	0x1a, 0xfc, 0x2f, 0x38, 0xdc, 0xf8, 0xac, 0x02, 0xa8, 0xf8, 0x96, 0xd2, 0xae, 0xb3, 0x9e, 0x63,
+	0xdc, 0xa3, 0x2b, 0x13, 0x3c, 0xfa, 0x62, 0x37, 0x3e, 0x73, 0x6c, 0x94, 0xdd, 0x6a, 0x2d, 0x3f,
+	0x71, 0x7d, 0x98, 0x8f, 0x99, 0x7a, 0x31, 0xdd, 0x95, 0xbd, 0x25, 0x0b, 0x18, 0xf4, 0x08, 0x20,
+	0xd7, 0x22, 0x35, 0xa4, 0xdc, 0x4a, 0x59, 0x2e, 0x6b, 0x93, 0x9a, 0x24, 0x6d, 0x91, 0x7e, 0x26,
+	0xdb, 0xe9, 0xb2, 0xf7, 0x5f, 0x38, 0xca, 0x5e, 0x83, 0x46, 0x72, 0x53, 0x7a, 0xee, 0xd6, 0x2b,
+	0xab, 0xcf, 0x75, 0xf9, 0x29, 0xaf, 0xf9, 0xb1, 0xaa, 0x20, 0x09, 0xcf, 0x0b, 0xb6, 0x89, 0x6f,
+	0xe6, 0x1e, 0x2f, 0xd7, 0x24, 0xce, 0x32, 0xa1, 0x7d, 0x9c, 0x53, 0x69, 0xfe, 0x18, 0x96, 0x9e,
+	0x39, 0x87, 0xc4, 0x1d, 0xf9, 0x64, 0x1b, 0x87, 0xae, 0x27, 0xea, 0x9b, 0xf4, 0x9b, 0x05, 0xa8,
+	0xa4, 0xee, 0x52, 0xf1, 0xe4, 0x3c, 0x19, 0x3b, 0x0e, 0x89, 0x38, 0x51, 0xd1, 0xd8, 0xb0, 0xd2,
+	0xef, 0x9c, 0x1f, 0xcc, 0xe5, 0xfd, 0xc0, 0xfc, 0x77, 0x05, 0x6e, 0xec, 0x9e, 0x44, 0x3e, 0xf6,
+	0xc2, 0x44, 0xc9, 0x0b, 0x9c, 0x71, 0x47, 0xcc, 0xbb, 0x87, 0xb6, 0x93, 0x98, 0x17, 0x97, 0xa7,
+	0x16, 0x13, 0x0f, 0x60, 0x75, 0x28, 0x1b, 0xa6, 0x94, 0x58, 0x41, 0x02, 0xe2, 0x70, 0xe2, 0xda,
+	0x94, 0x0d, 0x93, 0x8e, 0xb2, 0xa5, 0x69, 0xef, 0xb2, 0x61, 0x2c, 0x22, 0x52, 0x28, 0x22, 0x2c,
+	0xed, 0xe9, 0x29, 0x1b, 0xee, 0x32, 0x86, 0xde, 0x82, 0x45, 0x09, 0x4d, 0x72, 0x26, 0xd4, 0xce,
+	0x63, 0xc2, 0x82, 0x90, 0xca, 0xd9, 0x20, 0x1a, 0x5b, 0x1f, 0x3b, 0xc4, 0xb5, 0x13, 0xb4, 0xa3,
+	0xfb, 0xc3, 0x8e, 0x22, 0x7f, 0x47, 0x01, 0x1e, 0xc1, 0x17, 0x12, 0xe2, 0xda, 0xb1, 0x4f, 0x45,
+	0x5f, 0x38, 0x0a, 0x15, 0x00, 0xe9, 0x58, 0x1d, 0x41, 0x7e, 0xe6, 0x53, 0xbe, 0x2d, 0x88, 0x02,
+	0x72, 0x49, 0xbb, 0x84, 0xc5, 0xaa, 0xf7, 0xab, 0x8b, 0xef, 0x5d, 0xc6, 0xcc, 0x87, 0xd0, 0xfb,
+	0x01, 0x61, 0xde, 0x81, 0x9c, 0xb4, 0x6c, 0x0d, 0x19, 0x21, 0x01, 0x09, 0xcf, 0x1e, 0xd6, 0x7c,
+	0x6e, 0xc0, 0xcd, 0x89, 0x72, 0x97, 0x87, 0x6d, 0x96, 0xa1, 0x86, 0xc5, 0xbe, 0x0a, 0xe9, 0x36,
+	0x2c, 0xfd, 0x25, 0x12, 0x55, 0xc4, 0x68, 0x44, 0x63, 0xec, 0x0b, 0x21, 0xf5, 0x02, 0x90, 0x90,
+	0xf6, 0x5c, 0xf1, 0x82, 0x03, 0x9f, 0x3a, 0x47, 0xf6, 0x21, 0x8e, 0x0f, 0xf5, 0x13, 0x34, 0xad,
+	0x96, 0xa4, 0x7d, 0x5b, 0x92, 0x1e, 0x7c, 0xb8, 0x00, 0x2d, 0x99, 0x24, 0x08, 0x7b, 0xee, 0x39,
+	0x04, 0x45, 0x70, 0x75, 0x6c, 0x64, 0x86, 0xd2, 0x09, 0xdc, 0x6e, 0x10, 0xf1, 0xd3, 0xa7, 0x84,
+	0xab, 0x16, 0xb9, 0x77, 0x67, 0xe2, 0x14, 0x2c, 0x1f, 0x98, 0x66, 0xff, 0xa3, 0xbf, 0xff, 0xeb,
+	0x37, 0x95, 0xde, 0xeb, 0xc6, 0x2b, 0xe6, 0xd2, 0xa6, 0x83, 0x19, 0xf3, 0x08, 0xdb, 0x7c, 0xfe,
+	0xaa, 0xfc, 0x8b, 0xe3, 0xa6, 0x88, 0x3f, 0xf4, 0x53, 0xb8, 0x52, 0x9e, 0xfe, 0xa0, 0xb5, 0xd2,
+	0xc6, 0xe5, 0xd9, 0x59, 0xaf, 0x3f, 0x9d, 0x41, 0x2b, 0xbe, 0x27, 0x15, 0xaf, 0x09, 0xc5, 0xbd,
+	0x31, 0xc5, 0x69, 0x4e, 0x43, 0x9f, 0x66, 0x33, 0xc2, 0xf1, 0x61, 0x1b, 0xda, 0x98, 0xa6, 0xa6,
+	0x3c, 0x8f, 0x3b, 0x87, 0x41, 0xf7, 0xa5, 0x41, 0x1b, 0xc2, 0xa0, 0xf5, 0xe9, 0x06, 0x65, 0xba,
+	0x2d, 0xb8, 0x36, 0x61, 0x8a, 0x87, 0xcc, 0x34, 0x80, 0xa6, 0x8e, 0xf8, 0x7a, 0x93, 0x27, 0x5e,
+	0x5f, 0x33, 0xd0, 0x87, 0x06, 0xa0, 0x71, 0x9c, 0x8a, 0xce, 0xc6, 0xb0, 0x3d, 0x73, 0x16, 0x8b,
+	0x3e, 0xe1, 0xba, 0x3c, 0xe1, 0x6d, 0x71, 0xc2, 0xee, 0xd8, 0x09, 0x35, 0x48, 0x40, 0xbf, 0x36,
+	0xe0, 0xfa, 0xa4, 0xe6, 0x10, 0xad, 0x97, 0x6e, 0x70, 0x52, 0xef, 0xde, 0xbb, 0x3b, 0x9b, 0x49,
+	0x1b, 0xf2, 0xb2, 0x34, 0x64, 0x5d, 0x18, 0xb2, 0x3a, 0x66, 0x08, 0x2b, 0x68, 0x3d, 0x81, 0xc5,
+	0x52, 0x57, 0x82, 0x56, 0x4b, 0x3a, 0x4a, 0x4d, 0x5e, 0x6f, 0x6d, 0xea, 0xba, 0x56, 0x7f, 0x57,
+	0xaa, 0x5f, 0x15, 0xea, 0x57, 0xc6, 0xef, 0x21, 0x51, 0x33, 0x04, 0xc8, 0x66, 0x1a, 0x28, 0xad,
+	0xc0, 0x63, 0x73, 0x8e, 0x5e, 0x5a, 0x9a, 0x9e, 0x79, 0x41, 0x94, 0xd5, 0x83, 0x6d, 0xea, 0x12,
+	0xd3, 0x94, 0xaa, 0x6e, 0x09, 0x55, 0x37, 0xc6, 0x54, 0x39, 0x72, 0x2b, 0xf4, 0x91, 0x01, 0x8b,
+	0xa5, 0x9a, 0x72, 0x9e, 0x17, 0x4f, 0x8f, 0x39, 0xa5, 0x1e, 0x99, 0xff, 0x2f, 0x75, 0xdf, 0x13,
+	0xba, 0xfb, 0xe3, 0x0e, 0x5d, 0x52, 0xf8, 0x89, 0x01, 0xcb, 0x93, 0x71, 0x3a, 0xba, 0x57, 0xb2,
+	0x65, 0x72, 0x1f, 0xd1, 0x7b, 0xe9, 0x2c, 0xb6, 0xf3, 0x98, 0x15, 0x15, 0x65, 0xd1, 0xc7, 0x86,
+	0xcc, 0x77, 0xc5, 0xa2, 0x8f, 0xf2, 0xc1, 0x3c, 0x11, 0xec, 0xf7, 0xee, 0xcc, 0xe0, 0xd0, 0x76,
+	0xbc, 0x22, 0xed, 0xb8, 0x2b, 0xec, 0x58, 0x1b, 0xb3, 0xe3, 0xb8, 0xa8, 0x90, 0xc3, 0x62, 0x6e,
+	0xa3, 0x99, 0x39, 0x77, 0x6d, 0x82, 0xe6, 0x42, 0x9e, 0xd9, 0x90, 0x7a, 0x4d, 0xa1, 0xf7, 0xf6,
+	0x54, 0xbd, 0x52, 0xc5, 0x31, 0x2c, 0x14, 0x1b, 0x12, 0x74, 0xbb, 0xe8, 0x85, 0xa5, 0x46, 0x65,
+	0xa6, 0x27, 0xce, 0x3c, 0xae, 0x53, 0x54, 0xf3, 0x2b, 0x03, 0xae, 0x4d, 0xa8, 0x99, 0x59, 0x6e,
+	0x9b, 0x5e, 0x88, 0x7b, 0xeb, 0x33, 0x79, 0xce, 0xe3, 0x03, 0xcf, 0xa5, 0x60, 0x2a, 0xf4, 0xe4,
+	0x1b, 0x7f, 0xfd, 0x72, 0xd5, 0xf8, 0xdb, 0x97, 0xab, 0xc6, 0x3f, 0xbf, 0x5c, 0x35, 0x7e, 0xf4,
+	0xf2, 0xd0, 0xe3, 0x87, 0xa3, 0xc1, 0x7d, 0x87, 0x06, 0x9b, 0x16, 0x8d, 0x09, 0xe7, 0xf8, 0x2d,
+	0x9f, 0x1e, 0x6f, 0x6e, 0xab, 0x5d, 0xbe, 0xfa, 0x94, 0x6e, 0xea, 0xff, 0x88, 0x19, 0xd4, 0xe4,
+	0x7f, 0xb9, 0x7c, 0xfd, 0xbf, 0x03, 0x00, 0xa4, 0x42, 0x0d, 0x11, 0x47, 0x23, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// TaskServiceClient is the client API for TaskService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type TaskServiceClient interface {
+	// 查看全部任务详情列表
+	GetTaskDetailList(ctx context.Context, in *EmptyGetParams, opts ...grpc.CallOption) (*GetTaskDetailListResponse, error)
+	// 查看某个任务的全部事件列表
+	GetTaskEventList(ctx context.Context, in *GetTaskEventListRequest, opts ...grpc.CallOption) (*GetTaskEventListResponse, error)
+	// 查看某个任务的全部事件列表
+	GetTaskEventListByTaskIds(ctx context.Context, in *GetTaskEventListByTaskIdsRequest, opts ...grpc.CallOption) (*GetTaskEventListResponse, error)
+	// 订阅任务事件 (服务端流, grpc-gateway 以 Server-Sent Events 提供: GET /carrier/v1/task/subscribeTaskEvents)
+	SubscribeTaskEvents(ctx context.Context, in *SubscribeTaskEventsRequest, opts ...grpc.CallOption) (TaskService_SubscribeTaskEventsClient, error)
+	// 发布任务
+	PublishTaskDeclare(ctx context.Context, in *PublishTaskDeclareRequest, opts ...grpc.CallOption) (*PublishTaskDeclareResponse, error)
+	// 查看某个任务在各参与方上实际消耗的资源
+	GetTaskResourceUsage(ctx context.Context, in *GetTaskResourceUsageRequest, opts ...grpc.CallOption) (*GetTaskResourceUsageResponse, error)
+	// 查看某个执行中的任务在各参与方上的执行进度
+	GetTaskProgress(ctx context.Context, in *GetTaskProgressRequest, opts ...grpc.CallOption) (*GetTaskProgressResponse, error)
+	// 取消任务 (等待调度中, 共识中 或 执行中的任务)
+	CancelTask(ctx context.Context, in *CancelTaskRequest, opts ...grpc.CallOption) (*SimpleResponseCode, error)
+	// 调度演练: 对任务声明执行算力组织选举和本地计算节点选择 (任务不入队), 并解释每个候选者被接受或被拒绝的原因
+	ExplainSchedule(ctx context.Context, in *PublishTaskDeclareRequest, opts ...grpc.CallOption) (*ExplainScheduleResponse, error)
+	// 发布工作流 (由多个存在依赖关系的任务组成的 DAG)
+	PublishWorkflowDeclare(ctx context.Context, in *PublishWorkflowDeclareRequest, opts ...grpc.CallOption) (*PublishWorkflowDeclareResponse, error)
+	// 查看某个工作流的详情 (包含各任务的状态和工作流事件)
+	GetWorkflowDetail(ctx context.Context, in *GetWorkflowDetailRequest, opts ...grpc.CallOption) (*GetWorkflowDetailResponse, error)
+	// 查看全部工作流列表
+	GetWorkflowList(ctx context.Context, in *EmptyGetParams, opts ...grpc.CallOption) (*GetWorkflowListResponse, error)
+	// 取消工作流 (未发布的任务不再发布, 执行中的任务被取消)
+	CancelWorkflow(ctx context.Context, in *CancelWorkflowRequest, opts ...grpc.CallOption) (*SimpleResponseCode, error)
+	// 校验本地账本中记录的任务共识过程, 并证明任务是否已被全部参与方同意 (仅 chaincons 共识引擎可用)
+	VerifyTaskAgreement(ctx context.Context, in *VerifyTaskAgreementRequest, opts ...grpc.CallOption) (*VerifyTaskAgreementResponse, error)
+}
+
+type taskServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewTaskServiceClient(cc *grpc.ClientConn) TaskServiceClient {
+	return &taskServiceClient{cc}
+}
+
+func (c *taskServiceClient) GetTaskDetailList(ctx context.Context, in *EmptyGetParams, opts ...grpc.CallOption) (*GetTaskDetailListResponse, error) {
+	out := new(GetTaskDetailListResponse)
+	err := c.cc.Invoke(ctx, "/rpcapi.TaskService/GetTaskDetailList", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *GetTaskDetailListResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *taskServiceClient) GetTaskEventList(ctx context.Context, in *GetTaskEventListRequest, opts ...grpc.CallOption) (*GetTaskEventListResponse, error) {
+	out := new(GetTaskEventListResponse)
+	err := c.cc.Invoke(ctx, "/rpcapi.TaskService/GetTaskEventList", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Status != 0 {
-		n += 1 + sovTaskRpcApi(uint64(m.Status))
+	return out, nil
+}
+
+func (c *taskServiceClient) GetTaskEventListByTaskIds(ctx context.Context, in *GetTaskEventListByTaskIdsRequest, opts ...grpc.CallOption) (*GetTaskEventListResponse, error) {
+	out := new(GetTaskEventListResponse)
+	err := c.cc.Invoke(ctx, "/rpcapi.TaskService/GetTaskEventListByTaskIds", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Msg)
-	if l > 0 {
-		n += 1 + l + sovTaskRpcApi(uint64(l))
+	return out, nil
+}
+
+func (c *taskServiceClient) SubscribeTaskEvents(ctx context.Context, in *SubscribeTaskEventsRequest, opts ...grpc.CallOption) (TaskService_SubscribeTaskEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_TaskService_serviceDesc.Streams[0], "/rpcapi.TaskService/SubscribeTaskEvents", opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.TaskList) > 0 {
-		for _, e := range m.TaskList {
-			l = e.Size()
-			n += 1 + l + sovTaskRpcApi(uint64(l))
-		}
+	x := &taskServiceSubscribeTaskEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
 	}
-	return n
+	return x, nil
 }
 
-func (m *GetTaskEventListRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.TaskId)
-	if l > 0 {
-		n += 1 + l + sovTaskRpcApi(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+type TaskService_SubscribeTaskEventsClient interface {
+	Recv() (*TaskEventShow, error)
+	grpc.ClientStream
+}
+
+type taskServiceSubscribeTaskEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *taskServiceSubscribeTaskEventsClient) Recv() (*TaskEventShow, error) {
+	m := new(TaskEventShow)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
 	}
-	return n
+	return m, nil
 }
 
-func (m *GetTaskEventListByTaskIdsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *taskServiceClient) PublishTaskDeclare(ctx context.Context, in *PublishTaskDeclareRequest, opts ...grpc.CallOption) (*PublishTaskDeclareResponse, error) {
+	out := new(PublishTaskDeclareResponse)
+	err := c.cc.Invoke(ctx, "/rpcapi.TaskService/PublishTaskDeclare", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.TaskIds) > 0 {
-		for _, s := range m.TaskIds {
-			l = len(s)
-			n += 1 + l + sovTaskRpcApi(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *taskServiceClient) GetTaskResourceUsage(ctx context.Context, in *GetTaskResourceUsageRequest, opts ...grpc.CallOption) (*GetTaskResourceUsageResponse, error) {
+	out := new(GetTaskResourceUsageResponse)
+	err := c.cc.Invoke(ctx, "/rpcapi.TaskService/GetTaskResourceUsage", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return out, nil
+}
+
+func (c *taskServiceClient) GetTaskProgress(ctx context.Context, in *GetTaskProgressRequest, opts ...grpc.CallOption) (*GetTaskProgressResponse, error) {
+	out := new(GetTaskProgressResponse)
+	err := c.cc.Invoke(ctx, "/rpcapi.TaskService/GetTaskProgress", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *GetTaskEventListResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *taskServiceClient) CancelTask(ctx context.Context, in *CancelTaskRequest, opts ...grpc.CallOption) (*SimpleResponseCode, error) {
+	out := new(SimpleResponseCode)
+	err := c.cc.Invoke(ctx, "/rpcapi.TaskService/CancelTask", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Status != 0 {
-		n += 1 + sovTaskRpcApi(uint64(m.Status))
+	return out, nil
+}
+
+func (c *taskServiceClient) ExplainSchedule(ctx context.Context, in *PublishTaskDeclareRequest, opts ...grpc.CallOption) (*ExplainScheduleResponse, error) {
+	out := new(ExplainScheduleResponse)
+	err := c.cc.Invoke(ctx, "/rpcapi.TaskService/ExplainSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Msg)
-	if l > 0 {
-		n += 1 + l + sovTaskRpcApi(uint64(l))
+	return out, nil
+}
+
+func (c *taskServiceClient) PublishWorkflowDeclare(ctx context.Context, in *PublishWorkflowDeclareRequest, opts ...grpc.CallOption) (*PublishWorkflowDeclareResponse, error) {
+	out := new(PublishWorkflowDeclareResponse)
+	err := c.cc.Invoke(ctx, "/rpcapi.TaskService/PublishWorkflowDeclare", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.TaskEventList) > 0 {
-		for _, e := range m.TaskEventList {
-			l = e.Size()
-			n += 1 + l + sovTaskRpcApi(uint64(l))
-		}
+	return out, nil
+}
+
+func (c *taskServiceClient) GetWorkflowDetail(ctx context.Context, in *GetWorkflowDetailRequest, opts ...grpc.CallOption) (*GetWorkflowDetailResponse, error) {
+	out := new(GetWorkflowDetailResponse)
+	err := c.cc.Invoke(ctx, "/rpcapi.TaskService/GetWorkflowDetail", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	return out, nil
+}
+
+func (c *taskServiceClient) GetWorkflowList(ctx context.Context, in *EmptyGetParams, opts ...grpc.CallOption) (*GetWorkflowListResponse, error) {
+	out := new(GetWorkflowListResponse)
+	err := c.cc.Invoke(ctx, "/rpcapi.TaskService/GetWorkflowList", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *PublishTaskDeclareRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *taskServiceClient) CancelWorkflow(ctx context.Context, in *CancelWorkflowRequest, opts ...grpc.CallOption) (*SimpleResponseCode, error) {
+	out := new(SimpleResponseCode)
+	err := c.cc.Invoke(ctx, "/rpcapi.TaskService/CancelWorkflow", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.TaskName)
-	if l > 0 {
-		n += 1 + l + sovTaskRpcApi(uint64(l))
+	return out, nil
+}
+
+func (c *taskServiceClient) VerifyTaskAgreement(ctx context.Context, in *VerifyTaskAgreementRequest, opts ...grpc.CallOption) (*VerifyTaskAgreementResponse, error) {
+	out := new(VerifyTaskAgreementResponse)
+	err := c.cc.Invoke(ctx, "/rpcapi.TaskService/VerifyTaskAgreement", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if m.Owner != nil {
-		l = m.Owner.Size()
-		n += 1 + l + sovTaskRpcApi(uint64(l))
+	return out, nil
+}
+
+// TaskServiceServer is the server API for TaskService service.
+type TaskServiceServer interface {
+	// 查看全部任务详情列表
+	GetTaskDetailList(context.Context, *EmptyGetParams) (*GetTaskDetailListResponse, error)
+	// 查看某个任务的全部事件列表
+	GetTaskEventList(context.Context, *GetTaskEventListRequest) (*GetTaskEventListResponse, error)
+	// 查看某个任务的全部事件列表
+	GetTaskEventListByTaskIds(context.Context, *GetTaskEventListByTaskIdsRequest) (*GetTaskEventListResponse, error)
+	// 订阅任务事件 (服务端流, grpc-gateway 以 Server-Sent Events 提供: GET /carrier/v1/task/subscribeTaskEvents)
+	SubscribeTaskEvents(*SubscribeTaskEventsRequest, TaskService_SubscribeTaskEventsServer) error
+	// 发布任务
+	PublishTaskDeclare(context.Context, *PublishTaskDeclareRequest) (*PublishTaskDeclareResponse, error)
+	// 查看某个任务在各参与方上实际消耗的资源
+	GetTaskResourceUsage(context.Context, *GetTaskResourceUsageRequest) (*GetTaskResourceUsageResponse, error)
+	// 查看某个执行中的任务在各参与方上的执行进度
+	GetTaskProgress(context.Context, *GetTaskProgressRequest) (*GetTaskProgressResponse, error)
+	// 取消任务 (等待调度中, 共识中 或 执行中的任务)
+	CancelTask(context.Context, *CancelTaskRequest) (*SimpleResponseCode, error)
+	// 调度演练: 对任务声明执行算力组织选举和本地计算节点选择 (任务不入队), 并解释每个候选者被接受或被拒绝的原因
+	ExplainSchedule(context.Context, *PublishTaskDeclareRequest) (*ExplainScheduleResponse, error)
+	// 发布工作流 (由多个存在依赖关系的任务组成的 DAG)
+	PublishWorkflowDeclare(context.Context, *PublishWorkflowDeclareRequest) (*PublishWorkflowDeclareResponse, error)
+	// 查看某个工作流的详情 (包含各任务的状态和工作流事件)
+	GetWorkflowDetail(context.Context, *GetWorkflowDetailRequest) (*GetWorkflowDetailResponse, error)
+	// 查看全部工作流列表
+	GetWorkflowList(context.Context, *EmptyGetParams) (*GetWorkflowListResponse, error)
+	// 取消工作流 (未发布的任务不再发布, 执行中的任务被取消)
+	CancelWorkflow(context.Context, *CancelWorkflowRequest) (*SimpleResponseCode, error)
+	// 校验本地账本中记录的任务共识过程, 并证明任务是否已被全部参与方同意 (仅 chaincons 共识引擎可用)
+	VerifyTaskAgreement(context.Context, *VerifyTaskAgreementRequest) (*VerifyTaskAgreementResponse, error)
+}
+
+// UnimplementedTaskServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedTaskServiceServer struct {
+}
+
+func (*UnimplementedTaskServiceServer) GetTaskDetailList(ctx context.Context, req *EmptyGetParams) (*GetTaskDetailListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTaskDetailList not implemented")
+}
+func (*UnimplementedTaskServiceServer) GetTaskEventList(ctx context.Context, req *GetTaskEventListRequest) (*GetTaskEventListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTaskEventList not implemented")
+}
+func (*UnimplementedTaskServiceServer) GetTaskEventListByTaskIds(ctx context.Context, req *GetTaskEventListByTaskIdsRequest) (*GetTaskEventListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTaskEventListByTaskIds not implemented")
+}
+func (*UnimplementedTaskServiceServer) SubscribeTaskEvents(req *SubscribeTaskEventsRequest, srv TaskService_SubscribeTaskEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeTaskEvents not implemented")
+}
+func (*UnimplementedTaskServiceServer) PublishTaskDeclare(ctx context.Context, req *PublishTaskDeclareRequest) (*PublishTaskDeclareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishTaskDeclare not implemented")
+}
+func (*UnimplementedTaskServiceServer) GetTaskResourceUsage(ctx context.Context, req *GetTaskResourceUsageRequest) (*GetTaskResourceUsageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTaskResourceUsage not implemented")
+}
+func (*UnimplementedTaskServiceServer) GetTaskProgress(ctx context.Context, req *GetTaskProgressRequest) (*GetTaskProgressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTaskProgress not implemented")
+}
+func (*UnimplementedTaskServiceServer) CancelTask(ctx context.Context, req *CancelTaskRequest) (*SimpleResponseCode, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelTask not implemented")
+}
+func (*UnimplementedTaskServiceServer) ExplainSchedule(ctx context.Context, req *PublishTaskDeclareRequest) (*ExplainScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExplainSchedule not implemented")
+}
+func (*UnimplementedTaskServiceServer) PublishWorkflowDeclare(ctx context.Context, req *PublishWorkflowDeclareRequest) (*PublishWorkflowDeclareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishWorkflowDeclare not implemented")
+}
+func (*UnimplementedTaskServiceServer) GetWorkflowDetail(ctx context.Context, req *GetWorkflowDetailRequest) (*GetWorkflowDetailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkflowDetail not implemented")
+}
+func (*UnimplementedTaskServiceServer) GetWorkflowList(ctx context.Context, req *EmptyGetParams) (*GetWorkflowListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWorkflowList not implemented")
+}
+func (*UnimplementedTaskServiceServer) CancelWorkflow(ctx context.Context, req *CancelWorkflowRequest) (*SimpleResponseCode, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelWorkflow not implemented")
+}
+func (*UnimplementedTaskServiceServer) VerifyTaskAgreement(ctx context.Context, req *VerifyTaskAgreementRequest) (*VerifyTaskAgreementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyTaskAgreement not implemented")
+}
+
+func RegisterTaskServiceServer(s *grpc.Server, srv TaskServiceServer) {
+	s.RegisterService(&_TaskService_serviceDesc, srv)
+}
+
+func _TaskService_GetTaskDetailList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyGetParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).GetTaskDetailList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcapi.TaskService/GetTaskDetailList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).GetTaskDetailList(ctx, req.(*EmptyGetParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_GetTaskEventList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaskEventListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).GetTaskEventList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcapi.TaskService/GetTaskEventList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).GetTaskEventList(ctx, req.(*GetTaskEventListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_GetTaskEventListByTaskIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaskEventListByTaskIdsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).GetTaskEventListByTaskIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcapi.TaskService/GetTaskEventListByTaskIds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).GetTaskEventListByTaskIds(ctx, req.(*GetTaskEventListByTaskIdsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_SubscribeTaskEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeTaskEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(TaskServiceServer).SubscribeTaskEvents(m, &taskServiceSubscribeTaskEventsServer{stream})
+}
+
+type TaskService_SubscribeTaskEventsServer interface {
+	Send(*TaskEventShow) error
+	grpc.ServerStream
+}
+
+type taskServiceSubscribeTaskEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *taskServiceSubscribeTaskEventsServer) Send(m *TaskEventShow) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _TaskService_PublishTaskDeclare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishTaskDeclareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).PublishTaskDeclare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcapi.TaskService/PublishTaskDeclare",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).PublishTaskDeclare(ctx, req.(*PublishTaskDeclareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_GetTaskResourceUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaskResourceUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).GetTaskResourceUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcapi.TaskService/GetTaskResourceUsage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).GetTaskResourceUsage(ctx, req.(*GetTaskResourceUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_GetTaskProgress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTaskProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).GetTaskProgress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcapi.TaskService/GetTaskProgress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).GetTaskProgress(ctx, req.(*GetTaskProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_CancelTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).CancelTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcapi.TaskService/CancelTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).CancelTask(ctx, req.(*CancelTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_ExplainSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishTaskDeclareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).ExplainSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcapi.TaskService/ExplainSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).ExplainSchedule(ctx, req.(*PublishTaskDeclareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_PublishWorkflowDeclare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishWorkflowDeclareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).PublishWorkflowDeclare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcapi.TaskService/PublishWorkflowDeclare",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).PublishWorkflowDeclare(ctx, req.(*PublishWorkflowDeclareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_GetWorkflowDetail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWorkflowDetailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).GetWorkflowDetail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcapi.TaskService/GetWorkflowDetail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).GetWorkflowDetail(ctx, req.(*GetWorkflowDetailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_GetWorkflowList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EmptyGetParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).GetWorkflowList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcapi.TaskService/GetWorkflowList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).GetWorkflowList(ctx, req.(*EmptyGetParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_CancelWorkflow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelWorkflowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).CancelWorkflow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcapi.TaskService/CancelWorkflow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).CancelWorkflow(ctx, req.(*CancelWorkflowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TaskService_VerifyTaskAgreement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyTaskAgreementRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TaskServiceServer).VerifyTaskAgreement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/rpcapi.TaskService/VerifyTaskAgreement",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TaskServiceServer).VerifyTaskAgreement(ctx, req.(*VerifyTaskAgreementRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _TaskService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "rpcapi.TaskService",
+	HandlerType: (*TaskServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetTaskDetailList",
+			Handler:    _TaskService_GetTaskDetailList_Handler,
+		},
+		{
+			MethodName: "GetTaskEventList",
+			Handler:    _TaskService_GetTaskEventList_Handler,
+		},
+		{
+			MethodName: "GetTaskEventListByTaskIds",
+			Handler:    _TaskService_GetTaskEventListByTaskIds_Handler,
+		},
+		{
+			MethodName: "PublishTaskDeclare",
+			Handler:    _TaskService_PublishTaskDeclare_Handler,
+		},
+		{
+			MethodName: "GetTaskResourceUsage",
+			Handler:    _TaskService_GetTaskResourceUsage_Handler,
+		},
+		{
+			MethodName: "GetTaskProgress",
+			Handler:    _TaskService_GetTaskProgress_Handler,
+		},
+		{
+			MethodName: "CancelTask",
+			Handler:    _TaskService_CancelTask_Handler,
+		},
+		{
+			MethodName: "ExplainSchedule",
+			Handler:    _TaskService_ExplainSchedule_Handler,
+		},
+		{
+			MethodName: "PublishWorkflowDeclare",
+			Handler:    _TaskService_PublishWorkflowDeclare_Handler,
+		},
+		{
+			MethodName: "GetWorkflowDetail",
+			Handler:    _TaskService_GetWorkflowDetail_Handler,
+		},
+		{
+			MethodName: "GetWorkflowList",
+			Handler:    _TaskService_GetWorkflowList_Handler,
+		},
+		{
+			MethodName: "CancelWorkflow",
+			Handler:    _TaskService_CancelWorkflow_Handler,
+		},
+		{
+			MethodName: "VerifyTaskAgreement",
+			Handler:    _TaskService_VerifyTaskAgreement_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribeTaskEvents",
+			Handler:       _TaskService_SubscribeTaskEvents_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "lib/api/task_rpc_api.proto",
+}
+
+func (m *TaskDetailShow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskDetailShow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskDetailShow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.FinishDeadline != 0 {
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(m.FinishDeadline))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.StartDeadline != 0 {
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(m.StartDeadline))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.Priority != 0 {
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.OriginTaskId) > 0 {
+		i -= len(m.OriginTaskId)
+		copy(dAtA[i:], m.OriginTaskId)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.OriginTaskId)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.MaxAttempts != 0 {
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(m.MaxAttempts))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.Attempt != 0 {
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(m.Attempt))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.OperationCost != nil {
+		{
+			size, err := m.OperationCost.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTaskRpcApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	if len(m.State) > 0 {
+		i -= len(m.State)
+		copy(dAtA[i:], m.State)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.State)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.EndAt != 0 {
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(m.EndAt))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.StartAt != 0 {
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(m.StartAt))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.CreateAt != 0 {
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(m.CreateAt))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Receivers) > 0 {
+		for iNdEx := len(m.Receivers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Receivers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTaskRpcApi(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.PowerSupplier) > 0 {
+		for iNdEx := len(m.PowerSupplier) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PowerSupplier[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTaskRpcApi(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.DataSupplier) > 0 {
+		for iNdEx := len(m.DataSupplier) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DataSupplier[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTaskRpcApi(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.AlgoSupplier != nil {
+		{
+			size, err := m.AlgoSupplier.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTaskRpcApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Owner != nil {
+		{
+			size, err := m.Owner.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTaskRpcApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TaskName) > 0 {
+		i -= len(m.TaskName)
+		copy(dAtA[i:], m.TaskName)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.TaskName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaskDataSupplierShow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskDataSupplierShow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskDataSupplierShow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.MetaDataName) > 0 {
+		i -= len(m.MetaDataName)
+		copy(dAtA[i:], m.MetaDataName)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.MetaDataName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MetaDataId) > 0 {
+		i -= len(m.MetaDataId)
+		copy(dAtA[i:], m.MetaDataId)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.MetaDataId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MemberInfo != nil {
+		{
+			size, err := m.MemberInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTaskRpcApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaskPowerSupplierShow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskPowerSupplierShow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskPowerSupplierShow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.PowerInfo != nil {
+		{
+			size, err := m.PowerInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTaskRpcApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MemberInfo != nil {
+		{
+			size, err := m.MemberInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTaskRpcApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaskEventShow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskEventShow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskEventShow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Cursor) > 0 {
+		i -= len(m.Cursor)
+		copy(dAtA[i:], m.Cursor)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.Cursor)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.CreateAt != 0 {
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(m.CreateAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Content) > 0 {
+		i -= len(m.Content)
+		copy(dAtA[i:], m.Content)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.Content)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Owner != nil {
+		{
+			size, err := m.Owner.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTaskRpcApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaskEventDeclare) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskEventDeclare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskEventDeclare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.CreateAt != 0 {
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(m.CreateAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Content) > 0 {
+		i -= len(m.Content)
+		copy(dAtA[i:], m.Content)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.Content)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.IdentityId) > 0 {
+		i -= len(m.IdentityId)
+		copy(dAtA[i:], m.IdentityId)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.IdentityId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaskDataSupplierDeclare) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskDataSupplierDeclare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskDataSupplierDeclare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.MetaDataInfo != nil {
+		{
+			size, err := m.MetaDataInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTaskRpcApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MemberInfo != nil {
+		{
+			size, err := m.MemberInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTaskRpcApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaskMetaDataDeclare) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskMetaDataDeclare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskMetaDataDeclare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ColumnIndexList) > 0 {
+		dAtA11 := make([]byte, len(m.ColumnIndexList)*10)
+		var j10 int
+		for _, num := range m.ColumnIndexList {
+			for num >= 1<<7 {
+				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j10++
+			}
+			dAtA11[j10] = uint8(num)
+			j10++
+		}
+		i -= j10
+		copy(dAtA[i:], dAtA11[:j10])
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(j10))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MetaDataId) > 0 {
+		i -= len(m.MetaDataId)
+		copy(dAtA[i:], m.MetaDataId)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.MetaDataId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaskResultReceiverDeclare) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskResultReceiverDeclare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskResultReceiverDeclare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Providers) > 0 {
+		for iNdEx := len(m.Providers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Providers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTaskRpcApi(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.MemberInfo != nil {
+		{
+			size, err := m.MemberInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTaskRpcApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaskOperationCostDeclare) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskOperationCostDeclare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskOperationCostDeclare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Duration != 0 {
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(m.Duration))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.CostBandwidth != 0 {
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(m.CostBandwidth))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CostProcessor != 0 {
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(m.CostProcessor))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.CostMem != 0 {
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(m.CostMem))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTaskDetailResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTaskDetailResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTaskDetailResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Information != nil {
+		{
+			size, err := m.Information.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTaskRpcApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTaskDetailListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTaskDetailListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTaskDetailListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.TaskList) > 0 {
+		for iNdEx := len(m.TaskList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TaskList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTaskRpcApi(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTaskEventListRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTaskEventListRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTaskEventListRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTaskEventListByTaskIdsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTaskEventListByTaskIdsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTaskEventListByTaskIdsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.TaskIds) > 0 {
+		for iNdEx := len(m.TaskIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TaskIds[iNdEx])
+			copy(dAtA[i:], m.TaskIds[iNdEx])
+			i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.TaskIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubscribeTaskEventsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubscribeTaskEventsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubscribeTaskEventsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Cursor) > 0 {
+		i -= len(m.Cursor)
+		copy(dAtA[i:], m.Cursor)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.Cursor)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Since != 0 {
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(m.Since))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.IdentityIds) > 0 {
+		for iNdEx := len(m.IdentityIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.IdentityIds[iNdEx])
+			copy(dAtA[i:], m.IdentityIds[iNdEx])
+			i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.IdentityIds[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.TypePrefixes) > 0 {
+		for iNdEx := len(m.TypePrefixes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TypePrefixes[iNdEx])
+			copy(dAtA[i:], m.TypePrefixes[iNdEx])
+			i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.TypePrefixes[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.TaskIds) > 0 {
+		for iNdEx := len(m.TaskIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TaskIds[iNdEx])
+			copy(dAtA[i:], m.TaskIds[iNdEx])
+			i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.TaskIds[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTaskEventListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTaskEventListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTaskEventListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.TaskEventList) > 0 {
+		for iNdEx := len(m.TaskEventList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TaskEventList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTaskRpcApi(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PublishTaskDeclareRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PublishTaskDeclareRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PublishTaskDeclareRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.FinishDeadline != 0 {
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(m.FinishDeadline))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.StartDeadline != 0 {
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(m.StartDeadline))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Priority != 0 {
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.RetryPolicy != nil {
+		{
+			size, err := m.RetryPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTaskRpcApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.ContractExtraParams) > 0 {
+		i -= len(m.ContractExtraParams)
+		copy(dAtA[i:], m.ContractExtraParams)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.ContractExtraParams)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.DatasplitContractcode) > 0 {
+		i -= len(m.DatasplitContractcode)
+		copy(dAtA[i:], m.DatasplitContractcode)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.DatasplitContractcode)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.CalculateContractcode) > 0 {
+		i -= len(m.CalculateContractcode)
+		copy(dAtA[i:], m.CalculateContractcode)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.CalculateContractcode)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.OperationCost != nil {
+		{
+			size, err := m.OperationCost.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTaskRpcApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Receivers) > 0 {
+		for iNdEx := len(m.Receivers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Receivers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTaskRpcApi(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.PowerPartyIds) > 0 {
+		for iNdEx := len(m.PowerPartyIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.PowerPartyIds[iNdEx])
+			copy(dAtA[i:], m.PowerPartyIds[iNdEx])
+			i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.PowerPartyIds[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.DataSupplier) > 0 {
+		for iNdEx := len(m.DataSupplier) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DataSupplier[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTaskRpcApi(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Owner != nil {
+		{
+			size, err := m.Owner.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTaskRpcApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TaskName) > 0 {
+		i -= len(m.TaskName)
+		copy(dAtA[i:], m.TaskName)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.TaskName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaskRetryPolicyDeclare) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskRetryPolicyDeclare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskRetryPolicyDeclare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ReelectPower {
+		i--
+		if m.ReelectPower {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Backoff != 0 {
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(m.Backoff))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxAttempts != 0 {
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(m.MaxAttempts))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PublishTaskDeclareResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PublishTaskDeclareResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PublishTaskDeclareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CancelTaskRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancelTaskRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancelTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTaskResourceUsageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTaskResourceUsageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTaskResourceUsageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaskResourceUsageShow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskResourceUsageShow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskResourceUsageShow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ExceededItems) > 0 {
+		for iNdEx := len(m.ExceededItems) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ExceededItems[iNdEx])
+			copy(dAtA[i:], m.ExceededItems[iNdEx])
+			i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.ExceededItems[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.UpdateAt != 0 {
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(m.UpdateAt))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.UsedDuration != 0 {
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(m.UsedDuration))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.UsedBandwidth != 0 {
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(m.UsedBandwidth))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.UsedProcessor != 0 {
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(m.UsedProcessor))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.UsedMem != 0 {
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(m.UsedMem))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.JobNodeId) > 0 {
+		i -= len(m.JobNodeId)
+		copy(dAtA[i:], m.JobNodeId)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.JobNodeId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.IdentityId) > 0 {
+		i -= len(m.IdentityId)
+		copy(dAtA[i:], m.IdentityId)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.IdentityId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PartyId) > 0 {
+		i -= len(m.PartyId)
+		copy(dAtA[i:], m.PartyId)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.PartyId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTaskResourceUsageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTaskResourceUsageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTaskResourceUsageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.UsageList) > 0 {
+		for iNdEx := len(m.UsageList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UsageList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTaskRpcApi(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Declared != nil {
+		{
+			size, err := m.Declared.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTaskRpcApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTaskProgressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTaskProgressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTaskProgressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaskProgressShow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskProgressShow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskProgressShow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.UpdateAt != 0 {
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(m.UpdateAt))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.RemainTime != 0 {
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(m.RemainTime))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ElapsedTime != 0 {
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(m.ElapsedTime))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Percent != 0 {
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(m.Percent))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Phase) > 0 {
+		i -= len(m.Phase)
+		copy(dAtA[i:], m.Phase)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.Phase)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.JobNodeId) > 0 {
+		i -= len(m.JobNodeId)
+		copy(dAtA[i:], m.JobNodeId)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.JobNodeId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.IdentityId) > 0 {
+		i -= len(m.IdentityId)
+		copy(dAtA[i:], m.IdentityId)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.IdentityId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PartyId) > 0 {
+		i -= len(m.PartyId)
+		copy(dAtA[i:], m.PartyId)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.PartyId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetTaskProgressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetTaskProgressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetTaskProgressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ProgressList) > 0 {
+		for iNdEx := len(m.ProgressList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProgressList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTaskRpcApi(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowTaskDeclare) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowTaskDeclare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowTaskDeclare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Bindings) > 0 {
+		for iNdEx := len(m.Bindings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bindings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTaskRpcApi(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Depends) > 0 {
+		for iNdEx := len(m.Depends) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Depends[iNdEx])
+			copy(dAtA[i:], m.Depends[iNdEx])
+			i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.Depends[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Task != nil {
+		{
+			size, err := m.Task.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTaskRpcApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowResultBindingDeclare) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowResultBindingDeclare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowResultBindingDeclare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ParamKey) > 0 {
+		i -= len(m.ParamKey)
+		copy(dAtA[i:], m.ParamKey)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.ParamKey)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.IdentityId) > 0 {
+		i -= len(m.IdentityId)
+		copy(dAtA[i:], m.IdentityId)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.IdentityId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromTask) > 0 {
+		i -= len(m.FromTask)
+		copy(dAtA[i:], m.FromTask)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.FromTask)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PublishWorkflowDeclareRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PublishWorkflowDeclareRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PublishWorkflowDeclareRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.TaskList) > 0 {
+		for iNdEx := len(m.TaskList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TaskList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTaskRpcApi(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.WorkflowName) > 0 {
+		i -= len(m.WorkflowName)
+		copy(dAtA[i:], m.WorkflowName)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.WorkflowName)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PublishWorkflowDeclareResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PublishWorkflowDeclareResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PublishWorkflowDeclareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.WorkflowId) > 0 {
+		i -= len(m.WorkflowId)
+		copy(dAtA[i:], m.WorkflowId)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.WorkflowId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetWorkflowDetailRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetWorkflowDetailRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetWorkflowDetailRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.WorkflowId) > 0 {
+		i -= len(m.WorkflowId)
+		copy(dAtA[i:], m.WorkflowId)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.WorkflowId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CancelWorkflowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CancelWorkflowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CancelWorkflowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.WorkflowId) > 0 {
+		i -= len(m.WorkflowId)
+		copy(dAtA[i:], m.WorkflowId)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.WorkflowId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowTaskShow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowTaskShow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowTaskShow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.EndAt != 0 {
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(m.EndAt))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.PublishAt != 0 {
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(m.PublishAt))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Depends) > 0 {
+		for iNdEx := len(m.Depends) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Depends[iNdEx])
+			copy(dAtA[i:], m.Depends[iNdEx])
+			i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.Depends[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.State) > 0 {
+		i -= len(m.State)
+		copy(dAtA[i:], m.State)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.State)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowEventShow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowEventShow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowEventShow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.CreateAt != 0 {
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(m.CreateAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Content) > 0 {
+		i -= len(m.Content)
+		copy(dAtA[i:], m.Content)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.Content)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TaskName) > 0 {
+		i -= len(m.TaskName)
+		copy(dAtA[i:], m.TaskName)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.TaskName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WorkflowDetailShow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WorkflowDetailShow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WorkflowDetailShow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.EventList) > 0 {
+		for iNdEx := len(m.EventList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EventList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTaskRpcApi(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.TaskList) > 0 {
+		for iNdEx := len(m.TaskList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TaskList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTaskRpcApi(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.EndAt != 0 {
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(m.EndAt))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.CreateAt != 0 {
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(m.CreateAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.State) > 0 {
+		i -= len(m.State)
+		copy(dAtA[i:], m.State)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.State)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.WorkflowName) > 0 {
+		i -= len(m.WorkflowName)
+		copy(dAtA[i:], m.WorkflowName)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.WorkflowName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.WorkflowId) > 0 {
+		i -= len(m.WorkflowId)
+		copy(dAtA[i:], m.WorkflowId)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.WorkflowId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetWorkflowDetailResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetWorkflowDetailResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetWorkflowDetailResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Workflow != nil {
+		{
+			size, err := m.Workflow.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTaskRpcApi(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GetWorkflowListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GetWorkflowListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GetWorkflowListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.WorkflowList) > 0 {
+		for iNdEx := len(m.WorkflowList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.WorkflowList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTaskRpcApi(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduleCandidateShow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleCandidateShow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduleCandidateShow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Accepted {
+		i--
+		if m.Accepted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExplainScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExplainScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExplainScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.NodeErr) > 0 {
+		i -= len(m.NodeErr)
+		copy(dAtA[i:], m.NodeErr)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.NodeErr)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.NeedSlotCount != 0 {
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(m.NeedSlotCount))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.PlacedJobNode) > 0 {
+		i -= len(m.PlacedJobNode)
+		copy(dAtA[i:], m.PlacedJobNode)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.PlacedJobNode)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.NodeCandidates) > 0 {
+		for iNdEx := len(m.NodeCandidates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NodeCandidates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTaskRpcApi(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.OrgErr) > 0 {
+		i -= len(m.OrgErr)
+		copy(dAtA[i:], m.OrgErr)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.OrgErr)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ElectedOrgs) > 0 {
+		for iNdEx := len(m.ElectedOrgs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ElectedOrgs[iNdEx])
+			copy(dAtA[i:], m.ElectedOrgs[iNdEx])
+			i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.ElectedOrgs[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.OrgCandidates) > 0 {
+		for iNdEx := len(m.OrgCandidates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OrgCandidates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTaskRpcApi(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VerifyTaskAgreementRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VerifyTaskAgreementRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VerifyTaskAgreementRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VerifyTaskAgreementResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VerifyTaskAgreementResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VerifyTaskAgreementResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.BlockHashes) > 0 {
+		for iNdEx := len(m.BlockHashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BlockHashes[iNdEx])
+			copy(dAtA[i:], m.BlockHashes[iNdEx])
+			i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.BlockHashes[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ProposalId) > 0 {
+		i -= len(m.ProposalId)
+		copy(dAtA[i:], m.ProposalId)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.ProposalId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Agreed {
+		i--
+		if m.Agreed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Status != 0 {
+		i = encodeVarintTaskRpcApi(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTaskRpcApi(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTaskRpcApi(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TaskDetailShow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	l = len(m.TaskName)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	if m.Owner != nil {
+		l = m.Owner.Size()
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	if m.AlgoSupplier != nil {
+		l = m.AlgoSupplier.Size()
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	if len(m.DataSupplier) > 0 {
+		for _, e := range m.DataSupplier {
+			l = e.Size()
+			n += 1 + l + sovTaskRpcApi(uint64(l))
+		}
+	}
+	if len(m.PowerSupplier) > 0 {
+		for _, e := range m.PowerSupplier {
+			l = e.Size()
+			n += 1 + l + sovTaskRpcApi(uint64(l))
+		}
+	}
+	if len(m.Receivers) > 0 {
+		for _, e := range m.Receivers {
+			l = e.Size()
+			n += 1 + l + sovTaskRpcApi(uint64(l))
+		}
+	}
+	if m.CreateAt != 0 {
+		n += 1 + sovTaskRpcApi(uint64(m.CreateAt))
+	}
+	if m.StartAt != 0 {
+		n += 1 + sovTaskRpcApi(uint64(m.StartAt))
+	}
+	if m.EndAt != 0 {
+		n += 1 + sovTaskRpcApi(uint64(m.EndAt))
+	}
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	if m.OperationCost != nil {
+		l = m.OperationCost.Size()
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	if m.Attempt != 0 {
+		n += 1 + sovTaskRpcApi(uint64(m.Attempt))
+	}
+	if m.MaxAttempts != 0 {
+		n += 1 + sovTaskRpcApi(uint64(m.MaxAttempts))
+	}
+	l = len(m.OriginTaskId)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	if m.Priority != 0 {
+		n += 2 + sovTaskRpcApi(uint64(m.Priority))
+	}
+	if m.StartDeadline != 0 {
+		n += 2 + sovTaskRpcApi(uint64(m.StartDeadline))
+	}
+	if m.FinishDeadline != 0 {
+		n += 2 + sovTaskRpcApi(uint64(m.FinishDeadline))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TaskDataSupplierShow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MemberInfo != nil {
+		l = m.MemberInfo.Size()
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	l = len(m.MetaDataId)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	l = len(m.MetaDataName)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TaskPowerSupplierShow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MemberInfo != nil {
+		l = m.MemberInfo.Size()
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	if m.PowerInfo != nil {
+		l = m.PowerInfo.Size()
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TaskEventShow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	if m.Owner != nil {
+		l = m.Owner.Size()
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	l = len(m.Content)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	if m.CreateAt != 0 {
+		n += 1 + sovTaskRpcApi(uint64(m.CreateAt))
+	}
+	l = len(m.Cursor)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TaskEventDeclare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	l = len(m.IdentityId)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	l = len(m.Content)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	if m.CreateAt != 0 {
+		n += 1 + sovTaskRpcApi(uint64(m.CreateAt))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TaskDataSupplierDeclare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MemberInfo != nil {
+		l = m.MemberInfo.Size()
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	if m.MetaDataInfo != nil {
+		l = m.MetaDataInfo.Size()
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TaskMetaDataDeclare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MetaDataId)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	if len(m.ColumnIndexList) > 0 {
+		l = 0
+		for _, e := range m.ColumnIndexList {
+			l += sovTaskRpcApi(uint64(e))
+		}
+		n += 1 + sovTaskRpcApi(uint64(l)) + l
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TaskResultReceiverDeclare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MemberInfo != nil {
+		l = m.MemberInfo.Size()
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	if len(m.Providers) > 0 {
+		for _, e := range m.Providers {
+			l = e.Size()
+			n += 1 + l + sovTaskRpcApi(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TaskOperationCostDeclare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CostMem != 0 {
+		n += 1 + sovTaskRpcApi(uint64(m.CostMem))
+	}
+	if m.CostProcessor != 0 {
+		n += 1 + sovTaskRpcApi(uint64(m.CostProcessor))
+	}
+	if m.CostBandwidth != 0 {
+		n += 1 + sovTaskRpcApi(uint64(m.CostBandwidth))
+	}
+	if m.Duration != 0 {
+		n += 1 + sovTaskRpcApi(uint64(m.Duration))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetTaskDetailResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Information != nil {
+		l = m.Information.Size()
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetTaskDetailListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovTaskRpcApi(uint64(m.Status))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	if len(m.TaskList) > 0 {
+		for _, e := range m.TaskList {
+			l = e.Size()
+			n += 1 + l + sovTaskRpcApi(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetTaskEventListRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetTaskEventListByTaskIdsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TaskIds) > 0 {
+		for _, s := range m.TaskIds {
+			l = len(s)
+			n += 1 + l + sovTaskRpcApi(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SubscribeTaskEventsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TaskIds) > 0 {
+		for _, s := range m.TaskIds {
+			l = len(s)
+			n += 1 + l + sovTaskRpcApi(uint64(l))
+		}
+	}
+	if len(m.TypePrefixes) > 0 {
+		for _, s := range m.TypePrefixes {
+			l = len(s)
+			n += 1 + l + sovTaskRpcApi(uint64(l))
+		}
+	}
+	if len(m.IdentityIds) > 0 {
+		for _, s := range m.IdentityIds {
+			l = len(s)
+			n += 1 + l + sovTaskRpcApi(uint64(l))
+		}
+	}
+	if m.Since != 0 {
+		n += 1 + sovTaskRpcApi(uint64(m.Since))
+	}
+	l = len(m.Cursor)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetTaskEventListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovTaskRpcApi(uint64(m.Status))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	if len(m.TaskEventList) > 0 {
+		for _, e := range m.TaskEventList {
+			l = e.Size()
+			n += 1 + l + sovTaskRpcApi(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PublishTaskDeclareRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TaskName)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	if m.Owner != nil {
+		l = m.Owner.Size()
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	if len(m.DataSupplier) > 0 {
+		for _, e := range m.DataSupplier {
+			l = e.Size()
+			n += 1 + l + sovTaskRpcApi(uint64(l))
+		}
+	}
+	if len(m.PowerPartyIds) > 0 {
+		for _, s := range m.PowerPartyIds {
+			l = len(s)
+			n += 1 + l + sovTaskRpcApi(uint64(l))
+		}
+	}
+	if len(m.Receivers) > 0 {
+		for _, e := range m.Receivers {
+			l = e.Size()
+			n += 1 + l + sovTaskRpcApi(uint64(l))
+		}
+	}
+	if m.OperationCost != nil {
+		l = m.OperationCost.Size()
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	l = len(m.CalculateContractcode)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	l = len(m.DatasplitContractcode)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	l = len(m.ContractExtraParams)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	if m.RetryPolicy != nil {
+		l = m.RetryPolicy.Size()
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	if m.Priority != 0 {
+		n += 1 + sovTaskRpcApi(uint64(m.Priority))
+	}
+	if m.StartDeadline != 0 {
+		n += 1 + sovTaskRpcApi(uint64(m.StartDeadline))
+	}
+	if m.FinishDeadline != 0 {
+		n += 1 + sovTaskRpcApi(uint64(m.FinishDeadline))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TaskRetryPolicyDeclare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxAttempts != 0 {
+		n += 1 + sovTaskRpcApi(uint64(m.MaxAttempts))
+	}
+	if m.Backoff != 0 {
+		n += 1 + sovTaskRpcApi(uint64(m.Backoff))
+	}
+	if m.ReelectPower {
+		n += 2
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PublishTaskDeclareResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovTaskRpcApi(uint64(m.Status))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CancelTaskRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetTaskResourceUsageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TaskResourceUsageShow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PartyId)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	l = len(m.IdentityId)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	l = len(m.JobNodeId)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	if m.UsedMem != 0 {
+		n += 1 + sovTaskRpcApi(uint64(m.UsedMem))
+	}
+	if m.UsedProcessor != 0 {
+		n += 1 + sovTaskRpcApi(uint64(m.UsedProcessor))
+	}
+	if m.UsedBandwidth != 0 {
+		n += 1 + sovTaskRpcApi(uint64(m.UsedBandwidth))
+	}
+	if m.UsedDuration != 0 {
+		n += 1 + sovTaskRpcApi(uint64(m.UsedDuration))
+	}
+	if m.UpdateAt != 0 {
+		n += 1 + sovTaskRpcApi(uint64(m.UpdateAt))
+	}
+	if len(m.ExceededItems) > 0 {
+		for _, s := range m.ExceededItems {
+			l = len(s)
+			n += 1 + l + sovTaskRpcApi(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetTaskResourceUsageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovTaskRpcApi(uint64(m.Status))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	if m.Declared != nil {
+		l = m.Declared.Size()
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	if len(m.UsageList) > 0 {
+		for _, e := range m.UsageList {
+			l = e.Size()
+			n += 1 + l + sovTaskRpcApi(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetTaskProgressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TaskProgressShow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PartyId)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	l = len(m.IdentityId)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	l = len(m.JobNodeId)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	l = len(m.Phase)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	if m.Percent != 0 {
+		n += 1 + sovTaskRpcApi(uint64(m.Percent))
+	}
+	if m.ElapsedTime != 0 {
+		n += 1 + sovTaskRpcApi(uint64(m.ElapsedTime))
+	}
+	if m.RemainTime != 0 {
+		n += 1 + sovTaskRpcApi(uint64(m.RemainTime))
+	}
+	if m.UpdateAt != 0 {
+		n += 1 + sovTaskRpcApi(uint64(m.UpdateAt))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetTaskProgressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovTaskRpcApi(uint64(m.Status))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	if len(m.ProgressList) > 0 {
+		for _, e := range m.ProgressList {
+			l = e.Size()
+			n += 1 + l + sovTaskRpcApi(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowTaskDeclare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	if m.Task != nil {
+		l = m.Task.Size()
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	if len(m.Depends) > 0 {
+		for _, s := range m.Depends {
+			l = len(s)
+			n += 1 + l + sovTaskRpcApi(uint64(l))
+		}
+	}
+	if len(m.Bindings) > 0 {
+		for _, e := range m.Bindings {
+			l = e.Size()
+			n += 1 + l + sovTaskRpcApi(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowResultBindingDeclare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromTask)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	l = len(m.IdentityId)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	l = len(m.ParamKey)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PublishWorkflowDeclareRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WorkflowName)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	if len(m.TaskList) > 0 {
+		for _, e := range m.TaskList {
+			l = e.Size()
+			n += 1 + l + sovTaskRpcApi(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *PublishWorkflowDeclareResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovTaskRpcApi(uint64(m.Status))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	l = len(m.WorkflowId)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetWorkflowDetailRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WorkflowId)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *CancelWorkflowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WorkflowId)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowTaskShow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	if len(m.Depends) > 0 {
+		for _, s := range m.Depends {
+			l = len(s)
+			n += 1 + l + sovTaskRpcApi(uint64(l))
+		}
+	}
+	if m.PublishAt != 0 {
+		n += 1 + sovTaskRpcApi(uint64(m.PublishAt))
+	}
+	if m.EndAt != 0 {
+		n += 1 + sovTaskRpcApi(uint64(m.EndAt))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowEventShow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	l = len(m.TaskName)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	l = len(m.Content)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	if m.CreateAt != 0 {
+		n += 1 + sovTaskRpcApi(uint64(m.CreateAt))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *WorkflowDetailShow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.WorkflowId)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	l = len(m.WorkflowName)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	l = len(m.State)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	if m.CreateAt != 0 {
+		n += 1 + sovTaskRpcApi(uint64(m.CreateAt))
+	}
+	if m.EndAt != 0 {
+		n += 1 + sovTaskRpcApi(uint64(m.EndAt))
+	}
+	if len(m.TaskList) > 0 {
+		for _, e := range m.TaskList {
+			l = e.Size()
+			n += 1 + l + sovTaskRpcApi(uint64(l))
+		}
+	}
+	if len(m.EventList) > 0 {
+		for _, e := range m.EventList {
+			l = e.Size()
+			n += 1 + l + sovTaskRpcApi(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetWorkflowDetailResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovTaskRpcApi(uint64(m.Status))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	if m.Workflow != nil {
+		l = m.Workflow.Size()
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *GetWorkflowListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovTaskRpcApi(uint64(m.Status))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	if len(m.WorkflowList) > 0 {
+		for _, e := range m.WorkflowList {
+			l = e.Size()
+			n += 1 + l + sovTaskRpcApi(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ScheduleCandidateShow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	if m.Accepted {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ExplainScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovTaskRpcApi(uint64(m.Status))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	if len(m.OrgCandidates) > 0 {
+		for _, e := range m.OrgCandidates {
+			l = e.Size()
+			n += 1 + l + sovTaskRpcApi(uint64(l))
+		}
+	}
+	if len(m.ElectedOrgs) > 0 {
+		for _, s := range m.ElectedOrgs {
+			l = len(s)
+			n += 1 + l + sovTaskRpcApi(uint64(l))
+		}
+	}
+	l = len(m.OrgErr)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	if len(m.NodeCandidates) > 0 {
+		for _, e := range m.NodeCandidates {
+			l = e.Size()
+			n += 1 + l + sovTaskRpcApi(uint64(l))
+		}
+	}
+	l = len(m.PlacedJobNode)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	if m.NeedSlotCount != 0 {
+		n += 1 + sovTaskRpcApi(uint64(m.NeedSlotCount))
+	}
+	l = len(m.NodeErr)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *VerifyTaskAgreementRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *VerifyTaskAgreementResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sovTaskRpcApi(uint64(m.Status))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	if m.Agreed {
+		n += 2
+	}
+	l = len(m.ProposalId)
+	if l > 0 {
+		n += 1 + l + sovTaskRpcApi(uint64(l))
+	}
+	if len(m.BlockHashes) > 0 {
+		for _, s := range m.BlockHashes {
+			l = len(s)
+			n += 1 + l + sovTaskRpcApi(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovTaskRpcApi(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTaskRpcApi(x uint64) (n int) {
+	return sovTaskRpcApi(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TaskDetailShow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTaskRpcApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskDetailShow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskDetailShow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Owner == nil {
+				m.Owner = &TaskOrganizationIdentityInfo{}
+			}
+			if err := m.Owner.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AlgoSupplier", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.AlgoSupplier == nil {
+				m.AlgoSupplier = &TaskOrganizationIdentityInfo{}
+			}
+			if err := m.AlgoSupplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSupplier", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataSupplier = append(m.DataSupplier, &TaskDataSupplierShow{})
+			if err := m.DataSupplier[len(m.DataSupplier)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerSupplier", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PowerSupplier = append(m.PowerSupplier, &TaskPowerSupplierShow{})
+			if err := m.PowerSupplier[len(m.PowerSupplier)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receivers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receivers = append(m.Receivers, &TaskOrganizationIdentityInfo{})
+			if err := m.Receivers[len(m.Receivers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateAt", wireType)
+			}
+			m.CreateAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreateAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartAt", wireType)
+			}
+			m.StartAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndAt", wireType)
+			}
+			m.EndAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.State = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationCost", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OperationCost == nil {
+				m.OperationCost = &TaskOperationCostDeclare{}
+			}
+			if err := m.OperationCost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAttempts", wireType)
+			}
+			m.MaxAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAttempts |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginTaskId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginTaskId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartDeadline", wireType)
+			}
+			m.StartDeadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartDeadline |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishDeadline", wireType)
+			}
+			m.FinishDeadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinishDeadline |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTaskRpcApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskDataSupplierShow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTaskRpcApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskDataSupplierShow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskDataSupplierShow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MemberInfo == nil {
+				m.MemberInfo = &TaskOrganizationIdentityInfo{}
+			}
+			if err := m.MemberInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetaDataId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetaDataId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetaDataName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetaDataName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTaskRpcApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskPowerSupplierShow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTaskRpcApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskPowerSupplierShow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskPowerSupplierShow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MemberInfo == nil {
+				m.MemberInfo = &TaskOrganizationIdentityInfo{}
+			}
+			if err := m.MemberInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PowerInfo == nil {
+				m.PowerInfo = &ResourceUsedDetailShow{}
+			}
+			if err := m.PowerInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTaskRpcApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskEventShow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTaskRpcApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskEventShow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskEventShow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Owner == nil {
+				m.Owner = &OrganizationIdentityInfo{}
+			}
+			if err := m.Owner.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Content = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateAt", wireType)
+			}
+			m.CreateAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreateAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cursor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTaskRpcApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskEventDeclare) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTaskRpcApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskEventDeclare: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskEventDeclare: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdentityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IdentityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Content = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateAt", wireType)
+			}
+			m.CreateAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreateAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTaskRpcApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskDataSupplierDeclare) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTaskRpcApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskDataSupplierDeclare: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskDataSupplierDeclare: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MemberInfo == nil {
+				m.MemberInfo = &TaskOrganizationIdentityInfo{}
+			}
+			if err := m.MemberInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetaDataInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MetaDataInfo == nil {
+				m.MetaDataInfo = &TaskMetaDataDeclare{}
+			}
+			if err := m.MetaDataInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTaskRpcApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskMetaDataDeclare) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTaskRpcApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskMetaDataDeclare: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskMetaDataDeclare: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetaDataId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetaDataId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTaskRpcApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ColumnIndexList = append(m.ColumnIndexList, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTaskRpcApi
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTaskRpcApi
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTaskRpcApi
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ColumnIndexList) == 0 {
+					m.ColumnIndexList = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTaskRpcApi
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ColumnIndexList = append(m.ColumnIndexList, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ColumnIndexList", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTaskRpcApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskResultReceiverDeclare) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTaskRpcApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskResultReceiverDeclare: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskResultReceiverDeclare: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MemberInfo == nil {
+				m.MemberInfo = &TaskOrganizationIdentityInfo{}
+			}
+			if err := m.MemberInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Providers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Providers = append(m.Providers, &TaskOrganizationIdentityInfo{})
+			if err := m.Providers[len(m.Providers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTaskRpcApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskOperationCostDeclare) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTaskRpcApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskOperationCostDeclare: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskOperationCostDeclare: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CostMem", wireType)
+			}
+			m.CostMem = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CostMem |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CostProcessor", wireType)
+			}
+			m.CostProcessor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CostProcessor |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CostBandwidth", wireType)
+			}
+			m.CostBandwidth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CostBandwidth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTaskRpcApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetTaskDetailResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTaskRpcApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetTaskDetailResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetTaskDetailResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Information", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Information == nil {
+				m.Information = &TaskDetailShow{}
+			}
+			if err := m.Information.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTaskRpcApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetTaskDetailListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTaskRpcApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetTaskDetailListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetTaskDetailListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskList = append(m.TaskList, &GetTaskDetailResponse{})
+			if err := m.TaskList[len(m.TaskList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTaskRpcApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetTaskEventListRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTaskRpcApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetTaskEventListRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetTaskEventListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTaskRpcApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetTaskEventListByTaskIdsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTaskRpcApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetTaskEventListByTaskIdsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetTaskEventListByTaskIdsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskIds = append(m.TaskIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTaskRpcApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubscribeTaskEventsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTaskRpcApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubscribeTaskEventsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubscribeTaskEventsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskIds = append(m.TaskIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypePrefixes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypePrefixes = append(m.TypePrefixes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdentityIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IdentityIds = append(m.IdentityIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
+			}
+			m.Since = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Since |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Cursor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTaskRpcApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetTaskEventListResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTaskRpcApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetTaskEventListResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetTaskEventListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskEventList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskEventList = append(m.TaskEventList, &TaskEventShow{})
+			if err := m.TaskEventList[len(m.TaskEventList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTaskRpcApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PublishTaskDeclareRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTaskRpcApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PublishTaskDeclareRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PublishTaskDeclareRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Owner == nil {
+				m.Owner = &TaskOrganizationIdentityInfo{}
+			}
+			if err := m.Owner.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataSupplier", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DataSupplier = append(m.DataSupplier, &TaskDataSupplierDeclare{})
+			if err := m.DataSupplier[len(m.DataSupplier)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PowerPartyIds", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PowerPartyIds = append(m.PowerPartyIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receivers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receivers = append(m.Receivers, &TaskResultReceiverDeclare{})
+			if err := m.Receivers[len(m.Receivers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationCost", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OperationCost == nil {
+				m.OperationCost = &TaskOperationCostDeclare{}
+			}
+			if err := m.OperationCost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CalculateContractcode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CalculateContractcode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DatasplitContractcode", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DatasplitContractcode = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractExtraParams", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractExtraParams = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryPolicy == nil {
+				m.RetryPolicy = &TaskRetryPolicyDeclare{}
+			}
+			if err := m.RetryPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartDeadline", wireType)
+			}
+			m.StartDeadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartDeadline |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishDeadline", wireType)
+			}
+			m.FinishDeadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinishDeadline |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTaskRpcApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskRetryPolicyDeclare) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTaskRpcApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskRetryPolicyDeclare: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskRetryPolicyDeclare: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAttempts", wireType)
+			}
+			m.MaxAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAttempts |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Backoff", wireType)
+			}
+			m.Backoff = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Backoff |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReelectPower", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReelectPower = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTaskRpcApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PublishTaskDeclareResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTaskRpcApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PublishTaskDeclareResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PublishTaskDeclareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTaskRpcApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CancelTaskRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTaskRpcApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CancelTaskRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CancelTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTaskRpcApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetTaskResourceUsageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTaskRpcApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetTaskResourceUsageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetTaskResourceUsageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTaskRpcApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskResourceUsageShow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTaskRpcApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskResourceUsageShow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskResourceUsageShow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PartyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdentityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IdentityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobNodeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobNodeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsedMem", wireType)
+			}
+			m.UsedMem = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UsedMem |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsedProcessor", wireType)
+			}
+			m.UsedProcessor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UsedProcessor |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsedBandwidth", wireType)
+			}
+			m.UsedBandwidth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UsedBandwidth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsedDuration", wireType)
+			}
+			m.UsedDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UsedDuration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateAt", wireType)
+			}
+			m.UpdateAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdateAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExceededItems", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExceededItems = append(m.ExceededItems, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTaskRpcApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
 	}
-	if len(m.DataSupplier) > 0 {
-		for _, e := range m.DataSupplier {
-			l = e.Size()
-			n += 1 + l + sovTaskRpcApi(uint64(l))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetTaskResourceUsageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTaskRpcApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetTaskResourceUsageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetTaskResourceUsageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Declared", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Declared == nil {
+				m.Declared = &TaskOperationCostDeclare{}
+			}
+			if err := m.Declared.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsageList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UsageList = append(m.UsageList, &TaskResourceUsageShow{})
+			if err := m.UsageList[len(m.UsageList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTaskRpcApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
 		}
 	}
-	if len(m.PowerPartyIds) > 0 {
-		for _, s := range m.PowerPartyIds {
-			l = len(s)
-			n += 1 + l + sovTaskRpcApi(uint64(l))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GetTaskProgressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTaskRpcApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GetTaskProgressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GetTaskProgressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTaskRpcApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
 		}
 	}
-	if len(m.Receivers) > 0 {
-		for _, e := range m.Receivers {
-			l = e.Size()
-			n += 1 + l + sovTaskRpcApi(uint64(l))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskProgressShow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTaskRpcApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskProgressShow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskProgressShow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PartyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdentityId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IdentityId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobNodeId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JobNodeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percent", wireType)
+			}
+			m.Percent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Percent |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ElapsedTime", wireType)
+			}
+			m.ElapsedTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ElapsedTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainTime", wireType)
+			}
+			m.RemainTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateAt", wireType)
+			}
+			m.UpdateAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdateAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTaskRpcApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
 		}
 	}
-	if m.OperationCost != nil {
-		l = m.OperationCost.Size()
-		n += 1 + l + sovTaskRpcApi(uint64(l))
-	}
-	l = len(m.CalculateContractcode)
-	if l > 0 {
-		n += 1 + l + sovTaskRpcApi(uint64(l))
-	}
-	l = len(m.DatasplitContractcode)
-	if l > 0 {
-		n += 1 + l + sovTaskRpcApi(uint64(l))
-	}
-	l = len(m.ContractExtraParams)
-	if l > 0 {
-		n += 1 + l + sovTaskRpcApi(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
-	}
-	return n
-}
 
-func (m *PublishTaskDeclareResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Status != 0 {
-		n += 1 + sovTaskRpcApi(uint64(m.Status))
-	}
-	l = len(m.Msg)
-	if l > 0 {
-		n += 1 + l + sovTaskRpcApi(uint64(l))
-	}
-	l = len(m.TaskId)
-	if l > 0 {
-		n += 1 + l + sovTaskRpcApi(uint64(l))
-	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTaskRpcApi(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTaskRpcApi(x uint64) (n int) {
-	return sovTaskRpcApi(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *TaskDetailShow) Unmarshal(dAtA []byte) error {
+func (m *GetTaskProgressResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2868,15 +11551,34 @@ func (m *TaskDetailShow) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TaskDetailShow: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetTaskProgressResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TaskDetailShow: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetTaskProgressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2904,11 +11606,11 @@ func (m *TaskDetailShow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaskId = string(dAtA[iNdEx:postIndex])
+			m.Msg = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2936,11 +11638,11 @@ func (m *TaskDetailShow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaskName = string(dAtA[iNdEx:postIndex])
+			m.TaskId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgressList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2967,18 +11669,67 @@ func (m *TaskDetailShow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Owner == nil {
-				m.Owner = &TaskOrganizationIdentityInfo{}
-			}
-			if err := m.Owner.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ProgressList = append(m.ProgressList, &TaskProgressShow{})
+			if err := m.ProgressList[len(m.ProgressList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTaskRpcApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowTaskDeclare) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTaskRpcApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowTaskDeclare: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowTaskDeclare: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AlgoSupplier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTaskRpcApi
@@ -2988,31 +11739,27 @@ func (m *TaskDetailShow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTaskRpcApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTaskRpcApi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AlgoSupplier == nil {
-				m.AlgoSupplier = &TaskOrganizationIdentityInfo{}
-			}
-			if err := m.AlgoSupplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataSupplier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3039,16 +11786,18 @@ func (m *TaskDetailShow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DataSupplier = append(m.DataSupplier, &TaskDataSupplierShow{})
-			if err := m.DataSupplier[len(m.DataSupplier)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Task == nil {
+				m.Task = &PublishTaskDeclareRequest{}
+			}
+			if err := m.Task.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PowerSupplier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Depends", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTaskRpcApi
@@ -3058,29 +11807,27 @@ func (m *TaskDetailShow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTaskRpcApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTaskRpcApi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PowerSupplier = append(m.PowerSupplier, &TaskPowerSupplierShow{})
-			if err := m.PowerSupplier[len(m.PowerSupplier)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Depends = append(m.Depends, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receivers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bindings", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3107,54 +11854,67 @@ func (m *TaskDetailShow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receivers = append(m.Receivers, &TaskOrganizationIdentityInfo{})
-			if err := m.Receivers[len(m.Receivers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Bindings = append(m.Bindings, &WorkflowResultBindingDeclare{})
+			if err := m.Bindings[len(m.Bindings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateAt", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTaskRpcApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.CreateAt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTaskRpcApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CreateAt |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTaskRpcApi
 			}
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartAt", wireType)
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			m.StartAt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTaskRpcApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.StartAt |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WorkflowResultBindingDeclare) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTaskRpcApi
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndAt", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			m.EndAt = 0
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WorkflowResultBindingDeclare: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WorkflowResultBindingDeclare: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromTask", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTaskRpcApi
@@ -3164,14 +11924,27 @@ func (m *TaskDetailShow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EndAt |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromTask = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IdentityId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3199,13 +11972,13 @@ func (m *TaskDetailShow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.State = string(dAtA[iNdEx:postIndex])
+			m.IdentityId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperationCost", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamKey", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTaskRpcApi
@@ -3215,27 +11988,23 @@ func (m *TaskDetailShow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTaskRpcApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTaskRpcApi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OperationCost == nil {
-				m.OperationCost = &TaskOperationCostDeclare{}
-			}
-			if err := m.OperationCost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ParamKey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3259,7 +12028,7 @@ func (m *TaskDetailShow) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TaskDataSupplierShow) Unmarshal(dAtA []byte) error {
+func (m *PublishWorkflowDeclareRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3282,51 +12051,15 @@ func (m *TaskDataSupplierShow) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TaskDataSupplierShow: wiretype end group for non-group")
+			return fmt.Errorf("proto: PublishWorkflowDeclareRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TaskDataSupplierShow: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PublishWorkflowDeclareRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemberInfo", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTaskRpcApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTaskRpcApi
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTaskRpcApi
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.MemberInfo == nil {
-				m.MemberInfo = &TaskOrganizationIdentityInfo{}
-			}
-			if err := m.MemberInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetaDataId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3354,13 +12087,13 @@ func (m *TaskDataSupplierShow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MetaDataId = string(dAtA[iNdEx:postIndex])
+			m.WorkflowName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetaDataName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTaskRpcApi
@@ -3370,23 +12103,25 @@ func (m *TaskDataSupplierShow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTaskRpcApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTaskRpcApi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MetaDataName = string(dAtA[iNdEx:postIndex])
+			m.TaskList = append(m.TaskList, &WorkflowTaskDeclare{})
+			if err := m.TaskList[len(m.TaskList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3410,7 +12145,7 @@ func (m *TaskDataSupplierShow) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TaskPowerSupplierShow) Unmarshal(dAtA []byte) error {
+func (m *PublishWorkflowDeclareResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3433,17 +12168,36 @@ func (m *TaskPowerSupplierShow) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TaskPowerSupplierShow: wiretype end group for non-group")
+			return fmt.Errorf("proto: PublishWorkflowDeclareResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TaskPowerSupplierShow: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PublishWorkflowDeclareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemberInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTaskRpcApi
@@ -3453,33 +12207,29 @@ func (m *TaskPowerSupplierShow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTaskRpcApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTaskRpcApi
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.MemberInfo == nil {
-				m.MemberInfo = &TaskOrganizationIdentityInfo{}
-			}
-			if err := m.MemberInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PowerInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTaskRpcApi
@@ -3489,27 +12239,23 @@ func (m *TaskPowerSupplierShow) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTaskRpcApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTaskRpcApi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PowerInfo == nil {
-				m.PowerInfo = &ResourceUsedDetailShow{}
-			}
-			if err := m.PowerInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.WorkflowId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3533,7 +12279,7 @@ func (m *TaskPowerSupplierShow) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TaskEventShow) Unmarshal(dAtA []byte) error {
+func (m *GetWorkflowDetailRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3556,15 +12302,15 @@ func (m *TaskEventShow) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TaskEventShow: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetWorkflowDetailRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TaskEventShow: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetWorkflowDetailRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3592,79 +12338,62 @@ func (m *TaskEventShow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.WorkflowId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTaskRpcApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTaskRpcApi
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTaskRpcApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTaskRpcApi
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaskId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTaskRpcApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTaskRpcApi
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTaskRpcApi
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CancelWorkflowRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTaskRpcApi
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Owner == nil {
-				m.Owner = &OrganizationIdentityInfo{}
-			}
-			if err := m.Owner.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CancelWorkflowRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CancelWorkflowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3692,27 +12421,8 @@ func (m *TaskEventShow) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Content = string(dAtA[iNdEx:postIndex])
+			m.WorkflowId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateAt", wireType)
-			}
-			m.CreateAt = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTaskRpcApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CreateAt |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTaskRpcApi(dAtA[iNdEx:])
@@ -3735,7 +12445,7 @@ func (m *TaskEventShow) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TaskEventDeclare) Unmarshal(dAtA []byte) error {
+func (m *WorkflowTaskShow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3758,15 +12468,15 @@ func (m *TaskEventDeclare) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TaskEventDeclare: wiretype end group for non-group")
+			return fmt.Errorf("proto: WorkflowTaskShow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TaskEventDeclare: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WorkflowTaskShow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3794,7 +12504,7 @@ func (m *TaskEventDeclare) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -3830,7 +12540,7 @@ func (m *TaskEventDeclare) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IdentityId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3858,11 +12568,11 @@ func (m *TaskEventDeclare) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IdentityId = string(dAtA[iNdEx:postIndex])
+			m.State = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3890,13 +12600,45 @@ func (m *TaskEventDeclare) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Content = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depends", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depends = append(m.Depends, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PublishAt", wireType)
 			}
-			m.CreateAt = 0
+			m.PublishAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTaskRpcApi
@@ -3906,7 +12648,26 @@ func (m *TaskEventDeclare) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CreateAt |= uint64(b&0x7F) << shift
+				m.PublishAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndAt", wireType)
+			}
+			m.EndAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndAt |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3933,7 +12694,7 @@ func (m *TaskEventDeclare) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TaskDataSupplierDeclare) Unmarshal(dAtA []byte) error {
+func (m *WorkflowEventShow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3956,17 +12717,17 @@ func (m *TaskDataSupplierDeclare) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TaskDataSupplierDeclare: wiretype end group for non-group")
+			return fmt.Errorf("proto: WorkflowEventShow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TaskDataSupplierDeclare: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WorkflowEventShow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemberInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTaskRpcApi
@@ -3976,33 +12737,61 @@ func (m *TaskDataSupplierDeclare) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTaskRpcApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTaskRpcApi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MemberInfo == nil {
-				m.MemberInfo = &TaskOrganizationIdentityInfo{}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
 			}
-			if err := m.MemberInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.TaskName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetaDataInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTaskRpcApi
@@ -4012,82 +12801,27 @@ func (m *TaskDataSupplierDeclare) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTaskRpcApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTaskRpcApi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MetaDataInfo == nil {
-				m.MetaDataInfo = &TaskMetaDataDeclare{}
-			}
-			if err := m.MetaDataInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TaskId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTaskRpcApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTaskRpcApi
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TaskMetaDataDeclare) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTaskRpcApi
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TaskMetaDataDeclare: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TaskMetaDataDeclare: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetaDataId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Content", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4115,83 +12849,26 @@ func (m *TaskMetaDataDeclare) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MetaDataId = string(dAtA[iNdEx:postIndex])
+			m.Content = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTaskRpcApi
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.ColumnIndexList = append(m.ColumnIndexList, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowTaskRpcApi
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthTaskRpcApi
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthTaskRpcApi
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateAt", wireType)
+			}
+			m.CreateAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.ColumnIndexList) == 0 {
-					m.ColumnIndexList = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowTaskRpcApi
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.ColumnIndexList = append(m.ColumnIndexList, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreateAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field ColumnIndexList", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -4215,7 +12892,7 @@ func (m *TaskMetaDataDeclare) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TaskResultReceiverDeclare) Unmarshal(dAtA []byte) error {
+func (m *WorkflowDetailShow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4238,17 +12915,17 @@ func (m *TaskResultReceiverDeclare) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TaskResultReceiverDeclare: wiretype end group for non-group")
+			return fmt.Errorf("proto: WorkflowDetailShow: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TaskResultReceiverDeclare: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: WorkflowDetailShow: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemberInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTaskRpcApi
@@ -4258,33 +12935,29 @@ func (m *TaskResultReceiverDeclare) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTaskRpcApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTaskRpcApi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MemberInfo == nil {
-				m.MemberInfo = &TaskOrganizationIdentityInfo{}
-			}
-			if err := m.MemberInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.WorkflowId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Providers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowName", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTaskRpcApi
@@ -4294,82 +12967,29 @@ func (m *TaskResultReceiverDeclare) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTaskRpcApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTaskRpcApi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Providers = append(m.Providers, &TaskOrganizationIdentityInfo{})
-			if err := m.Providers[len(m.Providers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.WorkflowName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTaskRpcApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTaskRpcApi
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TaskOperationCostDeclare) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTaskRpcApi
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TaskOperationCostDeclare: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TaskOperationCostDeclare: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CostMem", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
 			}
-			m.CostMem = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTaskRpcApi
@@ -4379,16 +12999,29 @@ func (m *TaskOperationCostDeclare) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CostMem |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CostProcessor", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskRpcApi
 			}
-			m.CostProcessor = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.State = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTaskRpcApi
@@ -4398,16 +13031,29 @@ func (m *TaskOperationCostDeclare) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CostProcessor |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CostBandwidth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateAt", wireType)
 			}
-			m.CostBandwidth = 0
+			m.CreateAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTaskRpcApi
@@ -4417,16 +13063,16 @@ func (m *TaskOperationCostDeclare) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CostBandwidth |= uint64(b&0x7F) << shift
+				m.CreateAt |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndAt", wireType)
 			}
-			m.Duration = 0
+			m.EndAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTaskRpcApi
@@ -4436,65 +13082,14 @@ func (m *TaskOperationCostDeclare) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Duration |= uint64(b&0x7F) << shift
+				m.EndAt |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTaskRpcApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTaskRpcApi
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetTaskDetailResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTaskRpcApi
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetTaskDetailResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetTaskDetailResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Information", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4521,18 +13116,16 @@ func (m *GetTaskDetailResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Information == nil {
-				m.Information = &TaskDetailShow{}
-			}
-			if err := m.Information.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TaskList = append(m.TaskList, &WorkflowTaskShow{})
+			if err := m.TaskList[len(m.TaskList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventList", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTaskRpcApi
@@ -4542,23 +13135,25 @@ func (m *GetTaskDetailResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTaskRpcApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTaskRpcApi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Role = string(dAtA[iNdEx:postIndex])
+			m.EventList = append(m.EventList, &WorkflowEventShow{})
+			if err := m.EventList[len(m.EventList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4582,7 +13177,7 @@ func (m *GetTaskDetailResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetTaskDetailListResponse) Unmarshal(dAtA []byte) error {
+func (m *GetWorkflowDetailResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4605,10 +13200,10 @@ func (m *GetTaskDetailListResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetTaskDetailListResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetWorkflowDetailResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetTaskDetailListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetWorkflowDetailResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4664,7 +13259,7 @@ func (m *GetTaskDetailListResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Workflow", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4691,8 +13286,10 @@ func (m *GetTaskDetailListResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaskList = append(m.TaskList, &GetTaskDetailResponse{})
-			if err := m.TaskList[len(m.TaskList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Workflow == nil {
+				m.Workflow = &WorkflowDetailShow{}
+			}
+			if err := m.Workflow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4718,7 +13315,7 @@ func (m *GetTaskDetailListResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetTaskEventListRequest) Unmarshal(dAtA []byte) error {
+func (m *GetWorkflowListResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4741,15 +13338,34 @@ func (m *GetTaskEventListRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetTaskEventListRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GetWorkflowListResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetTaskEventListRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GetWorkflowListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4777,64 +13393,13 @@ func (m *GetTaskEventListRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaskId = string(dAtA[iNdEx:postIndex])
+			m.Msg = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTaskRpcApi(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTaskRpcApi
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GetTaskEventListByTaskIdsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTaskRpcApi
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GetTaskEventListByTaskIdsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetTaskEventListByTaskIdsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field WorkflowList", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTaskRpcApi
@@ -4844,23 +13409,25 @@ func (m *GetTaskEventListByTaskIdsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTaskRpcApi
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTaskRpcApi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaskIds = append(m.TaskIds, string(dAtA[iNdEx:postIndex]))
+			m.WorkflowList = append(m.WorkflowList, &WorkflowDetailShow{})
+			if err := m.WorkflowList[len(m.WorkflowList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4884,7 +13451,7 @@ func (m *GetTaskEventListByTaskIdsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GetTaskEventListResponse) Unmarshal(dAtA []byte) error {
+func (m *ScheduleCandidateShow) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4907,34 +13474,15 @@ func (m *GetTaskEventListResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GetTaskEventListResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GetTaskEventListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTaskRpcApi
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
+			return fmt.Errorf("proto: ScheduleCandidateShow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduleCandidateShow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4962,13 +13510,33 @@ func (m *GetTaskEventListResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Msg = string(dAtA[iNdEx:postIndex])
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accepted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Accepted = bool(v != 0)
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskEventList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTaskRpcApi
@@ -4978,25 +13546,23 @@ func (m *GetTaskEventListResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTaskRpcApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTaskRpcApi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaskEventList = append(m.TaskEventList, &TaskEventShow{})
-			if err := m.TaskEventList[len(m.TaskEventList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5020,7 +13586,7 @@ func (m *GetTaskEventListResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PublishTaskDeclareRequest) Unmarshal(dAtA []byte) error {
+func (m *ExplainScheduleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5043,17 +13609,17 @@ func (m *PublishTaskDeclareRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PublishTaskDeclareRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExplainScheduleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PublishTaskDeclareRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExplainScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskName", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTaskRpcApi
@@ -5063,29 +13629,16 @@ func (m *PublishTaskDeclareRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Status |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTaskRpcApi
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTaskRpcApi
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TaskName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTaskRpcApi
@@ -5095,31 +13648,27 @@ func (m *PublishTaskDeclareRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTaskRpcApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTaskRpcApi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Owner == nil {
-				m.Owner = &TaskOrganizationIdentityInfo{}
-			}
-			if err := m.Owner.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DataSupplier", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrgCandidates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5146,14 +13695,14 @@ func (m *PublishTaskDeclareRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DataSupplier = append(m.DataSupplier, &TaskDataSupplierDeclare{})
-			if err := m.DataSupplier[len(m.DataSupplier)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.OrgCandidates = append(m.OrgCandidates, &ScheduleCandidateShow{})
+			if err := m.OrgCandidates[len(m.OrgCandidates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PowerPartyIds", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ElectedOrgs", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5181,13 +13730,13 @@ func (m *PublishTaskDeclareRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PowerPartyIds = append(m.PowerPartyIds, string(dAtA[iNdEx:postIndex]))
+			m.ElectedOrgs = append(m.ElectedOrgs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receivers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrgErr", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTaskRpcApi
@@ -5197,29 +13746,27 @@ func (m *PublishTaskDeclareRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTaskRpcApi
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTaskRpcApi
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receivers = append(m.Receivers, &TaskResultReceiverDeclare{})
-			if err := m.Receivers[len(m.Receivers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OrgErr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperationCost", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeCandidates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5246,16 +13793,14 @@ func (m *PublishTaskDeclareRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.OperationCost == nil {
-				m.OperationCost = &TaskOperationCostDeclare{}
-			}
-			if err := m.OperationCost.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.NodeCandidates = append(m.NodeCandidates, &ScheduleCandidateShow{})
+			if err := m.NodeCandidates[len(m.NodeCandidates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CalculateContractcode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PlacedJobNode", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5283,11 +13828,30 @@ func (m *PublishTaskDeclareRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CalculateContractcode = string(dAtA[iNdEx:postIndex])
+			m.PlacedJobNode = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NeedSlotCount", wireType)
+			}
+			m.NeedSlotCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NeedSlotCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DatasplitContractcode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeErr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5315,11 +13879,62 @@ func (m *PublishTaskDeclareRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DatasplitContractcode = string(dAtA[iNdEx:postIndex])
+			m.NodeErr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTaskRpcApi(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VerifyTaskAgreementRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTaskRpcApi
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VerifyTaskAgreementRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VerifyTaskAgreementRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractExtraParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5347,7 +13962,7 @@ func (m *PublishTaskDeclareRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContractExtraParams = string(dAtA[iNdEx:postIndex])
+			m.TaskId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5371,7 +13986,7 @@ func (m *PublishTaskDeclareRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PublishTaskDeclareResponse) Unmarshal(dAtA []byte) error {
+func (m *VerifyTaskAgreementResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5394,10 +14009,10 @@ func (m *PublishTaskDeclareResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PublishTaskDeclareResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: VerifyTaskAgreementResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PublishTaskDeclareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VerifyTaskAgreementResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5483,6 +14098,90 @@ func (m *PublishTaskDeclareResponse) Unmarshal(dAtA []byte) error {
 			}
 			m.TaskId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Agreed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Agreed = bool(v != 0)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHashes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskRpcApi
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskRpcApi
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockHashes = append(m.BlockHashes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTaskRpcApi(dAtA[iNdEx:])
@@ -14,7 +14,7 @@ func (p *PrepareMsg) MarshalSSZ() ([]byte, error) {
 // MarshalSSZTo ssz marshals the PrepareMsg object to a target array
 func (p *PrepareMsg) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
-	offset := int(32)
+	offset := int(76)
 
 	// Offset (0) 'ProposalId'
 	dst = ssz.WriteOffset(dst, offset)
@@ -46,6 +46,30 @@ func (p *PrepareMsg) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = ssz.WriteOffset(dst, offset)
 	offset += len(p.Sign)
 
+	// Offset (7) 'ElectionSeed'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(p.ElectionSeed)
+
+	// Offset (8) 'ElectionProof'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(p.ElectionProof)
+
+	// Field (9) 'PrepareVotingTimeout'
+	dst = ssz.MarshalUint64(dst, p.PrepareVotingTimeout)
+
+	// Field (10) 'ConfirmVotingTimeout'
+	dst = ssz.MarshalUint64(dst, p.ConfirmVotingTimeout)
+
+	// Field (11) 'CommitEndingTimeout'
+	dst = ssz.MarshalUint64(dst, p.CommitEndingTimeout)
+
+	// Field (12) 'ProposalDeadline'
+	dst = ssz.MarshalUint64(dst, p.ProposalDeadline)
+
+	// Offset (13) 'ElectionPowersSign'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(p.ElectionPowersSign)
+
 	// Field (0) 'ProposalId'
 	if len(p.ProposalId) > 1024 {
 		err = ssz.ErrBytesLength
@@ -86,6 +110,27 @@ func (p *PrepareMsg) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	}
 	dst = append(dst, p.Sign...)
 
+	// Field (7) 'ElectionSeed'
+	if len(p.ElectionSeed) > 32 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	dst = append(dst, p.ElectionSeed...)
+
+	// Field (8) 'ElectionProof'
+	if len(p.ElectionProof) > 65 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	dst = append(dst, p.ElectionProof...)
+
+	// Field (13) 'ElectionPowersSign'
+	if len(p.ElectionPowersSign) > 65 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	dst = append(dst, p.ElectionPowersSign...)
+
 	return
 }
 
@@ -93,19 +138,19 @@ func (p *PrepareMsg) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 func (p *PrepareMsg) UnmarshalSSZ(buf []byte) error {
 	var err error
 	size := uint64(len(buf))
-	if size < 32 {
+	if size < 76 {
 		return ssz.ErrSize
 	}
 
 	tail := buf
-	var o0, o1, o2, o3, o4, o6 uint64
+	var o0, o1, o2, o3, o4, o6, o7, o8, o13 uint64
 
 	// Offset (0) 'ProposalId'
 	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
 		return ssz.ErrOffset
 	}
 
-	if o0 < 32 {
+	if o0 < 76 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -137,6 +182,33 @@ func (p *PrepareMsg) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
+	// Offset (7) 'ElectionSeed'
+	if o7 = ssz.ReadOffset(buf[32:36]); o7 > size || o6 > o7 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (8) 'ElectionProof'
+	if o8 = ssz.ReadOffset(buf[36:40]); o8 > size || o7 > o8 {
+		return ssz.ErrOffset
+	}
+
+	// Field (9) 'PrepareVotingTimeout'
+	p.PrepareVotingTimeout = ssz.UnmarshallUint64(buf[40:48])
+
+	// Field (10) 'ConfirmVotingTimeout'
+	p.ConfirmVotingTimeout = ssz.UnmarshallUint64(buf[48:56])
+
+	// Field (11) 'CommitEndingTimeout'
+	p.CommitEndingTimeout = ssz.UnmarshallUint64(buf[56:64])
+
+	// Field (12) 'ProposalDeadline'
+	p.ProposalDeadline = ssz.UnmarshallUint64(buf[64:72])
+
+	// Offset (13) 'ElectionPowersSign'
+	if o13 = ssz.ReadOffset(buf[72:76]); o13 > size || o8 > o13 {
+		return ssz.ErrOffset
+	}
+
 	// Field (0) 'ProposalId'
 	{
 		buf = tail[o0:o1]
@@ -198,7 +270,7 @@ func (p *PrepareMsg) UnmarshalSSZ(buf []byte) error {
 
 	// Field (6) 'Sign'
 	{
-		buf = tail[o6:]
+		buf = tail[o6:o7]
 		if len(buf) > 1024 {
 			return ssz.ErrBytesLength
 		}
@@ -207,12 +279,48 @@ func (p *PrepareMsg) UnmarshalSSZ(buf []byte) error {
 		}
 		p.Sign = append(p.Sign, buf...)
 	}
+
+	// Field (7) 'ElectionSeed'
+	{
+		buf = tail[o7:o8]
+		if len(buf) > 32 {
+			return ssz.ErrBytesLength
+		}
+		if cap(p.ElectionSeed) == 0 {
+			p.ElectionSeed = make([]byte, 0, len(buf))
+		}
+		p.ElectionSeed = append(p.ElectionSeed, buf...)
+	}
+
+	// Field (8) 'ElectionProof'
+	{
+		buf = tail[o8:o13]
+		if len(buf) > 65 {
+			return ssz.ErrBytesLength
+		}
+		if cap(p.ElectionProof) == 0 {
+			p.ElectionProof = make([]byte, 0, len(buf))
+		}
+		p.ElectionProof = append(p.ElectionProof, buf...)
+	}
+
+	// Field (13) 'ElectionPowersSign'
+	{
+		buf = tail[o13:]
+		if len(buf) > 65 {
+			return ssz.ErrBytesLength
+		}
+		if cap(p.ElectionPowersSign) == 0 {
+			p.ElectionPowersSign = make([]byte, 0, len(buf))
+		}
+		p.ElectionPowersSign = append(p.ElectionPowersSign, buf...)
+	}
 	return err
 }
 
 // SizeSSZ returns the ssz encoded size in bytes for the PrepareMsg object
 func (p *PrepareMsg) SizeSSZ() (size int) {
-	size = 32
+	size = 76
 
 	// Field (0) 'ProposalId'
 	size += len(p.ProposalId)
@@ -235,6 +343,15 @@ func (p *PrepareMsg) SizeSSZ() (size int) {
 	// Field (6) 'Sign'
 	size += len(p.Sign)
 
+	// Field (7) 'ElectionSeed'
+	size += len(p.ElectionSeed)
+
+	// Field (8) 'ElectionProof'
+	size += len(p.ElectionProof)
+
+	// Field (13) 'ElectionPowersSign'
+	size += len(p.ElectionPowersSign)
+
 	return
 }
 
@@ -290,6 +407,39 @@ func (p *PrepareMsg) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	}
 	hh.PutBytes(p.Sign)
 
+	// Field (7) 'ElectionSeed'
+	if len(p.ElectionSeed) > 32 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	hh.PutBytes(p.ElectionSeed)
+
+	// Field (8) 'ElectionProof'
+	if len(p.ElectionProof) > 65 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	hh.PutBytes(p.ElectionProof)
+
+	// Field (9) 'PrepareVotingTimeout'
+	hh.PutUint64(p.PrepareVotingTimeout)
+
+	// Field (10) 'ConfirmVotingTimeout'
+	hh.PutUint64(p.ConfirmVotingTimeout)
+
+	// Field (11) 'CommitEndingTimeout'
+	hh.PutUint64(p.CommitEndingTimeout)
+
+	// Field (12) 'ProposalDeadline'
+	hh.PutUint64(p.ProposalDeadline)
+
+	// Field (13) 'ElectionPowersSign'
+	if len(p.ElectionPowersSign) > 65 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	hh.PutBytes(p.ElectionPowersSign)
+
 	hh.Merkleize(indx)
 	return
 }
@@ -2563,7 +2713,7 @@ func (t *TaskResultMsg) MarshalSSZ() ([]byte, error) {
 // MarshalSSZTo ssz marshals the TaskResultMsg object to a target array
 func (t *TaskResultMsg) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
-	offset := int(32)
+	offset := int(36)
 
 	// Offset (0) 'ProposalId'
 	dst = ssz.WriteOffset(dst, offset)
@@ -2598,6 +2748,13 @@ func (t *TaskResultMsg) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = ssz.WriteOffset(dst, offset)
 	offset += len(t.Sign)
 
+	// Offset (7) 'ResourceUsageList'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(t.ResourceUsageList); ii++ {
+		offset += 4
+		offset += t.ResourceUsageList[ii].SizeSSZ()
+	}
+
 	// Field (0) 'ProposalId'
 	if len(t.ProposalId) > 1024 {
 		err = ssz.ErrBytesLength
@@ -2649,6 +2806,24 @@ func (t *TaskResultMsg) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	}
 	dst = append(dst, t.Sign...)
 
+	// Field (7) 'ResourceUsageList'
+	if len(t.ResourceUsageList) > 1024 {
+		err = ssz.ErrListTooBig
+		return
+	}
+	{
+		offset = 4 * len(t.ResourceUsageList)
+		for ii := 0; ii < len(t.ResourceUsageList); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += t.ResourceUsageList[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(t.ResourceUsageList); ii++ {
+		if dst, err = t.ResourceUsageList[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
 	return
 }
 
@@ -2656,19 +2831,19 @@ func (t *TaskResultMsg) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 func (t *TaskResultMsg) UnmarshalSSZ(buf []byte) error {
 	var err error
 	size := uint64(len(buf))
-	if size < 32 {
+	if size < 36 {
 		return ssz.ErrSize
 	}
 
 	tail := buf
-	var o0, o1, o2, o3, o4, o6 uint64
+	var o0, o1, o2, o3, o4, o6, o7 uint64
 
 	// Offset (0) 'ProposalId'
 	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
 		return ssz.ErrOffset
 	}
 
-	if o0 < 32 {
+	if o0 < 36 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
@@ -2700,6 +2875,11 @@ func (t *TaskResultMsg) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
+	// Offset (7) 'ResourceUsageList'
+	if o7 = ssz.ReadOffset(buf[32:36]); o7 > size || o6 > o7 {
+		return ssz.ErrOffset
+	}
+
 	// Field (0) 'ProposalId'
 	{
 		buf = tail[o0:o1]
@@ -2771,7 +2951,7 @@ func (t *TaskResultMsg) UnmarshalSSZ(buf []byte) error {
 
 	// Field (6) 'Sign'
 	{
-		buf = tail[o6:]
+		buf = tail[o6:o7]
 		if len(buf) > 1024 {
 			return ssz.ErrBytesLength
 		}
@@ -2780,12 +2960,34 @@ func (t *TaskResultMsg) UnmarshalSSZ(buf []byte) error {
 		}
 		t.Sign = append(t.Sign, buf...)
 	}
+
+	// Field (7) 'ResourceUsageList'
+	{
+		buf = tail[o7:]
+		num, err := ssz.DecodeDynamicLength(buf, 1024)
+		if err != nil {
+			return err
+		}
+		t.ResourceUsageList = make([]*TaskResourceUsage, num)
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if t.ResourceUsageList[indx] == nil {
+				t.ResourceUsageList[indx] = new(TaskResourceUsage)
+			}
+			if err = t.ResourceUsageList[indx].UnmarshalSSZ(buf); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
 	return err
 }
 
 // SizeSSZ returns the ssz encoded size in bytes for the TaskResultMsg object
 func (t *TaskResultMsg) SizeSSZ() (size int) {
-	size = 32
+	size = 36
 
 	// Field (0) 'ProposalId'
 	size += len(t.ProposalId)
@@ -2811,6 +3013,12 @@ func (t *TaskResultMsg) SizeSSZ() (size int) {
 	// Field (6) 'Sign'
 	size += len(t.Sign)
 
+	// Field (7) 'ResourceUsageList'
+	for ii := 0; ii < len(t.ResourceUsageList); ii++ {
+		size += 4
+		size += t.ResourceUsageList[ii].SizeSSZ()
+	}
+
 	return
 }
 
@@ -2875,52 +3083,80 @@ func (t *TaskResultMsg) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	}
 	hh.PutBytes(t.Sign)
 
+	// Field (7) 'ResourceUsageList'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(t.ResourceUsageList))
+		if num > 1024 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for i := uint64(0); i < num; i++ {
+			if err = t.ResourceUsageList[i].HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 1024)
+	}
+
 	hh.Merkleize(indx)
 	return
 }
 
-// MarshalSSZ ssz marshals the TaskEvent object
-func (t *TaskEvent) MarshalSSZ() ([]byte, error) {
+// MarshalSSZ ssz marshals the TaskResourceUsage object
+func (t *TaskResourceUsage) MarshalSSZ() ([]byte, error) {
 	return ssz.MarshalSSZ(t)
 }
 
-// MarshalSSZTo ssz marshals the TaskEvent object to a target array
-func (t *TaskEvent) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+// MarshalSSZTo ssz marshals the TaskResourceUsage object to a target array
+func (t *TaskResourceUsage) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	dst = buf
-	offset := int(24)
+	offset := int(56)
 
-	// Offset (0) 'Type'
+	// Offset (0) 'TaskId'
 	dst = ssz.WriteOffset(dst, offset)
-	offset += len(t.Type)
+	offset += len(t.TaskId)
 
-	// Offset (1) 'TaskId'
+	// Offset (1) 'PartyId'
 	dst = ssz.WriteOffset(dst, offset)
-	offset += len(t.TaskId)
+	offset += len(t.PartyId)
 
 	// Offset (2) 'IdentityId'
 	dst = ssz.WriteOffset(dst, offset)
 	offset += len(t.IdentityId)
 
-	// Offset (3) 'Content'
+	// Offset (3) 'JobNodeId'
 	dst = ssz.WriteOffset(dst, offset)
-	offset += len(t.Content)
+	offset += len(t.JobNodeId)
 
-	// Field (4) 'CreateAt'
-	dst = ssz.MarshalUint64(dst, t.CreateAt)
+	// Field (4) 'UsedMem'
+	dst = ssz.MarshalUint64(dst, t.UsedMem)
 
-	// Field (0) 'Type'
-	if len(t.Type) > 32 {
+	// Field (5) 'UsedProcessor'
+	dst = ssz.MarshalUint64(dst, t.UsedProcessor)
+
+	// Field (6) 'UsedBandwidth'
+	dst = ssz.MarshalUint64(dst, t.UsedBandwidth)
+
+	// Field (7) 'UsedDuration'
+	dst = ssz.MarshalUint64(dst, t.UsedDuration)
+
+	// Field (8) 'UpdateAt'
+	dst = ssz.MarshalUint64(dst, t.UpdateAt)
+
+	// Field (0) 'TaskId'
+	if len(t.TaskId) > 128 {
 		err = ssz.ErrBytesLength
 		return
 	}
-	dst = append(dst, t.Type...)
+	dst = append(dst, t.TaskId...)
 
-	// Field (1) 'TaskId'
-	if len(t.TaskId) > 128 {
+	// Field (1) 'PartyId'
+	if len(t.PartyId) > 64 {
 		err = ssz.ErrBytesLength
 		return
 	}
-	dst = append(dst, t.TaskId...)
+	dst = append(dst, t.PartyId...)
 
 	// Field (2) 'IdentityId'
 	if len(t.IdentityId) > 1024 {
@@ -2929,37 +3165,37 @@ func (t *TaskEvent) MarshalSSZTo(buf []byte) (dst []byte, err error) {
 	}
 	dst = append(dst, t.IdentityId...)
 
-	// Field (3) 'Content'
-	if len(t.Content) > 2048 {
+	// Field (3) 'JobNodeId'
+	if len(t.JobNodeId) > 1024 {
 		err = ssz.ErrBytesLength
 		return
 	}
-	dst = append(dst, t.Content...)
+	dst = append(dst, t.JobNodeId...)
 
 	return
 }
 
-// UnmarshalSSZ ssz unmarshals the TaskEvent object
-func (t *TaskEvent) UnmarshalSSZ(buf []byte) error {
+// UnmarshalSSZ ssz unmarshals the TaskResourceUsage object
+func (t *TaskResourceUsage) UnmarshalSSZ(buf []byte) error {
 	var err error
 	size := uint64(len(buf))
-	if size < 24 {
+	if size < 56 {
 		return ssz.ErrSize
 	}
 
 	tail := buf
 	var o0, o1, o2, o3 uint64
 
-	// Offset (0) 'Type'
+	// Offset (0) 'TaskId'
 	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
 		return ssz.ErrOffset
 	}
 
-	if o0 < 24 {
+	if o0 < 56 {
 		return ssz.ErrInvalidVariableOffset
 	}
 
-	// Offset (1) 'TaskId'
+	// Offset (1) 'PartyId'
 	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
 		return ssz.ErrOffset
 	}
@@ -2969,36 +3205,48 @@ func (t *TaskEvent) UnmarshalSSZ(buf []byte) error {
 		return ssz.ErrOffset
 	}
 
-	// Offset (3) 'Content'
+	// Offset (3) 'JobNodeId'
 	if o3 = ssz.ReadOffset(buf[12:16]); o3 > size || o2 > o3 {
 		return ssz.ErrOffset
 	}
 
-	// Field (4) 'CreateAt'
-	t.CreateAt = ssz.UnmarshallUint64(buf[16:24])
+	// Field (4) 'UsedMem'
+	t.UsedMem = ssz.UnmarshallUint64(buf[16:24])
 
-	// Field (0) 'Type'
+	// Field (5) 'UsedProcessor'
+	t.UsedProcessor = ssz.UnmarshallUint64(buf[24:32])
+
+	// Field (6) 'UsedBandwidth'
+	t.UsedBandwidth = ssz.UnmarshallUint64(buf[32:40])
+
+	// Field (7) 'UsedDuration'
+	t.UsedDuration = ssz.UnmarshallUint64(buf[40:48])
+
+	// Field (8) 'UpdateAt'
+	t.UpdateAt = ssz.UnmarshallUint64(buf[48:56])
+
+	// Field (0) 'TaskId'
 	{
 		buf = tail[o0:o1]
-		if len(buf) > 32 {
+		if len(buf) > 128 {
 			return ssz.ErrBytesLength
 		}
-		if cap(t.Type) == 0 {
-			t.Type = make([]byte, 0, len(buf))
+		if cap(t.TaskId) == 0 {
+			t.TaskId = make([]byte, 0, len(buf))
 		}
-		t.Type = append(t.Type, buf...)
+		t.TaskId = append(t.TaskId, buf...)
 	}
 
-	// Field (1) 'TaskId'
+	// Field (1) 'PartyId'
 	{
 		buf = tail[o1:o2]
-		if len(buf) > 128 {
+		if len(buf) > 64 {
 			return ssz.ErrBytesLength
 		}
-		if cap(t.TaskId) == 0 {
-			t.TaskId = make([]byte, 0, len(buf))
+		if cap(t.PartyId) == 0 {
+			t.PartyId = make([]byte, 0, len(buf))
 		}
-		t.TaskId = append(t.TaskId, buf...)
+		t.PartyId = append(t.PartyId, buf...)
 	}
 
 	// Field (2) 'IdentityId'
@@ -3013,61 +3261,61 @@ func (t *TaskEvent) UnmarshalSSZ(buf []byte) error {
 		t.IdentityId = append(t.IdentityId, buf...)
 	}
 
-	// Field (3) 'Content'
+	// Field (3) 'JobNodeId'
 	{
 		buf = tail[o3:]
-		if len(buf) > 2048 {
+		if len(buf) > 1024 {
 			return ssz.ErrBytesLength
 		}
-		if cap(t.Content) == 0 {
-			t.Content = make([]byte, 0, len(buf))
+		if cap(t.JobNodeId) == 0 {
+			t.JobNodeId = make([]byte, 0, len(buf))
 		}
-		t.Content = append(t.Content, buf...)
+		t.JobNodeId = append(t.JobNodeId, buf...)
 	}
 	return err
 }
 
-// SizeSSZ returns the ssz encoded size in bytes for the TaskEvent object
-func (t *TaskEvent) SizeSSZ() (size int) {
-	size = 24
-
-	// Field (0) 'Type'
-	size += len(t.Type)
+// SizeSSZ returns the ssz encoded size in bytes for the TaskResourceUsage object
+func (t *TaskResourceUsage) SizeSSZ() (size int) {
+	size = 56
 
-	// Field (1) 'TaskId'
+	// Field (0) 'TaskId'
 	size += len(t.TaskId)
 
+	// Field (1) 'PartyId'
+	size += len(t.PartyId)
+
 	// Field (2) 'IdentityId'
 	size += len(t.IdentityId)
 
-	// Field (3) 'Content'
-	size += len(t.Content)
+	// Field (3) 'JobNodeId'
+	size += len(t.JobNodeId)
 
 	return
 }
 
-// HashTreeRoot ssz hashes the TaskEvent object
-func (t *TaskEvent) HashTreeRoot() ([32]byte, error) {
+// HashTreeRoot ssz hashes the TaskResourceUsage object
+func (t *TaskResourceUsage) HashTreeRoot() ([32]byte, error) {
 	return ssz.HashWithDefaultHasher(t)
 }
 
-// HashTreeRootWith ssz hashes the TaskEvent object with a hasher
-func (t *TaskEvent) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+// HashTreeRootWith ssz hashes the TaskResourceUsage object with a hasher
+func (t *TaskResourceUsage) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	indx := hh.Index()
 
-	// Field (0) 'Type'
-	if len(t.Type) > 32 {
+	// Field (0) 'TaskId'
+	if len(t.TaskId) > 128 {
 		err = ssz.ErrBytesLength
 		return
 	}
-	hh.PutBytes(t.Type)
+	hh.PutBytes(t.TaskId)
 
-	// Field (1) 'TaskId'
-	if len(t.TaskId) > 128 {
+	// Field (1) 'PartyId'
+	if len(t.PartyId) > 64 {
 		err = ssz.ErrBytesLength
 		return
 	}
-	hh.PutBytes(t.TaskId)
+	hh.PutBytes(t.PartyId)
 
 	// Field (2) 'IdentityId'
 	if len(t.IdentityId) > 1024 {
@@ -3076,15 +3324,1127 @@ func (t *TaskEvent) HashTreeRootWith(hh *ssz.Hasher) (err error) {
 	}
 	hh.PutBytes(t.IdentityId)
 
-	// Field (3) 'Content'
-	if len(t.Content) > 2048 {
+	// Field (3) 'JobNodeId'
+	if len(t.JobNodeId) > 1024 {
 		err = ssz.ErrBytesLength
 		return
 	}
-	hh.PutBytes(t.Content)
+	hh.PutBytes(t.JobNodeId)
 
-	// Field (4) 'CreateAt'
-	hh.PutUint64(t.CreateAt)
+	// Field (4) 'UsedMem'
+	hh.PutUint64(t.UsedMem)
+
+	// Field (5) 'UsedProcessor'
+	hh.PutUint64(t.UsedProcessor)
+
+	// Field (6) 'UsedBandwidth'
+	hh.PutUint64(t.UsedBandwidth)
+
+	// Field (7) 'UsedDuration'
+	hh.PutUint64(t.UsedDuration)
+
+	// Field (8) 'UpdateAt'
+	hh.PutUint64(t.UpdateAt)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// MarshalSSZ ssz marshals the TaskEvent object
+func (t *TaskEvent) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(t)
+}
+
+// MarshalSSZTo ssz marshals the TaskEvent object to a target array
+func (t *TaskEvent) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(24)
+
+	// Offset (0) 'Type'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(t.Type)
+
+	// Offset (1) 'TaskId'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(t.TaskId)
+
+	// Offset (2) 'IdentityId'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(t.IdentityId)
+
+	// Offset (3) 'Content'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(t.Content)
+
+	// Field (4) 'CreateAt'
+	dst = ssz.MarshalUint64(dst, t.CreateAt)
+
+	// Field (0) 'Type'
+	if len(t.Type) > 32 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	dst = append(dst, t.Type...)
+
+	// Field (1) 'TaskId'
+	if len(t.TaskId) > 128 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	dst = append(dst, t.TaskId...)
+
+	// Field (2) 'IdentityId'
+	if len(t.IdentityId) > 1024 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	dst = append(dst, t.IdentityId...)
+
+	// Field (3) 'Content'
+	if len(t.Content) > 2048 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	dst = append(dst, t.Content...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the TaskEvent object
+func (t *TaskEvent) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 24 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0, o1, o2, o3 uint64
+
+	// Offset (0) 'Type'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if o0 < 24 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Offset (1) 'TaskId'
+	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (2) 'IdentityId'
+	if o2 = ssz.ReadOffset(buf[8:12]); o2 > size || o1 > o2 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (3) 'Content'
+	if o3 = ssz.ReadOffset(buf[12:16]); o3 > size || o2 > o3 {
+		return ssz.ErrOffset
+	}
+
+	// Field (4) 'CreateAt'
+	t.CreateAt = ssz.UnmarshallUint64(buf[16:24])
+
+	// Field (0) 'Type'
+	{
+		buf = tail[o0:o1]
+		if len(buf) > 32 {
+			return ssz.ErrBytesLength
+		}
+		if cap(t.Type) == 0 {
+			t.Type = make([]byte, 0, len(buf))
+		}
+		t.Type = append(t.Type, buf...)
+	}
+
+	// Field (1) 'TaskId'
+	{
+		buf = tail[o1:o2]
+		if len(buf) > 128 {
+			return ssz.ErrBytesLength
+		}
+		if cap(t.TaskId) == 0 {
+			t.TaskId = make([]byte, 0, len(buf))
+		}
+		t.TaskId = append(t.TaskId, buf...)
+	}
+
+	// Field (2) 'IdentityId'
+	{
+		buf = tail[o2:o3]
+		if len(buf) > 1024 {
+			return ssz.ErrBytesLength
+		}
+		if cap(t.IdentityId) == 0 {
+			t.IdentityId = make([]byte, 0, len(buf))
+		}
+		t.IdentityId = append(t.IdentityId, buf...)
+	}
+
+	// Field (3) 'Content'
+	{
+		buf = tail[o3:]
+		if len(buf) > 2048 {
+			return ssz.ErrBytesLength
+		}
+		if cap(t.Content) == 0 {
+			t.Content = make([]byte, 0, len(buf))
+		}
+		t.Content = append(t.Content, buf...)
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the TaskEvent object
+func (t *TaskEvent) SizeSSZ() (size int) {
+	size = 24
+
+	// Field (0) 'Type'
+	size += len(t.Type)
+
+	// Field (1) 'TaskId'
+	size += len(t.TaskId)
+
+	// Field (2) 'IdentityId'
+	size += len(t.IdentityId)
+
+	// Field (3) 'Content'
+	size += len(t.Content)
+
+	return
+}
+
+// HashTreeRoot ssz hashes the TaskEvent object
+func (t *TaskEvent) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(t)
+}
+
+// HashTreeRootWith ssz hashes the TaskEvent object with a hasher
+func (t *TaskEvent) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'Type'
+	if len(t.Type) > 32 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	hh.PutBytes(t.Type)
+
+	// Field (1) 'TaskId'
+	if len(t.TaskId) > 128 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	hh.PutBytes(t.TaskId)
+
+	// Field (2) 'IdentityId'
+	if len(t.IdentityId) > 1024 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	hh.PutBytes(t.IdentityId)
+
+	// Field (3) 'Content'
+	if len(t.Content) > 2048 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	hh.PutBytes(t.Content)
+
+	// Field (4) 'CreateAt'
+	hh.PutUint64(t.CreateAt)
+
+	hh.Merkleize(indx)
+	return
+}
+
+
+// MarshalSSZ ssz marshals the TaskCancelMsg object
+func (t *TaskCancelMsg) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(t)
+}
+
+// MarshalSSZTo ssz marshals the TaskCancelMsg object to a target array
+func (t *TaskCancelMsg) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(32)
+
+	// Offset (0) 'ProposalId'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(t.ProposalId)
+
+	// Offset (1) 'TaskRole'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(t.TaskRole)
+
+	// Offset (2) 'TaskPartyId'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(t.TaskPartyId)
+
+	// Offset (3) 'TaskId'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(t.TaskId)
+
+	// Offset (4) 'Owner'
+	dst = ssz.WriteOffset(dst, offset)
+	if t.Owner == nil {
+		t.Owner = new(TaskOrganizationIdentityInfo)
+	}
+	offset += t.Owner.SizeSSZ()
+
+	// Field (5) 'CreateAt'
+	dst = ssz.MarshalUint64(dst, t.CreateAt)
+
+	// Offset (6) 'Sign'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(t.Sign)
+
+	// Field (0) 'ProposalId'
+	if len(t.ProposalId) > 1024 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	dst = append(dst, t.ProposalId...)
+
+	// Field (1) 'TaskRole'
+	if len(t.TaskRole) > 32 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	dst = append(dst, t.TaskRole...)
+
+	// Field (2) 'TaskPartyId'
+	if len(t.TaskPartyId) > 64 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	dst = append(dst, t.TaskPartyId...)
+
+	// Field (3) 'TaskId'
+	if len(t.TaskId) > 128 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	dst = append(dst, t.TaskId...)
+
+	// Field (4) 'Owner'
+	if dst, err = t.Owner.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (6) 'Sign'
+	if len(t.Sign) > 1024 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	dst = append(dst, t.Sign...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the TaskCancelMsg object
+func (t *TaskCancelMsg) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 32 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0, o1, o2, o3, o4, o6 uint64
+
+	// Offset (0) 'ProposalId'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if o0 < 32 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Offset (1) 'TaskRole'
+	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (2) 'TaskPartyId'
+	if o2 = ssz.ReadOffset(buf[8:12]); o2 > size || o1 > o2 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (3) 'TaskId'
+	if o3 = ssz.ReadOffset(buf[12:16]); o3 > size || o2 > o3 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (4) 'Owner'
+	if o4 = ssz.ReadOffset(buf[16:20]); o4 > size || o3 > o4 {
+		return ssz.ErrOffset
+	}
+
+	// Field (5) 'CreateAt'
+	t.CreateAt = ssz.UnmarshallUint64(buf[20:28])
+
+	// Offset (6) 'Sign'
+	if o6 = ssz.ReadOffset(buf[28:32]); o6 > size || o4 > o6 {
+		return ssz.ErrOffset
+	}
+
+	// Field (0) 'ProposalId'
+	{
+		buf = tail[o0:o1]
+		if len(buf) > 1024 {
+			return ssz.ErrBytesLength
+		}
+		if cap(t.ProposalId) == 0 {
+			t.ProposalId = make([]byte, 0, len(buf))
+		}
+		t.ProposalId = append(t.ProposalId, buf...)
+	}
+
+	// Field (1) 'TaskRole'
+	{
+		buf = tail[o1:o2]
+		if len(buf) > 32 {
+			return ssz.ErrBytesLength
+		}
+		if cap(t.TaskRole) == 0 {
+			t.TaskRole = make([]byte, 0, len(buf))
+		}
+		t.TaskRole = append(t.TaskRole, buf...)
+	}
+
+	// Field (2) 'TaskPartyId'
+	{
+		buf = tail[o2:o3]
+		if len(buf) > 64 {
+			return ssz.ErrBytesLength
+		}
+		if cap(t.TaskPartyId) == 0 {
+			t.TaskPartyId = make([]byte, 0, len(buf))
+		}
+		t.TaskPartyId = append(t.TaskPartyId, buf...)
+	}
+
+	// Field (3) 'TaskId'
+	{
+		buf = tail[o3:o4]
+		if len(buf) > 128 {
+			return ssz.ErrBytesLength
+		}
+		if cap(t.TaskId) == 0 {
+			t.TaskId = make([]byte, 0, len(buf))
+		}
+		t.TaskId = append(t.TaskId, buf...)
+	}
+
+	// Field (4) 'Owner'
+	{
+		buf = tail[o4:o6]
+		if t.Owner == nil {
+			t.Owner = new(TaskOrganizationIdentityInfo)
+		}
+		if err = t.Owner.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+
+	// Field (6) 'Sign'
+	{
+		buf = tail[o6:]
+		if len(buf) > 1024 {
+			return ssz.ErrBytesLength
+		}
+		if cap(t.Sign) == 0 {
+			t.Sign = make([]byte, 0, len(buf))
+		}
+		t.Sign = append(t.Sign, buf...)
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the TaskCancelMsg object
+func (t *TaskCancelMsg) SizeSSZ() (size int) {
+	size = 32
+
+	// Field (0) 'ProposalId'
+	size += len(t.ProposalId)
+
+	// Field (1) 'TaskRole'
+	size += len(t.TaskRole)
+
+	// Field (2) 'TaskPartyId'
+	size += len(t.TaskPartyId)
+
+	// Field (3) 'TaskId'
+	size += len(t.TaskId)
+
+	// Field (4) 'Owner'
+	if t.Owner == nil {
+		t.Owner = new(TaskOrganizationIdentityInfo)
+	}
+	size += t.Owner.SizeSSZ()
+
+	// Field (6) 'Sign'
+	size += len(t.Sign)
+
+	return
+}
+
+// HashTreeRoot ssz hashes the TaskCancelMsg object
+func (t *TaskCancelMsg) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(t)
+}
+
+// HashTreeRootWith ssz hashes the TaskCancelMsg object with a hasher
+func (t *TaskCancelMsg) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'ProposalId'
+	if len(t.ProposalId) > 1024 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	hh.PutBytes(t.ProposalId)
+
+	// Field (1) 'TaskRole'
+	if len(t.TaskRole) > 32 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	hh.PutBytes(t.TaskRole)
+
+	// Field (2) 'TaskPartyId'
+	if len(t.TaskPartyId) > 64 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	hh.PutBytes(t.TaskPartyId)
+
+	// Field (3) 'TaskId'
+	if len(t.TaskId) > 128 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	hh.PutBytes(t.TaskId)
+
+	// Field (4) 'Owner'
+	if err = t.Owner.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (5) 'CreateAt'
+	hh.PutUint64(t.CreateAt)
+
+	// Field (6) 'Sign'
+	if len(t.Sign) > 1024 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	hh.PutBytes(t.Sign)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// MarshalSSZ ssz marshals the TaskProgressMsg object
+func (t *TaskProgressMsg) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(t)
+}
+
+// MarshalSSZTo ssz marshals the TaskProgressMsg object to a target array
+func (t *TaskProgressMsg) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(32)
+
+	// Offset (0) 'ProposalId'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(t.ProposalId)
+
+	// Offset (1) 'TaskRole'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(t.TaskRole)
+
+	// Offset (2) 'TaskId'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(t.TaskId)
+
+	// Offset (3) 'Owner'
+	dst = ssz.WriteOffset(dst, offset)
+	if t.Owner == nil {
+		t.Owner = new(TaskOrganizationIdentityInfo)
+	}
+	offset += t.Owner.SizeSSZ()
+
+	// Offset (4) 'ProgressList'
+	dst = ssz.WriteOffset(dst, offset)
+	for ii := 0; ii < len(t.ProgressList); ii++ {
+		offset += 4
+		offset += t.ProgressList[ii].SizeSSZ()
+	}
+
+	// Field (5) 'CreateAt'
+	dst = ssz.MarshalUint64(dst, t.CreateAt)
+
+	// Offset (6) 'Sign'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(t.Sign)
+
+	// Field (0) 'ProposalId'
+	if len(t.ProposalId) > 1024 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	dst = append(dst, t.ProposalId...)
+
+	// Field (1) 'TaskRole'
+	if len(t.TaskRole) > 32 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	dst = append(dst, t.TaskRole...)
+
+	// Field (2) 'TaskId'
+	if len(t.TaskId) > 128 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	dst = append(dst, t.TaskId...)
+
+	// Field (3) 'Owner'
+	if dst, err = t.Owner.MarshalSSZTo(dst); err != nil {
+		return
+	}
+
+	// Field (4) 'ProgressList'
+	if len(t.ProgressList) > 1024 {
+		err = ssz.ErrListTooBig
+		return
+	}
+	{
+		offset = 4 * len(t.ProgressList)
+		for ii := 0; ii < len(t.ProgressList); ii++ {
+			dst = ssz.WriteOffset(dst, offset)
+			offset += t.ProgressList[ii].SizeSSZ()
+		}
+	}
+	for ii := 0; ii < len(t.ProgressList); ii++ {
+		if dst, err = t.ProgressList[ii].MarshalSSZTo(dst); err != nil {
+			return
+		}
+	}
+
+	// Field (6) 'Sign'
+	if len(t.Sign) > 1024 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	dst = append(dst, t.Sign...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the TaskProgressMsg object
+func (t *TaskProgressMsg) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 32 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0, o1, o2, o3, o4, o6 uint64
+
+	// Offset (0) 'ProposalId'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if o0 < 32 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Offset (1) 'TaskRole'
+	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (2) 'TaskId'
+	if o2 = ssz.ReadOffset(buf[8:12]); o2 > size || o1 > o2 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (3) 'Owner'
+	if o3 = ssz.ReadOffset(buf[12:16]); o3 > size || o2 > o3 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (4) 'ProgressList'
+	if o4 = ssz.ReadOffset(buf[16:20]); o4 > size || o3 > o4 {
+		return ssz.ErrOffset
+	}
+
+	// Field (5) 'CreateAt'
+	t.CreateAt = ssz.UnmarshallUint64(buf[20:28])
+
+	// Offset (6) 'Sign'
+	if o6 = ssz.ReadOffset(buf[28:32]); o6 > size || o4 > o6 {
+		return ssz.ErrOffset
+	}
+
+	// Field (0) 'ProposalId'
+	{
+		buf = tail[o0:o1]
+		if len(buf) > 1024 {
+			return ssz.ErrBytesLength
+		}
+		if cap(t.ProposalId) == 0 {
+			t.ProposalId = make([]byte, 0, len(buf))
+		}
+		t.ProposalId = append(t.ProposalId, buf...)
+	}
+
+	// Field (1) 'TaskRole'
+	{
+		buf = tail[o1:o2]
+		if len(buf) > 32 {
+			return ssz.ErrBytesLength
+		}
+		if cap(t.TaskRole) == 0 {
+			t.TaskRole = make([]byte, 0, len(buf))
+		}
+		t.TaskRole = append(t.TaskRole, buf...)
+	}
+
+	// Field (2) 'TaskId'
+	{
+		buf = tail[o2:o3]
+		if len(buf) > 128 {
+			return ssz.ErrBytesLength
+		}
+		if cap(t.TaskId) == 0 {
+			t.TaskId = make([]byte, 0, len(buf))
+		}
+		t.TaskId = append(t.TaskId, buf...)
+	}
+
+	// Field (3) 'Owner'
+	{
+		buf = tail[o3:o4]
+		if t.Owner == nil {
+			t.Owner = new(TaskOrganizationIdentityInfo)
+		}
+		if err = t.Owner.UnmarshalSSZ(buf); err != nil {
+			return err
+		}
+	}
+
+	// Field (4) 'ProgressList'
+	{
+		buf = tail[o4:o6]
+		num, err := ssz.DecodeDynamicLength(buf, 1024)
+		if err != nil {
+			return err
+		}
+		t.ProgressList = make([]*TaskProgress, num)
+		err = ssz.UnmarshalDynamic(buf, num, func(indx int, buf []byte) (err error) {
+			if t.ProgressList[indx] == nil {
+				t.ProgressList[indx] = new(TaskProgress)
+			}
+			if err = t.ProgressList[indx].UnmarshalSSZ(buf); err != nil {
+				return err
+			}
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// Field (6) 'Sign'
+	{
+		buf = tail[o6:]
+		if len(buf) > 1024 {
+			return ssz.ErrBytesLength
+		}
+		if cap(t.Sign) == 0 {
+			t.Sign = make([]byte, 0, len(buf))
+		}
+		t.Sign = append(t.Sign, buf...)
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the TaskProgressMsg object
+func (t *TaskProgressMsg) SizeSSZ() (size int) {
+	size = 32
+
+	// Field (0) 'ProposalId'
+	size += len(t.ProposalId)
+
+	// Field (1) 'TaskRole'
+	size += len(t.TaskRole)
+
+	// Field (2) 'TaskId'
+	size += len(t.TaskId)
+
+	// Field (3) 'Owner'
+	if t.Owner == nil {
+		t.Owner = new(TaskOrganizationIdentityInfo)
+	}
+	size += t.Owner.SizeSSZ()
+
+	// Field (4) 'ProgressList'
+	for ii := 0; ii < len(t.ProgressList); ii++ {
+		size += 4
+		size += t.ProgressList[ii].SizeSSZ()
+	}
+
+	// Field (6) 'Sign'
+	size += len(t.Sign)
+
+	return
+}
+
+// HashTreeRoot ssz hashes the TaskProgressMsg object
+func (t *TaskProgressMsg) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(t)
+}
+
+// HashTreeRootWith ssz hashes the TaskProgressMsg object with a hasher
+func (t *TaskProgressMsg) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'ProposalId'
+	if len(t.ProposalId) > 1024 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	hh.PutBytes(t.ProposalId)
+
+	// Field (1) 'TaskRole'
+	if len(t.TaskRole) > 32 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	hh.PutBytes(t.TaskRole)
+
+	// Field (2) 'TaskId'
+	if len(t.TaskId) > 128 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	hh.PutBytes(t.TaskId)
+
+	// Field (3) 'Owner'
+	if err = t.Owner.HashTreeRootWith(hh); err != nil {
+		return
+	}
+
+	// Field (4) 'ProgressList'
+	{
+		subIndx := hh.Index()
+		num := uint64(len(t.ProgressList))
+		if num > 1024 {
+			err = ssz.ErrIncorrectListSize
+			return
+		}
+		for i := uint64(0); i < num; i++ {
+			if err = t.ProgressList[i].HashTreeRootWith(hh); err != nil {
+				return
+			}
+		}
+		hh.MerkleizeWithMixin(subIndx, num, 1024)
+	}
+
+	// Field (5) 'CreateAt'
+	hh.PutUint64(t.CreateAt)
+
+	// Field (6) 'Sign'
+	if len(t.Sign) > 1024 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	hh.PutBytes(t.Sign)
+
+	hh.Merkleize(indx)
+	return
+}
+
+// MarshalSSZ ssz marshals the TaskProgress object
+func (t *TaskProgress) MarshalSSZ() ([]byte, error) {
+	return ssz.MarshalSSZ(t)
+}
+
+// MarshalSSZTo ssz marshals the TaskProgress object to a target array
+func (t *TaskProgress) MarshalSSZTo(buf []byte) (dst []byte, err error) {
+	dst = buf
+	offset := int(52)
+
+	// Offset (0) 'TaskId'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(t.TaskId)
+
+	// Offset (1) 'PartyId'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(t.PartyId)
+
+	// Offset (2) 'IdentityId'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(t.IdentityId)
+
+	// Offset (3) 'JobNodeId'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(t.JobNodeId)
+
+	// Offset (4) 'Phase'
+	dst = ssz.WriteOffset(dst, offset)
+	offset += len(t.Phase)
+
+	// Field (5) 'Percent'
+	dst = ssz.MarshalUint64(dst, t.Percent)
+
+	// Field (6) 'ElapsedTime'
+	dst = ssz.MarshalUint64(dst, t.ElapsedTime)
+
+	// Field (7) 'RemainTime'
+	dst = ssz.MarshalUint64(dst, t.RemainTime)
+
+	// Field (8) 'UpdateAt'
+	dst = ssz.MarshalUint64(dst, t.UpdateAt)
+
+	// Field (0) 'TaskId'
+	if len(t.TaskId) > 128 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	dst = append(dst, t.TaskId...)
+
+	// Field (1) 'PartyId'
+	if len(t.PartyId) > 64 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	dst = append(dst, t.PartyId...)
+
+	// Field (2) 'IdentityId'
+	if len(t.IdentityId) > 1024 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	dst = append(dst, t.IdentityId...)
+
+	// Field (3) 'JobNodeId'
+	if len(t.JobNodeId) > 1024 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	dst = append(dst, t.JobNodeId...)
+
+	// Field (4) 'Phase'
+	if len(t.Phase) > 128 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	dst = append(dst, t.Phase...)
+
+	return
+}
+
+// UnmarshalSSZ ssz unmarshals the TaskProgress object
+func (t *TaskProgress) UnmarshalSSZ(buf []byte) error {
+	var err error
+	size := uint64(len(buf))
+	if size < 52 {
+		return ssz.ErrSize
+	}
+
+	tail := buf
+	var o0, o1, o2, o3, o4 uint64
+
+	// Offset (0) 'TaskId'
+	if o0 = ssz.ReadOffset(buf[0:4]); o0 > size {
+		return ssz.ErrOffset
+	}
+
+	if o0 < 52 {
+		return ssz.ErrInvalidVariableOffset
+	}
+
+	// Offset (1) 'PartyId'
+	if o1 = ssz.ReadOffset(buf[4:8]); o1 > size || o0 > o1 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (2) 'IdentityId'
+	if o2 = ssz.ReadOffset(buf[8:12]); o2 > size || o1 > o2 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (3) 'JobNodeId'
+	if o3 = ssz.ReadOffset(buf[12:16]); o3 > size || o2 > o3 {
+		return ssz.ErrOffset
+	}
+
+	// Offset (4) 'Phase'
+	if o4 = ssz.ReadOffset(buf[16:20]); o4 > size || o3 > o4 {
+		return ssz.ErrOffset
+	}
+
+	// Field (5) 'Percent'
+	t.Percent = ssz.UnmarshallUint64(buf[20:28])
+
+	// Field (6) 'ElapsedTime'
+	t.ElapsedTime = ssz.UnmarshallUint64(buf[28:36])
+
+	// Field (7) 'RemainTime'
+	t.RemainTime = ssz.UnmarshallUint64(buf[36:44])
+
+	// Field (8) 'UpdateAt'
+	t.UpdateAt = ssz.UnmarshallUint64(buf[44:52])
+
+	// Field (0) 'TaskId'
+	{
+		buf = tail[o0:o1]
+		if len(buf) > 128 {
+			return ssz.ErrBytesLength
+		}
+		if cap(t.TaskId) == 0 {
+			t.TaskId = make([]byte, 0, len(buf))
+		}
+		t.TaskId = append(t.TaskId, buf...)
+	}
+
+	// Field (1) 'PartyId'
+	{
+		buf = tail[o1:o2]
+		if len(buf) > 64 {
+			return ssz.ErrBytesLength
+		}
+		if cap(t.PartyId) == 0 {
+			t.PartyId = make([]byte, 0, len(buf))
+		}
+		t.PartyId = append(t.PartyId, buf...)
+	}
+
+	// Field (2) 'IdentityId'
+	{
+		buf = tail[o2:o3]
+		if len(buf) > 1024 {
+			return ssz.ErrBytesLength
+		}
+		if cap(t.IdentityId) == 0 {
+			t.IdentityId = make([]byte, 0, len(buf))
+		}
+		t.IdentityId = append(t.IdentityId, buf...)
+	}
+
+	// Field (3) 'JobNodeId'
+	{
+		buf = tail[o3:o4]
+		if len(buf) > 1024 {
+			return ssz.ErrBytesLength
+		}
+		if cap(t.JobNodeId) == 0 {
+			t.JobNodeId = make([]byte, 0, len(buf))
+		}
+		t.JobNodeId = append(t.JobNodeId, buf...)
+	}
+
+	// Field (4) 'Phase'
+	{
+		buf = tail[o4:]
+		if len(buf) > 128 {
+			return ssz.ErrBytesLength
+		}
+		if cap(t.Phase) == 0 {
+			t.Phase = make([]byte, 0, len(buf))
+		}
+		t.Phase = append(t.Phase, buf...)
+	}
+	return err
+}
+
+// SizeSSZ returns the ssz encoded size in bytes for the TaskProgress object
+func (t *TaskProgress) SizeSSZ() (size int) {
+	size = 52
+
+	// Field (0) 'TaskId'
+	size += len(t.TaskId)
+
+	// Field (1) 'PartyId'
+	size += len(t.PartyId)
+
+	// Field (2) 'IdentityId'
+	size += len(t.IdentityId)
+
+	// Field (3) 'JobNodeId'
+	size += len(t.JobNodeId)
+
+	// Field (4) 'Phase'
+	size += len(t.Phase)
+
+	return
+}
+
+// HashTreeRoot ssz hashes the TaskProgress object
+func (t *TaskProgress) HashTreeRoot() ([32]byte, error) {
+	return ssz.HashWithDefaultHasher(t)
+}
+
+// HashTreeRootWith ssz hashes the TaskProgress object with a hasher
+func (t *TaskProgress) HashTreeRootWith(hh *ssz.Hasher) (err error) {
+	indx := hh.Index()
+
+	// Field (0) 'TaskId'
+	if len(t.TaskId) > 128 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	hh.PutBytes(t.TaskId)
+
+	// Field (1) 'PartyId'
+	if len(t.PartyId) > 64 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	hh.PutBytes(t.PartyId)
+
+	// Field (2) 'IdentityId'
+	if len(t.IdentityId) > 1024 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	hh.PutBytes(t.IdentityId)
+
+	// Field (3) 'JobNodeId'
+	if len(t.JobNodeId) > 1024 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	hh.PutBytes(t.JobNodeId)
+
+	// Field (4) 'Phase'
+	if len(t.Phase) > 128 {
+		err = ssz.ErrBytesLength
+		return
+	}
+	hh.PutBytes(t.Phase)
+
+	// Field (5) 'Percent'
+	hh.PutUint64(t.Percent)
+
+	// Field (6) 'ElapsedTime'
+	hh.PutUint64(t.ElapsedTime)
+
+	// Field (7) 'RemainTime'
+	hh.PutUint64(t.RemainTime)
+
+	// Field (8) 'UpdateAt'
+	hh.PutUint64(t.UpdateAt)
 
 	hh.Merkleize(indx)
 	return
@@ -33,6 +33,13 @@ type PrepareMsg struct {
 	TaskInfo             []byte                        `protobuf:"bytes,5,opt,name=taskInfo,proto3" json:"taskInfo,omitempty" ssz-max:"16777216"`
 	CreateAt             uint64                        `protobuf:"varint,6,opt,name=create_at,json=createAt,proto3" json:"create_at,omitempty"`
 	Sign                 []byte                        `protobuf:"bytes,7,opt,name=sign,proto3" json:"sign,omitempty" ssz-max:"1024"`
+	ElectionSeed         []byte                        `protobuf:"bytes,8,opt,name=election_seed,json=electionSeed,proto3" json:"election_seed,omitempty" ssz-max:"32"`
+	ElectionProof        []byte                        `protobuf:"bytes,9,opt,name=election_proof,json=electionProof,proto3" json:"election_proof,omitempty" ssz-max:"65"`
+	PrepareVotingTimeout uint64                        `protobuf:"varint,10,opt,name=prepare_voting_timeout,json=prepareVotingTimeout,proto3" json:"prepare_voting_timeout,omitempty"`
+	ConfirmVotingTimeout uint64                        `protobuf:"varint,11,opt,name=confirm_voting_timeout,json=confirmVotingTimeout,proto3" json:"confirm_voting_timeout,omitempty"`
+	CommitEndingTimeout  uint64                        `protobuf:"varint,12,opt,name=commit_ending_timeout,json=commitEndingTimeout,proto3" json:"commit_ending_timeout,omitempty"`
+	ProposalDeadline     uint64                        `protobuf:"varint,13,opt,name=proposal_deadline,json=proposalDeadline,proto3" json:"proposal_deadline,omitempty"`
+	ElectionPowersSign   []byte                        `protobuf:"bytes,14,opt,name=election_powers_sign,json=electionPowersSign,proto3" json:"election_powers_sign,omitempty" ssz-max:"65"`
 	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
 	XXX_unrecognized     []byte                        `json:"-"`
 	XXX_sizecache        int32                         `json:"-"`
@@ -120,6 +127,55 @@ func (m *PrepareMsg) GetSign() []byte {
 	return nil
 }
 
+func (m *PrepareMsg) GetElectionSeed() []byte {
+	if m != nil {
+		return m.ElectionSeed
+	}
+	return nil
+}
+
+func (m *PrepareMsg) GetElectionProof() []byte {
+	if m != nil {
+		return m.ElectionProof
+	}
+	return nil
+}
+
+func (m *PrepareMsg) GetPrepareVotingTimeout() uint64 {
+	if m != nil {
+		return m.PrepareVotingTimeout
+	}
+	return 0
+}
+
+func (m *PrepareMsg) GetConfirmVotingTimeout() uint64 {
+	if m != nil {
+		return m.ConfirmVotingTimeout
+	}
+	return 0
+}
+
+func (m *PrepareMsg) GetCommitEndingTimeout() uint64 {
+	if m != nil {
+		return m.CommitEndingTimeout
+	}
+	return 0
+}
+
+func (m *PrepareMsg) GetProposalDeadline() uint64 {
+	if m != nil {
+		return m.ProposalDeadline
+	}
+	return 0
+}
+
+func (m *PrepareMsg) GetElectionPowersSign() []byte {
+	if m != nil {
+		return m.ElectionPowersSign
+	}
+	return nil
+}
+
 // 2pc prepareVote
 type PrepareVote struct {
 	ProposalId           []byte                        `protobuf:"bytes,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty" ssz-max:"1024"`
@@ -566,9 +622,10 @@ type TaskResultMsg struct {
 	TaskRole             []byte                        `protobuf:"bytes,2,opt,name=task_role,json=taskRole,proto3" json:"task_role,omitempty" ssz-max:"32"`
 	TaskId               []byte                        `protobuf:"bytes,3,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty" ssz-max:"128"`
 	Owner                *TaskOrganizationIdentityInfo `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
-	TaskEventList        []*TaskEvent                  `protobuf:"bytes,5,rep,name=task_event_list,json=taskEventList,proto3" json:"task_event_list,omitempty"`
+	TaskEventList        []*TaskEvent                  `protobuf:"bytes,5,rep,name=task_event_list,json=taskEventList,proto3" json:"task_event_list,omitempty" ssz-max:"16777216"`
 	CreateAt             uint64                        `protobuf:"varint,6,opt,name=create_at,json=createAt,proto3" json:"create_at,omitempty"`
 	Sign                 []byte                        `protobuf:"bytes,7,opt,name=sign,proto3" json:"sign,omitempty" ssz-max:"1024"`
+	ResourceUsageList    []*TaskResourceUsage          `protobuf:"bytes,8,rep,name=resource_usage_list,json=resourceUsageList,proto3" json:"resource_usage_list,omitempty" ssz-max:"1024"`
 	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
 	XXX_unrecognized     []byte                        `json:"-"`
 	XXX_sizecache        int32                         `json:"-"`
@@ -656,6 +713,205 @@ func (m *TaskResultMsg) GetSign() []byte {
 	return nil
 }
 
+func (m *TaskResultMsg) GetResourceUsageList() []*TaskResourceUsage {
+	if m != nil {
+		return m.ResourceUsageList
+	}
+	return nil
+}
+
+// 发起方通知 各参与方 取消某个task (中断共识中的提案 或 终止执行中的任务)
+type TaskCancelMsg struct {
+	ProposalId           []byte                        `protobuf:"bytes,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty" ssz-max:"1024"`
+	TaskRole             []byte                        `protobuf:"bytes,2,opt,name=task_role,json=taskRole,proto3" json:"task_role,omitempty" ssz-max:"32"`
+	TaskPartyId          []byte                        `protobuf:"bytes,3,opt,name=task_party_id,json=taskPartyId,proto3" json:"task_party_id,omitempty" ssz-max:"64"`
+	TaskId               []byte                        `protobuf:"bytes,4,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty" ssz-max:"128"`
+	Owner                *TaskOrganizationIdentityInfo `protobuf:"bytes,5,opt,name=owner,proto3" json:"owner,omitempty"`
+	CreateAt             uint64                        `protobuf:"varint,6,opt,name=create_at,json=createAt,proto3" json:"create_at,omitempty"`
+	Sign                 []byte                        `protobuf:"bytes,7,opt,name=sign,proto3" json:"sign,omitempty" ssz-max:"1024"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *TaskCancelMsg) Reset()         { *m = TaskCancelMsg{} }
+func (m *TaskCancelMsg) String() string { return proto.CompactTextString(m) }
+func (*TaskCancelMsg) ProtoMessage()    {}
+func (*TaskCancelMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a59cdf46cb297048, []int{7}
+}
+func (m *TaskCancelMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskCancelMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskCancelMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TaskCancelMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskCancelMsg.Merge(m, src)
+}
+func (m *TaskCancelMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskCancelMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskCancelMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskCancelMsg proto.InternalMessageInfo
+
+func (m *TaskCancelMsg) GetProposalId() []byte {
+	if m != nil {
+		return m.ProposalId
+	}
+	return nil
+}
+
+func (m *TaskCancelMsg) GetTaskRole() []byte {
+	if m != nil {
+		return m.TaskRole
+	}
+	return nil
+}
+
+func (m *TaskCancelMsg) GetTaskPartyId() []byte {
+	if m != nil {
+		return m.TaskPartyId
+	}
+	return nil
+}
+
+func (m *TaskCancelMsg) GetTaskId() []byte {
+	if m != nil {
+		return m.TaskId
+	}
+	return nil
+}
+
+func (m *TaskCancelMsg) GetOwner() *TaskOrganizationIdentityInfo {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *TaskCancelMsg) GetCreateAt() uint64 {
+	if m != nil {
+		return m.CreateAt
+	}
+	return 0
+}
+
+func (m *TaskCancelMsg) GetSign() []byte {
+	if m != nil {
+		return m.Sign
+	}
+	return nil
+}
+
+// 参与方 定期向发起方 上报任务在本方的执行进度
+type TaskProgressMsg struct {
+	ProposalId           []byte                        `protobuf:"bytes,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty" ssz-max:"1024"`
+	TaskRole             []byte                        `protobuf:"bytes,2,opt,name=task_role,json=taskRole,proto3" json:"task_role,omitempty" ssz-max:"32"`
+	TaskId               []byte                        `protobuf:"bytes,3,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty" ssz-max:"128"`
+	Owner                *TaskOrganizationIdentityInfo `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`
+	ProgressList         []*TaskProgress               `protobuf:"bytes,5,rep,name=progress_list,json=progressList,proto3" json:"progress_list,omitempty" ssz-max:"1024"`
+	CreateAt             uint64                        `protobuf:"varint,6,opt,name=create_at,json=createAt,proto3" json:"create_at,omitempty"`
+	Sign                 []byte                        `protobuf:"bytes,7,opt,name=sign,proto3" json:"sign,omitempty" ssz-max:"1024"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *TaskProgressMsg) Reset()         { *m = TaskProgressMsg{} }
+func (m *TaskProgressMsg) String() string { return proto.CompactTextString(m) }
+func (*TaskProgressMsg) ProtoMessage()    {}
+func (*TaskProgressMsg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a59cdf46cb297048, []int{8}
+}
+func (m *TaskProgressMsg) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskProgressMsg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskProgressMsg.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TaskProgressMsg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskProgressMsg.Merge(m, src)
+}
+func (m *TaskProgressMsg) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskProgressMsg) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskProgressMsg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskProgressMsg proto.InternalMessageInfo
+
+func (m *TaskProgressMsg) GetProposalId() []byte {
+	if m != nil {
+		return m.ProposalId
+	}
+	return nil
+}
+
+func (m *TaskProgressMsg) GetTaskRole() []byte {
+	if m != nil {
+		return m.TaskRole
+	}
+	return nil
+}
+
+func (m *TaskProgressMsg) GetTaskId() []byte {
+	if m != nil {
+		return m.TaskId
+	}
+	return nil
+}
+
+func (m *TaskProgressMsg) GetOwner() *TaskOrganizationIdentityInfo {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (m *TaskProgressMsg) GetProgressList() []*TaskProgress {
+	if m != nil {
+		return m.ProgressList
+	}
+	return nil
+}
+
+func (m *TaskProgressMsg) GetCreateAt() uint64 {
+	if m != nil {
+		return m.CreateAt
+	}
+	return 0
+}
+
+func (m *TaskProgressMsg) GetSign() []byte {
+	if m != nil {
+		return m.Sign
+	}
+	return nil
+}
+
 type DataSupplierOption struct {
 	MemberInfo           *TaskOrganizationIdentityInfo `protobuf:"bytes,1,opt,name=member_info,json=memberInfo,proto3" json:"member_info,omitempty"`
 	MetaDataId           []byte                        `protobuf:"bytes,2,opt,name=meta_data_id,json=metaDataId,proto3" json:"meta_data_id,omitempty" ssz-max:"64"`
@@ -669,7 +925,7 @@ func (m *DataSupplierOption) Reset()         { *m = DataSupplierOption{} }
 func (m *DataSupplierOption) String() string { return proto.CompactTextString(m) }
 func (*DataSupplierOption) ProtoMessage()    {}
 func (*DataSupplierOption) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a59cdf46cb297048, []int{7}
+	return fileDescriptor_a59cdf46cb297048, []int{9}
 }
 func (m *DataSupplierOption) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -730,7 +986,7 @@ func (m *PowerSupplierOption) Reset()         { *m = PowerSupplierOption{} }
 func (m *PowerSupplierOption) String() string { return proto.CompactTextString(m) }
 func (*PowerSupplierOption) ProtoMessage()    {}
 func (*PowerSupplierOption) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a59cdf46cb297048, []int{8}
+	return fileDescriptor_a59cdf46cb297048, []int{10}
 }
 func (m *PowerSupplierOption) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -778,7 +1034,7 @@ func (m *ReceiverOption) Reset()         { *m = ReceiverOption{} }
 func (m *ReceiverOption) String() string { return proto.CompactTextString(m) }
 func (*ReceiverOption) ProtoMessage()    {}
 func (*ReceiverOption) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a59cdf46cb297048, []int{9}
+	return fileDescriptor_a59cdf46cb297048, []int{11}
 }
 func (m *ReceiverOption) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -836,7 +1092,7 @@ func (m *TaskOperationCost) Reset()         { *m = TaskOperationCost{} }
 func (m *TaskOperationCost) String() string { return proto.CompactTextString(m) }
 func (*TaskOperationCost) ProtoMessage()    {}
 func (*TaskOperationCost) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a59cdf46cb297048, []int{10}
+	return fileDescriptor_a59cdf46cb297048, []int{12}
 }
 func (m *TaskOperationCost) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -906,7 +1162,7 @@ func (m *TaskPeerInfo) Reset()         { *m = TaskPeerInfo{} }
 func (m *TaskPeerInfo) String() string { return proto.CompactTextString(m) }
 func (*TaskPeerInfo) ProtoMessage()    {}
 func (*TaskPeerInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a59cdf46cb297048, []int{11}
+	return fileDescriptor_a59cdf46cb297048, []int{13}
 }
 func (m *TaskPeerInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -971,7 +1227,7 @@ func (m *TaskOrganizationIdentityInfo) Reset()         { *m = TaskOrganizationId
 func (m *TaskOrganizationIdentityInfo) String() string { return proto.CompactTextString(m) }
 func (*TaskOrganizationIdentityInfo) ProtoMessage()    {}
 func (*TaskOrganizationIdentityInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a59cdf46cb297048, []int{12}
+	return fileDescriptor_a59cdf46cb297048, []int{14}
 }
 func (m *TaskOrganizationIdentityInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1028,29 +1284,34 @@ func (m *TaskOrganizationIdentityInfo) GetPartyId() []byte {
 	return nil
 }
 
-type TaskEvent struct {
-	Type                 []byte   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty" ssz-max:"32"`
-	TaskId               []byte   `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty" ssz-max:"128"`
+// 任务在某个计算服务上实际消耗的资源
+type TaskResourceUsage struct {
+	TaskId               []byte   `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty" ssz-max:"128"`
+	PartyId              []byte   `protobuf:"bytes,2,opt,name=party_id,json=partyId,proto3" json:"party_id,omitempty" ssz-max:"64"`
 	IdentityId           []byte   `protobuf:"bytes,3,opt,name=identity_id,json=identityId,proto3" json:"identity_id,omitempty" ssz-max:"1024"`
-	Content              []byte   `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty" ssz-max:"2048"`
-	CreateAt             uint64   `protobuf:"varint,5,opt,name=create_at,json=createAt,proto3" json:"create_at,omitempty"`
+	JobNodeId            []byte   `protobuf:"bytes,4,opt,name=job_node_id,json=jobNodeId,proto3" json:"job_node_id,omitempty" ssz-max:"1024"`
+	UsedMem              uint64   `protobuf:"varint,5,opt,name=used_mem,json=usedMem,proto3" json:"used_mem,omitempty"`
+	UsedProcessor        uint64   `protobuf:"varint,6,opt,name=used_processor,json=usedProcessor,proto3" json:"used_processor,omitempty"`
+	UsedBandwidth        uint64   `protobuf:"varint,7,opt,name=used_bandwidth,json=usedBandwidth,proto3" json:"used_bandwidth,omitempty"`
+	UsedDuration         uint64   `protobuf:"varint,8,opt,name=used_duration,json=usedDuration,proto3" json:"used_duration,omitempty"`
+	UpdateAt             uint64   `protobuf:"varint,9,opt,name=update_at,json=updateAt,proto3" json:"update_at,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *TaskEvent) Reset()         { *m = TaskEvent{} }
-func (m *TaskEvent) String() string { return proto.CompactTextString(m) }
-func (*TaskEvent) ProtoMessage()    {}
-func (*TaskEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a59cdf46cb297048, []int{13}
+func (m *TaskResourceUsage) Reset()         { *m = TaskResourceUsage{} }
+func (m *TaskResourceUsage) String() string { return proto.CompactTextString(m) }
+func (*TaskResourceUsage) ProtoMessage()    {}
+func (*TaskResourceUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a59cdf46cb297048, []int{15}
 }
-func (m *TaskEvent) XXX_Unmarshal(b []byte) error {
+func (m *TaskResourceUsage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *TaskEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *TaskResourceUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_TaskEvent.Marshal(b, m, deterministic)
+		return xxx_messageInfo_TaskResourceUsage.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1060,147 +1321,396 @@ func (m *TaskEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *TaskEvent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_TaskEvent.Merge(m, src)
+func (m *TaskResourceUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskResourceUsage.Merge(m, src)
 }
-func (m *TaskEvent) XXX_Size() int {
+func (m *TaskResourceUsage) XXX_Size() int {
 	return m.Size()
 }
-func (m *TaskEvent) XXX_DiscardUnknown() {
-	xxx_messageInfo_TaskEvent.DiscardUnknown(m)
+func (m *TaskResourceUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskResourceUsage.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_TaskEvent proto.InternalMessageInfo
+var xxx_messageInfo_TaskResourceUsage proto.InternalMessageInfo
 
-func (m *TaskEvent) GetType() []byte {
+func (m *TaskResourceUsage) GetTaskId() []byte {
 	if m != nil {
-		return m.Type
+		return m.TaskId
 	}
 	return nil
 }
 
-func (m *TaskEvent) GetTaskId() []byte {
+func (m *TaskResourceUsage) GetPartyId() []byte {
 	if m != nil {
-		return m.TaskId
+		return m.PartyId
 	}
 	return nil
 }
 
-func (m *TaskEvent) GetIdentityId() []byte {
+func (m *TaskResourceUsage) GetIdentityId() []byte {
 	if m != nil {
 		return m.IdentityId
 	}
 	return nil
 }
 
-func (m *TaskEvent) GetContent() []byte {
+func (m *TaskResourceUsage) GetJobNodeId() []byte {
 	if m != nil {
-		return m.Content
+		return m.JobNodeId
 	}
 	return nil
 }
 
-func (m *TaskEvent) GetCreateAt() uint64 {
+func (m *TaskResourceUsage) GetUsedMem() uint64 {
 	if m != nil {
-		return m.CreateAt
+		return m.UsedMem
 	}
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*PrepareMsg)(nil), "rpcapi.PrepareMsg")
-	proto.RegisterType((*PrepareVote)(nil), "rpcapi.PrepareVote")
-	proto.RegisterType((*ConfirmMsg)(nil), "rpcapi.ConfirmMsg")
-	proto.RegisterType((*ConfirmTaskPeerInfo)(nil), "rpcapi.ConfirmTaskPeerInfo")
-	proto.RegisterType((*ConfirmVote)(nil), "rpcapi.ConfirmVote")
-	proto.RegisterType((*CommitMsg)(nil), "rpcapi.CommitMsg")
-	proto.RegisterType((*TaskResultMsg)(nil), "rpcapi.TaskResultMsg")
-	proto.RegisterType((*DataSupplierOption)(nil), "rpcapi.DataSupplierOption")
-	proto.RegisterType((*PowerSupplierOption)(nil), "rpcapi.PowerSupplierOption")
-	proto.RegisterType((*ReceiverOption)(nil), "rpcapi.ReceiverOption")
-	proto.RegisterType((*TaskOperationCost)(nil), "rpcapi.TaskOperationCost")
-	proto.RegisterType((*TaskPeerInfo)(nil), "rpcapi.TaskPeerInfo")
-	proto.RegisterType((*TaskOrganizationIdentityInfo)(nil), "rpcapi.TaskOrganizationIdentityInfo")
-	proto.RegisterType((*TaskEvent)(nil), "rpcapi.TaskEvent")
+func (m *TaskResourceUsage) GetUsedProcessor() uint64 {
+	if m != nil {
+		return m.UsedProcessor
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("lib/consensus/twopc/message.proto", fileDescriptor_a59cdf46cb297048) }
-
-var fileDescriptor_a59cdf46cb297048 = []byte{
-	// 1036 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x57, 0xdb, 0x6e, 0x1c, 0x45,
-	0x13, 0xd6, 0x8c, 0xc7, 0x7b, 0xa8, 0xf5, 0xe1, 0x77, 0xe7, 0x17, 0xda, 0x38, 0xc1, 0x36, 0x43,
-	0x40, 0x56, 0x82, 0xbd, 0xf1, 0xda, 0xd8, 0x26, 0xe2, 0x06, 0x3b, 0x01, 0xad, 0x44, 0x14, 0x6b,
-	0x88, 0x22, 0x84, 0x90, 0x46, 0xb3, 0x33, 0xe5, 0x4d, 0x2b, 0x3b, 0xd3, 0xad, 0xee, 0x5e, 0x3b,
-	0xce, 0x2d, 0x2f, 0x81, 0xc4, 0x35, 0xbc, 0x04, 0x2f, 0xc0, 0x25, 0x12, 0x57, 0xc8, 0xc2, 0x02,
-	0x3f, 0x82, 0x9f, 0x00, 0x75, 0xcf, 0xec, 0x49, 0x9e, 0x5d, 0x27, 0x66, 0x95, 0x8b, 0xdc, 0xcd,
-	0x54, 0x7d, 0x55, 0xd5, 0xf5, 0x75, 0xf5, 0xd7, 0x6a, 0xf8, 0xa0, 0x4d, 0x9b, 0xb5, 0x90, 0x25,
-	0x12, 0x13, 0xd9, 0x91, 0x35, 0x75, 0xcc, 0x78, 0x58, 0x8b, 0x51, 0xca, 0xa0, 0x85, 0xeb, 0x5c,
-	0x30, 0xc5, 0x48, 0x41, 0xf0, 0x30, 0xe0, 0x74, 0xf1, 0x43, 0x81, 0x9c, 0xc9, 0x9a, 0x31, 0x36,
-	0x3b, 0x87, 0xb5, 0x16, 0x6b, 0x31, 0xf3, 0x63, 0xbe, 0x52, 0xf0, 0x62, 0x55, 0xe7, 0x53, 0x27,
-	0x1c, 0x65, 0x4d, 0x05, 0xf2, 0x45, 0x14, 0xa8, 0x20, 0xf5, 0xb8, 0xa7, 0x36, 0xc0, 0x81, 0x40,
-	0x1e, 0x08, 0x7c, 0x2c, 0x5b, 0x64, 0x13, 0x2a, 0x5c, 0x30, 0xce, 0x64, 0xd0, 0xf6, 0x69, 0x54,
-	0xb5, 0x56, 0xac, 0xd5, 0x99, 0x3d, 0x72, 0x71, 0xb6, 0x3c, 0x27, 0xe5, 0xab, 0xb5, 0x38, 0x78,
-	0xf9, 0xc0, 0xdd, 0xb8, 0x5f, 0xdf, 0x72, 0x3d, 0xe8, 0xc2, 0x1a, 0x11, 0x59, 0x83, 0xb2, 0xce,
-	0xea, 0x0b, 0xd6, 0xc6, 0xaa, 0x6d, 0x42, 0xfe, 0x77, 0x71, 0xb6, 0x3c, 0xd3, 0x0b, 0xd9, 0xac,
-	0xbb, 0x5e, 0x49, 0x43, 0x3c, 0xd6, 0x46, 0xb2, 0x05, 0xb3, 0x06, 0xce, 0x03, 0xa1, 0x4e, 0x74,
-	0x95, 0xa9, 0x9c, 0x90, 0xed, 0x2d, 0xd7, 0xab, 0x68, 0xd8, 0x81, 0x46, 0x35, 0x22, 0xf2, 0x00,
-	0xa6, 0xd9, 0x71, 0x82, 0xa2, 0xea, 0xac, 0x58, 0xab, 0x95, 0xfa, 0x9d, 0xf5, 0xb4, 0xff, 0xf5,
-	0xa7, 0x81, 0x7c, 0xf1, 0x44, 0xb4, 0x82, 0x84, 0xbe, 0x0a, 0x14, 0x65, 0x49, 0x23, 0xc2, 0x44,
-	0x51, 0x75, 0xd2, 0x48, 0x0e, 0x99, 0x97, 0x86, 0x90, 0x3a, 0x98, 0xea, 0xda, 0x54, 0x9d, 0x36,
-	0xc5, 0xde, 0xbb, 0x38, 0x5b, 0x26, 0xfd, 0x96, 0xb6, 0x77, 0x76, 0x76, 0xea, 0x1b, 0xdb, 0xd9,
-	0x2a, 0x35, 0x8e, 0xdc, 0x82, 0x72, 0x28, 0x30, 0x50, 0xe8, 0x07, 0xaa, 0x5a, 0x58, 0xb1, 0x56,
-	0x1d, 0xaf, 0x94, 0x1a, 0xbe, 0x50, 0xe4, 0x63, 0x70, 0x24, 0x6d, 0x25, 0xd5, 0xe2, 0x48, 0x7e,
-	0x8c, 0xdf, 0xfd, 0xd3, 0x86, 0x4a, 0xc6, 0xee, 0x33, 0xa6, 0xf0, 0x7a, 0xf4, 0xae, 0x5f, 0xa6,
-	0x77, 0xe1, 0xe2, 0x6c, 0x79, 0xb6, 0x1f, 0x52, 0xdf, 0x1d, 0xe4, 0xb7, 0xc7, 0xd4, 0xd4, 0x9b,
-	0x33, 0xb5, 0x01, 0x95, 0x23, 0xa6, 0xd0, 0x67, 0x5c, 0x23, 0x0c, 0xd7, 0x79, 0x9b, 0x09, 0x1a,
-	0xf4, 0xc4, 0x60, 0xc8, 0x06, 0x94, 0x39, 0xa2, 0xf0, 0x69, 0x97, 0xdd, 0x4a, 0xfd, 0xff, 0x83,
-	0x25, 0x0f, 0x10, 0x85, 0x29, 0x51, 0xe2, 0xd9, 0xd7, 0x64, 0xb8, 0xfd, 0xc7, 0x06, 0xd8, 0x67,
-	0xc9, 0x21, 0x15, 0xf1, 0xbb, 0x3b, 0xb9, 0xbb, 0x19, 0xb9, 0x11, 0xca, 0x30, 0x23, 0xf7, 0x56,
-	0x37, 0x3e, 0x6b, 0xfe, 0x32, 0xc7, 0x0f, 0x51, 0x86, 0x93, 0xe1, 0xf8, 0xd4, 0x86, 0x1b, 0x39,
-	0x65, 0xc8, 0xe7, 0x30, 0x6f, 0xd6, 0xe7, 0xf7, 0x77, 0xde, 0x1a, 0xb3, 0xf3, 0xb3, 0x06, 0xdc,
-	0x8b, 0x7e, 0x0a, 0xb7, 0xb5, 0x02, 0xf9, 0xb2, 0xc3, 0x79, 0x9b, 0x0e, 0x66, 0xf1, 0xdb, 0x54,
-	0xaa, 0xaa, 0xbd, 0x32, 0x35, 0x32, 0x55, 0x55, 0x47, 0x7e, 0x93, 0x05, 0x76, 0xad, 0x5f, 0x53,
-	0xa9, 0xc8, 0x33, 0x78, 0x9f, 0xb3, 0x63, 0x14, 0x23, 0xd3, 0x4e, 0x8d, 0x49, 0x7b, 0xd3, 0x84,
-	0xe6, 0xe6, 0xfd, 0x16, 0x96, 0x04, 0xca, 0x4e, 0x5b, 0xf9, 0x02, 0x43, 0xa4, 0x47, 0x97, 0x13,
-	0x3b, 0x63, 0x12, 0x2f, 0xa6, 0xb1, 0x5e, 0x16, 0x3a, 0x98, 0xd9, 0xfd, 0xd9, 0x86, 0x4a, 0xc6,
-	0xee, 0xf5, 0xd5, 0xe1, 0x0d, 0x47, 0xf8, 0x2d, 0x8b, 0xc3, 0xd0, 0x14, 0x4e, 0x8f, 0x98, 0xc2,
-	0xc2, 0x15, 0x53, 0xf8, 0x8b, 0x0d, 0xe5, 0x7d, 0x16, 0xc7, 0x54, 0xbd, 0xbb, 0x07, 0x7d, 0x22,
-	0x44, 0x9d, 0xda, 0x30, 0xab, 0x8b, 0x79, 0x66, 0xe6, 0xde, 0x16, 0x59, 0x77, 0xa1, 0x68, 0xe0,
-	0x3d, 0x9a, 0x72, 0x6e, 0xa7, 0x82, 0xb9, 0x57, 0xff, 0x1b, 0x45, 0x9f, 0xc1, 0xbc, 0xa9, 0x83,
-	0x47, 0x98, 0xa8, 0xf4, 0xe4, 0x4d, 0x9b, 0x93, 0xb7, 0x30, 0x98, 0xe5, 0x91, 0xf6, 0x7a, 0x66,
-	0xfb, 0xcc, 0xa7, 0x39, 0xc3, 0x13, 0x11, 0xc3, 0x5f, 0x2d, 0x20, 0x0f, 0x07, 0xd4, 0x27, 0x1b,
-	0xf1, 0x47, 0x50, 0x89, 0x31, 0x6e, 0x0e, 0xeb, 0xe0, 0xeb, 0x35, 0x06, 0x69, 0xa0, 0x11, 0xc5,
-	0x3a, 0xcc, 0xc4, 0xa8, 0x02, 0xdf, 0x28, 0x23, 0x8d, 0x72, 0x79, 0xd7, 0x13, 0x07, 0x1a, 0xa5,
-	0x97, 0xd1, 0x88, 0xc8, 0x5d, 0x58, 0x08, 0x59, 0xbb, 0x13, 0x27, 0x3e, 0x4d, 0x22, 0x7c, 0xd9,
-	0x97, 0x39, 0xc7, 0x9b, 0x4f, 0x1d, 0x0d, 0x6d, 0x37, 0x62, 0xf3, 0x3d, 0xdc, 0x38, 0x18, 0xd4,
-	0xb8, 0x89, 0xae, 0xde, 0xfd, 0xc9, 0x82, 0xb9, 0xae, 0xc6, 0x4d, 0x96, 0x97, 0x3d, 0x28, 0x73,
-	0xc1, 0x8e, 0x68, 0x84, 0x42, 0x66, 0x37, 0xc3, 0xeb, 0x25, 0xe9, 0x87, 0xb9, 0x3f, 0x5a, 0xb0,
-	0x60, 0xb0, 0x1c, 0x85, 0x01, 0xee, 0x33, 0xa9, 0xc8, 0x4d, 0x28, 0x85, 0x4c, 0x2a, 0x3f, 0xc6,
-	0xd8, 0xac, 0xce, 0xf1, 0x8a, 0xfa, 0xff, 0x31, 0xc6, 0xe4, 0x23, 0x98, 0x33, 0x2e, 0x2e, 0x58,
-	0x88, 0x52, 0x32, 0x61, 0xb6, 0xc3, 0xf1, 0x66, 0xb5, 0xf5, 0xa0, 0x6b, 0xec, 0xc1, 0x9a, 0x41,
-	0x12, 0x1d, 0xd3, 0x48, 0x3d, 0x37, 0x07, 0x20, 0x83, 0xed, 0x75, 0x8d, 0x64, 0x11, 0x4a, 0x51,
-	0x27, 0x2d, 0x6c, 0xe6, 0xde, 0xf1, 0x7a, 0xff, 0xee, 0x0f, 0x16, 0xcc, 0x0c, 0x5d, 0xad, 0x2b,
-	0x60, 0x53, 0x9e, 0x1d, 0xd4, 0xcb, 0xbb, 0x6f, 0x53, 0x4e, 0xee, 0x80, 0xc3, 0x99, 0x50, 0x23,
-	0x27, 0xc4, 0x78, 0xc9, 0x3d, 0x28, 0x5d, 0xa9, 0x5e, 0x45, 0x9e, 0x2a, 0x97, 0xfb, 0x87, 0x05,
-	0xb7, 0xc7, 0x91, 0xa9, 0x6b, 0x26, 0x41, 0x8c, 0x23, 0xd7, 0x65, 0xbc, 0xe4, 0x1e, 0x14, 0x13,
-	0x16, 0x61, 0x7f, 0x7c, 0xf3, 0x0e, 0x53, 0x41, 0x43, 0x1a, 0x91, 0x96, 0x26, 0x9a, 0x95, 0xe8,
-	0xaf, 0x31, 0x57, 0x9a, 0xba, 0xb0, 0x46, 0x34, 0xd4, 0x95, 0x73, 0x55, 0x57, 0x7f, 0x59, 0x50,
-	0xee, 0x49, 0x82, 0x6e, 0x41, 0xbf, 0x80, 0x72, 0x5b, 0xd0, 0x82, 0x66, 0xbc, 0x83, 0x62, 0x66,
-	0x5f, 0x25, 0x66, 0xd7, 0xea, 0xe0, 0x13, 0x28, 0x86, 0x2c, 0x51, 0x98, 0xa8, 0xac, 0x81, 0xe1,
-	0x80, 0xfa, 0xfd, 0xad, 0x5d, 0xd7, 0xeb, 0x42, 0xc6, 0x5e, 0x0b, 0x7b, 0xfb, 0xbf, 0x9d, 0x2f,
-	0x59, 0xbf, 0x9f, 0x2f, 0x59, 0x7f, 0x9f, 0x2f, 0x59, 0xdf, 0x7d, 0xda, 0xa2, 0xea, 0x79, 0xa7,
-	0xb9, 0x1e, 0xb2, 0xb8, 0xe6, 0x31, 0x89, 0x4a, 0x05, 0x5f, 0xb6, 0xd9, 0x71, 0x6d, 0x3f, 0x10,
-	0x82, 0xa2, 0x58, 0xfb, 0x8a, 0xd5, 0x72, 0x5e, 0x95, 0xcd, 0x82, 0x79, 0x07, 0x6e, 0xfe, 0x1b,
-	0x00, 0x00, 0xff, 0xff, 0x46, 0x28, 0x4b, 0xa1, 0x73, 0x0e, 0x00, 0x00,
+func (m *TaskResourceUsage) GetUsedBandwidth() uint64 {
+	if m != nil {
+		return m.UsedBandwidth
+	}
+	return 0
 }
 
-func (m *PrepareMsg) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *TaskResourceUsage) GetUsedDuration() uint64 {
+	if m != nil {
+		return m.UsedDuration
+	}
+	return 0
+}
+
+func (m *TaskResourceUsage) GetUpdateAt() uint64 {
+	if m != nil {
+		return m.UpdateAt
+	}
+	return 0
+}
+
+// 任务在某个计算服务上的执行进度
+type TaskProgress struct {
+	TaskId               []byte   `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty" ssz-max:"128"`
+	PartyId              []byte   `protobuf:"bytes,2,opt,name=party_id,json=partyId,proto3" json:"party_id,omitempty" ssz-max:"64"`
+	IdentityId           []byte   `protobuf:"bytes,3,opt,name=identity_id,json=identityId,proto3" json:"identity_id,omitempty" ssz-max:"1024"`
+	JobNodeId            []byte   `protobuf:"bytes,4,opt,name=job_node_id,json=jobNodeId,proto3" json:"job_node_id,omitempty" ssz-max:"1024"`
+	Phase                []byte   `protobuf:"bytes,5,opt,name=phase,proto3" json:"phase,omitempty" ssz-max:"128"`
+	Percent              uint64   `protobuf:"varint,6,opt,name=percent,proto3" json:"percent,omitempty"`
+	ElapsedTime          uint64   `protobuf:"varint,7,opt,name=elapsed_time,json=elapsedTime,proto3" json:"elapsed_time,omitempty"`
+	RemainTime           uint64   `protobuf:"varint,8,opt,name=remain_time,json=remainTime,proto3" json:"remain_time,omitempty"`
+	UpdateAt             uint64   `protobuf:"varint,9,opt,name=update_at,json=updateAt,proto3" json:"update_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TaskProgress) Reset()         { *m = TaskProgress{} }
+func (m *TaskProgress) String() string { return proto.CompactTextString(m) }
+func (*TaskProgress) ProtoMessage()    {}
+func (*TaskProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a59cdf46cb297048, []int{16}
+}
+func (m *TaskProgress) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskProgress.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TaskProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskProgress.Merge(m, src)
+}
+func (m *TaskProgress) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskProgress proto.InternalMessageInfo
+
+func (m *TaskProgress) GetTaskId() []byte {
+	if m != nil {
+		return m.TaskId
+	}
+	return nil
+}
+
+func (m *TaskProgress) GetPartyId() []byte {
+	if m != nil {
+		return m.PartyId
+	}
+	return nil
+}
+
+func (m *TaskProgress) GetIdentityId() []byte {
+	if m != nil {
+		return m.IdentityId
+	}
+	return nil
+}
+
+func (m *TaskProgress) GetJobNodeId() []byte {
+	if m != nil {
+		return m.JobNodeId
+	}
+	return nil
+}
+
+func (m *TaskProgress) GetPhase() []byte {
+	if m != nil {
+		return m.Phase
+	}
+	return nil
+}
+
+func (m *TaskProgress) GetPercent() uint64 {
+	if m != nil {
+		return m.Percent
+	}
+	return 0
+}
+
+func (m *TaskProgress) GetElapsedTime() uint64 {
+	if m != nil {
+		return m.ElapsedTime
+	}
+	return 0
+}
+
+func (m *TaskProgress) GetRemainTime() uint64 {
+	if m != nil {
+		return m.RemainTime
+	}
+	return 0
+}
+
+func (m *TaskProgress) GetUpdateAt() uint64 {
+	if m != nil {
+		return m.UpdateAt
+	}
+	return 0
+}
+
+type TaskEvent struct {
+	Type                 []byte   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty" ssz-max:"32"`
+	TaskId               []byte   `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty" ssz-max:"128"`
+	IdentityId           []byte   `protobuf:"bytes,3,opt,name=identity_id,json=identityId,proto3" json:"identity_id,omitempty" ssz-max:"1024"`
+	Content              []byte   `protobuf:"bytes,4,opt,name=content,proto3" json:"content,omitempty" ssz-max:"2048"`
+	CreateAt             uint64   `protobuf:"varint,5,opt,name=create_at,json=createAt,proto3" json:"create_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TaskEvent) Reset()         { *m = TaskEvent{} }
+func (m *TaskEvent) String() string { return proto.CompactTextString(m) }
+func (*TaskEvent) ProtoMessage()    {}
+func (*TaskEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a59cdf46cb297048, []int{17}
+}
+func (m *TaskEvent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskEvent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TaskEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskEvent.Merge(m, src)
+}
+func (m *TaskEvent) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskEvent proto.InternalMessageInfo
+
+func (m *TaskEvent) GetType() []byte {
+	if m != nil {
+		return m.Type
+	}
+	return nil
+}
+
+func (m *TaskEvent) GetTaskId() []byte {
+	if m != nil {
+		return m.TaskId
+	}
+	return nil
+}
+
+func (m *TaskEvent) GetIdentityId() []byte {
+	if m != nil {
+		return m.IdentityId
+	}
+	return nil
+}
+
+func (m *TaskEvent) GetContent() []byte {
+	if m != nil {
+		return m.Content
+	}
+	return nil
+}
+
+func (m *TaskEvent) GetCreateAt() uint64 {
+	if m != nil {
+		return m.CreateAt
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*PrepareMsg)(nil), "rpcapi.PrepareMsg")
+	proto.RegisterType((*PrepareVote)(nil), "rpcapi.PrepareVote")
+	proto.RegisterType((*ConfirmMsg)(nil), "rpcapi.ConfirmMsg")
+	proto.RegisterType((*ConfirmTaskPeerInfo)(nil), "rpcapi.ConfirmTaskPeerInfo")
+	proto.RegisterType((*ConfirmVote)(nil), "rpcapi.ConfirmVote")
+	proto.RegisterType((*CommitMsg)(nil), "rpcapi.CommitMsg")
+	proto.RegisterType((*TaskResultMsg)(nil), "rpcapi.TaskResultMsg")
+	proto.RegisterType((*TaskCancelMsg)(nil), "rpcapi.TaskCancelMsg")
+	proto.RegisterType((*TaskProgressMsg)(nil), "rpcapi.TaskProgressMsg")
+	proto.RegisterType((*DataSupplierOption)(nil), "rpcapi.DataSupplierOption")
+	proto.RegisterType((*PowerSupplierOption)(nil), "rpcapi.PowerSupplierOption")
+	proto.RegisterType((*ReceiverOption)(nil), "rpcapi.ReceiverOption")
+	proto.RegisterType((*TaskOperationCost)(nil), "rpcapi.TaskOperationCost")
+	proto.RegisterType((*TaskPeerInfo)(nil), "rpcapi.TaskPeerInfo")
+	proto.RegisterType((*TaskOrganizationIdentityInfo)(nil), "rpcapi.TaskOrganizationIdentityInfo")
+	proto.RegisterType((*TaskResourceUsage)(nil), "rpcapi.TaskResourceUsage")
+	proto.RegisterType((*TaskProgress)(nil), "rpcapi.TaskProgress")
+	proto.RegisterType((*TaskEvent)(nil), "rpcapi.TaskEvent")
+}
+
+func init() { proto.RegisterFile("lib/consensus/twopc/message.proto", fileDescriptor_a59cdf46cb297048) }
+
+var fileDescriptor_a59cdf46cb297048 = []byte{
+	// 1449 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x58, 0xef, 0x6e, 0xdb, 0x46,
+	0x12, 0x07, 0x69, 0xc9, 0x92, 0x46, 0x92, 0x1d, 0xaf, 0x73, 0x01, 0xed, 0xe4, 0x6c, 0x87, 0xc9,
+	0xdd, 0x05, 0x97, 0x8b, 0x15, 0x2b, 0x4e, 0x1c, 0x04, 0xf7, 0x25, 0xb6, 0x73, 0x07, 0x03, 0x97,
+	0x8b, 0xcb, 0xa4, 0x41, 0x51, 0xb4, 0x20, 0x28, 0x72, 0xac, 0x30, 0x11, 0xb9, 0x8b, 0xdd, 0x95,
+	0x9d, 0xe4, 0x53, 0x81, 0xbe, 0x44, 0x81, 0x7e, 0x6e, 0x5f, 0xa2, 0x2f, 0xd0, 0x8f, 0x45, 0x0b,
+	0x14, 0x28, 0xda, 0x1a, 0x6d, 0xde, 0xa0, 0x46, 0x1f, 0xa0, 0xd8, 0xe5, 0x1f, 0xd1, 0xb5, 0x64,
+	0xa7, 0x8e, 0x11, 0x14, 0xfe, 0x26, 0xce, 0xfc, 0x66, 0x66, 0xf7, 0xc7, 0xdf, 0xcc, 0xae, 0x08,
+	0x17, 0x7b, 0x61, 0xa7, 0xe5, 0xd3, 0x58, 0x60, 0x2c, 0xfa, 0xa2, 0x25, 0x77, 0x28, 0xf3, 0x5b,
+	0x11, 0x0a, 0xe1, 0x75, 0x71, 0x91, 0x71, 0x2a, 0x29, 0x19, 0xe7, 0xcc, 0xf7, 0x58, 0x38, 0x7b,
+	0x89, 0x23, 0xa3, 0xa2, 0xa5, 0x8d, 0x9d, 0xfe, 0x56, 0xab, 0x4b, 0xbb, 0x54, 0x3f, 0xe8, 0x5f,
+	0x09, 0x78, 0xd6, 0x52, 0xf9, 0xe4, 0x0b, 0x86, 0xa2, 0x25, 0x3d, 0xf1, 0x2c, 0xf0, 0xa4, 0x97,
+	0x78, 0xec, 0x1f, 0xca, 0x00, 0x9b, 0x1c, 0x99, 0xc7, 0xf1, 0xbe, 0xe8, 0x92, 0x1b, 0x50, 0x67,
+	0x9c, 0x32, 0x2a, 0xbc, 0x9e, 0x1b, 0x06, 0x96, 0xb1, 0x60, 0x5c, 0x69, 0xac, 0x92, 0xbd, 0xdd,
+	0xf9, 0x09, 0x21, 0x5e, 0x5e, 0x8b, 0xbc, 0xe7, 0x77, 0xec, 0xa5, 0xeb, 0xed, 0x65, 0xdb, 0x81,
+	0x0c, 0xb6, 0x11, 0x90, 0x6b, 0x50, 0x53, 0x59, 0x5d, 0x4e, 0x7b, 0x68, 0x99, 0x3a, 0xe4, 0xcc,
+	0xde, 0xee, 0x7c, 0x23, 0x0f, 0xb9, 0xd1, 0xb6, 0x9d, 0xaa, 0x82, 0x38, 0xb4, 0x87, 0x64, 0x19,
+	0x9a, 0x1a, 0xce, 0x3c, 0x2e, 0x5f, 0xa8, 0x2a, 0x63, 0x43, 0x42, 0x6e, 0x2d, 0xdb, 0x4e, 0x5d,
+	0xc1, 0x36, 0x15, 0x6a, 0x23, 0x20, 0x77, 0xa0, 0x4c, 0x77, 0x62, 0xe4, 0x56, 0x69, 0xc1, 0xb8,
+	0x52, 0x6f, 0x5f, 0x5e, 0x4c, 0xf6, 0xbf, 0xf8, 0xc8, 0x13, 0xcf, 0x1e, 0xf0, 0xae, 0x17, 0x87,
+	0x2f, 0x3d, 0x19, 0xd2, 0x78, 0x23, 0xc0, 0x58, 0x86, 0xf2, 0xc5, 0x46, 0xbc, 0x45, 0x9d, 0x24,
+	0x84, 0xb4, 0x41, 0x57, 0x57, 0x26, 0xab, 0xac, 0x8b, 0x9d, 0xdb, 0xdb, 0x9d, 0x27, 0x83, 0x2d,
+	0xdd, 0x5a, 0x59, 0x59, 0x69, 0x2f, 0xdd, 0xb2, 0x9d, 0x1c, 0x47, 0xce, 0x43, 0xcd, 0xe7, 0xe8,
+	0x49, 0x74, 0x3d, 0x69, 0x8d, 0x2f, 0x18, 0x57, 0x4a, 0x4e, 0x35, 0x31, 0xdc, 0x95, 0xe4, 0xef,
+	0x50, 0x12, 0x61, 0x37, 0xb6, 0x2a, 0x23, 0xf9, 0xd1, 0x7e, 0x72, 0x13, 0x9a, 0xd8, 0x43, 0x5f,
+	0xad, 0xcb, 0x15, 0x88, 0x81, 0x55, 0x1d, 0xc1, 0x4e, 0x23, 0x83, 0x3d, 0x44, 0x0c, 0xc8, 0x0a,
+	0x4c, 0xe4, 0x61, 0x8c, 0x53, 0xba, 0x65, 0xd5, 0x86, 0x51, 0x74, 0xd3, 0x76, 0xf2, 0xf4, 0x9b,
+	0x0a, 0x46, 0x96, 0xe1, 0x1c, 0x4b, 0x5e, 0xa6, 0xbb, 0x4d, 0x65, 0x18, 0x77, 0x5d, 0x19, 0x46,
+	0x48, 0xfb, 0xd2, 0x02, 0xbd, 0x83, 0xb3, 0xa9, 0xf7, 0xb1, 0x76, 0x3e, 0x4a, 0x7c, 0x2a, 0xca,
+	0xa7, 0xf1, 0x56, 0xc8, 0xa3, 0xdf, 0x47, 0xd5, 0x93, 0xa8, 0xd4, 0xbb, 0x3f, 0xaa, 0x0d, 0x7f,
+	0xf1, 0x69, 0x14, 0x85, 0xd2, 0xc5, 0x38, 0x28, 0x06, 0x35, 0x74, 0xd0, 0x74, 0xe2, 0xbc, 0x17,
+	0x07, 0x85, 0x98, 0xab, 0x30, 0x95, 0xcb, 0x2b, 0x40, 0x2f, 0xe8, 0x85, 0x31, 0x5a, 0x4d, 0x8d,
+	0x3f, 0x93, 0x39, 0xd6, 0x53, 0x3b, 0x59, 0x85, 0xb3, 0x03, 0x16, 0xe8, 0x0e, 0x72, 0xe1, 0x6a,
+	0xd2, 0x27, 0x46, 0x70, 0x41, 0x72, 0x2e, 0x34, 0xf8, 0x61, 0xd8, 0x8d, 0xed, 0xef, 0x4c, 0xa8,
+	0x6f, 0xe6, 0x7b, 0xc6, 0xe3, 0xe9, 0x7b, 0xf1, 0xa0, 0xbe, 0xa7, 0xf6, 0x76, 0xe7, 0x9b, 0x83,
+	0x90, 0xf6, 0xed, 0xa2, 0xc0, 0x73, 0xa9, 0x8e, 0xfd, 0x71, 0xa9, 0x2e, 0x41, 0x7d, 0x9b, 0x4a,
+	0x74, 0x29, 0x53, 0x08, 0xab, 0x34, 0x64, 0xaf, 0x4a, 0x2f, 0xa0, 0x40, 0x0f, 0x34, 0x86, 0x2c,
+	0x41, 0x8d, 0x21, 0x72, 0x37, 0xcc, 0xe4, 0x5d, 0x6f, 0x9f, 0x2d, 0x96, 0xdc, 0x44, 0xe4, 0xba,
+	0x44, 0x95, 0xa5, 0xbf, 0x4e, 0x44, 0xdc, 0xf6, 0xcf, 0x26, 0xc0, 0x5a, 0xa2, 0x8c, 0xd3, 0x3b,
+	0x3a, 0x6e, 0xa7, 0xe4, 0x06, 0x28, 0xfc, 0x94, 0xdc, 0xf3, 0x59, 0x7c, 0xba, 0xf9, 0x83, 0x1c,
+	0xaf, 0xa3, 0xf0, 0x4f, 0x86, 0xe3, 0xef, 0x4d, 0x98, 0x1e, 0x52, 0x86, 0xfc, 0x1b, 0x26, 0xf5,
+	0xfa, 0xdc, 0xc1, 0x9b, 0x37, 0x0e, 0x79, 0xf3, 0x4d, 0x0d, 0xce, 0xa3, 0x1f, 0xc1, 0x05, 0x75,
+	0x04, 0xb8, 0xa2, 0xcf, 0x58, 0x2f, 0x2c, 0x66, 0x71, 0x7b, 0xa1, 0x90, 0x96, 0xb9, 0x30, 0x36,
+	0x32, 0x95, 0xa5, 0x22, 0x1f, 0xa6, 0x81, 0x99, 0xf5, 0x7f, 0xa1, 0x90, 0xe4, 0x31, 0xfc, 0x55,
+	0xb7, 0xe9, 0xc8, 0xb4, 0x63, 0x87, 0xa4, 0x9d, 0xd1, 0xa1, 0x43, 0xf3, 0xbe, 0x07, 0x73, 0x1c,
+	0x45, 0xbf, 0x27, 0x5d, 0x8e, 0x3e, 0x86, 0xdb, 0x07, 0x13, 0x97, 0x0e, 0x49, 0x3c, 0x9b, 0xc4,
+	0x3a, 0x69, 0x68, 0x31, 0xb3, 0xfd, 0x99, 0x09, 0xf5, 0xb5, 0x7c, 0xb6, 0xe1, 0x5b, 0x91, 0xf0,
+	0x5b, 0x1e, 0x0e, 0xfb, 0x54, 0x58, 0x1e, 0xa1, 0xc2, 0xf1, 0x23, 0x54, 0xf8, 0xb9, 0x09, 0xb5,
+	0x35, 0x3d, 0xce, 0x4f, 0x6f, 0xa3, 0x9f, 0x08, 0x51, 0x5f, 0x8f, 0x41, 0x53, 0x15, 0x73, 0xb4,
+	0xe6, 0xde, 0x16, 0x59, 0xff, 0x84, 0x8a, 0x86, 0xe7, 0x34, 0x0d, 0x39, 0x9d, 0xc6, 0x15, 0xe2,
+	0x0d, 0x29, 0x7a, 0x07, 0x26, 0x75, 0x1d, 0xdc, 0xc6, 0x58, 0x26, 0x9d, 0x57, 0xd6, 0x9d, 0x37,
+	0x55, 0xcc, 0x72, 0x4f, 0x79, 0x47, 0x5e, 0xb0, 0x9a, 0x32, 0x83, 0xe8, 0xde, 0x3e, 0x91, 0x5b,
+	0xd6, 0x87, 0x30, 0xcd, 0x51, 0xd0, 0x3e, 0xf7, 0xd1, 0xed, 0xab, 0x2b, 0x72, 0xb2, 0xb6, 0xaa,
+	0x5e, 0xdb, 0x4c, 0x71, 0x6d, 0x4e, 0x0a, 0x7b, 0x57, 0xa1, 0x86, 0x66, 0x9c, 0xe2, 0x45, 0x88,
+	0x9e, 0x12, 0xdf, 0x9a, 0xc9, 0x4b, 0x5d, 0xf3, 0x62, 0x1f, 0x7b, 0x7f, 0xee, 0x0e, 0x28, 0x48,
+	0xa1, 0xf4, 0xda, 0x52, 0x28, 0xbf, 0x61, 0xb7, 0x1c, 0xf7, 0x70, 0xfb, 0xc5, 0x84, 0x49, 0x3d,
+	0xab, 0x39, 0xed, 0x72, 0x14, 0xe2, 0xb4, 0xf5, 0xcb, 0x7d, 0x68, 0xb2, 0x74, 0x6b, 0xc5, 0x6e,
+	0xd9, 0x7f, 0x4e, 0xa5, 0x80, 0xa1, 0x7b, 0x6c, 0x64, 0xe1, 0x27, 0xd6, 0x2b, 0xf6, 0x17, 0x06,
+	0x90, 0xf5, 0xc2, 0x09, 0x9e, 0x1e, 0x13, 0xf7, 0xa0, 0x1e, 0x61, 0xd4, 0xd9, 0x7f, 0x97, 0x78,
+	0xbd, 0xcd, 0x42, 0x12, 0xa8, 0x7e, 0x93, 0x36, 0x34, 0x22, 0x94, 0x9e, 0xab, 0x6f, 0x17, 0x61,
+	0x60, 0x99, 0x23, 0x34, 0x0b, 0x0a, 0xa5, 0x96, 0xa1, 0x25, 0x3b, 0xe5, 0xd3, 0x5e, 0x3f, 0x8a,
+	0xdd, 0x30, 0x0e, 0xf0, 0xf9, 0xe0, 0xaa, 0x50, 0x72, 0x26, 0x13, 0xc7, 0x86, 0xb2, 0xeb, 0x56,
+	0xfc, 0x00, 0xa6, 0x37, 0x8b, 0xf7, 0x84, 0x13, 0x5d, 0xbd, 0xfd, 0xa9, 0x01, 0x13, 0xd9, 0x3d,
+	0xe1, 0x64, 0x79, 0x59, 0x85, 0x1a, 0xe3, 0x74, 0x3b, 0x0c, 0x90, 0x8b, 0xf4, 0x76, 0xf5, 0x7a,
+	0x49, 0x06, 0x61, 0xf6, 0x27, 0x06, 0x4c, 0x69, 0x2c, 0x43, 0xae, 0x81, 0x6b, 0x54, 0x48, 0x32,
+	0x03, 0x55, 0x9f, 0x0a, 0xe9, 0x46, 0x18, 0xe9, 0xd5, 0x95, 0x9c, 0x8a, 0x7a, 0xbe, 0x8f, 0x11,
+	0xf9, 0x1b, 0x4c, 0x68, 0x17, 0xe3, 0xd4, 0x47, 0x21, 0x28, 0xd7, 0xaf, 0xa3, 0xe4, 0x34, 0x95,
+	0x75, 0x33, 0x33, 0xe6, 0xb0, 0x8e, 0x17, 0x07, 0x3b, 0x61, 0x20, 0x9f, 0x58, 0x63, 0x03, 0xd8,
+	0x6a, 0x66, 0x24, 0xb3, 0x50, 0x0d, 0xfa, 0x49, 0x61, 0xdd, 0x0b, 0x25, 0x27, 0x7f, 0xb6, 0x3f,
+	0x36, 0xa0, 0xb1, 0xef, 0x7a, 0xba, 0x00, 0x66, 0xc8, 0x2c, 0x63, 0xc4, 0xdb, 0x37, 0x43, 0x46,
+	0x2e, 0x43, 0x89, 0x51, 0x2e, 0x47, 0x2a, 0x44, 0x7b, 0xc9, 0x55, 0xa8, 0x1e, 0x39, 0xff, 0x2a,
+	0x2c, 0x99, 0x7d, 0xf6, 0x37, 0x06, 0x5c, 0x38, 0x8c, 0x4c, 0x55, 0x33, 0xf6, 0x22, 0x1c, 0xb9,
+	0x2e, 0xed, 0x25, 0x57, 0xa1, 0x12, 0xd3, 0x00, 0x07, 0xf2, 0x1d, 0xd6, 0x4c, 0xe3, 0x0a, 0xb2,
+	0x11, 0xa8, 0x71, 0x15, 0xa6, 0x25, 0x06, 0x6b, 0x1c, 0x16, 0x00, 0x19, 0x6c, 0x23, 0xd8, 0xb7,
+	0xab, 0xd2, 0x51, 0xbb, 0xfa, 0x68, 0x0c, 0xa6, 0x0e, 0x1c, 0x5d, 0xc5, 0x11, 0x66, 0x1c, 0x35,
+	0xc2, 0x8a, 0xe5, 0xcc, 0x23, 0xca, 0x1d, 0x6f, 0x43, 0x6d, 0xa8, 0x3f, 0xa5, 0x1d, 0x37, 0xa3,
+	0xad, 0x34, 0x32, 0xa8, 0xf6, 0x94, 0x76, 0xfe, 0x9f, 0x30, 0x37, 0x03, 0xd5, 0xbe, 0xc0, 0x40,
+	0x0b, 0x37, 0xb9, 0x6e, 0x55, 0xd4, 0x73, 0x2a, 0x5c, 0xed, 0x1a, 0x08, 0x37, 0x99, 0x76, 0x4d,
+	0x65, 0xdd, 0x27, 0x5c, 0x0d, 0x1b, 0x08, 0xb7, 0x32, 0x80, 0x0d, 0x84, 0x7b, 0x09, 0xb4, 0xc1,
+	0xcd, 0xd5, 0x5b, 0xd5, 0xa8, 0x86, 0x32, 0xae, 0xa7, 0x36, 0x35, 0x5b, 0xfb, 0x2c, 0x48, 0x67,
+	0x6b, 0x2d, 0x91, 0x77, 0x62, 0xb8, 0x2b, 0xed, 0x5f, 0xcd, 0x54, 0xde, 0xe9, 0x34, 0x3e, 0x05,
+	0xec, 0xff, 0x03, 0xca, 0xec, 0x89, 0x27, 0xd0, 0x2a, 0x8f, 0x5a, 0x7f, 0xe2, 0x27, 0x16, 0x54,
+	0x18, 0x72, 0x1f, 0xe3, 0xec, 0xc8, 0xc9, 0x1e, 0xc9, 0x45, 0x68, 0x60, 0xcf, 0x63, 0x8a, 0x5a,
+	0xf5, 0xe5, 0x27, 0x25, 0xbf, 0x9e, 0xda, 0xd4, 0x17, 0x1f, 0x32, 0x0f, 0x75, 0x8e, 0x91, 0x17,
+	0xc6, 0x09, 0x22, 0x21, 0x1e, 0x12, 0x93, 0x06, 0x1c, 0x4a, 0xfb, 0x8f, 0x06, 0xd4, 0xf2, 0x0b,
+	0xa5, 0x6a, 0x5e, 0xf5, 0x01, 0xd3, 0x32, 0x46, 0x1c, 0xef, 0xda, 0x5b, 0x7c, 0x33, 0xe6, 0x51,
+	0x6f, 0xe6, 0x58, 0x64, 0xff, 0x0b, 0x2a, 0x3e, 0x8d, 0xa5, 0xe2, 0x63, 0x18, 0xd1, 0xed, 0xeb,
+	0xcb, 0xb7, 0x6d, 0x27, 0x83, 0x1c, 0xfa, 0xa7, 0x62, 0x75, 0xed, 0xcb, 0x57, 0x73, 0xc6, 0x57,
+	0xaf, 0xe6, 0x8c, 0x9f, 0x5e, 0xcd, 0x19, 0xef, 0xdf, 0xec, 0x86, 0xf2, 0x49, 0xbf, 0xb3, 0xe8,
+	0xd3, 0xa8, 0xe5, 0x50, 0x81, 0x52, 0x7a, 0xff, 0xe9, 0xd1, 0x9d, 0xd6, 0x9a, 0xc7, 0x79, 0x88,
+	0xfc, 0xda, 0x7f, 0x69, 0x6b, 0xc8, 0x47, 0xe1, 0xce, 0xb8, 0xfe, 0x8c, 0x7b, 0xe3, 0xb7, 0x01,
+	0x00, 0x57, 0x25, 0xa2, 0xce, 0x32, 0x16, 0x00, 0x00,
+}
+
+func (m *PrepareMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
 	return dAtA[:n], nil
 }
@@ -1219,6 +1729,47 @@ func (m *PrepareMsg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ElectionPowersSign) > 0 {
+		i -= len(m.ElectionPowersSign)
+		copy(dAtA[i:], m.ElectionPowersSign)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.ElectionPowersSign)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.ProposalDeadline != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.ProposalDeadline))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.CommitEndingTimeout != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.CommitEndingTimeout))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.ConfirmVotingTimeout != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.ConfirmVotingTimeout))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.PrepareVotingTimeout != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.PrepareVotingTimeout))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.ElectionProof) > 0 {
+		i -= len(m.ElectionProof)
+		copy(dAtA[i:], m.ElectionProof)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.ElectionProof)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.ElectionSeed) > 0 {
+		i -= len(m.ElectionSeed)
+		copy(dAtA[i:], m.ElectionSeed)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.ElectionSeed)))
+		i--
+		dAtA[i] = 0x42
+	}
 	if len(m.Sign) > 0 {
 		i -= len(m.Sign)
 		copy(dAtA[i:], m.Sign)
@@ -1691,6 +2242,20 @@ func (m *TaskResultMsg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.ResourceUsageList) > 0 {
+		for iNdEx := len(m.ResourceUsageList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ResourceUsageList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	if len(m.Sign) > 0 {
 		i -= len(m.Sign)
 		copy(dAtA[i:], m.Sign)
@@ -1753,7 +2318,7 @@ func (m *TaskResultMsg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *DataSupplierOption) Marshal() (dAtA []byte, err error) {
+func (m *TaskCancelMsg) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1763,12 +2328,12 @@ func (m *DataSupplierOption) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DataSupplierOption) MarshalTo(dAtA []byte) (int, error) {
+func (m *TaskCancelMsg) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DataSupplierOption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TaskCancelMsg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1777,34 +2342,21 @@ func (m *DataSupplierOption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if len(m.ColumnIndexList) > 0 {
-		dAtA11 := make([]byte, len(m.ColumnIndexList)*10)
-		var j10 int
-		for _, num := range m.ColumnIndexList {
-			for num >= 1<<7 {
-				dAtA11[j10] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j10++
-			}
-			dAtA11[j10] = uint8(num)
-			j10++
-		}
-		i -= j10
-		copy(dAtA[i:], dAtA11[:j10])
-		i = encodeVarintMessage(dAtA, i, uint64(j10))
+	if len(m.Sign) > 0 {
+		i -= len(m.Sign)
+		copy(dAtA[i:], m.Sign)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Sign)))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x3a
 	}
-	if len(m.MetaDataId) > 0 {
-		i -= len(m.MetaDataId)
-		copy(dAtA[i:], m.MetaDataId)
-		i = encodeVarintMessage(dAtA, i, uint64(len(m.MetaDataId)))
+	if m.CreateAt != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.CreateAt))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x30
 	}
-	if m.MemberInfo != nil {
+	if m.Owner != nil {
 		{
-			size, err := m.MemberInfo.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Owner.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1812,17 +2364,195 @@ func (m *DataSupplierOption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintMessage(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x2a
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *PowerSupplierOption) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TaskPartyId) > 0 {
+		i -= len(m.TaskPartyId)
+		copy(dAtA[i:], m.TaskPartyId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.TaskPartyId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TaskRole) > 0 {
+		i -= len(m.TaskRole)
+		copy(dAtA[i:], m.TaskRole)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.TaskRole)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProposalId) > 0 {
+		i -= len(m.ProposalId)
+		copy(dAtA[i:], m.ProposalId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.ProposalId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaskProgressMsg) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskProgressMsg) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskProgressMsg) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Sign) > 0 {
+		i -= len(m.Sign)
+		copy(dAtA[i:], m.Sign)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Sign)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.CreateAt != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.CreateAt))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.ProgressList) > 0 {
+		for iNdEx := len(m.ProgressList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProgressList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMessage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.Owner != nil {
+		{
+			size, err := m.Owner.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TaskRole) > 0 {
+		i -= len(m.TaskRole)
+		copy(dAtA[i:], m.TaskRole)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.TaskRole)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProposalId) > 0 {
+		i -= len(m.ProposalId)
+		copy(dAtA[i:], m.ProposalId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.ProposalId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DataSupplierOption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DataSupplierOption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DataSupplierOption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ColumnIndexList) > 0 {
+		dAtA13 := make([]byte, len(m.ColumnIndexList)*10)
+		var j12 int
+		for _, num := range m.ColumnIndexList {
+			for num >= 1<<7 {
+				dAtA13[j12] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j12++
+			}
+			dAtA13[j12] = uint8(num)
+			j12++
+		}
+		i -= j12
+		copy(dAtA[i:], dAtA13[:j12])
+		i = encodeVarintMessage(dAtA, i, uint64(j12))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MetaDataId) > 0 {
+		i -= len(m.MetaDataId)
+		copy(dAtA[i:], m.MetaDataId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.MetaDataId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MemberInfo != nil {
+		{
+			size, err := m.MemberInfo.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMessage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PowerSupplierOption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
 	return dAtA[:n], nil
 }
@@ -2059,7 +2789,7 @@ func (m *TaskOrganizationIdentityInfo) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *TaskEvent) Marshal() (dAtA []byte, err error) {
+func (m *TaskResourceUsage) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2069,12 +2799,12 @@ func (m *TaskEvent) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *TaskEvent) MarshalTo(dAtA []byte) (int, error) {
+func (m *TaskResourceUsage) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *TaskEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *TaskResourceUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2083,15 +2813,35 @@ func (m *TaskEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	if m.CreateAt != 0 {
-		i = encodeVarintMessage(dAtA, i, uint64(m.CreateAt))
+	if m.UpdateAt != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.UpdateAt))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.UsedDuration != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.UsedDuration))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.UsedBandwidth != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.UsedBandwidth))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.UsedProcessor != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.UsedProcessor))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.UsedMem != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.UsedMem))
 		i--
 		dAtA[i] = 0x28
 	}
-	if len(m.Content) > 0 {
-		i -= len(m.Content)
-		copy(dAtA[i:], m.Content)
-		i = encodeVarintMessage(dAtA, i, uint64(len(m.Content)))
+	if len(m.JobNodeId) > 0 {
+		i -= len(m.JobNodeId)
+		copy(dAtA[i:], m.JobNodeId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.JobNodeId)))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -2102,66 +2852,232 @@ func (m *TaskEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1a
 	}
+	if len(m.PartyId) > 0 {
+		i -= len(m.PartyId)
+		copy(dAtA[i:], m.PartyId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.PartyId)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.TaskId) > 0 {
 		i -= len(m.TaskId)
 		copy(dAtA[i:], m.TaskId)
 		i = encodeVarintMessage(dAtA, i, uint64(len(m.TaskId)))
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Type) > 0 {
-		i -= len(m.Type)
-		copy(dAtA[i:], m.Type)
-		i = encodeVarintMessage(dAtA, i, uint64(len(m.Type)))
-		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintMessage(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMessage(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *TaskProgress) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *PrepareMsg) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *TaskProgress) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskProgress) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ProposalId)
-	if l > 0 {
-		n += 1 + l + sovMessage(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.TaskRole)
-	if l > 0 {
-		n += 1 + l + sovMessage(uint64(l))
+	if m.UpdateAt != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.UpdateAt))
+		i--
+		dAtA[i] = 0x48
 	}
-	l = len(m.TaskPartyId)
-	if l > 0 {
-		n += 1 + l + sovMessage(uint64(l))
+	if m.RemainTime != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.RemainTime))
+		i--
+		dAtA[i] = 0x40
 	}
-	if m.Owner != nil {
-		l = m.Owner.Size()
-		n += 1 + l + sovMessage(uint64(l))
+	if m.ElapsedTime != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.ElapsedTime))
+		i--
+		dAtA[i] = 0x38
 	}
-	l = len(m.TaskInfo)
-	if l > 0 {
-		n += 1 + l + sovMessage(uint64(l))
+	if m.Percent != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.Percent))
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.CreateAt != 0 {
-		n += 1 + sovMessage(uint64(m.CreateAt))
+	if len(m.Phase) > 0 {
+		i -= len(m.Phase)
+		copy(dAtA[i:], m.Phase)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Phase)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.Sign)
-	if l > 0 {
-		n += 1 + l + sovMessage(uint64(l))
+	if len(m.JobNodeId) > 0 {
+		i -= len(m.JobNodeId)
+		copy(dAtA[i:], m.JobNodeId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.JobNodeId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.IdentityId) > 0 {
+		i -= len(m.IdentityId)
+		copy(dAtA[i:], m.IdentityId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.IdentityId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PartyId) > 0 {
+		i -= len(m.PartyId)
+		copy(dAtA[i:], m.PartyId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.PartyId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaskEvent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskEvent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.CreateAt != 0 {
+		i = encodeVarintMessage(dAtA, i, uint64(m.CreateAt))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Content) > 0 {
+		i -= len(m.Content)
+		copy(dAtA[i:], m.Content)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Content)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.IdentityId) > 0 {
+		i -= len(m.IdentityId)
+		copy(dAtA[i:], m.IdentityId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.IdentityId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintMessage(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMessage(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMessage(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PrepareMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProposalId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.TaskRole)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.TaskPartyId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.Owner != nil {
+		l = m.Owner.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.TaskInfo)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.CreateAt != 0 {
+		n += 1 + sovMessage(uint64(m.CreateAt))
+	}
+	l = len(m.Sign)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.ElectionSeed)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.ElectionProof)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.PrepareVotingTimeout != 0 {
+		n += 1 + sovMessage(uint64(m.PrepareVotingTimeout))
+	}
+	if m.ConfirmVotingTimeout != 0 {
+		n += 1 + sovMessage(uint64(m.ConfirmVotingTimeout))
+	}
+	if m.CommitEndingTimeout != 0 {
+		n += 1 + sovMessage(uint64(m.CommitEndingTimeout))
+	}
+	if m.ProposalDeadline != 0 {
+		n += 1 + sovMessage(uint64(m.ProposalDeadline))
+	}
+	l = len(m.ElectionPowersSign)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -2386,6 +3302,92 @@ func (m *TaskResultMsg) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMessage(uint64(l))
 	}
+	if len(m.ResourceUsageList) > 0 {
+		for _, e := range m.ResourceUsageList {
+			l = e.Size()
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TaskCancelMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProposalId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.TaskRole)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.TaskPartyId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.Owner != nil {
+		l = m.Owner.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.CreateAt != 0 {
+		n += 1 + sovMessage(uint64(m.CreateAt))
+	}
+	l = len(m.Sign)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TaskProgressMsg) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProposalId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.TaskRole)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.Owner != nil {
+		l = m.Owner.Size()
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if len(m.ProgressList) > 0 {
+		for _, e := range m.ProgressList {
+			l = e.Size()
+			n += 1 + l + sovMessage(uint64(l))
+		}
+	}
+	if m.CreateAt != 0 {
+		n += 1 + sovMessage(uint64(m.CreateAt))
+	}
+	l = len(m.Sign)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2533,17 +3535,17 @@ func (m *TaskOrganizationIdentityInfo) Size() (n int) {
 	return n
 }
 
-func (m *TaskEvent) Size() (n int) {
+func (m *TaskResourceUsage) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Type)
+	l = len(m.TaskId)
 	if l > 0 {
 		n += 1 + l + sovMessage(uint64(l))
 	}
-	l = len(m.TaskId)
+	l = len(m.PartyId)
 	if l > 0 {
 		n += 1 + l + sovMessage(uint64(l))
 	}
@@ -2551,12 +3553,24 @@ func (m *TaskEvent) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMessage(uint64(l))
 	}
-	l = len(m.Content)
+	l = len(m.JobNodeId)
 	if l > 0 {
 		n += 1 + l + sovMessage(uint64(l))
 	}
-	if m.CreateAt != 0 {
-		n += 1 + sovMessage(uint64(m.CreateAt))
+	if m.UsedMem != 0 {
+		n += 1 + sovMessage(uint64(m.UsedMem))
+	}
+	if m.UsedProcessor != 0 {
+		n += 1 + sovMessage(uint64(m.UsedProcessor))
+	}
+	if m.UsedBandwidth != 0 {
+		n += 1 + sovMessage(uint64(m.UsedBandwidth))
+	}
+	if m.UsedDuration != 0 {
+		n += 1 + sovMessage(uint64(m.UsedDuration))
+	}
+	if m.UpdateAt != 0 {
+		n += 1 + sovMessage(uint64(m.UpdateAt))
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -2564,29 +3578,104 @@ func (m *TaskEvent) Size() (n int) {
 	return n
 }
 
-func sovMessage(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMessage(x uint64) (n int) {
-	return sovMessage(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (m *PrepareMsg) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMessage
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
+func (m *TaskProgress) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.PartyId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.IdentityId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.JobNodeId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.Phase)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.Percent != 0 {
+		n += 1 + sovMessage(uint64(m.Percent))
+	}
+	if m.ElapsedTime != 0 {
+		n += 1 + sovMessage(uint64(m.ElapsedTime))
+	}
+	if m.RemainTime != 0 {
+		n += 1 + sovMessage(uint64(m.RemainTime))
+	}
+	if m.UpdateAt != 0 {
+		n += 1 + sovMessage(uint64(m.UpdateAt))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TaskEvent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.IdentityId)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	l = len(m.Content)
+	if l > 0 {
+		n += 1 + l + sovMessage(uint64(l))
+	}
+	if m.CreateAt != 0 {
+		n += 1 + sovMessage(uint64(m.CreateAt))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovMessage(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMessage(x uint64) (n int) {
+	return sovMessage(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PrepareMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
 				break
 			}
 		}
@@ -2824,6 +3913,184 @@ func (m *PrepareMsg) Unmarshal(dAtA []byte) error {
 				m.Sign = []byte{}
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ElectionSeed", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ElectionSeed = append(m.ElectionSeed[:0], dAtA[iNdEx:postIndex]...)
+			if m.ElectionSeed == nil {
+				m.ElectionSeed = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ElectionProof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ElectionProof = append(m.ElectionProof[:0], dAtA[iNdEx:postIndex]...)
+			if m.ElectionProof == nil {
+				m.ElectionProof = []byte{}
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrepareVotingTimeout", wireType)
+			}
+			m.PrepareVotingTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PrepareVotingTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfirmVotingTimeout", wireType)
+			}
+			m.ConfirmVotingTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConfirmVotingTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitEndingTimeout", wireType)
+			}
+			m.CommitEndingTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitEndingTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalDeadline", wireType)
+			}
+			m.ProposalDeadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalDeadline |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ElectionPowersSign", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ElectionPowersSign = append(m.ElectionPowersSign[:0], dAtA[iNdEx:postIndex]...)
+			if m.ElectionPowersSign == nil {
+				m.ElectionPowersSign = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
@@ -4329,14 +5596,48 @@ func (m *TaskResultMsg) Unmarshal(dAtA []byte) error {
 				m.Sign = []byte{}
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMessage(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResourceUsageList", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMessage
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ResourceUsageList = append(m.ResourceUsageList, &TaskResourceUsage{})
+			if err := m.ResourceUsageList[len(m.ResourceUsageList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessage
 			}
 			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
@@ -4351,7 +5652,7 @@ func (m *TaskResultMsg) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DataSupplierOption) Unmarshal(dAtA []byte) error {
+func (m *TaskCancelMsg) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4374,15 +5675,151 @@ func (m *DataSupplierOption) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DataSupplierOption: wiretype end group for non-group")
+			return fmt.Errorf("proto: TaskCancelMsg: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DataSupplierOption: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TaskCancelMsg: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemberInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalId = append(m.ProposalId[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposalId == nil {
+				m.ProposalId = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskRole", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskRole = append(m.TaskRole[:0], dAtA[iNdEx:postIndex]...)
+			if m.TaskRole == nil {
+				m.TaskRole = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskPartyId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskPartyId = append(m.TaskPartyId[:0], dAtA[iNdEx:postIndex]...)
+			if m.TaskPartyId == nil {
+				m.TaskPartyId = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskId = append(m.TaskId[:0], dAtA[iNdEx:postIndex]...)
+			if m.TaskId == nil {
+				m.TaskId = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4394,31 +5831,1130 @@ func (m *DataSupplierOption) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Owner == nil {
+				m.Owner = &TaskOrganizationIdentityInfo{}
+			}
+			if err := m.Owner.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateAt", wireType)
+			}
+			m.CreateAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreateAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sign", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sign = append(m.Sign[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sign == nil {
+				m.Sign = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskProgressMsg) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskProgressMsg: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskProgressMsg: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposalId = append(m.ProposalId[:0], dAtA[iNdEx:postIndex]...)
+			if m.ProposalId == nil {
+				m.ProposalId = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskRole", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskRole = append(m.TaskRole[:0], dAtA[iNdEx:postIndex]...)
+			if m.TaskRole == nil {
+				m.TaskRole = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskId = append(m.TaskId[:0], dAtA[iNdEx:postIndex]...)
+			if m.TaskId == nil {
+				m.TaskId = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Owner == nil {
+				m.Owner = &TaskOrganizationIdentityInfo{}
+			}
+			if err := m.Owner.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgressList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProgressList = append(m.ProgressList, &TaskProgress{})
+			if err := m.ProgressList[len(m.ProgressList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateAt", wireType)
+			}
+			m.CreateAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreateAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sign", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sign = append(m.Sign[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sign == nil {
+				m.Sign = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DataSupplierOption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DataSupplierOption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DataSupplierOption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MemberInfo == nil {
+				m.MemberInfo = &TaskOrganizationIdentityInfo{}
+			}
+			if err := m.MemberInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetaDataId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetaDataId = append(m.MetaDataId[:0], dAtA[iNdEx:postIndex]...)
+			if m.MetaDataId == nil {
+				m.MetaDataId = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMessage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ColumnIndexList = append(m.ColumnIndexList, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMessage
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMessage
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthMessage
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ColumnIndexList) == 0 {
+					m.ColumnIndexList = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMessage
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ColumnIndexList = append(m.ColumnIndexList, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ColumnIndexList", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PowerSupplierOption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PowerSupplierOption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PowerSupplierOption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MemberInfo == nil {
+				m.MemberInfo = &TaskOrganizationIdentityInfo{}
+			}
+			if err := m.MemberInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReceiverOption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReceiverOption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReceiverOption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberInfo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.MemberInfo == nil {
+				m.MemberInfo = &TaskOrganizationIdentityInfo{}
+			}
+			if err := m.MemberInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Providers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Providers = append(m.Providers, &TaskOrganizationIdentityInfo{})
+			if err := m.Providers[len(m.Providers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskOperationCost) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskOperationCost: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskOperationCost: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CostMem", wireType)
+			}
+			m.CostMem = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CostMem |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CostProcessor", wireType)
+			}
+			m.CostProcessor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CostProcessor |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CostBandwidth", wireType)
+			}
+			m.CostBandwidth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CostBandwidth |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			m.Duration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Duration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskPeerInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskPeerInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskPeerInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ip", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ip = append(m.Ip[:0], dAtA[iNdEx:postIndex]...)
+			if m.Ip == nil {
+				m.Ip = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Port = append(m.Port[:0], dAtA[iNdEx:postIndex]...)
+			if m.Port == nil {
+				m.Port = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartyId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PartyId = append(m.PartyId[:0], dAtA[iNdEx:postIndex]...)
+			if m.PartyId == nil {
+				m.PartyId = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMessage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskOrganizationIdentityInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMessage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskOrganizationIdentityInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskOrganizationIdentityInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthMessage
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMessage
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MemberInfo == nil {
-				m.MemberInfo = &TaskOrganizationIdentityInfo{}
-			}
-			if err := m.MemberInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Name = append(m.Name[:0], dAtA[iNdEx:postIndex]...)
+			if m.Name == nil {
+				m.Name = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetaDataId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4445,87 +6981,79 @@ func (m *DataSupplierOption) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MetaDataId = append(m.MetaDataId[:0], dAtA[iNdEx:postIndex]...)
-			if m.MetaDataId == nil {
-				m.MetaDataId = []byte{}
+			m.NodeId = append(m.NodeId[:0], dAtA[iNdEx:postIndex]...)
+			if m.NodeId == nil {
+				m.NodeId = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowMessage
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IdentityId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
 				}
-				m.ColumnIndexList = append(m.ColumnIndexList, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowMessage
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthMessage
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthMessage
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IdentityId = append(m.IdentityId[:0], dAtA[iNdEx:postIndex]...)
+			if m.IdentityId == nil {
+				m.IdentityId = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartyId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.ColumnIndexList) == 0 {
-					m.ColumnIndexList = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowMessage
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.ColumnIndexList = append(m.ColumnIndexList, v)
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field ColumnIndexList", wireType)
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PartyId = append(m.PartyId[:0], dAtA[iNdEx:postIndex]...)
+			if m.PartyId == nil {
+				m.PartyId = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
@@ -4548,7 +7076,7 @@ func (m *DataSupplierOption) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PowerSupplierOption) Unmarshal(dAtA []byte) error {
+func (m *TaskResourceUsage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4571,17 +7099,17 @@ func (m *PowerSupplierOption) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PowerSupplierOption: wiretype end group for non-group")
+			return fmt.Errorf("proto: TaskResourceUsage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PowerSupplierOption: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TaskResourceUsage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemberInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -4591,84 +7119,65 @@ func (m *PowerSupplierOption) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthMessage
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMessage
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MemberInfo == nil {
-				m.MemberInfo = &TaskOrganizationIdentityInfo{}
-			}
-			if err := m.MemberInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.TaskId = append(m.TaskId[:0], dAtA[iNdEx:postIndex]...)
+			if m.TaskId == nil {
+				m.TaskId = []byte{}
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMessage(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartyId", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMessage
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if byteLen < 0 {
+				return ErrInvalidLengthMessage
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ReceiverOption) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMessage
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMessage
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.PartyId = append(m.PartyId[:0], dAtA[iNdEx:postIndex]...)
+			if m.PartyId == nil {
+				m.PartyId = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ReceiverOption: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReceiverOption: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MemberInfo", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IdentityId", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -4678,33 +7187,31 @@ func (m *ReceiverOption) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthMessage
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMessage
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.MemberInfo == nil {
-				m.MemberInfo = &TaskOrganizationIdentityInfo{}
-			}
-			if err := m.MemberInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.IdentityId = append(m.IdentityId[:0], dAtA[iNdEx:postIndex]...)
+			if m.IdentityId == nil {
+				m.IdentityId = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Providers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobNodeId", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -4714,82 +7221,50 @@ func (m *ReceiverOption) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthMessage
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMessage
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Providers = append(m.Providers, &TaskOrganizationIdentityInfo{})
-			if err := m.Providers[len(m.Providers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.JobNodeId = append(m.JobNodeId[:0], dAtA[iNdEx:postIndex]...)
+			if m.JobNodeId == nil {
+				m.JobNodeId = []byte{}
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMessage(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMessage
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TaskOperationCost) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMessage
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsedMem", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.UsedMem = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UsedMem |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TaskOperationCost: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TaskOperationCost: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CostMem", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UsedProcessor", wireType)
 			}
-			m.CostMem = 0
+			m.UsedProcessor = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -4799,16 +7274,16 @@ func (m *TaskOperationCost) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CostMem |= uint64(b&0x7F) << shift
+				m.UsedProcessor |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CostProcessor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UsedBandwidth", wireType)
 			}
-			m.CostProcessor = 0
+			m.UsedBandwidth = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -4818,16 +7293,16 @@ func (m *TaskOperationCost) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CostProcessor |= uint64(b&0x7F) << shift
+				m.UsedBandwidth |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CostBandwidth", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UsedDuration", wireType)
 			}
-			m.CostBandwidth = 0
+			m.UsedDuration = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -4837,16 +7312,16 @@ func (m *TaskOperationCost) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.CostBandwidth |= uint64(b&0x7F) << shift
+				m.UsedDuration |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateAt", wireType)
 			}
-			m.Duration = 0
+			m.UpdateAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -4856,7 +7331,7 @@ func (m *TaskOperationCost) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Duration |= uint64(b&0x7F) << shift
+				m.UpdateAt |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -4883,7 +7358,7 @@ func (m *TaskOperationCost) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TaskPeerInfo) Unmarshal(dAtA []byte) error {
+func (m *TaskProgress) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4906,15 +7381,15 @@ func (m *TaskPeerInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TaskPeerInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: TaskProgress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TaskPeerInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TaskProgress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ip", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4941,14 +7416,14 @@ func (m *TaskPeerInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ip = append(m.Ip[:0], dAtA[iNdEx:postIndex]...)
-			if m.Ip == nil {
-				m.Ip = []byte{}
+			m.TaskId = append(m.TaskId[:0], dAtA[iNdEx:postIndex]...)
+			if m.TaskId == nil {
+				m.TaskId = []byte{}
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Port", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartyId", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -4975,14 +7450,14 @@ func (m *TaskPeerInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Port = append(m.Port[:0], dAtA[iNdEx:postIndex]...)
-			if m.Port == nil {
-				m.Port = []byte{}
+			m.PartyId = append(m.PartyId[:0], dAtA[iNdEx:postIndex]...)
+			if m.PartyId == nil {
+				m.PartyId = []byte{}
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PartyId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IdentityId", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5009,65 +7484,14 @@ func (m *TaskPeerInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PartyId = append(m.PartyId[:0], dAtA[iNdEx:postIndex]...)
-			if m.PartyId == nil {
-				m.PartyId = []byte{}
+			m.IdentityId = append(m.IdentityId[:0], dAtA[iNdEx:postIndex]...)
+			if m.IdentityId == nil {
+				m.IdentityId = []byte{}
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMessage(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMessage
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TaskOrganizationIdentityInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMessage
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TaskOrganizationIdentityInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TaskOrganizationIdentityInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobNodeId", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5094,14 +7518,14 @@ func (m *TaskOrganizationIdentityInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = append(m.Name[:0], dAtA[iNdEx:postIndex]...)
-			if m.Name == nil {
-				m.Name = []byte{}
+			m.JobNodeId = append(m.JobNodeId[:0], dAtA[iNdEx:postIndex]...)
+			if m.JobNodeId == nil {
+				m.JobNodeId = []byte{}
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NodeId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5128,16 +7552,16 @@ func (m *TaskOrganizationIdentityInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NodeId = append(m.NodeId[:0], dAtA[iNdEx:postIndex]...)
-			if m.NodeId == nil {
-				m.NodeId = []byte{}
+			m.Phase = append(m.Phase[:0], dAtA[iNdEx:postIndex]...)
+			if m.Phase == nil {
+				m.Phase = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IdentityId", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percent", wireType)
 			}
-			var byteLen int
+			m.Percent = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -5147,31 +7571,16 @@ func (m *TaskOrganizationIdentityInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.Percent |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthMessage
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMessage
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.IdentityId = append(m.IdentityId[:0], dAtA[iNdEx:postIndex]...)
-			if m.IdentityId == nil {
-				m.IdentityId = []byte{}
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PartyId", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ElapsedTime", wireType)
 			}
-			var byteLen int
+			m.ElapsedTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMessage
@@ -5181,26 +7590,49 @@ func (m *TaskOrganizationIdentityInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.ElapsedTime |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthMessage
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainTime", wireType)
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMessage
+			m.RemainTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateAt", wireType)
 			}
-			m.PartyId = append(m.PartyId[:0], dAtA[iNdEx:postIndex]...)
-			if m.PartyId == nil {
-				m.PartyId = []byte{}
+			m.UpdateAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMessage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdateAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMessage(dAtA[iNdEx:])
@@ -558,6 +558,324 @@ func (m *TaskEventArrayPB) GetTaskEventList() []*types.EventData {
 	return nil
 }
 
+// 本地某个任务在各计算服务上实际消耗的资源
+type TaskResourceUsagePB struct {
+	TaskId               string   `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	PartyId              string   `protobuf:"bytes,2,opt,name=party_id,json=partyId,proto3" json:"party_id,omitempty"`
+	IdentityId           string   `protobuf:"bytes,3,opt,name=identity_id,json=identityId,proto3" json:"identity_id,omitempty"`
+	JobNodeId            string   `protobuf:"bytes,4,opt,name=job_node_id,json=jobNodeId,proto3" json:"job_node_id,omitempty"`
+	UsedMem              uint64   `protobuf:"varint,5,opt,name=used_mem,json=usedMem,proto3" json:"used_mem,omitempty"`
+	UsedProcessor        uint64   `protobuf:"varint,6,opt,name=used_processor,json=usedProcessor,proto3" json:"used_processor,omitempty"`
+	UsedBandwidth        uint64   `protobuf:"varint,7,opt,name=used_bandwidth,json=usedBandwidth,proto3" json:"used_bandwidth,omitempty"`
+	UsedDuration         uint64   `protobuf:"varint,8,opt,name=used_duration,json=usedDuration,proto3" json:"used_duration,omitempty"`
+	UpdateAt             uint64   `protobuf:"varint,9,opt,name=update_at,json=updateAt,proto3" json:"update_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TaskResourceUsagePB) Reset()         { *m = TaskResourceUsagePB{} }
+func (m *TaskResourceUsagePB) String() string { return proto.CompactTextString(m) }
+func (*TaskResourceUsagePB) ProtoMessage()    {}
+func (*TaskResourceUsagePB) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5465d80e8cba02e3, []int{10}
+}
+func (m *TaskResourceUsagePB) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskResourceUsagePB) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskResourceUsagePB.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TaskResourceUsagePB) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskResourceUsagePB.Merge(m, src)
+}
+func (m *TaskResourceUsagePB) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskResourceUsagePB) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskResourceUsagePB.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskResourceUsagePB proto.InternalMessageInfo
+
+func (m *TaskResourceUsagePB) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
+	}
+	return ""
+}
+
+func (m *TaskResourceUsagePB) GetPartyId() string {
+	if m != nil {
+		return m.PartyId
+	}
+	return ""
+}
+
+func (m *TaskResourceUsagePB) GetIdentityId() string {
+	if m != nil {
+		return m.IdentityId
+	}
+	return ""
+}
+
+func (m *TaskResourceUsagePB) GetJobNodeId() string {
+	if m != nil {
+		return m.JobNodeId
+	}
+	return ""
+}
+
+func (m *TaskResourceUsagePB) GetUsedMem() uint64 {
+	if m != nil {
+		return m.UsedMem
+	}
+	return 0
+}
+
+func (m *TaskResourceUsagePB) GetUsedProcessor() uint64 {
+	if m != nil {
+		return m.UsedProcessor
+	}
+	return 0
+}
+
+func (m *TaskResourceUsagePB) GetUsedBandwidth() uint64 {
+	if m != nil {
+		return m.UsedBandwidth
+	}
+	return 0
+}
+
+func (m *TaskResourceUsagePB) GetUsedDuration() uint64 {
+	if m != nil {
+		return m.UsedDuration
+	}
+	return 0
+}
+
+func (m *TaskResourceUsagePB) GetUpdateAt() uint64 {
+	if m != nil {
+		return m.UpdateAt
+	}
+	return 0
+}
+
+type TaskResourceUsageArrayPB struct {
+	UsageList            []*TaskResourceUsagePB `protobuf:"bytes,1,rep,name=usage_list,json=usageList,proto3" json:"usage_list,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *TaskResourceUsageArrayPB) Reset()         { *m = TaskResourceUsageArrayPB{} }
+func (m *TaskResourceUsageArrayPB) String() string { return proto.CompactTextString(m) }
+func (*TaskResourceUsageArrayPB) ProtoMessage()    {}
+func (*TaskResourceUsageArrayPB) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5465d80e8cba02e3, []int{11}
+}
+func (m *TaskResourceUsageArrayPB) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskResourceUsageArrayPB) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskResourceUsageArrayPB.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TaskResourceUsageArrayPB) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskResourceUsageArrayPB.Merge(m, src)
+}
+func (m *TaskResourceUsageArrayPB) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskResourceUsageArrayPB) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskResourceUsageArrayPB.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskResourceUsageArrayPB proto.InternalMessageInfo
+
+func (m *TaskResourceUsageArrayPB) GetUsageList() []*TaskResourceUsagePB {
+	if m != nil {
+		return m.UsageList
+	}
+	return nil
+}
+
+// 本地某个任务在各参与方计算服务上的最新执行进度
+type TaskProgressPB struct {
+	TaskId               string   `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	PartyId              string   `protobuf:"bytes,2,opt,name=party_id,json=partyId,proto3" json:"party_id,omitempty"`
+	IdentityId           string   `protobuf:"bytes,3,opt,name=identity_id,json=identityId,proto3" json:"identity_id,omitempty"`
+	JobNodeId            string   `protobuf:"bytes,4,opt,name=job_node_id,json=jobNodeId,proto3" json:"job_node_id,omitempty"`
+	Phase                string   `protobuf:"bytes,5,opt,name=phase,proto3" json:"phase,omitempty"`
+	Percent              uint64   `protobuf:"varint,6,opt,name=percent,proto3" json:"percent,omitempty"`
+	ElapsedTime          uint64   `protobuf:"varint,7,opt,name=elapsed_time,json=elapsedTime,proto3" json:"elapsed_time,omitempty"`
+	RemainTime           uint64   `protobuf:"varint,8,opt,name=remain_time,json=remainTime,proto3" json:"remain_time,omitempty"`
+	UpdateAt             uint64   `protobuf:"varint,9,opt,name=update_at,json=updateAt,proto3" json:"update_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TaskProgressPB) Reset()         { *m = TaskProgressPB{} }
+func (m *TaskProgressPB) String() string { return proto.CompactTextString(m) }
+func (*TaskProgressPB) ProtoMessage()    {}
+func (*TaskProgressPB) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5465d80e8cba02e3, []int{12}
+}
+func (m *TaskProgressPB) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskProgressPB) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskProgressPB.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TaskProgressPB) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskProgressPB.Merge(m, src)
+}
+func (m *TaskProgressPB) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskProgressPB) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskProgressPB.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskProgressPB proto.InternalMessageInfo
+
+func (m *TaskProgressPB) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
+	}
+	return ""
+}
+
+func (m *TaskProgressPB) GetPartyId() string {
+	if m != nil {
+		return m.PartyId
+	}
+	return ""
+}
+
+func (m *TaskProgressPB) GetIdentityId() string {
+	if m != nil {
+		return m.IdentityId
+	}
+	return ""
+}
+
+func (m *TaskProgressPB) GetJobNodeId() string {
+	if m != nil {
+		return m.JobNodeId
+	}
+	return ""
+}
+
+func (m *TaskProgressPB) GetPhase() string {
+	if m != nil {
+		return m.Phase
+	}
+	return ""
+}
+
+func (m *TaskProgressPB) GetPercent() uint64 {
+	if m != nil {
+		return m.Percent
+	}
+	return 0
+}
+
+func (m *TaskProgressPB) GetElapsedTime() uint64 {
+	if m != nil {
+		return m.ElapsedTime
+	}
+	return 0
+}
+
+func (m *TaskProgressPB) GetRemainTime() uint64 {
+	if m != nil {
+		return m.RemainTime
+	}
+	return 0
+}
+
+func (m *TaskProgressPB) GetUpdateAt() uint64 {
+	if m != nil {
+		return m.UpdateAt
+	}
+	return 0
+}
+
+type TaskProgressArrayPB struct {
+	ProgressList         []*TaskProgressPB `protobuf:"bytes,1,rep,name=progress_list,json=progressList,proto3" json:"progress_list,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *TaskProgressArrayPB) Reset()         { *m = TaskProgressArrayPB{} }
+func (m *TaskProgressArrayPB) String() string { return proto.CompactTextString(m) }
+func (*TaskProgressArrayPB) ProtoMessage()    {}
+func (*TaskProgressArrayPB) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5465d80e8cba02e3, []int{13}
+}
+func (m *TaskProgressArrayPB) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskProgressArrayPB) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskProgressArrayPB.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TaskProgressArrayPB) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskProgressArrayPB.Merge(m, src)
+}
+func (m *TaskProgressArrayPB) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskProgressArrayPB) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskProgressArrayPB.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskProgressArrayPB proto.InternalMessageInfo
+
+func (m *TaskProgressArrayPB) GetProgressList() []*TaskProgressPB {
+	if m != nil {
+		return m.ProgressList
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*SeedNodePB)(nil), "db.SeedNodePB")
 	proto.RegisterType((*SeedNodeListPB)(nil), "db.SeedNodeListPB")
@@ -569,42 +887,64 @@ func init() {
 	proto.RegisterType((*StringArrayPB)(nil), "db.StringArrayPB")
 	proto.RegisterType((*TaskArrayPB)(nil), "db.TaskArrayPB")
 	proto.RegisterType((*TaskEventArrayPB)(nil), "db.TaskEventArrayPB")
+	proto.RegisterType((*TaskResourceUsagePB)(nil), "db.TaskResourceUsagePB")
+	proto.RegisterType((*TaskResourceUsageArrayPB)(nil), "db.TaskResourceUsageArrayPB")
+	proto.RegisterType((*TaskProgressPB)(nil), "db.TaskProgressPB")
+	proto.RegisterType((*TaskProgressArrayPB)(nil), "db.TaskProgressArrayPB")
 }
 
 func init() { proto.RegisterFile("lib/db/structs.proto", fileDescriptor_5465d80e8cba02e3) }
 
 var fileDescriptor_5465d80e8cba02e3 = []byte{
-	// 478 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x93, 0x4f, 0x6b, 0xdb, 0x30,
-	0x18, 0xc6, 0x51, 0x9a, 0x94, 0xfa, 0xcd, 0x9f, 0x06, 0xe3, 0x83, 0x29, 0x2c, 0x0d, 0x2e, 0x83,
-	0x1c, 0x36, 0x1b, 0xda, 0x52, 0x06, 0x3b, 0xcd, 0xdb, 0x32, 0x06, 0x65, 0x18, 0x67, 0xbb, 0xec,
-	0xb0, 0x20, 0x47, 0x9a, 0x27, 0x9a, 0x59, 0x46, 0x7a, 0x93, 0xad, 0xc7, 0x7d, 0xbb, 0x5d, 0x06,
-	0xfb, 0x08, 0x23, 0x9f, 0x64, 0x48, 0x8e, 0x53, 0xaf, 0xd9, 0xb5, 0x37, 0xe9, 0x79, 0x1e, 0xfd,
-	0x78, 0xf5, 0x20, 0x81, 0xb7, 0x14, 0x59, 0xc4, 0xb2, 0x48, 0xa3, 0x5a, 0x2d, 0x50, 0x87, 0xa5,
-	0x92, 0x28, 0xdd, 0x16, 0xcb, 0x4e, 0xce, 0x14, 0x2f, 0xa5, 0x8e, 0xac, 0x90, 0xad, 0x3e, 0x47,
-	0xb9, 0xcc, 0xa5, 0xdd, 0xd8, 0x55, 0x15, 0x3c, 0xf1, 0xcd, 0x71, 0xbc, 0x2d, 0xb9, 0x8e, 0x90,
-	0xea, 0x1b, 0x46, 0x91, 0x56, 0x4e, 0xf0, 0x83, 0x00, 0xcc, 0x38, 0x67, 0xef, 0x24, 0xe3, 0x49,
-	0xec, 0x0e, 0xa0, 0x25, 0x98, 0x4f, 0xc6, 0x64, 0xe2, 0xa4, 0x2d, 0xc1, 0xdc, 0x53, 0xe8, 0x8a,
-	0x02, 0xb9, 0x2a, 0xe8, 0x72, 0x2e, 0x4a, 0xbf, 0x65, 0x0d, 0xa8, 0xa5, 0xb7, 0xa5, 0x7b, 0x06,
-	0xfd, 0x5d, 0xa0, 0x94, 0x0a, 0xfd, 0x03, 0x1b, 0xe9, 0xd5, 0x62, 0x22, 0x15, 0xba, 0x8f, 0x00,
-	0x16, 0xb2, 0x28, 0xe6, 0x1a, 0x29, 0x72, 0xbf, 0x3d, 0x26, 0x93, 0x4e, 0xea, 0x18, 0x65, 0x66,
-	0x84, 0x60, 0x0a, 0x83, 0x7a, 0x84, 0x6b, 0xa1, 0x31, 0x89, 0xdd, 0x4b, 0x18, 0x68, 0xce, 0xd9,
-	0xbc, 0x90, 0x8c, 0xcf, 0x97, 0x42, 0xa3, 0x4f, 0xc6, 0x07, 0x93, 0xee, 0xf9, 0x20, 0x64, 0x59,
-	0x78, 0x37, 0x6e, 0xda, 0xd3, 0x8d, 0x73, 0xc1, 0x2f, 0x02, 0xc3, 0x94, 0xe7, 0x42, 0x23, 0x57,
-	0x0f, 0x7c, 0xa3, 0x53, 0xe8, 0xf2, 0xef, 0x77, 0x94, 0x76, 0x45, 0xa9, 0xa5, 0x8a, 0xb2, 0x0b,
-	0x58, 0x4a, 0xa7, 0xa2, 0xd4, 0xe2, 0x7f, 0x7a, 0x39, 0xbc, 0xdf, 0xcb, 0x27, 0xf0, 0xfe, 0xbd,
-	0xce, 0xb6, 0x9d, 0x29, 0x78, 0x6a, 0xa7, 0xef, 0x75, 0xe4, 0x99, 0x8e, 0xee, 0xd7, 0x90, 0xba,
-	0x6a, 0x8f, 0x14, 0xf8, 0x70, 0xf4, 0x41, 0x14, 0x78, 0x71, 0x9e, 0xc4, 0x6e, 0x0f, 0xc8, 0xda,
-	0xb6, 0xd4, 0x4f, 0xc9, 0xba, 0x76, 0xae, 0x2e, 0x9b, 0x4e, 0x7b, 0xeb, 0xcc, 0x50, 0x89, 0x22,
-	0x6f, 0x3a, 0x8e, 0x71, 0x1e, 0x43, 0xbf, 0x72, 0x5e, 0x28, 0x45, 0x6f, 0x93, 0xd8, 0xf5, 0xa0,
-	0x43, 0xcd, 0xd2, 0xce, 0xe5, 0xa4, 0xd5, 0x26, 0x78, 0x0e, 0xdd, 0xf7, 0x54, 0xdf, 0xd4, 0xa1,
-	0x27, 0xe0, 0x98, 0x17, 0xd9, 0xbc, 0xc0, 0x71, 0x68, 0x5f, 0x6a, 0x68, 0x62, 0xaf, 0x28, 0xd2,
-	0xf4, 0xc8, 0x24, 0xec, 0xc4, 0xd7, 0x30, 0x34, 0xea, 0xeb, 0x35, 0x2f, 0xb0, 0x26, 0x3c, 0x83,
-	0x63, 0x4b, 0xe0, 0x46, 0x6c, 0x72, 0x86, 0x5b, 0x8e, 0x4d, 0x5b, 0x50, 0x1f, 0xeb, 0xc3, 0x86,
-	0x16, 0x5f, 0xfd, 0xdc, 0x8c, 0xc8, 0xef, 0xcd, 0x88, 0xfc, 0xd9, 0x8c, 0xc8, 0xc7, 0x49, 0x2e,
-	0xf0, 0xcb, 0x2a, 0x0b, 0x17, 0xf2, 0x6b, 0x94, 0x4a, 0xcd, 0x11, 0xe9, 0x74, 0x29, 0xbf, 0x45,
-	0x2f, 0xa9, 0x52, 0x82, 0xab, 0xa7, 0x6f, 0x64, 0x54, 0x7d, 0xc2, 0xec, 0xd0, 0x7e, 0x9d, 0x8b,
-	0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x4e, 0xb2, 0x62, 0x00, 0x95, 0x03, 0x00, 0x00,
+	// 757 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0xcd, 0x4e, 0x2b, 0x37,
+	0x18, 0xd5, 0x84, 0x84, 0x64, 0xbe, 0xfc, 0x5c, 0xe4, 0x1b, 0xe9, 0x4e, 0x6f, 0xd5, 0x40, 0x83,
+	0x90, 0xb2, 0x68, 0x33, 0x12, 0x20, 0x5a, 0xa9, 0x2b, 0x52, 0x4a, 0x15, 0x89, 0xa2, 0x68, 0x80,
+	0x4d, 0x17, 0x8d, 0x3c, 0xf1, 0xd7, 0x60, 0x48, 0xc6, 0x23, 0xdb, 0x03, 0x61, 0xd9, 0x97, 0xe8,
+	0x9b, 0xf4, 0x1d, 0xba, 0xa9, 0xd4, 0x47, 0xa8, 0x78, 0x92, 0xca, 0xf6, 0x4c, 0x32, 0x40, 0xd5,
+	0x5d, 0xd5, 0x5d, 0xbe, 0x73, 0x8e, 0x8f, 0xec, 0xf3, 0x9d, 0x68, 0xa0, 0xbb, 0xe0, 0x71, 0xc8,
+	0xe2, 0x50, 0x69, 0x99, 0xcd, 0xb4, 0x1a, 0xa6, 0x52, 0x68, 0x41, 0x2a, 0x2c, 0xfe, 0xb8, 0x2f,
+	0x31, 0x15, 0x2a, 0xb4, 0x40, 0x9c, 0xfd, 0x1c, 0xce, 0xc5, 0x5c, 0xd8, 0xc1, 0xfe, 0x72, 0xc2,
+	0x8f, 0x81, 0x39, 0xae, 0x9f, 0x52, 0x54, 0xa1, 0xa6, 0xea, 0x9e, 0x51, 0x4d, 0x1d, 0xd3, 0xff,
+	0xc5, 0x03, 0xb8, 0x42, 0x64, 0x97, 0x82, 0xe1, 0x64, 0x44, 0x3a, 0x50, 0xe1, 0x2c, 0xf0, 0xf6,
+	0xbc, 0x81, 0x1f, 0x55, 0x38, 0x23, 0xbb, 0xd0, 0xe4, 0x89, 0x46, 0x99, 0xd0, 0xc5, 0x94, 0xa7,
+	0x41, 0xc5, 0x12, 0x50, 0x40, 0xe3, 0x94, 0xec, 0x43, 0x7b, 0x2d, 0x48, 0x85, 0xd4, 0xc1, 0x96,
+	0x95, 0xb4, 0x0a, 0x70, 0x22, 0xa4, 0x26, 0x9f, 0x01, 0xcc, 0x44, 0x92, 0x4c, 0x95, 0xa6, 0x1a,
+	0x83, 0xea, 0x9e, 0x37, 0xa8, 0x45, 0xbe, 0x41, 0xae, 0x0c, 0xd0, 0x3f, 0x87, 0x4e, 0x71, 0x85,
+	0x0b, 0xae, 0xf4, 0x64, 0x44, 0x8e, 0xa1, 0xa3, 0x10, 0xd9, 0x34, 0x11, 0x0c, 0xa7, 0x0b, 0xae,
+	0x74, 0xe0, 0xed, 0x6d, 0x0d, 0x9a, 0x87, 0x9d, 0x21, 0x8b, 0x87, 0x9b, 0xeb, 0x46, 0x2d, 0x55,
+	0x3a, 0xd7, 0xff, 0xc3, 0x83, 0x9d, 0x08, 0xe7, 0x5c, 0x69, 0x94, 0xff, 0xf1, 0x8b, 0x76, 0xa1,
+	0x89, 0xab, 0x8d, 0x4b, 0xd5, 0xb9, 0xe0, 0xaa, 0xec, 0x82, 0xab, 0xb2, 0x4b, 0xcd, 0xb9, 0xe0,
+	0xaa, 0xe4, 0xf2, 0x32, 0x97, 0xed, 0xd7, 0xb9, 0xfc, 0x04, 0xdd, 0x97, 0xcf, 0xc9, 0xd3, 0x39,
+	0x87, 0xae, 0x5c, 0xe3, 0x6f, 0x32, 0xea, 0x9a, 0x8c, 0x5e, 0xc7, 0x10, 0x11, 0xf9, 0xc6, 0xa9,
+	0x1f, 0x40, 0xe3, 0x86, 0x27, 0xfa, 0xe8, 0x70, 0x32, 0x22, 0x2d, 0xf0, 0x1e, 0x6c, 0x4a, 0xed,
+	0xc8, 0x7b, 0x28, 0x98, 0x93, 0xe3, 0x32, 0x53, 0xcd, 0x99, 0x2b, 0x2d, 0x79, 0x32, 0x2f, 0x33,
+	0xbe, 0x61, 0x0e, 0xa0, 0xed, 0x98, 0x53, 0x29, 0xe9, 0xd3, 0x64, 0x44, 0xba, 0x50, 0xa3, 0xe6,
+	0xa7, 0xbd, 0x97, 0x1f, 0xb9, 0xa1, 0xff, 0x0d, 0x34, 0xaf, 0xa9, 0xba, 0x2f, 0x44, 0x5f, 0x80,
+	0x6f, 0x1a, 0x59, 0x7e, 0xc0, 0xbb, 0xa1, 0x6d, 0xea, 0xd0, 0xc8, 0xce, 0xa8, 0xa6, 0x51, 0xc3,
+	0x28, 0xec, 0x8d, 0x2f, 0x60, 0xc7, 0xa0, 0xdf, 0x3d, 0x60, 0xa2, 0x0b, 0x87, 0xaf, 0xe1, 0x9d,
+	0x75, 0x40, 0x03, 0x96, 0x7d, 0x76, 0x72, 0x1f, 0xab, 0xb6, 0x46, 0x6d, 0x5d, 0x1c, 0xb6, 0x6e,
+	0xbf, 0x55, 0xe0, 0xbd, 0xb1, 0x8b, 0x50, 0x89, 0x4c, 0xce, 0xf0, 0x46, 0xd1, 0xb9, 0xa9, 0xcc,
+	0x07, 0xa8, 0x5b, 0xc7, 0x75, 0x6f, 0xb6, 0xcd, 0x38, 0x66, 0xe4, 0x13, 0x68, 0xa4, 0x54, 0xea,
+	0x27, 0xc3, 0xb8, 0xe2, 0xd4, 0xed, 0x3c, 0x76, 0xb5, 0x62, 0x98, 0x68, 0xee, 0xd8, 0xad, 0xbc,
+	0x56, 0x39, 0x34, 0x66, 0xa4, 0x07, 0xcd, 0x3b, 0x11, 0xbb, 0x6d, 0x71, 0x96, 0x37, 0xc6, 0xbf,
+	0x13, 0xb1, 0x59, 0x87, 0xf3, 0xce, 0x14, 0xb2, 0xe9, 0x12, 0x97, 0xb6, 0x2b, 0xd5, 0xa8, 0x6e,
+	0xe6, 0x1f, 0x70, 0x49, 0x0e, 0xa0, 0x63, 0xa9, 0x54, 0x8a, 0x19, 0x2a, 0x25, 0xa4, 0xad, 0x4a,
+	0x35, 0x6a, 0x1b, 0x74, 0x52, 0x80, 0x6b, 0x59, 0x4c, 0x13, 0xf6, 0xc8, 0x99, 0xbe, 0x0d, 0xea,
+	0x1b, 0xd9, 0xa8, 0x00, 0x4d, 0x33, 0xad, 0x8c, 0x65, 0x92, 0x6a, 0x2e, 0x92, 0xa0, 0x61, 0x55,
+	0x2d, 0x03, 0x9e, 0xe5, 0x18, 0xf9, 0x14, 0xfc, 0x2c, 0x65, 0x54, 0xe3, 0x94, 0xea, 0xc0, 0xb7,
+	0x82, 0x86, 0x03, 0x4e, 0x75, 0x3f, 0x82, 0xe0, 0x4d, 0x6c, 0xc5, 0x36, 0x4e, 0x00, 0x32, 0x33,
+	0x97, 0x17, 0xf1, 0xc1, 0x34, 0xf2, 0x1f, 0x82, 0x8e, 0x7c, 0x2b, 0xb5, 0xbb, 0xf8, 0xb5, 0x02,
+	0x1d, 0x23, 0x99, 0x48, 0x31, 0x97, 0xa8, 0xd4, 0xff, 0xb5, 0x86, 0x2e, 0xd4, 0xd2, 0x5b, 0xaa,
+	0x30, 0xff, 0xbf, 0xba, 0x81, 0x04, 0x50, 0x4f, 0x51, 0xce, 0x30, 0xd1, 0x79, 0xf4, 0xc5, 0x48,
+	0x3e, 0x87, 0x16, 0x2e, 0x68, 0x6a, 0x02, 0xd5, 0x7c, 0x89, 0x79, 0xe4, 0xcd, 0x1c, 0xbb, 0xe6,
+	0x4b, 0x34, 0x77, 0x92, 0xb8, 0xa4, 0x3c, 0x71, 0x0a, 0x17, 0x37, 0x38, 0xc8, 0x0a, 0xfe, 0x35,
+	0xec, 0x4b, 0x78, 0x5f, 0xce, 0xa5, 0xc8, 0xf9, 0x2b, 0x68, 0xa7, 0x39, 0x54, 0x8e, 0x9a, 0x14,
+	0x51, 0x6f, 0x72, 0x8c, 0x5a, 0x85, 0xd0, 0x04, 0x3d, 0x3a, 0xf9, 0xfd, 0xb9, 0xe7, 0xfd, 0xf9,
+	0xdc, 0xf3, 0xfe, 0x7a, 0xee, 0x79, 0x3f, 0x0e, 0xe6, 0x5c, 0xdf, 0x66, 0xf1, 0x70, 0x26, 0x96,
+	0x61, 0x24, 0x14, 0x6a, 0x4d, 0xcf, 0x17, 0xe2, 0x31, 0xfc, 0x96, 0x4a, 0xc9, 0x51, 0x7e, 0xf9,
+	0xbd, 0x08, 0xdd, 0x97, 0x27, 0xde, 0xb6, 0xdf, 0x8b, 0xa3, 0xbf, 0x07, 0x00, 0x34, 0x10, 0x8e,
+	0xff, 0x8a, 0x06, 0x00, 0x00,
 }
 
 func (m *SeedNodePB) Marshal() (dAtA []byte, err error) {
@@ -1025,55 +1365,299 @@ func (m *TaskEventArrayPB) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintStructs(dAtA []byte, offset int, v uint64) int {
-	offset -= sovStructs(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *TaskResourceUsagePB) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *SeedNodePB) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *TaskResourceUsagePB) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskResourceUsagePB) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovStructs(uint64(l))
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
 	}
-	l = len(m.InternalIp)
-	if l > 0 {
-		n += 1 + l + sovStructs(uint64(l))
+	if m.UpdateAt != 0 {
+		i = encodeVarintStructs(dAtA, i, uint64(m.UpdateAt))
+		i--
+		dAtA[i] = 0x48
 	}
-	l = len(m.InternalPort)
-	if l > 0 {
-		n += 1 + l + sovStructs(uint64(l))
+	if m.UsedDuration != 0 {
+		i = encodeVarintStructs(dAtA, i, uint64(m.UsedDuration))
+		i--
+		dAtA[i] = 0x40
 	}
-	if m.ConnState != 0 {
-		n += 1 + sovStructs(uint64(m.ConnState))
+	if m.UsedBandwidth != 0 {
+		i = encodeVarintStructs(dAtA, i, uint64(m.UsedBandwidth))
+		i--
+		dAtA[i] = 0x38
 	}
-	if m.XXX_unrecognized != nil {
-		n += len(m.XXX_unrecognized)
+	if m.UsedProcessor != 0 {
+		i = encodeVarintStructs(dAtA, i, uint64(m.UsedProcessor))
+		i--
+		dAtA[i] = 0x30
 	}
-	return n
-}
-
-func (m *SeedNodeListPB) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.UsedMem != 0 {
+		i = encodeVarintStructs(dAtA, i, uint64(m.UsedMem))
+		i--
+		dAtA[i] = 0x28
 	}
-	var l int
-	_ = l
-	if len(m.SeedNodeList) > 0 {
-		for _, e := range m.SeedNodeList {
-			l = e.Size()
-			n += 1 + l + sovStructs(uint64(l))
-		}
+	if len(m.JobNodeId) > 0 {
+		i -= len(m.JobNodeId)
+		copy(dAtA[i:], m.JobNodeId)
+		i = encodeVarintStructs(dAtA, i, uint64(len(m.JobNodeId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.IdentityId) > 0 {
+		i -= len(m.IdentityId)
+		copy(dAtA[i:], m.IdentityId)
+		i = encodeVarintStructs(dAtA, i, uint64(len(m.IdentityId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PartyId) > 0 {
+		i -= len(m.PartyId)
+		copy(dAtA[i:], m.PartyId)
+		i = encodeVarintStructs(dAtA, i, uint64(len(m.PartyId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintStructs(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaskResourceUsageArrayPB) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskResourceUsageArrayPB) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskResourceUsageArrayPB) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.UsageList) > 0 {
+		for iNdEx := len(m.UsageList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UsageList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStructs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaskProgressPB) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskProgressPB) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskProgressPB) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.UpdateAt != 0 {
+		i = encodeVarintStructs(dAtA, i, uint64(m.UpdateAt))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.RemainTime != 0 {
+		i = encodeVarintStructs(dAtA, i, uint64(m.RemainTime))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.ElapsedTime != 0 {
+		i = encodeVarintStructs(dAtA, i, uint64(m.ElapsedTime))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.Percent != 0 {
+		i = encodeVarintStructs(dAtA, i, uint64(m.Percent))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Phase) > 0 {
+		i -= len(m.Phase)
+		copy(dAtA[i:], m.Phase)
+		i = encodeVarintStructs(dAtA, i, uint64(len(m.Phase)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.JobNodeId) > 0 {
+		i -= len(m.JobNodeId)
+		copy(dAtA[i:], m.JobNodeId)
+		i = encodeVarintStructs(dAtA, i, uint64(len(m.JobNodeId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.IdentityId) > 0 {
+		i -= len(m.IdentityId)
+		copy(dAtA[i:], m.IdentityId)
+		i = encodeVarintStructs(dAtA, i, uint64(len(m.IdentityId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PartyId) > 0 {
+		i -= len(m.PartyId)
+		copy(dAtA[i:], m.PartyId)
+		i = encodeVarintStructs(dAtA, i, uint64(len(m.PartyId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintStructs(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaskProgressArrayPB) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskProgressArrayPB) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskProgressArrayPB) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.ProgressList) > 0 {
+		for iNdEx := len(m.ProgressList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProgressList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStructs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStructs(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStructs(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SeedNodePB) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovStructs(uint64(l))
+	}
+	l = len(m.InternalIp)
+	if l > 0 {
+		n += 1 + l + sovStructs(uint64(l))
+	}
+	l = len(m.InternalPort)
+	if l > 0 {
+		n += 1 + l + sovStructs(uint64(l))
+	}
+	if m.ConnState != 0 {
+		n += 1 + sovStructs(uint64(m.ConnState))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *SeedNodeListPB) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.SeedNodeList) > 0 {
+		for _, e := range m.SeedNodeList {
+			l = e.Size()
+			n += 1 + l + sovStructs(uint64(l))
+		}
 	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
@@ -1234,50 +1818,173 @@ func (m *TaskEventArrayPB) Size() (n int) {
 	return n
 }
 
-func sovStructs(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozStructs(x uint64) (n int) {
-	return sovStructs(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *TaskResourceUsagePB) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovStructs(uint64(l))
+	}
+	l = len(m.PartyId)
+	if l > 0 {
+		n += 1 + l + sovStructs(uint64(l))
+	}
+	l = len(m.IdentityId)
+	if l > 0 {
+		n += 1 + l + sovStructs(uint64(l))
+	}
+	l = len(m.JobNodeId)
+	if l > 0 {
+		n += 1 + l + sovStructs(uint64(l))
+	}
+	if m.UsedMem != 0 {
+		n += 1 + sovStructs(uint64(m.UsedMem))
+	}
+	if m.UsedProcessor != 0 {
+		n += 1 + sovStructs(uint64(m.UsedProcessor))
+	}
+	if m.UsedBandwidth != 0 {
+		n += 1 + sovStructs(uint64(m.UsedBandwidth))
+	}
+	if m.UsedDuration != 0 {
+		n += 1 + sovStructs(uint64(m.UsedDuration))
+	}
+	if m.UpdateAt != 0 {
+		n += 1 + sovStructs(uint64(m.UpdateAt))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
 }
-func (m *SeedNodePB) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowStructs
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: SeedNodePB: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SeedNodePB: illegal tag %d (wire type %d)", fieldNum, wire)
+
+func (m *TaskResourceUsageArrayPB) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.UsageList) > 0 {
+		for _, e := range m.UsageList {
+			l = e.Size()
+			n += 1 + l + sovStructs(uint64(l))
 		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStructs
-				}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TaskProgressPB) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovStructs(uint64(l))
+	}
+	l = len(m.PartyId)
+	if l > 0 {
+		n += 1 + l + sovStructs(uint64(l))
+	}
+	l = len(m.IdentityId)
+	if l > 0 {
+		n += 1 + l + sovStructs(uint64(l))
+	}
+	l = len(m.JobNodeId)
+	if l > 0 {
+		n += 1 + l + sovStructs(uint64(l))
+	}
+	l = len(m.Phase)
+	if l > 0 {
+		n += 1 + l + sovStructs(uint64(l))
+	}
+	if m.Percent != 0 {
+		n += 1 + sovStructs(uint64(m.Percent))
+	}
+	if m.ElapsedTime != 0 {
+		n += 1 + sovStructs(uint64(m.ElapsedTime))
+	}
+	if m.RemainTime != 0 {
+		n += 1 + sovStructs(uint64(m.RemainTime))
+	}
+	if m.UpdateAt != 0 {
+		n += 1 + sovStructs(uint64(m.UpdateAt))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TaskProgressArrayPB) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ProgressList) > 0 {
+		for _, e := range m.ProgressList {
+			l = e.Size()
+			n += 1 + l + sovStructs(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func sovStructs(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozStructs(x uint64) (n int) {
+	return sovStructs(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SeedNodePB) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStructs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SeedNodePB: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SeedNodePB: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructs
+				}
 				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
@@ -1328,16 +2035,824 @@ func (m *SeedNodePB) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthStructs
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InternalIp = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InternalPort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStructs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStructs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InternalPort = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnState", wireType)
+			}
+			m.ConnState = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConnState |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStructs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStructs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SeedNodeListPB) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStructs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SeedNodeListPB: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SeedNodeListPB: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeedNodeList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStructs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStructs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SeedNodeList = append(m.SeedNodeList, &SeedNodePB{})
+			if err := m.SeedNodeList[len(m.SeedNodeList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStructs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStructs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisteredNodePB) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStructs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisteredNodePB: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisteredNodePB: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStructs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStructs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InternalIp", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStructs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStructs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InternalIp = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InternalPort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStructs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStructs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InternalPort = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalIp", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStructs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStructs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalIp = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExternalPort", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStructs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStructs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExternalPort = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnState", wireType)
+			}
+			m.ConnState = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConnState |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStructs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStructs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisteredNodeListPB) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStructs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisteredNodeListPB: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisteredNodeListPB: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredNodeList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStructs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStructs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegisteredNodeList = append(m.RegisteredNodeList, &RegisteredNodePB{})
+			if err := m.RegisteredNodeList[len(m.RegisteredNodeList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStructs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStructs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Uint32PB) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStructs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Uint32PB: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Uint32PB: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field V", wireType)
+			}
+			m.V = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.V |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStructs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStructs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Uint64PB) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStructs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Uint64PB: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Uint64PB: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field V", wireType)
+			}
+			m.V = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.V |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStructs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStructs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StringPB) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStructs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StringPB: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StringPB: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field V", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStructs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStructs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.V = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStructs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStructs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StringArrayPB) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStructs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StringArrayPB: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StringArrayPB: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Array", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStructs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStructs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Array = append(m.Array, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStructs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStructs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskArrayPB) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStructs
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InternalIp = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskArrayPB: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskArrayPB: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InternalPort", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStructs
@@ -1347,43 +2862,26 @@ func (m *SeedNodePB) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthStructs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthStructs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InternalPort = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnState", wireType)
-			}
-			m.ConnState = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowStructs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ConnState |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.TaskList = append(m.TaskList, &types.TaskData{})
+			if err := m.TaskList[len(m.TaskList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStructs(dAtA[iNdEx:])
@@ -1406,7 +2904,7 @@ func (m *SeedNodePB) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SeedNodeListPB) Unmarshal(dAtA []byte) error {
+func (m *TaskEventArrayPB) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1429,15 +2927,15 @@ func (m *SeedNodeListPB) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SeedNodeListPB: wiretype end group for non-group")
+			return fmt.Errorf("proto: TaskEventArrayPB: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SeedNodeListPB: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TaskEventArrayPB: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SeedNodeList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskEventList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1464,8 +2962,8 @@ func (m *SeedNodeListPB) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SeedNodeList = append(m.SeedNodeList, &SeedNodePB{})
-			if err := m.SeedNodeList[len(m.SeedNodeList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.TaskEventList = append(m.TaskEventList, &types.EventData{})
+			if err := m.TaskEventList[len(m.TaskEventList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1491,7 +2989,7 @@ func (m *SeedNodeListPB) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RegisteredNodePB) Unmarshal(dAtA []byte) error {
+func (m *TaskResourceUsagePB) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1514,15 +3012,15 @@ func (m *RegisteredNodePB) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RegisteredNodePB: wiretype end group for non-group")
+			return fmt.Errorf("proto: TaskResourceUsagePB: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisteredNodePB: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TaskResourceUsagePB: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1550,11 +3048,11 @@ func (m *RegisteredNodePB) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.TaskId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InternalIp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PartyId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1582,11 +3080,11 @@ func (m *RegisteredNodePB) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InternalIp = string(dAtA[iNdEx:postIndex])
+			m.PartyId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InternalPort", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IdentityId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1614,11 +3112,11 @@ func (m *RegisteredNodePB) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.InternalPort = string(dAtA[iNdEx:postIndex])
+			m.IdentityId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalIp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobNodeId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1646,13 +3144,13 @@ func (m *RegisteredNodePB) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExternalIp = string(dAtA[iNdEx:postIndex])
+			m.JobNodeId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExternalPort", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsedMem", wireType)
 			}
-			var stringLen uint64
+			m.UsedMem = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStructs
@@ -1662,29 +3160,16 @@ func (m *RegisteredNodePB) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.UsedMem |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthStructs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthStructs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ExternalPort = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConnState", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UsedProcessor", wireType)
 			}
-			m.ConnState = 0
+			m.UsedProcessor = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStructs
@@ -1694,67 +3179,16 @@ func (m *RegisteredNodePB) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ConnState |= int32(b&0x7F) << shift
+				m.UsedProcessor |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStructs(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthStructs
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RegisteredNodeListPB) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowStructs
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RegisteredNodeListPB: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RegisteredNodeListPB: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredNodeList", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsedBandwidth", wireType)
 			}
-			var msglen int
+			m.UsedBandwidth = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStructs
@@ -1764,82 +3198,35 @@ func (m *RegisteredNodeListPB) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.UsedBandwidth |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthStructs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthStructs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RegisteredNodeList = append(m.RegisteredNodeList, &RegisteredNodePB{})
-			if err := m.RegisteredNodeList[len(m.RegisteredNodeList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStructs(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthStructs
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Uint32PB) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowStructs
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsedDuration", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.UsedDuration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UsedDuration |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Uint32PB: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Uint32PB: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 9:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field V", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateAt", wireType)
 			}
-			m.V = 0
+			m.UpdateAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStructs
@@ -1849,7 +3236,7 @@ func (m *Uint32PB) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.V |= uint32(b&0x7F) << shift
+				m.UpdateAt |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -1876,7 +3263,7 @@ func (m *Uint32PB) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Uint64PB) Unmarshal(dAtA []byte) error {
+func (m *TaskResourceUsageArrayPB) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1899,17 +3286,17 @@ func (m *Uint64PB) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Uint64PB: wiretype end group for non-group")
+			return fmt.Errorf("proto: TaskResourceUsageArrayPB: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Uint64PB: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TaskResourceUsageArrayPB: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field V", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UsageList", wireType)
 			}
-			m.V = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStructs
@@ -1919,11 +3306,26 @@ func (m *Uint64PB) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.V |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthStructs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStructs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UsageList = append(m.UsageList, &TaskResourceUsagePB{})
+			if err := m.UsageList[len(m.UsageList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStructs(dAtA[iNdEx:])
@@ -1946,7 +3348,7 @@ func (m *Uint64PB) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StringPB) Unmarshal(dAtA []byte) error {
+func (m *TaskProgressPB) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1969,15 +3371,15 @@ func (m *StringPB) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StringPB: wiretype end group for non-group")
+			return fmt.Errorf("proto: TaskProgressPB: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StringPB: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TaskProgressPB: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field V", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2005,62 +3407,43 @@ func (m *StringPB) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.V = string(dAtA[iNdEx:postIndex])
+			m.TaskId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStructs(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartyId", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthStructs
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStructs
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *StringArrayPB) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowStructs
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStructs
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: StringArrayPB: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StringArrayPB: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.PartyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Array", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IdentityId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2088,64 +3471,45 @@ func (m *StringArrayPB) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Array = append(m.Array, string(dAtA[iNdEx:postIndex]))
+			m.IdentityId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipStructs(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobNodeId", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthStructs
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStructs
 			}
-			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TaskArrayPB) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowStructs
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStructs
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TaskArrayPB: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TaskArrayPB: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.JobNodeId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowStructs
@@ -2155,26 +3519,100 @@ func (m *TaskArrayPB) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthStructs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthStructs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaskList = append(m.TaskList, &types.TaskData{})
-			if err := m.TaskList[len(m.TaskList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Phase = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Percent", wireType)
+			}
+			m.Percent = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Percent |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ElapsedTime", wireType)
+			}
+			m.ElapsedTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ElapsedTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainTime", wireType)
+			}
+			m.RemainTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateAt", wireType)
+			}
+			m.UpdateAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStructs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpdateAt |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStructs(dAtA[iNdEx:])
@@ -2197,7 +3635,7 @@ func (m *TaskArrayPB) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TaskEventArrayPB) Unmarshal(dAtA []byte) error {
+func (m *TaskProgressArrayPB) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2220,15 +3658,15 @@ func (m *TaskEventArrayPB) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TaskEventArrayPB: wiretype end group for non-group")
+			return fmt.Errorf("proto: TaskProgressArrayPB: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TaskEventArrayPB: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TaskProgressArrayPB: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskEventList", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgressList", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2255,8 +3693,8 @@ func (m *TaskEventArrayPB) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaskEventList = append(m.TaskEventList, &types.EventData{})
-			if err := m.TaskEventList[len(m.TaskEventList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ProgressList = append(m.ProgressList, &TaskProgressPB{})
+			if err := m.ProgressList[len(m.ProgressList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -267,41 +267,155 @@ func (m *TaskReadyGoReply) GetMsg() string {
 	return ""
 }
 
+type TaskCancelReq struct {
+	TaskId               string   `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	PartyId              string   `protobuf:"bytes,2,opt,name=party_id,json=partyId,proto3" json:"party_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TaskCancelReq) Reset()         { *m = TaskCancelReq{} }
+func (m *TaskCancelReq) String() string { return proto.CompactTextString(m) }
+func (*TaskCancelReq) ProtoMessage()    {}
+func (*TaskCancelReq) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3fb4ac3629666f03, []int{2}
+}
+func (m *TaskCancelReq) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskCancelReq) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskCancelReq.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TaskCancelReq) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskCancelReq.Merge(m, src)
+}
+func (m *TaskCancelReq) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskCancelReq) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskCancelReq.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskCancelReq proto.InternalMessageInfo
+
+func (m *TaskCancelReq) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
+	}
+	return ""
+}
+
+func (m *TaskCancelReq) GetPartyId() string {
+	if m != nil {
+		return m.PartyId
+	}
+	return ""
+}
+
+type TaskCancelReply struct {
+	Ok                   bool     `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	Msg                  string   `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TaskCancelReply) Reset()         { *m = TaskCancelReply{} }
+func (m *TaskCancelReply) String() string { return proto.CompactTextString(m) }
+func (*TaskCancelReply) ProtoMessage()    {}
+func (*TaskCancelReply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3fb4ac3629666f03, []int{3}
+}
+func (m *TaskCancelReply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskCancelReply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskCancelReply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TaskCancelReply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskCancelReply.Merge(m, src)
+}
+func (m *TaskCancelReply) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskCancelReply) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskCancelReply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskCancelReply proto.InternalMessageInfo
+
+func (m *TaskCancelReply) GetOk() bool {
+	if m != nil {
+		return m.Ok
+	}
+	return false
+}
+
+func (m *TaskCancelReply) GetMsg() string {
+	if m != nil {
+		return m.Msg
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*TaskReadyGoReq)(nil), "common.TaskReadyGoReq")
 	proto.RegisterType((*TaskReadyGoReq_Peer)(nil), "common.TaskReadyGoReq.Peer")
 	proto.RegisterType((*TaskReadyGoReply)(nil), "common.TaskReadyGoReply")
+	proto.RegisterType((*TaskCancelReq)(nil), "common.TaskCancelReq")
+	proto.RegisterType((*TaskCancelReply)(nil), "common.TaskCancelReply")
 }
 
 func init() { proto.RegisterFile("lib/fighter/common/common.proto", fileDescriptor_3fb4ac3629666f03) }
 
 var fileDescriptor_3fb4ac3629666f03 = []byte{
-	// 392 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x92, 0x4b, 0xae, 0xd3, 0x30,
-	0x14, 0x86, 0x95, 0x67, 0x9b, 0xd3, 0xab, 0xab, 0x62, 0x09, 0x11, 0x40, 0xf4, 0x35, 0xaa, 0x84,
-	0x68, 0x04, 0x74, 0x05, 0xad, 0x44, 0x95, 0x59, 0x65, 0x31, 0x81, 0x09, 0x72, 0x63, 0x37, 0xb5,
-	0xf2, 0xb0, 0x71, 0xdc, 0xa2, 0x2e, 0x8c, 0x3d, 0x30, 0x64, 0x09, 0xa8, 0x2b, 0x41, 0x76, 0x42,
-	0x45, 0x74, 0x47, 0x39, 0xe7, 0x7c, 0xbf, 0x73, 0x7e, 0xfd, 0x36, 0x4c, 0x4b, 0x7e, 0x48, 0x8e,
-	0x3c, 0x3f, 0x69, 0xa6, 0x92, 0x4c, 0x54, 0x95, 0xa8, 0xbb, 0xcf, 0x4a, 0x2a, 0xa1, 0x05, 0x0a,
-	0xdb, 0x6e, 0xf1, 0xd3, 0x83, 0xc7, 0xcf, 0xa4, 0x29, 0x30, 0x23, 0xf4, 0xba, 0x13, 0x98, 0x7d,
-	0x47, 0x2f, 0x60, 0xa0, 0x49, 0x53, 0x7c, 0xe3, 0x34, 0x76, 0x66, 0xce, 0x32, 0xc2, 0xa1, 0x69,
-	0x53, 0x8a, 0xa6, 0x30, 0xca, 0x44, 0xad, 0x15, 0xc9, 0xb4, 0x81, 0xae, 0x85, 0xf0, 0x6f, 0x94,
-	0x52, 0x73, 0x92, 0x12, 0x4d, 0x0c, 0xf4, 0xda, 0x93, 0xa6, 0x4d, 0x29, 0x7a, 0x09, 0x43, 0x49,
-	0x94, 0xbe, 0x1a, 0xe2, 0x5b, 0x32, 0xb0, 0x7d, 0x4a, 0xd1, 0x73, 0x08, 0x59, 0x7d, 0x31, 0x20,
-	0xb0, 0x20, 0x60, 0xf5, 0x25, 0xa5, 0xe8, 0x3d, 0x04, 0x92, 0x31, 0xd5, 0xc4, 0xe1, 0xcc, 0x5b,
-	0x8e, 0x3e, 0xbc, 0x5e, 0x75, 0xee, 0xfb, 0x5e, 0x57, 0x7b, 0xc6, 0x14, 0x6e, 0x95, 0x68, 0x0e,
-	0x0f, 0x77, 0x7b, 0xd9, 0x31, 0x8f, 0x07, 0xf6, 0x7f, 0x77, 0xcb, 0xdb, 0x63, 0x8e, 0xde, 0x00,
-	0x58, 0x83, 0x76, 0x79, 0x3c, 0x9c, 0x79, 0xcb, 0x08, 0x47, 0x66, 0xb2, 0x37, 0x03, 0xf4, 0x16,
-	0x9e, 0x65, 0xa2, 0x92, 0x67, 0x4d, 0x34, 0x17, 0x75, 0xa7, 0x8a, 0xac, 0x6a, 0xfc, 0x1f, 0x68,
-	0xc5, 0x73, 0x78, 0x50, 0xac, 0x39, 0x97, 0xba, 0xd3, 0x81, 0xd5, 0x8d, 0xda, 0x99, 0x95, 0xbc,
-	0xfa, 0x02, 0xbe, 0x31, 0x88, 0x1e, 0xc1, 0xe5, 0xb2, 0x0b, 0xd3, 0xe5, 0x12, 0x21, 0xf0, 0xa5,
-	0x50, 0xda, 0x26, 0x18, 0x60, 0x5b, 0xf7, 0x22, 0xf2, 0xfa, 0x11, 0x21, 0xf0, 0x6b, 0x52, 0xb1,
-	0x2e, 0x39, 0x5b, 0x2f, 0xd6, 0x30, 0xee, 0x45, 0x21, 0xcb, 0xab, 0x59, 0x23, 0x0a, 0xbb, 0x66,
-	0x88, 0x5d, 0x51, 0xa0, 0x31, 0x78, 0x55, 0x93, 0x77, 0xf7, 0x64, 0xca, 0xcd, 0xe6, 0xd7, 0x6d,
-	0xe2, 0xfc, 0xbe, 0x4d, 0x9c, 0x3f, 0xb7, 0x89, 0xf3, 0x75, 0x9d, 0x73, 0x7d, 0x3a, 0x1f, 0x4c,
-	0xb4, 0x09, 0x16, 0x0d, 0xd3, 0x9a, 0x7c, 0x2a, 0xc5, 0x8f, 0x64, 0x4b, 0x94, 0xe2, 0x4c, 0xbd,
-	0xdb, 0x89, 0xe4, 0xe9, 0x33, 0x3a, 0x84, 0xf6, 0x01, 0x7d, 0xfc, 0x1b, 0x00, 0x00, 0xff, 0xff,
-	0xc4, 0x3d, 0x0b, 0x28, 0x63, 0x02, 0x00, 0x00,
+	// 418 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x52, 0xcd, 0x8e, 0xd3, 0x30,
+	0x10, 0x56, 0x7e, 0xdb, 0x4c, 0x97, 0xa5, 0x58, 0x42, 0x04, 0x10, 0xdd, 0x6e, 0x4e, 0x95, 0x10,
+	0x8d, 0x60, 0xf7, 0x09, 0x36, 0x12, 0xab, 0xdc, 0x56, 0x16, 0x17, 0xb8, 0x20, 0x37, 0x76, 0xb3,
+	0x56, 0x7e, 0x6c, 0x1c, 0x77, 0x51, 0x1f, 0x8c, 0x77, 0xe0, 0xc8, 0x23, 0xa0, 0x3e, 0x09, 0xb2,
+	0x13, 0xaa, 0x46, 0x48, 0x68, 0x4f, 0x9e, 0x99, 0xef, 0x1b, 0xcf, 0xa7, 0x6f, 0x06, 0x2e, 0x6a,
+	0xbe, 0x49, 0xb7, 0xbc, 0xbc, 0xd7, 0x4c, 0xa5, 0x85, 0x68, 0x1a, 0xd1, 0x0e, 0xcf, 0x5a, 0x2a,
+	0xa1, 0x05, 0x0a, 0xfb, 0x2c, 0xf9, 0xe1, 0xc1, 0xf9, 0x27, 0xd2, 0x55, 0x98, 0x11, 0xba, 0xbf,
+	0x15, 0x98, 0x7d, 0x43, 0x2f, 0x60, 0xa2, 0x49, 0x57, 0x7d, 0xe5, 0x34, 0x76, 0x96, 0xce, 0x2a,
+	0xc2, 0xa1, 0x49, 0x73, 0x8a, 0x2e, 0x60, 0x56, 0x88, 0x56, 0x2b, 0x52, 0x68, 0x03, 0xba, 0x16,
+	0x84, 0xbf, 0xa5, 0x9c, 0x9a, 0x4e, 0x4a, 0x34, 0x31, 0xa0, 0xd7, 0x77, 0x9a, 0x34, 0xa7, 0xe8,
+	0x25, 0x4c, 0x25, 0x51, 0x7a, 0x6f, 0x10, 0xdf, 0x22, 0x13, 0x9b, 0xe7, 0x14, 0x3d, 0x87, 0x90,
+	0xb5, 0x0f, 0x06, 0x08, 0x2c, 0x10, 0xb0, 0xf6, 0x21, 0xa7, 0xe8, 0x3d, 0x04, 0x92, 0x31, 0xd5,
+	0xc5, 0xe1, 0xd2, 0x5b, 0xcd, 0x3e, 0xbc, 0x5e, 0x0f, 0xea, 0xc7, 0x5a, 0xd7, 0x77, 0x8c, 0x29,
+	0xdc, 0x33, 0xd1, 0x25, 0x9c, 0x1d, 0xe5, 0x15, 0xdb, 0x32, 0x9e, 0xd8, 0xff, 0x8e, 0x92, 0xb3,
+	0x6d, 0x89, 0xde, 0x00, 0x58, 0x81, 0x76, 0x78, 0x3c, 0x5d, 0x7a, 0xab, 0x08, 0x47, 0xa6, 0x72,
+	0x67, 0x0a, 0xe8, 0x2d, 0x3c, 0x2b, 0x44, 0x23, 0x77, 0x9a, 0x68, 0x2e, 0xda, 0x81, 0x15, 0x59,
+	0xd6, 0xfc, 0x04, 0xe8, 0xc9, 0x97, 0x70, 0xa6, 0x58, 0xb7, 0xab, 0xf5, 0xc0, 0x03, 0xcb, 0x9b,
+	0xf5, 0x35, 0x4b, 0x79, 0xf5, 0x19, 0x7c, 0x23, 0x10, 0x9d, 0x83, 0xcb, 0xe5, 0x60, 0xa6, 0xcb,
+	0x25, 0x42, 0xe0, 0x4b, 0xa1, 0xb4, 0x75, 0x30, 0xc0, 0x36, 0x1e, 0x59, 0xe4, 0x8d, 0x2d, 0x42,
+	0xe0, 0xb7, 0xa4, 0x61, 0x83, 0x73, 0x36, 0x4e, 0xae, 0x61, 0x3e, 0xb2, 0x42, 0xd6, 0x7b, 0x33,
+	0x46, 0x54, 0x76, 0xcc, 0x14, 0xbb, 0xa2, 0x42, 0x73, 0xf0, 0x9a, 0xae, 0x1c, 0xf6, 0x64, 0xc2,
+	0x24, 0x83, 0x27, 0xa6, 0x2b, 0x23, 0x6d, 0xc1, 0xea, 0xff, 0xee, 0xfa, 0x54, 0x8e, 0x3b, 0x92,
+	0x93, 0x5c, 0xc1, 0xd3, 0xd3, 0x4f, 0x1e, 0x35, 0xf9, 0xe6, 0xe6, 0xe7, 0x61, 0xe1, 0xfc, 0x3a,
+	0x2c, 0x9c, 0xdf, 0x87, 0x85, 0xf3, 0xe5, 0xba, 0xe4, 0xfa, 0x7e, 0xb7, 0x31, 0x4b, 0x4d, 0xb1,
+	0xe8, 0x98, 0xd6, 0xe4, 0x63, 0x2d, 0xbe, 0xa7, 0x19, 0x51, 0x8a, 0x33, 0xf5, 0xee, 0x56, 0xa4,
+	0xff, 0x1e, 0xf0, 0x26, 0xb4, 0xa7, 0x7b, 0xf5, 0x67, 0x00, 0xa1, 0x40, 0xd4, 0x6e, 0xdd, 0x02,
+	0x00, 0x00,
 }
 
 func (m *TaskReadyGoReq) Marshal() (dAtA []byte, err error) {
@@ -511,6 +625,91 @@ func (m *TaskReadyGoReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TaskCancelReq) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskCancelReq) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskCancelReq) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.PartyId) > 0 {
+		i -= len(m.PartyId)
+		copy(dAtA[i:], m.PartyId)
+		i = encodeVarintCommon(dAtA, i, uint64(len(m.PartyId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintCommon(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaskCancelReply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskCancelReply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskCancelReply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintCommon(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Ok {
+		i--
+		if m.Ok {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintCommon(dAtA []byte, offset int, v uint64) int {
 	offset -= sovCommon(v)
 	base := offset
@@ -628,6 +827,45 @@ func (m *TaskReadyGoReply) Size() (n int) {
 	return n
 }
 
+func (m *TaskCancelReq) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovCommon(uint64(l))
+	}
+	l = len(m.PartyId)
+	if l > 0 {
+		n += 1 + l + sovCommon(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TaskCancelReply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Ok {
+		n += 2
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovCommon(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
 func sovCommon(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1276,6 +1514,224 @@ func (m *TaskReadyGoReply) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *TaskCancelReq) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskCancelReq: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskCancelReq: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartyId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PartyId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskCancelReply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowCommon
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskCancelReply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskCancelReply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ok", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Ok = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCommon
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthCommon
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipCommon(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthCommon
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipCommon(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
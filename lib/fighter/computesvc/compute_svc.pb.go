@@ -8,11 +8,11 @@ import (
 	fmt "fmt"
 	common "github.com/RosettaFlow/Carrier-Go/lib/fighter/common"
 	proto "github.com/gogo/protobuf/proto"
+	empty "github.com/golang/protobuf/ptypes/empty"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
-	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -494,50 +494,51 @@ func init() {
 }
 
 var fileDescriptor_a6b6bf31653e6c0e = []byte{
-	// 675 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0xc1, 0x4e, 0xdb, 0x4c,
-	0x10, 0xc6, 0x49, 0x48, 0xc8, 0x04, 0xf1, 0xc3, 0xfe, 0x08, 0x5c, 0x93, 0x92, 0xd4, 0x52, 0xab,
-	0x08, 0xa9, 0xb6, 0x44, 0x5b, 0x09, 0x71, 0x84, 0x96, 0x10, 0x55, 0xaa, 0x2a, 0x43, 0x2f, 0xed,
-	0x01, 0x6d, 0xb2, 0x8b, 0xb3, 0x8a, 0xed, 0x35, 0xde, 0x0d, 0x88, 0x6b, 0x5f, 0xa1, 0x0f, 0xd0,
-	0x53, 0xdf, 0xa1, 0x8f, 0xd0, 0x63, 0xa5, 0xaa, 0xf7, 0x0a, 0xf5, 0x41, 0xaa, 0xdd, 0x75, 0x12,
-	0x13, 0x41, 0x7b, 0xf2, 0xce, 0x7c, 0xdf, 0x7c, 0x33, 0x3b, 0xb3, 0x63, 0xe8, 0x44, 0xac, 0xef,
-	0x9f, 0xb3, 0x70, 0x28, 0x69, 0xe6, 0x0f, 0x78, 0x9c, 0x8e, 0x25, 0x15, 0x97, 0x83, 0xc9, 0xf1,
-	0x4c, 0x5c, 0x0e, 0xbc, 0x34, 0xe3, 0x92, 0x23, 0x98, 0xa1, 0xce, 0x56, 0xc8, 0x79, 0x18, 0x51,
-	0x5f, 0x23, 0xfd, 0xf1, 0xb9, 0x4f, 0xe3, 0x54, 0x5e, 0x1b, 0xa2, 0xd3, 0xcc, 0x41, 0x9c, 0x32,
-	0x1f, 0x27, 0x09, 0x97, 0x58, 0x32, 0x9e, 0x88, 0x1c, 0x6d, 0xcd, 0x25, 0x8c, 0x79, 0x92, 0x7f,
-	0x0c, 0xc1, 0xfd, 0x62, 0xc1, 0xca, 0xbb, 0x34, 0xe2, 0x98, 0x9c, 0x0c, 0x71, 0x46, 0x02, 0x7a,
-	0x81, 0x5e, 0x40, 0x25, 0xa6, 0x12, 0xdb, 0x56, 0xdb, 0xea, 0x34, 0x76, 0x5b, 0xde, 0xac, 0x12,
-	0xef, 0x36, 0xd3, 0xeb, 0x25, 0xe7, 0xfc, 0x78, 0x21, 0xd0, 0x74, 0xe4, 0x40, 0x6d, 0xc0, 0x13,
-	0x49, 0x13, 0x69, 0x97, 0xda, 0x56, 0x67, 0xf9, 0x78, 0x21, 0x98, 0x38, 0x9c, 0x3d, 0xa8, 0x28,
-	0x2e, 0xda, 0x84, 0x9a, 0xc4, 0x62, 0x74, 0xc6, 0x88, 0x56, 0xaf, 0x07, 0x55, 0x65, 0xf6, 0x88,
-	0x02, 0x08, 0x96, 0x58, 0x01, 0x25, 0x03, 0x28, 0xb3, 0x47, 0x0e, 0xaa, 0x50, 0x51, 0x27, 0xf7,
-	0x39, 0xac, 0xde, 0x4a, 0x9e, 0x46, 0xd7, 0x68, 0x05, 0x4a, 0x7c, 0xa4, 0x85, 0x96, 0x82, 0x12,
-	0x1f, 0xa1, 0x55, 0x28, 0xc7, 0x22, 0xcc, 0x05, 0xd4, 0xd1, 0x0d, 0x60, 0xa5, 0x4b, 0xe5, 0x89,
-	0xc4, 0x72, 0x2c, 0x4c, 0xcc, 0x2a, 0x94, 0x07, 0xe9, 0x38, 0xcf, 0xae, 0x8e, 0x3a, 0x8a, 0xc6,
-	0xd3, 0x28, 0x1a, 0xa3, 0x26, 0xd4, 0xfb, 0x38, 0x21, 0x57, 0x8c, 0xc8, 0xa1, 0x5d, 0xd6, 0xfe,
-	0x99, 0xc3, 0xf5, 0x60, 0xad, 0x4b, 0xe5, 0x29, 0x16, 0xa3, 0x97, 0x54, 0x62, 0x16, 0x09, 0xd5,
-	0xb3, 0x07, 0xb0, 0x94, 0x5f, 0x4c, 0xd8, 0x56, 0xbb, 0xdc, 0xa9, 0x07, 0x35, 0x73, 0x33, 0xe1,
-	0x7e, 0x2d, 0xc1, 0xff, 0xf3, 0x01, 0xaa, 0x92, 0x1e, 0x2c, 0xeb, 0x10, 0x62, 0x9c, 0x3a, 0xac,
-	0xb1, 0xfb, 0xa4, 0xd8, 0xee, 0x3b, 0xc2, 0x3c, 0x63, 0x04, 0x0d, 0x39, 0x03, 0x9c, 0x9f, 0x16,
-	0x54, 0xcd, 0xf9, 0xfe, 0x0e, 0x6f, 0x41, 0x5d, 0x03, 0x09, 0x8e, 0x69, 0x7e, 0x59, 0x5d, 0xf2,
-	0x1b, 0x1c, 0x53, 0xd4, 0x82, 0x86, 0x1a, 0x55, 0x86, 0x07, 0x52, 0x45, 0x9a, 0x3b, 0xc3, 0xc4,
-	0xd5, 0x23, 0xe8, 0x11, 0x2c, 0xd3, 0x08, 0xa7, 0x82, 0x92, 0x33, 0xc9, 0x62, 0x6a, 0x57, 0xda,
-	0x56, 0xa7, 0x1c, 0x34, 0x72, 0xdf, 0x29, 0x33, 0x1a, 0x19, 0x8d, 0x31, 0x4b, 0x0c, 0x63, 0x51,
-	0x33, 0xc0, 0xb8, 0x34, 0xc1, 0x81, 0xa5, 0x34, 0xe3, 0x61, 0x46, 0x85, 0xb0, 0xab, 0xa6, 0x80,
-	0x89, 0x8d, 0xd6, 0x61, 0x31, 0x1d, 0x62, 0x41, 0xed, 0x9a, 0x06, 0x8c, 0xb1, 0xfb, 0xb9, 0x0c,
-	0xff, 0x1d, 0x9a, 0x76, 0xbc, 0xcd, 0xf8, 0x25, 0x23, 0x34, 0x43, 0x1f, 0xa0, 0x3e, 0x1d, 0x29,
-	0xda, 0xf0, 0xcc, 0xeb, 0xf7, 0x26, 0xab, 0xe1, 0xbd, 0x52, 0xab, 0xe1, 0x38, 0x73, 0x5d, 0x2c,
-	0xbc, 0x00, 0xd7, 0xf9, 0xf8, 0xe3, 0xf7, 0xa7, 0xd2, 0x3a, 0x42, 0x93, 0xad, 0xf3, 0xc3, 0xa9,
-	0x9e, 0xd4, 0xef, 0xa5, 0xd0, 0x73, 0xf4, 0xf0, 0x6f, 0xf3, 0xb8, 0x70, 0x5a, 0xff, 0x18, 0x97,
-	0xeb, 0xea, 0x6c, 0x4d, 0x77, 0xb3, 0x98, 0xad, 0xc0, 0xda, 0xb7, 0x76, 0xd0, 0x6b, 0x68, 0x14,
-	0xde, 0x36, 0x72, 0xee, 0xdf, 0x38, 0xa7, 0x79, 0x2f, 0xa6, 0x92, 0x2d, 0x74, 0x2c, 0x14, 0xc1,
-	0xda, 0x31, 0x4e, 0x48, 0x44, 0x55, 0x92, 0x80, 0x62, 0x72, 0xdd, 0xe5, 0x68, 0xc3, 0xcb, 0x97,
-	0xbe, 0xe0, 0x54, 0x72, 0xf6, 0x9d, 0x7e, 0x25, 0xf5, 0x58, 0xd7, 0xdd, 0x72, 0x9d, 0x69, 0xdd,
-	0xc3, 0x79, 0xd5, 0x7d, 0x6b, 0xe7, 0xe0, 0xe8, 0xdb, 0xcd, 0xb6, 0xf5, 0xfd, 0x66, 0xdb, 0xfa,
-	0x75, 0xb3, 0x6d, 0xbd, 0xdf, 0x0b, 0x99, 0x1c, 0x8e, 0xfb, 0x4a, 0xd4, 0x0f, 0xb8, 0xa0, 0x52,
-	0xe2, 0xa3, 0x88, 0x5f, 0xf9, 0x87, 0x38, 0xcb, 0x18, 0xcd, 0x9e, 0x76, 0xb9, 0x7f, 0xf7, 0x0f,
-	0xb0, 0x5f, 0xd5, 0x03, 0x7c, 0xf6, 0x27, 0x00, 0x00, 0xff, 0xff, 0x7b, 0xc7, 0x54, 0x12, 0x21,
-	0x05, 0x00, 0x00,
+	// 703 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0x5e, 0xda, 0xae, 0x5d, 0x5f, 0xa7, 0xd2, 0x99, 0xb1, 0x65, 0x59, 0x69, 0x4b, 0x04, 0xa8,
+	0x9a, 0x44, 0x22, 0x0d, 0x90, 0xa6, 0x1d, 0x37, 0x58, 0x57, 0x21, 0x21, 0x94, 0x8d, 0x0b, 0x1c,
+	0x26, 0xb7, 0xf6, 0xda, 0xa8, 0x49, 0x9c, 0xc5, 0xee, 0xa6, 0x5d, 0xb9, 0x71, 0xe6, 0x37, 0xf0,
+	0x1f, 0xf8, 0x09, 0x1c, 0x91, 0x10, 0x77, 0x34, 0xf1, 0x43, 0x90, 0xed, 0xb4, 0xcd, 0xaa, 0x0e,
+	0x4e, 0xb1, 0xbf, 0xef, 0xbd, 0xef, 0x7b, 0x7e, 0xf6, 0x0b, 0xb4, 0x03, 0xbf, 0xe7, 0x9e, 0xfb,
+	0x83, 0xa1, 0xa0, 0x89, 0xdb, 0x67, 0x61, 0x3c, 0x16, 0x94, 0x5f, 0xf6, 0x27, 0xcb, 0x33, 0x7e,
+	0xd9, 0x77, 0xe2, 0x84, 0x09, 0x86, 0x60, 0xc6, 0x5a, 0xdb, 0x03, 0xc6, 0x06, 0x01, 0x75, 0x15,
+	0xd3, 0x1b, 0x9f, 0xbb, 0x34, 0x8c, 0xc5, 0xb5, 0x0e, 0xb4, 0xea, 0x29, 0x89, 0x63, 0xdf, 0xc5,
+	0x51, 0xc4, 0x04, 0x16, 0x3e, 0x8b, 0x78, 0xca, 0x36, 0xe7, 0x0c, 0x43, 0x16, 0xa5, 0x1f, 0x1d,
+	0x60, 0x7f, 0x35, 0xa0, 0xfa, 0x3e, 0x0e, 0x18, 0x26, 0x27, 0x43, 0x9c, 0x10, 0x8f, 0x5e, 0xa0,
+	0x97, 0x50, 0x08, 0xa9, 0xc0, 0xa6, 0xd1, 0x32, 0xda, 0x95, 0xdd, 0xa6, 0x33, 0xab, 0xc4, 0xb9,
+	0x1d, 0xe9, 0x74, 0xa3, 0x73, 0x76, 0xbc, 0xe4, 0xa9, 0x70, 0x64, 0x41, 0xa9, 0xcf, 0x22, 0x41,
+	0x23, 0x61, 0xe6, 0x5a, 0x46, 0x7b, 0xf5, 0x78, 0xc9, 0x9b, 0x00, 0xd6, 0x1e, 0x14, 0x64, 0x2c,
+	0xda, 0x84, 0x92, 0xc0, 0x7c, 0x74, 0xe6, 0x13, 0xa5, 0x5e, 0xf6, 0x8a, 0x72, 0xdb, 0x25, 0x92,
+	0x20, 0x58, 0x60, 0x49, 0xe4, 0x34, 0x21, 0xb7, 0x5d, 0x72, 0x50, 0x84, 0x82, 0x5c, 0xd9, 0x2f,
+	0xa0, 0x76, 0xcb, 0x3c, 0x0e, 0xae, 0x51, 0x15, 0x72, 0x6c, 0xa4, 0x84, 0x56, 0xbc, 0x1c, 0x1b,
+	0xa1, 0x1a, 0xe4, 0x43, 0x3e, 0x48, 0x05, 0xe4, 0xd2, 0xf6, 0xa0, 0xda, 0xa1, 0xe2, 0x44, 0x60,
+	0x31, 0xe6, 0x3a, 0xa7, 0x06, 0xf9, 0x7e, 0x3c, 0x4e, 0xdd, 0xe5, 0x52, 0x65, 0xd1, 0x70, 0x9a,
+	0x45, 0x43, 0x54, 0x87, 0x72, 0x0f, 0x47, 0xe4, 0xca, 0x27, 0x62, 0x68, 0xe6, 0x15, 0x3e, 0x03,
+	0x6c, 0x07, 0xd6, 0x3a, 0x54, 0x9c, 0x62, 0x3e, 0x7a, 0x45, 0x05, 0xf6, 0x03, 0x2e, 0x7b, 0xb6,
+	0x05, 0x2b, 0xe9, 0xc1, 0xb8, 0x69, 0xb4, 0xf2, 0xed, 0xb2, 0x57, 0xd2, 0x27, 0xe3, 0xf6, 0xb7,
+	0x1c, 0xdc, 0x9f, 0x4f, 0x90, 0x95, 0x74, 0x61, 0x55, 0xa5, 0x10, 0x0d, 0xaa, 0xb4, 0xca, 0xee,
+	0xd3, 0x6c, 0xbb, 0x17, 0xa4, 0x39, 0x7a, 0xe3, 0x55, 0xc4, 0x8c, 0xb0, 0x7e, 0x19, 0x50, 0xd4,
+	0xeb, 0xbb, 0x3b, 0xbc, 0x0d, 0x65, 0x45, 0x44, 0x38, 0xa4, 0xe9, 0x61, 0x55, 0xc9, 0x6f, 0x71,
+	0x48, 0x51, 0x13, 0x2a, 0xf2, 0xaa, 0x12, 0xdc, 0x17, 0x32, 0x53, 0x9f, 0x19, 0x26, 0x50, 0x97,
+	0xa0, 0x47, 0xb0, 0x4a, 0x03, 0x1c, 0x73, 0x4a, 0xce, 0x84, 0x1f, 0x52, 0xb3, 0xd0, 0x32, 0xda,
+	0x79, 0xaf, 0x92, 0x62, 0xa7, 0xbe, 0xd6, 0x48, 0x68, 0x88, 0xfd, 0x48, 0x47, 0x2c, 0xab, 0x08,
+	0xd0, 0x90, 0x0a, 0xb0, 0x60, 0x25, 0x4e, 0xd8, 0x20, 0xa1, 0x9c, 0x9b, 0x45, 0x5d, 0xc0, 0x64,
+	0x8f, 0xd6, 0x61, 0x39, 0x1e, 0x62, 0x4e, 0xcd, 0x92, 0x22, 0xf4, 0x66, 0xf7, 0x73, 0x01, 0xee,
+	0x1d, 0xea, 0x76, 0xbc, 0x4b, 0xd8, 0xa5, 0x4f, 0x68, 0x82, 0x3e, 0x42, 0x79, 0x7a, 0xa5, 0x68,
+	0xc3, 0xd1, 0xaf, 0xdf, 0x99, 0x8c, 0x86, 0xf3, 0x5a, 0x8e, 0x86, 0x65, 0xcd, 0x75, 0x31, 0xf3,
+	0x02, 0x6c, 0xeb, 0xd3, 0xcf, 0x3f, 0x5f, 0x72, 0xeb, 0x08, 0x4d, 0xa6, 0xce, 0x1d, 0x4c, 0xf5,
+	0x04, 0x54, 0x6f, 0xf7, 0x1c, 0x3d, 0xfc, 0xd7, 0x7d, 0x5c, 0x58, 0xcd, 0xff, 0x5c, 0x97, 0x6d,
+	0x2b, 0xb7, 0xba, 0xbd, 0x99, 0x75, 0xcb, 0x44, 0xed, 0x1b, 0x3b, 0xe8, 0x0d, 0x54, 0x32, 0x6f,
+	0x1b, 0x59, 0x77, 0x4f, 0x9c, 0x55, 0xbf, 0x93, 0x93, 0x66, 0x4b, 0x6d, 0x03, 0x05, 0xb0, 0x76,
+	0x8c, 0x23, 0x12, 0x50, 0x69, 0xe2, 0x51, 0x4c, 0xae, 0x3b, 0x0c, 0x6d, 0x38, 0xe9, 0xd0, 0x67,
+	0x40, 0x29, 0x67, 0x2e, 0xc4, 0xa5, 0xd4, 0x13, 0x55, 0x77, 0xd3, 0xb6, 0xa6, 0x75, 0x0f, 0xe7,
+	0x55, 0x65, 0xe9, 0x43, 0xa8, 0x69, 0xb7, 0x43, 0x1c, 0xf5, 0x69, 0x20, 0x59, 0xf4, 0x20, 0x2b,
+	0xaa, 0x71, 0xe9, 0xb5, 0xb9, 0x08, 0x96, 0x56, 0x8f, 0x95, 0x55, 0xc3, 0xde, 0x9a, 0xb3, 0x9a,
+	0x49, 0xee, 0x1b, 0x3b, 0x07, 0x47, 0xdf, 0x6f, 0x1a, 0xc6, 0x8f, 0x9b, 0x86, 0xf1, 0xfb, 0xa6,
+	0x61, 0x7c, 0xd8, 0x1b, 0xf8, 0x62, 0x38, 0xee, 0x49, 0x49, 0xd7, 0x63, 0x9c, 0x0a, 0x81, 0x8f,
+	0x02, 0x76, 0xe5, 0x1e, 0xe2, 0x24, 0xf1, 0x69, 0xf2, 0xac, 0xc3, 0xdc, 0xc5, 0xbf, 0xda, 0x5e,
+	0x51, 0x3d, 0x95, 0xe7, 0x7f, 0x07, 0x00, 0x4f, 0xd6, 0xf0, 0x84, 0x8b, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -552,10 +553,11 @@ const _ = grpc.SupportPackageIsVersion4
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type ComputeProviderClient interface {
-	GetStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetStatusReply, error)
+	GetStatus(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*GetStatusReply, error)
 	GetTaskDetails(ctx context.Context, in *GetTaskDetailsReq, opts ...grpc.CallOption) (*GetTaskDetailsReply, error)
 	UploadShard(ctx context.Context, opts ...grpc.CallOption) (ComputeProvider_UploadShardClient, error)
 	HandleTaskReadyGo(ctx context.Context, in *common.TaskReadyGoReq, opts ...grpc.CallOption) (*common.TaskReadyGoReply, error)
+	HandleCancelTask(ctx context.Context, in *common.TaskCancelReq, opts ...grpc.CallOption) (*common.TaskCancelReply, error)
 }
 
 type computeProviderClient struct {
@@ -566,7 +568,7 @@ func NewComputeProviderClient(cc *grpc.ClientConn) ComputeProviderClient {
 	return &computeProviderClient{cc}
 }
 
-func (c *computeProviderClient) GetStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetStatusReply, error) {
+func (c *computeProviderClient) GetStatus(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*GetStatusReply, error) {
 	out := new(GetStatusReply)
 	err := c.cc.Invoke(ctx, "/computesvc.ComputeProvider/GetStatus", in, out, opts...)
 	if err != nil {
@@ -627,19 +629,29 @@ func (c *computeProviderClient) HandleTaskReadyGo(ctx context.Context, in *commo
 	return out, nil
 }
 
+func (c *computeProviderClient) HandleCancelTask(ctx context.Context, in *common.TaskCancelReq, opts ...grpc.CallOption) (*common.TaskCancelReply, error) {
+	out := new(common.TaskCancelReply)
+	err := c.cc.Invoke(ctx, "/computesvc.ComputeProvider/HandleCancelTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ComputeProviderServer is the server API for ComputeProvider service.
 type ComputeProviderServer interface {
-	GetStatus(context.Context, *emptypb.Empty) (*GetStatusReply, error)
+	GetStatus(context.Context, *empty.Empty) (*GetStatusReply, error)
 	GetTaskDetails(context.Context, *GetTaskDetailsReq) (*GetTaskDetailsReply, error)
 	UploadShard(ComputeProvider_UploadShardServer) error
 	HandleTaskReadyGo(context.Context, *common.TaskReadyGoReq) (*common.TaskReadyGoReply, error)
+	HandleCancelTask(context.Context, *common.TaskCancelReq) (*common.TaskCancelReply, error)
 }
 
 // UnimplementedComputeProviderServer can be embedded to have forward compatible implementations.
 type UnimplementedComputeProviderServer struct {
 }
 
-func (*UnimplementedComputeProviderServer) GetStatus(ctx context.Context, req *emptypb.Empty) (*GetStatusReply, error) {
+func (*UnimplementedComputeProviderServer) GetStatus(ctx context.Context, req *empty.Empty) (*GetStatusReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStatus not implemented")
 }
 func (*UnimplementedComputeProviderServer) GetTaskDetails(ctx context.Context, req *GetTaskDetailsReq) (*GetTaskDetailsReply, error) {
@@ -651,13 +663,16 @@ func (*UnimplementedComputeProviderServer) UploadShard(srv ComputeProvider_Uploa
 func (*UnimplementedComputeProviderServer) HandleTaskReadyGo(ctx context.Context, req *common.TaskReadyGoReq) (*common.TaskReadyGoReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HandleTaskReadyGo not implemented")
 }
+func (*UnimplementedComputeProviderServer) HandleCancelTask(ctx context.Context, req *common.TaskCancelReq) (*common.TaskCancelReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleCancelTask not implemented")
+}
 
 func RegisterComputeProviderServer(s *grpc.Server, srv ComputeProviderServer) {
 	s.RegisterService(&_ComputeProvider_serviceDesc, srv)
 }
 
 func _ComputeProvider_GetStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+	in := new(empty.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -669,7 +684,7 @@ func _ComputeProvider_GetStatus_Handler(srv interface{}, ctx context.Context, de
 		FullMethod: "/computesvc.ComputeProvider/GetStatus",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(ComputeProviderServer).GetStatus(ctx, req.(*emptypb.Empty))
+		return srv.(ComputeProviderServer).GetStatus(ctx, req.(*empty.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -736,6 +751,24 @@ func _ComputeProvider_HandleTaskReadyGo_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ComputeProvider_HandleCancelTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(common.TaskCancelReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ComputeProviderServer).HandleCancelTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/computesvc.ComputeProvider/HandleCancelTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ComputeProviderServer).HandleCancelTask(ctx, req.(*common.TaskCancelReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ComputeProvider_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "computesvc.ComputeProvider",
 	HandlerType: (*ComputeProviderServer)(nil),
@@ -752,6 +785,10 @@ var _ComputeProvider_serviceDesc = grpc.ServiceDesc{
 			MethodName: "HandleTaskReadyGo",
 			Handler:    _ComputeProvider_HandleTaskReadyGo_Handler,
 		},
+		{
+			MethodName: "HandleCancelTask",
+			Handler:    _ComputeProvider_HandleCancelTask_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
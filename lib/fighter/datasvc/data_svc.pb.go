@@ -8,11 +8,11 @@ import (
 	fmt "fmt"
 	common "github.com/RosettaFlow/Carrier-Go/lib/fighter/common"
 	proto "github.com/gogo/protobuf/proto"
+	empty "github.com/golang/protobuf/ptypes/empty"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
-	emptypb "google.golang.org/protobuf/types/known/emptypb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -927,72 +927,74 @@ func init() {
 }
 
 var fileDescriptor_6c1e51d2cf0273a9 = []byte{
-	// 1030 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xcb, 0x6e, 0xdb, 0x46,
-	0x14, 0x35, 0x25, 0x4a, 0x36, 0xaf, 0x1f, 0xb1, 0x27, 0xb1, 0x44, 0xd0, 0x8a, 0x2b, 0x70, 0x53,
-	0xc1, 0x45, 0x28, 0xc3, 0x7d, 0x2c, 0xb2, 0x28, 0x0a, 0x5b, 0xf5, 0x03, 0x08, 0x02, 0x83, 0x4e,
-	0x16, 0x2d, 0x5a, 0x08, 0x23, 0xce, 0xd8, 0x1a, 0x88, 0xe2, 0x28, 0x9c, 0x91, 0x05, 0x75, 0xd9,
-	0x7e, 0x42, 0x57, 0xdd, 0xf7, 0x2f, 0xfa, 0x03, 0x5d, 0x16, 0xc8, 0x0f, 0x14, 0x46, 0x3f, 0xa4,
-	0x98, 0x19, 0x92, 0xa2, 0x1c, 0x1b, 0x4d, 0x57, 0x9a, 0xfb, 0xe0, 0xb9, 0xaf, 0x33, 0x77, 0x04,
-	0x7e, 0xcc, 0x06, 0xdd, 0x6b, 0x76, 0x33, 0x94, 0x34, 0xed, 0x12, 0x2c, 0xb1, 0xb8, 0x8d, 0xf4,
-	0x6f, 0x5f, 0xdc, 0x46, 0xc1, 0x24, 0xe5, 0x92, 0xa3, 0xd5, 0x4c, 0xef, 0xed, 0xdd, 0x70, 0x7e,
-	0x13, 0xd3, 0xae, 0x56, 0x0f, 0xa6, 0xd7, 0x5d, 0x3a, 0x9e, 0xc8, 0xb9, 0xf1, 0xf2, 0x5a, 0x99,
-	0x11, 0x4f, 0x58, 0x17, 0x27, 0x09, 0x97, 0x58, 0x32, 0x9e, 0x88, 0xcc, 0xfa, 0x49, 0x39, 0x4e,
-	0xc4, 0xc7, 0x63, 0x9e, 0x64, 0x3f, 0xc6, 0xc1, 0x0f, 0xe0, 0x49, 0x8f, 0xcf, 0x92, 0x98, 0x63,
-	0x12, 0xd2, 0x77, 0x53, 0x2a, 0x24, 0xda, 0x03, 0xe7, 0x9a, 0xc5, 0xb4, 0x3f, 0xc1, 0x72, 0xe8,
-	0x5a, 0x6d, 0xab, 0xe3, 0x84, 0x6b, 0x4a, 0x71, 0x89, 0xe5, 0xd0, 0x1f, 0xc0, 0xe6, 0xc2, 0x7f,
-	0x12, 0xcf, 0xd1, 0x0b, 0xa8, 0x0b, 0x89, 0xe5, 0x54, 0x68, 0xd7, 0xad, 0xa3, 0xa7, 0x41, 0x96,
-	0x76, 0xf0, 0x06, 0x8b, 0xd1, 0x95, 0x36, 0x9d, 0xaf, 0x84, 0x99, 0x13, 0xf2, 0x60, 0x35, 0xe2,
-	0x89, 0xa4, 0x89, 0x74, 0x2b, 0x6d, 0xab, 0xb3, 0x71, 0xbe, 0x12, 0xe6, 0x8a, 0xe3, 0x3a, 0xd8,
-	0xea, 0x5b, 0xff, 0x0f, 0x0b, 0xd6, 0x4e, 0x59, 0x4c, 0x2f, 0x92, 0x6b, 0x5e, 0x64, 0x93, 0xe0,
-	0x31, 0x2d, 0x67, 0xf3, 0x1a, 0x8f, 0x69, 0x61, 0x94, 0xf3, 0x09, 0xd5, 0x78, 0x99, 0xf1, 0xcd,
-	0x7c, 0x42, 0x51, 0x1b, 0xd6, 0x09, 0x15, 0x51, 0xca, 0x26, 0xaa, 0x23, 0x6e, 0x55, 0x9b, 0xcb,
-	0x2a, 0xe4, 0xaa, 0x64, 0xe2, 0xe9, 0x38, 0x11, 0xae, 0xdd, 0xae, 0x76, 0x9c, 0x30, 0x17, 0xd1,
-	0x73, 0x80, 0x88, 0xc7, 0x7d, 0xa2, 0x80, 0x85, 0x5b, 0xd3, 0x46, 0x27, 0xe2, 0x71, 0x4f, 0x2b,
-	0x90, 0x07, 0x6b, 0x23, 0x3a, 0x9f, 0xf1, 0x94, 0x08, 0xb7, 0xae, 0x8d, 0x85, 0xec, 0xff, 0x00,
-	0x9b, 0x6f, 0x27, 0xe5, 0x7e, 0x7e, 0x0a, 0xf6, 0x98, 0x4a, 0xac, 0x93, 0x5f, 0x3f, 0xda, 0x29,
-	0xfa, 0x93, 0x97, 0x78, 0xbe, 0x12, 0x6a, 0x87, 0x8f, 0xea, 0xcd, 0x15, 0xac, 0xe7, 0xe8, 0xaa,
-	0xfb, 0x5b, 0x50, 0xe1, 0x23, 0x8d, 0xbc, 0x16, 0x56, 0xf8, 0x08, 0x35, 0x41, 0xb3, 0xa6, 0xcf,
-	0x48, 0xd6, 0x8e, 0xba, 0x12, 0x2f, 0xc8, 0xf2, 0x50, 0xab, 0xf7, 0x86, 0xfa, 0xbb, 0x05, 0x9b,
-	0xaf, 0x98, 0x90, 0x3d, 0x2c, 0xb1, 0xc1, 0x0d, 0x4c, 0x38, 0xd7, 0x6a, 0x57, 0x3b, 0xeb, 0x47,
-	0x5e, 0x91, 0xf3, 0x92, 0x57, 0x10, 0xf2, 0x59, 0xa8, 0xfd, 0xbc, 0x08, 0xaa, 0x21, 0x9f, 0x95,
-	0xc3, 0x5b, 0x4b, 0xe1, 0x11, 0xd8, 0x2c, 0xb9, 0xe6, 0x59, 0x52, 0xfa, 0xbc, 0x3c, 0xd9, 0xea,
-	0xbd, 0xc9, 0x22, 0xb0, 0x05, 0xfb, 0x89, 0xba, 0x76, 0xdb, 0xea, 0xd4, 0x42, 0x7d, 0xf6, 0x7f,
-	0xb3, 0x00, 0x9d, 0x51, 0x1d, 0xbf, 0x47, 0x25, 0x66, 0xb1, 0xc9, 0x75, 0x17, 0xea, 0x49, 0x3f,
-	0xe5, 0x33, 0xc3, 0xc0, 0x5a, 0x58, 0x4b, 0x42, 0x3e, 0x13, 0xe8, 0x2b, 0xa8, 0x31, 0x49, 0xc7,
-	0xc2, 0xad, 0xe8, 0x1a, 0xda, 0x45, 0x0d, 0x1f, 0x42, 0x04, 0x17, 0x92, 0x8e, 0x43, 0xe3, 0xee,
-	0x7d, 0x01, 0xb6, 0x12, 0x51, 0x03, 0xea, 0x86, 0x0d, 0x79, 0x29, 0x46, 0x42, 0xcf, 0xa0, 0x46,
-	0x4a, 0x7c, 0x33, 0x82, 0xff, 0x4b, 0x05, 0xb6, 0xce, 0xa8, 0x34, 0x7c, 0x37, 0x79, 0xed, 0x81,
-	0x93, 0x70, 0x92, 0x91, 0x33, 0x63, 0xae, 0x52, 0x68, 0x72, 0x36, 0x61, 0x55, 0x1b, 0x17, 0x83,
-	0x52, 0xe2, 0x05, 0x51, 0xf0, 0xea, 0xaa, 0xe4, 0x1d, 0x31, 0x02, 0x3a, 0xcc, 0xfa, 0x67, 0x6b,
-	0x0e, 0xb5, 0xca, 0xb5, 0x94, 0x42, 0x06, 0x8a, 0x4e, 0xa6, 0xbb, 0x9e, 0x00, 0x5b, 0xdf, 0x1f,
-	0x04, 0x76, 0xe9, 0xea, 0xe8, 0xb3, 0x62, 0xb7, 0xe4, 0x12, 0xc7, 0x7d, 0xc2, 0xc4, 0x28, 0x8b,
-	0xef, 0x68, 0x4d, 0x8f, 0x89, 0x91, 0x4a, 0x7c, 0x2a, 0x28, 0x31, 0xd6, 0x6c, 0x30, 0x4a, 0x91,
-	0x1b, 0x19, 0x89, 0xa9, 0x31, 0xda, 0xc6, 0xa8, 0x14, 0xca, 0xe8, 0xbf, 0x86, 0xdd, 0x2b, 0x9a,
-	0x90, 0xab, 0x21, 0x4e, 0xa9, 0x30, 0x3c, 0x31, 0x77, 0xe0, 0x51, 0x62, 0xb4, 0xc0, 0x49, 0x69,
-	0x44, 0xd9, 0x2d, 0x4d, 0xcd, 0xa4, 0x9c, 0x70, 0xa1, 0xf0, 0x8f, 0xe1, 0xe9, 0x7d, 0x3c, 0xd5,
-	0xd9, 0xcf, 0x3e, 0x62, 0xe7, 0xe4, 0x1b, 0xe7, 0xe0, 0x1b, 0x80, 0x85, 0x16, 0x39, 0x50, 0xbb,
-	0x92, 0x38, 0x95, 0xdb, 0x2b, 0x68, 0x43, 0x6d, 0x99, 0x84, 0x89, 0x21, 0x25, 0xdb, 0x16, 0xda,
-	0x04, 0xe7, 0x04, 0x27, 0x11, 0x8d, 0x63, 0x4a, 0xb6, 0x2b, 0x08, 0xa0, 0x7e, 0x8a, 0x99, 0x3a,
-	0x57, 0x8f, 0xde, 0xd7, 0x60, 0x43, 0x05, 0xbf, 0x4c, 0xf9, 0x2d, 0x23, 0x34, 0x45, 0x6f, 0xc1,
-	0x29, 0x1a, 0x8f, 0x1a, 0x81, 0xd9, 0xc0, 0x41, 0xbe, 0x9e, 0x83, 0x6f, 0xd5, 0x7a, 0xf6, 0x9a,
-	0x8f, 0x0c, 0xc9, 0x6f, 0xfe, 0xfc, 0xfe, 0x9f, 0x5f, 0x2b, 0x3b, 0xe8, 0x89, 0x5e, 0xf8, 0xdd,
-	0x9b, 0x02, 0x29, 0x84, 0xb5, 0xfc, 0x7e, 0x3d, 0x8a, 0xda, 0x78, 0xf8, 0x2a, 0xfa, 0x0d, 0x0d,
-	0xba, 0x8d, 0xb6, 0x0c, 0x68, 0x9c, 0xe3, 0x7c, 0x07, 0x60, 0xf6, 0x45, 0x86, 0x9a, 0x7f, 0xbd,
-	0xb4, 0xa2, 0xbc, 0x67, 0x1f, 0xe8, 0x15, 0xe6, 0x9e, 0xc6, 0xdc, 0xf5, 0xb7, 0x0d, 0xe6, 0xb4,
-	0xc0, 0x79, 0x69, 0x1d, 0x74, 0x2c, 0xf4, 0x23, 0xac, 0x1f, 0x63, 0x19, 0x0d, 0xcd, 0x27, 0xff,
-	0x13, 0xbb, 0xa5, 0xb1, 0x1b, 0xfe, 0x8e, 0xc1, 0x1e, 0x2c, 0x80, 0x34, 0xf8, 0xa1, 0x85, 0x30,
-	0x6c, 0xe4, 0x2f, 0x8d, 0xce, 0xdd, 0x2d, 0x70, 0xee, 0x3d, 0x58, 0xa5, 0x9e, 0x2c, 0x3d, 0x4d,
-	0xfe, 0x73, 0x1d, 0xa3, 0xe9, 0x23, 0x13, 0x83, 0xf0, 0x59, 0xf2, 0x6a, 0x51, 0xc1, 0xa1, 0x85,
-	0xbe, 0x06, 0xe8, 0xd1, 0x98, 0x4a, 0xfa, 0x1f, 0x01, 0x1e, 0x2e, 0x61, 0x05, 0x5d, 0xc2, 0xd6,
-	0x32, 0x3d, 0xd1, 0x7e, 0xe1, 0xf9, 0xe0, 0x3d, 0xf0, 0x5a, 0x8f, 0xda, 0x0d, 0x22, 0x83, 0x9d,
-	0x73, 0x9c, 0x90, 0x98, 0x2a, 0xca, 0x86, 0x14, 0x93, 0xf9, 0x19, 0x47, 0x8d, 0x20, 0x7b, 0xb2,
-	0x4b, 0xca, 0x90, 0xbe, 0xf3, 0xdc, 0x07, 0xf5, 0x0a, 0xc8, 0xd7, 0x95, 0xb7, 0xfc, 0xa6, 0xa9,
-	0x7c, 0x78, 0x1f, 0xf2, 0xa5, 0x75, 0x70, 0x7c, 0xf2, 0xe7, 0xdd, 0xbe, 0xf5, 0xd7, 0xdd, 0xbe,
-	0xf5, 0xf7, 0xdd, 0xbe, 0xf5, 0xfd, 0x97, 0x37, 0x4c, 0x0e, 0xa7, 0x03, 0x85, 0xd8, 0x0d, 0xb9,
-	0xa0, 0x52, 0xe2, 0xd3, 0x98, 0xcf, 0xba, 0x27, 0x38, 0x4d, 0x19, 0x4d, 0x5f, 0x9c, 0xf1, 0xee,
-	0x03, 0x7f, 0x59, 0x06, 0x75, 0x4d, 0xcf, 0xcf, 0xff, 0x0d, 0x00, 0x00, 0xff, 0xff, 0x0f, 0xba,
-	0x72, 0x42, 0xd0, 0x08, 0x00, 0x00,
+	// 1061 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0x4b, 0x6e, 0xe3, 0x46,
+	0x13, 0x36, 0xf5, 0xb2, 0x59, 0x7e, 0x8c, 0xdc, 0x33, 0x96, 0x08, 0x5a, 0xe3, 0x5f, 0x3f, 0x37,
+	0x11, 0x1c, 0x0c, 0x65, 0x38, 0x8f, 0x85, 0x17, 0x41, 0x60, 0x2b, 0x7e, 0x00, 0x83, 0x81, 0x41,
+	0x8f, 0x17, 0x09, 0x12, 0x08, 0x2d, 0xb2, 0x6d, 0x35, 0x44, 0xb1, 0x39, 0xec, 0x96, 0x05, 0x65,
+	0x99, 0x1c, 0x21, 0xab, 0xec, 0x73, 0x8a, 0xe4, 0x02, 0x59, 0x06, 0xc8, 0x05, 0x02, 0x23, 0x07,
+	0x09, 0xba, 0x9b, 0xa4, 0x28, 0x8d, 0x8c, 0x4c, 0x56, 0x62, 0xd5, 0x57, 0xfc, 0xbe, 0xaa, 0xea,
+	0xea, 0xa2, 0xc0, 0x09, 0xe9, 0xa0, 0x7b, 0x47, 0xef, 0x87, 0x82, 0x24, 0xdd, 0x00, 0x0b, 0xcc,
+	0x1f, 0x7c, 0xf5, 0xdb, 0xe7, 0x0f, 0xbe, 0x1b, 0x27, 0x4c, 0x30, 0xb4, 0x9e, 0xfa, 0xed, 0xfd,
+	0x7b, 0xc6, 0xee, 0x43, 0xd2, 0x55, 0xee, 0xc1, 0xe4, 0xae, 0x4b, 0xc6, 0xb1, 0x98, 0xe9, 0x28,
+	0xbb, 0x95, 0x82, 0x38, 0xa6, 0x5d, 0x1c, 0x45, 0x4c, 0x60, 0x41, 0x59, 0xc4, 0x53, 0xf4, 0x7f,
+	0x45, 0x1d, 0x9f, 0x8d, 0xc7, 0x2c, 0x4a, 0x7f, 0x74, 0x80, 0xe3, 0xc2, 0xb3, 0x1e, 0x9b, 0x46,
+	0x21, 0xc3, 0x81, 0x47, 0xde, 0x4d, 0x08, 0x17, 0x68, 0x1f, 0xcc, 0x3b, 0x1a, 0x92, 0x7e, 0x8c,
+	0xc5, 0xd0, 0x32, 0xda, 0x46, 0xc7, 0xf4, 0x36, 0xa4, 0xe3, 0x1a, 0x8b, 0xa1, 0x33, 0x80, 0xed,
+	0x79, 0x7c, 0x1c, 0xce, 0xd0, 0x2b, 0xa8, 0x71, 0x81, 0xc5, 0x84, 0xab, 0xd0, 0x9d, 0xe3, 0xe7,
+	0x6e, 0x9a, 0xb6, 0xfb, 0x16, 0xf3, 0xd1, 0x8d, 0x82, 0x2e, 0xd7, 0xbc, 0x34, 0x08, 0xd9, 0xb0,
+	0xee, 0xb3, 0x48, 0x90, 0x48, 0x58, 0xa5, 0xb6, 0xd1, 0xd9, 0xba, 0x5c, 0xf3, 0x32, 0xc7, 0x69,
+	0x0d, 0x2a, 0xf2, 0x5d, 0xe7, 0x37, 0x03, 0x36, 0xce, 0x69, 0x48, 0xae, 0xa2, 0x3b, 0x96, 0x67,
+	0x13, 0xe1, 0x31, 0x29, 0x66, 0xf3, 0x06, 0x8f, 0x49, 0x0e, 0x8a, 0x59, 0x4c, 0xac, 0xd2, 0x1c,
+	0x7c, 0x3b, 0x8b, 0x09, 0x6a, 0xc3, 0x66, 0x40, 0xb8, 0x9f, 0xd0, 0x58, 0x76, 0xc4, 0x2a, 0x2b,
+	0xb8, 0xe8, 0x42, 0x96, 0x4c, 0x26, 0x9c, 0x8c, 0x23, 0x6e, 0x55, 0xda, 0xe5, 0x8e, 0xe9, 0x65,
+	0x26, 0x7a, 0x09, 0xe0, 0xb3, 0xb0, 0x1f, 0x48, 0x62, 0x6e, 0x55, 0x15, 0x68, 0xfa, 0x2c, 0xec,
+	0x29, 0x07, 0xb2, 0x61, 0x63, 0x44, 0x66, 0x53, 0x96, 0x04, 0xdc, 0xaa, 0x29, 0x30, 0xb7, 0x9d,
+	0x6f, 0x61, 0xfb, 0x36, 0x2e, 0xf6, 0xf3, 0x23, 0xa8, 0x8c, 0x89, 0xc0, 0x2a, 0xf9, 0xcd, 0xe3,
+	0xdd, 0xbc, 0x3f, 0x59, 0x89, 0x97, 0x6b, 0x9e, 0x0a, 0xf8, 0xa0, 0xde, 0xdc, 0xc0, 0xe6, 0x6d,
+	0x3c, 0xef, 0xfe, 0x0e, 0x94, 0xd8, 0x48, 0x31, 0x6f, 0x78, 0x25, 0x36, 0x42, 0x4d, 0x50, 0x53,
+	0xd3, 0xa7, 0x41, 0xda, 0x8e, 0x9a, 0x34, 0xaf, 0x82, 0xc5, 0x43, 0x2d, 0x2f, 0x1d, 0xea, 0x2f,
+	0x06, 0x6c, 0xbf, 0xa6, 0x5c, 0xf4, 0xb0, 0xc0, 0x9a, 0xd7, 0xd5, 0x72, 0x96, 0xd1, 0x2e, 0x77,
+	0x36, 0x8f, 0xed, 0x3c, 0xe7, 0x85, 0x28, 0xd7, 0x63, 0x53, 0x4f, 0xc5, 0xd9, 0x3e, 0x94, 0x3d,
+	0x36, 0x2d, 0xca, 0x1b, 0x0b, 0xf2, 0x08, 0x2a, 0x34, 0xba, 0x63, 0x69, 0x52, 0xea, 0x79, 0xf1,
+	0x64, 0xcb, 0x4b, 0x27, 0x8b, 0xa0, 0xc2, 0xe9, 0xf7, 0xc4, 0xaa, 0xb4, 0x8d, 0x4e, 0xd5, 0x53,
+	0xcf, 0xce, 0xcf, 0x06, 0xa0, 0x0b, 0xa2, 0xf4, 0x7b, 0x44, 0x60, 0x1a, 0xea, 0x5c, 0xf7, 0xa0,
+	0x16, 0xf5, 0x13, 0x36, 0xd5, 0x13, 0x58, 0xf5, 0xaa, 0x91, 0xc7, 0xa6, 0x1c, 0x7d, 0x0e, 0x55,
+	0x2a, 0xc8, 0x98, 0x5b, 0x25, 0x55, 0x43, 0x3b, 0xaf, 0xe1, 0x7d, 0x0a, 0xf7, 0x4a, 0x90, 0xb1,
+	0xa7, 0xc3, 0xed, 0x4f, 0xa1, 0x22, 0x4d, 0xd4, 0x80, 0x9a, 0x9e, 0x86, 0xac, 0x14, 0x6d, 0xa1,
+	0x17, 0x50, 0x0d, 0x0a, 0xf3, 0xa6, 0x0d, 0xe7, 0xc7, 0x12, 0xec, 0x5c, 0x10, 0xa1, 0xe7, 0x5d,
+	0xe7, 0xb5, 0x0f, 0x66, 0xc4, 0x82, 0x74, 0x38, 0xd3, 0xc9, 0x95, 0x0e, 0x35, 0x9c, 0x4d, 0x58,
+	0x57, 0xe0, 0xfc, 0xa0, 0xa4, 0x79, 0x15, 0x48, 0x7a, 0x79, 0x55, 0xb2, 0x8e, 0x68, 0x03, 0x1d,
+	0xa5, 0xfd, 0xab, 0xa8, 0x19, 0x6a, 0x15, 0x6b, 0x29, 0x48, 0xba, 0x72, 0x9c, 0x74, 0x77, 0x6d,
+	0x0e, 0x15, 0x69, 0xc9, 0x46, 0x16, 0xae, 0x8e, 0x7a, 0x96, 0xd3, 0x2d, 0x98, 0xc0, 0x61, 0x3f,
+	0xa0, 0x7c, 0x94, 0xea, 0x9b, 0xca, 0xd3, 0xa3, 0x7c, 0x24, 0x13, 0x9f, 0x70, 0x12, 0x68, 0x34,
+	0x3d, 0x18, 0xe9, 0xc8, 0x40, 0x1a, 0x84, 0x44, 0x83, 0x15, 0x0d, 0x4a, 0x87, 0x04, 0x9d, 0x37,
+	0xb0, 0x77, 0x43, 0xa2, 0xe0, 0x66, 0x88, 0x13, 0xc2, 0xf5, 0x9c, 0xe8, 0x3b, 0xf0, 0xe4, 0x60,
+	0xb4, 0xc0, 0x4c, 0x88, 0x4f, 0xe8, 0x03, 0x49, 0xf4, 0x49, 0x99, 0xde, 0xdc, 0xe1, 0x9c, 0xc2,
+	0xf3, 0x65, 0x3e, 0xd9, 0xd9, 0x8f, 0x3f, 0x60, 0xe7, 0x64, 0x1b, 0xe7, 0xf0, 0x4b, 0x80, 0xb9,
+	0x17, 0x99, 0x50, 0xbd, 0x11, 0x38, 0x11, 0xf5, 0x35, 0xb4, 0x25, 0xb7, 0x4c, 0x44, 0xf9, 0x90,
+	0x04, 0x75, 0x03, 0x6d, 0x83, 0x79, 0x86, 0x23, 0x9f, 0x84, 0x21, 0x09, 0xea, 0x25, 0x04, 0x50,
+	0x3b, 0xc7, 0x54, 0x3e, 0x97, 0x8f, 0x7f, 0xad, 0xc1, 0x96, 0x14, 0xbf, 0x4e, 0xd8, 0x03, 0x0d,
+	0x48, 0x82, 0x6e, 0xc1, 0xcc, 0x1b, 0x8f, 0x1a, 0xae, 0xde, 0xc0, 0x6e, 0xb6, 0x9e, 0xdd, 0xaf,
+	0xe4, 0x7a, 0xb6, 0x9b, 0x4f, 0x1c, 0x92, 0xd3, 0xfc, 0xe1, 0xcf, 0xbf, 0x7f, 0x2a, 0xed, 0xa2,
+	0x67, 0x6a, 0xe1, 0x77, 0xef, 0x73, 0x26, 0x0f, 0x36, 0xb2, 0xfb, 0xf5, 0x24, 0x6b, 0x63, 0xf5,
+	0x55, 0x74, 0x1a, 0x8a, 0xb4, 0x8e, 0x76, 0x34, 0x69, 0x98, 0xf1, 0x7c, 0x0d, 0xa0, 0xf7, 0x45,
+	0xca, 0x9a, 0xbd, 0xbd, 0xb0, 0xa2, 0xec, 0x17, 0xef, 0xf9, 0x25, 0xe7, 0xbe, 0xe2, 0xdc, 0x73,
+	0xea, 0x9a, 0x73, 0x92, 0xf3, 0x9c, 0x18, 0x87, 0x1d, 0x03, 0x7d, 0x07, 0x9b, 0xa7, 0x58, 0xf8,
+	0x43, 0xfd, 0xca, 0x7f, 0xe4, 0x6e, 0x29, 0xee, 0x86, 0xb3, 0xab, 0xb9, 0x07, 0x73, 0x22, 0x45,
+	0x7e, 0x64, 0x20, 0x0c, 0x5b, 0xd9, 0x97, 0x46, 0xe5, 0x6e, 0xe5, 0x3c, 0x4b, 0x1f, 0x2c, 0xbb,
+	0xb1, 0x02, 0x91, 0x1a, 0x2f, 0x95, 0x46, 0xd3, 0x41, 0x5a, 0x23, 0x60, 0xd3, 0xe8, 0xf5, 0xbc,
+	0x82, 0x23, 0x03, 0x7d, 0x01, 0xd0, 0x23, 0x21, 0x11, 0xe4, 0x5f, 0x04, 0x56, 0x97, 0xb0, 0x86,
+	0xae, 0x61, 0x67, 0x71, 0x3c, 0xd1, 0x41, 0x1e, 0xb9, 0xf2, 0x1e, 0xd8, 0xad, 0x27, 0x71, 0xcd,
+	0x48, 0x61, 0xf7, 0x12, 0x47, 0x41, 0x48, 0xe4, 0xc8, 0x7a, 0x04, 0x07, 0xb3, 0x0b, 0x86, 0x1a,
+	0x6e, 0xfa, 0xc9, 0x2e, 0x38, 0x3d, 0xf2, 0xce, 0xb6, 0x56, 0xfa, 0x25, 0x91, 0xa3, 0x2a, 0x6f,
+	0x39, 0x4d, 0x5d, 0xf9, 0x70, 0x99, 0xf2, 0xc4, 0x38, 0x44, 0x04, 0xea, 0x5a, 0x4a, 0x8f, 0xbd,
+	0x44, 0xd1, 0x5e, 0x91, 0x51, 0xfb, 0xa5, 0x50, 0x73, 0x95, 0x5b, 0xea, 0xfc, 0x5f, 0xe9, 0xec,
+	0x3b, 0x8d, 0xa2, 0xce, 0x9c, 0xef, 0xc4, 0x38, 0x3c, 0x3d, 0xfb, 0xfd, 0xf1, 0xc0, 0xf8, 0xe3,
+	0xf1, 0xc0, 0xf8, 0xeb, 0xf1, 0xc0, 0xf8, 0xe6, 0xb3, 0x7b, 0x2a, 0x86, 0x93, 0x81, 0xe4, 0xeb,
+	0x7a, 0x8c, 0x13, 0x21, 0xf0, 0x79, 0xc8, 0xa6, 0xdd, 0x33, 0x9c, 0x24, 0x94, 0x24, 0xaf, 0x2e,
+	0x58, 0x77, 0xc5, 0x3f, 0xa3, 0x41, 0x4d, 0xdd, 0x82, 0x4f, 0xfe, 0x19, 0x00, 0x1f, 0x6a, 0x0e,
+	0x93, 0x37, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1007,14 +1009,15 @@ const _ = grpc.SupportPackageIsVersion4
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type DataProviderClient interface {
-	GetStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetStatusReply, error)
-	ListData(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListDataReply, error)
+	GetStatus(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*GetStatusReply, error)
+	ListData(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListDataReply, error)
 	UploadData(ctx context.Context, opts ...grpc.CallOption) (DataProvider_UploadDataClient, error)
 	BatchUpload(ctx context.Context, opts ...grpc.CallOption) (DataProvider_BatchUploadClient, error)
 	DownloadData(ctx context.Context, in *DownloadRequest, opts ...grpc.CallOption) (DataProvider_DownloadDataClient, error)
 	DeleteData(ctx context.Context, in *DownloadRequest, opts ...grpc.CallOption) (*UploadReply, error)
 	SendSharesData(ctx context.Context, in *SendSharesDataRequest, opts ...grpc.CallOption) (*SendSharesDataReply, error)
 	HandleTaskReadyGo(ctx context.Context, in *common.TaskReadyGoReq, opts ...grpc.CallOption) (*common.TaskReadyGoReply, error)
+	HandleCancelTask(ctx context.Context, in *common.TaskCancelReq, opts ...grpc.CallOption) (*common.TaskCancelReply, error)
 }
 
 type dataProviderClient struct {
@@ -1025,7 +1028,7 @@ func NewDataProviderClient(cc *grpc.ClientConn) DataProviderClient {
 	return &dataProviderClient{cc}
 }
 
-func (c *dataProviderClient) GetStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetStatusReply, error) {
+func (c *dataProviderClient) GetStatus(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*GetStatusReply, error) {
 	out := new(GetStatusReply)
 	err := c.cc.Invoke(ctx, "/datasvc.DataProvider/GetStatus", in, out, opts...)
 	if err != nil {
@@ -1034,7 +1037,7 @@ func (c *dataProviderClient) GetStatus(ctx context.Context, in *emptypb.Empty, o
 	return out, nil
 }
 
-func (c *dataProviderClient) ListData(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*ListDataReply, error) {
+func (c *dataProviderClient) ListData(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*ListDataReply, error) {
 	out := new(ListDataReply)
 	err := c.cc.Invoke(ctx, "/datasvc.DataProvider/ListData", in, out, opts...)
 	if err != nil {
@@ -1167,26 +1170,36 @@ func (c *dataProviderClient) HandleTaskReadyGo(ctx context.Context, in *common.T
 	return out, nil
 }
 
+func (c *dataProviderClient) HandleCancelTask(ctx context.Context, in *common.TaskCancelReq, opts ...grpc.CallOption) (*common.TaskCancelReply, error) {
+	out := new(common.TaskCancelReply)
+	err := c.cc.Invoke(ctx, "/datasvc.DataProvider/HandleCancelTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DataProviderServer is the server API for DataProvider service.
 type DataProviderServer interface {
-	GetStatus(context.Context, *emptypb.Empty) (*GetStatusReply, error)
-	ListData(context.Context, *emptypb.Empty) (*ListDataReply, error)
+	GetStatus(context.Context, *empty.Empty) (*GetStatusReply, error)
+	ListData(context.Context, *empty.Empty) (*ListDataReply, error)
 	UploadData(DataProvider_UploadDataServer) error
 	BatchUpload(DataProvider_BatchUploadServer) error
 	DownloadData(*DownloadRequest, DataProvider_DownloadDataServer) error
 	DeleteData(context.Context, *DownloadRequest) (*UploadReply, error)
 	SendSharesData(context.Context, *SendSharesDataRequest) (*SendSharesDataReply, error)
 	HandleTaskReadyGo(context.Context, *common.TaskReadyGoReq) (*common.TaskReadyGoReply, error)
+	HandleCancelTask(context.Context, *common.TaskCancelReq) (*common.TaskCancelReply, error)
 }
 
 // UnimplementedDataProviderServer can be embedded to have forward compatible implementations.
 type UnimplementedDataProviderServer struct {
 }
 
-func (*UnimplementedDataProviderServer) GetStatus(ctx context.Context, req *emptypb.Empty) (*GetStatusReply, error) {
+func (*UnimplementedDataProviderServer) GetStatus(ctx context.Context, req *empty.Empty) (*GetStatusReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStatus not implemented")
 }
-func (*UnimplementedDataProviderServer) ListData(ctx context.Context, req *emptypb.Empty) (*ListDataReply, error) {
+func (*UnimplementedDataProviderServer) ListData(ctx context.Context, req *empty.Empty) (*ListDataReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListData not implemented")
 }
 func (*UnimplementedDataProviderServer) UploadData(srv DataProvider_UploadDataServer) error {
@@ -1207,13 +1220,16 @@ func (*UnimplementedDataProviderServer) SendSharesData(ctx context.Context, req
 func (*UnimplementedDataProviderServer) HandleTaskReadyGo(ctx context.Context, req *common.TaskReadyGoReq) (*common.TaskReadyGoReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method HandleTaskReadyGo not implemented")
 }
+func (*UnimplementedDataProviderServer) HandleCancelTask(ctx context.Context, req *common.TaskCancelReq) (*common.TaskCancelReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HandleCancelTask not implemented")
+}
 
 func RegisterDataProviderServer(s *grpc.Server, srv DataProviderServer) {
 	s.RegisterService(&_DataProvider_serviceDesc, srv)
 }
 
 func _DataProvider_GetStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+	in := new(empty.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -1225,13 +1241,13 @@ func _DataProvider_GetStatus_Handler(srv interface{}, ctx context.Context, dec f
 		FullMethod: "/datasvc.DataProvider/GetStatus",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataProviderServer).GetStatus(ctx, req.(*emptypb.Empty))
+		return srv.(DataProviderServer).GetStatus(ctx, req.(*empty.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
 func _DataProvider_ListData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
+	in := new(empty.Empty)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
@@ -1243,7 +1259,7 @@ func _DataProvider_ListData_Handler(srv interface{}, ctx context.Context, dec fu
 		FullMethod: "/datasvc.DataProvider/ListData",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(DataProviderServer).ListData(ctx, req.(*emptypb.Empty))
+		return srv.(DataProviderServer).ListData(ctx, req.(*empty.Empty))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1375,6 +1391,24 @@ func _DataProvider_HandleTaskReadyGo_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataProvider_HandleCancelTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(common.TaskCancelReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataProviderServer).HandleCancelTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/datasvc.DataProvider/HandleCancelTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataProviderServer).HandleCancelTask(ctx, req.(*common.TaskCancelReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _DataProvider_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "datasvc.DataProvider",
 	HandlerType: (*DataProviderServer)(nil),
@@ -1399,6 +1433,10 @@ var _DataProvider_serviceDesc = grpc.ServiceDesc{
 			MethodName: "HandleTaskReadyGo",
 			Handler:    _DataProvider_HandleTaskReadyGo_Handler,
 		},
+		{
+			MethodName: "HandleCancelTask",
+			Handler:    _DataProvider_HandleCancelTask_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
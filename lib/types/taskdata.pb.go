@@ -55,9 +55,21 @@ type TaskData struct {
 	CalculateContractCode string              `protobuf:"bytes,27,opt,name=CalculateContractCode,proto3" json:"CalculateContractCode,omitempty"`
 	DataSplitContractCode string              `protobuf:"bytes,28,opt,name=DataSplitContractCode,proto3" json:"DataSplitContractCode,omitempty"`
 	ContractExtraParams   string              `protobuf:"bytes,29,opt,name=ContractExtraParams,proto3" json:"ContractExtraParams,omitempty"`
-	XXX_NoUnkeyedLiteral  struct{}            `json:"-"`
-	XXX_unrecognized      []byte              `json:"-"`
-	XXX_sizecache         int32               `json:"-"`
+	// 任务失败后的重试策略 (为空时不重试)
+	RetryPolicy *TaskRetryPolicy `protobuf:"bytes,30,opt,name=retryPolicy,proto3" json:"retryPolicy,omitempty"`
+	// 任务的第几次尝试 (从 1 开始, 为 0 时等同于 1)
+	Attempt uint32 `protobuf:"varint,31,opt,name=attempt,proto3" json:"attempt,omitempty"`
+	// 重试任务对应的原始任务Id (首次尝试时为空)
+	OriginTaskId string `protobuf:"bytes,32,opt,name=originTaskId,proto3" json:"originTaskId,omitempty"`
+	// 任务的优先级 (0: normal, 1: high, 2: urgent), 优先级高的任务在调度队列中先被调度
+	Priority uint32 `protobuf:"varint,33,opt,name=priority,proto3" json:"priority,omitempty"`
+	// 任务必须在此时间之前启动 (毫秒时间戳, 为 0 时不限制)
+	StartDeadline uint64 `protobuf:"varint,34,opt,name=startDeadline,proto3" json:"startDeadline,omitempty"`
+	// 任务必须在此时间之前完成 (毫秒时间戳, 为 0 时不限制)
+	FinishDeadline       uint64   `protobuf:"varint,35,opt,name=finishDeadline,proto3" json:"finishDeadline,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *TaskData) Reset()         { *m = TaskData{} }
@@ -268,6 +280,115 @@ func (m *TaskData) GetContractExtraParams() string {
 	return ""
 }
 
+func (m *TaskData) GetRetryPolicy() *TaskRetryPolicy {
+	if m != nil {
+		return m.RetryPolicy
+	}
+	return nil
+}
+
+func (m *TaskData) GetAttempt() uint32 {
+	if m != nil {
+		return m.Attempt
+	}
+	return 0
+}
+
+func (m *TaskData) GetOriginTaskId() string {
+	if m != nil {
+		return m.OriginTaskId
+	}
+	return ""
+}
+
+func (m *TaskData) GetPriority() uint32 {
+	if m != nil {
+		return m.Priority
+	}
+	return 0
+}
+
+func (m *TaskData) GetStartDeadline() uint64 {
+	if m != nil {
+		return m.StartDeadline
+	}
+	return 0
+}
+
+func (m *TaskData) GetFinishDeadline() uint64 {
+	if m != nil {
+		return m.FinishDeadline
+	}
+	return 0
+}
+
+// 任务的重试策略
+type TaskRetryPolicy struct {
+	// 最大尝试次数 (包含首次执行, <= 1 时不重试)
+	MaxAttempts uint32 `protobuf:"varint,1,opt,name=maxAttempts,proto3" json:"maxAttempts,omitempty"`
+	// 每次重试前的等待时长 (毫秒)
+	Backoff uint64 `protobuf:"varint,2,opt,name=backoff,proto3" json:"backoff,omitempty"`
+	// 重试时是否重新选举算力提供方 (否则沿用上一次的算力提供方)
+	ReelectPower         bool     `protobuf:"varint,3,opt,name=reelectPower,proto3" json:"reelectPower,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TaskRetryPolicy) Reset()         { *m = TaskRetryPolicy{} }
+func (m *TaskRetryPolicy) String() string { return proto.CompactTextString(m) }
+func (*TaskRetryPolicy) ProtoMessage()    {}
+func (*TaskRetryPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2293d9334aae6da1, []int{1}
+}
+func (m *TaskRetryPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskRetryPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskRetryPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TaskRetryPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskRetryPolicy.Merge(m, src)
+}
+func (m *TaskRetryPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskRetryPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskRetryPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskRetryPolicy proto.InternalMessageInfo
+
+func (m *TaskRetryPolicy) GetMaxAttempts() uint32 {
+	if m != nil {
+		return m.MaxAttempts
+	}
+	return 0
+}
+
+func (m *TaskRetryPolicy) GetBackoff() uint64 {
+	if m != nil {
+		return m.Backoff
+	}
+	return 0
+}
+
+func (m *TaskRetryPolicy) GetReelectPower() bool {
+	if m != nil {
+		return m.ReelectPower
+	}
+	return false
+}
+
 // 任务算力提供方基础信息
 type TaskResourceSupplierData struct {
 	// 身份信息
@@ -283,7 +404,7 @@ func (m *TaskResourceSupplierData) Reset()         { *m = TaskResourceSupplierDa
 func (m *TaskResourceSupplierData) String() string { return proto.CompactTextString(m) }
 func (*TaskResourceSupplierData) ProtoMessage()    {}
 func (*TaskResourceSupplierData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2293d9334aae6da1, []int{1}
+	return fileDescriptor_2293d9334aae6da1, []int{2}
 }
 func (m *TaskResourceSupplierData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -349,7 +470,7 @@ func (m *ResourceUsedOverview) Reset()         { *m = ResourceUsedOverview{} }
 func (m *ResourceUsedOverview) String() string { return proto.CompactTextString(m) }
 func (*ResourceUsedOverview) ProtoMessage()    {}
 func (*ResourceUsedOverview) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2293d9334aae6da1, []int{2}
+	return fileDescriptor_2293d9334aae6da1, []int{3}
 }
 func (m *ResourceUsedOverview) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -439,7 +560,7 @@ func (m *TaskMetadataSupplierData) Reset()         { *m = TaskMetadataSupplierDa
 func (m *TaskMetadataSupplierData) String() string { return proto.CompactTextString(m) }
 func (*TaskMetadataSupplierData) ProtoMessage()    {}
 func (*TaskMetadataSupplierData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2293d9334aae6da1, []int{3}
+	return fileDescriptor_2293d9334aae6da1, []int{4}
 }
 func (m *TaskMetadataSupplierData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -514,7 +635,7 @@ func (m *TaskResourceData) Reset()         { *m = TaskResourceData{} }
 func (m *TaskResourceData) String() string { return proto.CompactTextString(m) }
 func (*TaskResourceData) ProtoMessage()    {}
 func (*TaskResourceData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2293d9334aae6da1, []int{4}
+	return fileDescriptor_2293d9334aae6da1, []int{5}
 }
 func (m *TaskResourceData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -584,7 +705,7 @@ func (m *TaskResultReceiverData) Reset()         { *m = TaskResultReceiverData{}
 func (m *TaskResultReceiverData) String() string { return proto.CompactTextString(m) }
 func (*TaskResultReceiverData) ProtoMessage()    {}
 func (*TaskResultReceiverData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2293d9334aae6da1, []int{5}
+	return fileDescriptor_2293d9334aae6da1, []int{6}
 }
 func (m *TaskResultReceiverData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -641,7 +762,7 @@ func (m *OrganizationData) Reset()         { *m = OrganizationData{} }
 func (m *OrganizationData) String() string { return proto.CompactTextString(m) }
 func (*OrganizationData) ProtoMessage()    {}
 func (*OrganizationData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2293d9334aae6da1, []int{6}
+	return fileDescriptor_2293d9334aae6da1, []int{7}
 }
 func (m *OrganizationData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -713,7 +834,7 @@ func (m *EventData) Reset()         { *m = EventData{} }
 func (m *EventData) String() string { return proto.CompactTextString(m) }
 func (*EventData) ProtoMessage()    {}
 func (*EventData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2293d9334aae6da1, []int{7}
+	return fileDescriptor_2293d9334aae6da1, []int{8}
 }
 func (m *EventData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -779,6 +900,7 @@ func (m *EventData) GetIdentity() string {
 
 func init() {
 	proto.RegisterType((*TaskData)(nil), "types.TaskData")
+	proto.RegisterType((*TaskRetryPolicy)(nil), "types.TaskRetryPolicy")
 	proto.RegisterType((*TaskResourceSupplierData)(nil), "types.TaskResourceSupplierData")
 	proto.RegisterType((*ResourceUsedOverview)(nil), "types.ResourceUsedOverview")
 	proto.RegisterType((*TaskMetadataSupplierData)(nil), "types.TaskMetadataSupplierData")
@@ -791,68 +913,77 @@ func init() {
 func init() { proto.RegisterFile("lib/types/taskdata.proto", fileDescriptor_2293d9334aae6da1) }
 
 var fileDescriptor_2293d9334aae6da1 = []byte{
-	// 965 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xc1, 0x6e, 0xdb, 0x46,
-	0x13, 0x06, 0x6d, 0xd9, 0x92, 0x56, 0x96, 0xe3, 0x7f, 0x7f, 0xc7, 0xde, 0xc8, 0x89, 0x2b, 0xa8,
-	0x08, 0x2a, 0xa0, 0xa8, 0x55, 0x38, 0x45, 0x81, 0x20, 0x27, 0x47, 0x4d, 0x0b, 0xa1, 0x4d, 0x1d,
-	0xd0, 0xee, 0xa5, 0x17, 0x63, 0x45, 0x6e, 0x15, 0x22, 0x14, 0x97, 0xd8, 0x1d, 0xca, 0x75, 0x81,
-	0x5e, 0xfa, 0x12, 0x3d, 0xf4, 0x05, 0xfa, 0x1a, 0xbd, 0xf5, 0xd8, 0x47, 0x28, 0xfc, 0x00, 0x05,
-	0xfa, 0x06, 0xc5, 0xcc, 0x92, 0x14, 0xa9, 0xc8, 0xe9, 0xa1, 0x37, 0x7e, 0x33, 0xdf, 0xf7, 0x8d,
-	0x38, 0xb3, 0x9c, 0x15, 0x13, 0x71, 0x34, 0x1d, 0xc1, 0x4d, 0xaa, 0xec, 0x08, 0xa4, 0x7d, 0x13,
-	0x4a, 0x90, 0x27, 0xa9, 0xd1, 0xa0, 0xf9, 0x16, 0x45, 0x7b, 0xef, 0x1b, 0x95, 0x6a, 0x3b, 0xa2,
-	0xd8, 0x34, 0xfb, 0x6e, 0x34, 0xd3, 0x33, 0x4d, 0x80, 0x9e, 0x1c, 0xb7, 0x57, 0x71, 0x99, 0x2b,
-	0x90, 0x4b, 0x97, 0xc1, 0xdf, 0x4d, 0xd6, 0xba, 0x94, 0xf6, 0xcd, 0x67, 0x12, 0x24, 0xef, 0xb1,
-	0x56, 0x14, 0xaa, 0x04, 0x22, 0xb8, 0x11, 0x5e, 0xdf, 0x1b, 0xb6, 0xfd, 0x12, 0xf3, 0x03, 0xb6,
-	0x9d, 0xe8, 0x50, 0x4d, 0x42, 0xb1, 0x41, 0x99, 0x1c, 0xa1, 0x06, 0x9f, 0xbe, 0x96, 0x73, 0x25,
-	0x36, 0x9d, 0xa6, 0xc0, 0xa8, 0xc1, 0x52, 0x93, 0x50, 0x34, 0x9c, 0xc6, 0x21, 0x7e, 0xcc, 0x18,
-	0x3e, 0x5d, 0x80, 0x84, 0xcc, 0x8a, 0x2d, 0xca, 0x55, 0x22, 0xa8, 0xc3, 0x97, 0x9d, 0x84, 0x62,
-	0xdb, 0xe9, 0x1c, 0xc2, 0x5a, 0xf8, 0x44, 0xb5, 0x9a, 0xae, 0x56, 0x81, 0xf9, 0x3e, 0xdb, 0xb2,
-	0x20, 0x41, 0x89, 0x16, 0x25, 0x1c, 0x40, 0x27, 0xa3, 0xa4, 0xd5, 0x89, 0x68, 0x3b, 0x27, 0x87,
-	0xf0, 0x17, 0xa8, 0x85, 0x4a, 0x60, 0xac, 0xb3, 0x04, 0x04, 0xeb, 0x7b, 0xc3, 0xae, 0x5f, 0x89,
-	0x70, 0xce, 0x1a, 0xa1, 0xb2, 0x81, 0xe8, 0x90, 0x8a, 0x9e, 0xb1, 0x7a, 0x60, 0x94, 0x04, 0x75,
-	0x06, 0x62, 0xa7, 0xef, 0x0d, 0x1b, 0x7e, 0x89, 0xb1, 0xba, 0x4a, 0xc2, 0x33, 0x10, 0x5d, 0x4a,
-	0x38, 0xc0, 0x05, 0x6b, 0x5a, 0x90, 0x06, 0xce, 0x40, 0xec, 0x52, 0xbc, 0x80, 0xfc, 0x01, 0x6b,
-	0xa5, 0xd2, 0xc0, 0xcd, 0x55, 0x14, 0x8a, 0x7b, 0x54, 0xa3, 0x49, 0x78, 0x12, 0xf2, 0x67, 0x6c,
-	0x47, 0xc6, 0x33, 0x7d, 0x91, 0xa5, 0x69, 0x1c, 0x29, 0x23, 0xf6, 0xfb, 0xde, 0xb0, 0x73, 0x7a,
-	0x78, 0x42, 0xe3, 0x3b, 0x39, 0x37, 0x33, 0x99, 0x44, 0x3f, 0x48, 0x88, 0x74, 0x82, 0x33, 0xf3,
-	0x6b, 0x64, 0x14, 0x63, 0x47, 0x7c, 0x65, 0x75, 0x66, 0x02, 0x25, 0xee, 0xd7, 0xc4, 0x97, 0x95,
-	0x94, 0x13, 0x57, 0xc9, 0xfc, 0x4b, 0xb6, 0x57, 0x9c, 0x8e, 0xb2, 0xfa, 0x41, 0x7f, 0x73, 0xd8,
-	0x39, 0x7d, 0xaf, 0x62, 0xf0, 0x72, 0x85, 0x42, 0x46, 0x6f, 0x09, 0xd1, 0xcc, 0xe4, 0xc6, 0xa5,
-	0xd9, 0xe1, 0x5b, 0x66, 0xfe, 0x0a, 0xc5, 0x99, 0xad, 0x0a, 0xf9, 0x33, 0xd6, 0x36, 0x2a, 0x50,
-	0xd1, 0x42, 0x19, 0x2b, 0x04, 0xb9, 0x3c, 0xaa, 0xbb, 0x64, 0x31, 0xf8, 0x39, 0x83, 0x3c, 0x96,
-	0x7c, 0xfe, 0x94, 0x75, 0xb0, 0xb7, 0x89, 0x32, 0x5f, 0x45, 0x16, 0xc4, 0x03, 0x92, 0xdf, 0xd9,
-	0xcf, 0x2a, 0x97, 0x7f, 0xca, 0xba, 0x74, 0x28, 0x30, 0x43, 0xe2, 0x1e, 0x89, 0xf7, 0x72, 0xf1,
-	0x8b, 0x22, 0xe7, 0xd7, 0x69, 0xfc, 0x13, 0x76, 0x7f, 0x2c, 0xe3, 0x20, 0x8b, 0x25, 0xa8, 0xb1,
-	0x4e, 0xc0, 0xc8, 0x00, 0xc6, 0x3a, 0x54, 0xe2, 0x88, 0x66, 0xbd, 0x3e, 0x89, 0x2a, 0x74, 0xb8,
-	0x48, 0xe3, 0x08, 0x6a, 0xaa, 0x87, 0x4e, 0xb5, 0x36, 0xc9, 0x3f, 0x66, 0xff, 0x2f, 0xf0, 0x8b,
-	0xef, 0xc1, 0xc8, 0x57, 0xd2, 0xc8, 0xb9, 0x15, 0x8f, 0x48, 0xb3, 0x2e, 0x35, 0xf8, 0xd5, 0x63,
-	0xe2, 0xae, 0xe6, 0xe3, 0x09, 0xd2, 0x95, 0x9e, 0xd0, 0x1e, 0x78, 0xd7, 0xf1, 0xab, 0x92, 0xf9,
-	0x39, 0xdb, 0x2f, 0x66, 0xf7, 0x8d, 0x55, 0xe1, 0xf9, 0x42, 0x99, 0x45, 0xa4, 0xae, 0x69, 0x65,
-	0x74, 0x4e, 0x8f, 0x72, 0x13, 0x7f, 0x0d, 0xc5, 0x5f, 0x2b, 0x1c, 0xfc, 0xe5, 0xb1, 0xfd, 0x75,
-	0x74, 0x7e, 0xc4, 0xda, 0xa0, 0x41, 0xc6, 0x57, 0x73, 0x35, 0x27, 0xfb, 0x86, 0xdf, 0xa2, 0xc0,
-	0x4b, 0x35, 0xc7, 0xaf, 0x2b, 0xb3, 0x2a, 0xa4, 0xdc, 0xa6, 0xfb, 0xf0, 0x10, 0x63, 0xea, 0x03,
-	0x76, 0xcf, 0xe9, 0x52, 0xa3, 0x03, 0x65, 0xad, 0x36, 0xb4, 0x9b, 0xba, 0xfe, 0x2e, 0x85, 0x5f,
-	0x15, 0x51, 0xfe, 0x98, 0xed, 0x92, 0xc7, 0x92, 0xb7, 0x45, 0xbc, 0x2e, 0x46, 0x97, 0xb4, 0xd2,
-	0x6f, 0x2a, 0x93, 0xf0, 0x3a, 0x0a, 0xe1, 0x35, 0xed, 0xac, 0x46, 0xee, 0xf7, 0xbc, 0x88, 0x96,
-	0x7e, 0x4b, 0x5e, 0x93, 0x78, 0xe4, 0x57, 0xd2, 0x06, 0xbf, 0xe5, 0xb3, 0x59, 0xf7, 0x95, 0xfd,
-	0xb7, 0xd9, 0x1c, 0xb2, 0x26, 0x7e, 0xa4, 0xb8, 0x71, 0xf2, 0x0d, 0x8e, 0x70, 0x12, 0x62, 0x2b,
-	0x29, 0x91, 0x54, 0x56, 0x38, 0x06, 0x68, 0xad, 0x9e, 0xb2, 0x4e, 0xa0, 0xe3, 0x6c, 0x9e, 0x5c,
-	0xc5, 0x78, 0xfe, 0x1b, 0x74, 0xfe, 0xff, 0x97, 0x57, 0x1c, 0x53, 0x06, 0x7f, 0xaa, 0xcf, 0x1c,
-	0x0b, 0x4f, 0xff, 0xe0, 0x67, 0x8f, 0xed, 0xad, 0xae, 0x1a, 0x9c, 0x49, 0xa0, 0x2d, 0xd0, 0x4c,
-	0x3c, 0x37, 0x13, 0xc4, 0x38, 0x93, 0xc7, 0x6c, 0x97, 0x52, 0xcb, 0x56, 0x6f, 0xb8, 0x56, 0x63,
-	0xb4, 0x36, 0x11, 0xa2, 0x2d, 0x3b, 0xe8, 0x66, 0x4b, 0xb4, 0x65, 0xa3, 0x7b, 0xac, 0x15, 0x66,
-	0xc6, 0x35, 0xa8, 0xe1, 0x0e, 0x46, 0x81, 0x07, 0x3f, 0x79, 0xec, 0x60, 0xfd, 0xc2, 0xe0, 0x4f,
-	0x58, 0xab, 0x58, 0x19, 0xff, 0xd6, 0xd7, 0x92, 0x88, 0xa2, 0xd4, 0xe8, 0x45, 0x14, 0x2a, 0xfc,
-	0xcd, 0xef, 0xdc, 0x2b, 0x25, 0x71, 0xf0, 0x23, 0xdb, 0x5b, 0xcd, 0xd6, 0xee, 0x03, 0xaf, 0x7e,
-	0x1f, 0x54, 0x2f, 0xe5, 0x8d, 0x3b, 0x2f, 0xe5, 0xcd, 0x3b, 0x2f, 0xe5, 0x46, 0xfd, 0x52, 0x1e,
-	0xfc, 0xe2, 0xb1, 0x76, 0xb9, 0xb8, 0x2a, 0x57, 0xad, 0x57, 0xbb, 0x6a, 0x1f, 0xb2, 0x36, 0xad,
-	0xb4, 0xcb, 0x9b, 0x54, 0xe5, 0x65, 0x97, 0x01, 0xbc, 0xd8, 0x08, 0x9c, 0x41, 0xf1, 0x7d, 0xe5,
-	0x90, 0x0f, 0xd8, 0x4e, 0x7e, 0x8d, 0x26, 0xa0, 0x12, 0xc8, 0xab, 0xd7, 0x62, 0xb5, 0x37, 0xda,
-	0xaa, 0xbf, 0xd1, 0xf3, 0xa7, 0xbf, 0xdf, 0x1e, 0x7b, 0x7f, 0xdc, 0x1e, 0x7b, 0x7f, 0xde, 0x1e,
-	0x7b, 0xdf, 0x7e, 0x38, 0x8b, 0xe0, 0x75, 0x36, 0x3d, 0x09, 0xf4, 0x7c, 0xe4, 0x6b, 0xab, 0x00,
-	0xe4, 0xe7, 0xb1, 0xbe, 0x1e, 0x8d, 0xa5, 0x31, 0x91, 0x32, 0x1f, 0x7d, 0xa1, 0x47, 0xe5, 0x3f,
-	0x9b, 0xe9, 0x36, 0xfd, 0xa3, 0x79, 0xf2, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x18, 0x67, 0x00,
-	0x44, 0x33, 0x09, 0x00, 0x00,
+	// 1107 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xc1, 0x6e, 0x1c, 0x45,
+	0x10, 0xd5, 0xd8, 0x6b, 0x7b, 0xb7, 0xd7, 0x6b, 0x9b, 0xc6, 0xb1, 0x3b, 0x76, 0xe2, 0x2c, 0x1b,
+	0x02, 0x96, 0x10, 0x5e, 0xe4, 0x20, 0x44, 0x94, 0x93, 0xb3, 0x09, 0xc8, 0x82, 0x60, 0x6b, 0x6c,
+	0x2e, 0x5c, 0xac, 0xde, 0x99, 0xf6, 0xba, 0xe5, 0xd9, 0xe9, 0xa1, 0xa7, 0xd6, 0xce, 0x22, 0x71,
+	0xe1, 0x27, 0x38, 0xf0, 0x03, 0xfc, 0x06, 0x37, 0x8e, 0x48, 0xfc, 0x00, 0xf2, 0x07, 0xf0, 0x0d,
+	0xa8, 0xaa, 0x67, 0x66, 0x67, 0xd6, 0xeb, 0x70, 0xc8, 0x6d, 0x5e, 0xd5, 0x7b, 0x55, 0xd3, 0x55,
+	0xdd, 0xd5, 0xcd, 0x44, 0xa4, 0xfb, 0x5d, 0x18, 0x27, 0x2a, 0xed, 0x82, 0x4c, 0x2f, 0x43, 0x09,
+	0x72, 0x2f, 0xb1, 0x06, 0x0c, 0x5f, 0x20, 0xeb, 0xd6, 0x63, 0xab, 0x12, 0x93, 0x76, 0xc9, 0xd6,
+	0x1f, 0x9d, 0x77, 0x07, 0x66, 0x60, 0x08, 0xd0, 0x97, 0xe3, 0x6e, 0x95, 0xa2, 0x0c, 0x15, 0xc8,
+	0x49, 0x94, 0xce, 0xdf, 0x0d, 0x56, 0x3f, 0x95, 0xe9, 0xe5, 0x4b, 0x09, 0x92, 0x6f, 0xb1, 0xba,
+	0x0e, 0x55, 0x0c, 0x1a, 0xc6, 0xc2, 0x6b, 0x7b, 0xbb, 0x0d, 0xbf, 0xc0, 0x7c, 0x83, 0x2d, 0xc6,
+	0x26, 0x54, 0x87, 0xa1, 0x98, 0x23, 0x4f, 0x86, 0x50, 0x83, 0x5f, 0xdf, 0xc9, 0xa1, 0x12, 0xf3,
+	0x4e, 0x93, 0x63, 0xd4, 0x60, 0xaa, 0xc3, 0x50, 0xd4, 0x9c, 0xc6, 0x21, 0xbe, 0xc3, 0x18, 0x7e,
+	0x9d, 0x80, 0x84, 0x51, 0x2a, 0x16, 0xc8, 0x57, 0xb2, 0xa0, 0x0e, 0x17, 0x7b, 0x18, 0x8a, 0x45,
+	0xa7, 0x73, 0x08, 0x73, 0xe1, 0x17, 0xe5, 0x5a, 0x72, 0xb9, 0x72, 0xcc, 0xd7, 0xd9, 0x42, 0x0a,
+	0x12, 0x94, 0xa8, 0x93, 0xc3, 0x01, 0x8c, 0x64, 0x95, 0x4c, 0x4d, 0x2c, 0x1a, 0x2e, 0x92, 0x43,
+	0xf8, 0x07, 0xea, 0x4a, 0xc5, 0xd0, 0x33, 0xa3, 0x18, 0x04, 0x6b, 0x7b, 0xbb, 0x2d, 0xbf, 0x64,
+	0xe1, 0x9c, 0xd5, 0x42, 0x95, 0x06, 0xa2, 0x49, 0x2a, 0xfa, 0xc6, 0xec, 0x81, 0x55, 0x12, 0xd4,
+	0x01, 0x88, 0xe5, 0xb6, 0xb7, 0x5b, 0xf3, 0x0b, 0x8c, 0xd9, 0x55, 0x1c, 0x1e, 0x80, 0x68, 0x91,
+	0xc3, 0x01, 0x2e, 0xd8, 0x52, 0x0a, 0xd2, 0xc2, 0x01, 0x88, 0x15, 0xb2, 0xe7, 0x90, 0xdf, 0x67,
+	0xf5, 0x44, 0x5a, 0x18, 0x9f, 0xe9, 0x50, 0xac, 0x52, 0x8e, 0x25, 0xc2, 0x87, 0x21, 0x7f, 0xce,
+	0x96, 0x65, 0x34, 0x30, 0x27, 0xa3, 0x24, 0x89, 0xb4, 0xb2, 0x62, 0xbd, 0xed, 0xed, 0x36, 0xf7,
+	0x37, 0xf7, 0xa8, 0x7d, 0x7b, 0x47, 0x76, 0x20, 0x63, 0xfd, 0x93, 0x04, 0x6d, 0x62, 0xec, 0x99,
+	0x5f, 0x21, 0xa3, 0x18, 0x2b, 0xe2, 0xab, 0xd4, 0x8c, 0x6c, 0xa0, 0xc4, 0xbd, 0x8a, 0xf8, 0xb4,
+	0xe4, 0x72, 0xe2, 0x32, 0x99, 0x7f, 0xc3, 0xd6, 0xf2, 0xdd, 0x51, 0x64, 0xdf, 0x68, 0xcf, 0xef,
+	0x36, 0xf7, 0x1f, 0x95, 0x02, 0xbc, 0x9e, 0xa2, 0x50, 0xa0, 0x5b, 0x42, 0x0c, 0x66, 0xb3, 0xc0,
+	0x45, 0xb0, 0xcd, 0x5b, 0xc1, 0xfc, 0x29, 0x8a, 0x0b, 0x36, 0x2d, 0xe4, 0xcf, 0x59, 0xc3, 0xaa,
+	0x40, 0xe9, 0x2b, 0x65, 0x53, 0x21, 0x28, 0xca, 0xc3, 0x6a, 0x94, 0x51, 0x04, 0x7e, 0xc6, 0xa0,
+	0x18, 0x13, 0x3e, 0x7f, 0xc6, 0x9a, 0x58, 0xdb, 0x58, 0xd9, 0x6f, 0x75, 0x0a, 0xe2, 0x7e, 0x7b,
+	0xbe, 0x54, 0x92, 0x5b, 0xf5, 0x2c, 0x73, 0xf9, 0x17, 0xac, 0x45, 0x9b, 0x02, 0x3d, 0x24, 0xde,
+	0x22, 0xf1, 0x5a, 0x26, 0x7e, 0x95, 0xfb, 0xfc, 0x2a, 0x8d, 0x7f, 0xce, 0xee, 0xf5, 0x64, 0x14,
+	0x8c, 0x22, 0x09, 0xaa, 0x67, 0x62, 0xb0, 0x32, 0x80, 0x9e, 0x09, 0x95, 0xd8, 0xa6, 0x5e, 0xcf,
+	0x76, 0xa2, 0x0a, 0x23, 0x9c, 0x24, 0x91, 0x86, 0x8a, 0xea, 0x81, 0x53, 0xcd, 0x74, 0xf2, 0xcf,
+	0xd8, 0xfb, 0x39, 0x7e, 0xf5, 0x06, 0xac, 0x3c, 0x96, 0x56, 0x0e, 0x53, 0xf1, 0x90, 0x34, 0xb3,
+	0x5c, 0xfc, 0x4b, 0xd6, 0xb4, 0x0a, 0xec, 0xf8, 0xd8, 0x44, 0x3a, 0x18, 0x8b, 0x1d, 0xda, 0x23,
+	0x1b, 0x95, 0x7a, 0x16, 0x5e, 0xbf, 0x4c, 0xc5, 0x0d, 0x2d, 0x01, 0xd4, 0x30, 0x01, 0xf1, 0x88,
+	0xce, 0x4c, 0x0e, 0x79, 0x87, 0x2d, 0x1b, 0xab, 0x07, 0x3a, 0x3e, 0x75, 0x07, 0xb7, 0x4d, 0xe9,
+	0x2b, 0x36, 0x3c, 0x40, 0x89, 0xd5, 0xc6, 0xe2, 0x78, 0xf9, 0x80, 0xe4, 0x05, 0xe6, 0x1f, 0xb2,
+	0x16, 0x9d, 0x8d, 0x97, 0x4a, 0x86, 0x91, 0x8e, 0x95, 0xe8, 0xd0, 0x81, 0xa9, 0x1a, 0xf9, 0x47,
+	0x6c, 0xe5, 0x5c, 0xc7, 0x3a, 0xbd, 0x28, 0x68, 0x8f, 0x89, 0x36, 0x65, 0xed, 0xfc, 0xc8, 0x56,
+	0xa7, 0xd6, 0xc1, 0xdb, 0xac, 0x39, 0x94, 0x6f, 0x0e, 0xdc, 0xef, 0xa6, 0x34, 0xde, 0x5a, 0x7e,
+	0xd9, 0x84, 0x8b, 0xeb, 0xcb, 0xe0, 0xd2, 0x9c, 0x9f, 0xd3, 0x88, 0xab, 0xf9, 0x39, 0xc4, 0xc5,
+	0x59, 0xa5, 0x22, 0x15, 0xc0, 0xb1, 0xb9, 0x56, 0x96, 0xe6, 0x5c, 0xdd, 0xaf, 0xd8, 0x3a, 0xbf,
+	0x7b, 0x4c, 0xdc, 0xb5, 0xa3, 0xf1, 0x58, 0x9a, 0xd2, 0x46, 0xa3, 0xec, 0x6f, 0x3b, 0xd3, 0x65,
+	0x32, 0x3f, 0x62, 0xeb, 0xf9, 0x81, 0xf8, 0x3e, 0x55, 0xe1, 0xd1, 0x95, 0xb2, 0x57, 0x5a, 0x5d,
+	0xd3, 0x4f, 0x36, 0xf7, 0xb7, 0xb3, 0x20, 0xfe, 0x0c, 0x8a, 0x3f, 0x53, 0xd8, 0xf9, 0xd7, 0x63,
+	0xeb, 0xb3, 0xe8, 0x7c, 0x9b, 0x35, 0xc0, 0x80, 0x8c, 0xce, 0x86, 0x6a, 0x98, 0xd5, 0xa0, 0x4e,
+	0x86, 0xd7, 0x6a, 0x88, 0x23, 0x6b, 0x94, 0xaa, 0x90, 0x7c, 0xf3, 0xae, 0x3e, 0x88, 0xd1, 0xf5,
+	0x31, 0x5b, 0x75, 0xba, 0xc4, 0x9a, 0x40, 0xa5, 0xa9, 0xb1, 0x34, 0xf0, 0x5b, 0xfe, 0x0a, 0x99,
+	0x8f, 0x73, 0x2b, 0x7f, 0xc2, 0x56, 0x28, 0xc6, 0x84, 0xb7, 0x40, 0xbc, 0x16, 0x5a, 0x27, 0xb4,
+	0x22, 0x5e, 0x5f, 0xc6, 0xe1, 0xb5, 0x0e, 0xe1, 0x82, 0x2e, 0x82, 0x5a, 0x16, 0xef, 0x45, 0x6e,
+	0x2d, 0xe2, 0x4d, 0x78, 0x4b, 0x6e, 0xdb, 0xa0, 0xb5, 0xa0, 0x75, 0xfe, 0xc8, 0x7a, 0x33, 0x6b,
+	0x74, 0xbd, 0x5b, 0x6f, 0x36, 0xd9, 0x12, 0x4e, 0x3e, 0x1c, 0xe3, 0xd9, 0xb5, 0x88, 0xf0, 0x30,
+	0xc4, 0x52, 0x92, 0x23, 0x2e, 0xdd, 0x8b, 0x68, 0xa0, 0xbb, 0x6a, 0x9f, 0x35, 0x03, 0x13, 0x8d,
+	0x86, 0xf1, 0x59, 0x84, 0x43, 0xa5, 0x46, 0x43, 0xe5, 0xbd, 0x2c, 0x63, 0x8f, 0x3c, 0xf8, 0xab,
+	0x3e, 0x73, 0x2c, 0x1c, 0x29, 0x9d, 0x5f, 0x3d, 0xb6, 0x36, 0x3d, 0xbf, 0xb1, 0x27, 0x81, 0x49,
+	0x81, 0x7a, 0xe2, 0xb9, 0x9e, 0x20, 0xc6, 0x9e, 0x3c, 0x61, 0x2b, 0xe4, 0x9a, 0x94, 0x7a, 0xce,
+	0x95, 0x1a, 0xad, 0x95, 0x8e, 0x10, 0x6d, 0x52, 0x41, 0xd7, 0x5b, 0xa2, 0x4d, 0x0a, 0xbd, 0xc5,
+	0xea, 0xe1, 0xc8, 0xba, 0x02, 0xd5, 0xdc, 0xc6, 0xc8, 0x71, 0xe7, 0x17, 0x8f, 0x6d, 0xcc, 0x9e,
+	0xc2, 0xfc, 0x29, 0xab, 0xe7, 0x73, 0xf8, 0xff, 0xea, 0x5a, 0x10, 0x51, 0x94, 0x58, 0x73, 0xa5,
+	0x43, 0x85, 0xff, 0xfc, 0xd6, 0x61, 0x5d, 0x10, 0x3b, 0x3f, 0xb3, 0xb5, 0x69, 0x6f, 0xe5, 0x92,
+	0xf5, 0xaa, 0x97, 0x6c, 0xf9, 0xa5, 0x33, 0x77, 0xe7, 0x4b, 0x67, 0xfe, 0xce, 0x97, 0x4e, 0xad,
+	0xfa, 0xd2, 0xe9, 0xfc, 0xe6, 0xb1, 0x46, 0x71, 0x1b, 0x94, 0xde, 0x2f, 0x5e, 0xe5, 0xfd, 0xf2,
+	0x80, 0x35, 0xe8, 0x9e, 0x38, 0x1d, 0x27, 0x2a, 0x4b, 0x3b, 0x31, 0xe0, 0xfc, 0x21, 0x70, 0x00,
+	0xf9, 0xf9, 0xca, 0x20, 0xce, 0x9f, 0xec, 0x6d, 0x12, 0x83, 0x8a, 0x21, 0xcb, 0x5e, 0xb1, 0x55,
+	0x56, 0xb4, 0x50, 0x5d, 0xd1, 0x8b, 0x67, 0x7f, 0xde, 0xec, 0x78, 0x7f, 0xdd, 0xec, 0x78, 0xff,
+	0xdc, 0xec, 0x78, 0x3f, 0x7c, 0x32, 0xd0, 0x70, 0x31, 0xea, 0xef, 0x05, 0x66, 0xd8, 0xf5, 0x4d,
+	0xaa, 0x00, 0xe4, 0x57, 0x91, 0xb9, 0xee, 0xf6, 0xa4, 0xb5, 0x5a, 0xd9, 0x4f, 0xbf, 0x36, 0xdd,
+	0xe2, 0xb9, 0xd8, 0x5f, 0xa4, 0x67, 0xe2, 0xd3, 0xff, 0x06, 0x00, 0x15, 0x4c, 0xa9, 0xeb, 0x88,
+	0x0a, 0x00, 0x00,
 }
 
 func (m *TaskData) Marshal() (dAtA []byte, err error) {
@@ -879,6 +1010,57 @@ func (m *TaskData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.FinishDeadline != 0 {
+		i = encodeVarintTaskdata(dAtA, i, uint64(m.FinishDeadline))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.StartDeadline != 0 {
+		i = encodeVarintTaskdata(dAtA, i, uint64(m.StartDeadline))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.Priority != 0 {
+		i = encodeVarintTaskdata(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x88
+	}
+	if len(m.OriginTaskId) > 0 {
+		i -= len(m.OriginTaskId)
+		copy(dAtA[i:], m.OriginTaskId)
+		i = encodeVarintTaskdata(dAtA, i, uint64(len(m.OriginTaskId)))
+		i--
+		dAtA[i] = 0x2
+		i--
+		dAtA[i] = 0x82
+	}
+	if m.Attempt != 0 {
+		i = encodeVarintTaskdata(dAtA, i, uint64(m.Attempt))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf8
+	}
+	if m.RetryPolicy != nil {
+		{
+			size, err := m.RetryPolicy.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTaskdata(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf2
+	}
 	if len(m.ContractExtraParams) > 0 {
 		i -= len(m.ContractExtraParams)
 		copy(dAtA[i:], m.ContractExtraParams)
@@ -1114,6 +1296,53 @@ func (m *TaskData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TaskRetryPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskRetryPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskRetryPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.ReelectPower {
+		i--
+		if m.ReelectPower {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Backoff != 0 {
+		i = encodeVarintTaskdata(dAtA, i, uint64(m.Backoff))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxAttempts != 0 {
+		i = encodeVarintTaskdata(dAtA, i, uint64(m.MaxAttempts))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *TaskResourceSupplierData) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1627,6 +1856,47 @@ func (m *TaskData) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovTaskdata(uint64(l))
 	}
+	if m.RetryPolicy != nil {
+		l = m.RetryPolicy.Size()
+		n += 2 + l + sovTaskdata(uint64(l))
+	}
+	if m.Attempt != 0 {
+		n += 2 + sovTaskdata(uint64(m.Attempt))
+	}
+	l = len(m.OriginTaskId)
+	if l > 0 {
+		n += 2 + l + sovTaskdata(uint64(l))
+	}
+	if m.Priority != 0 {
+		n += 2 + sovTaskdata(uint64(m.Priority))
+	}
+	if m.StartDeadline != 0 {
+		n += 2 + sovTaskdata(uint64(m.StartDeadline))
+	}
+	if m.FinishDeadline != 0 {
+		n += 2 + sovTaskdata(uint64(m.FinishDeadline))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *TaskRetryPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxAttempts != 0 {
+		n += 1 + sovTaskdata(uint64(m.MaxAttempts))
+	}
+	if m.Backoff != 0 {
+		n += 1 + sovTaskdata(uint64(m.Backoff))
+	}
+	if m.ReelectPower {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -2619,6 +2889,259 @@ func (m *TaskData) Unmarshal(dAtA []byte) error {
 			}
 			m.ContractExtraParams = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 30:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryPolicy", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTaskdata
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskdata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RetryPolicy == nil {
+				m.RetryPolicy = &TaskRetryPolicy{}
+			}
+			if err := m.RetryPolicy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 31:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attempt", wireType)
+			}
+			m.Attempt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Attempt |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 32:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginTaskId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTaskdata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTaskdata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginTaskId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 33:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 34:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartDeadline", wireType)
+			}
+			m.StartDeadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartDeadline |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 35:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishDeadline", wireType)
+			}
+			m.FinishDeadline = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FinishDeadline |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTaskdata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTaskdata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskRetryPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTaskdata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskRetryPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskRetryPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAttempts", wireType)
+			}
+			m.MaxAttempts = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAttempts |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Backoff", wireType)
+			}
+			m.Backoff = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Backoff |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReelectPower", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTaskdata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReelectPower = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTaskdata(dAtA[iNdEx:])
@@ -10,6 +10,7 @@ import (
 	"github.com/RosettaFlow/Carrier-Go/common/flags"
 	"github.com/RosettaFlow/Carrier-Go/common/sliceutil"
 	"github.com/RosettaFlow/Carrier-Go/core"
+	"github.com/RosettaFlow/Carrier-Go/core/rawdb"
 	"github.com/RosettaFlow/Carrier-Go/db"
 	"github.com/RosettaFlow/Carrier-Go/event"
 	"github.com/RosettaFlow/Carrier-Go/gateway"
@@ -30,6 +31,9 @@ import (
 	"time"
 )
 
+// DatabaseDirName is the name of the database directory within the data directory.
+const DatabaseDirName = "datachain"
+
 // CarrierNode defines a struct that handles the services running a random rosetta net.
 // It handles the lifecycle of the entire system and registers
 // services to a service registry.
@@ -41,6 +45,7 @@ type CarrierNode struct {
 	services  *common.ServiceRegistry
 
 	db        core.CarrierDB
+	dataDb    db.Database
 	stateFeed *event.Feed
 	lock      sync.RWMutex
 	stop      chan struct{} // Channel to wait for termination notifications.
@@ -118,13 +123,21 @@ func New(cliCtx *cli.Context) (*CarrierNode, error) {
 }
 
 func (node *CarrierNode) startDB(cliCtx *cli.Context, config *carrier.Config) error {
-	dbPath := filepath.Join(node.config.DataDir, "datachain")
+	dbPath := filepath.Join(node.config.DataDir, DatabaseDirName)
 	log.WithField("database-path", dbPath).Info("Checking DB")
 	db, err := node.OpenDatabase(dbPath, config.DatabaseCache, config.DatabaseHandles)
 	if err != nil {
 		return err
 	}
 
+	// check the schema version of the database, the fresh database will be marked with the current version.
+	if version := rawdb.ReadDatabaseVersion(db); nil == version {
+		rawdb.WriteDatabaseVersion(db, rawdb.DatabaseVersion)
+	} else if *version != rawdb.DatabaseVersion {
+		db.Close()
+		return fmt.Errorf("the database version mismatch, database: %d, carrier: %d", *version, rawdb.DatabaseVersion)
+	}
+
 	// setting database
 	carrierDB, err := core.NewDataCenter(node.ctx, db, &params.DataCenterConfig{
 		// todo 写死的连接dataCenter的 grpc server 的ip和port
@@ -135,6 +148,7 @@ func (node *CarrierNode) startDB(cliCtx *cli.Context, config *carrier.Config) er
 		return err
 	}
 	node.db = carrierDB
+	node.dataDb = db
 	return nil
 }
 
@@ -232,6 +246,9 @@ func (b *CarrierNode) registerP2P(cliCtx *cli.Context) error {
 		MaxPeers:          cliCtx.Uint(flags.P2PMaxPeers.Name),
 		AllowListCIDR:     cliCtx.String(flags.P2PAllowList.Name),
 		DenyListCIDR:      sliceutil.SplitCommaSeparated(cliCtx.StringSlice(flags.P2PDenyList.Name)),
+		PeerAdmission:     cliCtx.Bool(flags.P2PPeerAdmission.Name),
+		PeerAllowlistFile: cliCtx.String(flags.P2PPeerAllowlistFile.Name),
+		PSKFile:           cliCtx.String(flags.P2PPSKFile.Name),
 		EnableUPnP:        cliCtx.Bool(flags.EnableUPnPFlag.Name),
 		DisableDiscv5:     cliCtx.Bool(flags.DisableDiscv5.Name),
 		StateNotifier:     b,
@@ -244,6 +261,7 @@ func (b *CarrierNode) registerP2P(cliCtx *cli.Context) error {
 
 func (b *CarrierNode) registerBackendService(carrierConfig *carrier.Config, mockIdentityIdsFile string) error {
 	carrierConfig.CarrierDB = b.db
+	carrierConfig.DataDB = b.dataDb
 	carrierConfig.P2P = b.fetchP2P()
 	backendService, err := carrier.NewService(b.ctx, carrierConfig, mockIdentityIdsFile)
 	if err != nil {
@@ -255,9 +273,10 @@ func (b *CarrierNode) registerBackendService(carrierConfig *carrier.Config, mock
 func (b *CarrierNode) registerHandlerService() error {
 	// use ` b.services.FetchService` to check whether the dependent service is registered.
 	rs := handler.NewService(b.ctx, &handler.Config{
-		P2P:           b.fetchP2P(),
-		StateNotifier: b,
-		Engines:       b.fetchBackend().Engines,
+		P2P:             b.fetchP2P(),
+		StateNotifier:   b,
+		Engines:         b.fetchBackend().Engines,
+		ConsensusEngine: b.fetchBackend().ConsensusEngine(),
 	})
 	return b.services.RegisterService(rs)
 }
@@ -268,6 +287,8 @@ func (b *CarrierNode) registerRPCService() error {
 	port := b.cliCtx.String(flags.RPCPort.Name)
 	cert := b.cliCtx.String(flags.CertFlag.Name)
 	key := b.cliCtx.String(flags.KeyFlag.Name)
+	clientCA := b.cliCtx.String(flags.ClientCAFlag.Name)
+	authConfig := b.cliCtx.String(flags.RPCAuthConfigFlag.Name)
 	enableDebugRPCEndpoints := b.cliCtx.Bool(flags.EnableDebugRPCEndpoints.Name)
 	maxMsgSize := b.cliCtx.Int(flags.GrpcMaxCallRecvMsgSizeFlag.Name)
 
@@ -278,6 +299,8 @@ func (b *CarrierNode) registerRPCService() error {
 		Port:                    port,
 		CertFlag:                cert,
 		KeyFlag:                 key,
+		ClientCAFile:            clientCA,
+		AuthConfigFile:          authConfig,
 		EnableDebugRPCEndpoints: enableDebugRPCEndpoints,
 		Broadcaster:             p2pService,
 		PeersFetcher:            p2pService,
@@ -5,6 +5,7 @@ import (
 	"github.com/RosettaFlow/Carrier-Go/carrier"
 	"github.com/RosettaFlow/Carrier-Go/common/flags"
 	"github.com/RosettaFlow/Carrier-Go/params"
+	"github.com/RosettaFlow/Carrier-Go/types"
 	"github.com/ethereum/go-ethereum/common/fdlimit"
 	"github.com/urfave/cli/v2"
 	"path/filepath"
@@ -30,6 +31,58 @@ func SetCarrierConfig(ctx *cli.Context, cfg *carrier.Config) {
 	//checkExclusive(ctx, DeveloperFlag, TestnetFlag)
 	cfg.DatabaseHandles = makeDatabaseHandles()
 
+	if ctx.IsSet(flags.SchedQueuePolicyFlag.Name) {
+		cfg.SchedQueuePolicy = ctx.String(flags.SchedQueuePolicyFlag.Name)
+	}
+	if ctx.IsSet(flags.SchedElectionPolicyFlag.Name) {
+		cfg.SchedElectionPolicy = ctx.String(flags.SchedElectionPolicyFlag.Name)
+	}
+	if ctx.IsSet(flags.SchedPlacementPolicyFlag.Name) {
+		cfg.SchedPlacementPolicy = ctx.String(flags.SchedPlacementPolicyFlag.Name)
+	}
+	if ctx.IsSet(flags.SchedHighPriorityCapFlag.Name) {
+		cfg.SchedHighPriorityCap = uint32(ctx.Uint(flags.SchedHighPriorityCapFlag.Name))
+	}
+	if ctx.IsSet(flags.SlotUnitMemFlag.Name) || ctx.IsSet(flags.SlotUnitProcessorFlag.Name) || ctx.IsSet(flags.SlotUnitBandwidthFlag.Name) {
+		cfg.SlotUnit = &types.Slot{
+			Mem:       ctx.Uint64(flags.SlotUnitMemFlag.Name),
+			Processor: ctx.Uint64(flags.SlotUnitProcessorFlag.Name),
+			Bandwidth: ctx.Uint64(flags.SlotUnitBandwidthFlag.Name),
+		}
+	}
+
+	if ctx.IsSet(flags.FighterHealthCheckIntervalFlag.Name) {
+		cfg.HealthCheck.Interval = ctx.Duration(flags.FighterHealthCheckIntervalFlag.Name)
+	}
+	if ctx.IsSet(flags.FighterHealthCheckTimeoutFlag.Name) {
+		cfg.HealthCheck.Timeout = ctx.Duration(flags.FighterHealthCheckTimeoutFlag.Name)
+	}
+	if ctx.IsSet(flags.FighterHealthFailureThresholdFlag.Name) {
+		cfg.HealthCheck.FailureThreshold = uint32(ctx.Uint(flags.FighterHealthFailureThresholdFlag.Name))
+	}
+
+	if ctx.IsSet(flags.TwopcPrepareVotingTimeoutFlag.Name) {
+		cfg.TwopcPeriod.PrepareVotingTimeout = ctx.Duration(flags.TwopcPrepareVotingTimeoutFlag.Name)
+	}
+	if ctx.IsSet(flags.TwopcConfirmVotingTimeoutFlag.Name) {
+		cfg.TwopcPeriod.ConfirmVotingTimeout = ctx.Duration(flags.TwopcConfirmVotingTimeoutFlag.Name)
+	}
+	if ctx.IsSet(flags.TwopcCommitEndingTimeoutFlag.Name) {
+		cfg.TwopcPeriod.CommitEndingTimeout = ctx.Duration(flags.TwopcCommitEndingTimeoutFlag.Name)
+	}
+	if ctx.IsSet(flags.TwopcProposalDeadlineFlag.Name) {
+		cfg.TwopcPeriod.ProposalDeadline = ctx.Duration(flags.TwopcProposalDeadlineFlag.Name)
+	}
+	if ctx.IsSet(flags.TwopcMinPeriodTimeoutFlag.Name) {
+		cfg.TwopcPeriod.MinPeriodTimeout = ctx.Duration(flags.TwopcMinPeriodTimeoutFlag.Name)
+	}
+	if ctx.IsSet(flags.TwopcMaxPeriodTimeoutFlag.Name) {
+		cfg.TwopcPeriod.MaxPeriodTimeout = ctx.Duration(flags.TwopcMaxPeriodTimeoutFlag.Name)
+	}
+	if ctx.IsSet(flags.TwopcMaxProposalDeadlineFlag.Name) {
+		cfg.TwopcPeriod.MaxProposalDeadline = ctx.Duration(flags.TwopcMaxProposalDeadlineFlag.Name)
+	}
+
 	// override any default configs.
 	switch {
 	case ctx.IsSet(flags.TestnetFlag.Name):
@@ -37,6 +90,12 @@ func SetCarrierConfig(ctx *cli.Context, cfg *carrier.Config) {
 		params.OverrideCarrierNetworkConfig(params.TestnetNetworkConfig())
 	}
 
+	// the consensus engine is selected by the network, unless it is overridden by the flag.
+	cfg.ConsensusEngine = types.ConsensusEngineType(params.CarrierChainConfig().ConsensusEngine)
+	if ctx.IsSet(flags.ConsensusEngineFlag.Name) {
+		cfg.ConsensusEngine = types.ConsensusEngineType(ctx.String(flags.ConsensusEngineFlag.Name))
+	}
+
 	// Override any default configs for hard coded networks.
 	// todo: more setting...
 }
@@ -23,6 +23,11 @@ type Config struct {
 	MaxPeers            uint
 	AllowListCIDR       string
 	DenyListCIDR        []string
+	// admit only the peers whose nodeId maps to an identity registered on the data center, or is on the allowlist file
+	PeerAdmission       bool
+	PeerAllowlistFile   string
+	// the swarm key file of the libp2p private network, empty means the public network
+	PSKFile             string
 	//TODO: need to update..
 	StateNotifier       statefeed.Notifier
 	//DB                  db.ReadOnlyDatabase
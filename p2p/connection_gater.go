@@ -60,7 +60,12 @@ func (s *Service) InterceptAccept(n network.ConnMultiaddrs) (allow bool) {
 
 // InterceptSecured tests whether a given connection,
 // now authenticated, is allowed.
-func (s *Service) InterceptSecured(_ network.Direction, _ peer.ID, _ network.ConnMultiaddrs) (allow bool) {
+func (s *Service) InterceptSecured(_ network.Direction, pid peer.ID, n network.ConnMultiaddrs) (allow bool) {
+	if !s.IsPeerAdmitted(pid) {
+		log.WithFields(logrus.Fields{"peer": n.RemoteMultiaddr(), "peerId": pid,
+			"reason": "not admitted by identity"}).Debug("Not accepting secured connection")
+		return false
+	}
 	return true
 }
 
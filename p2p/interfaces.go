@@ -28,6 +28,7 @@ type P2P interface {
 	ConnectionHandler
 	PeersProvider
 	MetadataProvider
+	PeerAdmitter
 }
 
 // Broadcaster broadcasts messages to peers over the p2p pubsub protocol.
@@ -95,3 +96,9 @@ type MetadataProvider interface {
 	Metadata() *pb.MetaData
 	MetadataSeq() uint64
 }
+
+// PeerAdmitter admits the peers by the identities registered on the data center.
+type PeerAdmitter interface {
+	SetIdentityNodeIdsFetcher(fetcher IdentityNodeIdsFetcher)
+	IsPeerAdmitted(pid peer.ID) bool
+}
@@ -39,6 +39,11 @@ func (s *Service) buildOptions(ip net.IP, priKey *ecdsa.PrivateKey) []libp2p.Opt
 
 	options = append(options, libp2p.Security(noise.ID, noise.New))
 
+	if s.psk != nil {
+		// Only the nodes with the same pre-shared key can connect to each other.
+		options = append(options, libp2p.PrivateNetwork(s.psk))
+	}
+
 	if cfg.EnableUPnP {
 		options = append(options, libp2p.NATPortMap()) // Allow to use UPnP
 	}
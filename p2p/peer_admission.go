@@ -0,0 +1,176 @@
+package p2p
+
+import (
+	"bufio"
+	"crypto/ecdsa"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	gcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/libp2p/go-libp2p-core/crypto"
+	"github.com/libp2p/go-libp2p-core/peer"
+)
+
+// The interval to refresh the nodeIds of the identities registered on the data center,
+// the cached admitted peers are dropped on every refresh so that the revoked identities take effect.
+const peerAdmissionRefreshInterval = 1 * time.Minute
+
+var ErrPeerNotSecp256k1 = errors.New("the public key of peer is not secp256k1")
+
+// IdentityNodeIdsFetcher returns the nodeIds of the identities which are registered and not revoked on the data center.
+type IdentityNodeIdsFetcher = func() ([]string, error)
+
+// peerAdmission admits the peers whose nodeId maps to an identity registered on the data center,
+// or is listed on the local static allowlist file.
+//
+// NOTE: before the first successful fetch of the identities, only the peers on the allowlist are admitted.
+type peerAdmission struct {
+	lock       sync.RWMutex
+	fetcher    IdentityNodeIdsFetcher
+	allowlist  map[string]struct{} // nodeIds
+	identities map[string]struct{} // nodeIds
+	// only the admitted peers are cached, so that the unknown peers can not grow the cache
+	admittedPeers map[peer.ID]struct{}
+}
+
+func newPeerAdmission(allowlistFile string) (*peerAdmission, error) {
+	allowlist, err := loadPeerAllowlist(allowlistFile)
+	if nil != err {
+		return nil, err
+	}
+	return &peerAdmission{
+		allowlist:     allowlist,
+		identities:    make(map[string]struct{}),
+		admittedPeers: make(map[peer.ID]struct{}),
+	}, nil
+}
+
+// loadPeerAllowlist reads the nodeIds from the file, one per line, the empty lines and the lines start with `#` are skipped.
+func loadPeerAllowlist(file string) (map[string]struct{}, error) {
+	allowlist := make(map[string]struct{})
+	if "" == file {
+		return allowlist, nil
+	}
+	f, err := os.Open(file)
+	if nil != err {
+		return nil, fmt.Errorf("could not open peer allowlist file: %v", err)
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if "" == line || strings.HasPrefix(line, "#") {
+			continue
+		}
+		allowlist[normalizeNodeId(line)] = struct{}{}
+	}
+	if err := scanner.Err(); nil != err {
+		return nil, fmt.Errorf("could not read peer allowlist file: %v", err)
+	}
+	return allowlist, nil
+}
+
+func normalizeNodeId(nodeId string) string {
+	return strings.ToLower(strings.TrimPrefix(strings.TrimPrefix(nodeId, "0x"), "0X"))
+}
+
+// peerIdToNodeId converts the peer ID to the nodeId (the hex of the uncompressed public key without the prefix byte).
+func peerIdToNodeId(pid peer.ID) (string, error) {
+	pubKey, err := pid.ExtractPublicKey()
+	if nil != err {
+		return "", err
+	}
+	publicKey, ok := pubKey.(*crypto.Secp256k1PublicKey)
+	if !ok {
+		return "", ErrPeerNotSecp256k1
+	}
+	return hex.EncodeToString(gcrypto.FromECDSAPub((*ecdsa.PublicKey)(publicKey))[1:]), nil
+}
+
+func (a *peerAdmission) setFetcher(fetcher IdentityNodeIdsFetcher) {
+	a.lock.Lock()
+	a.fetcher = fetcher
+	a.lock.Unlock()
+}
+
+// refresh fetches the nodeIds of the identities again and drops the cached admitted peers,
+// the last fetched identities are kept if the fetch failed.
+func (a *peerAdmission) refresh() error {
+	a.lock.RLock()
+	fetcher := a.fetcher
+	a.lock.RUnlock()
+	if nil == fetcher {
+		return nil
+	}
+
+	nodeIds, err := fetcher()
+	if nil != err {
+		return err
+	}
+	identities := make(map[string]struct{}, len(nodeIds))
+	for _, nodeId := range nodeIds {
+		identities[normalizeNodeId(nodeId)] = struct{}{}
+	}
+
+	a.lock.Lock()
+	a.identities = identities
+	a.admittedPeers = make(map[peer.ID]struct{})
+	a.lock.Unlock()
+	return nil
+}
+
+// admitted reports whether the peer is admitted, the admitted peer is cached until the next refresh.
+func (a *peerAdmission) admitted(pid peer.ID) bool {
+	a.lock.RLock()
+	_, ok := a.admittedPeers[pid]
+	a.lock.RUnlock()
+	if ok {
+		return true
+	}
+
+	nodeId, err := peerIdToNodeId(pid)
+	if nil != err {
+		log.WithError(err).WithField("peer", pid).Debug("Could not convert peer ID to nodeId on peer admission")
+		return false
+	}
+
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	_, inAllowlist := a.allowlist[nodeId]
+	_, inIdentities := a.identities[nodeId]
+	if !inAllowlist && !inIdentities {
+		return false
+	}
+	a.admittedPeers[pid] = struct{}{}
+	return true
+}
+
+// SetIdentityNodeIdsFetcher sets the source of the identities for the peer admission,
+// it takes no effect if the peer admission is disabled.
+func (s *Service) SetIdentityNodeIdsFetcher(fetcher IdentityNodeIdsFetcher) {
+	if nil == s.admission {
+		return
+	}
+	s.admission.setFetcher(fetcher)
+}
+
+// IsPeerAdmitted reports whether the peer is allowed to join the mesh,
+// all the peers are admitted if the peer admission is disabled.
+func (s *Service) IsPeerAdmitted(pid peer.ID) bool {
+	if nil == s.admission {
+		return true
+	}
+	return s.admission.admitted(pid)
+}
+
+func (s *Service) refreshPeerAdmission() {
+	if err := s.admission.refresh(); nil != err {
+		log.WithError(err).Error("Could not refresh the identities of peer admission, keep the last ones")
+	}
+}
@@ -0,0 +1,53 @@
+package p2p
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/stretchr/testify/require"
+	"gotest.tools/assert"
+)
+
+func TestPeerAdmission(t *testing.T) {
+	// 16Uiu2HAm4cVF9ikZ9h7UytUSPfZMZPjwn2GNdDRjfBLfUgz7N6yJ
+	nodeId := "887e6ed21139cf609995f6b6964cedefdc458de515991d2dc0b2667889148dfd5dfd011ebcf258303d5d1e0113ba093a06682146d4c5e3be5078dc5ff6e62714"
+	pubkey, err := crypto.UnmarshalPubkey(append([]byte{4}, common.Hex2Bytes(nodeId)...))
+	require.NoError(t, err)
+	pid, err := peer.IDFromPublicKey(convertToInterfacePubkey(pubkey))
+	require.NoError(t, err)
+
+	converted, err := peerIdToNodeId(pid)
+	require.NoError(t, err)
+	assert.Equal(t, converted, nodeId)
+
+	admission, err := newPeerAdmission("")
+	require.NoError(t, err)
+	// no identity has been fetched yet
+	assert.Equal(t, admission.admitted(pid), false)
+
+	identities := []string{"0x" + nodeId}
+	admission.setFetcher(func() ([]string, error) { return identities, nil })
+	// the identities take effect after the next refresh
+	assert.Equal(t, admission.admitted(pid), false)
+	// the refused peer is not cached
+	assert.Equal(t, len(admission.admittedPeers), 0)
+	require.NoError(t, admission.refresh())
+	assert.Equal(t, admission.admitted(pid), true)
+
+	// the identity was revoked, the admitted peer is cached until the next refresh
+	identities = []string{}
+	assert.Equal(t, admission.admitted(pid), true)
+	require.NoError(t, admission.refresh())
+	assert.Equal(t, admission.admitted(pid), false)
+
+	// the static allowlist admits the peer regardless of the identities
+	file := filepath.Join(t.TempDir(), "allowlist")
+	require.NoError(t, ioutil.WriteFile(file, []byte("# consortium nodes\n\n0x"+nodeId+"\n"), 0600))
+	admission, err = newPeerAdmission(file)
+	require.NoError(t, err)
+	assert.Equal(t, admission.admitted(pid), true)
+}
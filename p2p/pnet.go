@@ -0,0 +1,23 @@
+package p2p
+
+import (
+	"os"
+
+	"github.com/libp2p/go-libp2p-core/pnet"
+	"github.com/pkg/errors"
+)
+
+// loadPSK reads the pre-shared key of the private network from the swarm key file,
+// the file is in the format of `/key/swarm/psk/1.0.0/` (can be generated by `keytool genswarmkey`).
+func loadPSK(file string) (pnet.PSK, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not open the swarm key file")
+	}
+	defer f.Close()
+	psk, err := pnet.DecodeV1PSK(f)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode the swarm key file")
+	}
+	return psk, nil
+}
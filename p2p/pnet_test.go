@@ -0,0 +1,28 @@
+package p2p
+
+import (
+	"encoding/hex"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"gotest.tools/assert"
+)
+
+func TestLoadPSK(t *testing.T) {
+	key := "a9459c678ae18e74240dfb90e04f689fd81c0dcc7d3c7055574b9529ac7237d2"
+	file := filepath.Join(t.TempDir(), "swarm.key")
+	require.NoError(t, ioutil.WriteFile(file, []byte("/key/swarm/psk/1.0.0/\n/base16/\n"+key+"\n"), 0600))
+
+	psk, err := loadPSK(file)
+	require.NoError(t, err)
+	assert.Equal(t, hex.EncodeToString(psk), key)
+
+	require.NoError(t, ioutil.WriteFile(file, []byte(key), 0600))
+	_, err = loadPSK(file)
+	assert.Assert(t, err != nil)
+
+	_, err = loadPSK(filepath.Join(t.TempDir(), "missing.key"))
+	assert.Assert(t, err != nil)
+}
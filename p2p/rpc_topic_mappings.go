@@ -30,12 +30,14 @@ const (
 	RPCGossipTestDataByRangeTopic = "/rosettanet/carrier_chain/req/gossip_test_data_by_range" + schemaVersionV1
 
 	// for 2pc consensus
-	RPCTwoPcPrepareMsgTopic    = "/rosettanet/consensus/twopc/send_preparemsg" + schemaVersionV1
-	RPCTwoPcPrepareVoteTopic   = "/rosettanet/consensus/twopc/send_preparevote" + schemaVersionV1
-	RPCTwoPcConfirmMsgTopic    = "/rosettanet/consensus/twopc/send_confirmmsg" + schemaVersionV1
-	RPCTwoPcConfirmVoteTopic   = "/rosettanet/consensus/twopc/send_confirmvote" + schemaVersionV1
-	RPCTwoPcCommitMsgTopic     = "/rosettanet/consensus/twopc/send_commitmsg" + schemaVersionV1
-	RPCTwoPcTaskResultMsgTopic = "/rosettanet/consensus/twopc/send_taskresultmsg" + schemaVersionV1
+	RPCTwoPcPrepareMsgTopic      = "/rosettanet/consensus/twopc/send_preparemsg" + schemaVersionV1
+	RPCTwoPcPrepareVoteTopic     = "/rosettanet/consensus/twopc/send_preparevote" + schemaVersionV1
+	RPCTwoPcConfirmMsgTopic      = "/rosettanet/consensus/twopc/send_confirmmsg" + schemaVersionV1
+	RPCTwoPcConfirmVoteTopic     = "/rosettanet/consensus/twopc/send_confirmvote" + schemaVersionV1
+	RPCTwoPcCommitMsgTopic       = "/rosettanet/consensus/twopc/send_commitmsg" + schemaVersionV1
+	RPCTwoPcTaskResultMsgTopic   = "/rosettanet/consensus/twopc/send_taskresultmsg" + schemaVersionV1
+	RPCTwoPcTaskCancelMsgTopic   = "/rosettanet/consensus/twopc/send_taskcancelmsg" + schemaVersionV1
+	RPCTwoPcTaskProgressMsgTopic = "/rosettanet/consensus/twopc/send_taskprogressmsg" + schemaVersionV1
 )
 
 // RPCTopicMappings map the base message type to the rpc request.
@@ -52,6 +54,8 @@ var RPCTopicMappings = map[string]interface{}{
 	RPCTwoPcConfirmVoteTopic:      new(twopcpb.ConfirmVote),
 	RPCTwoPcCommitMsgTopic:        new(twopcpb.CommitMsg),
 	RPCTwoPcTaskResultMsgTopic:    new(twopcpb.TaskResultMsg),
+	RPCTwoPcTaskCancelMsgTopic:    new(twopcpb.TaskCancelMsg),
+	RPCTwoPcTaskProgressMsgTopic:  new(twopcpb.TaskProgressMsg),
 }
 
 // VerifyTopicMapping verifies that the topic and its accompanying
@@ -25,6 +25,7 @@ import (
 	"github.com/libp2p/go-libp2p-core/host"
 	"github.com/libp2p/go-libp2p-core/network"
 	"github.com/libp2p/go-libp2p-core/peer"
+	"github.com/libp2p/go-libp2p-core/pnet"
 	"github.com/libp2p/go-libp2p-core/protocol"
 	pubsub "github.com/libp2p/go-libp2p-pubsub"
 	"github.com/libp2p/go-libp2p/p2p/protocol/identify"
@@ -61,6 +62,8 @@ type Service struct {
 	peers                 *peers.Status
 	addrFilter            *multiaddr.Filters
 	ipLimiter             *leakybucket.Collector
+	admission             *peerAdmission
+	psk                   pnet.PSK
 	privKey               *ecdsa.PrivateKey
 	metaData              *pb.MetaData
 	pubsub                *pubsub.PubSub
@@ -115,6 +118,30 @@ func NewService(ctx context.Context, cfg *Config) (*Service, error) {
 		return nil, err
 	}
 	s.ipLimiter = leakybucket.NewCollector(ipLimit, ipBurst, true /* deleteEmptyBuckets */)
+	if s.cfg.PSKFile != "" {
+		s.psk, err = loadPSK(s.cfg.PSKFile)
+		if err != nil {
+			log.WithError(err).Error("Failed to load the pre-shared key of private network")
+			return nil, err
+		}
+		// The discv5 is a public udp protocol which would leak the nodes of the private network,
+		// and the relay node is out of the private network, so both of them are disabled.
+		if !s.cfg.NoDiscovery && !s.cfg.DisableDiscv5 {
+			log.Warn("Disable discv5 for the private network, only the static peers will be connected")
+		}
+		if s.cfg.RelayNodeAddr != "" {
+			log.WithField("relayNode", s.cfg.RelayNodeAddr).Warn("Ignore the relay node for the private network")
+		}
+		s.cfg.DisableDiscv5 = true
+		s.cfg.RelayNodeAddr = ""
+	}
+	if s.cfg.PeerAdmission {
+		s.admission, err = newPeerAdmission(s.cfg.PeerAllowlistFile)
+		if err != nil {
+			log.WithError(err).Error("Failed to create peer admission")
+			return nil, err
+		}
+	}
 
 	opts := s.buildOptions(ipAddr, s.privKey)
 	h, err := libp2p.New(s.ctx, opts...)
@@ -214,6 +241,10 @@ func (s *Service) Start() error {
 	}
 
 	// periodic functions
+	if s.admission != nil {
+		go s.refreshPeerAdmission()
+		runutil.RunEvery(s.ctx, peerAdmissionRefreshInterval, s.refreshPeerAdmission)
+	}
 	runutil.RunEvery(s.ctx, params.CarrierNetworkConfig().TtfbTimeout, func() {
 		ensurePeerConnections(s.ctx, s.host, peersToWatch...)
 	})
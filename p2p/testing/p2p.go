@@ -372,6 +372,14 @@ func (p *TestP2P) InterceptSecured(network.Direction, peer.ID, network.ConnMulti
 	return true
 }
 
+// SetIdentityNodeIdsFetcher .
+func (p *TestP2P) SetIdentityNodeIdsFetcher(func() ([]string, error)) {}
+
+// IsPeerAdmitted .
+func (p *TestP2P) IsPeerAdmitted(peer.ID) bool {
+	return true
+}
+
 // InterceptUpgraded .
 func (p *TestP2P) InterceptUpgraded(network.Conn) (allow bool, reason control.DisconnectReason) {
 	return true, 0
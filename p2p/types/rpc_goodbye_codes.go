@@ -18,6 +18,9 @@ const (
 	GoodbyeCodeTooManyPeers = RPCGoodbyeCode(129)
 	GoodbyeCodeBadScore     = RPCGoodbyeCode(250)
 	GoodbyeCodeBanned       = RPCGoodbyeCode(251)
+
+	// Carrier specific codes
+	GoodbyeCodeNotAdmitted = RPCGoodbyeCode(252)
 )
 
 // GoodbyeCodeMessages defines a mapping between goodbye codes and string messages.
@@ -29,6 +32,7 @@ var GoodbyeCodeMessages = map[RPCGoodbyeCode]string{
 	GoodbyeCodeTooManyPeers:          "client has too many peers",
 	GoodbyeCodeBadScore:              "peer score too low",
 	GoodbyeCodeBanned:                "client banned this node",
+	GoodbyeCodeNotAdmitted:           "node is not admitted by identity",
 }
 
 // ErrToGoodbyeCode converts given error to RPC goodbye code.
@@ -12,6 +12,8 @@ type DataChainConfig struct {
 
 	SlotsPerEpoch  types.Slot `yaml:"SLOTS_PER_EPOCH" spec:"true"`  // SlotsPerEpoch is the number of slots in an epoch.
 	SecondsPerSlot uint64     `yaml:"SECONDS_PER_SLOT" spec:"true"` // SecondsPerSlot is how many seconds are in a single slot.
+
+	ConsensusEngine string `yaml:"CONSENSUS_ENGINE"` // ConsensusEngine is the engine which the tasks are consensused by, "TwopcType" or "ChainconsType".
 }
 
 // DataCenterConfig is the datacenter service config.
@@ -38,11 +38,11 @@ var (
 var (
 	// the default config for main network.
 	mainnetCarrierConfig = &DataChainConfig{
-
+		ConsensusEngine: "TwopcType",
 	}
 
 	// the default config for test network.
 	testnetCarrierConfig = &DataChainConfig{
-
+		ConsensusEngine: "TwopcType",
 	}
 )
@@ -0,0 +1,260 @@
+package rpc
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"path"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"gopkg.in/yaml.v2"
+)
+
+// The built-in roles of the gRPC API.
+const (
+	RoleAdmin         = "admin"
+	RoleDataOperator  = "data-operator"
+	RoleTaskSubmitter = "task-submitter"
+	RoleReadOnly      = "read-only"
+	// the jobNodes and dataNodes (Fighter) which report the task events, resource expenses and uploaded files.
+	RoleNode = "node"
+)
+
+const (
+	authorizationHeader = "authorization"
+	bearerPrefix        = "bearer "
+
+	// the grpc-gateway always sets this header on the requests it forwards.
+	gatewayForwardedHeader = "x-forwarded-host"
+)
+
+var (
+	readOnlyMethods = []string{
+		"/rpcapi.*/Get*",
+		"/rpcapi.*/Query*",
+		"/rpcapi.TaskService/VerifyTaskAgreement",
+		"/rpcapi.TaskService/SubscribeTaskEvents",
+		"/carrier.rpc.v1.Debug/Get*",
+		"/carrier.rpc.v1.Debug/ListPeers",
+		"/grpc.reflection.*/*",
+	}
+
+	// defaultRolePermissions is the full method patterns (matched by path.Match, "*" matches all methods)
+	// which every built-in role is permitted to call.
+	defaultRolePermissions = map[string][]string{
+		RoleAdmin: {"*"},
+		RoleDataOperator: append([]string{
+			"/rpcapi.MetaDataService/PublishMetaData",
+			"/rpcapi.MetaDataService/RevokeMetaData",
+			"/rpcapi.PowerService/PublishPower",
+			"/rpcapi.PowerService/RevokePower",
+			"/rpcapi.YarnService/SetDataNode",
+			"/rpcapi.YarnService/UpdateDataNode",
+			"/rpcapi.YarnService/DeleteDataNode",
+			"/rpcapi.YarnService/DrainDataNode",
+			"/rpcapi.YarnService/ReportUpFileSummary",
+		}, readOnlyMethods...),
+		RoleTaskSubmitter: append([]string{
+			"/rpcapi.TaskService/PublishTaskDeclare",
+			"/rpcapi.TaskService/CancelTask",
+			"/rpcapi.TaskService/PublishWorkflowDeclare",
+			"/rpcapi.TaskService/CancelWorkflow",
+			"/rpcapi.TaskService/ExplainSchedule",
+		}, readOnlyMethods...),
+		RoleReadOnly: readOnlyMethods,
+		RoleNode: {
+			"/rpcapi.YarnService/ReportTaskEvent",
+			"/rpcapi.YarnService/ReportTaskResourceExpense",
+			"/rpcapi.YarnService/ReportUpFileSummary",
+		},
+	}
+)
+
+var (
+	ErrAuthConfigInvalid = errors.New("the rpc auth config is invalid")
+)
+
+// AuthConfig is the content of the rpc auth config file.
+//
+//	roles:                      # optional, extends or overrides the built-in roles
+//	  auditor:
+//	    - "/rpcapi.TaskService/Get*"
+//	principals:
+//	  - name: ops
+//	    token: "a-long-random-token"  # sent as `authorization: Bearer <token>`
+//	    roles: [admin]
+//	  - name: task-client
+//	    cert_cn: "task-client"         # the CommonName of mTLS client certificate
+//	    roles: [task-submitter]
+//	  - name: fighter
+//	    cert_cn: "fighter"
+//	    roles: [node]
+type AuthConfig struct {
+	Roles      map[string][]string `yaml:"roles"`
+	Principals []*AuthPrincipal    `yaml:"principals"`
+}
+
+// AuthPrincipal is a caller of the gRPC API, who is identified by an api token
+// or the CommonName of the mTLS client certificate.
+type AuthPrincipal struct {
+	Name   string   `yaml:"name"`
+	Token  string   `yaml:"token"`
+	CertCN string   `yaml:"cert_cn"`
+	Roles  []string `yaml:"roles"`
+}
+
+// LoadAuthConfig reads the rpc auth config from the yaml file.
+func LoadAuthConfig(file string) (*AuthConfig, error) {
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	cfg := new(AuthConfig)
+	if err := yaml.UnmarshalStrict(data, cfg); err != nil {
+		return nil, fmt.Errorf("%s, %s", ErrAuthConfigInvalid, err)
+	}
+	return cfg, nil
+}
+
+type authorizer struct {
+	tokens      map[[sha256.Size]byte]*AuthPrincipal
+	certs       map[string]*AuthPrincipal
+	permissions map[string][]string
+}
+
+func newAuthorizer(cfg *AuthConfig) (*authorizer, error) {
+	a := &authorizer{
+		tokens:      make(map[[sha256.Size]byte]*AuthPrincipal),
+		certs:       make(map[string]*AuthPrincipal),
+		permissions: make(map[string][]string),
+	}
+	for role, methods := range defaultRolePermissions {
+		a.permissions[role] = methods
+	}
+	for role, methods := range cfg.Roles {
+		a.permissions[role] = methods
+	}
+
+	for _, p := range cfg.Principals {
+		if "" == p.Name {
+			return nil, fmt.Errorf("%s, the name of principal is empty", ErrAuthConfigInvalid)
+		}
+		if "" == p.Token && "" == p.CertCN {
+			return nil, fmt.Errorf("%s, principal %s has neither token nor cert_cn", ErrAuthConfigInvalid, p.Name)
+		}
+		for _, role := range p.Roles {
+			if _, ok := a.permissions[role]; !ok {
+				return nil, fmt.Errorf("%s, unknown role %s of principal %s", ErrAuthConfigInvalid, role, p.Name)
+			}
+		}
+		if "" != p.Token {
+			digest := sha256.Sum256([]byte(p.Token))
+			if _, ok := a.tokens[digest]; ok {
+				return nil, fmt.Errorf("%s, duplicated token of principal %s", ErrAuthConfigInvalid, p.Name)
+			}
+			a.tokens[digest] = p
+		}
+		if "" != p.CertCN {
+			if _, ok := a.certs[p.CertCN]; ok {
+				return nil, fmt.Errorf("%s, duplicated cert_cn of principal %s", ErrAuthConfigInvalid, p.Name)
+			}
+			a.certs[p.CertCN] = p
+		}
+	}
+	return a, nil
+}
+
+// authenticate finds the principal of the caller by the bearer token first,
+// and then by the verified mTLS client certificate.
+// The requests forwarded by grpc-gateway are only authenticated by the token they carried,
+// because the client certificate of those is the gateway's, not the caller's.
+func (a *authorizer) authenticate(ctx context.Context) (*AuthPrincipal, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	if vals := md.Get(authorizationHeader); len(vals) != 0 {
+		token := vals[0]
+		if len(token) > len(bearerPrefix) && strings.EqualFold(token[:len(bearerPrefix)], bearerPrefix) {
+			token = token[len(bearerPrefix):]
+		}
+		p, ok := a.tokens[sha256.Sum256([]byte(strings.TrimSpace(token)))]
+		if !ok {
+			return nil, status.Error(codes.Unauthenticated, "invalid api token")
+		}
+		return p, nil
+	}
+
+	if len(md.Get(gatewayForwardedHeader)) == 0 {
+		if pr, ok := peer.FromContext(ctx); ok {
+			if tlsInfo, ok := pr.AuthInfo.(credentials.TLSInfo); ok && len(tlsInfo.State.VerifiedChains) != 0 &&
+				len(tlsInfo.State.VerifiedChains[0]) != 0 {
+				cn := tlsInfo.State.VerifiedChains[0][0].Subject.CommonName
+				if p, ok := a.certs[cn]; ok {
+					return p, nil
+				}
+				return nil, status.Errorf(codes.Unauthenticated, "unknown client certificate %s", cn)
+			}
+		}
+	}
+	return nil, status.Error(codes.Unauthenticated, "missing credentials")
+}
+
+func (a *authorizer) authorize(p *AuthPrincipal, fullMethod string) error {
+	for _, role := range p.Roles {
+		for _, pattern := range a.permissions[role] {
+			if pattern == "*" {
+				return nil
+			}
+			if ok, _ := path.Match(pattern, fullMethod); ok {
+				return nil
+			}
+		}
+	}
+	return status.Errorf(codes.PermissionDenied, "%s is not permitted to call %s", p.Name, fullMethod)
+}
+
+func (a *authorizer) check(ctx context.Context, fullMethod string) error {
+	p, err := a.authenticate(ctx)
+	if err != nil {
+		log.WithError(err).Warnf("Rejected unauthenticated gRPC call, method: {%s}", fullMethod)
+		return err
+	}
+	if err := a.authorize(p, fullMethod); err != nil {
+		log.WithError(err).Warnf("Rejected unauthorized gRPC call, principal: {%s}, method: {%s}", p.Name, fullMethod)
+		return err
+	}
+	return nil
+}
+
+// unaryInterceptor checks the credentials and permission of every unary call.
+func (a *authorizer) unaryInterceptor(
+	ctx context.Context,
+	req interface{},
+	info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler,
+) (interface{}, error) {
+	if err := a.check(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+// streamInterceptor checks the credentials and permission of every stream call.
+func (a *authorizer) streamInterceptor(
+	srv interface{},
+	ss grpc.ServerStream,
+	info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler,
+) error {
+	if err := a.check(ss.Context(), info.FullMethod); err != nil {
+		return err
+	}
+	return handler(srv, ss)
+}
@@ -0,0 +1,170 @@
+package rpc
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func newTestAuthorizer(t *testing.T) *authorizer {
+	a, err := newAuthorizer(&AuthConfig{
+		Roles: map[string][]string{
+			"auditor": {"/rpcapi.TaskService/Get*"},
+		},
+		Principals: []*AuthPrincipal{
+			{Name: "ops", Token: "ops-token", Roles: []string{RoleAdmin}},
+			{Name: "viewer", Token: "viewer-token", Roles: []string{RoleReadOnly}},
+			{Name: "submitter", Token: "submitter-token", Roles: []string{RoleTaskSubmitter}},
+			{Name: "auditor", Token: "auditor-token", Roles: []string{"auditor"}},
+			{Name: "fighter", CertCN: "fighter", Roles: []string{RoleNode}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return a
+}
+
+func tokenContext(token string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs(authorizationHeader, "Bearer "+token))
+}
+
+func certContext(cn string, md metadata.MD) context.Context {
+	ctx := peer.NewContext(context.Background(), &peer.Peer{
+		AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{
+			VerifiedChains: [][]*x509.Certificate{{{Subject: pkix.Name{CommonName: cn}}}},
+		}},
+	})
+	return metadata.NewIncomingContext(ctx, md)
+}
+
+func TestNewAuthorizerInvalid(t *testing.T) {
+	cases := map[string]*AuthConfig{
+		"empty name":      {Principals: []*AuthPrincipal{{Token: "t", Roles: []string{RoleAdmin}}}},
+		"no credential":   {Principals: []*AuthPrincipal{{Name: "p", Roles: []string{RoleAdmin}}}},
+		"unknown role":    {Principals: []*AuthPrincipal{{Name: "p", Token: "t", Roles: []string{"unknown"}}}},
+		"duplicate token": {Principals: []*AuthPrincipal{{Name: "p1", Token: "t"}, {Name: "p2", Token: "t"}}},
+		"duplicate cert":  {Principals: []*AuthPrincipal{{Name: "p1", CertCN: "cn"}, {Name: "p2", CertCN: "cn"}}},
+	}
+	for name, cfg := range cases {
+		if _, err := newAuthorizer(cfg); err == nil {
+			t.Errorf("%s: expect error", name)
+		}
+	}
+}
+
+func TestAuthorizerAuthenticate(t *testing.T) {
+	a := newTestAuthorizer(t)
+
+	if p, err := a.authenticate(tokenContext("viewer-token")); err != nil || p.Name != "viewer" {
+		t.Fatalf("authenticate by token failed, principal: %v, err: %v", p, err)
+	}
+	if _, err := a.authenticate(tokenContext("wrong-token")); status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("expect unauthenticated with the wrong token, got: %v", err)
+	}
+	if p, err := a.authenticate(certContext("fighter", nil)); err != nil || p.Name != "fighter" {
+		t.Fatalf("authenticate by client certificate failed, principal: %v, err: %v", p, err)
+	}
+	if _, err := a.authenticate(certContext("unknown", nil)); status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("expect unauthenticated with the unknown certificate, got: %v", err)
+	}
+	// the certificate of the requests forwarded by grpc-gateway is the gateway's
+	if _, err := a.authenticate(certContext("fighter", metadata.Pairs(gatewayForwardedHeader, "localhost"))); status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("expect unauthenticated with the certificate of gateway, got: %v", err)
+	}
+	if _, err := a.authenticate(context.Background()); status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("expect unauthenticated without credentials, got: %v", err)
+	}
+}
+
+func TestAuthorizerAuthorize(t *testing.T) {
+	a := newTestAuthorizer(t)
+
+	cases := []struct {
+		principal string
+		method    string
+		permitted bool
+	}{
+		{"ops", "/rpcapi.YarnService/SetDataNode", true},
+		{"viewer", "/rpcapi.TaskService/GetTaskDetailList", true},
+		{"viewer", "/rpcapi.YarnService/QueryAvailableDataNode", true},
+		{"viewer", "/rpcapi.TaskService/SubscribeTaskEvents", true},
+		{"viewer", "/rpcapi.TaskService/PublishTaskDeclare", false},
+		{"submitter", "/rpcapi.TaskService/PublishTaskDeclare", true},
+		{"submitter", "/rpcapi.TaskService/SubscribeTaskEvents", true},
+		{"submitter", "/rpcapi.YarnService/SetDataNode", false},
+		{"auditor", "/rpcapi.TaskService/GetTaskEventList", true},
+		{"auditor", "/rpcapi.PowerService/GetPowerTotalDetailList", false},
+		{"fighter", "/rpcapi.YarnService/ReportTaskEvent", true},
+		{"fighter", "/rpcapi.YarnService/ReportTaskResourceExpense", true},
+		{"fighter", "/rpcapi.YarnService/ReportUpFileSummary", true},
+		{"fighter", "/rpcapi.YarnService/SetJobNode", false},
+	}
+	principals := make(map[string]*AuthPrincipal)
+	for _, p := range a.tokens {
+		principals[p.Name] = p
+	}
+	for _, p := range a.certs {
+		principals[p.Name] = p
+	}
+	for _, c := range cases {
+		err := a.authorize(principals[c.principal], c.method)
+		if c.permitted && err != nil {
+			t.Errorf("%s should be permitted to call %s, err: %v", c.principal, c.method, err)
+		}
+		if !c.permitted && status.Code(err) != codes.PermissionDenied {
+			t.Errorf("%s should not be permitted to call %s, err: %v", c.principal, c.method, err)
+		}
+	}
+}
+
+type testServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *testServerStream) Context() context.Context { return s.ctx }
+
+func TestAuthorizerInterceptor(t *testing.T) {
+	a := newTestAuthorizer(t)
+
+	var called int
+	unaryHandler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		called++
+		return req, nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: "/rpcapi.TaskService/PublishTaskDeclare"}
+	if _, err := a.unaryInterceptor(tokenContext("viewer-token"), nil, info, unaryHandler); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expect permission denied, got: %v", err)
+	}
+	if _, err := a.unaryInterceptor(context.Background(), nil, info, unaryHandler); status.Code(err) != codes.Unauthenticated {
+		t.Fatalf("expect unauthenticated, got: %v", err)
+	}
+	if called != 0 {
+		t.Fatal("the handler should not be called for the rejected calls")
+	}
+	if _, err := a.unaryInterceptor(tokenContext("submitter-token"), nil, info, unaryHandler); err != nil || called != 1 {
+		t.Fatalf("the permitted call failed, called: %d, err: %v", called, err)
+	}
+
+	streamHandler := func(srv interface{}, ss grpc.ServerStream) error {
+		called++
+		return nil
+	}
+	streamInfo := &grpc.StreamServerInfo{FullMethod: "/rpcapi.TaskService/SubscribeTaskEvents", IsServerStream: true}
+	if err := a.streamInterceptor(nil, &testServerStream{ctx: certContext("fighter", nil)}, streamInfo, streamHandler); status.Code(err) != codes.PermissionDenied {
+		t.Fatalf("expect permission denied, got: %v", err)
+	}
+	if err := a.streamInterceptor(nil, &testServerStream{ctx: tokenContext("viewer-token")}, streamInfo, streamHandler); err != nil || called != 2 {
+		t.Fatalf("the permitted stream call failed, called: %d, err: %v", called, err)
+	}
+}
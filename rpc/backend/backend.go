@@ -1,6 +1,7 @@
 package backend
 
 import (
+	"github.com/RosettaFlow/Carrier-Go/event"
 	"github.com/RosettaFlow/Carrier-Go/types"
 )
 
@@ -10,6 +11,7 @@ type Backend interface {
 	// system (the yarn node self info)
 	GetNodeInfo() (*types.YarnNodeInfo, error)
 	GetRegisteredPeers() (*types.YarnRegisteredNodeDetail, error)
+	BackupDatabase(file string) (int, error)
 
 	// local node resource api
 	SetSeedNode(seed *types.SeedNodeInfo) (types.NodeConnStatus, error)
@@ -19,10 +21,13 @@ type Backend interface {
 	SetRegisterNode(typ types.RegisteredNodeType, node *types.RegisteredNodeInfo) (types.NodeConnStatus, error)
 	UpdateRegisterNode(typ types.RegisteredNodeType, node *types.RegisteredNodeInfo) (types.NodeConnStatus, error)
 	DeleteRegisterNode(typ types.RegisteredNodeType, id string) error
+	DrainRegisterNode(typ types.RegisteredNodeType, id string, drained bool) error
+	IsDataNodeAvailable(id string) bool
 	GetRegisterNode(typ types.RegisteredNodeType, id string) (*types.RegisteredNodeInfo, error)
 	GetRegisterNodeList(typ types.RegisteredNodeType) ([]*types.RegisteredNodeInfo, error)
 
 	SendTaskEvent(event *types.TaskEventInfo) error
+	ReportTaskResourceExpense(usage *types.TaskResourceUsage) error
 
 	// metadata api
 	GetMetaDataDetail(identityId, metaDataId string) (*types.OrgMetaDataInfo, error)
@@ -32,6 +37,10 @@ type Backend interface {
 	// power api
 	GetPowerTotalDetailList() ([]*types.OrgPowerDetail, error)
 	GetPowerSingleDetailList() ([]*types.NodePowerDetail, error)
+	GetOrgQuotaList() ([]*types.OrgQuota, error)
+	GetOrgQuotaUsage(identityId string) (*types.OrgQuotaUsage, error)
+	SetOrgQuota(quota *types.OrgQuota) error
+	RemoveOrgQuota(identityId string) error
 
 	// identity api
 	GetNodeIdentity() (*types.Identity, error)
@@ -41,6 +50,19 @@ type Backend interface {
 	GetTaskDetailList() ([]*types.TaskDetailShow, error)
 	GetTaskEventList(taskId string) ([]*types.TaskEvent, error)
 	GetTaskEventListByTaskIds(taskIds []string) ([]*types.TaskEvent, error)
+	GetLocalTaskEventList(taskIds []string) ([]*types.TaskEventInfo, error)
+	SubscribeTaskEvents(ch chan<- *types.TaskEventInfo) event.Subscription
+	GetTaskResourceUsage(taskId string) (*types.TaskOperationCost, []*types.TaskResourceUsage, error)
+	GetTaskProgress(taskId string) ([]*types.TaskProgress, error)
+	CancelTask(taskId string) error
+	ExplainSchedule(task *types.TaskMsg) (*types.ScheduleExplain, error)
+	VerifyTaskAgreement(taskId string) (*types.TaskAgreement, error)
+
+	// workflow api
+	PublishWorkflow(workflow *types.Workflow) (string, error)
+	GetWorkflow(workflowId string) (*types.Workflow, error)
+	GetWorkflowList() ([]*types.Workflow, error)
+	CancelWorkflow(workflowId string) error
 
 	// about DataResourceTable
 	//StoreDataResourceTable(dataResourceTable *types.DataResourceTable) error
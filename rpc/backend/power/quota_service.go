@@ -0,0 +1,83 @@
+package power
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/RosettaFlow/Carrier-Go/lib/api"
+	"github.com/RosettaFlow/Carrier-Go/rpc/backend"
+	"github.com/RosettaFlow/Carrier-Go/types"
+)
+
+func (svr *PowerServiceServer) GetOrgQuotaList(ctx context.Context, req *pb.EmptyGetParams) (*pb.GetOrgQuotaListResponse, error) {
+	quotaList, err := svr.B.GetOrgQuotaList()
+	if nil != err {
+		log.WithError(err).Error("RPC-API:GetOrgQuotaList failed")
+		return nil, ErrGetOrgQuotaList
+	}
+	arr := make([]*pb.OrgQuotaShow, len(quotaList))
+	for i, quota := range quotaList {
+		usage, err := svr.B.GetOrgQuotaUsage(quota.IdentityId)
+		if nil != err {
+			log.WithError(err).Errorf("RPC-API:GetOrgQuotaList failed, query the quota usage failed, identityId: {%s}", quota.IdentityId)
+			return nil, ErrGetOrgQuotaList
+		}
+		arr[i] = &pb.OrgQuotaShow{
+			Quota: &pb.OrgQuota{
+				IdentityId:         quota.IdentityId,
+				MaxConcurrentTasks: quota.MaxConcurrentTasks,
+				MaxSlots:           quota.MaxSlots,
+				MaxDailySlotHours:  quota.MaxDailySlotHours,
+			},
+			Usage: &pb.OrgQuotaUsage{
+				RunningTasks:  usage.RunningTasks,
+				UsedSlots:     usage.UsedSlots,
+				DailySlotMsec: usage.DailySlotMsec,
+			},
+		}
+	}
+	log.Debugf("RPC-API:GetOrgQuotaList succeed, quotaList len: {%d}", len(arr))
+	return &pb.GetOrgQuotaListResponse{
+		Status:    0,
+		Msg:       backend.OK,
+		QuotaList: arr,
+	}, nil
+}
+
+func (svr *PowerServiceServer) SetOrgQuota(ctx context.Context, req *pb.SetOrgQuotaRequest) (*pb.SimpleResponseCode, error) {
+	if nil == req.Quota || "" == req.Quota.IdentityId {
+		return nil, errors.New("required identityId of quota")
+	}
+
+	quota := &types.OrgQuota{
+		IdentityId:         req.Quota.IdentityId,
+		MaxConcurrentTasks: req.Quota.MaxConcurrentTasks,
+		MaxSlots:           req.Quota.MaxSlots,
+		MaxDailySlotHours:  req.Quota.MaxDailySlotHours,
+	}
+	if err := svr.B.SetOrgQuota(quota); nil != err {
+		log.WithError(err).Errorf("RPC-API:SetOrgQuota failed, quota: %s", quota.String())
+		return nil, ErrSetOrgQuota
+	}
+	log.Debugf("RPC-API:SetOrgQuota succeed, quota: %s", quota.String())
+	return &pb.SimpleResponseCode{
+		Status: 0,
+		Msg:    backend.OK,
+	}, nil
+}
+
+func (svr *PowerServiceServer) RemoveOrgQuota(ctx context.Context, req *pb.RemoveOrgQuotaRequest) (*pb.SimpleResponseCode, error) {
+	if "" == req.IdentityId {
+		return nil, errors.New("required identityId")
+	}
+
+	if err := svr.B.RemoveOrgQuota(req.IdentityId); nil != err {
+		log.WithError(err).Errorf("RPC-API:RemoveOrgQuota failed, identityId: {%s}", req.IdentityId)
+		return nil, ErrRemoveOrgQuota
+	}
+	log.Debugf("RPC-API:RemoveOrgQuota succeed, identityId: {%s}", req.IdentityId)
+	return &pb.SimpleResponseCode{
+		Status: 0,
+		Msg:    backend.OK,
+	}, nil
+}
@@ -7,6 +7,9 @@ var (
 	ErrGetTotalPowerList  = &backend.RpcBizErr{Msg: "Failed to get total power list"}
 	ErrGetSinglePowerList = &backend.RpcBizErr{Msg: "Failed to get current node power list"}
 	ErrSendPowerMsg       = &backend.RpcBizErr{Msg: "Failed to send powerMsg"}
+	ErrGetOrgQuotaList    = &backend.RpcBizErr{Msg: "Failed to get org quota list"}
+	ErrSetOrgQuota        = &backend.RpcBizErr{Msg: "Failed to set org quota"}
+	ErrRemoveOrgQuota     = &backend.RpcBizErr{Msg: "Failed to remove org quota"}
 )
 
 type PowerServiceServer struct {
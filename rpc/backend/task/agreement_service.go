@@ -0,0 +1,39 @@
+package task
+
+import (
+	"context"
+	"errors"
+
+	pb "github.com/RosettaFlow/Carrier-Go/lib/api"
+	"github.com/RosettaFlow/Carrier-Go/rpc/backend"
+)
+
+func (svr *TaskServiceServer) VerifyTaskAgreement(ctx context.Context, req *pb.VerifyTaskAgreementRequest) (*pb.VerifyTaskAgreementResponse, error) {
+	if "" == req.TaskId {
+		return nil, errors.New("required taskId")
+	}
+
+	agreement, err := svr.B.VerifyTaskAgreement(req.TaskId)
+	if nil != err {
+		log.WithError(err).Errorf("RPC-API:VerifyTaskAgreement failed, taskId: {%s}", req.TaskId)
+		return nil, ErrVerifyTaskAgreement
+	}
+	blockHashes := make([]string, len(agreement.Blocks))
+	for i, block := range agreement.Blocks {
+		blockHashes[i] = block.Hash().String()
+	}
+	var proposalId string
+	if agreement.Agreed {
+		proposalId = agreement.ProposalId.String()
+	}
+	log.Debugf("RPC-API:VerifyTaskAgreement succeed, taskId: {%s}, agreed: {%v}, proposalId: {%s}, block count: {%d}",
+		req.TaskId, agreement.Agreed, proposalId, len(blockHashes))
+	return &pb.VerifyTaskAgreementResponse{
+		Status:      0,
+		Msg:         backend.OK,
+		TaskId:      agreement.TaskId,
+		Agreed:      agreement.Agreed,
+		ProposalId:  proposalId,
+		BlockHashes: blockHashes,
+	}, nil
+}
@@ -65,6 +65,32 @@ func (svr *TaskServiceServer) GetTaskEventListByTaskIds (ctx context.Context, re
 }
 
 func (svr *TaskServiceServer) PublishTaskDeclare(ctx context.Context, req *pb.PublishTaskDeclareRequest) (*pb.PublishTaskDeclareResponse, error) {
+	taskMsg, err := svr.newTaskMsgFromDeclare(req)
+	if nil != err {
+		return nil, err
+	}
+
+	// add taskId
+	taskId := taskMsg.SetTaskId()
+	taskMsg.Data.TaskData().TaskId = taskId
+
+	err = svr.B.SendMsg(taskMsg)
+	if nil != err {
+		log.WithError(err).Errorf("RPC-API:PublishTaskDeclare failed, query metadata of partner failed, taskId: {%s}",
+			taskId)
+		return nil, ErrSendTaskMsg
+	}
+	//log.Debugf("RPC-API:PublishTaskDeclare succeed, taskId: {%s}, taskMsg: %s", taskId, taskMsg.String())
+	log.Debugf("RPC-API:PublishTaskDeclare succeed, taskId: {%s}", taskId)
+	return &pb.PublishTaskDeclareResponse{
+		Status: 0,
+		Msg:    backend.OK,
+		TaskId: taskId,
+	}, nil
+}
+
+// newTaskMsgFromDeclare validates the task declaration and makes the taskMsg (without taskId) of it.
+func (svr *TaskServiceServer) newTaskMsgFromDeclare(req *pb.PublishTaskDeclareRequest) (*types.TaskMsg, error) {
 	if req == nil || req.Owner == nil {
 		return nil, errors.New("required owner")
 	}
@@ -80,6 +106,18 @@ func (svr *TaskServiceServer) PublishTaskDeclare(ctx context.Context, req *pb.Pu
 	if "" == req.CalculateContractcode {
 		return nil, errors.New("required CalculateContractCode")
 	}
+	if nil != req.RetryPolicy && req.RetryPolicy.MaxAttempts > types.MaxTaskRetryAttempts {
+		return nil, fmt.Errorf("the maxAttempts of retryPolicy can not be greater than %d", types.MaxTaskRetryAttempts)
+	}
+	if nil != req.RetryPolicy && req.RetryPolicy.Backoff > types.MaxTaskRetryBackoff {
+		return nil, fmt.Errorf("the backoff of retryPolicy can not be greater than %d ms", types.MaxTaskRetryBackoff)
+	}
+	if types.TaskPriority(req.Priority) > types.MaxTaskPriority {
+		return nil, fmt.Errorf("the priority of task can not be greater than %d", types.MaxTaskPriority)
+	}
+	if 0 != req.FinishDeadline && req.StartDeadline > req.FinishDeadline {
+		return nil, errors.New("the startDeadline of task can not be later than the finishDeadline")
+	}
 
 	_, err := svr.B.GetNodeIdentity()
 	if nil != err {
@@ -169,25 +207,95 @@ func (svr *TaskServiceServer) PublishTaskDeclare(ctx context.Context, req *pb.Pu
 	// add empty powerSuppliers
 	taskMsg.Data.TaskData().ResourceSupplier = make([]*libTypes.TaskResourceSupplierData, 0)
 
-	// add taskId
-	taskId := taskMsg.SetTaskId()
-	taskMsg.Data.TaskData().TaskId = taskId
+	return taskMsg, nil
+}
 
-	err = svr.B.SendMsg(taskMsg)
-	if nil != err {
-		log.WithError(err).Errorf("RPC-API:PublishTaskDeclare failed, query metadata of partner failed, taskId: {%s}",
-			taskId)
-		return nil, ErrSendTaskMsg
+func (svr *TaskServiceServer) CancelTask(ctx context.Context, req *pb.CancelTaskRequest) (*pb.SimpleResponseCode, error) {
+	if "" == req.TaskId {
+		return nil, errors.New("required taskId")
 	}
-	//log.Debugf("RPC-API:PublishTaskDeclare succeed, taskId: {%s}, taskMsg: %s", taskId, taskMsg.String())
-	log.Debugf("RPC-API:PublishTaskDeclare succeed, taskId: {%s}", taskId)
-	return &pb.PublishTaskDeclareResponse{
+
+	if err := svr.B.CancelTask(req.TaskId); nil != err {
+		log.WithError(err).Errorf("RPC-API:CancelTask failed, taskId: {%s}", req.TaskId)
+		return nil, ErrCancelTask
+	}
+	log.Debugf("RPC-API:CancelTask succeed, taskId: {%s}", req.TaskId)
+	return &pb.SimpleResponseCode{
 		Status: 0,
 		Msg:    backend.OK,
-		TaskId: taskId,
 	}, nil
 }
 
+func (svr *TaskServiceServer) GetTaskResourceUsage(ctx context.Context, req *pb.GetTaskResourceUsageRequest) (*pb.GetTaskResourceUsageResponse, error) {
+	if "" == req.TaskId {
+		return nil, errors.New("required taskId")
+	}
+
+	declared, usageList, err := svr.B.GetTaskResourceUsage(req.TaskId)
+	if nil != err {
+		log.WithError(err).Errorf("RPC-API:GetTaskResourceUsage failed, taskId: {%s}", req.TaskId)
+		return nil, ErrGetTaskResourceUsage
+	}
+
+	arr := make([]*pb.TaskResourceUsageShow, len(usageList))
+	for i, usage := range usageList {
+		arr[i] = &pb.TaskResourceUsageShow{
+			PartyId:       usage.PartyId,
+			IdentityId:    usage.IdentityId,
+			JobNodeId:     usage.JobNodeId,
+			UsedMem:       usage.UsedMem,
+			UsedProcessor: usage.UsedProcessor,
+			UsedBandwidth: usage.UsedBandwidth,
+			UsedDuration:  usage.UsedDuration,
+			UpdateAt:      usage.UpdateAt,
+			ExceededItems: usage.ExceededItems(declared),
+		}
+	}
+	resp := &pb.GetTaskResourceUsageResponse{
+		Status:    0,
+		Msg:       backend.OK,
+		TaskId:    req.TaskId,
+		UsageList: arr,
+	}
+	if nil != declared {
+		resp.Declared = types.ConvertTaskOperationCostToPB(declared)
+	}
+	log.Debugf("RPC-API:GetTaskResourceUsage succeed, taskId: {%s}, usage count: {%d}", req.TaskId, len(arr))
+	return resp, nil
+}
+
+func (svr *TaskServiceServer) GetTaskProgress(ctx context.Context, req *pb.GetTaskProgressRequest) (*pb.GetTaskProgressResponse, error) {
+	if "" == req.TaskId {
+		return nil, errors.New("required taskId")
+	}
+
+	progressList, err := svr.B.GetTaskProgress(req.TaskId)
+	if nil != err {
+		log.WithError(err).Errorf("RPC-API:GetTaskProgress failed, taskId: {%s}", req.TaskId)
+		return nil, ErrGetTaskProgress
+	}
+
+	arr := make([]*pb.TaskProgressShow, len(progressList))
+	for i, progress := range progressList {
+		arr[i] = &pb.TaskProgressShow{
+			PartyId:     progress.PartyId,
+			IdentityId:  progress.IdentityId,
+			JobNodeId:   progress.JobNodeId,
+			Phase:       progress.Phase,
+			Percent:     progress.Percent,
+			ElapsedTime: progress.ElapsedTime,
+			RemainTime:  progress.RemainTime,
+			UpdateAt:    progress.UpdateAt,
+		}
+	}
+	log.Debugf("RPC-API:GetTaskProgress succeed, taskId: {%s}, progress count: {%d}", req.TaskId, len(arr))
+	return &pb.GetTaskProgressResponse{
+		Status:       0,
+		Msg:          backend.OK,
+		TaskId:       req.TaskId,
+		ProgressList: arr,
+	}, nil
+}
 
 func utilTaskDetailResponseArrString(tasks []*pb.GetTaskDetailResponse) string {
 	arr := make([]string, len(tasks))
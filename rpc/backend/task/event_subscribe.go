@@ -0,0 +1,284 @@
+package task
+
+import (
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	pb "github.com/RosettaFlow/Carrier-Go/lib/api"
+	"github.com/RosettaFlow/Carrier-Go/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// the buffer size of the task events waiting to be sent to a subscriber,
+	// the subscriber is dropped by the feed once the buffer is full.
+	taskEventSubscribeBufferSize = 512
+
+	// the replayed events created within this window before subscribing may be received
+	// from the subscription again, which are skipped by the subscriber.
+	taskEventReplayDedupWindow = time.Minute
+)
+
+type taskEventFilter struct {
+	taskIds      map[string]struct{}
+	identityIds  map[string]struct{}
+	typePrefixes []string
+}
+
+func newTaskEventFilter(req *pb.SubscribeTaskEventsRequest) *taskEventFilter {
+	toSet := func(arr []string) map[string]struct{} {
+		set := make(map[string]struct{}, len(arr))
+		for _, v := range arr {
+			if "" != v {
+				set[v] = struct{}{}
+			}
+		}
+		return set
+	}
+	prefixes := make([]string, 0, len(req.TypePrefixes))
+	for _, prefix := range req.TypePrefixes {
+		if "" != prefix {
+			prefixes = append(prefixes, prefix)
+		}
+	}
+	return &taskEventFilter{
+		taskIds:      toSet(req.TaskIds),
+		identityIds:  toSet(req.IdentityIds),
+		typePrefixes: prefixes,
+	}
+}
+
+func (f *taskEventFilter) match(event *types.TaskEventInfo) bool {
+	if len(f.taskIds) != 0 {
+		if _, ok := f.taskIds[event.TaskId]; !ok {
+			return false
+		}
+	}
+	if len(f.identityIds) != 0 {
+		if _, ok := f.identityIds[event.Identity]; !ok {
+			return false
+		}
+	}
+	if len(f.typePrefixes) != 0 {
+		for _, prefix := range f.typePrefixes {
+			if strings.HasPrefix(event.Type, prefix) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+func taskEventKey(event *types.TaskEventInfo) string {
+	return fmt.Sprintf("%s|%s|%s|%d|%s", event.TaskId, event.Type, event.Identity, event.CreateTime, event.Content)
+}
+
+// taskEventCursor is the position of a subscriber in the task events, which is the latest create time
+// of the delivered events and the keys of the delivered events created at that millisecond,
+// so that the events sharing the millisecond with the last delivered one are neither resent nor lost on resuming.
+//
+// It's encoded as `<createAt>[:<key>,<key>...]`.
+type taskEventCursor struct {
+	createAt uint64
+	keys     map[string]struct{}
+}
+
+func parseTaskEventCursor(cursor string) (*taskEventCursor, error) {
+	createAt, keys := cursor, ""
+	if idx := strings.Index(cursor, ":"); idx >= 0 {
+		createAt, keys = cursor[:idx], cursor[idx+1:]
+	}
+	v, err := strconv.ParseUint(createAt, 10, 64)
+	if nil != err {
+		return nil, fmt.Errorf("invalid cursor: %s", cursor)
+	}
+	c := &taskEventCursor{createAt: v, keys: make(map[string]struct{})}
+	for _, key := range strings.Split(keys, ",") {
+		if "" != key {
+			c.keys[key] = struct{}{}
+		}
+	}
+	return c, nil
+}
+
+// cursorKey is the short key of event in the cursor.
+func cursorKey(event *types.TaskEventInfo) string {
+	h := fnv.New64a()
+	h.Write([]byte(taskEventKey(event)))
+	return fmt.Sprintf("%016x", h.Sum64())
+}
+
+// delivered returns whether the event is at or before the cursor.
+func (c *taskEventCursor) delivered(event *types.TaskEventInfo) bool {
+	if event.CreateTime != c.createAt {
+		return event.CreateTime < c.createAt
+	}
+	_, ok := c.keys[cursorKey(event)]
+	return ok
+}
+
+// advance moves the cursor after the delivered event, the event created before the cursor is already covered by it.
+func (c *taskEventCursor) advance(event *types.TaskEventInfo) {
+	switch {
+	case event.CreateTime > c.createAt:
+		c.createAt = event.CreateTime
+		c.keys = map[string]struct{}{cursorKey(event): {}}
+	case event.CreateTime == c.createAt:
+		c.keys[cursorKey(event)] = struct{}{}
+	}
+}
+
+func (c *taskEventCursor) String() string {
+	if len(c.keys) == 0 {
+		return strconv.FormatUint(c.createAt, 10)
+	}
+	keys := make([]string, 0, len(c.keys))
+	for key := range c.keys {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return fmt.Sprintf("%d:%s", c.createAt, strings.Join(keys, ","))
+}
+
+func (svr *TaskServiceServer) SubscribeTaskEvents(req *pb.SubscribeTaskEventsRequest, stream pb.TaskService_SubscribeTaskEventsServer) error {
+
+	filter := newTaskEventFilter(req)
+
+	// the events at or before the cursor have been delivered, they are not replayed.
+	var resumeFrom *taskEventCursor
+	if "" != req.Cursor {
+		cursor, err := parseTaskEventCursor(req.Cursor)
+		if nil != err {
+			return status.Error(codes.InvalidArgument, err.Error())
+		}
+		resumeFrom = cursor
+	} else if req.Since != 0 {
+		// the events created at `since` are replayed neither
+		resumeFrom = &taskEventCursor{createAt: req.Since + 1, keys: make(map[string]struct{})}
+	}
+
+	// subscribe first, so that no event is missed between the replaying and the subscription.
+	eventCh := make(chan *types.TaskEventInfo, taskEventSubscribeBufferSize)
+	sub := svr.B.SubscribeTaskEvents(eventCh)
+	defer sub.Unsubscribe()
+	subscribeAt := uint64(time.Now().Add(-taskEventReplayDedupWindow).UnixNano() / int64(time.Millisecond))
+
+	// tell the caller (e.g. the Server-Sent Events of grpc-gateway) that the subscription is accepted.
+	if err := stream.SendHeader(metadata.MD{}); nil != err {
+		return err
+	}
+
+	// the owner of event is filled with the local identity if it is produced by local.
+	var local *types.Identity
+	if identity, err := svr.B.GetNodeIdentity(); nil == err {
+		local = identity
+	}
+	cursor := &taskEventCursor{keys: make(map[string]struct{})}
+	if nil != resumeFrom {
+		cursor.createAt = resumeFrom.createAt
+		for key := range resumeFrom.keys {
+			cursor.keys[key] = struct{}{}
+		}
+	}
+	send := func(event *types.TaskEventInfo) error {
+		owner := &pb.OrganizationIdentityInfo{IdentityId: event.Identity}
+		if nil != local && local.IdentityId() == event.Identity {
+			owner.Name = local.Name()
+			owner.NodeId = local.NodeId()
+		}
+		cursor.advance(event)
+		return stream.Send(&pb.TaskEventShow{
+			Type:     event.Type,
+			TaskId:   event.TaskId,
+			Owner:    owner,
+			Content:  event.Content,
+			CreateAt: event.CreateTime,
+			Cursor:   cursor.String(),
+		})
+	}
+
+	// the live events received while replaying, they are sent after the replaying,
+	// so that the subscriber is not dropped as a slow one during a long replaying.
+	pending := make([]*types.TaskEventInfo, 0)
+	drain := func() {
+		for {
+			select {
+			case event := <-eventCh:
+				pending = append(pending, event)
+			default:
+				return
+			}
+		}
+	}
+
+	replayed := make(map[string]struct{})
+	if nil != resumeFrom {
+		history, err := svr.B.GetLocalTaskEventList(req.TaskIds)
+		if nil != err {
+			log.WithError(err).Errorf("RPC-API:SubscribeTaskEvents failed to query history events, taskIds: {%v}", req.TaskIds)
+			return ErrGetNodeTaskEventList
+		}
+		sort.SliceStable(history, func(i, j int) bool { return history[i].CreateTime < history[j].CreateTime })
+		for _, event := range history {
+			drain()
+			if resumeFrom.delivered(event) || !filter.match(event) {
+				continue
+			}
+			if err := send(event); nil != err {
+				return err
+			}
+			if event.CreateTime >= subscribeAt {
+				replayed[taskEventKey(event)] = struct{}{}
+			}
+		}
+		drain()
+	}
+
+	log.Debugf("RPC-API:SubscribeTaskEvents start, taskIds: {%v}, typePrefixes: {%v}, identityIds: {%v}, since: {%d}, cursor: {%s}",
+		req.TaskIds, req.TypePrefixes, req.IdentityIds, req.Since, req.Cursor)
+
+	handle := func(event *types.TaskEventInfo) error {
+		if !filter.match(event) {
+			return nil
+		}
+		if len(replayed) != 0 {
+			key := taskEventKey(event)
+			if _, ok := replayed[key]; ok {
+				delete(replayed, key)
+				return nil
+			}
+		}
+		return send(event)
+	}
+	for _, event := range pending {
+		if err := handle(event); nil != err {
+			return err
+		}
+	}
+	pending = nil
+
+	for {
+		select {
+		case event := <-eventCh:
+			if err := handle(event); nil != err {
+				return err
+			}
+		case err := <-sub.Err():
+			if err == types.ErrTaskEventSubscriberSlow {
+				log.Warnf("RPC-API:SubscribeTaskEvents drop the slow subscriber, taskIds: {%v}", req.TaskIds)
+				return status.Error(codes.ResourceExhausted, err.Error())
+			}
+			return err
+		case <-stream.Context().Done():
+			log.Debugf("RPC-API:SubscribeTaskEvents end, taskIds: {%v}", req.TaskIds)
+			return nil
+		}
+	}
+}
@@ -0,0 +1,50 @@
+package task
+
+import (
+	"context"
+
+	pb "github.com/RosettaFlow/Carrier-Go/lib/api"
+	"github.com/RosettaFlow/Carrier-Go/rpc/backend"
+	"github.com/RosettaFlow/Carrier-Go/types"
+)
+
+func (svr *TaskServiceServer) ExplainSchedule(ctx context.Context, req *pb.PublishTaskDeclareRequest) (*pb.ExplainScheduleResponse, error) {
+	taskMsg, err := svr.newTaskMsgFromDeclare(req)
+	if nil != err {
+		return nil, err
+	}
+	// the taskId is only used to make the seed of election, the task is never queued
+	taskId := taskMsg.SetTaskId()
+	taskMsg.Data.TaskData().TaskId = taskId
+
+	explain, err := svr.B.ExplainSchedule(taskMsg)
+	if nil != err {
+		log.WithError(err).Errorf("RPC-API:ExplainSchedule failed, taskName: {%s}", req.TaskName)
+		return nil, ErrExplainSchedule
+	}
+	log.Debugf("RPC-API:ExplainSchedule succeed, taskName: {%s}, electedOrgs: %v, placedJobNode: {%s}",
+		req.TaskName, explain.ElectedOrgs, explain.PlacedJobNode)
+	return &pb.ExplainScheduleResponse{
+		Status:         0,
+		Msg:            backend.OK,
+		OrgCandidates:  convertScheduleCandidateShowArr(explain.OrgCandidates),
+		ElectedOrgs:    explain.ElectedOrgs,
+		OrgErr:         explain.OrgErr,
+		NodeCandidates: convertScheduleCandidateShowArr(explain.NodeCandidates),
+		PlacedJobNode:  explain.PlacedJobNode,
+		NeedSlotCount:  explain.NeedSlotCount,
+		NodeErr:        explain.NodeErr,
+	}, nil
+}
+
+func convertScheduleCandidateShowArr(candidates []*types.ScheduleCandidate) []*pb.ScheduleCandidateShow {
+	arr := make([]*pb.ScheduleCandidateShow, len(candidates))
+	for i, c := range candidates {
+		arr[i] = &pb.ScheduleCandidateShow{
+			Id:       c.Id,
+			Accepted: c.Accepted,
+			Reason:   c.Reason,
+		}
+	}
+	return arr
+}
@@ -6,6 +6,15 @@ var (
 	ErrGetNodeTaskList      = &backend.RpcBizErr{Msg: "Failed to get all task of current node"}
 	ErrGetNodeTaskEventList = &backend.RpcBizErr{Msg: "Failed to get all event of current node's task"}
 	ErrSendTaskMsg          = &backend.RpcBizErr{Msg: "Failed to send taskMsg"}
+	ErrCancelTask           = &backend.RpcBizErr{Msg: "Failed to cancel task"}
+	ErrGetTaskResourceUsage = &backend.RpcBizErr{Msg: "Failed to get the resource usage of task"}
+	ErrGetTaskProgress      = &backend.RpcBizErr{Msg: "Failed to get the progress of task"}
+	ErrPublishWorkflow      = &backend.RpcBizErr{Msg: "Failed to publish workflow"}
+	ErrGetWorkflowDetail    = &backend.RpcBizErr{Msg: "Failed to get the detail of workflow"}
+	ErrGetWorkflowList      = &backend.RpcBizErr{Msg: "Failed to get the workflow list"}
+	ErrCancelWorkflow       = &backend.RpcBizErr{Msg: "Failed to cancel workflow"}
+	ErrExplainSchedule      = &backend.RpcBizErr{Msg: "Failed to explain the schedule of task"}
+	ErrVerifyTaskAgreement  = &backend.RpcBizErr{Msg: "Failed to verify the agreement of task"}
 )
 
 type TaskServiceServer struct {
@@ -0,0 +1,159 @@
+package task
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	pb "github.com/RosettaFlow/Carrier-Go/lib/api"
+	libTypes "github.com/RosettaFlow/Carrier-Go/lib/types"
+	"github.com/RosettaFlow/Carrier-Go/rpc/backend"
+	"github.com/RosettaFlow/Carrier-Go/types"
+)
+
+func (svr *TaskServiceServer) PublishWorkflowDeclare(ctx context.Context, req *pb.PublishWorkflowDeclareRequest) (*pb.PublishWorkflowDeclareResponse, error) {
+	if "" == req.WorkflowName {
+		return nil, errors.New("required workflowName")
+	}
+	if len(req.TaskList) == 0 {
+		return nil, errors.New("required taskList")
+	}
+
+	identity, err := svr.B.GetNodeIdentity()
+	if nil != err {
+		log.WithError(err).Errorf("RPC-API:PublishWorkflowDeclare failed, query local identity failed, can not publish workflow")
+		return nil, ErrPublishWorkflow
+	}
+
+	taskList := make([]*libTypes.WorkflowTaskData, len(req.TaskList))
+	for i, declare := range req.TaskList {
+		taskMsg, err := svr.newTaskMsgFromDeclare(declare.Task)
+		if nil != err {
+			return nil, fmt.Errorf("invalid task declaration of workflow, name: {%s}, %s", declare.Name, err)
+		}
+		bindings := make([]*libTypes.WorkflowResultBinding, len(declare.Bindings))
+		for j, binding := range declare.Bindings {
+			bindings[j] = &libTypes.WorkflowResultBinding{
+				FromTask: binding.FromTask,
+				Identity: binding.IdentityId,
+				ParamKey: binding.ParamKey,
+			}
+		}
+		taskList[i] = &libTypes.WorkflowTaskData{
+			Name:          declare.Name,
+			Depends:       declare.Depends,
+			Bindings:      bindings,
+			Task:          taskMsg.Data.TaskData(),
+			PowerPartyIds: taskMsg.PowerPartyIds,
+		}
+	}
+
+	workflow := types.NewWorkflow(&libTypes.WorkflowData{
+		WorkflowName: req.WorkflowName,
+		Identity:     identity.IdentityId(),
+		TaskList:     taskList,
+	})
+	if err := workflow.Validate(); nil != err {
+		return nil, err
+	}
+
+	workflowId, err := svr.B.PublishWorkflow(workflow)
+	if nil != err {
+		log.WithError(err).Errorf("RPC-API:PublishWorkflowDeclare failed, workflowName: {%s}", req.WorkflowName)
+		return nil, ErrPublishWorkflow
+	}
+	log.Debugf("RPC-API:PublishWorkflowDeclare succeed, workflowId: {%s}, taskCount: {%d}", workflowId, len(taskList))
+	return &pb.PublishWorkflowDeclareResponse{
+		Status:     0,
+		Msg:        backend.OK,
+		WorkflowId: workflowId,
+	}, nil
+}
+
+func (svr *TaskServiceServer) GetWorkflowDetail(ctx context.Context, req *pb.GetWorkflowDetailRequest) (*pb.GetWorkflowDetailResponse, error) {
+	if "" == req.WorkflowId {
+		return nil, errors.New("required workflowId")
+	}
+
+	workflow, err := svr.B.GetWorkflow(req.WorkflowId)
+	if nil != err {
+		log.WithError(err).Errorf("RPC-API:GetWorkflowDetail failed, workflowId: {%s}", req.WorkflowId)
+		return nil, ErrGetWorkflowDetail
+	}
+	log.Debugf("RPC-API:GetWorkflowDetail succeed, workflowId: {%s}, state: {%s}", req.WorkflowId, workflow.State().String())
+	return &pb.GetWorkflowDetailResponse{
+		Status:   0,
+		Msg:      backend.OK,
+		Workflow: convertWorkflowDetailShow(workflow),
+	}, nil
+}
+
+func (svr *TaskServiceServer) GetWorkflowList(ctx context.Context, req *pb.EmptyGetParams) (*pb.GetWorkflowListResponse, error) {
+	workflows, err := svr.B.GetWorkflowList()
+	if nil != err {
+		log.WithError(err).Error("RPC-API:GetWorkflowList failed")
+		return nil, ErrGetWorkflowList
+	}
+	arr := make([]*pb.WorkflowDetailShow, len(workflows))
+	for i, workflow := range workflows {
+		arr[i] = convertWorkflowDetailShow(workflow)
+	}
+	log.Debugf("RPC-API:GetWorkflowList succeed, workflowList len: {%d}", len(arr))
+	return &pb.GetWorkflowListResponse{
+		Status:       0,
+		Msg:          backend.OK,
+		WorkflowList: arr,
+	}, nil
+}
+
+func (svr *TaskServiceServer) CancelWorkflow(ctx context.Context, req *pb.CancelWorkflowRequest) (*pb.SimpleResponseCode, error) {
+	if "" == req.WorkflowId {
+		return nil, errors.New("required workflowId")
+	}
+
+	if err := svr.B.CancelWorkflow(req.WorkflowId); nil != err {
+		log.WithError(err).Errorf("RPC-API:CancelWorkflow failed, workflowId: {%s}", req.WorkflowId)
+		return nil, ErrCancelWorkflow
+	}
+	log.Debugf("RPC-API:CancelWorkflow succeed, workflowId: {%s}", req.WorkflowId)
+	return &pb.SimpleResponseCode{
+		Status: 0,
+		Msg:    backend.OK,
+	}, nil
+}
+
+func convertWorkflowDetailShow(workflow *types.Workflow) *pb.WorkflowDetailShow {
+	data := workflow.WorkflowData()
+	taskList := make([]*pb.WorkflowTaskShow, len(data.GetTaskList()))
+	for i, task := range data.GetTaskList() {
+		taskList[i] = &pb.WorkflowTaskShow{
+			Name:      task.GetName(),
+			TaskId:    task.GetTaskId(),
+			State:     task.GetState(),
+			Reason:    task.GetReason(),
+			Depends:   task.GetDepends(),
+			PublishAt: task.GetPublishAt(),
+			EndAt:     task.GetEndAt(),
+		}
+	}
+	eventList := make([]*pb.WorkflowEventShow, len(data.GetEventList()))
+	for i, event := range data.GetEventList() {
+		eventList[i] = &pb.WorkflowEventShow{
+			Type:     event.GetEventType(),
+			TaskName: event.GetTaskName(),
+			TaskId:   event.GetTaskId(),
+			Content:  event.GetEventContent(),
+			CreateAt: event.GetEventAt(),
+		}
+	}
+	return &pb.WorkflowDetailShow{
+		WorkflowId:   data.GetWorkflowId(),
+		WorkflowName: data.GetWorkflowName(),
+		State:        data.GetState(),
+		Reason:       data.GetReason(),
+		CreateAt:     data.GetCreateAt(),
+		EndAt:        data.GetEndAt(),
+		TaskList:     taskList,
+		EventList:    eventList,
+	}
+}
@@ -2,10 +2,12 @@ package yarn
 
 import (
 	"context"
+	"errors"
 	"github.com/RosettaFlow/Carrier-Go/core/rawdb"
 	pb "github.com/RosettaFlow/Carrier-Go/lib/api"
 	"github.com/RosettaFlow/Carrier-Go/rpc/backend"
 	"github.com/RosettaFlow/Carrier-Go/types"
+	"path/filepath"
 )
 
 func (svr *YarnServiceServer) GetNodeInfo(ctx context.Context, req *pb.EmptyGetParams) (*pb.GetNodeInfoResponse, error) {
@@ -87,6 +89,7 @@ func (svr *YarnServiceServer) GetRegisteredPeers(ctx context.Context, req *pb.Em
 				Count:   v.Task.Count,
 				TaskIds: v.Task.TaskIds,
 			},
+			Health: convertRegisteredNodeHealth(v.Health),
 		}
 		jobNodes[i] = node
 	}
@@ -112,6 +115,7 @@ func (svr *YarnServiceServer) GetRegisteredPeers(ctx context.Context, req *pb.Em
 				FileCount:     v.Delta.FileCount,
 				FileTotalSize: v.Delta.FileTotalSize,
 			},
+			Health: convertRegisteredNodeHealth(v.Health),
 		}
 		dataNodes[i] = node
 	}
@@ -286,6 +290,15 @@ func (svr *YarnServiceServer) DeleteDataNode(ctx context.Context, req *pb.Delete
 	return &pb.SimpleResponseCode{Status: 0, Msg: backend.OK}, nil
 }
 
+func (svr *YarnServiceServer) DrainDataNode(ctx context.Context, req *pb.DrainRegisteredNodeRequest) (*pb.SimpleResponseCode, error) {
+	if err := svr.B.DrainRegisterNode(types.PREFIX_TYPE_DATANODE, req.Id, req.Drained); nil != err {
+		log.WithError(err).Errorf("RPC-API:DrainDataNode failed, dataNodeId: {%s}, drained: {%v}", req.Id, req.Drained)
+		return nil, ErrDrainRegisteredNode
+	}
+	log.Debugf("RPC-API:DrainDataNode succeed, dataNodeId: {%s}, drained: {%v}", req.Id, req.Drained)
+	return &pb.SimpleResponseCode{Status: 0, Msg: backend.OK}, nil
+}
+
 func (svr *YarnServiceServer) GetDataNodeList(ctx context.Context, req *pb.EmptyGetParams) (*pb.GetRegisteredNodeListResponse, error) {
 
 	list, err := svr.B.GetRegisterNodeList(types.PREFIX_TYPE_DATANODE)
@@ -390,6 +403,15 @@ func (svr *YarnServiceServer) DeleteJobNode(ctx context.Context, req *pb.DeleteR
 	return &pb.SimpleResponseCode{Status: 0, Msg: backend.OK}, nil
 }
 
+func (svr *YarnServiceServer) DrainJobNode(ctx context.Context, req *pb.DrainRegisteredNodeRequest) (*pb.SimpleResponseCode, error) {
+	if err := svr.B.DrainRegisterNode(types.PREFIX_TYPE_JOBNODE, req.Id, req.Drained); nil != err {
+		log.WithError(err).Errorf("RPC-API:DrainJobNode failed, jobNodeId: {%s}, drained: {%v}", req.Id, req.Drained)
+		return nil, ErrDrainRegisteredNode
+	}
+	log.Debugf("RPC-API:DrainJobNode succeed, jobNodeId: {%s}, drained: {%v}", req.Id, req.Drained)
+	return &pb.SimpleResponseCode{Status: 0, Msg: backend.OK}, nil
+}
+
 func (svr *YarnServiceServer) GetJobNodeList(ctx context.Context, req *pb.EmptyGetParams) (*pb.GetRegisteredNodeListResponse, error) {
 	list, err := svr.B.GetRegisterNodeList(types.PREFIX_TYPE_JOBNODE)
 	if rawdb.IsNoDBNotFoundErr(err) {
@@ -427,7 +449,7 @@ func (svr *YarnServiceServer) QueryAvailableDataNode(ctx context.Context, req *p
 
 	var nodeId string
 	for _, resource := range dataResourceTables {
-		if req.FileSize < resource.RemainDisk() {
+		if req.FileSize < resource.RemainDisk() && svr.B.IsDataNodeAvailable(resource.GetNodeId()) {
 			nodeId = resource.GetNodeId()
 			break
 		}
@@ -468,3 +490,35 @@ func (svr *YarnServiceServer) QueryFilePosition(ctx context.Context, req *pb.Que
 		FilePath: dataResourceFileUpload.GetFilePath(),
 	}, nil
 }
+
+func (svr *YarnServiceServer) BackupDatabase(ctx context.Context, req *pb.BackupDatabaseRequest) (*pb.BackupDatabaseResponse, error) {
+	if "" == req.OutputFile || !filepath.IsAbs(req.OutputFile) {
+		return nil, errors.New("required absolute path of outputFile")
+	}
+
+	count, err := svr.B.BackupDatabase(req.OutputFile)
+	if nil != err {
+		log.WithError(err).Errorf("RPC-API:BackupDatabase failed, outputFile: {%s}", req.OutputFile)
+		return nil, ErrBackupDatabase
+	}
+	log.Infof("RPC-API:BackupDatabase succeed, outputFile: {%s}, keyCount: {%d}", req.OutputFile, count)
+	return &pb.BackupDatabaseResponse{
+		Status:   0,
+		Msg:      backend.OK,
+		FilePath: req.OutputFile,
+		KeyCount: uint64(count),
+	}, nil
+}
+
+func convertRegisteredNodeHealth(health *types.RegisteredNodeHealth) *pb.YarnRegisteredNodeHealth {
+	if nil == health {
+		return nil
+	}
+	return &pb.YarnRegisteredNodeHealth{
+		State:        health.State,
+		Latency:      health.Latency,
+		FailureCount: health.FailureCount,
+		LastCheckAt:  health.LastCheckAt,
+		LastError:    health.LastError,
+	}
+}
@@ -2,6 +2,7 @@ package yarn
 
 import (
 	"context"
+	"errors"
 	pb "github.com/RosettaFlow/Carrier-Go/lib/api"
 	"github.com/RosettaFlow/Carrier-Go/rpc/backend"
 	"github.com/RosettaFlow/Carrier-Go/types"
@@ -24,5 +25,22 @@ func (svr *YarnServiceServer) ReportTaskEvent(ctx context.Context, req *pb.Repor
 }
 
 func (svr *YarnServiceServer) ReportTaskResourceExpense(ctx context.Context, req *pb.ReportTaskResourceExpenseRequest) (*pb.SimpleResponseCode, error) {
-	return nil, nil
+	log.Debugf("RPC-API:ReportTaskResourceExpense, req: {%v}", req)
+	if "" == req.TaskId || "" == req.PartyId || "" == req.JobNodeId {
+		return nil, errors.New("required taskId, partyId and jobNodeId")
+	}
+	err := svr.B.ReportTaskResourceExpense(&types.TaskResourceUsage{
+		TaskId:        req.TaskId,
+		PartyId:       req.PartyId,
+		JobNodeId:     req.JobNodeId,
+		UsedMem:       req.UsedMem,
+		UsedProcessor: req.UsedProcessor,
+		UsedBandwidth: req.UsedBandwidth,
+		UsedDuration:  req.UsedDuration,
+	})
+	if nil != err {
+		log.WithError(err).Errorf("RPC-API:ReportTaskResourceExpense failed, taskId: {%s}, jobNodeId: {%s}", req.TaskId, req.JobNodeId)
+		return nil, ErrReportTaskResourceExpense
+	}
+	return &pb.SimpleResponseCode{Status: 0, Msg: backend.OK}, nil
 }
\ No newline at end of file
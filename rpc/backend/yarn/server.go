@@ -14,11 +14,14 @@ var (
 	ErrSetJobNodeInfo             = &backend.RpcBizErr{Msg: "Failed to set job node info"}
 	ErrGetJobNodeList             = &backend.RpcBizErr{Msg: "Failed to get data nodes"}
 	ErrDeleteJobNodeInfo          = &backend.RpcBizErr{Msg: "Failed to delete job node info"}
+	ErrDrainRegisteredNode        = &backend.RpcBizErr{Msg: "Failed to drain registered node"}
 	ErrReportTaskEvent            = &backend.RpcBizErr{Msg: "Failed to report taskEvent"}
+	ErrReportTaskResourceExpense  = &backend.RpcBizErr{Msg: "Failed to report task resource expense"}
 	ErrReportUpFileSummary        = &backend.RpcBizErr{Msg: "Failed to ReportUpFileSummary"}
 	ErrQueryDataResourceTableList = &backend.RpcBizErr{Msg: "Failed to query dataResourceTableList"}
 	ErrQueryDataResourceDataUsed  = &backend.RpcBizErr{Msg: "Failed to query dataResourceDataUsed"}
 	ErrGetNodeInfo                = &backend.RpcBizErr{Msg: "Failed to get yarn node information"}
+	ErrBackupDatabase             = &backend.RpcBizErr{Msg: "Failed to backup database"}
 )
 
 type YarnServiceServer struct {
@@ -2,6 +2,9 @@ package rpc
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
 	"fmt"
 	"github.com/RosettaFlow/Carrier-Go/common"
 	statefeed "github.com/RosettaFlow/Carrier-Go/common/feed/state"
@@ -22,6 +25,7 @@ import (
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/reflection"
+	"io/ioutil"
 	"net"
 	"sync"
 )
@@ -46,6 +50,8 @@ type Config struct {
 	Port                    string
 	CertFlag                string
 	KeyFlag                 string
+	ClientCAFile            string
+	AuthConfigFile          string
 	EnableDebugRPCEndpoints bool
 	Broadcaster             p2p.Broadcaster
 	PeersFetcher            p2p.PeersProvider
@@ -101,15 +107,39 @@ func (s *Service) Start() error {
 	}
 	grpc_prometheus.EnableHandlingTimeHistogram()
 	if s.cfg.CertFlag != "" && s.cfg.KeyFlag != "" {
-		creds, err := credentials.NewServerTLSFromFile(s.cfg.CertFlag, s.cfg.KeyFlag)
+		creds, err := s.serverTLSCredentials()
 		if err != nil {
 			log.WithError(err).Fatal("Could not load TLS keys")
 			return err
 		}
 		opts = append(opts, grpc.Creds(creds))
 	} else {
+		if s.cfg.ClientCAFile != "" {
+			err := errors.New("the client CA of gRPC server requires the tls-cert and tls-key")
+			log.WithError(err).Error("Could not enable mTLS")
+			return err
+		}
 		log.Warn("You are using an insecure gRPC server.")
 	}
+	if s.cfg.AuthConfigFile != "" {
+		authCfg, err := LoadAuthConfig(s.cfg.AuthConfigFile)
+		if err != nil {
+			log.WithError(err).Errorf("Could not load rpc auth config, file: {%s}", s.cfg.AuthConfigFile)
+			return err
+		}
+		authorizer, err := newAuthorizer(authCfg)
+		if err != nil {
+			log.WithError(err).Errorf("Could not load rpc auth config, file: {%s}", s.cfg.AuthConfigFile)
+			return err
+		}
+		opts = append(opts,
+			grpc.ChainUnaryInterceptor(authorizer.unaryInterceptor),
+			grpc.ChainStreamInterceptor(authorizer.streamInterceptor),
+		)
+		log.WithField("principals", len(authCfg.Principals)).Info("Enabled authentication of gRPC server")
+	} else {
+		log.Warn("You are using a gRPC server without authentication.")
+	}
 	// create grpc server
 	s.grpcServer = grpc.NewServer(opts...)
 
@@ -142,6 +172,33 @@ func (s *Service) Start() error {
 	return nil
 }
 
+// serverTLSCredentials loads the TLS keys of server, and requires the verified
+// client certificates (mTLS) if the client CA is given.
+func (s *Service) serverTLSCredentials() (credentials.TransportCredentials, error) {
+	if s.cfg.ClientCAFile == "" {
+		return credentials.NewServerTLSFromFile(s.cfg.CertFlag, s.cfg.KeyFlag)
+	}
+	cert, err := tls.LoadX509KeyPair(s.cfg.CertFlag, s.cfg.KeyFlag)
+	if err != nil {
+		return nil, err
+	}
+	caPEM, err := ioutil.ReadFile(s.cfg.ClientCAFile)
+	if err != nil {
+		return nil, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caPEM) {
+		return nil, fmt.Errorf("no certificate found in client CA file %s", s.cfg.ClientCAFile)
+	}
+	// The client certificate is optional, so that the callers with api token
+	// (and the grpc-gateway) can still connect.
+	return credentials.NewTLS(&tls.Config{
+		Certificates: []tls.Certificate{cert},
+		ClientAuth:   tls.VerifyClientCertIfGiven,
+		ClientCAs:    pool,
+	}), nil
+}
+
 // Stop the service.
 func (s *Service) Stop() error {
 	s.cancel()
@@ -54,6 +54,29 @@ func FetchTaskPeerInfo(peerInfo *pb.TaskPeerInfo) *PrepareVoteResource {
 	}
 }
 
+// ProposalStateRecord is the persistent form of the proposalState of 2pc,
+// stored on local db so that the proposal can be recovered after carrier restarted.
+type ProposalStateRecord struct {
+	ProposalId         common.Hash
+	TaskDir            ProposalTaskDir
+	TaskRole           TaskRole
+	SelfIdentity       *TaskNodeAlias
+	TaskId             string
+	PeriodNum          uint32
+	PrePeriodStartTime uint64
+	PeriodStartTime    uint64
+	DeadlineDuration   uint64
+	CreateAt           uint64
+	// The timeouts (ms) of prepare, confirm and commit periods, empty on the records stored by the older version
+	PeriodTimeouts []uint64 `rlp:"tail"`
+}
+
+func (record *ProposalStateRecord) String() string {
+	return fmt.Sprintf(`{"proposalId": %s, "taskDir": %s, "taskRole": %s, "selfIdentity": %s, "taskId": %s, "periodNum": %d, "prePeriodStartTime": %d, "periodStartTime": %d, "deadlineDuration": %d, "createAt": %d, "periodTimeouts": %v}`,
+		record.ProposalId.String(), record.TaskDir.String(), record.TaskRole.String(), record.SelfIdentity.String(), record.TaskId,
+		record.PeriodNum, record.PrePeriodStartTime, record.PeriodStartTime, record.DeadlineDuration, record.CreateAt, record.PeriodTimeouts)
+}
+
 type PrepareMsg struct {
 	ProposalId  common.Hash
 	TaskRole    TaskRole
@@ -62,11 +85,12 @@ type PrepareMsg struct {
 	TaskInfo    *Task
 	CreateAt    uint64
 	Sign        []byte
+	Election    *ElectionProof
 }
 
 func (msg *PrepareMsg) String() string {
-	return fmt.Sprintf(`{"proposalId": %s, "taskRole": %s, "taskPartyId": %s, "owner": %s, "createAt": %d, "sign": %v}`,
-		msg.ProposalId.String(), msg.TaskRole.String(), msg.TaskPartyId, msg.Owner.String(), msg.CreateAt, msg.Sign)
+	return fmt.Sprintf(`{"proposalId": %s, "taskRole": %s, "taskPartyId": %s, "owner": %s, "createAt": %d, "sign": %v, "election": %s}`,
+		msg.ProposalId.String(), msg.TaskRole.String(), msg.TaskPartyId, msg.Owner.String(), msg.CreateAt, msg.Sign, msg.Election.String())
 }
 
 type PrepareVote struct {
@@ -263,6 +287,7 @@ type TaskResultMsg struct {
 	TaskEventList []*TaskEventInfo
 	CreateAt      uint64
 	Sign          []byte
+	ResourceUsageList []*TaskResourceUsage
 }
 
 func (msg *TaskResultMsg)String() string {
@@ -285,6 +310,7 @@ func ConvertTaskResultMsg(msg *TaskResultMsg) *pb.TaskResultMsg {
 		TaskEventList: ConvertTaskEventArr(msg.TaskEventList),
 		CreateAt:      msg.CreateAt,
 		Sign:          msg.Sign,
+		ResourceUsageList: ConvertTaskResourceUsageArr(msg.ResourceUsageList),
 	}
 }
 
@@ -302,5 +328,6 @@ func FetchTaskResultMsg(msg *pb.TaskResultMsg) *TaskResultMsg {
 		TaskEventList: FetchTaskEventArr(msg.TaskEventList),
 		CreateAt:      msg.CreateAt,
 		Sign:          msg.Sign,
+		ResourceUsageList: FetchTaskResourceUsageArr(msg.ResourceUsageList),
 	}
 }
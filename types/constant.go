@@ -45,12 +45,13 @@ type TaskState string
 
 func (t TaskState) String() string { return string(t) }
 
-// (pending: 等在中; running: 计算中; failed: 失败; success: 成功)
+// (pending: 等在中; running: 计算中; failed: 失败; success: 成功; cancelled: 已取消)
 const (
-	TaskStatePending TaskState = "pending"
-	TaskStateRunning TaskState = "running"
-	TaskStateFailed  TaskState = "failed"
-	TaskStateSuccess TaskState = "success"
+	TaskStatePending   TaskState = "pending"
+	TaskStateRunning   TaskState = "running"
+	TaskStateFailed    TaskState = "failed"
+	TaskStateSuccess   TaskState = "success"
+	TaskStateCancelled TaskState = "cancelled"
 )
 
 type IdentityType string
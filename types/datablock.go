@@ -46,6 +46,12 @@ func (h *Header) Signature() []byte {
 	return h.Extra[32:]
 }
 
+// SealHash returns the hash of the header which is signed by the sealer,
+// the signature part of the extra is excluded.
+func (h *Header) SealHash() common.Hash {
+	return h._sealHash()
+}
+
 func (h *Header) _sealHash() (hash common.Hash) {
 	extra := h.Extra
 	hasher := sha3.NewKeccak256()
@@ -174,6 +180,17 @@ func (b *Block) Extra() []byte            { return common.CopyBytes(b.header.Ext
 
 func (b *Block) Header() *Header { return CopyHeader(b.header) }
 
+// Body returns the body data of the block, including the extra data.
+func (b *Block) Body() *types.BodyData {
+	return &types.BodyData{
+		Metadata:     b.metadatas.To(),
+		Resourcedata: b.resources.To(),
+		Identitydata: b.identities.To(),
+		Taskdata:     b.taskDatas.To(),
+		ExtraData:    common.CopyBytes(b.extraData),
+	}
+}
+
 // WithBody returns a new block with the given data(metadata/identity/resource/taskdata).
 func (b *Block) WithBody(metadataList []*Metadata, resourcesList []*Resource,
 	identityList []*Identity, taskDataList []*Task, extraData []byte) *Block {
@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	pb "github.com/RosettaFlow/Carrier-Go/lib/consensus/twopc"
 	libTypes "github.com/RosettaFlow/Carrier-Go/lib/types"
@@ -25,6 +26,9 @@ type PowerMsgEvent struct{ Msgs PowerMsgs }
 type PowerRevokeMsgEvent struct{ Msgs PowerRevokeMsgs }
 type TaskMsgEvent struct{ Msgs TaskMsgs }
 
+// ErrTaskEventSubscriberSlow is sent to the subscription of task events which is dropped for its full channel.
+var ErrTaskEventSubscriberSlow = errors.New("the subscriber of task events is too slow")
+
 type TaskEventInfo struct {
 	Type       string `json:"type"`
 	Identity   string `json:"identity"`
@@ -36,6 +36,12 @@ func NewTaskDetailShowFromTaskData(input *Task, role string) *TaskDetailShow {
 			Bandwidth: taskData.GetTaskResource().GetCostBandwidth(),
 			Duration:  taskData.GetTaskResource().GetDuration(),
 		},
+		Attempt:      input.Attempt(),
+		MaxAttempts:  taskData.GetRetryPolicy().GetMaxAttempts(),
+		OriginTaskId: taskData.GetOriginTaskId(),
+		Priority:     taskData.GetPriority(),
+		StartDeadline:  taskData.GetStartDeadline(),
+		FinishDeadline: taskData.GetFinishDeadline(),
 	}
 	// DataSupplier
 	for _, metadataSupplier := range taskData.GetMetadataSupplier() {
@@ -476,14 +476,70 @@ type TaskBullet struct {
 	Starve      bool
 	Term        uint32
 	Resched     uint32
+	// the priority on queue, it may be lower than the one declared by task (capped by scheduler)
+	Priority TaskPriority
+	// the time (ms) when the task was put into the queue first
+	EnqueueAt uint64
 }
 
 func NewTaskBulletByTaskMsg(msg *TaskMsg) *TaskBullet {
 	return &TaskBullet{
 		UnschedTask: NewUnSchedTaskWrap(msg.Data, msg.PowerPartyIds),
+		Priority:    msg.Data.Priority(),
+		EnqueueAt:   uint64(timeutils.UnixMsec()),
 	}
 }
 
+// NewTaskBulletByRecord restores the bullet from the persisted record and the local task of it.
+func NewTaskBulletByRecord(record *TaskBulletRecord, task *Task) *TaskBullet {
+	return &TaskBullet{
+		UnschedTask: NewUnSchedTaskWrap(task, record.PowerPartyIds),
+		Starve:      record.Starve,
+		Term:        record.Term,
+		Resched:     record.Resched,
+		Priority:    TaskPriority(record.Priority),
+		EnqueueAt:   record.EnqueueAt,
+	}
+}
+
+// Record returns the state of bullet on the queue to be persisted.
+func (b *TaskBullet) Record() *TaskBulletRecord {
+	return &TaskBulletRecord{
+		TaskId:        b.UnschedTask.Data.TaskId(),
+		PowerPartyIds: b.UnschedTask.PowerPartyIds,
+		Starve:        b.Starve,
+		Term:          b.Term,
+		Resched:       b.Resched,
+		Priority:      uint32(b.Priority),
+		EnqueueAt:     b.EnqueueAt,
+	}
+}
+
+// TaskBulletRecord is the persisted state of the TaskBullet on the queue of scheduler,
+// the task itself is stored by `StoreLocalTask`.
+type TaskBulletRecord struct {
+	TaskId        string
+	PowerPartyIds []string
+	Starve        bool
+	Term          uint32
+	Resched       uint32
+	Priority      uint32
+	EnqueueAt     uint64
+}
+
+// Before reports whether the bullet should be scheduled before the other one regardless of the waiting term,
+// the one with higher priority goes first, then the one with earlier deadline (the ones without deadline go last).
+func (b *TaskBullet) Before(other *TaskBullet) bool {
+	if b.Priority != other.Priority {
+		return b.Priority > other.Priority
+	}
+	bd, od := b.UnschedTask.Data.LatestStartAt(), other.UnschedTask.Data.LatestStartAt()
+	if bd == od || 0 == bd {
+		return false
+	}
+	return 0 == od || bd < od
+}
+
 func (b *TaskBullet) IncreaseResched() { b.Resched++ }
 func (b *TaskBullet) DecreaseResched() {
 	if b.Resched > 0 {
@@ -500,7 +556,13 @@ func (b *TaskBullet) DecreaseTerm() {
 type TaskBullets []*TaskBullet
 
 func (h TaskBullets) Len() int           { return len(h) }
-func (h TaskBullets) Less(i, j int) bool { return h[i].Term > h[j].Term } // term:  a.3 > c.2 > b.1,  So order is: a c b
+func (h TaskBullets) Less(i, j int) bool {
+	// priority first, then deadline, then term:  (urgent, 1) > (normal, 3) > (normal, 2),  So order is: urgent first
+	if h[i].Before(h[j]) || h[j].Before(h[i]) {
+		return h[i].Before(h[j])
+	}
+	return h[i].Term > h[j].Term // term:  a.3 > c.2 > b.1,  So order is: a c b
+}
 func (h TaskBullets) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
 
 func (h *TaskBullets) Push(x interface{}) {
@@ -582,9 +644,29 @@ func NewTaskMessageFromRequest(req *pb.PublishTaskDeclareRequest) *TaskMsg {
 			CalculateContractCode: req.CalculateContractcode,
 			DataSplitContractCode: req.DatasplitContractcode,
 			ContractExtraParams: req.ContractExtraParams,
+			RetryPolicy:         NewTaskRetryPolicyFromRequest(req.RetryPolicy),
+			Attempt:             1,
+			Priority:            req.Priority,
+			StartDeadline:       req.StartDeadline,
+			FinishDeadline:      req.FinishDeadline,
 		}),
 	}
 }
+
+func NewTaskRetryPolicyFromRequest(policy *pb.TaskRetryPolicyDeclare) *libTypes.TaskRetryPolicy {
+	if nil == policy || policy.MaxAttempts <= 1 {
+		return nil
+	}
+	backoff := policy.Backoff
+	if backoff > MaxTaskRetryBackoff {
+		backoff = MaxTaskRetryBackoff
+	}
+	return &libTypes.TaskRetryPolicy{
+		MaxAttempts:  policy.MaxAttempts,
+		Backoff:      backoff,
+		ReelectPower: policy.ReelectPower,
+	}
+}
 func ConvertTaskMsgToTaskWithPowers(task *Task, powers []*libTypes.TaskResourceSupplierData) *Task {
 	task.SetResourceSupplierArr(powers)
 
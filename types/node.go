@@ -38,6 +38,7 @@ type YarnRegisteredJobNode struct {
 		Count   uint32   `json:"count"`
 		TaskIds []string `json:"taskIds"`
 	} `json:"task"`
+	Health *RegisteredNodeHealth `json:"health"`
 }
 
 type YarnRegisteredDataNode struct {
@@ -52,4 +53,14 @@ type YarnRegisteredDataNode struct {
 		FileCount     uint32 `json:"fileCount"`
 		FileTotalSize uint32 `json:"fileTotalSize"`
 	} `json:"delta"`
+	Health *RegisteredNodeHealth `json:"health"`
+}
+
+// RegisteredNodeHealth is the health of jobNode or dataNode probed by the yarnNode.
+type RegisteredNodeHealth struct {
+	State        string `json:"state"`
+	Latency      uint64 `json:"latency"` // ms
+	FailureCount uint32 `json:"failureCount"`
+	LastCheckAt  uint64 `json:"lastCheckAt"`
+	LastError    string `json:"lastError"`
 }
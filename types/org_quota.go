@@ -0,0 +1,100 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	msecPerDay  = uint64(24 * 60 * 60 * 1000)
+	msecPerHour = uint64(60 * 60 * 1000)
+)
+
+var (
+	ErrOrgQuotaTaskExceeded      = errors.New("the concurrent tasks of identity exceed the quota")
+	ErrOrgQuotaSlotExceeded      = errors.New("the slots used by identity exceed the quota")
+	ErrOrgQuotaSlotHoursExceeded = errors.New("the daily slot-hours used by identity exceed the quota")
+)
+
+// OrgQuota limits the local power used by the tasks of a remote identity, zero means unlimited.
+type OrgQuota struct {
+	IdentityId         string
+	MaxConcurrentTasks uint32
+	MaxSlots           uint64
+	MaxDailySlotHours  uint64
+}
+
+func (q *OrgQuota) String() string {
+	return fmt.Sprintf(`{"identityId": %s, "maxConcurrentTasks": %d, "maxSlots": %d, "maxDailySlotHours": %d}`,
+		q.IdentityId, q.MaxConcurrentTasks, q.MaxSlots, q.MaxDailySlotHours)
+}
+
+// Check returns the error if the task of identity using `needSlotCount` slots can not be accepted any more.
+func (q *OrgQuota) Check(usage *OrgQuotaUsage, needSlotCount uint64) error {
+	if 0 != q.MaxConcurrentTasks && usage.RunningTasks+1 > q.MaxConcurrentTasks {
+		return fmt.Errorf("%s, identityId: {%s}, running: {%d}, quota: {%d}",
+			ErrOrgQuotaTaskExceeded, q.IdentityId, usage.RunningTasks, q.MaxConcurrentTasks)
+	}
+	if 0 != q.MaxSlots && usage.UsedSlots+needSlotCount > q.MaxSlots {
+		return fmt.Errorf("%s, identityId: {%s}, used: {%d}, need: {%d}, quota: {%d}",
+			ErrOrgQuotaSlotExceeded, q.IdentityId, usage.UsedSlots, needSlotCount, q.MaxSlots)
+	}
+	if 0 != q.MaxDailySlotHours && usage.DailySlotMsec >= q.MaxDailySlotHours*msecPerHour {
+		return fmt.Errorf("%s, identityId: {%s}, used: {%d} slot-ms, quota: {%d} slot-hours",
+			ErrOrgQuotaSlotHoursExceeded, q.IdentityId, usage.DailySlotMsec, q.MaxDailySlotHours)
+	}
+	return nil
+}
+
+// OrgDailySlotUsage is the slot-time used by the finished tasks of a remote identity in the day.
+type OrgDailySlotUsage struct {
+	IdentityId string
+	Day        uint64 // days since the unix epoch (UTC)
+	SlotMsec   uint64
+}
+
+// OrgQuotaUsage is the current usage of the local power by the tasks of a remote identity.
+type OrgQuotaUsage struct {
+	IdentityId    string
+	RunningTasks  uint32
+	UsedSlots     uint64
+	DailySlotMsec uint64 // the slot-time (slot * ms) used today, include the running tasks
+}
+
+func (u *OrgQuotaUsage) String() string {
+	return fmt.Sprintf(`{"identityId": %s, "runningTasks": %d, "usedSlots": %d, "dailySlotMsec": %d}`,
+		u.IdentityId, u.RunningTasks, u.UsedSlots, u.DailySlotMsec)
+}
+
+// NewOrgQuotaUsage sums up the usage of identity from the slots locked by the local tasks,
+// and the slot-time used by the finished tasks today.
+func NewOrgQuotaUsage(identityId string, powerUseds []*LocalTaskPowerUsed, daily *OrgDailySlotUsage, now uint64) *OrgQuotaUsage {
+	usage := &OrgQuotaUsage{IdentityId: identityId}
+	if nil != daily && daily.Day == UnixDay(now) {
+		usage.DailySlotMsec = daily.SlotMsec
+	}
+	for _, used := range powerUseds {
+		if used.GetIdentityId() != identityId {
+			continue
+		}
+		usage.RunningTasks++
+		usage.UsedSlots += used.GetSlotCount()
+		usage.DailySlotMsec += TodaySlotMsec(used, now)
+	}
+	return usage
+}
+
+// UnixDay returns the days since the unix epoch (UTC) of the time (ms).
+func UnixDay(msec uint64) uint64 { return msec / msecPerDay }
+
+// TodaySlotMsec returns the slot-time used by the task since the beginning of today.
+func TodaySlotMsec(used *LocalTaskPowerUsed, now uint64) uint64 {
+	start := used.GetUseAt()
+	if today := UnixDay(now) * msecPerDay; start < today {
+		start = today
+	}
+	if now <= start {
+		return 0
+	}
+	return used.GetSlotCount() * (now - start)
+}
@@ -0,0 +1,74 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+func TestOrgQuotaCheck(t *testing.T) {
+	now := uint64(10*msecPerDay + 2*msecPerHour)
+	powerUseds := []*LocalTaskPowerUsed{
+		NewLocalTaskPowerUsed("task:0x01", "jobNode1", 2, "identity:a", now-msecPerHour),
+		// locked since yesterday, only the slot-time of today is counted
+		NewLocalTaskPowerUsed("task:0x02", "jobNode2", 1, "identity:a", now-5*msecPerHour),
+		NewLocalTaskPowerUsed("task:0x03", "jobNode1", 4, "identity:b", now-msecPerHour),
+	}
+	daily := &OrgDailySlotUsage{IdentityId: "identity:a", Day: UnixDay(now), SlotMsec: msecPerHour}
+
+	usage := NewOrgQuotaUsage("identity:a", powerUseds, daily, now)
+	if usage.RunningTasks != 2 || usage.UsedSlots != 3 {
+		t.Fatalf("unexpected usage: %s", usage.String())
+	}
+	if want := 2*msecPerHour + 2*msecPerHour + msecPerHour; usage.DailySlotMsec != want {
+		t.Fatalf("unexpected daily slot-time: %d, want: %d", usage.DailySlotMsec, want)
+	}
+	// the daily usage of yesterday is not counted
+	daily.Day--
+	if usage := NewOrgQuotaUsage("identity:a", powerUseds, daily, now); usage.DailySlotMsec != 4*msecPerHour {
+		t.Fatalf("unexpected daily slot-time: %d", usage.DailySlotMsec)
+	}
+
+	if err := (&OrgQuota{IdentityId: "identity:a"}).Check(usage, 100); nil != err {
+		t.Fatalf("the empty quota should be unlimited, err: %s", err)
+	}
+	if err := (&OrgQuota{IdentityId: "identity:a", MaxConcurrentTasks: 2}).Check(usage, 1); nil == err {
+		t.Fatal("expect the error of concurrent tasks")
+	}
+	if err := (&OrgQuota{IdentityId: "identity:a", MaxSlots: 4}).Check(usage, 2); nil == err {
+		t.Fatal("expect the error of slots")
+	}
+	if err := (&OrgQuota{IdentityId: "identity:a", MaxSlots: 4}).Check(usage, 1); nil != err {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if err := (&OrgQuota{IdentityId: "identity:a", MaxDailySlotHours: 5}).Check(usage, 1); nil == err {
+		t.Fatal("expect the error of daily slot-hours")
+	}
+}
+
+func TestLocalTaskPowerUsedRLP(t *testing.T) {
+	used := NewLocalTaskPowerUsed("task:0x01", "jobNode1", 2, "identity:a", 1000)
+	b, err := rlp.EncodeToBytes(used)
+	if nil != err {
+		t.Fatal(err)
+	}
+	var dec LocalTaskPowerUsed
+	if err := rlp.DecodeBytes(b, &dec); nil != err {
+		t.Fatal(err)
+	}
+	if dec.GetTaskId() != "task:0x01" || dec.GetSlotCount() != 2 || dec.GetIdentityId() != "identity:a" || dec.GetUseAt() != 1000 {
+		t.Fatalf("unexpected decoded: %s", dec.String())
+	}
+
+	// the record stored by the old version
+	old, err := rlp.EncodeToBytes([]interface{}{"task:0x02", "jobNode2", uint64(3)})
+	if nil != err {
+		t.Fatal(err)
+	}
+	if err := rlp.DecodeBytes(old, &dec); nil != err {
+		t.Fatal(err)
+	}
+	if dec.GetTaskId() != "task:0x02" || dec.GetSlotCount() != 3 || "" != dec.GetIdentityId() {
+		t.Fatalf("unexpected decoded: %s", dec.String())
+	}
+}
@@ -51,19 +51,18 @@ func NewLocalResourceTable(nodeId, powerId string, mem, processor, bandwidth uin
 	return &LocalResourceTable{
 		nodeId:  nodeId,
 		powerId: powerId,
-		//nodeResource: &resource{
-		//	mem:       mem,
-		//	processor: processor,
-		//	bandwidth: bandwidth,
-		//},
-		nodeResource: DefaultResouece, // TODO for test
-		assign:       false,
+		nodeResource: &resource{
+			mem:       mem,
+			processor: processor,
+			bandwidth: bandwidth,
+		},
+		assign: false,
 	}
 }
 
 func (r *LocalResourceTable) String() string {
-	return fmt.Sprintf(`{"nodeId": "%s", "powerId": "%s", "nodeResource": %s, "assign": %v}`,
-		r.nodeId, r.powerId, r.nodeResource.String(), r.assign)
+	return fmt.Sprintf(`{"nodeId": "%s", "powerId": "%s", "nodeResource": %s, "assign": %v, "slotTotal": %d, "slotUsed": %d}`,
+		r.nodeId, r.powerId, r.nodeResource.String(), r.assign, r.slotTotal, r.slotUsed)
 }
 func (r *LocalResourceTable) GetNodeId() string    { return r.nodeId }
 func (r *LocalResourceTable) GetPowerId() string   { return r.powerId }
@@ -73,21 +72,28 @@ func (r *LocalResourceTable) GetBandwidth() uint64 { return r.nodeResource.bandw
 func (r *LocalResourceTable) GetAssign() bool      { return r.assign }
 func (r *LocalResourceTable) GetSlotTotal() uint32 { return r.slotTotal }
 func (r *LocalResourceTable) GetSlotUsed() uint32  { return r.slotUsed }
+// SetSlotUnit recomputes the slotTotal of the node by the slot unit, and the slotUsed is kept.
 func (r *LocalResourceTable) SetSlotUnit(slot *Slot) {
-	memCount := r.nodeResource.mem / slot.Mem
-	processorCount := r.nodeResource.processor / slot.Processor
-	bandwidthCount := r.nodeResource.bandwidth / slot.Bandwidth
-
-	min := min3number(memCount, processorCount, bandwidthCount)
+	r.slotTotal = uint32(slot.CalculateSlotCount(r.nodeResource.mem, r.nodeResource.processor, r.nodeResource.bandwidth))
+}
 
-	r.slotTotal = uint32(min)
+// SetSlotUsed resets the slotUsed of the node, it is used to rebuild the slot accounting of the node.
+func (r *LocalResourceTable) SetSlotUsed(count uint32) {
+	r.slotUsed = count
+	r.assign = count != 0
 }
 
-func (r *LocalResourceTable) RemianSlot() uint32 { return r.slotTotal - r.slotUsed /*- r.slotLocked*/ }
+func (r *LocalResourceTable) RemianSlot() uint32 {
+	// the slotTotal maybe less than the slotUsed after the slot unit was changed
+	if r.slotUsed >= r.slotTotal {
+		return 0
+	}
+	return r.slotTotal - r.slotUsed /*- r.slotLocked*/
+}
 func (r *LocalResourceTable) UseSlot(count uint32) error {
 
 	if r.RemianSlot() < count {
-		return fmt.Errorf("Failed to lock local resource, slotRemain {%s} less than need lock count {%s}", r.RemianSlot(), count)
+		return fmt.Errorf("Failed to lock local resource, slotRemain {%d} less than need lock count {%d}", r.RemianSlot(), count)
 	}
 	r.slotUsed += count
 	r.assign = true
@@ -98,7 +104,7 @@ func (r *LocalResourceTable) FreeSlot(count uint32) error {
 		return nil
 	}
 	if r.slotUsed == 0 || r.slotUsed < count {
-		return fmt.Errorf("Failed to unlock local resource, slotUsed {%s} less than need free count {%s}", r.slotUsed, count)
+		return fmt.Errorf("Failed to unlock local resource, slotUsed {%d} less than need free count {%d}", r.slotUsed, count)
 	} else {
 		r.slotUsed -= count
 	}
@@ -279,27 +285,43 @@ type LocalTaskPowerUsed struct {
 	taskId    string
 	nodeId    string
 	slotCount uint64
+	// the identityId of task owner, and the time (ms) when the slots were locked
+	identityId string
+	useAt      uint64
 }
 type localTaskPowerUsedRlp struct {
 	TaskId    string
 	NodeId    string
 	SlotCount uint64
+	// [identityId, useAt], the records stored by the old version have not them
+	Extra []rlp.RawValue `rlp:"tail"`
 }
 
-func NewLocalTaskPowerUsed(taskId, nodeId string, slotCount uint64) *LocalTaskPowerUsed {
+func NewLocalTaskPowerUsed(taskId, nodeId string, slotCount uint64, identityId string, useAt uint64) *LocalTaskPowerUsed {
 	return &LocalTaskPowerUsed{
-		taskId:    taskId,
-		nodeId:    nodeId,
-		slotCount: slotCount,
+		taskId:     taskId,
+		nodeId:     nodeId,
+		slotCount:  slotCount,
+		identityId: identityId,
+		useAt:      useAt,
 	}
 }
 
 // EncodeRLP implements rlp.Encoder.
 func (pcache *LocalTaskPowerUsed) EncodeRLP(w io.Writer) error {
+	identityId, err := rlp.EncodeToBytes(pcache.identityId)
+	if nil != err {
+		return err
+	}
+	useAt, err := rlp.EncodeToBytes(pcache.useAt)
+	if nil != err {
+		return err
+	}
 	return rlp.Encode(w, localTaskPowerUsedRlp{
 		TaskId:    pcache.taskId,
 		NodeId:    pcache.nodeId,
 		SlotCount: pcache.slotCount,
+		Extra:     []rlp.RawValue{identityId, useAt},
 	})
 }
 
@@ -307,17 +329,29 @@ func (pcache *LocalTaskPowerUsed) EncodeRLP(w io.Writer) error {
 func (pcache *LocalTaskPowerUsed) DecodeRLP(s *rlp.Stream) error {
 	var dec localTaskPowerUsedRlp
 	err := s.Decode(&dec)
-	if err == nil {
-		pcache.taskId, pcache.nodeId, pcache.slotCount = dec.TaskId, dec.NodeId, dec.SlotCount
+	if err != nil {
+		return err
 	}
-	return err
+	pcache.taskId, pcache.nodeId, pcache.slotCount = dec.TaskId, dec.NodeId, dec.SlotCount
+	pcache.identityId, pcache.useAt = "", 0
+	if len(dec.Extra) >= 2 {
+		if err := rlp.DecodeBytes(dec.Extra[0], &pcache.identityId); nil != err {
+			return err
+		}
+		if err := rlp.DecodeBytes(dec.Extra[1], &pcache.useAt); nil != err {
+			return err
+		}
+	}
+	return nil
 }
-func (pcache *LocalTaskPowerUsed) GetTaskId() string    { return pcache.taskId }
-func (pcache *LocalTaskPowerUsed) GetNodeId() string    { return pcache.nodeId }
-func (pcache *LocalTaskPowerUsed) GetSlotCount() uint64 { return pcache.slotCount }
+func (pcache *LocalTaskPowerUsed) GetTaskId() string     { return pcache.taskId }
+func (pcache *LocalTaskPowerUsed) GetNodeId() string     { return pcache.nodeId }
+func (pcache *LocalTaskPowerUsed) GetSlotCount() uint64  { return pcache.slotCount }
+func (pcache *LocalTaskPowerUsed) GetIdentityId() string { return pcache.identityId }
+func (pcache *LocalTaskPowerUsed) GetUseAt() uint64      { return pcache.useAt }
 func (pcache *LocalTaskPowerUsed) String() string {
-	return fmt.Sprintf(`{"taskId": %s, "nodeId": %s, "slotCount":, %d}`,
-		pcache.taskId, pcache.nodeId, pcache.slotCount)
+	return fmt.Sprintf(`{"taskId": %s, "nodeId": %s, "slotCount":, %d, "identityId": %s, "useAt": %d}`,
+		pcache.taskId, pcache.nodeId, pcache.slotCount, pcache.identityId, pcache.useAt)
 }
 
 
@@ -39,3 +39,25 @@ func TestResourceEncode(t *testing.T) {
 		t.Fatalf("encode protobuf mismatch, got %x, want %x", common.Bytes2Hex(dBuffer.Bytes()), common.Bytes2Hex(buffer.Bytes()))
 	}
 }
+
+func TestLocalResourceTableSlot(t *testing.T) {
+	table := NewLocalResourceTable("jobNode", "power", 1024*8, 4, 1024)
+	table.SetSlotUnit(&Slot{Mem: 1024, Processor: 1, Bandwidth: 256})
+	if table.GetSlotTotal() != 4 {
+		t.Fatalf("slotTotal mismatch, got %d, want %d", table.GetSlotTotal(), 4)
+	}
+	if err := table.UseSlot(3); err != nil {
+		t.Fatal("use slot failed, err: ", err)
+	}
+
+	// shrink the slot unit, the slotUsed is kept and the remain slot must not overflow
+	table.SetSlotUnit(&Slot{Mem: 1024, Processor: 2, Bandwidth: 256})
+	if table.GetSlotTotal() != 2 || table.RemianSlot() != 0 {
+		t.Fatalf("slot mismatch after set slot unit, slotTotal: %d, remain: %d", table.GetSlotTotal(), table.RemianSlot())
+	}
+
+	table.SetSlotUsed(0)
+	if table.GetAssign() || table.RemianSlot() != 2 {
+		t.Fatalf("slot mismatch after reset slotUsed, assign: %v, remain: %d", table.GetAssign(), table.RemianSlot())
+	}
+}
@@ -0,0 +1,84 @@
+package types
+
+import "fmt"
+
+// ScheduleCandidate is a remote org or a local jobNode considered by the schedule of task,
+// with the reason why it was accepted or rejected.
+type ScheduleCandidate struct {
+	Id       string // identityId of org or jobNodeId
+	Accepted bool
+	Reason   string
+}
+
+// ScheduleExplain records how the orgs of task are elected and how the local jobNode is placed,
+// it is made by the dry-run of schedule which never queues the task.
+//
+// NOTE: all the methods are nil-safe, so the scheduler records into it only when it is not nil.
+type ScheduleExplain struct {
+	OrgCandidates  []*ScheduleCandidate
+	NodeCandidates []*ScheduleCandidate
+	// the identityIds of the orgs elected finally
+	ElectedOrgs []string
+	// the jobNodeId placed finally
+	PlacedJobNode string
+	NeedSlotCount uint32
+	OrgErr        string
+	NodeErr       string
+}
+
+func (e *ScheduleExplain) orgCandidate(identityId string) *ScheduleCandidate {
+	for _, c := range e.OrgCandidates {
+		if c.Id == identityId {
+			return c
+		}
+	}
+	c := &ScheduleCandidate{Id: identityId}
+	e.OrgCandidates = append(e.OrgCandidates, c)
+	return c
+}
+
+// AcceptOrg marks the org as accepted on the current step of election.
+func (e *ScheduleExplain) AcceptOrg(identityId, format string, args ...interface{}) {
+	if nil == e {
+		return
+	}
+	c := e.orgCandidate(identityId)
+	c.Accepted, c.Reason = true, fmt.Sprintf(format, args...)
+}
+
+// RejectOrg marks the org as rejected, with the reason.
+func (e *ScheduleExplain) RejectOrg(identityId, format string, args ...interface{}) {
+	if nil == e {
+		return
+	}
+	c := e.orgCandidate(identityId)
+	c.Accepted, c.Reason = false, fmt.Sprintf(format, args...)
+}
+
+// AcceptNode marks the local jobNode as accepted.
+func (e *ScheduleExplain) AcceptNode(jobNodeId, format string, args ...interface{}) {
+	if nil == e {
+		return
+	}
+	e.NodeCandidates = append(e.NodeCandidates, &ScheduleCandidate{Id: jobNodeId, Accepted: true, Reason: fmt.Sprintf(format, args...)})
+}
+
+// RejectNode marks the local jobNode as rejected, with the reason.
+func (e *ScheduleExplain) RejectNode(jobNodeId, format string, args ...interface{}) {
+	if nil == e {
+		return
+	}
+	e.NodeCandidates = append(e.NodeCandidates, &ScheduleCandidate{Id: jobNodeId, Reason: fmt.Sprintf(format, args...)})
+}
+
+// RejectOrgsExcept rejects the accepted orgs which are not in the keep set.
+func (e *ScheduleExplain) RejectOrgsExcept(keep map[string]struct{}, reason string) {
+	if nil == e {
+		return
+	}
+	for _, c := range e.OrgCandidates {
+		if _, ok := keep[c.Id]; c.Accepted && !ok {
+			c.Accepted, c.Reason = false, reason
+		}
+	}
+}
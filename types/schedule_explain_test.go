@@ -0,0 +1,37 @@
+package types
+
+import "testing"
+
+func TestScheduleExplain(t *testing.T) {
+	// the nil explain records nothing
+	var nilExplain *ScheduleExplain
+	nilExplain.AcceptOrg("identity:a", "enough resource")
+	nilExplain.RejectNode("jobNode1", "not enough slots")
+	nilExplain.RejectOrgsExcept(nil, "not elected")
+
+	explain := &ScheduleExplain{}
+	explain.RejectOrg("identity:a", "it is a mock identity")
+	explain.AcceptOrg("identity:b", "enough resource")
+	explain.AcceptOrg("identity:c", "enough resource")
+	explain.RejectOrgsExcept(map[string]struct{}{"identity:b": {}}, "not elected")
+	explain.AcceptOrg("identity:b", "elected as the power org, partyId: %s", "p1")
+
+	if len(explain.OrgCandidates) != 3 {
+		t.Fatalf("unexpected org candidate count: %d", len(explain.OrgCandidates))
+	}
+	expect := []struct {
+		id       string
+		accepted bool
+		reason   string
+	}{
+		{"identity:a", false, "it is a mock identity"},
+		{"identity:b", true, "elected as the power org, partyId: p1"},
+		{"identity:c", false, "not elected"},
+	}
+	for i, e := range expect {
+		c := explain.OrgCandidates[i]
+		if c.Id != e.id || c.Accepted != e.accepted || c.Reason != e.reason {
+			t.Fatalf("unexpected org candidate: %+v, want: %+v", c, e)
+		}
+	}
+}
@@ -8,6 +8,11 @@ type Slot struct {
 	Bandwidth uint64
 }
 
+// IsValid reports whether every resource of the slot unit is not zero.
+func (s *Slot) IsValid() bool {
+	return nil != s && s.Mem != 0 && s.Processor != 0 && s.Bandwidth != 0
+}
+
 func (s *Slot) CalculateSlotCount (mem, processor, bandwidth uint64) uint64 {
 	memCount := mem / s.Mem
 	processorCount := processor / s.Processor
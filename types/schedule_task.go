@@ -17,7 +17,9 @@ type ProposalTask struct {
 type ConsensusTaskWrap struct {
 	Task              *Task
 	OwnerDataResource *PrepareVoteResource
-	ResultCh          chan *ConsensuResult
+	// the proof of the power org election, it is carried by every prepareMsg
+	Election *ElectionProof
+	ResultCh chan *ConsensuResult
 }
 
 func (wrap *ConsensusTaskWrap) SendResult(result *ConsensuResult) {
@@ -39,14 +41,16 @@ type ReplayScheduleTaskWrap struct {
 	Role     TaskRole
 	PartyId  string
 	Task     *Task
+	Election *ElectionProof
 	ResultCh chan *ScheduleResult
 }
 
-func NewReplayScheduleTaskWrap(role TaskRole, partyId string, task *Task) *ReplayScheduleTaskWrap {
+func NewReplayScheduleTaskWrap(role TaskRole, partyId string, task *Task, election *ElectionProof) *ReplayScheduleTaskWrap {
 	return &ReplayScheduleTaskWrap{
 		Role:     role,
 		PartyId:  partyId,
 		Task:     task,
+		Election: election,
 		ResultCh: make(chan *ScheduleResult),
 	}
 }
@@ -72,12 +76,35 @@ func (wrap *ReplayScheduleTaskWrap) String() string {
 	return string(result)
 }
 
+// CancelTaskWrap asks the taskManager to stop a executing task,
+// the result of canceling it on the local Fighter is sent back by ResultCh.
+type CancelTaskWrap struct {
+	TaskId   string
+	ResultCh chan error
+}
+
+func NewCancelTaskWrap(taskId string) *CancelTaskWrap {
+	return &CancelTaskWrap{
+		TaskId:   taskId,
+		ResultCh: make(chan error, 1),
+	}
+}
+func (wrap *CancelTaskWrap) SendResult(err error) {
+	wrap.ResultCh <- err
+	close(wrap.ResultCh)
+}
+func (wrap *CancelTaskWrap) RecvResult() error {
+	return <-wrap.ResultCh
+}
+
 type DoneScheduleTaskChWrap struct {
 	ProposalId   common.Hash
 	SelfTaskRole TaskRole
 	SelfIdentity *libTypes.OrganizationData
 	Task         *ConsensusScheduleTask
 	ResultCh     chan *TaskResultMsgWrap
+	// the progress of task on the partner, which will be sent to the task owner
+	ProgressCh chan *TaskProgressMsgWrap
 }
 type ConsensusScheduleTask struct {
 	TaskDir          ProposalTaskDir
@@ -127,6 +154,8 @@ func (t TaskConsStatus) String() string {
 		return "TaskSucceed"
 	case TaskConsensusInterrupt:
 		return "TaskConsensusInterrupt"
+	case TaskConsensusCancel:
+		return "TaskConsensusCancel"
 	case TaskRunningInterrupt:
 		return "TaskRunningInterrupt"
 	default:
@@ -137,6 +166,7 @@ func (t TaskConsStatus) String() string {
 const (
 	TaskSucceed            TaskConsStatus = 0x0000
 	TaskConsensusInterrupt TaskConsStatus = 0x0001
+	TaskConsensusCancel    TaskConsStatus = 0x0002
 	TaskRunningInterrupt   TaskConsStatus = 0x0100
 )
 
@@ -184,3 +214,20 @@ type ConsensuResult struct {
 func (res *ConsensuResult) String() string {
 	return fmt.Sprintf(`{"taskId": %s, "status": %s, "done": %v, "err": %s}`, res.TaskId, res.Status.String(), res.Done, res.Err)
 }
+
+// ElectionProof is the verifiable random seed of the power org election of task,
+// the proof is the signature of task owner on the taskId, and the seed is derived from the proof.
+// The powers which are not elected by the seed (reused from the previous attempt, or elected
+// by a policy which is not verifiable) are attested by the signature of task owner on the proof and them.
+type ElectionProof struct {
+	Seed       []byte `json:"seed"`
+	Proof      []byte `json:"proof"`
+	PowersSign []byte `json:"powersSign"`
+}
+
+func (p *ElectionProof) String() string {
+	if nil == p {
+		return "{}"
+	}
+	return fmt.Sprintf(`{"seed": %x, "proof": %x, "powersSign": %x}`, p.Seed, p.Proof, p.PowersSign)
+}
@@ -0,0 +1,22 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/RosettaFlow/Carrier-Go/common"
+)
+
+// TaskAgreement is the evidence of the consensus on a task, which is recovered from the ledger of chaincons.
+type TaskAgreement struct {
+	TaskId string
+	// the proposal which was agreed by all the task members, empty if there is no agreement
+	ProposalId common.Hash
+	Agreed     bool
+	// the blocks which recorded the consensus of the task, in the ledger order
+	Blocks Blocks
+}
+
+func (a *TaskAgreement) String() string {
+	return fmt.Sprintf(`{"taskId": %s, "proposalId": %s, "agreed": %v, "blocks": %s}`,
+		a.TaskId, a.ProposalId.String(), a.Agreed, a.Blocks.String())
+}
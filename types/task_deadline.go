@@ -0,0 +1,45 @@
+package types
+
+import "errors"
+
+var (
+	ErrTaskStartDeadlineMissed  = errors.New("the task can not start before its start deadline")
+	ErrTaskFinishDeadlineMissed = errors.New("the task can not finish before its finish deadline")
+)
+
+// StartDeadline returns the time (ms) the task must start before, zero means no deadline.
+func (m *Task) StartDeadline() uint64 { return m.data.GetStartDeadline() }
+
+// FinishDeadline returns the time (ms) the task must finish by, zero means no deadline.
+func (m *Task) FinishDeadline() uint64 { return m.data.GetFinishDeadline() }
+
+// LatestStartAt returns the latest time (ms) the task can start at to meet both of its deadlines,
+// the declared duration is taken away from the finish deadline. zero means the task has no deadline.
+func (m *Task) LatestStartAt() uint64 {
+	latest := m.StartDeadline()
+	if finish := m.FinishDeadline(); 0 != finish {
+		var start uint64
+		if duration := m.data.GetTaskResource().GetDuration(); finish > duration {
+			start = finish - duration
+		}
+		// a deadline already missed still has to be earlier than the tasks without deadline
+		if 0 == start {
+			start = 1
+		}
+		if 0 == latest || start < latest {
+			latest = start
+		}
+	}
+	return latest
+}
+
+// CheckDeadline returns the error if the task can no longer start at `now` (ms) in time.
+func (m *Task) CheckDeadline(now uint64) error {
+	if start := m.StartDeadline(); 0 != start && now > start {
+		return ErrTaskStartDeadlineMissed
+	}
+	if finish := m.FinishDeadline(); 0 != finish && now+m.data.GetTaskResource().GetDuration() > finish {
+		return ErrTaskFinishDeadlineMissed
+	}
+	return nil
+}
@@ -0,0 +1,66 @@
+package types
+
+import (
+	"container/heap"
+	"testing"
+
+	libTypes "github.com/RosettaFlow/Carrier-Go/lib/types"
+)
+
+func newTestDeadlineTask(taskId string, startDeadline, finishDeadline, duration uint64) *Task {
+	return NewTask(&libTypes.TaskData{
+		TaskId:         taskId,
+		StartDeadline:  startDeadline,
+		FinishDeadline: finishDeadline,
+		TaskResource:   &libTypes.TaskResourceData{Duration: duration},
+	})
+}
+
+func TestTaskDeadline(t *testing.T) {
+	tests := []struct {
+		task   *Task
+		now    uint64
+		latest uint64
+		err    error
+	}{
+		{newTestDeadlineTask("none", 0, 0, 100), 1000, 0, nil},
+		{newTestDeadlineTask("start", 2000, 0, 100), 1000, 2000, nil},
+		{newTestDeadlineTask("start-missed", 2000, 0, 100), 2001, 2000, ErrTaskStartDeadlineMissed},
+		{newTestDeadlineTask("finish", 0, 2000, 500), 1500, 1500, nil},
+		{newTestDeadlineTask("finish-missed", 0, 2000, 500), 1501, 1500, ErrTaskFinishDeadlineMissed},
+		{newTestDeadlineTask("both", 1200, 2000, 500), 1000, 1200, nil},
+		{newTestDeadlineTask("too-long", 0, 2000, 5000), 0, 1, ErrTaskFinishDeadlineMissed},
+	}
+	for _, test := range tests {
+		if latest := test.task.LatestStartAt(); latest != test.latest {
+			t.Fatalf("unexpected latest start time of task %s: %d, want: %d", test.task.TaskId(), latest, test.latest)
+		}
+		if err := test.task.CheckDeadline(test.now); err != test.err {
+			t.Fatalf("unexpected deadline check of task %s: %v, want: %v", test.task.TaskId(), err, test.err)
+		}
+	}
+}
+
+func TestTaskBulletsDeadline(t *testing.T) {
+	newBullet := func(taskId string, priority TaskPriority, startDeadline uint64, term uint32) *TaskBullet {
+		task := newTestDeadlineTask(taskId, startDeadline, 0, 100)
+		task.TaskData().Priority = uint32(priority)
+		bullet := NewTaskBulletByTaskMsg(&TaskMsg{TaskId: taskId, Data: task})
+		bullet.Term = term
+		return bullet
+	}
+
+	bullets := new(TaskBullets)
+	heap.Push(bullets, newBullet("none-3", TaskPriorityNormal, 0, 3))
+	heap.Push(bullets, newBullet("late-0", TaskPriorityNormal, 5000, 0))
+	heap.Push(bullets, newBullet("early-0", TaskPriorityNormal, 1000, 0))
+	heap.Push(bullets, newBullet("high-none-0", TaskPriorityHigh, 0, 0))
+
+	expect := []string{"high-none-0", "early-0", "late-0", "none-3"}
+	for _, taskId := range expect {
+		bullet := heap.Pop(bullets).(*TaskBullet)
+		if bullet.UnschedTask.Data.TaskId() != taskId {
+			t.Fatalf("unexpected task popped: %s, want: %s", bullet.UnschedTask.Data.TaskId(), taskId)
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package types
+
+// TaskPriority is the priority class of the local task, the task with higher priority
+// is scheduled before the ones with lower priority which are waiting on the same queue.
+type TaskPriority uint32
+
+const (
+	TaskPriorityNormal TaskPriority = 0
+	TaskPriorityHigh   TaskPriority = 1
+	TaskPriorityUrgent TaskPriority = 2
+
+	// MaxTaskPriority is the highest priority class can be declared on the task publication.
+	MaxTaskPriority = TaskPriorityUrgent
+)
+
+func (p TaskPriority) String() string {
+	switch p {
+	case TaskPriorityNormal:
+		return "normal"
+	case TaskPriorityHigh:
+		return "high"
+	case TaskPriorityUrgent:
+		return "urgent"
+	default:
+		return "unknown"
+	}
+}
+
+// IsHigh reports whether the priority is above the normal one,
+// the count of the queued tasks with such priority is capped for every identity.
+func (p TaskPriority) IsHigh() bool { return p > TaskPriorityNormal }
+
+// Priority returns the priority class declared on the task publication.
+func (m *Task) Priority() TaskPriority {
+	return TaskPriority(m.data.GetPriority())
+}
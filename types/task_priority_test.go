@@ -0,0 +1,38 @@
+package types
+
+import (
+	"container/heap"
+	"testing"
+
+	libTypes "github.com/RosettaFlow/Carrier-Go/lib/types"
+)
+
+func newTestTaskBullet(taskId string, priority TaskPriority, term uint32) *TaskBullet {
+	bullet := NewTaskBulletByTaskMsg(&TaskMsg{
+		TaskId: taskId,
+		Data:   NewTask(&libTypes.TaskData{TaskId: taskId, Priority: uint32(priority)}),
+	})
+	bullet.Term = term
+	return bullet
+}
+
+func TestTaskBulletsPriority(t *testing.T) {
+	bullets := new(TaskBullets)
+	heap.Push(bullets, newTestTaskBullet("normal-3", TaskPriorityNormal, 3))
+	heap.Push(bullets, newTestTaskBullet("high-0", TaskPriorityHigh, 0))
+	heap.Push(bullets, newTestTaskBullet("normal-1", TaskPriorityNormal, 1))
+	heap.Push(bullets, newTestTaskBullet("urgent-0", TaskPriorityUrgent, 0))
+	heap.Push(bullets, newTestTaskBullet("high-2", TaskPriorityHigh, 2))
+
+	expect := []string{"urgent-0", "high-2", "high-0", "normal-3", "normal-1"}
+	for _, taskId := range expect {
+		bullet := heap.Pop(bullets).(*TaskBullet)
+		if bullet.UnschedTask.Data.TaskId() != taskId {
+			t.Fatalf("unexpected task popped: %s, want: %s", bullet.UnschedTask.Data.TaskId(), taskId)
+		}
+	}
+
+	if bullet := newTestTaskBullet("task", TaskPriorityHigh, 0); bullet.Priority != TaskPriorityHigh || !bullet.Priority.IsHigh() {
+		t.Fatalf("unexpected priority of bullet: %s", bullet.Priority.String())
+	}
+}
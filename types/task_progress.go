@@ -0,0 +1,95 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	pb "github.com/RosettaFlow/Carrier-Go/lib/consensus/twopc"
+)
+
+// TaskProgress is the latest progress of a task on one jobNode of a task partner,
+// which is polled from the `GetTaskDetails` of Fighter.
+type TaskProgress struct {
+	TaskId      string `json:"taskId"`
+	PartyId     string `json:"partyId"`
+	IdentityId  string `json:"identityId"`
+	JobNodeId   string `json:"jobNodeId"`
+	Phase       string `json:"phase"`
+	Percent     uint32 `json:"percent"`
+	ElapsedTime uint64 `json:"elapsedTime"`
+	RemainTime  uint64 `json:"remainTime"`
+	UpdateAt    uint64 `json:"updateAt"`
+}
+
+func (p *TaskProgress) String() string {
+	return fmt.Sprintf(`{"taskId": %s, "partyId": %s, "identityId": %s, "jobNodeId": %s, "phase": %s, "percent": %d, "elapsedTime": %d, "remainTime": %d, "updateAt": %d}`,
+		p.TaskId, p.PartyId, p.IdentityId, p.JobNodeId, p.Phase, p.Percent, p.ElapsedTime, p.RemainTime, p.UpdateAt)
+}
+
+// ParseTaskProgressPercent converts the progress reported by Fighter into the percent (0 ~ 100),
+// the progress may be a percentage (e.g. "45%", "45") or a fraction (e.g. "0.45").
+func ParseTaskProgressPercent(progress string) uint32 {
+	s := strings.TrimSpace(progress)
+	isPercentage := strings.HasSuffix(s, "%")
+	s = strings.TrimSpace(strings.TrimSuffix(s, "%"))
+	v, err := strconv.ParseFloat(s, 64)
+	if nil != err || v <= 0 {
+		return 0
+	}
+	if !isPercentage && v < 1 && strings.Contains(s, ".") {
+		v = v * 100
+	}
+	if v >= 100 {
+		return 100
+	}
+	return uint32(v)
+}
+
+func ConvertTaskProgress(p *TaskProgress) *pb.TaskProgress {
+	return &pb.TaskProgress{
+		TaskId:      []byte(p.TaskId),
+		PartyId:     []byte(p.PartyId),
+		IdentityId:  []byte(p.IdentityId),
+		JobNodeId:   []byte(p.JobNodeId),
+		Phase:       []byte(p.Phase),
+		Percent:     uint64(p.Percent),
+		ElapsedTime: p.ElapsedTime,
+		RemainTime:  p.RemainTime,
+		UpdateAt:    p.UpdateAt,
+	}
+}
+
+func FetchTaskProgress(p *pb.TaskProgress) *TaskProgress {
+	percent := p.Percent
+	if percent > 100 {
+		percent = 100
+	}
+	return &TaskProgress{
+		TaskId:      string(p.TaskId),
+		PartyId:     string(p.PartyId),
+		IdentityId:  string(p.IdentityId),
+		JobNodeId:   string(p.JobNodeId),
+		Phase:       string(p.Phase),
+		Percent:     uint32(percent),
+		ElapsedTime: p.ElapsedTime,
+		RemainTime:  p.RemainTime,
+		UpdateAt:    p.UpdateAt,
+	}
+}
+
+func ConvertTaskProgressArr(arr []*TaskProgress) []*pb.TaskProgress {
+	result := make([]*pb.TaskProgress, len(arr))
+	for i, p := range arr {
+		result[i] = ConvertTaskProgress(p)
+	}
+	return result
+}
+
+func FetchTaskProgressArr(arr []*pb.TaskProgress) []*TaskProgress {
+	result := make([]*TaskProgress, len(arr))
+	for i, p := range arr {
+		result[i] = FetchTaskProgress(p)
+	}
+	return result
+}
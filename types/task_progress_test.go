@@ -0,0 +1,26 @@
+package types
+
+import "testing"
+
+func TestParseTaskProgressPercent(t *testing.T) {
+	cases := []struct {
+		progress string
+		percent  uint32
+	}{
+		{"", 0},
+		{"unknown", 0},
+		{"-5", 0},
+		{"45%", 45},
+		{" 45.6 % ", 45},
+		{"45", 45},
+		{"0.45", 45},
+		{"1", 1},
+		{"100%", 100},
+		{"150", 100},
+	}
+	for _, c := range cases {
+		if got := ParseTaskProgressPercent(c.progress); got != c.percent {
+			t.Errorf("ParseTaskProgressPercent(%q) = %d, want %d", c.progress, got, c.percent)
+		}
+	}
+}
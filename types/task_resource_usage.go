@@ -0,0 +1,98 @@
+package types
+
+import (
+	"fmt"
+
+	pb "github.com/RosettaFlow/Carrier-Go/lib/consensus/twopc"
+)
+
+// The resource items of a task which are compared with the declared operation cost.
+const (
+	ResourceItemMem       = "mem"
+	ResourceItemProcessor = "processor"
+	ResourceItemBandwidth = "bandwidth"
+	ResourceItemDuration  = "duration"
+)
+
+// TaskResourceUsage is the resource actually used by a task on one jobNode of a task partner.
+type TaskResourceUsage struct {
+	TaskId        string `json:"taskId"`
+	PartyId       string `json:"partyId"`
+	IdentityId    string `json:"identityId"`
+	JobNodeId     string `json:"jobNodeId"`
+	UsedMem       uint64 `json:"usedMem"`
+	UsedProcessor uint64 `json:"usedProcessor"`
+	UsedBandwidth uint64 `json:"usedBandwidth"`
+	UsedDuration  uint64 `json:"usedDuration"`
+	UpdateAt      uint64 `json:"updateAt"`
+}
+
+func (usage *TaskResourceUsage) String() string {
+	return fmt.Sprintf(`{"taskId": %s, "partyId": %s, "identityId": %s, "jobNodeId": %s, "usedMem": %d, "usedProcessor": %d, "usedBandwidth": %d, "usedDuration": %d, "updateAt": %d}`,
+		usage.TaskId, usage.PartyId, usage.IdentityId, usage.JobNodeId, usage.UsedMem, usage.UsedProcessor, usage.UsedBandwidth, usage.UsedDuration, usage.UpdateAt)
+}
+
+// ExceededItems returns the resource items which are used more than the declared cost of task.
+func (usage *TaskResourceUsage) ExceededItems(declared *TaskOperationCost) []string {
+	items := make([]string, 0)
+	if nil == declared {
+		return items
+	}
+	if usage.UsedMem > declared.Mem {
+		items = append(items, ResourceItemMem)
+	}
+	if usage.UsedProcessor > declared.Processor {
+		items = append(items, ResourceItemProcessor)
+	}
+	if usage.UsedBandwidth > declared.Bandwidth {
+		items = append(items, ResourceItemBandwidth)
+	}
+	if usage.UsedDuration > declared.Duration {
+		items = append(items, ResourceItemDuration)
+	}
+	return items
+}
+
+func ConvertTaskResourceUsage(usage *TaskResourceUsage) *pb.TaskResourceUsage {
+	return &pb.TaskResourceUsage{
+		TaskId:        []byte(usage.TaskId),
+		PartyId:       []byte(usage.PartyId),
+		IdentityId:    []byte(usage.IdentityId),
+		JobNodeId:     []byte(usage.JobNodeId),
+		UsedMem:       usage.UsedMem,
+		UsedProcessor: usage.UsedProcessor,
+		UsedBandwidth: usage.UsedBandwidth,
+		UsedDuration:  usage.UsedDuration,
+		UpdateAt:      usage.UpdateAt,
+	}
+}
+
+func FetchTaskResourceUsage(usage *pb.TaskResourceUsage) *TaskResourceUsage {
+	return &TaskResourceUsage{
+		TaskId:        string(usage.TaskId),
+		PartyId:       string(usage.PartyId),
+		IdentityId:    string(usage.IdentityId),
+		JobNodeId:     string(usage.JobNodeId),
+		UsedMem:       usage.UsedMem,
+		UsedProcessor: usage.UsedProcessor,
+		UsedBandwidth: usage.UsedBandwidth,
+		UsedDuration:  usage.UsedDuration,
+		UpdateAt:      usage.UpdateAt,
+	}
+}
+
+func ConvertTaskResourceUsageArr(usages []*TaskResourceUsage) []*pb.TaskResourceUsage {
+	arr := make([]*pb.TaskResourceUsage, len(usages))
+	for i, usage := range usages {
+		arr[i] = ConvertTaskResourceUsage(usage)
+	}
+	return arr
+}
+
+func FetchTaskResourceUsageArr(usages []*pb.TaskResourceUsage) []*TaskResourceUsage {
+	arr := make([]*TaskResourceUsage, len(usages))
+	for i, usage := range usages {
+		arr[i] = FetchTaskResourceUsage(usage)
+	}
+	return arr
+}
@@ -0,0 +1,108 @@
+package types
+
+import (
+	"time"
+
+	"github.com/RosettaFlow/Carrier-Go/common/timeutils"
+	libTypes "github.com/RosettaFlow/Carrier-Go/lib/types"
+)
+
+// MaxTaskRetryAttempts is the upper limit of the attempts declared by the retry policy of task.
+const MaxTaskRetryAttempts = 10
+
+// MaxTaskRetryBackoff is the upper limit of the backoff (ms) declared by the retry policy of task.
+const MaxTaskRetryBackoff = 60 * 60 * 1000
+
+// TaskRetryRecord is the persisted next attempt of a failed task which is waiting for its backoff,
+// the task of the attempt itself is stored by `StoreLocalTask`.
+type TaskRetryRecord struct {
+	TaskId        string
+	PowerPartyIds []string
+	// the time (ms) to requeue the attempt to scheduler
+	RequeueAt uint64
+}
+
+// Attempt returns the sequence number of the current attempt of task (start from 1).
+func (m *Task) Attempt() uint32 {
+	if 0 == m.data.GetAttempt() {
+		return 1
+	}
+	return m.data.GetAttempt()
+}
+
+// OriginTaskId returns the taskId of the first attempt of task.
+func (m *Task) OriginTaskId() string {
+	if "" == m.data.GetOriginTaskId() {
+		return m.data.GetTaskId()
+	}
+	return m.data.GetOriginTaskId()
+}
+
+// CanRetry returns whether the retry policy of task allows another attempt after the current attempt failed.
+func (m *Task) CanRetry() bool {
+	policy := m.data.GetRetryPolicy()
+	if nil == policy {
+		return false
+	}
+	return m.Attempt() < policy.GetMaxAttempts()
+}
+
+// RetryBackoff returns the delay before the next attempt of task is requeued, the backoff of the
+// task received from the remote peer or stored by the older version is clamped by MaxTaskRetryBackoff.
+func (m *Task) RetryBackoff() time.Duration {
+	backoff := m.data.GetRetryPolicy().GetBackoff()
+	if backoff > MaxTaskRetryBackoff {
+		backoff = MaxTaskRetryBackoff
+	}
+	return time.Duration(backoff) * time.Millisecond
+}
+
+// ReusePrevPowers returns whether the current attempt reuses the powerSuppliers of the previous attempt,
+// instead of electing the new ones.
+func (m *Task) ReusePrevPowers() bool {
+	policy := m.data.GetRetryPolicy()
+	if nil == policy || policy.GetReelectPower() {
+		return false
+	}
+	return m.Attempt() > 1 && len(m.data.GetResourceSupplier()) != 0
+}
+
+// NewRetryTaskMsg makes the taskMsg of the next attempt of the failed task,
+// the new attempt takes a new taskId and links to the taskId of the first attempt.
+func NewRetryTaskMsg(task *Task) (*TaskMsg, error) {
+	raw, err := task.TaskData().Marshal()
+	if nil != err {
+		return nil, err
+	}
+	data := new(libTypes.TaskData)
+	if err := data.Unmarshal(raw); nil != err {
+		return nil, err
+	}
+
+	// the powerPartyIds were assigned to the powerSuppliers on the election of the previous attempt
+	powerPartyIds := make([]string, len(data.GetResourceSupplier()))
+	for i, supplier := range data.GetResourceSupplier() {
+		powerPartyIds[i] = supplier.GetOrganization().GetPartyId()
+	}
+
+	data.TaskId = ""
+	data.State = TaskStatePending.String()
+	data.Reason = ""
+	data.EventCount = 0
+	data.EventDataList = nil
+	data.CreateAt = uint64(timeutils.UnixMsec())
+	data.StartAt = 0
+	data.EndAt = 0
+	data.Attempt = task.Attempt() + 1
+	data.OriginTaskId = task.OriginTaskId()
+	if data.GetRetryPolicy().GetReelectPower() {
+		data.ResourceSupplier = make([]*libTypes.TaskResourceSupplierData, 0)
+	}
+
+	msg := &TaskMsg{
+		PowerPartyIds: powerPartyIds,
+		Data:          NewTask(data),
+	}
+	data.TaskId = msg.SetTaskId()
+	return msg, nil
+}
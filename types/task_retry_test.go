@@ -0,0 +1,84 @@
+package types
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	pb "github.com/RosettaFlow/Carrier-Go/lib/api"
+	libTypes "github.com/RosettaFlow/Carrier-Go/lib/types"
+)
+
+func newFailedAttemptTask(reelect bool) *Task {
+	return NewTask(&libTypes.TaskData{
+		TaskId:   "task:0x01",
+		TaskName: "retry",
+		Identity: "identity:0x01",
+		PartyId:  "p0",
+		State:    TaskStateFailed.String(),
+		EndAt:    100,
+		ResourceSupplier: []*libTypes.TaskResourceSupplierData{
+			{Organization: &libTypes.OrganizationData{PartyId: "p1", Identity: "identity:0x02"}},
+			{Organization: &libTypes.OrganizationData{PartyId: "p2", Identity: "identity:0x03"}},
+		},
+		EventDataList: []*libTypes.EventData{{TaskId: "task:0x01"}},
+		RetryPolicy:   &libTypes.TaskRetryPolicy{MaxAttempts: 2, Backoff: 10, ReelectPower: reelect},
+	})
+}
+
+func TestNewRetryTaskMsg(t *testing.T) {
+	task := newFailedAttemptTask(false)
+	if task.Attempt() != 1 || !task.CanRetry() || task.ReusePrevPowers() {
+		t.Fatalf("unexpected first attempt, attempt: %d, canRetry: %v, reusePowers: %v",
+			task.Attempt(), task.CanRetry(), task.ReusePrevPowers())
+	}
+
+	msg, err := NewRetryTaskMsg(task)
+	if nil != err {
+		t.Fatal(err)
+	}
+	next := msg.Data
+	if msg.TaskId == "" || msg.TaskId == task.TaskId() || next.TaskId() != msg.TaskId {
+		t.Fatalf("unexpected taskId of the next attempt: %s", msg.TaskId)
+	}
+	if next.Attempt() != 2 || next.OriginTaskId() != task.TaskId() {
+		t.Fatalf("unexpected next attempt, attempt: %d, originTaskId: %s", next.Attempt(), next.OriginTaskId())
+	}
+	if next.TaskData().State != TaskStatePending.String() || next.TaskData().EndAt != 0 || len(next.TaskData().EventDataList) != 0 {
+		t.Fatalf("the next attempt was not reset: %s", next.TaskData().String())
+	}
+	if len(msg.PowerPartyIds) != 2 || msg.PowerPartyIds[0] != "p1" || msg.PowerPartyIds[1] != "p2" {
+		t.Fatalf("unexpected powerPartyIds: %v", msg.PowerPartyIds)
+	}
+	if !next.ReusePrevPowers() || next.CanRetry() {
+		t.Fatalf("unexpected last attempt, reusePowers: %v, canRetry: %v", next.ReusePrevPowers(), next.CanRetry())
+	}
+	// the failed attempt keeps untouched
+	if task.TaskData().State != TaskStateFailed.String() || len(task.TaskData().EventDataList) != 1 {
+		t.Fatalf("the failed attempt was changed: %s", task.TaskData().String())
+	}
+
+	msg, err = NewRetryTaskMsg(newFailedAttemptTask(true))
+	if nil != err {
+		t.Fatal(err)
+	}
+	if len(msg.Data.TaskData().ResourceSupplier) != 0 || msg.Data.ReusePrevPowers() || len(msg.PowerPartyIds) != 2 {
+		t.Fatalf("the powers should be re-elected on the next attempt: %s", msg.String())
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	task := newFailedAttemptTask(false)
+	if task.RetryBackoff() != 10*time.Millisecond {
+		t.Fatalf("unexpected backoff: %s", task.RetryBackoff())
+	}
+	// the overflowed backoff is clamped
+	task.TaskData().RetryPolicy.Backoff = math.MaxUint64
+	if task.RetryBackoff() != MaxTaskRetryBackoff*time.Millisecond {
+		t.Fatalf("the backoff was not clamped: %s", task.RetryBackoff())
+	}
+	policy := NewTaskRetryPolicyFromRequest(&pb.TaskRetryPolicyDeclare{MaxAttempts: 3, Backoff: math.MaxUint64})
+	if policy.Backoff != MaxTaskRetryBackoff {
+		t.Fatalf("the declared backoff was not clamped: %d", policy.Backoff)
+	}
+}
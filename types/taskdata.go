@@ -57,6 +57,16 @@ func (m *Task) TaskData() *libTypes.TaskData {
 	return m.data
 }
 
+// OperationCost returns the resource declared by the task owner.
+func (m *Task) OperationCost() *TaskOperationCost {
+	return &TaskOperationCost{
+		Processor: uint64(m.data.GetTaskResource().GetCostProcessor()),
+		Mem:       m.data.GetTaskResource().GetCostMem(),
+		Bandwidth: m.data.GetTaskResource().GetCostBandwidth(),
+		Duration:  m.data.GetTaskResource().GetDuration(),
+	}
+}
+
 func (m *Task) SetEventList(eventList []*TaskEventInfo) {
 	eventArr := make([]*libTypes.EventData, len(eventList))
 	for i, ev := range eventList{
@@ -126,6 +136,12 @@ type TaskDetailShow struct {
 	EndAt         uint64                   `json:"endAt"`
 	State         string                   `json:"state"`
 	OperationCost *TaskOperationCost       `json:"operationCost"`
+	Attempt       uint32                   `json:"attempt"`
+	MaxAttempts   uint32                   `json:"maxAttempts"`
+	OriginTaskId  string                   `json:"originTaskId"`
+	Priority      uint32                   `json:"priority"`
+	StartDeadline  uint64                  `json:"startDeadline"`
+	FinishDeadline uint64                  `json:"finishDeadline"`
 }
 
 func ConvertTaskDetailShowToPB(task *TaskDetailShow) *pb.TaskDetailShow {
@@ -142,6 +158,12 @@ func ConvertTaskDetailShowToPB(task *TaskDetailShow) *pb.TaskDetailShow {
 		EndAt:         task.EndAt,
 		State:         task.State,
 		OperationCost: ConvertTaskOperationCostToPB(task.OperationCost),
+		Attempt:       task.Attempt,
+		MaxAttempts:   task.MaxAttempts,
+		OriginTaskId:  task.OriginTaskId,
+		Priority:      task.Priority,
+		StartDeadline:  task.StartDeadline,
+		FinishDeadline: task.FinishDeadline,
 	}
 }
 func ConvertTaskDetailShowFromPB(task *pb.TaskDetailShow) *TaskDetailShow {
@@ -158,6 +180,12 @@ func ConvertTaskDetailShowFromPB(task *pb.TaskDetailShow) *TaskDetailShow {
 		EndAt:         task.EndAt,
 		State:         task.State,
 		OperationCost: ConvertTaskOperationCostFromPB(task.OperationCost),
+		Attempt:       task.Attempt,
+		MaxAttempts:   task.MaxAttempts,
+		OriginTaskId:  task.OriginTaskId,
+		Priority:      task.Priority,
+		StartDeadline:  task.StartDeadline,
+		FinishDeadline: task.FinishDeadline,
 	}
 }
 
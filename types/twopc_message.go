@@ -3,10 +3,8 @@ package types
 import (
 	"encoding/json"
 	"github.com/RosettaFlow/Carrier-Go/common"
-	"github.com/RosettaFlow/Carrier-Go/common/rlputil"
 	"github.com/RosettaFlow/Carrier-Go/crypto/sha3"
 	pb "github.com/RosettaFlow/Carrier-Go/lib/consensus/twopc"
-	"github.com/ethereum/go-ethereum/rlp"
 	"sync/atomic"
 )
 
@@ -17,6 +15,25 @@ const (
 
 type ProposalTaskDir uint8
 
+// pbMsgMarshaler is the pb encoding of the 2pc messages.
+type pbMsgMarshaler interface {
+	Marshal() ([]byte, error)
+}
+
+// pbMsgHash hashes the pb encoding of the msg.
+// The rlp encoding can not be used here, because the pb structs carry
+// the int32 `XXX_sizecache` field that rlp does not support.
+func pbMsgHash(msg pbMsgMarshaler) (hash common.Hash) {
+	b, err := msg.Marshal()
+	if nil != err {
+		return common.Hash{}
+	}
+	hasher := sha3.NewKeccak256()
+	hasher.Write(b)
+	hasher.Sum(hash[:0])
+	return hash
+}
+
 func (dir ProposalTaskDir) String() string {
 	if dir == SendTaskDir {
 		return "sendTask"
@@ -48,23 +65,16 @@ func (msg *PrepareMsgWrap) SealHash() common.Hash {
 	return v
 }
 func (msg *PrepareMsgWrap) _sealHash() (hash common.Hash) {
-	hasher := sha3.NewKeccak256()
-	rlp.Encode(hasher, []interface{}{
-		msg.ProposalId,
-		msg.TaskRole,
-		msg.Owner,
-		msg.TaskInfo,
-		msg.CreateAt,
-	})
-
-	hasher.Sum(hash[:0])
-	return hash
+	// the signature is not a part of the seal hash
+	m := *msg.PrepareMsg
+	m.Sign = nil
+	return pbMsgHash(&m)
 }
 func (msg *PrepareMsgWrap) Hash() common.Hash {
 	if hash := msg.hash.Load(); hash != nil {
 		return hash.(common.Hash)
 	}
-	v := rlputil.RlpHash(msg)
+	v := pbMsgHash(msg.PrepareMsg)
 	msg.hash.Store(v)
 	return v
 }
@@ -94,24 +104,16 @@ func (msg *PrepareVoteWrap) SealHash() common.Hash {
 	return v
 }
 func (msg *PrepareVoteWrap) _sealHash() (hash common.Hash) {
-	hasher := sha3.NewKeccak256()
-	rlp.Encode(hasher, []interface{}{
-		msg.ProposalId,
-		msg.TaskRole,
-		msg.Owner,
-		msg.VoteOption,
-		msg.PeerInfo,
-		msg.CreateAt,
-	})
-
-	hasher.Sum(hash[:0])
-	return hash
+	// the signature is not a part of the seal hash
+	m := *msg.PrepareVote
+	m.Sign = nil
+	return pbMsgHash(&m)
 }
 func (msg *PrepareVoteWrap) Hash() common.Hash {
 	if hash := msg.hash.Load(); hash != nil {
 		return hash.(common.Hash)
 	}
-	v := rlputil.RlpHash(msg)
+	v := pbMsgHash(msg.PrepareVote)
 	msg.hash.Store(v)
 	return v
 }
@@ -142,21 +144,16 @@ func (msg *ConfirmMsgWrap) SealHash() common.Hash {
 	return v
 }
 func (msg *ConfirmMsgWrap) _sealHash() (hash common.Hash) {
-	hasher := sha3.NewKeccak256()
-	rlp.Encode(hasher, []interface{}{
-		msg.ProposalId,
-		msg.Owner,
-		msg.CreateAt,
-	})
-
-	hasher.Sum(hash[:0])
-	return hash
+	// the signature is not a part of the seal hash
+	m := *msg.ConfirmMsg
+	m.Sign = nil
+	return pbMsgHash(&m)
 }
 func (msg *ConfirmMsgWrap) Hash() common.Hash {
 	if hash := msg.hash.Load(); hash != nil {
 		return hash.(common.Hash)
 	}
-	v := rlputil.RlpHash(msg)
+	v := pbMsgHash(msg.ConfirmMsg)
 	msg.hash.Store(v)
 	return v
 }
@@ -188,23 +185,16 @@ func (msg *ConfirmVoteWrap) SealHash() common.Hash {
 	return v
 }
 func (msg *ConfirmVoteWrap) _sealHash() (hash common.Hash) {
-	hasher := sha3.NewKeccak256()
-	rlp.Encode(hasher, []interface{}{
-		msg.ProposalId,
-		msg.TaskRole,
-		msg.Owner,
-		msg.VoteOption,
-		msg.CreateAt,
-	})
-
-	hasher.Sum(hash[:0])
-	return hash
+	// the signature is not a part of the seal hash
+	m := *msg.ConfirmVote
+	m.Sign = nil
+	return pbMsgHash(&m)
 }
 func (msg *ConfirmVoteWrap) Hash() common.Hash {
 	if hash := msg.hash.Load(); hash != nil {
 		return hash.(common.Hash)
 	}
-	v := rlputil.RlpHash(msg)
+	v := pbMsgHash(msg.ConfirmVote)
 	msg.hash.Store(v)
 	return v
 }
@@ -237,21 +227,16 @@ func (msg *CommitMsgWrap) SealHash() common.Hash {
 	return v
 }
 func (msg *CommitMsgWrap) _sealHash() (hash common.Hash) {
-	hasher := sha3.NewKeccak256()
-	rlp.Encode(hasher, []interface{}{
-		msg.ProposalId,
-		msg.Owner,
-		msg.CreateAt,
-	})
-
-	hasher.Sum(hash[:0])
-	return hash
+	// the signature is not a part of the seal hash
+	m := *msg.CommitMsg
+	m.Sign = nil
+	return pbMsgHash(&m)
 }
 func (msg *CommitMsgWrap) Hash() common.Hash {
 	if hash := msg.hash.Load(); hash != nil {
 		return hash.(common.Hash)
 	}
-	v := rlputil.RlpHash(msg)
+	v := pbMsgHash(msg.CommitMsg)
 	msg.hash.Store(v)
 	return v
 }
@@ -281,24 +266,95 @@ func (msg *TaskResultMsgWrap) SealHash() common.Hash {
 	return v
 }
 func (msg *TaskResultMsgWrap) _sealHash() (hash common.Hash) {
-	hasher := sha3.NewKeccak256()
-	rlp.Encode(hasher, []interface{}{
-		msg.ProposalId,
-		msg.TaskRole,
-		msg.TaskId,
-		msg.TaskEventList,
-		msg.CreateAt,
-	})
-
-	hasher.Sum(hash[:0])
-	return hash
+	// the signature is not a part of the seal hash
+	m := *msg.TaskResultMsg
+	m.Sign = nil
+	return pbMsgHash(&m)
 }
 func (msg *TaskResultMsgWrap) Hash() common.Hash {
 	if hash := msg.hash.Load(); hash != nil {
 		return hash.(common.Hash)
 	}
-	v := rlputil.RlpHash(msg)
+	v := pbMsgHash(msg.TaskResultMsg)
+	msg.hash.Store(v)
+	return v
+}
+func (msg *TaskResultMsgWrap) Signature() []byte {return msg.Sign}
+
+
+// ------------------------------- About TaskCancelMsg -------------------------------
+type TaskCancelMsgWrap struct {
+	*pb.TaskCancelMsg
+	// caches
+	sealHash atomic.Value `json:"-" rlp:"-"`
+	hash     atomic.Value `json:"-" rlp:"-"`
+}
+func (msg *TaskCancelMsgWrap) String() string {
+	result, err := json.Marshal(msg)
+	if err != nil{
+		return "Failed to generate string"
+	}
+	return string(result)
+}
+func (msg *TaskCancelMsgWrap) SealHash() common.Hash {
+	if sealHash := msg.sealHash.Load(); sealHash != nil {
+		return sealHash.(common.Hash)
+	}
+	v := msg._sealHash()
+	msg.sealHash.Store(v)
+	return v
+}
+func (msg *TaskCancelMsgWrap) _sealHash() (hash common.Hash) {
+	// the signature is not a part of the seal hash
+	m := *msg.TaskCancelMsg
+	m.Sign = nil
+	return pbMsgHash(&m)
+}
+func (msg *TaskCancelMsgWrap) Hash() common.Hash {
+	if hash := msg.hash.Load(); hash != nil {
+		return hash.(common.Hash)
+	}
+	v := pbMsgHash(msg.TaskCancelMsg)
+	msg.hash.Store(v)
+	return v
+}
+func (msg *TaskCancelMsgWrap) Signature() []byte {return msg.Sign}
+
+
+// ------------------------------- About TaskProgressMsg -------------------------------
+type TaskProgressMsgWrap struct {
+	*pb.TaskProgressMsg
+	// caches
+	sealHash atomic.Value `json:"-" rlp:"-"`
+	hash     atomic.Value `json:"-" rlp:"-"`
+}
+func (msg *TaskProgressMsgWrap) String() string {
+	result, err := json.Marshal(msg)
+	if err != nil{
+		return "Failed to generate string"
+	}
+	return string(result)
+}
+func (msg *TaskProgressMsgWrap) SealHash() common.Hash {
+	if sealHash := msg.sealHash.Load(); sealHash != nil {
+		return sealHash.(common.Hash)
+	}
+	v := msg._sealHash()
+	msg.sealHash.Store(v)
+	return v
+}
+func (msg *TaskProgressMsgWrap) _sealHash() (hash common.Hash) {
+	// the signature is not a part of the seal hash
+	m := *msg.TaskProgressMsg
+	m.Sign = nil
+	return pbMsgHash(&m)
+}
+func (msg *TaskProgressMsgWrap) Hash() common.Hash {
+	if hash := msg.hash.Load(); hash != nil {
+		return hash.(common.Hash)
+	}
+	v := pbMsgHash(msg.TaskProgressMsg)
 	msg.hash.Store(v)
 	return v
 }
-func (msg *TaskResultMsgWrap) Signature() []byte {return msg.Sign}
\ No newline at end of file
+func (msg *TaskProgressMsgWrap) Signature() []byte {return msg.Sign}
@@ -0,0 +1,62 @@
+package types
+
+import (
+	"github.com/RosettaFlow/Carrier-Go/common"
+	pb "github.com/RosettaFlow/Carrier-Go/lib/consensus/twopc"
+	"github.com/ethereum/go-ethereum/crypto"
+	"testing"
+)
+
+func newTestPrepareMsg() *pb.PrepareMsg {
+	return &pb.PrepareMsg{
+		ProposalId:  common.BytesToHash([]byte("proposalId")).Bytes(),
+		TaskRole:    DataSupplier.Bytes(),
+		TaskPartyId: []byte("p1"),
+		Owner: &pb.TaskOrganizationIdentityInfo{
+			Name:       []byte("owner"),
+			NodeId:     []byte("nodeId"),
+			IdentityId: []byte("identityId"),
+			PartyId:    []byte("p0"),
+		},
+		TaskInfo: []byte("taskInfo"),
+		CreateAt: 1,
+	}
+}
+
+func TestPrepareMsgSealHash(t *testing.T) {
+	msg := newTestPrepareMsg()
+	sealHash := (&PrepareMsgWrap{PrepareMsg: msg}).SealHash()
+	if sealHash == (common.Hash{}) {
+		t.Fatal("the sealHash of prepareMsg is empty")
+	}
+
+	key, err := crypto.GenerateKey()
+	if nil != err {
+		t.Fatal(err)
+	}
+	sign, err := crypto.Sign(sealHash.Bytes(), key)
+	if nil != err {
+		t.Fatal(err)
+	}
+	msg.Sign = sign
+
+	// the signature is not a part of the sealHash
+	signed := &PrepareMsgWrap{PrepareMsg: msg}
+	if signed.SealHash() != sealHash {
+		t.Fatal("the sealHash of prepareMsg changed after signed")
+	}
+	pub, err := crypto.SigToPub(signed.SealHash().Bytes(), signed.Signature())
+	if nil != err {
+		t.Fatal(err)
+	}
+	if crypto.PubkeyToAddress(*pub) != crypto.PubkeyToAddress(key.PublicKey) {
+		t.Fatal("failed to recover the signer of prepareMsg")
+	}
+
+	// the sealHash must be changed with the owner
+	changed := newTestPrepareMsg()
+	changed.Owner.PartyId = []byte("p2")
+	if (&PrepareMsgWrap{PrepareMsg: changed}).SealHash() == sealHash {
+		t.Fatal("the sealHash of prepareMsg is not changed with the owner")
+	}
+}
@@ -0,0 +1,384 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/RosettaFlow/Carrier-Go/common/rlputil"
+	"github.com/RosettaFlow/Carrier-Go/common/timeutils"
+	libTypes "github.com/RosettaFlow/Carrier-Go/lib/types"
+)
+
+const PREFIX_WORKFLOW_ID = "workflow:"
+
+// MaxWorkflowTaskCount is the upper limit of the task count in a workflow.
+const MaxWorkflowTaskCount = 64
+
+var (
+	ErrWorkflowInvalid        = errors.New("invalid workflow")
+	ErrWorkflowBindingInvalid = errors.New("invalid result binding of workflow task")
+	ErrWorkflowDependsCyclic  = errors.New("the depends of workflow tasks is cyclic")
+	ErrWorkflowContractParams = errors.New("the ContractExtraParams of workflow task is not a json object")
+	ErrWorkflowResultNotReady = errors.New("the result metadata of the task bound is not published yet")
+)
+
+// WorkflowResultResolver returns the result metadata of the published task which is held by the identity,
+// it returns ErrWorkflowResultNotReady if the metadata is not published yet.
+type WorkflowResultResolver func(identity, taskId string) (*Metadata, error)
+
+// Workflow is a DAG of the dependent tasks, a task will be published only when all its predecessors succeed.
+type Workflow struct {
+	data *libTypes.WorkflowData
+}
+
+func NewWorkflow(data *libTypes.WorkflowData) *Workflow {
+	return &Workflow{data: data}
+}
+
+func (w *Workflow) WorkflowId() string                   { return w.data.GetWorkflowId() }
+func (w *Workflow) WorkflowData() *libTypes.WorkflowData { return w.data }
+func (w *Workflow) State() TaskState                     { return TaskState(w.data.GetState()) }
+func (w *Workflow) IsTerminated() bool                   { return IsTaskStateTerminated(w.State()) }
+
+// SetWorkflowId generates the workflowId by the owner identity, workflow name and the current time.
+func (w *Workflow) SetWorkflowId() string {
+	if "" != w.data.WorkflowId {
+		return w.data.WorkflowId
+	}
+	w.data.WorkflowId = PREFIX_WORKFLOW_ID + rlputil.RlpHash([]interface{}{
+		w.data.GetIdentity(),
+		w.data.GetWorkflowName(),
+		uint64(timeutils.UnixMsec()),
+	}).Hex()
+	return w.data.WorkflowId
+}
+
+// Task returns the workflow task with the name.
+func (w *Workflow) Task(name string) *libTypes.WorkflowTaskData {
+	for _, task := range w.data.GetTaskList() {
+		if task.GetName() == name {
+			return task
+		}
+	}
+	return nil
+}
+
+// TaskByTaskId returns the workflow task which published with the taskId.
+func (w *Workflow) TaskByTaskId(taskId string) *libTypes.WorkflowTaskData {
+	for _, task := range w.data.GetTaskList() {
+		if "" != taskId && task.GetTaskId() == taskId {
+			return task
+		}
+	}
+	return nil
+}
+
+// Validate checks the names, depends and result bindings of the workflow tasks,
+// and the depends must make up a DAG.
+func (w *Workflow) Validate() error {
+	tasks := w.data.GetTaskList()
+	if len(tasks) == 0 {
+		return fmt.Errorf("%s, the workflow has no task", ErrWorkflowInvalid)
+	}
+	if len(tasks) > MaxWorkflowTaskCount {
+		return fmt.Errorf("%s, the task count of workflow can not be greater than %d", ErrWorkflowInvalid, MaxWorkflowTaskCount)
+	}
+
+	names := make(map[string]*libTypes.WorkflowTaskData, len(tasks))
+	for _, task := range tasks {
+		if "" == task.GetName() {
+			return fmt.Errorf("%s, the task name of workflow is empty", ErrWorkflowInvalid)
+		}
+		if _, ok := names[task.GetName()]; ok {
+			return fmt.Errorf("%s, the task name of workflow is duplicated, name: {%s}", ErrWorkflowInvalid, task.GetName())
+		}
+		if nil == task.GetTask() {
+			return fmt.Errorf("%s, the task declaration of workflow is empty, name: {%s}", ErrWorkflowInvalid, task.GetName())
+		}
+		names[task.GetName()] = task
+	}
+
+	for _, task := range tasks {
+		depends := make(map[string]struct{}, len(task.GetDepends()))
+		for _, depend := range task.GetDepends() {
+			if depend == task.GetName() {
+				return fmt.Errorf("%s, the task depends on itself, name: {%s}", ErrWorkflowInvalid, task.GetName())
+			}
+			if _, ok := names[depend]; !ok {
+				return fmt.Errorf("%s, the depend task is not found, name: {%s}, depend: {%s}", ErrWorkflowInvalid, task.GetName(), depend)
+			}
+			depends[depend] = struct{}{}
+		}
+		for _, binding := range task.GetBindings() {
+			if err := validateWorkflowBinding(task, binding, depends, names); nil != err {
+				return err
+			}
+		}
+		if _, err := parseContractExtraParams(task.GetTask().GetContractExtraParams()); nil != err {
+			return fmt.Errorf("%s, name: {%s}", err, task.GetName())
+		}
+	}
+
+	if _, err := w.topoSort(); nil != err {
+		return err
+	}
+	return nil
+}
+
+func validateWorkflowBinding(task *libTypes.WorkflowTaskData, binding *libTypes.WorkflowResultBinding,
+	depends map[string]struct{}, names map[string]*libTypes.WorkflowTaskData) error {
+
+	if _, ok := depends[binding.GetFromTask()]; !ok {
+		return fmt.Errorf("%s, the task bound is not a depend task, name: {%s}, fromTask: {%s}",
+			ErrWorkflowBindingInvalid, task.GetName(), binding.GetFromTask())
+	}
+	var isReceiver bool
+	for _, receiver := range names[binding.GetFromTask()].GetTask().GetReceivers() {
+		if receiver.GetReceiver().GetIdentity() == binding.GetIdentity() {
+			isReceiver = true
+			break
+		}
+	}
+	if !isReceiver {
+		return fmt.Errorf("%s, the identity is not a result receiver of the task bound, name: {%s}, fromTask: {%s}, identity: {%s}",
+			ErrWorkflowBindingInvalid, task.GetName(), binding.GetFromTask(), binding.GetIdentity())
+	}
+
+	var isDataSupplier bool
+	for _, supplier := range task.GetTask().GetMetadataSupplier() {
+		if supplier.GetOrganization().GetIdentity() == binding.GetIdentity() {
+			isDataSupplier = true
+			break
+		}
+	}
+	if !isDataSupplier {
+		return fmt.Errorf("%s, the identity is not a data supplier of the task, name: {%s}, fromTask: {%s}, identity: {%s}",
+			ErrWorkflowBindingInvalid, task.GetName(), binding.GetFromTask(), binding.GetIdentity())
+	}
+	return nil
+}
+
+// topoSort returns the task names in the topological order of depends (Kahn's algorithm).
+func (w *Workflow) topoSort() ([]string, error) {
+	tasks := w.data.GetTaskList()
+	indegree := make(map[string]int, len(tasks))
+	successors := make(map[string][]string, len(tasks))
+	for _, task := range tasks {
+		indegree[task.GetName()] += 0
+		for _, depend := range uniqueStrings(task.GetDepends()) {
+			indegree[task.GetName()]++
+			successors[depend] = append(successors[depend], task.GetName())
+		}
+	}
+
+	queue := make([]string, 0, len(tasks))
+	for _, task := range tasks {
+		if indegree[task.GetName()] == 0 {
+			queue = append(queue, task.GetName())
+		}
+	}
+	order := make([]string, 0, len(tasks))
+	for len(queue) != 0 {
+		name := queue[0]
+		queue = queue[1:]
+		order = append(order, name)
+		for _, next := range successors[name] {
+			indegree[next]--
+			if indegree[next] == 0 {
+				queue = append(queue, next)
+			}
+		}
+	}
+	if len(order) != len(tasks) {
+		return nil, ErrWorkflowDependsCyclic
+	}
+	return order, nil
+}
+
+// ReadyTasks returns the pending tasks whose predecessors all succeed.
+func (w *Workflow) ReadyTasks() []*libTypes.WorkflowTaskData {
+	ready := make([]*libTypes.WorkflowTaskData, 0)
+	for _, task := range w.data.GetTaskList() {
+		if TaskState(task.GetState()) != TaskStatePending {
+			continue
+		}
+		succeed := true
+		for _, depend := range task.GetDepends() {
+			if TaskState(w.Task(depend).GetState()) != TaskStateSuccess {
+				succeed = false
+				break
+			}
+		}
+		if succeed {
+			ready = append(ready, task)
+		}
+	}
+	return ready
+}
+
+// PendingDownstream returns the pending tasks which depend on the task directly or indirectly.
+func (w *Workflow) PendingDownstream(name string) []*libTypes.WorkflowTaskData {
+	visited := map[string]struct{}{name: {}}
+	queue := []string{name}
+	result := make([]*libTypes.WorkflowTaskData, 0)
+	for len(queue) != 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		for _, task := range w.data.GetTaskList() {
+			if _, ok := visited[task.GetName()]; ok {
+				continue
+			}
+			for _, depend := range task.GetDepends() {
+				if depend == cur {
+					visited[task.GetName()] = struct{}{}
+					queue = append(queue, task.GetName())
+					if TaskState(task.GetState()) == TaskStatePending {
+						result = append(result, task)
+					}
+					break
+				}
+			}
+		}
+	}
+	return result
+}
+
+// Finished returns whether all the tasks of workflow are terminated, and the final state of workflow:
+// success if all tasks succeed, failed if any task failed, otherwise cancelled.
+func (w *Workflow) Finished() (bool, TaskState) {
+	var hasFailed, hasCancelled bool
+	for _, task := range w.data.GetTaskList() {
+		switch TaskState(task.GetState()) {
+		case TaskStateSuccess:
+		case TaskStateFailed:
+			hasFailed = true
+		case TaskStateCancelled:
+			hasCancelled = true
+		default:
+			return false, w.State()
+		}
+	}
+	if hasFailed {
+		return true, TaskStateFailed
+	}
+	if hasCancelled {
+		return true, TaskStateCancelled
+	}
+	return true, TaskStateSuccess
+}
+
+// AddEvent appends a workflow level event.
+func (w *Workflow) AddEvent(typ, taskName, taskId, content string) {
+	w.data.EventList = append(w.data.EventList, &libTypes.WorkflowEventData{
+		EventType:    typ,
+		TaskName:     taskName,
+		TaskId:       taskId,
+		EventContent: content,
+		EventAt:      uint64(timeutils.UnixMsec()),
+	})
+}
+
+// NewWorkflowTaskMsg makes the taskMsg of the workflow task with a new taskId, the result metadata of the
+// predecessors become the input metadata of the data suppliers by the result bindings, and their taskIds are
+// injected into the ContractExtraParams if the paramKey of binding is set.
+func (w *Workflow) NewWorkflowTaskMsg(task *libTypes.WorkflowTaskData, resolve WorkflowResultResolver) (*TaskMsg, error) {
+	raw, err := task.GetTask().Marshal()
+	if nil != err {
+		return nil, err
+	}
+	data := new(libTypes.TaskData)
+	if err := data.Unmarshal(raw); nil != err {
+		return nil, err
+	}
+
+	if len(task.GetBindings()) != 0 {
+		params, err := parseContractExtraParams(data.GetContractExtraParams())
+		if nil != err {
+			return nil, err
+		}
+		for _, binding := range task.GetBindings() {
+			from := w.Task(binding.GetFromTask())
+			if nil == from || "" == from.GetTaskId() {
+				return nil, fmt.Errorf("%s, the task bound was not published, fromTask: {%s}", ErrWorkflowBindingInvalid, binding.GetFromTask())
+			}
+			metadata, err := resolve(binding.GetIdentity(), from.GetTaskId())
+			if nil != err {
+				return nil, err
+			}
+			bindResultMetadata(data, binding.GetIdentity(), metadata.MetadataData())
+			if "" != binding.GetParamKey() {
+				params[binding.GetParamKey()] = from.GetTaskId()
+			}
+		}
+		b, err := json.Marshal(params)
+		if nil != err {
+			return nil, err
+		}
+		data.ContractExtraParams = string(b)
+	}
+
+	data.TaskId = ""
+	data.State = TaskStatePending.String()
+	data.CreateAt = uint64(timeutils.UnixMsec())
+	data.Attempt = 1
+
+	msg := &TaskMsg{
+		PowerPartyIds: task.GetPowerPartyIds(),
+		Data:          NewTask(data),
+	}
+	data.TaskId = msg.SetTaskId()
+	return msg, nil
+}
+
+// bindResultMetadata makes the result metadata of the task bound the input metadata of the data supplier,
+// all the columns of the result are used if the supplier selects none.
+func bindResultMetadata(data *libTypes.TaskData, identity string, metadata *libTypes.MetaData) {
+	for _, supplier := range data.GetMetadataSupplier() {
+		if supplier.GetOrganization().GetIdentity() != identity {
+			continue
+		}
+		supplier.MetaId = metadata.GetDataId()
+		supplier.MetaName = metadata.GetTableName()
+		if len(supplier.GetColumnList()) == 0 {
+			supplier.ColumnList = metadata.GetColumnMetaList()
+		}
+	}
+}
+
+func parseContractExtraParams(params string) (map[string]interface{}, error) {
+	result := make(map[string]interface{})
+	if "" == params {
+		return result, nil
+	}
+	if err := json.Unmarshal([]byte(params), &result); nil != err || nil == result {
+		return nil, ErrWorkflowContractParams
+	}
+	return result, nil
+}
+
+func uniqueStrings(arr []string) []string {
+	cache := make(map[string]struct{}, len(arr))
+	result := make([]string, 0, len(arr))
+	for _, s := range arr {
+		if _, ok := cache[s]; ok {
+			continue
+		}
+		cache[s] = struct{}{}
+		result = append(result, s)
+	}
+	return result
+}
+
+// IsTaskStateTerminated returns whether the state is one of success, failed and cancelled.
+func IsTaskStateTerminated(state TaskState) bool {
+	return state == TaskStateSuccess || state == TaskStateFailed || state == TaskStateCancelled
+}
+
+// TaskFinishedEvent is published by taskManager when a local task (as the owner) finished.
+type TaskFinishedEvent struct {
+	TaskId string
+	State  TaskState
+	// the taskId of the next attempt, if the failed task was retried by its retry policy
+	RetryTaskId string
+}
@@ -0,0 +1,174 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	libTypes "github.com/RosettaFlow/Carrier-Go/lib/types"
+)
+
+func newTestWorkflowTask(name string, depends ...string) *libTypes.WorkflowTaskData {
+	return &libTypes.WorkflowTaskData{
+		Name:    name,
+		Depends: depends,
+		State:   TaskStatePending.String(),
+		Task: &libTypes.TaskData{
+			TaskName: name,
+			Identity: "identity:owner",
+			PartyId:  "p0",
+			MetadataSupplier: []*libTypes.TaskMetadataSupplierData{
+				{Organization: &libTypes.OrganizationData{Identity: "identity:owner", PartyId: "p0"}},
+			},
+			Receivers: []*libTypes.TaskResultReceiverData{
+				{Receiver: &libTypes.OrganizationData{Identity: "identity:owner", PartyId: "p0"}},
+			},
+		},
+	}
+}
+
+// psi -> feature -> train -> predict, and predict depends on psi too.
+func newTestWorkflow() *Workflow {
+	return NewWorkflow(&libTypes.WorkflowData{
+		WorkflowName: "pipeline",
+		Identity:     "identity:owner",
+		TaskList: []*libTypes.WorkflowTaskData{
+			newTestWorkflowTask("psi"),
+			newTestWorkflowTask("feature", "psi"),
+			newTestWorkflowTask("train", "feature"),
+			newTestWorkflowTask("predict", "train", "psi"),
+		},
+	})
+}
+
+func TestWorkflowValidate(t *testing.T) {
+	if err := newTestWorkflow().Validate(); nil != err {
+		t.Fatalf("validate workflow failed: %s", err)
+	}
+
+	cyclic := newTestWorkflow()
+	cyclic.Task("psi").Depends = []string{"predict"}
+	if err := cyclic.Validate(); err != ErrWorkflowDependsCyclic {
+		t.Fatalf("expect cyclic error, got: %v", err)
+	}
+
+	missing := newTestWorkflow()
+	missing.Task("train").Depends = []string{"unknown"}
+	if err := missing.Validate(); nil == err {
+		t.Fatal("expect error on the unknown depend")
+	}
+
+	duplicated := newTestWorkflow()
+	duplicated.Task("train").Name = "feature"
+	if err := duplicated.Validate(); nil == err {
+		t.Fatal("expect error on the duplicated task name")
+	}
+
+	binding := newTestWorkflow()
+	binding.Task("train").Bindings = []*libTypes.WorkflowResultBinding{
+		{FromTask: "psi", Identity: "identity:owner", ParamKey: "psi_task_id"},
+	}
+	if err := binding.Validate(); nil == err {
+		t.Fatal("expect error on the binding of a task which is not a depend")
+	}
+	binding.Task("train").Bindings[0].FromTask = "feature"
+	binding.Task("train").Bindings[0].Identity = "identity:other"
+	if err := binding.Validate(); nil == err {
+		t.Fatal("expect error on the binding identity which is not a receiver")
+	}
+	binding.Task("train").Bindings[0].Identity = "identity:owner"
+	if err := binding.Validate(); nil != err {
+		t.Fatalf("validate workflow with binding failed: %s", err)
+	}
+}
+
+func TestWorkflowReadyTasks(t *testing.T) {
+	w := newTestWorkflow()
+	assertReady := func(names ...string) {
+		t.Helper()
+		ready := w.ReadyTasks()
+		if len(ready) != len(names) {
+			t.Fatalf("unexpected ready task count: %d, want: %v", len(ready), names)
+		}
+		for i, task := range ready {
+			if task.GetName() != names[i] {
+				t.Fatalf("unexpected ready task: %s, want: %s", task.GetName(), names[i])
+			}
+		}
+	}
+
+	assertReady("psi")
+	w.Task("psi").State = TaskStateSuccess.String()
+	assertReady("feature")
+	w.Task("feature").State = TaskStateRunning.String()
+	assertReady()
+	w.Task("feature").State = TaskStateSuccess.String()
+	assertReady("train")
+
+	downstream := w.PendingDownstream("feature")
+	if len(downstream) != 2 || downstream[0].GetName() != "train" || downstream[1].GetName() != "predict" {
+		t.Fatalf("unexpected pending downstream: %v", downstream)
+	}
+
+	if finished, _ := w.Finished(); finished {
+		t.Fatal("the workflow should not be finished")
+	}
+	w.Task("train").State = TaskStateFailed.String()
+	w.Task("predict").State = TaskStateCancelled.String()
+	if finished, state := w.Finished(); !finished || state != TaskStateFailed {
+		t.Fatalf("unexpected finished state: %v, %s", finished, state)
+	}
+}
+
+func TestNewWorkflowTaskMsg(t *testing.T) {
+	w := newTestWorkflow()
+	w.Task("feature").Task.ContractExtraParams = `{"epoch": 10}`
+	w.Task("feature").Bindings = []*libTypes.WorkflowResultBinding{
+		{FromTask: "psi", Identity: "identity:owner", ParamKey: "psi_task_id"},
+	}
+	results := make(map[string]*Metadata)
+	resolve := func(identity, taskId string) (*Metadata, error) {
+		if metadata, ok := results[identity+taskId]; ok {
+			return metadata, nil
+		}
+		return nil, ErrWorkflowResultNotReady
+	}
+	if _, err := w.NewWorkflowTaskMsg(w.Task("feature"), resolve); nil == err {
+		t.Fatal("expect error while the task bound was not published")
+	}
+
+	w.Task("psi").TaskId = "task:0x01"
+	if _, err := w.NewWorkflowTaskMsg(w.Task("feature"), resolve); err != ErrWorkflowResultNotReady {
+		t.Fatalf("expect not ready error while the result metadata was not published, got: %v", err)
+	}
+
+	results["identity:owner"+"task:0x01"] = NewMetadata(&libTypes.MetaData{
+		Identity:       "identity:owner",
+		DataId:         "metadata:0x01",
+		OriginId:       "task:0x01",
+		TableName:      "psi_result",
+		ColumnMetaList: []*libTypes.ColumnMeta{{Cindex: 0, Cname: "id"}},
+	})
+	msg, err := w.NewWorkflowTaskMsg(w.Task("feature"), resolve)
+	if nil != err {
+		t.Fatal(err)
+	}
+	if "" == msg.TaskId || msg.Data.TaskId() != msg.TaskId {
+		t.Fatalf("unexpected taskId: %s", msg.TaskId)
+	}
+	supplier := msg.Data.TaskData().GetMetadataSupplier()[0]
+	if supplier.GetMetaId() != "metadata:0x01" || supplier.GetMetaName() != "psi_result" || len(supplier.GetColumnList()) != 1 {
+		t.Fatalf("the result metadata was not bound to the data supplier: %v", supplier)
+	}
+	var params map[string]interface{}
+	if err := json.Unmarshal([]byte(msg.Data.TaskData().ContractExtraParams), &params); nil != err {
+		t.Fatal(err)
+	}
+	if params["psi_task_id"] != "task:0x01" || params["epoch"] != float64(10) {
+		t.Fatalf("unexpected ContractExtraParams: %s", msg.Data.TaskData().ContractExtraParams)
+	}
+	// the template keeps untouched
+	if w.Task("feature").GetTask().GetContractExtraParams() != `{"epoch": 10}` || "" != w.Task("feature").GetTask().GetTaskId() ||
+		"" != w.Task("feature").GetTask().GetMetadataSupplier()[0].GetMetaId() {
+		t.Fatal("the task template of workflow was changed")
+	}
+}